@@ -0,0 +1,131 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package openapi holds the OpenAPI 3 document of the k8s-chaos REST API, including the schemas of
+// all chaos.gushchin.dev CRDs, and generates the types of the Python and TypeScript clients from it.
+// The document is served at /api/v1/openapi.json; regenerate it with `make openapi`.
+package openapi
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+)
+
+// Spec is the generated OpenAPI document
+//
+//go:embed openapi.json
+var Spec []byte
+
+//go:embed base.yaml
+var base []byte
+
+// crds are the CRD manifests whose schemas become components, by schema name
+var crds = []struct {
+	schema string
+	file   string
+}{
+	{"ChaosCleanupTask", "chaos.gushchin.dev_chaoscleanuptasks.yaml"},
+	{"ChaosExperiment", "chaos.gushchin.dev_chaosexperiments.yaml"},
+	{"ChaosExperimentHistory", "chaos.gushchin.dev_chaosexperimenthistories.yaml"},
+	{"ChaosFreeze", "chaos.gushchin.dev_chaosfreezes.yaml"},
+	{"ChaosMonkey", "chaos.gushchin.dev_chaosmonkeys.yaml"},
+	{"ChaosPolicy", "chaos.gushchin.dev_chaospolicies.yaml"},
+}
+
+// Generate builds the OpenAPI document from base.yaml and the CRD manifests in crdDir
+func Generate(crdDir string) ([]byte, error) {
+	doc, err := decodeYAML(base)
+	if err != nil {
+		return nil, fmt.Errorf("invalid base.yaml: %w", err)
+	}
+	schemas, ok := lookup(doc, "components", "schemas").(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("base.yaml has no components.schemas")
+	}
+
+	for _, crd := range crds {
+		data, err := os.ReadFile(filepath.Join(crdDir, crd.file))
+		if err != nil {
+			return nil, err
+		}
+		manifest, err := decodeYAML(data)
+		if err != nil {
+			return nil, fmt.Errorf("invalid CRD %s: %w", crd.file, err)
+		}
+		versions, _ := lookup(manifest, "spec", "versions").([]any)
+		if len(versions) != 1 {
+			return nil, fmt.Errorf("CRD %s: expected exactly one version, got %d", crd.file, len(versions))
+		}
+		schema, ok := lookup(versions[0], "schema", "openAPIV3Schema").(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("CRD %s has no openAPIV3Schema", crd.file)
+		}
+
+		// The CRD leaves metadata open; describe the fields clients use
+		if properties, ok := schema["properties"].(map[string]any); ok {
+			properties["metadata"] = map[string]any{"$ref": "#/components/schemas/ObjectMeta"}
+		}
+		schemas[crd.schema] = schema
+		schemas[crd.schema+"List"] = map[string]any{
+			"type":     "object",
+			"required": []any{"items"},
+			"properties": map[string]any{
+				"apiVersion": map[string]any{"type": "string"},
+				"kind":       map[string]any{"type": "string"},
+				"metadata":   map[string]any{"$ref": "#/components/schemas/ListMeta"},
+				"items": map[string]any{
+					"type":  "array",
+					"items": map[string]any{"$ref": "#/components/schemas/" + crd.schema},
+				},
+			},
+		}
+	}
+
+	out, err := json.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append(out, '\n'), nil
+}
+
+func decodeYAML(data []byte) (map[string]any, error) {
+	encoded, err := utilyaml.ToJSON(data)
+	if err != nil {
+		return nil, err
+	}
+	var doc map[string]any
+	if err := json.Unmarshal(encoded, &doc); err != nil {
+		return nil, err
+	}
+	return doc, nil
+}
+
+// lookup walks nested maps along keys, returning nil when a key is missing
+func lookup(node any, keys ...string) any {
+	for _, key := range keys {
+		m, ok := node.(map[string]any)
+		if !ok {
+			return nil
+		}
+		node = m[key]
+	}
+	return node
+}
@@ -0,0 +1,125 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package openapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestSpecIsUpToDate(t *testing.T) {
+	spec, err := Generate("../../config/crd/bases")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(spec, Spec) {
+		t.Fatal("api/openapi/openapi.json is out of date, run make openapi")
+	}
+
+	typescript, err := TypeScript(spec)
+	if err != nil {
+		t.Fatal(err)
+	}
+	python, err := Python(spec)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for path, want := range map[string][]byte{
+		"../../clients/typescript/src/types.ts":          typescript,
+		"../../clients/python/k8s_chaos_client/types.py": python,
+	} {
+		got, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(got, want) {
+			t.Errorf("%s is out of date, run make openapi", path)
+		}
+	}
+}
+
+func TestGenerateMergesCRDSchemas(t *testing.T) {
+	var doc map[string]any
+	if err := json.Unmarshal(Spec, &doc); err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range []string{"ChaosExperiment", "ChaosExperimentList", "ChaosExperimentHistory", "ChaosFreeze", "ChaosPolicy", "Error"} {
+		if lookup(doc, "components", "schemas", name) == nil {
+			t.Errorf("schema %s missing", name)
+		}
+	}
+	metadata, _ := lookup(doc, "components", "schemas", "ChaosExperiment", "properties", "metadata").(map[string]any)
+	if metadata["$ref"] != "#/components/schemas/ObjectMeta" {
+		t.Errorf("metadata: got %v, want a reference to ObjectMeta", metadata)
+	}
+}
+
+func TestTypes(t *testing.T) {
+	spec := []byte(`{"components": {"schemas": {"Thing": {
+		"type": "object",
+		"required": ["name"],
+		"properties": {
+			"name": {"type": "string", "description": "Name of the thing"},
+			"mode": {"type": "string", "enum": ["a", "b"]},
+			"port": {"x-kubernetes-int-or-string": true},
+			"labels": {"type": "object", "additionalProperties": {"type": "string"}},
+			"x-extra": {"type": "array", "items": {"$ref": "#/components/schemas/Other"}},
+			"nested": {"type": "object", "properties": {"count": {"type": "integer"}}}
+		}
+	}}}}`)
+
+	typescript, err := TypeScript(spec)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{
+		"export interface Thing {",
+		"  /** Name of the thing */\n  name: string;",
+		`  mode?: "a" | "b";`,
+		"  port?: number | string;",
+		"  labels?: { [key: string]: string };",
+		`  "x-extra"?: Other[];`,
+		"  nested?: {\n    count?: number;\n  };",
+	} {
+		if !strings.Contains(string(typescript), want) {
+			t.Errorf("TypeScript output lacks %q:\n%s", want, typescript)
+		}
+	}
+
+	python, err := Python(spec)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{
+		`"mode": Literal["a", "b"],`,
+		`"port": Union[int, str],`,
+		`"labels": Dict[str, str],`,
+		`"x-extra": List["Other"],`,
+		`"nested": "ThingNested",`,
+		"ThingNested = TypedDict(\n    \"ThingNested\",\n    {\n        \"count\": int,",
+	} {
+		if !strings.Contains(string(python), want) {
+			t.Errorf("Python output lacks %q:\n%s", want, python)
+		}
+	}
+	if strings.Index(string(python), "ThingNested =") > strings.Index(string(python), "Thing =") {
+		t.Error("nested TypedDict must be defined before its parent")
+	}
+}
@@ -0,0 +1,271 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package openapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const generatedHeader = "Code generated by `make openapi` from api/openapi; DO NOT EDIT."
+
+// componentSchemas returns the component schemas of an OpenAPI document, sorted by name
+func componentSchemas(spec []byte) ([]string, map[string]map[string]any, error) {
+	var doc map[string]any
+	if err := json.Unmarshal(spec, &doc); err != nil {
+		return nil, nil, fmt.Errorf("invalid OpenAPI document: %w", err)
+	}
+	raw, _ := lookup(doc, "components", "schemas").(map[string]any)
+	schemas := make(map[string]map[string]any, len(raw))
+	names := make([]string, 0, len(raw))
+	for name, schema := range raw {
+		if m, ok := schema.(map[string]any); ok {
+			schemas[name] = m
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names, schemas, nil
+}
+
+func refName(schema map[string]any) string {
+	ref, _ := schema["$ref"].(string)
+	return strings.TrimPrefix(ref, "#/components/schemas/")
+}
+
+func requiredSet(schema map[string]any) map[string]bool {
+	required := map[string]bool{}
+	list, _ := schema["required"].([]any)
+	for _, name := range list {
+		if s, ok := name.(string); ok {
+			required[s] = true
+		}
+	}
+	return required
+}
+
+func sortedProperties(schema map[string]any) ([]string, map[string]any) {
+	properties, _ := schema["properties"].(map[string]any)
+	names := make([]string, 0, len(properties))
+	for name := range properties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names, properties
+}
+
+// exported turns a property name into the suffix of a nested type name
+func exported(name string) string {
+	var b strings.Builder
+	upper := true
+	for _, r := range name {
+		if r == '-' || r == '_' || r == '.' {
+			upper = true
+			continue
+		}
+		if upper {
+			b.WriteString(strings.ToUpper(string(r)))
+			upper = false
+		} else {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+var tsIdentifier = regexp.MustCompile(`^[A-Za-z_$][A-Za-z0-9_$]*$`)
+
+// TypeScript renders the component schemas of spec as TypeScript interfaces
+func TypeScript(spec []byte) ([]byte, error) {
+	names, schemas, err := componentSchemas(spec)
+	if err != nil {
+		return nil, err
+	}
+	var b strings.Builder
+	fmt.Fprintf(&b, "// %s\n", generatedHeader)
+	for _, name := range names {
+		b.WriteString("\n")
+		writeTSComment(&b, schemas[name], "")
+		if _, isObject := schemas[name]["properties"]; isObject {
+			fmt.Fprintf(&b, "export interface %s %s\n", name, tsType(schemas[name], ""))
+		} else {
+			fmt.Fprintf(&b, "export type %s = %s;\n", name, tsType(schemas[name], ""))
+		}
+	}
+	return []byte(b.String()), nil
+}
+
+func writeTSComment(b *strings.Builder, schema map[string]any, indent string) {
+	description, _ := schema["description"].(string)
+	if description == "" {
+		return
+	}
+	lines := strings.Split(strings.ReplaceAll(description, "*/", "* /"), "\n")
+	if len(lines) == 1 {
+		fmt.Fprintf(b, "%s/** %s */\n", indent, lines[0])
+		return
+	}
+	fmt.Fprintf(b, "%s/**\n", indent)
+	for _, line := range lines {
+		fmt.Fprintf(b, "%s * %s\n", indent, strings.TrimRight(line, " "))
+	}
+	fmt.Fprintf(b, "%s */\n", indent)
+}
+
+func tsType(schema map[string]any, indent string) string {
+	if name := refName(schema); name != "" {
+		return name
+	}
+	if schema["x-kubernetes-int-or-string"] == true {
+		return "number | string"
+	}
+	if values, ok := schema["enum"].([]any); ok && len(values) > 0 {
+		literals := make([]string, len(values))
+		for i, v := range values {
+			encoded, _ := json.Marshal(v)
+			literals[i] = string(encoded)
+		}
+		return strings.Join(literals, " | ")
+	}
+
+	switch schema["type"] {
+	case "string":
+		return "string"
+	case "integer", "number":
+		return "number"
+	case "boolean":
+		return "boolean"
+	case "array":
+		items, _ := schema["items"].(map[string]any)
+		item := tsType(items, indent)
+		if strings.ContainsAny(item, " |{") {
+			return "Array<" + item + ">"
+		}
+		return item + "[]"
+	}
+
+	names, properties := sortedProperties(schema)
+	if len(names) == 0 {
+		if additional, ok := schema["additionalProperties"].(map[string]any); ok {
+			return "{ [key: string]: " + tsType(additional, indent) + " }"
+		}
+		return "{ [key: string]: unknown }"
+	}
+	required := requiredSet(schema)
+	inner := indent + "  "
+	var b strings.Builder
+	b.WriteString("{\n")
+	for _, name := range names {
+		property, _ := properties[name].(map[string]any)
+		writeTSComment(&b, property, inner)
+		key := name
+		if !tsIdentifier.MatchString(name) {
+			key = strconv.Quote(name)
+		}
+		optional := "?"
+		if required[name] {
+			optional = ""
+		}
+		fmt.Fprintf(&b, "%s%s%s: %s;\n", inner, key, optional, tsType(property, inner))
+	}
+	b.WriteString(indent + "}")
+	return b.String()
+}
+
+// Python renders the component schemas of spec as TypedDicts. Nested objects become their own
+// TypedDicts named after the path to them, e.g. ChaosExperimentSpec.
+func Python(spec []byte) ([]byte, error) {
+	names, schemas, err := componentSchemas(spec)
+	if err != nil {
+		return nil, err
+	}
+	var b strings.Builder
+	fmt.Fprintf(&b, "# %s\n", generatedHeader)
+	b.WriteString("# pylint: skip-file\n")
+	b.WriteString("from typing import Any, Dict, List, Literal, TypedDict, Union\n")
+	for _, name := range names {
+		if _, isObject := schemas[name]["properties"]; isObject {
+			writePythonTypedDict(&b, name, schemas[name])
+		} else {
+			fmt.Fprintf(&b, "\n%s = %s\n", name, pythonType(schemas[name], name, &b))
+		}
+	}
+	return []byte(b.String()), nil
+}
+
+// writePythonTypedDict writes the TypedDicts of nested objects first, then the one of schema
+func writePythonTypedDict(b *strings.Builder, name string, schema map[string]any) {
+	names, properties := sortedProperties(schema)
+	fields := make([]string, 0, len(names))
+	for _, property := range names {
+		propertySchema, _ := properties[property].(map[string]any)
+		fields = append(fields, fmt.Sprintf("    %q: %s,", property,
+			pythonType(propertySchema, name+exported(property), b)))
+	}
+
+	fmt.Fprintf(b, "\n%s = TypedDict(\n    %q,\n    {\n", name, name)
+	for _, field := range fields {
+		b.WriteString("    " + field + "\n")
+	}
+	b.WriteString("    },\n    total=False,\n)\n")
+}
+
+// pythonType returns the annotation of schema, writing TypedDicts for nested objects named
+// nestedName. References to other types are quoted so that definition order does not matter.
+func pythonType(schema map[string]any, nestedName string, b *strings.Builder) string {
+	if name := refName(schema); name != "" {
+		return strconv.Quote(name)
+	}
+	if schema["x-kubernetes-int-or-string"] == true {
+		return "Union[int, str]"
+	}
+	if values, ok := schema["enum"].([]any); ok && len(values) > 0 {
+		literals := make([]string, len(values))
+		for i, v := range values {
+			encoded, _ := json.Marshal(v)
+			literals[i] = string(encoded)
+		}
+		return "Literal[" + strings.Join(literals, ", ") + "]"
+	}
+
+	switch schema["type"] {
+	case "string":
+		return "str"
+	case "integer":
+		return "int"
+	case "number":
+		return "float"
+	case "boolean":
+		return "bool"
+	case "array":
+		items, _ := schema["items"].(map[string]any)
+		return "List[" + pythonType(items, nestedName, b) + "]"
+	}
+
+	if _, ok := schema["properties"]; ok {
+		writePythonTypedDict(b, nestedName, schema)
+		return strconv.Quote(nestedName)
+	}
+	if additional, ok := schema["additionalProperties"].(map[string]any); ok {
+		return "Dict[str, " + pythonType(additional, nestedName+"Value", b) + "]"
+	}
+	return "Dict[str, Any]"
+}
@@ -0,0 +1,150 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Phases of a ChaosCleanupTask
+const (
+	// CleanupTaskPending tasks are waiting for their next attempt
+	CleanupTaskPending = "Pending"
+	// CleanupTaskSucceeded tasks reverted their injection
+	CleanupTaskSucceeded = "Succeeded"
+	// CleanupTaskFailed tasks ran out of attempts; the injection needs to be reverted by hand
+	CleanupTaskFailed = "Failed"
+)
+
+// ChaosCleanupTaskSpec describes one revert left to do for an experiment
+type ChaosCleanupTaskSpec struct {
+	// Experiment is the name of the experiment, in the task's namespace, whose injection is reverted.
+	// Tasks outlive their experiment, so it may be gone.
+	// +optional
+	Experiment string `json:"experiment,omitempty"`
+
+	// Operation is the revert to run
+	// +kubebuilder:validation:Enum=Uncordon;Untaint;StopContainer;RestoreQuota;RemoveWebhook
+	// +kubebuilder:validation:Required
+	Operation string `json:"operation"`
+
+	// Node to uncordon or untaint
+	// +optional
+	Node string `json:"node,omitempty"`
+
+	// TaintKey is the key of the taint Untaint removes
+	// +optional
+	TaintKey string `json:"taintKey,omitempty"`
+
+	// TaintEffect is the effect of the taint Untaint removes
+	// +kubebuilder:validation:Enum=NoSchedule;PreferNoSchedule;NoExecute
+	// +optional
+	TaintEffect string `json:"taintEffect,omitempty"`
+
+	// Pod whose ephemeral container StopContainer stops, as "namespace/name"
+	// +optional
+	Pod string `json:"pod,omitempty"`
+
+	// Container is the ephemeral container StopContainer stops
+	// +optional
+	Container string `json:"container,omitempty"`
+
+	// Quota is the ResourceQuota RestoreQuota restores, as "namespace/name"
+	// +optional
+	Quota string `json:"quota,omitempty"`
+
+	// Webhook is the ValidatingWebhookConfiguration RemoveWebhook deletes
+	// +optional
+	Webhook string `json:"webhook,omitempty"`
+
+	// AfterExperiment holds the revert until the experiment is no longer running. The controller
+	// sets it for injections that must last as long as the experiment, e.g. affected pods that did
+	// not fit status.affectedPods.
+	// +optional
+	AfterExperiment bool `json:"afterExperiment,omitempty"`
+
+	// MaxAttempts is how often the revert is tried, with exponential backoff, before the task fails
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:default=10
+	// +optional
+	MaxAttempts int32 `json:"maxAttempts,omitempty"`
+}
+
+// ChaosCleanupTaskStatus defines the observed state of ChaosCleanupTask
+type ChaosCleanupTaskStatus struct {
+	// Phase is Pending until the revert succeeds or runs out of attempts
+	// +kubebuilder:validation:Enum=Pending;Succeeded;Failed
+	// +optional
+	Phase string `json:"phase,omitempty"`
+
+	// Attempts is the number of times the revert was tried
+	// +optional
+	Attempts int32 `json:"attempts,omitempty"`
+
+	// LastError is the error of the last failed attempt
+	// +optional
+	LastError string `json:"lastError,omitempty"`
+
+	// LastAttemptTime is when the revert was last tried
+	// +optional
+	LastAttemptTime *metav1.Time `json:"lastAttemptTime,omitempty"`
+
+	// NextAttemptTime is when a pending task is tried again
+	// +optional
+	NextAttemptTime *metav1.Time `json:"nextAttemptTime,omitempty"`
+
+	// CompletionTime is when the task succeeded or failed
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:shortName=cleanuptask
+// +kubebuilder:printcolumn:name="Experiment",type="string",JSONPath=".spec.experiment"
+// +kubebuilder:printcolumn:name="Operation",type="string",JSONPath=".spec.operation"
+// +kubebuilder:printcolumn:name="Node",type="string",JSONPath=".spec.node"
+// +kubebuilder:printcolumn:name="Pod",type="string",JSONPath=".spec.pod"
+// +kubebuilder:printcolumn:name="Phase",type="string",JSONPath=".status.phase"
+// +kubebuilder:printcolumn:name="Attempts",type="integer",JSONPath=".status.attempts"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// ChaosCleanupTask is the Schema for the chaoscleanuptasks API
+// The experiment controller creates a task for every revert it cannot finish itself, e.g. a node
+// that failed to uncordon or a pod it has no room to track in its status. A dedicated controller
+// retries the task until it succeeds, independent of the experiment, which may have completed or
+// been deleted in the meantime.
+type ChaosCleanupTask struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ChaosCleanupTaskSpec   `json:"spec,omitempty"`
+	Status ChaosCleanupTaskStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ChaosCleanupTaskList contains a list of ChaosCleanupTask
+type ChaosCleanupTaskList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ChaosCleanupTask `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ChaosCleanupTask{}, &ChaosCleanupTaskList{})
+}
@@ -17,6 +17,9 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -27,6 +30,10 @@ const (
 	// ExclusionLabel is the label that protects resources from chaos experiments
 	ExclusionLabel = "chaos.gushchin.dev/exclude"
 
+	// ExcludeUntilAnnotation opts a pod or namespace out of chaos until an RFC3339 time, e.g. for
+	// the length of a data migration; unlike the exclusion label it expires on its own
+	ExcludeUntilAnnotation = "chaos.gushchin.dev/exclude-until"
+
 	// ProductionAnnotation marks a namespace as production
 	ProductionAnnotation = "chaos.gushchin.dev/production"
 
@@ -35,6 +42,71 @@ const (
 
 	// ProductionLabelValue for environment label
 	ProductionLabelValue = "production"
+
+	// InitiatedByAnnotation records the user or service account that created the experiment
+	// It is set by the mutating webhook from the admission request and cannot be changed afterwards
+	InitiatedByAnnotation = "chaos.gushchin.dev/initiated-by"
+
+	// UserAgentAnnotation identifies the tool that created the experiment (e.g. k8s-chaos-cli, argocd)
+	// Clients set it themselves; the mutating webhook fills it in for well-known GitOps controllers
+	UserAgentAnnotation = "chaos.gushchin.dev/user-agent"
+
+	// AbortAnnotation asks the controller to stop the experiment and revert its injections
+	// The value records who requested the abort
+	AbortAnnotation = "chaos.gushchin.dev/abort"
+
+	// ReplayOfAnnotation names the history record (namespace/name) an experiment was reconstructed from
+	ReplayOfAnnotation = "chaos.gushchin.dev/replay-of"
+
+	// ReplayTargetsAnnotation lists the pods (comma-separated namespace/name) a replayed run should target first
+	// It seeds sticky target selection until the experiment records its own status.selectedTargets
+	ReplayTargetsAnnotation = "chaos.gushchin.dev/replay-targets"
+
+	// ApprovedByAnnotation records who approved the experiment for ChaosPolicy severity rules
+	// The mutating webhook replaces any value written to it with the requesting user
+	ApprovedByAnnotation = "chaos.gushchin.dev/approved-by"
+
+	// ChangeTicketAnnotation references the change request (e.g. a ServiceNow CHG number or Jira
+	// issue key) the experiment runs under. With a change-management system configured, injections
+	// wait until the ticket is approved and its change window is open.
+	ChangeTicketAnnotation = "chaos.gushchin.dev/change-ticket"
+
+	// NextRunsAnnotation previews the next three runs of a scheduled experiment, including its
+	// scheduleJitter offset, as computed by the mutating webhook when the schedule was admitted
+	NextRunsAnnotation = "chaos.gushchin.dev/next-runs"
+
+	// ExperimentLabel, ExperimentUIDLabel and ActionLabel are stamped on everything an experiment
+	// creates or touches: helper pods, history records, pods it injected ephemeral containers into
+	// and nodes it cordoned. Select on ExperimentUIDLabel to tell runs of recreated experiments apart
+	ExperimentLabel    = "chaos.gushchin.dev/experiment"
+	ExperimentUIDLabel = "chaos.gushchin.dev/experiment-uid"
+	ActionLabel        = "chaos.gushchin.dev/action"
+
+	// ConditionCleanupPending is set on a deleted experiment while reverts handed to its
+	// ChaosCleanupTasks are still being retried; `delete --wait` reports it
+	ConditionCleanupPending = "CleanupPending"
+
+	// NodeRoleControlPlaneLabel and NodeRoleMasterLabel mark control-plane nodes
+	NodeRoleControlPlaneLabel = "node-role.kubernetes.io/control-plane"
+	NodeRoleMasterLabel       = "node-role.kubernetes.io/master"
+
+	// EphemeralContainersAnnotation lists the ephemeral containers (comma-separated) chaos injected into a pod
+	EphemeralContainersAnnotation = "chaos.gushchin.dev/ephemeral-containers"
+
+	// CordonedByAnnotation names the experiment (namespace/name) that cordoned a node
+	// It is removed together with the experiment labels when the node is uncordoned
+	CordonedByAnnotation = "chaos.gushchin.dev/cordoned-by"
+
+	// AutoscalerTaintAnnotation holds the key of the autoscaler taint node-drain put on a node in place
+	// of cordoning it (spec.nodeReplacement.autoscaler); the taint goes when the node is uncordoned
+	AutoscalerTaintAnnotation = "chaos.gushchin.dev/autoscaler-taint"
+)
+
+// Experiment severities, from least to most disruptive
+const (
+	SeverityLow    = "low"
+	SeverityMedium = "medium"
+	SeverityHigh   = "high"
 )
 
 // ChaosExperimentSpec defines the desired state of ChaosExperiment
@@ -46,7 +118,7 @@ type ChaosExperimentSpec struct {
 
 	// Action specifies the chaos action to perform
 	// +kubebuilder:validation:Required
-	// +kubebuilder:validation:Enum=pod-kill;pod-delay;node-drain;node-taint;node-cpu-stress;node-disk-fill;pod-cpu-stress;pod-memory-stress;pod-failure;pod-network-loss;pod-network-corruption;pod-disk-fill;pod-restart;network-partition
+	// +kubebuilder:validation:Enum=pod-kill;pod-delay;node-drain;node-taint;node-cpu-stress;node-disk-fill;pod-cpu-stress;pod-memory-stress;pod-failure;pod-network-loss;pod-network-corruption;pod-disk-fill;pod-restart;network-partition;lease-steal;scheduler-pressure;quota-squeeze;admission-delay
 	Action string `json:"action"`
 
 	// Namespace specifies the target namespace for chaos experiments
@@ -59,6 +131,26 @@ type ChaosExperimentSpec struct {
 	// +kubebuilder:validation:MinProperties=1
 	Selector map[string]string `json:"selector"`
 
+	// ExcludeOwners skips pods whose owning workload's name matches one of these glob patterns
+	// (e.g., "kafka-*", "postgres"); pods of a Deployment are owned by the Deployment
+	// +optional
+	ExcludeOwners []string `json:"excludeOwners,omitempty"`
+
+	// IncludeOwners limits the targets to pods whose owning workload's name matches one of these
+	// glob patterns; pods without an owner are skipped. excludeOwners still applies
+	// +optional
+	IncludeOwners []string `json:"includeOwners,omitempty"`
+
+	// NodeSelector limits pod actions to pods running on nodes with these labels, e.g. a spot
+	// node pool. Node actions select nodes with selector instead
+	// +optional
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+
+	// NodeAffinity limits pod actions to pods running on nodes matching one of its terms, like the
+	// requiredDuringSchedulingIgnoredDuringExecution node affinity of a pod
+	// +optional
+	NodeAffinity *corev1.NodeSelector `json:"nodeAffinity,omitempty"`
+
 	// Count specifies the number of resources to affect
 	// +kubebuilder:validation:Minimum=1
 	// +kubebuilder:validation:Maximum=100
@@ -66,17 +158,38 @@ type ChaosExperimentSpec struct {
 	// +optional
 	Count int `json:"count,omitempty"`
 
-	// Duration specifies how long the chaos action should last (for pod-delay)
-	// +kubebuilder:validation:Pattern="^([0-9]+(s|m|h))+$"
+	// Duration specifies how long the chaos action should last; for pod-delay it is the added
+	// latency and may also be given in milliseconds (e.g., "250ms")
+	// +kubebuilder:validation:Pattern="^([0-9]+(ms|s|m|h))+$"
 	// +optional
 	Duration string `json:"duration,omitempty"`
 
-	// ExperimentDuration specifies how long the entire experiment should run before auto-stopping
-	// If not set, the experiment runs indefinitely until manually stopped
+	// Interval is the time between the starts of successive injection rounds while the experiment
+	// runs. Must be at least duration when both are set, so that rounds do not overlap.
+	// Default: "1m"
 	// +kubebuilder:validation:Pattern="^([0-9]+(s|m|h))+$"
 	// +optional
+	Interval string `json:"interval,omitempty"`
+
+	// NetAdminFallback applies the delay from an ephemeral helper container with NET_ADMIN and tc
+	// when the target container lacks either (for pod-delay). Without it such targets fail with a
+	// message naming what is missing. Pod Security admission must allow NET_ADMIN in the namespace.
+	// +optional
+	NetAdminFallback bool `json:"netAdminFallback,omitempty"`
+
+	// ExperimentDuration specifies how long the entire experiment should run before auto-stopping
+	// (e.g., "2h", "3d"). If not set, the experiment runs indefinitely until manually stopped
+	// +kubebuilder:validation:Pattern="^([0-9]+(s|m|h|d))+$"
+	// +optional
 	ExperimentDuration string `json:"experimentDuration,omitempty"`
 
+	// TTLSecondsAfterFinished deletes the experiment this many seconds after it reaches the
+	// Completed or Failed phase, like the Job field of the same name. If not set, finished
+	// experiments are kept until deleted manually. History records are not affected.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	TTLSecondsAfterFinished *int32 `json:"ttlSecondsAfterFinished,omitempty"`
+
 	// MaxRetries specifies the maximum number of retry attempts for failed experiments
 	// +kubebuilder:validation:Minimum=0
 	// +kubebuilder:validation:Maximum=10
@@ -109,6 +222,13 @@ type ChaosExperimentSpec struct {
 	// +optional
 	CPUWorkers int `json:"cpuWorkers,omitempty"`
 
+	// RelativeLoad stresses a share of the target pod's CPU limit, such as "50%" for half of it,
+	// whatever the size of the node (for pod-cpu-stress). It replaces cpuLoad and cpuWorkers, which
+	// are derived from the limit; pods without a CPU limit are skipped.
+	// +kubebuilder:validation:Pattern=`^([1-9][0-9]?|100)%$`
+	// +optional
+	RelativeLoad string `json:"relativeLoad,omitempty"`
+
 	// MemorySize specifies the amount of memory to consume per worker (for pod-memory-stress)
 	// Format: number followed by M (megabytes) or G (gigabytes)
 	// Examples: "256M", "512M", "1G", "2G"
@@ -124,6 +244,21 @@ type ChaosExperimentSpec struct {
 	// +optional
 	MemoryWorkers int `json:"memoryWorkers,omitempty"`
 
+	// MemoryOvercommitPolicy decides what pod-memory-stress does when memorySize * memoryWorkers does
+	// not fit under the target pod's memory limit or the memory left allocatable on its node.
+	// Refuse (the default) skips the pod; Clamp shrinks memorySize to fit and emits a warning.
+	// +kubebuilder:validation:Enum=Refuse;Clamp
+	// +optional
+	MemoryOvercommitPolicy string `json:"memoryOvercommitPolicy,omitempty"`
+
+	// AutoscalerPolicy controls how HorizontalPodAutoscalers of the targets are handled during
+	// pod-cpu-stress and pod-memory-stress. Observe records their replica counts in status.autoscalers;
+	// HoldScaleDown additionally disables scale-down until the experiment completes, so scale-up
+	// reactions to the stress can be measured without the autoscaler undoing them.
+	// +kubebuilder:validation:Enum=Observe;HoldScaleDown
+	// +optional
+	AutoscalerPolicy string `json:"autoscalerPolicy,omitempty"`
+
 	// LossPercentage specifies the packet loss percentage (for pod-network-loss)
 	// Range: 1-40. Percentage of packets to drop.
 	// +kubebuilder:validation:Minimum=1
@@ -175,6 +310,20 @@ type ChaosExperimentSpec struct {
 	// +optional
 	VolumeName string `json:"volumeName,omitempty"`
 
+	// ReserveBytes is free space pod-disk-fill always leaves on the filesystem, as a quantity such
+	// as "500Mi". The fill stops short of fillPercentage rather than cross it, and shrinks when the
+	// workload's own writes do.
+	// +kubebuilder:validation:Pattern=`^[0-9]+(Ki|Mi|Gi|Ti|k|M|G|T)?$`
+	// +optional
+	ReserveBytes string `json:"reserveBytes,omitempty"`
+
+	// ReservePercentage is free space pod-disk-fill always leaves, as a percentage of the filesystem
+	// Range: 0-50
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=50
+	// +optional
+	ReservePercentage int `json:"reservePercentage,omitempty"`
+
 	// Direction specifies the direction of network traffic to block (for network-partition)
 	// +kubebuilder:validation:Enum=both;ingress;egress
 	// +kubebuilder:default=both
@@ -183,7 +332,8 @@ type ChaosExperimentSpec struct {
 
 	// TargetIPs specifies exact IP addresses to block (for network-partition)
 	// If empty, blocks all traffic (full partition - current behavior)
-	// Examples: ["10.96.0.50", "192.168.1.100"]
+	// Examples: ["10.96.0.50", "192.168.1.100", "fd00::50"]
+	// IPv6 addresses are blocked with ip6tables in pods that have an IPv6 address
 	// Can be combined with targetCIDRs, targetPorts, and targetProtocols
 	// Applied based on direction field (ingress, egress, or both)
 	// +optional
@@ -191,8 +341,8 @@ type ChaosExperimentSpec struct {
 
 	// TargetCIDRs specifies IP ranges to block using CIDR notation (for network-partition)
 	// If empty along with targetIPs, blocks all traffic (full partition)
-	// Examples: ["10.96.0.0/12", "192.168.0.0/16"]
-	// Format: x.x.x.x/y where each x is 0-255 and y is 0-32
+	// Examples: ["10.96.0.0/12", "192.168.0.0/16", "fd00::/64"]
+	// Format: x.x.x.x/y where each x is 0-255 and y is 0-32, or an IPv6 prefix
 	// Can be combined with targetIPs, targetPorts, and targetProtocols
 	// Applied based on direction field (ingress, egress, or both)
 	// +optional
@@ -217,6 +367,32 @@ type ChaosExperimentSpec struct {
 	// +optional
 	TargetProtocols []string `json:"targetProtocols,omitempty"`
 
+	// ExternalTargets lists destinations outside the cluster to cut off (for network-partition):
+	// IP addresses, CIDRs or DNS names. DNS names are re-resolved every 30 seconds while the
+	// partition lasts, so services behind changing addresses stay blocked. Unlike an empty target
+	// list, which isolates the pod, only these destinations are blocked and in-cluster traffic is kept.
+	// Examples: ["db.example.com", "203.0.113.0/24"]
+	// +optional
+	ExternalTargets []string `json:"externalTargets,omitempty"`
+
+	// PeerSelector selects a second group of pods for network-partition. When set, only traffic
+	// between the target pods and the peer pods is blocked instead of isolating the targets from
+	// everything. Peer pod IPs are resolved when the partition is injected.
+	// +optional
+	PeerSelector map[string]string `json:"peerSelector,omitempty"`
+
+	// PeerNamespaces lists the namespaces searched for peerSelector pods
+	// Default: the experiment's target namespace
+	// +optional
+	PeerNamespaces []string `json:"peerNamespaces,omitempty"`
+
+	// NetworkMeasurement injects a measurement container next to the fault that pings a target
+	// from inside each affected pod while the fault lasts, and reports the observed RTT and loss in
+	// status.networkMeasurements and history (for pod-network-loss, pod-network-corruption and
+	// network-partition)
+	// +optional
+	NetworkMeasurement *NetworkMeasurement `json:"networkMeasurement,omitempty"`
+
 	// DryRun mode previews affected resources without executing chaos
 	// When enabled, the controller lists resources that would be affected and updates status without performing actions
 	// +kubebuilder:default=false
@@ -236,12 +412,114 @@ type ChaosExperimentSpec struct {
 	// +optional
 	MaxPercentage int `json:"maxPercentage,omitempty"`
 
+	// MaxNodes caps how many nodes a node action may affect per run; experiments whose count
+	// exceeds it fail validation. Only supported for node actions
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MaxNodes int `json:"maxNodes,omitempty"`
+
+	// SelectionSeed makes target selection deterministic
+	// When set, eligible pods are ordered by name and shuffled with this seed instead of a random source,
+	// so the same set of candidates always yields the same victims
+	// +optional
+	SelectionSeed *int64 `json:"selectionSeed,omitempty"`
+
+	// StickyTargets keeps affecting the same pods on repeated runs
+	// The first run records its victims in status.selectedTargets; later runs prefer those pods
+	// for as long as they remain eligible and only pick replacements for the ones that disappeared
+	// +kubebuilder:default=false
+	// +optional
+	StickyTargets bool `json:"stickyTargets,omitempty"`
+
+	// SelectionStrategy controls which eligible pods are picked as targets
+	// random: any pod (default); oldest/newest: by creation time;
+	// highest-cpu/highest-memory: busiest pods according to metrics-server;
+	// one-per-node/one-per-zone: at most one pod per node or topology zone
+	// +kubebuilder:validation:Enum=random;oldest;newest;highest-cpu;highest-memory;one-per-node;one-per-zone
+	// +kubebuilder:default=random
+	// +optional
+	SelectionStrategy string `json:"selectionStrategy,omitempty"`
+
+	// SpreadBy groups eligible pods into failure domains before targets are taken: zone (topology
+	// zone of the pod's node, falling back to the node), node, or owner (the owning workload)
+	// +kubebuilder:validation:Enum=zone;node;owner
+	// +optional
+	SpreadBy string `json:"spreadBy,omitempty"`
+
+	// SpreadMode decides how targets use the spreadBy domains
+	// spread: take one pod per domain before taking a second from any (default);
+	// concentrate: take targets from a single domain only, e.g. all replicas in one zone
+	// +kubebuilder:validation:Enum=spread;concentrate
+	// +optional
+	SpreadMode string `json:"spreadMode,omitempty"`
+
+	// Severity classifies how disruptive the experiment is: low, medium or high
+	// ChaosPolicy severity rules may deny higher severities or require approvals and time windows for them
+	// +kubebuilder:validation:Enum=low;medium;high
+	// +kubebuilder:default=medium
+	// +optional
+	Severity string `json:"severity,omitempty"`
+
 	// AllowProduction explicitly allows experiments in production namespaces
 	// Production namespaces are identified by annotations or labels (environment=production, env=prod)
 	// +kubebuilder:default=false
 	// +optional
 	AllowProduction bool `json:"allowProduction,omitempty"`
 
+	// AllowSingletonDisruption allows targeting pods that are the only ready replica of their owner
+	// or that currently hold a leader-election lease. Such pods are skipped by default.
+	// +kubebuilder:default=false
+	// +optional
+	AllowSingletonDisruption bool `json:"allowSingletonDisruption,omitempty"`
+
+	// IgnoreRollouts allows targeting pods whose Deployment or StatefulSet is in the middle of a
+	// rollout. By default such pods are skipped until the rollout settles.
+	// +kubebuilder:default=false
+	// +optional
+	IgnoreRollouts bool `json:"ignoreRollouts,omitempty"`
+
+	// IncludeJobPods lets pod actions target pods owned by a Job, including the Jobs of a CronJob.
+	// Such pods are skipped by default so batch runs are not broken by accident. When included,
+	// history records report how the affected Jobs fared.
+	// +kubebuilder:default=false
+	// +optional
+	IncludeJobPods bool `json:"includeJobPods,omitempty"`
+
+	// IgnoreEvictionAnnotations makes pod-kill and node-drain disregard the operational annotations
+	// other controllers use to protect pods. By default pods annotated
+	// cluster-autoscaler.kubernetes.io/safe-to-evict: "false" are not killed, nodes hosting such a pod
+	// are not drained, and victims with a lower controller.kubernetes.io/pod-deletion-cost go first.
+	// +kubebuilder:default=false
+	// +optional
+	IgnoreEvictionAnnotations bool `json:"ignoreEvictionAnnotations,omitempty"`
+
+	// AllowControlPlane allows node-drain to target control-plane nodes
+	// Nodes labeled node-role.kubernetes.io/control-plane (or master) are skipped by default
+	// +kubebuilder:default=false
+	// +optional
+	AllowControlPlane bool `json:"allowControlPlane,omitempty"`
+
+	// MaxUnavailableNodes caps how many nodes may be unavailable (cordoned or NotReady) cluster-wide
+	// while the experiment drains nodes. Nodes that would exceed the budget are skipped (node-drain only)
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MaxUnavailableNodes int `json:"maxUnavailableNodes,omitempty"`
+
+	// DrainTimeout bounds how long node-drain waits for a node's pods to be evicted (e.g., "5m").
+	// When set, pods are evicted through the Eviction API, so PodDisruptionBudgets are honored and
+	// blocked evictions are retried. A node that still hosts evictable pods when the timeout expires
+	// is uncordoned again, the round is recorded as partial, and the remaining pods are listed in
+	// status.unevictedPods. If not set, pods are deleted without waiting (node-drain only)
+	// +kubebuilder:validation:Pattern="^([0-9]+(s|m|h))+$"
+	// +optional
+	DrainTimeout string `json:"drainTimeout,omitempty"`
+
+	// NodeReplacement makes node-drain work with the cluster's node autoscaler: drained nodes can be
+	// cordoned with the autoscaler's own taint, and the controller waits for a new node to replace
+	// each drained one, reporting the time it took in status.nodeReplacements (node-drain only)
+	// +optional
+	NodeReplacement *NodeReplacement `json:"nodeReplacement,omitempty"`
+
 	// Schedule defines a cron schedule for automatic experiment execution
 	// When set, the experiment will run automatically according to this schedule
 	// Format follows standard cron syntax: "minute hour day-of-month month day-of-week"
@@ -251,6 +529,26 @@ type ChaosExperimentSpec struct {
 	// +optional
 	Schedule string `json:"schedule,omitempty"`
 
+	// Schedules are further cron schedules in the same format. The experiment runs whenever
+	// Schedule or any of them fires, e.g. "0 10 * * 1-5" and "0 14 * * 6" for weekday mornings and
+	// Saturday afternoons
+	// +kubebuilder:validation:MaxItems=10
+	// +optional
+	Schedules []string `json:"schedules,omitempty"`
+
+	// ExcludeSchedules skip the scheduled runs that fall into windows opened by other cron
+	// schedules, such as a weekly deploy window. Requires schedule or schedules
+	// +kubebuilder:validation:MaxItems=10
+	// +optional
+	ExcludeSchedules []ScheduleExclusion `json:"excludeSchedules,omitempty"`
+
+	// ScheduleJitter delays each scheduled run by a fixed offset within this window (e.g., "10m"),
+	// so that experiments sharing a schedule do not all start at once. The offset is derived from
+	// the experiment's namespace and name. Should be shorter than the time between runs.
+	// +kubebuilder:validation:Pattern="^([0-9]+(s|m|h))+$"
+	// +optional
+	ScheduleJitter string `json:"scheduleJitter,omitempty"`
+
 	// DependsOn specifies a list of experiment names in the same namespace that must reach "Completed" phase before this experiment can start executing.
 	// +optional
 	DependsOn []string `json:"dependsOn,omitempty"`
@@ -267,11 +565,24 @@ type ChaosExperimentSpec struct {
 
 	// RestartInterval specifies delay between restarting each pod (pod-restart only)
 	// Format: "30s", "1m", "2m30s"
-	// Default: "" (restart all immediately)
+	// Default: "" (restart the next pod as soon as the previous one is Ready)
 	// +kubebuilder:validation:Pattern="^([0-9]+(s|m|h))+$"
 	// +optional
 	RestartInterval string `json:"restartInterval,omitempty"`
 
+	// FailureSignal is the signal sent to PID 1 of the target container (pod-failure only)
+	// Default: "KILL"
+	// +kubebuilder:validation:Enum=KILL;TERM;INT;QUIT;ABRT;SEGV
+	// +optional
+	FailureSignal string `json:"failureSignal,omitempty"`
+
+	// FailureInterval is how often the container is failed again once it is running, while
+	// duration lasts (pod-failure only). Without duration the container is failed once per run.
+	// Default: "10s"
+	// +kubebuilder:validation:Pattern="^([0-9]+(s|m|h))+$"
+	// +optional
+	FailureInterval string `json:"failureInterval,omitempty"`
+
 	// TaintKey specifies the key of the taint to apply to nodes (for node-taint)
 	// +optional
 	TaintKey string `json:"taintKey,omitempty"`
@@ -285,6 +596,472 @@ type ChaosExperimentSpec struct {
 	// +kubebuilder:default=NoSchedule
 	// +optional
 	TaintEffect string `json:"taintEffect,omitempty"`
+
+	// LeaseName is the coordination.k8s.io Lease in spec.namespace that lease-steal takes from the
+	// current leader. spec.selector matches the candidate pods that compete for it.
+	// +kubebuilder:validation:MaxLength=253
+	// +optional
+	LeaseName string `json:"leaseName,omitempty"`
+
+	// LeaseMode is how lease-steal takes the lease (lease-steal only):
+	// - "Acquire": the controller becomes the holder for spec.duration, so the leader fails to
+	//   renew and steps down; candidates take over once the lease expires or is released
+	// - "Delete": the lease is deleted and the candidates race to create it again; the old leader
+	//   may win the race
+	// Default: "Acquire"
+	// +kubebuilder:validation:Enum=Acquire;Delete
+	// +optional
+	LeaseMode string `json:"leaseMode,omitempty"`
+
+	// Balloons configures the placeholder pods scheduler-pressure creates in spec.namespace for
+	// spec.duration (scheduler-pressure only). spec.selector matches the workload pods whose
+	// priority is checked against the balloons' and whose preemption is recorded; spec.nodeSelector
+	// and spec.nodeAffinity pin the balloons to those nodes.
+	// +optional
+	Balloons *Balloons `json:"balloons,omitempty"`
+
+	// QuotaSqueeze selects the ResourceQuotas in spec.namespace that quota-squeeze shrinks for
+	// spec.duration, and by how much (quota-squeeze only). Without it every ResourceQuota in the
+	// namespace is shrunk to its current usage.
+	// +optional
+	QuotaSqueeze *QuotaSqueeze `json:"quotaSqueeze,omitempty"`
+
+	// AdmissionDelay configures the no-op webhook admission-delay registers for spec.duration to slow
+	// down API requests for the given resources in spec.namespace (admission-delay only). Only objects
+	// matching spec.selector are delayed; the webhook fails open and is removed when the run ends.
+	// +optional
+	AdmissionDelay *AdmissionDelay `json:"admissionDelay,omitempty"`
+
+	// MetricsQueries are PromQL queries sampled before, during and after the experiment and stored
+	// in status.metrics and in each history record, for before/after comparisons of latency or
+	// error rates. Each query must evaluate to a single value. Requires the controller's --prometheus-url.
+	// +kubebuilder:validation:MaxItems=10
+	// +optional
+	MetricsQueries []MetricsQuery `json:"metricsQueries,omitempty"`
+
+	// SuccessCriteria are CEL expressions judged once the experiment completes, after the same
+	// settling time as the "after" value of metricsQueries. The verdict in status and history is
+	// Passed when all of them hold. Requires experimentDuration.
+	// +kubebuilder:validation:MaxItems=10
+	// +optional
+	SuccessCriteria []SuccessCriterion `json:"successCriteria,omitempty"`
+}
+
+// MetricsQuery is a named PromQL query sampled around an experiment
+type MetricsQuery struct {
+	// Name identifies the query in results (e.g., "p99-latency")
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=63
+	Name string `json:"name"`
+
+	// Query is the PromQL expression, evaluated as an instant query
+	// +kubebuilder:validation:MinLength=1
+	Query string `json:"query"`
+}
+
+// SuccessCriterion is a named condition a completed experiment must meet to pass
+type SuccessCriterion struct {
+	// Name identifies the criterion in the verdict (e.g., "all-recovered")
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=63
+	Name string `json:"name"`
+
+	// Expression is a CEL expression evaluating to a bool. It can read metrics (the samples of
+	// metricsQueries by name, e.g. metrics["p99"].after), affected, recovered, unrecovered,
+	// recoverySeconds, failedTargets and unverifiedTargets.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=1024
+	Expression string `json:"expression"`
+}
+
+// Verdicts of successCriteria
+const (
+	// VerdictPassed experiments met all their success criteria
+	VerdictPassed = "Passed"
+	// VerdictFailed experiments missed at least one criterion, or were aborted
+	VerdictFailed = "Failed"
+)
+
+// Verdict is the judgement of the successCriteria of a completed experiment
+type Verdict struct {
+	// Result is Passed when every criterion held, Failed otherwise
+	// +kubebuilder:validation:Enum=Passed;Failed
+	Result string `json:"result"`
+
+	// EvaluatedAt is when the criteria were judged
+	EvaluatedAt metav1.Time `json:"evaluatedAt"`
+
+	// Message summarizes the verdict
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// Criteria holds the outcome of each criterion
+	// +optional
+	Criteria []CriterionResult `json:"criteria,omitempty"`
+
+	// Observed holds the values the criteria were judged with, other than metrics
+	// (e.g., "recovered": "2")
+	// +optional
+	Observed map[string]string `json:"observed,omitempty"`
+}
+
+// CriterionResult is the outcome of one success criterion
+type CriterionResult struct {
+	// Name of the criterion
+	Name string `json:"name"`
+
+	// Passed is set when the expression held
+	Passed bool `json:"passed"`
+
+	// Message explains a failed criterion, such as an evaluation error
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// RecoveryStatus counts the targets an experiment with successCriteria affected and how the pods
+// it injected recovered. Targets neither recovered, timed out nor gone are still pending.
+type RecoveryStatus struct {
+	// Affected counts the targets the injection rounds affected: pods, or nodes for node actions.
+	// It is recorded at injection time and kept when the injections are reverted.
+	// +optional
+	Affected int32 `json:"affected,omitempty"`
+
+	// Tracked is the number of injected targets whose recovery is measured
+	Tracked int32 `json:"tracked"`
+
+	// Recovered counts the targets that recovered
+	// +optional
+	Recovered int32 `json:"recovered,omitempty"`
+
+	// TimedOut counts the targets that did not recover within 30 minutes
+	// +optional
+	TimedOut int32 `json:"timedOut,omitempty"`
+
+	// Gone counts the targets deleted or replaced before their recovery could be measured
+	// +optional
+	Gone int32 `json:"gone,omitempty"`
+
+	// Slowest is the longest recovery of a recovered target, such as "20s"
+	// +optional
+	Slowest string `json:"slowest,omitempty"`
+}
+
+// Pending is the number of targets whose recovery is still being measured
+func (s *RecoveryStatus) Pending() int32 {
+	if s == nil {
+		return 0
+	}
+	return max(s.Tracked-s.Recovered-s.TimedOut-s.Gone, 0)
+}
+
+// RollingRestartStatus is the progress of a pod-restart run. The controller restarts one pod at a
+// time and checks on it in later reconciles instead of waiting for it.
+type RollingRestartStatus struct {
+	// StartedAt is when the run began
+	StartedAt metav1.Time `json:"startedAt"`
+
+	// Pending are the targets left to restart, as "namespace/podName", in restart order
+	// +optional
+	Pending []string `json:"pending,omitempty"`
+
+	// Pod is the target restarted last, as "namespace/podName", until it is Ready again
+	// +optional
+	Pod string `json:"pod,omitempty"`
+
+	// Container is the restarted container of Pod
+	// +optional
+	Container string `json:"container,omitempty"`
+
+	// RestartCount is the restart count of Container before it was restarted
+	// +optional
+	RestartCount int32 `json:"restartCount,omitempty"`
+
+	// InjectedAt is when Container was sent SIGTERM; the timing of the restart is relative to it
+	// +optional
+	InjectedAt *metav1.Time `json:"injectedAt,omitempty"`
+
+	// Observed is set once Container was seen restarting
+	// +optional
+	Observed bool `json:"observed,omitempty"`
+
+	// NextRestartAt is when the next target is restarted, restartInterval after the previous one
+	// +optional
+	NextRestartAt *metav1.Time `json:"nextRestartAt,omitempty"`
+
+	// Restarted are the targets restarted so far, with their timing in details
+	// +optional
+	Restarted []ResourceReference `json:"restarted,omitempty"`
+}
+
+// MetricSample holds the values of a metrics query at the points of an experiment. Values are
+// formatted decimal numbers; a point that could not be sampled is left empty and Error is set.
+type MetricSample struct {
+	// Name of the query
+	Name string `json:"name"`
+
+	// Before is the value when the experiment (or run) started
+	// +optional
+	Before string `json:"before,omitempty"`
+
+	// During is the value while chaos was active
+	// +optional
+	During string `json:"during,omitempty"`
+
+	// After is the value once the experiment completed and the targets had time to settle
+	// +optional
+	After string `json:"after,omitempty"`
+
+	// Error is the last error encountered while sampling the query
+	// +optional
+	Error string `json:"error,omitempty"`
+}
+
+// TargetResult is the state of the ephemeral container injected into one target pod
+type TargetResult struct {
+	// Pod is the target, as "namespace/podName"
+	Pod string `json:"pod"`
+
+	// Container is the last ephemeral container injected into the pod
+	Container string `json:"container"`
+
+	// StartedAt is when the container started running
+	// +optional
+	StartedAt *metav1.Time `json:"startedAt,omitempty"`
+
+	// State is Pending until the container runs, then Running; Succeeded or Failed once it exited.
+	// A container whose image cannot be pulled is Failed.
+	// +kubebuilder:validation:Enum=Pending;Running;Succeeded;Failed
+	State string `json:"state"`
+
+	// ExitCode of the container once it exited
+	// +optional
+	ExitCode *int32 `json:"exitCode,omitempty"`
+
+	// Reason of the container's waiting or terminated state, such as ErrImagePull or Error
+	// +optional
+	Reason string `json:"reason,omitempty"`
+
+	// Message explains a failure: the container's termination message or the tail of its log
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// Restarts counts the injections retried after the container failed
+	// +optional
+	Restarts int32 `json:"restarts,omitempty"`
+
+	// Verification is Verified when a probe run in the running container saw the fault in place,
+	// such as the netem qdisc or the stressor's CPU usage, and Unverified when it did not. Empty
+	// when the action has no probe or the container has not run yet.
+	// +kubebuilder:validation:Enum=Verified;Unverified
+	// +optional
+	Verification string `json:"verification,omitempty"`
+
+	// VerificationMessage explains why an injection is Unverified, from the probe's output
+	// +optional
+	VerificationMessage string `json:"verificationMessage,omitempty"`
+}
+
+// NetworkMeasurement configures the measurement container of a network experiment
+type NetworkMeasurement struct {
+	// Target is the host name or IP address pinged from inside the affected pods, such as a peer
+	// Service or the node's gateway
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	// +kubebuilder:validation:Pattern=`^[A-Za-z0-9.:-]+$`
+	Target string `json:"target"`
+
+	// WindowSeconds is how many pings, one per second, make up one sample
+	// +kubebuilder:validation:Minimum=2
+	// +kubebuilder:validation:Maximum=300
+	// +kubebuilder:default=10
+	// +optional
+	WindowSeconds int32 `json:"windowSeconds,omitempty"`
+}
+
+// NetworkMeasurementResult is what one measurement container observed from its pod
+type NetworkMeasurementResult struct {
+	// Pod is the affected pod, as "namespace/podName"
+	Pod string `json:"pod"`
+
+	// Container is the measurement container
+	Container string `json:"container"`
+
+	// Target is the pinged host
+	Target string `json:"target"`
+
+	// Samples counts the completed ping windows
+	// +optional
+	Samples int32 `json:"samples,omitempty"`
+
+	// PacketsSent and PacketsReceived count the pings across all samples
+	// +optional
+	PacketsSent int32 `json:"packetsSent,omitempty"`
+	// +optional
+	PacketsReceived int32 `json:"packetsReceived,omitempty"`
+
+	// LossPercentage is the share of pings without a reply
+	// +optional
+	LossPercentage int32 `json:"lossPercentage,omitempty"`
+
+	// AvgRTT is the mean round-trip time of the replies, such as "12.4ms"
+	// +optional
+	AvgRTT string `json:"avgRTT,omitempty"`
+
+	// MaxRTT is the slowest reply
+	// +optional
+	MaxRTT string `json:"maxRTT,omitempty"`
+
+	// Complete is set once the container exited and its whole output was read
+	// +optional
+	Complete bool `json:"complete,omitempty"`
+}
+
+// Balloons sizes the balloon pods of scheduler-pressure. Balloons run a pause container and
+// only hold the resources they request.
+type Balloons struct {
+	// PriorityClassName is the PriorityClass of the balloon pods. A class above the priority of the
+	// workload pods makes the scheduler preempt them to fit the balloons; one below leaves the
+	// balloons Pending wherever capacity is short, churning the scheduling queue.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	PriorityClassName string `json:"priorityClassName"`
+
+	// Replicas is the number of balloon pods
+	// +kubebuilder:default=1
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	Replicas int `json:"replicas,omitempty"`
+
+	// CPU requested by each balloon pod (e.g., "500m")
+	// +kubebuilder:default="500m"
+	// +optional
+	CPU string `json:"cpu,omitempty"`
+
+	// Memory requested by each balloon pod (e.g., "256Mi")
+	// +kubebuilder:default="256Mi"
+	// +optional
+	Memory string `json:"memory,omitempty"`
+}
+
+// QuotaSqueeze configures how quota-squeeze shrinks ResourceQuotas. Each hard limit is lowered
+// towards the quota's current usage, never below it, so running pods are unaffected and only new
+// pods and objects are refused.
+type QuotaSqueeze struct {
+	// Name is the ResourceQuota to shrink; every ResourceQuota in spec.namespace when empty
+	// +kubebuilder:validation:MaxLength=253
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// Resources limits the squeeze to these hard limits of the quota (e.g., "pods",
+	// "requests.cpu"); all of them when empty
+	// +optional
+	Resources []string `json:"resources,omitempty"`
+
+	// HeadroomPercent is how much of each limit's free room is left: 0 sets the limit to the
+	// current usage, 50 halves the room between usage and the original limit
+	// +kubebuilder:default=0
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=99
+	// +optional
+	HeadroomPercent int `json:"headroomPercent,omitempty"`
+}
+
+// ScheduleExclusion is a window in which scheduled runs are skipped
+type ScheduleExclusion struct {
+	// Schedule is a cron schedule opening the window, e.g. "0 14 * * 3" for Wednesdays at 14:00
+	// +kubebuilder:validation:MinLength=1
+	Schedule string `json:"schedule"`
+
+	// Duration is how long each window lasts (e.g., "2h"). If not set, only runs due at the
+	// same time as Schedule are skipped
+	// +kubebuilder:validation:Pattern="^([0-9]+(s|m|h|d))+$"
+	// +optional
+	Duration string `json:"duration,omitempty"`
+}
+
+// AdmissionDelay configures the slow webhook of admission-delay. The webhook admits every request
+// it sees, after holding it for Delay.
+type AdmissionDelay struct {
+	// Delay is how long each matching request is held (e.g., "5s"), at most 25s
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=`^([0-9]+(ms|s))+$`
+	Delay string `json:"delay"`
+
+	// Resources are the resources whose requests are delayed, as "resource" for the core group or
+	// "resource.group" (e.g., "configmaps", "deployments.apps"). Subresources, leases, events and
+	// chaos.gushchin.dev resources are refused.
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=20
+	Resources []string `json:"resources"`
+
+	// Operations are the request operations delayed
+	// +kubebuilder:default={"CREATE","UPDATE"}
+	// +optional
+	Operations []AdmissionOperation `json:"operations,omitempty"`
+}
+
+// AdmissionOperation is an API request operation admission-delay can slow down
+// +kubebuilder:validation:Enum=CREATE;UPDATE;DELETE
+type AdmissionOperation string
+
+// MaxAdmissionDelay caps spec.admissionDelay.delay below the 30s limit of an admission webhook
+// timeout, so the API server always gets the answer
+const MaxAdmissionDelay = 25 * time.Second
+
+// MaxAdmissionDelayDuration caps spec.duration of admission-delay
+const MaxAdmissionDelayDuration = time.Hour
+
+// NodeReplacement configures how node-drain cooperates with a node autoscaler
+type NodeReplacement struct {
+	// Autoscaler cordons drained nodes with the taint this autoscaler puts on nodes it is about to
+	// remove instead of marking them unschedulable: ToBeDeletedByClusterAutoscaler for
+	// cluster-autoscaler, karpenter.sh/disrupted for karpenter. If not set, nodes are cordoned as usual.
+	// +kubebuilder:validation:Enum=cluster-autoscaler;karpenter
+	// +optional
+	Autoscaler string `json:"autoscaler,omitempty"`
+
+	// Timeout is how long to wait for a replacement node (e.g., "10m"). A replacement is a node that
+	// matches spec.selector, was created after the drain and became Ready.
+	// +kubebuilder:default="10m"
+	// +kubebuilder:validation:Pattern="^([0-9]+(s|m|h))+$"
+	// +optional
+	Timeout string `json:"timeout,omitempty"`
+}
+
+// NodeReplacementResult is the replacement observed for one drained node
+type NodeReplacementResult struct {
+	// Node is the drained node
+	Node string `json:"node"`
+
+	// DrainedAt is when the node was drained
+	DrainedAt metav1.Time `json:"drainedAt"`
+
+	// ReplacementNode is the new node that took its place, once found
+	// +optional
+	ReplacementNode string `json:"replacementNode,omitempty"`
+
+	// TimeToReplacement is the time from the drain until the replacement node was Ready (e.g., "2m14s")
+	// +optional
+	TimeToReplacement string `json:"timeToReplacement,omitempty"`
+
+	// TimedOut is set when no replacement was Ready within spec.nodeReplacement.timeout
+	// +optional
+	TimedOut bool `json:"timedOut,omitempty"`
+}
+
+// UnevictedPod is a pod that kept a node from draining within spec.drainTimeout
+type UnevictedPod struct {
+	// Node is the node the drain was rolled back on
+	Node string `json:"node"`
+
+	// Pod is the pod that was left behind, as "namespace/podName"
+	Pod string `json:"pod"`
+
+	// Reason is why the pod was not evicted, such as a PodDisruptionBudget blocking the eviction
+	// or the pod being stuck terminating
+	// +optional
+	Reason string `json:"reason,omitempty"`
 }
 
 // TimeWindowType defines the time window mode for experiments.
@@ -321,6 +1098,202 @@ type TimeWindow struct {
 	DaysOfWeek []string `json:"daysOfWeek,omitempty"`
 }
 
+// BlastRadius estimates the impact of an experiment run before chaos is injected.
+type BlastRadius struct {
+	// AffectedPods is the number of pods selected for this run
+	AffectedPods int `json:"affectedPods"`
+
+	// NodesTouched is the number of distinct nodes hosting the selected pods
+	NodesTouched int `json:"nodesTouched"`
+
+	// Workloads breaks the selection down by owning workload
+	// +optional
+	Workloads []WorkloadImpact `json:"workloads,omitempty"`
+
+	// CPUSharePercent is the share of the namespace's current CPU usage consumed by the selected pods.
+	// Only set when metrics-server is available.
+	// +optional
+	CPUSharePercent *int32 `json:"cpuSharePercent,omitempty"`
+
+	// MemorySharePercent is the share of the namespace's current memory usage consumed by the selected pods.
+	// Only set when metrics-server is available.
+	// +optional
+	MemorySharePercent *int32 `json:"memorySharePercent,omitempty"`
+
+	// Topology is a snapshot of what the selected pods serve, for rendering a blast-radius graph:
+	// the pods, their owning workloads, the Services selecting them and the Ingresses routing to those Services
+	// +optional
+	Topology *BlastRadiusTopology `json:"topology,omitempty"`
+}
+
+// BlastRadiusTopology is a graph of the resources reached by an experiment run.
+// All resources live in the experiment's target namespace.
+type BlastRadiusTopology struct {
+	// Nodes are the resources in the graph
+	Nodes []TopologyNode `json:"nodes"`
+
+	// Edges point from a resource to the one it backs: pod to workload, workload to Service, Service to Ingress
+	// +optional
+	Edges []TopologyEdge `json:"edges,omitempty"`
+
+	// Truncated is set when selected pods were left out of the graph to bound the status size
+	// +optional
+	Truncated bool `json:"truncated,omitempty"`
+}
+
+// TopologyNode is a resource in the blast-radius graph
+type TopologyNode struct {
+	// ID identifies the node within the graph as Kind/Name
+	ID string `json:"id"`
+
+	// Kind of the resource (Pod, Deployment, StatefulSet, Service, Ingress, ...)
+	Kind string `json:"kind"`
+
+	// Name of the resource
+	Name string `json:"name"`
+}
+
+// TopologyEdge connects two nodes of the blast-radius graph by ID
+type TopologyEdge struct {
+	// From is the ID of the backing resource
+	From string `json:"from"`
+
+	// To is the ID of the resource it backs
+	To string `json:"to"`
+}
+
+// WorkloadImpact describes how much of a single workload is affected.
+type WorkloadImpact struct {
+	// Kind of the owning workload (e.g. Deployment, StatefulSet). "Pod" for unowned pods.
+	Kind string `json:"kind"`
+
+	// Name of the owning workload
+	Name string `json:"name"`
+
+	// Affected is the number of the workload's pods selected for this run
+	Affected int `json:"affected"`
+
+	// Total is the number of pods the workload currently has
+	Total int `json:"total"`
+
+	// Percentage is Affected as a percentage of Total
+	Percentage int32 `json:"percentage"`
+}
+
+// AutoscalerActivity records the replica counts of a HorizontalPodAutoscaler scaling a target workload
+type AutoscalerActivity struct {
+	// Name of the HorizontalPodAutoscaler
+	Name string `json:"name"`
+
+	// Target is the scaled workload (e.g., "Deployment/web")
+	Target string `json:"target"`
+
+	// InitialReplicas is the replica count when the experiment first touched the workload
+	InitialReplicas int32 `json:"initialReplicas"`
+
+	// PeakReplicas is the highest replica count observed during the experiment
+	PeakReplicas int32 `json:"peakReplicas"`
+
+	// CurrentReplicas is the replica count at the last observation
+	CurrentReplicas int32 `json:"currentReplicas"`
+
+	// ScaleDownHeld is true while the controller has scale-down disabled on this autoscaler
+	// +optional
+	ScaleDownHeld bool `json:"scaleDownHeld,omitempty"`
+}
+
+// MaxAffectedPodRefs caps status.affectedPods, matching the largest count of a single run
+const MaxAffectedPodRefs = 100
+
+// MaxAffectedSummaryItems caps the per-pod entries of status.affectedSummary
+const MaxAffectedSummaryItems = 20
+
+// AffectedSummary is a bounded account of the pods an experiment injected containers into
+type AffectedSummary struct {
+	// Pods is the number of distinct pods injected into
+	// Once Truncated is set a pod whose entries were dropped may be counted again
+	Pods int `json:"pods"`
+
+	// Injections is the number of containers injected across those pods
+	Injections int `json:"injections"`
+
+	// Truncated is set when items or status.affectedPods had to leave entries out
+	// +optional
+	Truncated bool `json:"truncated,omitempty"`
+
+	// Items lists the first MaxAffectedSummaryItems pods with their injection counts
+	// +optional
+	Items []AffectedPod `json:"items,omitempty"`
+}
+
+// AffectedPod counts the injections into one pod
+type AffectedPod struct {
+	// Namespace of the pod
+	Namespace string `json:"namespace"`
+
+	// Name of the pod
+	Name string `json:"name"`
+
+	// Injections is the number of containers injected into the pod
+	Injections int `json:"injections"`
+}
+
+// Operations of a PendingCleanup
+const (
+	// CleanupUncordon makes a cordoned node schedulable again
+	CleanupUncordon = "Uncordon"
+	// CleanupUntaint removes the experiment's taint (spec.taintKey and spec.taintEffect) from a node
+	CleanupUntaint = "Untaint"
+	// CleanupStopContainer signals an injected ephemeral container, which reverts its fault and exits
+	CleanupStopContainer = "StopContainer"
+	// CleanupRestoreQuota puts back the hard limits a quota-squeeze saved on a ResourceQuota
+	CleanupRestoreQuota = "RestoreQuota"
+	// CleanupRemoveWebhook deletes the ValidatingWebhookConfiguration of an admission-delay
+	CleanupRemoveWebhook = "RemoveWebhook"
+)
+
+// PendingCleanup is a revert of an injection the controller was interrupted in, handed off to the
+// next controller instance
+type PendingCleanup struct {
+	// Operation is the revert to run
+	// +kubebuilder:validation:Enum=Uncordon;Untaint;StopContainer;RestoreQuota;RemoveWebhook
+	Operation string `json:"operation"`
+
+	// Node to uncordon or untaint
+	// +optional
+	Node string `json:"node,omitempty"`
+
+	// Pod whose ephemeral container to stop, as "namespace/name"
+	// +optional
+	Pod string `json:"pod,omitempty"`
+
+	// Container is the ephemeral container to stop
+	// +optional
+	Container string `json:"container,omitempty"`
+
+	// Quota is the ResourceQuota to restore, as "namespace/name"
+	// +optional
+	Quota string `json:"quota,omitempty"`
+
+	// Webhook is the ValidatingWebhookConfiguration to delete
+	// +optional
+	Webhook string `json:"webhook,omitempty"`
+}
+
+// ChangeTicketStatus is the change ticket the last injection round was verified against
+type ChangeTicketStatus struct {
+	// ID is the ticket referenced by the chaos.gushchin.dev/change-ticket annotation
+	ID string `json:"id"`
+
+	// State is the approval state or status the change-management system reported
+	// +optional
+	State string `json:"state,omitempty"`
+
+	// VerifiedAt is when the ticket was last found approved within its change window
+	// +optional
+	VerifiedAt *metav1.Time `json:"verifiedAt,omitempty"`
+}
+
 // ChaosExperimentStatus defines the observed state of ChaosExperiment.
 type ChaosExperimentStatus struct {
 	// INSERT ADDITIONAL STATUS FIELD - define observed state of cluster
@@ -362,6 +1335,27 @@ type ChaosExperimentStatus struct {
 	// +optional
 	CompletedAt *metav1.Time `json:"completedAt,omitempty"`
 
+	// FailureEndsAt is when the current pod-failure run stops failing its targets
+	// +optional
+	FailureEndsAt *metav1.Time `json:"failureEndsAt,omitempty"`
+
+	// RollingRestart is the progress of the current pod-restart run
+	// +optional
+	RollingRestart *RollingRestartStatus `json:"rollingRestart,omitempty"`
+
+	// BalloonsEndAt is when the balloon pods of the current scheduler-pressure run are removed
+	// +optional
+	BalloonsEndAt *metav1.Time `json:"balloonsEndAt,omitempty"`
+
+	// QuotaSqueezeEndsAt is when the ResourceQuotas squeezed by the current quota-squeeze run are
+	// restored
+	// +optional
+	QuotaSqueezeEndsAt *metav1.Time `json:"quotaSqueezeEndsAt,omitempty"`
+
+	// AdmissionDelayEndsAt is when the webhook of the current admission-delay run is removed
+	// +optional
+	AdmissionDelayEndsAt *metav1.Time `json:"admissionDelayEndsAt,omitempty"`
+
 	// LastScheduledTime indicates when the scheduled experiment was last triggered
 	// Only set when spec.schedule is defined
 	// +optional
@@ -377,11 +1371,40 @@ type ChaosExperimentStatus struct {
 	// +optional
 	CordonedNodes []string `json:"cordonedNodes,omitempty"`
 
+	// UnevictedPods lists the pods the last node-drain round could not evict within spec.drainTimeout
+	// +optional
+	UnevictedPods []UnevictedPod `json:"unevictedPods,omitempty"`
+
+	// NodeReplacements tracks, for each node drained with spec.nodeReplacement, the node that replaced it
+	// Keeps the 50 most recent drains
+	// +optional
+	NodeReplacements []NodeReplacementResult `json:"nodeReplacements,omitempty"`
+
 	// TaintedNodes tracks nodes that were tainted by this experiment
 	// Used for removing taints when the experiment completes
 	// +optional
 	TaintedNodes []string `json:"taintedNodes,omitempty"`
 
+	// StolenLeases tracks the leases this experiment holds, as "namespace/name"
+	// Used to release them when the experiment completes or is aborted
+	// +optional
+	StolenLeases []string `json:"stolenLeases,omitempty"`
+
+	// BalloonPods tracks the balloon pods of the current scheduler-pressure run, as "namespace/name"
+	// Used to remove them when the run ends or the experiment is aborted
+	// +optional
+	BalloonPods []string `json:"balloonPods,omitempty"`
+
+	// SqueezedQuotas tracks the ResourceQuotas this experiment shrank, as "namespace/name"
+	// Used to restore their original limits when the run ends or the experiment is aborted
+	// +optional
+	SqueezedQuotas []string `json:"squeezedQuotas,omitempty"`
+
+	// AdmissionWebhook is the ValidatingWebhookConfiguration of the current admission-delay run
+	// Used to remove it when the run ends or the experiment is aborted
+	// +optional
+	AdmissionWebhook string `json:"admissionWebhook,omitempty"`
+
 	// Conditions represents the latest available observations of the experiment
 	// +optional
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
@@ -389,8 +1412,63 @@ type ChaosExperimentStatus struct {
 	// AffectedPods tracks pods that have ephemeral containers injected by this experiment
 	// Used for cleanup when the experiment completes (pod-cpu-stress, pod-memory-stress, pod-network-loss, pod-disk-fill)
 	// Format: "namespace/podName:containerName"
+	// Holds at most MaxAffectedPodRefs entries; beyond that the oldest injections are handed to
+	// ChaosCleanupTasks that stop them once the experiment is no longer running
 	// +optional
 	AffectedPods []string `json:"affectedPods,omitempty"`
+
+	// AffectedSummary counts the pods injected into since the last cleanup. It stays small on big
+	// selectors; the full target list of every run is kept in its history record
+	// +optional
+	AffectedSummary *AffectedSummary `json:"affectedSummary,omitempty"`
+
+	// TargetResults reports whether the container injected into each target pod started, and how
+	// it exited (pod-cpu-stress, pod-memory-stress, pod-network-loss, pod-network-corruption,
+	// network-partition, pod-disk-fill)
+	// +optional
+	TargetResults []TargetResult `json:"targetResults,omitempty"`
+
+	// NetworkMeasurements reports the RTT and loss seen by the spec.networkMeasurement containers,
+	// one entry per container, most recent last
+	// +optional
+	NetworkMeasurements []NetworkMeasurementResult `json:"networkMeasurements,omitempty"`
+
+	// SelectedTargets records the pods picked by the last run when spec.stickyTargets is enabled
+	// Format: "namespace/podName"
+	// +optional
+	SelectedTargets []string `json:"selectedTargets,omitempty"`
+
+	// BlastRadius is the impact estimate computed when targets were selected for the last run
+	// +optional
+	BlastRadius *BlastRadius `json:"blastRadius,omitempty"`
+
+	// Autoscalers records how the HorizontalPodAutoscalers of the targets reacted to the experiment
+	// Only set when spec.autoscalerPolicy is defined
+	// +optional
+	Autoscalers []AutoscalerActivity `json:"autoscalers,omitempty"`
+
+	// ChangeTicket is the change ticket the last injection round was verified against
+	// Only set when the experiment references one and a change-management system is configured
+	// +optional
+	ChangeTicket *ChangeTicketStatus `json:"changeTicket,omitempty"`
+
+	// Metrics holds the before, during and after values of spec.metricsQueries, filled in when the
+	// experiment completes
+	// +optional
+	Metrics []MetricSample `json:"metrics,omitempty"`
+
+	// Recovery counts the affected targets and how the injected pods recovered, for spec.successCriteria
+	// +optional
+	Recovery *RecoveryStatus `json:"recovery,omitempty"`
+
+	// Verdict judges spec.successCriteria once the experiment completed
+	// +optional
+	Verdict *Verdict `json:"verdict,omitempty"`
+
+	// PendingCleanup lists reverts a controller shutting down in the middle of an injection left
+	// behind. The next controller runs them before reconciling the experiment any further.
+	// +optional
+	PendingCleanup []PendingCleanup `json:"pendingCleanup,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -400,6 +1478,7 @@ type ChaosExperimentStatus struct {
 // +kubebuilder:printcolumn:name="Count",type="integer",JSONPath=".spec.count"
 // +kubebuilder:printcolumn:name="Phase",type="string",JSONPath=".status.phase"
 // +kubebuilder:printcolumn:name="Retries",type="integer",JSONPath=".status.retryCount"
+// +kubebuilder:printcolumn:name="Verdict",type="string",JSONPath=".status.verdict.result"
 // +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 
 // ChaosExperiment is the Schema for the chaosexperiments API
@@ -17,6 +17,7 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"regexp"
 	"testing"
 	"time"
 
@@ -242,7 +243,7 @@ func TestChaosExperimentInvalidCases(t *testing.T) {
 				Selector:  map[string]string{"app": "test"},
 				Duration:  "30",
 			},
-			errMsg: "duration must match pattern ^([0-9]+(s|m|h))+$",
+			errMsg: "duration must match pattern ^([0-9]+(ms|s|m|h))+$",
 		},
 		{
 			name: "invalid duration format - wrong unit",
@@ -252,7 +253,7 @@ func TestChaosExperimentInvalidCases(t *testing.T) {
 				Selector:  map[string]string{"app": "test"},
 				Duration:  "30minutes",
 			},
-			errMsg: "duration must match pattern ^([0-9]+(s|m|h))+$",
+			errMsg: "duration must match pattern ^([0-9]+(ms|s|m|h))+$",
 		},
 		{
 			name: "invalid duration format - spaces",
@@ -262,7 +263,7 @@ func TestChaosExperimentInvalidCases(t *testing.T) {
 				Selector:  map[string]string{"app": "test"},
 				Duration:  "30 s",
 			},
-			errMsg: "duration must match pattern ^([0-9]+(s|m|h))+$",
+			errMsg: "duration must match pattern ^([0-9]+(ms|s|m|h))+$",
 		},
 	}
 
@@ -280,6 +281,9 @@ func TestChaosExperimentInvalidCases(t *testing.T) {
 	}
 }
 
+// durationPattern is the pattern of the Duration field in the CRD
+var durationPattern = regexp.MustCompile(`^([0-9]+(ms|s|m|h))+$`)
+
 // validateChaosExperimentSpec performs the same validation that OpenAPI schema would do
 // This is for testing purposes to ensure our validation markers are correct
 func validateChaosExperimentSpec(spec *ChaosExperimentSpec) error {
@@ -329,7 +333,7 @@ func validateChaosExperimentSpec(spec *ChaosExperimentSpec) error {
 	if spec.Duration != "" {
 		matched := durationPattern.MatchString(spec.Duration)
 		if !matched {
-			return &ValidationError{Field: "duration", Message: "duration must match pattern ^([0-9]+(s|m|h))+$"}
+			return &ValidationError{Field: "duration", Message: "duration must match pattern ^([0-9]+(ms|s|m|h))+$"}
 		}
 	}
 
@@ -995,6 +999,8 @@ func TestValidateCIDR(t *testing.T) {
 		{name: "valid CIDR - /12", cidr: "10.96.0.0/12", wantErr: false},
 		{name: "valid CIDR - /32", cidr: "192.168.1.1/32", wantErr: false},
 		{name: "valid CIDR - /8", cidr: "10.0.0.0/8", wantErr: false},
+		{name: "valid IPv6 CIDR - /32", cidr: "2001:db8::/32", wantErr: false},
+		{name: "valid IPv6 CIDR - /128", cidr: "fd00::9/128", wantErr: false},
 
 		// Invalid CIDRs
 		{name: "empty CIDR", cidr: "", wantErr: true},
@@ -1004,7 +1010,7 @@ func TestValidateCIDR(t *testing.T) {
 		{name: "invalid CIDR - negative mask", cidr: "192.168.1.0/-1", wantErr: true},
 		{name: "invalid CIDR - malformed IP", cidr: "256.1.1.1/24", wantErr: true},
 		{name: "invalid CIDR - text", cidr: "not-a-cidr/24", wantErr: true},
-		{name: "IPv6 CIDR (unsupported)", cidr: "2001:db8::/32", wantErr: true},
+		{name: "IPv4-mapped IPv6 CIDR", cidr: "::ffff:10.0.0.0/104", wantErr: true},
 	}
 
 	for _, tt := range tests {
@@ -1032,6 +1038,8 @@ func TestValidateIP(t *testing.T) {
 		{name: "valid IP - public", ip: "8.8.8.8", wantErr: false},
 		{name: "valid IP - broadcast", ip: "255.255.255.255", wantErr: false},
 		{name: "valid IP - all zeros", ip: "0.0.0.0", wantErr: false},
+		{name: "valid IPv6 - loopback", ip: "::1", wantErr: false},
+		{name: "valid IPv6 - documentation", ip: "2001:db8::1", wantErr: false},
 
 		// Invalid IPs
 		{name: "empty IP", ip: "", wantErr: true},
@@ -1040,8 +1048,8 @@ func TestValidateIP(t *testing.T) {
 		{name: "invalid IP - too few octets", ip: "1.2.3", wantErr: true},
 		{name: "invalid IP - text", ip: "not-an-ip", wantErr: true},
 		{name: "invalid IP - hostname", ip: "example.com", wantErr: true},
-		{name: "IPv6 (unsupported)", ip: "::1", wantErr: true},
-		{name: "IPv6 (unsupported)", ip: "2001:db8::1", wantErr: true},
+		{name: "IPv4-mapped IPv6", ip: "::ffff:10.0.0.1", wantErr: true},
+		{name: "invalid IPv6", ip: "2001:db8::zz", wantErr: true},
 	}
 
 	for _, tt := range tests {
@@ -1096,6 +1104,8 @@ func TestIsDangerousTarget(t *testing.T) {
 		// Dangerous targets
 		{name: "loopback", ip: "127.0.0.1", wantDangerous: true, wantReasonPart: "Loopback"},
 		{name: "link-local", ip: "169.254.1.1", wantDangerous: true, wantReasonPart: "Link-local"},
+		{name: "IPv6 loopback", ip: "::1", wantDangerous: true, wantReasonPart: "Loopback"},
+		{name: "IPv6 link-local", ip: "fe80::1", wantDangerous: true, wantReasonPart: "Link-local"},
 		{name: "k8s API server", ip: "10.96.0.1", wantDangerous: true, wantReasonPart: "Kubernetes API"},
 		{name: "cluster DNS", ip: "10.96.0.10", wantDangerous: true, wantReasonPart: "Cluster DNS"},
 		{name: "cluster service IP", ip: "10.96.100.50", wantDangerous: true, wantReasonPart: "Cluster service"},
@@ -1131,6 +1141,7 @@ func TestIsDangerousCIDR(t *testing.T) {
 	}{
 		// Dangerous CIDRs
 		{name: "loopback range", cidr: "127.0.0.0/8", wantDangerous: true, wantReasonPart: "loopback"},
+		{name: "IPv6 everything", cidr: "::/0", wantDangerous: true, wantReasonPart: "loopback"},
 		{name: "cluster service CIDR", cidr: "10.96.0.0/12", wantDangerous: true, wantReasonPart: "overlaps"},
 		{name: "private 10.x", cidr: "10.0.0.0/8", wantDangerous: true, wantReasonPart: "overlaps"},
 		{name: "private 172.16.x", cidr: "172.16.0.0/12", wantDangerous: true, wantReasonPart: "overlaps"},
@@ -1138,6 +1149,7 @@ func TestIsDangerousCIDR(t *testing.T) {
 		// Safe CIDRs
 		{name: "public CIDR", cidr: "8.8.8.0/24", wantDangerous: false, wantReasonPart: ""},
 		{name: "specific non-cluster private", cidr: "192.168.1.0/24", wantDangerous: false, wantReasonPart: ""},
+		{name: "IPv6 documentation", cidr: "2001:db8::/32", wantDangerous: false, wantReasonPart: ""},
 
 		// Invalid CIDRs (return false, not an error)
 		{name: "invalid CIDR", cidr: "not-a-cidr", wantDangerous: false, wantReasonPart: ""},
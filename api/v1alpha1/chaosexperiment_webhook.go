@@ -18,11 +18,22 @@ package v1alpha1
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"path"
+	"slices"
+	"strconv"
 	"strings"
+	"time"
 
+	admissionv1 "k8s.io/api/admission/v1"
+	coordinationv1 "k8s.io/api/coordination/v1"
 	corev1 "k8s.io/api/core/v1"
+	schedulingv1 "k8s.io/api/scheduling/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -31,7 +42,10 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
+	chaosduration "github.com/neogan74/k8s-chaos/internal/duration"
 	chaosmetrics "github.com/neogan74/k8s-chaos/internal/metrics"
+	cronschedule "github.com/neogan74/k8s-chaos/internal/schedule"
+	"github.com/neogan74/k8s-chaos/internal/verdict"
 )
 
 // log is for logging in this package.
@@ -45,27 +59,173 @@ type ChaosExperimentWebhook struct {
 	Client client.Client
 }
 
+// ChaosExperimentDefaulter implements webhook.CustomDefaulter and stamps audit annotations
+// +kubebuilder:object:generate=false
+type ChaosExperimentDefaulter struct{}
+
 // SetupWebhookWithManager sets up the webhook with the Manager.
 func (r *ChaosExperiment) SetupWebhookWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewWebhookManagedBy(mgr).
 		For(r).
+		WithDefaulter(&ChaosExperimentDefaulter{}).
 		WithValidator(&ChaosExperimentWebhook{Client: mgr.GetClient()}).
 		Complete()
 }
 
+// +kubebuilder:webhook:path=/mutate-chaos-gushchin-dev-v1alpha1-chaosexperiment,mutating=true,failurePolicy=fail,sideEffects=None,groups=chaos.gushchin.dev,resources=chaosexperiments,verbs=create;update,versions=v1alpha1,name=mchaosexperiment.kb.io,admissionReviewVersions=v1
+
+var _ webhook.CustomDefaulter = &ChaosExperimentDefaulter{}
+
+// Default records who created the experiment and with which tool.
+// On update the original initiated-by value is restored so it cannot be forged, unless the spec
+// changed: whoever changes what the experiment does becomes its initiator, so that a user cannot
+// retarget an experiment that runs as its more privileged creator. Pausing and resuming do not count.
+// Whoever writes the approved-by annotation is recorded as the approver, whatever the value.
+func (d *ChaosExperimentDefaulter) Default(ctx context.Context, obj runtime.Object) error {
+	exp, ok := obj.(*ChaosExperiment)
+	if !ok {
+		return fmt.Errorf("expected a ChaosExperiment but got a %T", obj)
+	}
+
+	req, err := admission.RequestFromContext(ctx)
+	if err != nil {
+		// Not called through the admission chain, nothing to record
+		return nil
+	}
+
+	switch req.Operation {
+	case admissionv1.Create:
+		setAnnotation(exp, InitiatedByAnnotation, req.UserInfo.Username)
+		if exp.Annotations[UserAgentAnnotation] == "" {
+			if agent := inferUserAgent(req.UserInfo.Username); agent != "" {
+				setAnnotation(exp, UserAgentAnnotation, agent)
+			}
+		}
+		if _, ok := exp.Annotations[ApprovedByAnnotation]; ok {
+			setAnnotation(exp, ApprovedByAnnotation, req.UserInfo.Username)
+		}
+		setNextRunsAnnotation(exp, time.Now())
+	case admissionv1.Update:
+		old := &ChaosExperiment{}
+		if err := json.Unmarshal(req.OldObject.Raw, old); err != nil {
+			return fmt.Errorf("failed to decode old object: %w", err)
+		}
+		if specChanged(&old.Spec, &exp.Spec) {
+			setAnnotation(exp, InitiatedByAnnotation, req.UserInfo.Username)
+		} else if initiator, ok := old.Annotations[InitiatedByAnnotation]; ok {
+			setAnnotation(exp, InitiatedByAnnotation, initiator)
+		} else {
+			delete(exp.Annotations, InitiatedByAnnotation)
+		}
+		if approver, ok := exp.Annotations[ApprovedByAnnotation]; ok && approver != old.Annotations[ApprovedByAnnotation] {
+			setAnnotation(exp, ApprovedByAnnotation, req.UserInfo.Username)
+		}
+		// Keep the preview of an unchanged schedule, so that unrelated updates do not rewrite it
+		if exp.Spec.Schedule != old.Spec.Schedule || exp.Spec.ScheduleJitter != old.Spec.ScheduleJitter ||
+			!slices.Equal(exp.Spec.Schedules, old.Spec.Schedules) ||
+			!slices.Equal(exp.Spec.ExcludeSchedules, old.Spec.ExcludeSchedules) ||
+			exp.Annotations[NextRunsAnnotation] == "" {
+			setNextRunsAnnotation(exp, time.Now())
+		}
+	}
+
+	chaosexperimentlog.Info("default", "name", exp.Name, "operation", req.Operation,
+		"initiatedBy", exp.Annotations[InitiatedByAnnotation])
+	return nil
+}
+
+// specChanged reports whether an update changes what the experiment does, other than pausing it
+func specChanged(old, spec *ChaosExperimentSpec) bool {
+	unpaused := *spec
+	unpaused.Paused = old.Paused
+	return !equality.Semantic.DeepEqual(*old, unpaused)
+}
+
+// setAnnotation sets an annotation, creating the map if needed
+func setAnnotation(exp *ChaosExperiment, key, value string) {
+	if exp.Annotations == nil {
+		exp.Annotations = map[string]string{}
+	}
+	exp.Annotations[key] = value
+}
+
+// nextRunsPreviewCount is how many runs NextRunsAnnotation previews
+const nextRunsPreviewCount = 3
+
+// setNextRunsAnnotation previews the runs of a scheduled experiment after now, the way the
+// controller will compute them, and removes the preview when there is no valid schedule
+func setNextRunsAnnotation(exp *ChaosExperiment, now time.Time) {
+	definition := ScheduleDefinition(&exp.Spec)
+	if definition.IsZero() {
+		delete(exp.Annotations, NextRunsAnnotation)
+		return
+	}
+	var offset time.Duration
+	var note string
+	if window, err := chaosduration.Parse(exp.Spec.ScheduleJitter, chaosduration.Standard...); err == nil && window > 0 {
+		if exp.Name == "" {
+			// A generated name is not known yet, and with it the offset
+			note = fmt.Sprintf(" (each delayed by up to %s)", exp.Spec.ScheduleJitter)
+		} else {
+			offset = cronschedule.Offset(exp.Namespace+"/"+exp.Name, window)
+		}
+	}
+	schedule, err := cronschedule.Build(definition, offset)
+	if err != nil {
+		// Rejected by the validating webhook
+		delete(exp.Annotations, NextRunsAnnotation)
+		return
+	}
+	setAnnotation(exp, NextRunsAnnotation, cronschedule.Preview(schedule, now, nextRunsPreviewCount)+note)
+}
+
+// inferUserAgent guesses the originating tool from well-known GitOps service accounts
+func inferUserAgent(username string) string {
+	if !strings.HasPrefix(username, "system:serviceaccount:") {
+		return ""
+	}
+	switch {
+	case strings.Contains(username, "argocd"):
+		return "argocd"
+	case strings.Contains(username, "flux") || strings.Contains(username, "kustomize-controller") ||
+		strings.Contains(username, "helm-controller"):
+		return "flux"
+	}
+	return ""
+}
+
 // +kubebuilder:webhook:path=/validate-chaos-gushchin-dev-v1alpha1-chaosexperiment,mutating=false,failurePolicy=fail,sideEffects=None,groups=chaos.gushchin.dev,resources=chaosexperiments,verbs=create;update,versions=v1alpha1,name=vchaosexperiment.kb.io,admissionReviewVersions=v1
 
 var _ webhook.CustomValidator = &ChaosExperimentWebhook{}
 
 // ValidateCreate implements webhook.Validator so a webhook will be registered for the type
-func (w *ChaosExperimentWebhook) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+func (w *ChaosExperimentWebhook) ValidateCreate(ctx context.Context, obj runtime.Object) (warnings admission.Warnings, err error) {
 	exp, ok := obj.(*ChaosExperiment)
 	if !ok {
 		return nil, fmt.Errorf("expected a ChaosExperiment but got a %T", obj)
 	}
 
 	chaosexperimentlog.Info("validate create", "name", exp.Name)
+	defer func(start time.Time) { observeWebhookDecision("create", start, warnings, err) }(time.Now())
+
+	// Reject new experiments while a chaos freeze is in effect
+	if err := w.validateNoActiveFreeze(ctx, exp); err != nil {
+		return nil, err
+	}
 
+	// Reject severities a ChaosPolicy denies; the controller holds back injections of
+	// experiments that became denied after creation
+	severityWarnings, err := w.validateSeverityRules(ctx, exp)
+	if err != nil {
+		return severityWarnings, err
+	}
+
+	warnings, err = w.validateExperiment(ctx, exp)
+	return append(severityWarnings, warnings...), err
+}
+
+// validateExperiment runs the validations shared by create and update
+func (w *ChaosExperimentWebhook) validateExperiment(ctx context.Context, exp *ChaosExperiment) (admission.Warnings, error) {
 	var warnings admission.Warnings
 
 	// Validate namespace exists
@@ -73,6 +233,19 @@ func (w *ChaosExperimentWebhook) ValidateCreate(ctx context.Context, obj runtime
 		return warnings, err
 	}
 
+	// Node actions pick nodes with the selector
+	if strings.HasPrefix(exp.Spec.Action, "node-") {
+		return w.validateNodeExperiment(ctx, exp)
+	}
+
+	// admission-delay slows down API requests; its selector matches the objects delayed, not pods
+	if exp.Spec.Action == "admission-delay" {
+		if err := w.validateCrossFieldConstraints(exp.Name, &exp.Spec); err != nil {
+			return warnings, err
+		}
+		return warnings, w.validateProductionNamespace(ctx, exp)
+	}
+
 	// Validate selector matches at least one pod
 	matchedPods, err := w.validateSelectorEffectiveness(ctx, exp.Spec.Namespace, exp.Spec.Selector)
 	if err != nil {
@@ -99,20 +272,169 @@ func (w *ChaosExperimentWebhook) ValidateCreate(ctx context.Context, obj runtime
 	}
 	warnings = append(warnings, safetyWarnings...)
 
+	if exp.Spec.Action == "scheduler-pressure" {
+		priorityWarnings, err := w.validateBalloonPriority(ctx, exp, matchedPods)
+		if err != nil {
+			return warnings, err
+		}
+		warnings = append(warnings, priorityWarnings...)
+	}
+
+	if exp.Spec.Action == "lease-steal" {
+		leaseWarnings, err := w.validateLease(ctx, exp, matchedPods)
+		if err != nil {
+			return warnings, err
+		}
+		warnings = append(warnings, leaseWarnings...)
+	}
+
+	if exp.Spec.Action == "quota-squeeze" {
+		quotaWarnings, err := w.validateQuotaSqueeze(ctx, exp)
+		if err != nil {
+			return warnings, err
+		}
+		warnings = append(warnings, quotaWarnings...)
+	}
+
 	return warnings, nil
 }
 
+// validateQuotaSqueeze warns when there is no ResourceQuota for quota-squeeze to shrink, or the
+// quotas have none of the resources spec.quotaSqueeze.resources names
+func (w *ChaosExperimentWebhook) validateQuotaSqueeze(ctx context.Context, exp *ChaosExperiment) (admission.Warnings, error) {
+	var squeeze QuotaSqueeze
+	if exp.Spec.QuotaSqueeze != nil {
+		squeeze = *exp.Spec.QuotaSqueeze
+	}
+	var quotas []corev1.ResourceQuota
+	if squeeze.Name != "" {
+		quota := &corev1.ResourceQuota{}
+		if err := w.Client.Get(ctx, types.NamespacedName{Namespace: exp.Spec.Namespace, Name: squeeze.Name}, quota); err != nil {
+			if apierrors.IsNotFound(err) {
+				return admission.Warnings{fmt.Sprintf("ResourceQuota %s not found in namespace %s; quota-squeeze runs fail until it exists",
+					squeeze.Name, exp.Spec.Namespace)}, nil
+			}
+			return nil, fmt.Errorf("failed to get ResourceQuota %s: %w", squeeze.Name, err)
+		}
+		quotas = append(quotas, *quota)
+	} else {
+		list := &corev1.ResourceQuotaList{}
+		if err := w.Client.List(ctx, list, client.InNamespace(exp.Spec.Namespace)); err != nil {
+			return nil, fmt.Errorf("failed to list ResourceQuotas: %w", err)
+		}
+		if len(list.Items) == 0 {
+			return admission.Warnings{fmt.Sprintf("ResourceQuota not found in namespace %s; quota-squeeze runs fail until one exists",
+				exp.Spec.Namespace)}, nil
+		}
+		quotas = list.Items
+	}
+
+	if len(squeeze.Resources) == 0 {
+		return nil, nil
+	}
+	for _, quota := range quotas {
+		for _, name := range squeeze.Resources {
+			if _, ok := quota.Spec.Hard[corev1.ResourceName(name)]; ok {
+				return nil, nil
+			}
+		}
+	}
+	return admission.Warnings{fmt.Sprintf("ResourceQuota limits in namespace %s include none of %s; quota-squeeze has nothing to shrink",
+		exp.Spec.Namespace, strings.Join(squeeze.Resources, ", "))}, nil
+}
+
+// validateBalloonPriority warns when the PriorityClass of the balloons does not exist yet, and when
+// no pod the selector matches has a lower priority, so the balloons cannot preempt any of them
+func (w *ChaosExperimentWebhook) validateBalloonPriority(ctx context.Context, exp *ChaosExperiment, pods []corev1.Pod) (admission.Warnings, error) {
+	name := exp.Spec.Balloons.PriorityClassName
+	class := &schedulingv1.PriorityClass{}
+	if err := w.Client.Get(ctx, types.NamespacedName{Name: name}, class); err != nil {
+		if apierrors.IsNotFound(err) {
+			return admission.Warnings{fmt.Sprintf("PriorityClass %q not found; the balloon pods cannot be created until it exists", name)}, nil
+		}
+		return nil, fmt.Errorf("failed to get PriorityClass %s: %w", name, err)
+	}
+	for _, pod := range pods {
+		if pod.Spec.Priority == nil || *pod.Spec.Priority < class.Value {
+			return nil, nil
+		}
+	}
+	return admission.Warnings{fmt.Sprintf(
+		"PriorityClass %q (value %d) is not above the priority of any of the %d pod(s) matching the selector; the balloons will not preempt them",
+		name, class.Value, len(pods))}, nil
+}
+
+// validateLease warns when the lease of a lease-steal experiment does not exist yet or is held by
+// something other than the pods the selector matches, which usually means the wrong lease
+func (w *ChaosExperimentWebhook) validateLease(ctx context.Context, exp *ChaosExperiment, candidates []corev1.Pod) (admission.Warnings, error) {
+	lease := &coordinationv1.Lease{}
+	key := types.NamespacedName{Namespace: exp.Spec.Namespace, Name: exp.Spec.LeaseName}
+	if err := w.Client.Get(ctx, key, lease); err != nil {
+		if apierrors.IsNotFound(err) {
+			return admission.Warnings{fmt.Sprintf("Lease %s not found; lease-steal runs fail until it exists", key)}, nil
+		}
+		return nil, fmt.Errorf("failed to get lease %s: %w", key, err)
+	}
+	holder := ""
+	if lease.Spec.HolderIdentity != nil {
+		holder = *lease.Spec.HolderIdentity
+	}
+	if holder == "" {
+		return nil, nil
+	}
+	for _, pod := range candidates {
+		if holder == pod.Name || strings.HasPrefix(holder, pod.Name+"_") {
+			return nil, nil
+		}
+	}
+	return admission.Warnings{fmt.Sprintf("Lease %s is held by %q, which is not one of the pods matching the selector", key, holder)}, nil
+}
+
 // ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
-func (w *ChaosExperimentWebhook) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+func (w *ChaosExperimentWebhook) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (warnings admission.Warnings, err error) {
 	exp, ok := newObj.(*ChaosExperiment)
 	if !ok {
 		return nil, fmt.Errorf("expected a ChaosExperiment but got a %T", newObj)
 	}
 
 	chaosexperimentlog.Info("validate update", "name", exp.Name)
+	defer func(start time.Time) { observeWebhookDecision("update", start, warnings, err) }(time.Now())
+
+	if oldExp, ok := oldObj.(*ChaosExperiment); ok {
+		if err := validateImmutableFields(oldExp, exp); err != nil {
+			return nil, err
+		}
+	}
+
+	// Perform the same validations as create. Updates stay allowed during a freeze
+	// so that experiments can still be paused or edited.
+	return w.validateExperiment(ctx, exp)
+}
 
-	// Perform the same validations as create
-	return w.ValidateCreate(ctx, newObj)
+// validateImmutableFields rejects changing the action or target namespace of an experiment that has
+// not finished. The controller keeps per-action state in status (cordoned nodes, injected containers,
+// applied taints) that the old action's cleanup would no longer find. Experiments that never ran or
+// reached Completed or Failed may be repurposed.
+func validateImmutableFields(oldExp, exp *ChaosExperiment) error {
+	switch oldExp.Status.Phase {
+	case "", "Completed", "Failed":
+		return nil
+	}
+	if exp.Spec.Action != oldExp.Spec.Action {
+		return denied(webhookReasonImmutable, &ValidationError{
+			Field: "spec.action",
+			Message: fmt.Sprintf("action cannot change from %q to %q while the experiment is %s; wait for it to complete or create a new experiment",
+				oldExp.Spec.Action, exp.Spec.Action, oldExp.Status.Phase),
+		})
+	}
+	if exp.Spec.Namespace != oldExp.Spec.Namespace {
+		return denied(webhookReasonImmutable, &ValidationError{
+			Field: "spec.namespace",
+			Message: fmt.Sprintf("namespace cannot change from %q to %q while the experiment is %s; wait for it to complete or create a new experiment",
+				oldExp.Spec.Namespace, exp.Spec.Namespace, oldExp.Status.Phase),
+		})
+	}
+	return nil
 }
 
 // ValidateDelete implements webhook.Validator so a webhook will be registered for the type
@@ -128,13 +450,73 @@ func (w *ChaosExperimentWebhook) ValidateDelete(ctx context.Context, obj runtime
 	return nil, nil
 }
 
+// validateNoActiveFreeze rejects the experiment if any ChaosFreeze is currently in effect
+func (w *ChaosExperimentWebhook) validateNoActiveFreeze(ctx context.Context, exp *ChaosExperiment) error {
+	freezes := &ChaosFreezeList{}
+	if err := w.Client.List(ctx, freezes); err != nil {
+		return fmt.Errorf("failed to check for chaos freeze: %w", err)
+	}
+
+	now := time.Now()
+	for _, freeze := range freezes.Items {
+		if !freeze.IsActive(now) {
+			continue
+		}
+		chaosmetrics.SafetyFreezeBlocks.WithLabelValues(exp.Spec.Action, exp.Spec.Namespace).Inc()
+		if freeze.Spec.Reason != "" {
+			return denied(webhookReasonFreeze, fmt.Errorf("chaos is frozen by ChaosFreeze %q (%s); new experiments are not allowed", freeze.Name, freeze.Spec.Reason))
+		}
+		return denied(webhookReasonFreeze, fmt.Errorf("chaos is frozen by ChaosFreeze %q; new experiments are not allowed", freeze.Name))
+	}
+	return nil
+}
+
+// validateSeverityRules rejects experiments a ChaosPolicy denies at their severity and warns
+// when injections will wait for an approval. Dry runs are not gated.
+func (w *ChaosExperimentWebhook) validateSeverityRules(ctx context.Context, exp *ChaosExperiment) (admission.Warnings, error) {
+	if exp.Spec.DryRun {
+		return nil, nil
+	}
+	policies := &ChaosPolicyList{}
+	if err := w.Client.List(ctx, policies); err != nil {
+		return nil, fmt.Errorf("failed to check chaos policies: %w", err)
+	}
+
+	severity := SeverityOf(&exp.Spec)
+	var warnings admission.Warnings
+	for i := range policies.Items {
+		policy := &policies.Items[i]
+		if !policy.AppliesTo(exp.Spec.Namespace) {
+			continue
+		}
+		for _, rule := range policy.SeverityRulesFor(severity) {
+			if rule.Deny {
+				chaosmetrics.SafetySeverityBlocks.WithLabelValues(exp.Spec.Action, exp.Spec.Namespace, severity).Inc()
+				return nil, denied(webhookReasonSeverity, fmt.Errorf("ChaosPolicy %q does not allow %s severity experiments in namespace %s",
+					policy.Name, severity, exp.Spec.Namespace))
+			}
+			if rule.RequireApproval && !IsApproved(exp) {
+				warnings = append(warnings, fmt.Sprintf(
+					"ChaosPolicy %q requires approval of %s severity experiments: injections wait until someone else sets the %s annotation",
+					policy.Name, severity, ApprovedByAnnotation))
+			}
+			if rule.RequireChangeTicket && exp.Annotations[ChangeTicketAnnotation] == "" {
+				warnings = append(warnings, fmt.Sprintf(
+					"ChaosPolicy %q requires a change ticket for %s severity experiments: injections wait until the %s annotation references an approved one",
+					policy.Name, severity, ChangeTicketAnnotation))
+			}
+		}
+	}
+	return warnings, nil
+}
+
 // validateNamespaceExists checks if the target namespace exists
 func (w *ChaosExperimentWebhook) validateNamespaceExists(ctx context.Context, namespace string) error {
 	ns := &corev1.Namespace{}
 	err := w.Client.Get(ctx, types.NamespacedName{Name: namespace}, ns)
 	if err != nil {
 		if apierrors.IsNotFound(err) {
-			return fmt.Errorf("target namespace %q does not exist", namespace)
+			return denied(webhookReasonNamespaceNotFound, fmt.Errorf("target namespace %q does not exist", namespace))
 		}
 		return fmt.Errorf("failed to validate namespace existence: %w", err)
 	}
@@ -150,68 +532,364 @@ func (w *ChaosExperimentWebhook) validateSelectorEffectiveness(ctx context.Conte
 	}
 
 	if len(podList.Items) == 0 {
-		return nil, fmt.Errorf("selector does not match any pods in namespace %q", namespace)
+		return nil, denied(webhookReasonSelectorNoMatch, fmt.Errorf("selector does not match any pods in namespace %q", namespace))
 	}
 
 	return podList.Items, nil
 }
 
+// validateNodeExperiment runs the validations of node actions against the nodes the selector
+// matches: control-plane nodes among them are called out and maxPercentage applies to the nodes
+// the action may pick
+func (w *ChaosExperimentWebhook) validateNodeExperiment(ctx context.Context, exp *ChaosExperiment) (admission.Warnings, error) {
+	var warnings admission.Warnings
+
+	nodeList := &corev1.NodeList{}
+	if err := w.Client.List(ctx, nodeList, client.MatchingLabels(exp.Spec.Selector)); err != nil {
+		return warnings, fmt.Errorf("failed to list nodes with selector: %w", err)
+	}
+	if len(nodeList.Items) == 0 {
+		return warnings, denied(webhookReasonSelectorNoMatch, fmt.Errorf("selector does not match any nodes"))
+	}
+
+	if err := w.validateCrossFieldConstraints(exp.Name, &exp.Spec); err != nil {
+		return warnings, err
+	}
+	if err := w.validateProductionNamespace(ctx, exp); err != nil {
+		return warnings, err
+	}
+
+	var controlPlane []string
+	for i := range nodeList.Items {
+		if IsControlPlaneNode(&nodeList.Items[i]) {
+			controlPlane = append(controlPlane, nodeList.Items[i].Name)
+		}
+	}
+	eligible := len(nodeList.Items)
+	switch {
+	case len(controlPlane) == 0:
+	case exp.Spec.Action == "node-drain" && !exp.Spec.AllowControlPlane:
+		// node-drain skips control-plane nodes unless allowed
+		eligible -= len(controlPlane)
+		if eligible == 0 {
+			return warnings, denied(webhookReasonControlPlane, fmt.Errorf(
+				"all %d matching nodes are control-plane nodes, which node-drain skips unless allowControlPlane is set",
+				len(nodeList.Items)))
+		}
+		warnings = append(warnings, fmt.Sprintf(
+			"Selector matches %d control-plane node(s) (%s), which node-drain skips. %d eligible nodes remain.",
+			len(controlPlane), strings.Join(controlPlane, ", "), eligible))
+	default:
+		warnings = append(warnings, fmt.Sprintf(
+			"Selector matches %d control-plane node(s) (%s); %s on them can disrupt the whole cluster.",
+			len(controlPlane), strings.Join(controlPlane, ", "), exp.Spec.Action))
+	}
+
+	count := max(exp.Spec.Count, 1)
+	if count > eligible {
+		warnings = append(warnings, fmt.Sprintf(
+			"Count (%d) exceeds number of eligible nodes matching selector (%d). Experiment will only affect %d nodes.",
+			count, eligible, eligible))
+	}
+	if exp.Spec.MaxPercentage > 0 {
+		if err := CheckMaxPercentage(exp.Spec.Count, exp.Spec.MaxPercentage, eligible); err != nil {
+			chaosmetrics.SafetyPercentageViolations.WithLabelValues(exp.Spec.Action, exp.Spec.Namespace).Inc()
+			return warnings, denied(webhookReasonMaxPercentage, err)
+		}
+	}
+
+	if exp.Spec.DryRun {
+		warnings = append(warnings, "DRY RUN mode enabled: No actual chaos will be executed")
+	}
+	return warnings, nil
+}
+
 // validateCrossFieldConstraints validates dependencies between fields
 func (w *ChaosExperimentWebhook) validateCrossFieldConstraints(name string, spec *ChaosExperimentSpec) error {
+	if errs := ValidateSpecStructure(name, spec); len(errs) > 0 {
+		if errs[0].Field == "spec.maxNodes" {
+			return denied(webhookReasonMaxNodes, &errs[0])
+		}
+		return denied(webhookReasonInvalidSpec, &errs[0])
+	}
+	return nil
+}
+
+// ValidateSpecStructure runs the validations that need no cluster state and returns every
+// failing field rather than stopping at the first one. The controller uses it to reject invalid
+// experiments when the admission webhook is not installed.
+func ValidateSpecStructure(name string, spec *ChaosExperimentSpec) []ValidationError {
+	var errs []ValidationError
+	add := func(field string, err error) {
+		if err != nil {
+			errs = append(errs, ValidationError{Field: field, Message: err.Error()})
+		}
+	}
+
 	// Validate DependsOn to ensure no self-dependency
 	for _, dep := range spec.DependsOn {
 		if dep == name {
-			return fmt.Errorf("experiment cannot depend on itself: %s", name)
+			add("spec.dependsOn", fmt.Errorf("experiment cannot depend on itself: %s", name))
 		}
 	}
 
-	// Validate duration format if provided
+	// Validate duration format if provided; the latency of pod-delay may be given in milliseconds
 	if spec.Duration != "" {
-		if err := ValidateDurationFormat(spec.Duration); err != nil {
-			return err
+		units := chaosduration.Standard
+		if spec.Action == "pod-delay" {
+			units = chaosduration.WithMilliseconds
+		}
+		add("spec.duration", chaosduration.Validate(spec.Duration, units...))
+	}
+
+	// Validate interval format and that rounds do not overlap
+	if spec.Interval != "" {
+		if err := ValidateDurationFormat(spec.Interval); err != nil {
+			add("spec.interval", fmt.Errorf("invalid interval format: %w", err))
+		} else {
+			add("spec.interval", validateIntervalCoversDuration(spec.Interval, spec.Duration))
 		}
 	}
 
 	// Validate experimentDuration format if provided
 	if spec.ExperimentDuration != "" {
-		if err := ValidateDurationFormat(spec.ExperimentDuration); err != nil {
-			return fmt.Errorf("invalid experimentDuration format: %w", err)
+		if err := chaosduration.Validate(spec.ExperimentDuration, chaosduration.WithDays...); err != nil {
+			add("spec.experimentDuration", fmt.Errorf("invalid experimentDuration format: %w", err))
 		}
 	}
 
 	// Validate schedule format if provided
 	if spec.Schedule != "" {
-		if err := ValidateSchedule(spec.Schedule); err != nil {
-			return err
+		add("spec.schedule", ValidateSchedule(spec.Schedule))
+	}
+
+	for i, schedule := range spec.Schedules {
+		add(fmt.Sprintf("spec.schedules[%d]", i), ValidateSchedule(schedule))
+	}
+	scheduled := spec.Schedule != "" || len(spec.Schedules) > 0
+	if len(spec.ExcludeSchedules) > 0 && !scheduled {
+		add("spec.excludeSchedules", fmt.Errorf("excludeSchedules requires schedule or schedules"))
+	}
+	for i, exclusion := range spec.ExcludeSchedules {
+		field := fmt.Sprintf("spec.excludeSchedules[%d]", i)
+		if exclusion.Schedule == "" {
+			add(field+".schedule", fmt.Errorf("schedule must be specified"))
+		} else {
+			add(field+".schedule", ValidateSchedule(exclusion.Schedule))
+		}
+		if exclusion.Duration != "" {
+			add(field+".duration", chaosduration.Validate(exclusion.Duration, chaosduration.WithDays...))
+		}
+	}
+
+	if spec.ScheduleJitter != "" {
+		if !scheduled {
+			add("spec.scheduleJitter", fmt.Errorf("scheduleJitter requires schedule or schedules"))
+		} else if err := ValidateDurationFormat(spec.ScheduleJitter); err != nil {
+			add("spec.scheduleJitter", fmt.Errorf("invalid scheduleJitter format: %w", err))
+		}
+	}
+
+	// Success criteria are judged when the experiment completes, which takes experimentDuration
+	if len(spec.SuccessCriteria) > 0 && spec.ExperimentDuration == "" {
+		add("spec.successCriteria", fmt.Errorf("successCriteria requires experimentDuration"))
+	}
+	for i, criterion := range spec.SuccessCriteria {
+		field := fmt.Sprintf("spec.successCriteria[%d]", i)
+		if slices.ContainsFunc(spec.SuccessCriteria[:i], func(c SuccessCriterion) bool { return c.Name == criterion.Name }) {
+			add(field+".name", fmt.Errorf("duplicate criterion name %q", criterion.Name))
+		}
+		if err := verdict.Compile(criterion.Expression); err != nil {
+			add(field+".expression", fmt.Errorf("invalid expression: %w", err))
 		}
 	}
 
 	// Validate time windows if provided
 	if len(spec.TimeWindows) > 0 {
-		if err := ValidateTimeWindows(spec.TimeWindows); err != nil {
-			return err
-		}
+		add("spec.timeWindows", ValidateTimeWindows(spec.TimeWindows))
 	}
 
 	// Validate maintenance windows if provided
 	if len(spec.MaintenanceWindows) > 0 {
-		if err := ValidateMaintenanceWindows(spec.MaintenanceWindows); err != nil {
-			return err
-		}
+		add("spec.maintenanceWindows", ValidateMaintenanceWindows(spec.MaintenanceWindows))
 	}
 
 	// Validate restartInterval format if provided
 	if spec.RestartInterval != "" {
 		if err := ValidateDurationFormat(spec.RestartInterval); err != nil {
-			return fmt.Errorf("invalid restartInterval format: %w", err)
+			add("spec.restartInterval", fmt.Errorf("invalid restartInterval format: %w", err))
+		}
+	}
+
+	// Validate failureInterval format if provided
+	if spec.FailureInterval != "" {
+		if err := ValidateDurationFormat(spec.FailureInterval); err != nil {
+			add("spec.failureInterval", fmt.Errorf("invalid failureInterval format: %w", err))
 		}
 	}
 
-	return w.validateActionRequirements(spec)
+	if spec.DrainTimeout != "" {
+		if spec.Action != "node-drain" {
+			add("spec.drainTimeout", fmt.Errorf("drainTimeout is only supported for node-drain action"))
+		} else if err := ValidateDurationFormat(spec.DrainTimeout); err != nil {
+			add("spec.drainTimeout", fmt.Errorf("invalid drainTimeout format: %w", err))
+		}
+	}
+
+	if spec.NodeReplacement != nil {
+		if spec.Action != "node-drain" {
+			add("spec.nodeReplacement", fmt.Errorf("nodeReplacement is only supported for node-drain action"))
+		} else if spec.NodeReplacement.Timeout != "" {
+			if err := ValidateDurationFormat(spec.NodeReplacement.Timeout); err != nil {
+				add("spec.nodeReplacement.timeout", fmt.Errorf("invalid timeout format: %w", err))
+			}
+		}
+	}
+
+	if spec.MaxNodes > 0 {
+		if !strings.HasPrefix(spec.Action, "node-") {
+			add("spec.maxNodes", fmt.Errorf("maxNodes is only supported for node actions"))
+		} else if max(spec.Count, 1) > spec.MaxNodes {
+			add("spec.maxNodes", fmt.Errorf("count (%d) exceeds maxNodes (%d)", max(spec.Count, 1), spec.MaxNodes))
+		}
+	}
+
+	if spec.IgnoreEvictionAnnotations && spec.Action != "pod-kill" && spec.Action != "node-drain" {
+		add("spec.ignoreEvictionAnnotations", fmt.Errorf("ignoreEvictionAnnotations is only supported for pod-kill and node-drain actions"))
+	}
+
+	// Autoscaler handling only makes sense for actions that drive resource usage
+	if spec.AutoscalerPolicy != "" && spec.Action != "pod-cpu-stress" && spec.Action != "pod-memory-stress" {
+		add("spec.autoscalerPolicy", fmt.Errorf("autoscalerPolicy is only supported for pod-cpu-stress and pod-memory-stress actions"))
+	}
+
+	if spec.RelativeLoad != "" && spec.Action != "pod-cpu-stress" {
+		add("spec.relativeLoad", fmt.Errorf("relativeLoad is only supported for pod-cpu-stress action"))
+	}
+	if spec.MemoryOvercommitPolicy != "" && spec.Action != "pod-memory-stress" {
+		add("spec.memoryOvercommitPolicy", fmt.Errorf("memoryOvercommitPolicy is only supported for pod-memory-stress action"))
+	}
+
+	// Peer groups only exist for network-partition
+	if (len(spec.PeerSelector) > 0 || len(spec.PeerNamespaces) > 0) && spec.Action != "network-partition" {
+		add("spec.peerSelector", fmt.Errorf("peerSelector and peerNamespaces are only supported for network-partition action"))
+	}
+	if spec.NetAdminFallback && spec.Action != "pod-delay" {
+		add("spec.netAdminFallback", fmt.Errorf("netAdminFallback is only supported for pod-delay action"))
+	}
+	if len(spec.ExternalTargets) > 0 && spec.Action != "network-partition" {
+		add("spec.externalTargets", fmt.Errorf("externalTargets is only supported for network-partition action"))
+	}
+	if spec.Balloons != nil && spec.Action != "scheduler-pressure" {
+		add("spec.balloons", fmt.Errorf("balloons is only supported for scheduler-pressure action"))
+	}
+	if (spec.LeaseName != "" || spec.LeaseMode != "") && spec.Action != "lease-steal" {
+		add("spec.leaseName", fmt.Errorf("leaseName and leaseMode are only supported for lease-steal action"))
+	}
+	if spec.QuotaSqueeze != nil && spec.Action != "quota-squeeze" {
+		add("spec.quotaSqueeze", fmt.Errorf("quotaSqueeze is only supported for quota-squeeze action"))
+	}
+	if spec.AdmissionDelay != nil && spec.Action != "admission-delay" {
+		add("spec.admissionDelay", fmt.Errorf("admissionDelay is only supported for admission-delay action"))
+	}
+	if spec.NetworkMeasurement != nil {
+		switch spec.Action {
+		case "pod-network-loss", "pod-network-corruption", "network-partition":
+		default:
+			add("spec.networkMeasurement", fmt.Errorf("networkMeasurement is only supported for pod-network-loss, pod-network-corruption and network-partition actions"))
+		}
+	}
+
+	// Owner patterns filter pods; node actions select nodes
+	for _, owners := range []struct {
+		field    string
+		patterns []string
+	}{{"spec.excludeOwners", spec.ExcludeOwners}, {"spec.includeOwners", spec.IncludeOwners}} {
+		if len(owners.patterns) > 0 && strings.HasPrefix(spec.Action, "node-") {
+			add(owners.field, fmt.Errorf("owner patterns are not supported for node actions"))
+		}
+		for _, pattern := range owners.patterns {
+			if _, err := path.Match(pattern, ""); err != nil {
+				add(owners.field, fmt.Errorf("invalid owner pattern %q: %w", pattern, err))
+			}
+		}
+	}
+
+	// Node constraints narrow pod actions by where pods run
+	if (len(spec.NodeSelector) > 0 || spec.NodeAffinity != nil) && strings.HasPrefix(spec.Action, "node-") {
+		add("spec.nodeSelector", fmt.Errorf("nodeSelector and nodeAffinity are not supported for node actions; use selector to pick nodes"))
+	}
+	if spec.NodeAffinity != nil {
+		add("spec.nodeAffinity", validateNodeAffinity(spec.NodeAffinity))
+	}
+
+	// Spreading arranges pod targets and replaces the one-per-* strategies
+	if spec.SpreadBy != "" {
+		switch {
+		case strings.HasPrefix(spec.Action, "node-"):
+			add("spec.spreadBy", fmt.Errorf("spreadBy is not supported for node actions"))
+		case spec.SelectionStrategy == "one-per-node" || spec.SelectionStrategy == "one-per-zone":
+			add("spec.spreadBy", fmt.Errorf("spreadBy cannot be combined with selectionStrategy %s", spec.SelectionStrategy))
+		}
+	} else if spec.SpreadMode != "" {
+		add("spec.spreadMode", fmt.Errorf("spreadMode requires spreadBy"))
+	}
+
+	add("spec", validateActionRequirements(spec))
+
+	return errs
+}
+
+// validateNodeAffinity checks node selector terms the way the API server checks a pod's required
+// node affinity
+func validateNodeAffinity(affinity *corev1.NodeSelector) error {
+	if len(affinity.NodeSelectorTerms) == 0 {
+		return fmt.Errorf("nodeSelectorTerms must have at least one term")
+	}
+	for i, term := range affinity.NodeSelectorTerms {
+		for _, req := range term.MatchExpressions {
+			if err := validateNodeSelectorRequirement(req); err != nil {
+				return fmt.Errorf("nodeSelectorTerms[%d].matchExpressions: %w", i, err)
+			}
+		}
+		for _, req := range term.MatchFields {
+			if req.Key != "metadata.name" {
+				return fmt.Errorf("nodeSelectorTerms[%d].matchFields: unsupported field %q, only metadata.name is supported", i, req.Key)
+			}
+			if err := validateNodeSelectorRequirement(req); err != nil {
+				return fmt.Errorf("nodeSelectorTerms[%d].matchFields: %w", i, err)
+			}
+		}
+	}
+	return nil
+}
+
+// validateNodeSelectorRequirement checks that the values of a requirement fit its operator
+func validateNodeSelectorRequirement(req corev1.NodeSelectorRequirement) error {
+	switch req.Operator {
+	case corev1.NodeSelectorOpIn, corev1.NodeSelectorOpNotIn:
+		if len(req.Values) == 0 {
+			return fmt.Errorf("key %q: operator %s requires at least one value", req.Key, req.Operator)
+		}
+	case corev1.NodeSelectorOpExists, corev1.NodeSelectorOpDoesNotExist:
+		if len(req.Values) > 0 {
+			return fmt.Errorf("key %q: operator %s takes no values", req.Key, req.Operator)
+		}
+	case corev1.NodeSelectorOpGt, corev1.NodeSelectorOpLt:
+		if len(req.Values) != 1 {
+			return fmt.Errorf("key %q: operator %s requires exactly one value", req.Key, req.Operator)
+		}
+		if _, err := strconv.ParseInt(req.Values[0], 10, 64); err != nil {
+			return fmt.Errorf("key %q: operator %s requires an integer value, got %q", req.Key, req.Operator, req.Values[0])
+		}
+	default:
+		return fmt.Errorf("key %q: unsupported operator %q", req.Key, req.Operator)
+	}
+	return nil
 }
 
 // validateActionRequirements validates action-specific field requirements
-func (w *ChaosExperimentWebhook) validateActionRequirements(spec *ChaosExperimentSpec) error {
+func validateActionRequirements(spec *ChaosExperimentSpec) error {
 	switch spec.Action {
 	case "pod-delay":
 		return requireDuration(spec.Action, spec.Duration)
@@ -235,6 +913,11 @@ func (w *ChaosExperimentWebhook) validateActionRequirements(spec *ChaosExperimen
 		if spec.VolumeName == "" && spec.TargetPath == "" {
 			return fmt.Errorf("targetPath must be specified when volumeName is not set for pod-disk-fill action")
 		}
+		if spec.ReserveBytes != "" {
+			if _, err := resource.ParseQuantity(spec.ReserveBytes); err != nil {
+				return fmt.Errorf("reserveBytes must be a quantity such as 500Mi, got: %s", spec.ReserveBytes)
+			}
+		}
 	case "node-disk-fill":
 		if spec.Duration == "" {
 			return fmt.Errorf("duration is required for node-disk-fill action")
@@ -246,9 +929,23 @@ func (w *ChaosExperimentWebhook) validateActionRequirements(spec *ChaosExperimen
 		if err := requireDuration(spec.Action, spec.Duration); err != nil {
 			return err
 		}
-		if err := w.validateNetworkPartitionTargets(spec); err != nil {
+		if err := validateNetworkPartitionTargets(spec); err != nil {
 			return err
 		}
+	case "scheduler-pressure":
+		return validateSchedulerPressureRequirements(spec)
+	case "lease-steal":
+		if spec.LeaseName == "" {
+			return fmt.Errorf("leaseName must be specified for lease-steal action")
+		}
+		// A deleted lease is gone at once; an acquired one is held for the duration
+		if spec.LeaseMode != "Delete" {
+			return requireDuration(spec.Action, spec.Duration)
+		}
+	case "quota-squeeze":
+		return requireDuration(spec.Action, spec.Duration)
+	case "admission-delay":
+		return validateAdmissionDelayRequirements(spec)
 	}
 	return nil
 }
@@ -260,12 +957,41 @@ func requireDuration(action, duration string) error {
 	return nil
 }
 
+// validateIntervalCoversDuration rejects an interval shorter than the duration of each round
+func validateIntervalCoversDuration(interval, duration string) error {
+	if duration == "" {
+		return nil
+	}
+	durationValue, err := chaosduration.Parse(duration, chaosduration.WithMilliseconds...)
+	if err != nil {
+		// Reported on spec.duration
+		return nil
+	}
+	intervalValue, err := chaosduration.Parse(interval, chaosduration.Standard...)
+	if err != nil {
+		return err
+	}
+	if intervalValue < durationValue {
+		return fmt.Errorf("interval (%s) must be at least duration (%s) so that injection rounds do not overlap", interval, duration)
+	}
+	return nil
+}
+
 func validateCPUStressRequirements(spec *ChaosExperimentSpec) error {
 	if err := requireDuration(spec.Action, spec.Duration); err != nil {
 		return err
 	}
+	if spec.RelativeLoad != "" {
+		if _, err := ParseRelativeLoad(spec.RelativeLoad); err != nil {
+			return err
+		}
+		if spec.CPULoad > 0 {
+			return fmt.Errorf("cpuLoad and relativeLoad are mutually exclusive")
+		}
+		return nil
+	}
 	if spec.CPULoad <= 0 {
-		return fmt.Errorf("cpuLoad must be specified and greater than 0 for %s action", spec.Action)
+		return fmt.Errorf("cpuLoad or relativeLoad must be specified for %s action", spec.Action)
 	}
 	return nil
 }
@@ -283,6 +1009,62 @@ func validateNodeTaintRequirements(spec *ChaosExperimentSpec) error {
 	return nil
 }
 
+func validateSchedulerPressureRequirements(spec *ChaosExperimentSpec) error {
+	if err := requireDuration(spec.Action, spec.Duration); err != nil {
+		return err
+	}
+	if spec.Balloons == nil || spec.Balloons.PriorityClassName == "" {
+		return fmt.Errorf("balloons.priorityClassName must be specified for scheduler-pressure action")
+	}
+	for _, request := range []struct{ field, value string }{
+		{"cpu", spec.Balloons.CPU}, {"memory", spec.Balloons.Memory},
+	} {
+		if request.value == "" {
+			continue
+		}
+		if q, err := resource.ParseQuantity(request.value); err != nil || q.Sign() <= 0 {
+			return fmt.Errorf("balloons.%s must be a positive quantity, got: %s", request.field, request.value)
+		}
+	}
+	return nil
+}
+
+// protectedAdmissionResources are never delayed: slowing down leases breaks leader election of
+// every controller, including this one, and events and chaos resources are what reports the chaos
+var protectedAdmissionResources = map[string]bool{
+	"leases.coordination.k8s.io": true,
+	"events":                     true,
+	"events.events.k8s.io":       true,
+}
+
+func validateAdmissionDelayRequirements(spec *ChaosExperimentSpec) error {
+	if err := requireDuration(spec.Action, spec.Duration); err != nil {
+		return err
+	}
+	if duration, err := chaosduration.Parse(spec.Duration, chaosduration.Standard...); err == nil && duration > MaxAdmissionDelayDuration {
+		return fmt.Errorf("duration must be at most %s for admission-delay action, got: %s", MaxAdmissionDelayDuration, spec.Duration)
+	}
+	if spec.AdmissionDelay == nil {
+		return fmt.Errorf("admissionDelay must be specified for admission-delay action")
+	}
+	delay, err := chaosduration.Parse(spec.AdmissionDelay.Delay, chaosduration.WithMilliseconds...)
+	if err != nil || delay <= 0 || delay > MaxAdmissionDelay {
+		return fmt.Errorf("admissionDelay.delay must be a duration between 1ms and %s, got: %s", MaxAdmissionDelay, spec.AdmissionDelay.Delay)
+	}
+	if len(spec.AdmissionDelay.Resources) == 0 {
+		return fmt.Errorf("admissionDelay.resources must name at least one resource")
+	}
+	for _, name := range spec.AdmissionDelay.Resources {
+		switch {
+		case name == "" || strings.ContainsAny(name, "*/"):
+			return fmt.Errorf("admissionDelay.resources must be plain resource names without wildcards or subresources, got: %q", name)
+		case protectedAdmissionResources[name] || strings.HasSuffix(name, "."+GroupVersion.Group):
+			return fmt.Errorf("admissionDelay.resources must not include %s", name)
+		}
+	}
+	return nil
+}
+
 func validateMemoryStressRequirements(spec *ChaosExperimentSpec) error {
 	if err := requireDuration(spec.Action, spec.Duration); err != nil {
 		return err
@@ -330,7 +1112,11 @@ func validateDiskFillRequirements(spec *ChaosExperimentSpec) error {
 }
 
 // validateNetworkPartitionTargets validates selective targeting fields for network-partition action
-func (w *ChaosExperimentWebhook) validateNetworkPartitionTargets(spec *ChaosExperimentSpec) error {
+func validateNetworkPartitionTargets(spec *ChaosExperimentSpec) error {
+	if len(spec.PeerNamespaces) > 0 && len(spec.PeerSelector) == 0 {
+		return fmt.Errorf("peerNamespaces requires peerSelector")
+	}
+
 	// Validate targetIPs
 	for i, ip := range spec.TargetIPs {
 		if err := ValidateIP(ip); err != nil {
@@ -345,6 +1131,13 @@ func (w *ChaosExperimentWebhook) validateNetworkPartitionTargets(spec *ChaosExpe
 		}
 	}
 
+	// Validate externalTargets
+	for i, target := range spec.ExternalTargets {
+		if err := ValidateExternalTarget(target); err != nil {
+			return fmt.Errorf("externalTargets[%d]: %w", i, err)
+		}
+	}
+
 	// Validate targetPorts
 	for i, port := range spec.TargetPorts {
 		if err := ValidatePortRange(port); err != nil {
@@ -378,11 +1171,13 @@ func (w *ChaosExperimentWebhook) validateSafetyConstraints(ctx context.Context,
 	}
 
 	// 2. Filter excluded pods
-	eligiblePods := w.filterExcludedPods(matchedPods)
+	eligiblePods, temporarilyExcluded := w.filterExcludedPods(matchedPods, time.Now())
 
-	// 3. Warn if all pods are excluded
-	if len(eligiblePods) == 0 && len(matchedPods) > 0 {
-		return warnings, fmt.Errorf("all %d matching pods are excluded via %s label", len(matchedPods), ExclusionLabel)
+	// 3. Reject if all pods are excluded for good; temporary exclusions expire, so the
+	// experiment may still find targets later
+	if len(eligiblePods) == 0 && temporarilyExcluded == 0 && len(matchedPods) > 0 {
+		return warnings, denied(webhookReasonAllExcluded,
+			fmt.Errorf("all %d matching pods are excluded via %s label", len(matchedPods), ExclusionLabel))
 	}
 
 	// 4. Validate maximum percentage limit
@@ -393,13 +1188,31 @@ func (w *ChaosExperimentWebhook) validateSafetyConstraints(ctx context.Context,
 	}
 
 	// 5. Add informational warnings
-	if len(matchedPods) > len(eligiblePods) {
-		excludedCount := len(matchedPods) - len(eligiblePods)
+	if excludedCount := len(matchedPods) - len(eligiblePods) - temporarilyExcluded; excludedCount > 0 {
 		warnings = append(warnings, fmt.Sprintf(
 			"%d pod(s) excluded via %s label. %d eligible pods remain.",
 			excludedCount, ExclusionLabel, len(eligiblePods),
 		))
 	}
+	if temporarilyExcluded > 0 {
+		warnings = append(warnings, fmt.Sprintf(
+			"%d pod(s) excluded via %s annotation until it expires. %d eligible pods remain.",
+			temporarilyExcluded, ExcludeUntilAnnotation, len(eligiblePods),
+		))
+	}
+
+	if !exp.Spec.IncludeJobPods {
+		jobPods := 0
+		for i := range eligiblePods {
+			if owner := metav1.GetControllerOf(&eligiblePods[i]); owner != nil && owner.Kind == "Job" {
+				jobPods++
+			}
+		}
+		if jobPods > 0 {
+			warnings = append(warnings, fmt.Sprintf(
+				"%d pod(s) are owned by Jobs and will be skipped; set includeJobPods to target them.", jobPods))
+		}
+	}
 
 	if exp.Spec.DryRun {
 		warnings = append(warnings, "DRY RUN mode enabled: No actual chaos will be executed")
@@ -421,6 +1234,17 @@ func (w *ChaosExperimentWebhook) validateSafetyConstraints(ctx context.Context,
 			}
 		}
 
+		// External IPs and CIDRs get the same checks
+		for _, target := range exp.Spec.ExternalTargets {
+			isDangerous, reason := IsDangerousTarget(target)
+			if strings.Contains(target, "/") {
+				isDangerous, reason = IsDangerousCIDR(target)
+			}
+			if isDangerous {
+				warnings = append(warnings, fmt.Sprintf("WARNING: External target %s - %s", target, reason))
+			}
+		}
+
 		// Warn if ports specified without protocols (will default to TCP)
 		if len(exp.Spec.TargetPorts) > 0 && len(exp.Spec.TargetProtocols) == 0 {
 			warnings = append(warnings, "No targetProtocols specified; will default to TCP for all target ports")
@@ -445,86 +1269,116 @@ func (w *ChaosExperimentWebhook) validateProductionNamespace(ctx context.Context
 		return nil
 	}
 
-	// Check if namespace is marked as production
-	isProduction := false
+	if IsProductionNamespace(exp.Spec.Namespace, ns) {
+		// Track production block in metrics
+		chaosmetrics.SafetyProductionBlocks.WithLabelValues(exp.Spec.Action, exp.Spec.Namespace).Inc()
 
-	// Check annotation
-	if val, exists := ns.Annotations[ProductionAnnotation]; exists && val == "true" {
-		isProduction = true
+		return denied(webhookReasonProduction, fmt.Errorf(
+			"chaos experiments in production namespace %q require explicit approval: set allowProduction: true",
+			exp.Spec.Namespace,
+		))
 	}
 
-	// Check environment label
-	if val, exists := ns.Labels[ProductionLabel]; exists && (val == ProductionLabelValue || val == prodEnvValue) {
-		isProduction = true
-	}
+	return nil
+}
 
-	// Check env label
-	if val, exists := ns.Labels["env"]; exists && val == prodEnvValue {
-		isProduction = true
+// IsControlPlaneNode reports whether the node carries a control-plane (or legacy master) role label
+func IsControlPlaneNode(node *corev1.Node) bool {
+	if _, ok := node.Labels[NodeRoleControlPlaneLabel]; ok {
+		return true
 	}
+	_, ok := node.Labels[NodeRoleMasterLabel]
+	return ok
+}
 
-	// Check namespace name patterns
-	nsName := exp.Spec.Namespace
-	if nsName == "production" || nsName == prodEnvValue ||
-		strings.HasPrefix(nsName, "prod-") || strings.HasPrefix(nsName, "production-") ||
-		strings.HasSuffix(nsName, "-prod") || strings.HasSuffix(nsName, "-production") {
-		isProduction = true
-	}
+// IsProductionNamespace reports whether a namespace is treated as production, by its annotation,
+// its environment labels or its name. ns may be nil when only the name is known.
+func IsProductionNamespace(name string, ns *corev1.Namespace) bool {
+	if ns != nil {
+		// Check annotation
+		if val, exists := ns.Annotations[ProductionAnnotation]; exists && val == "true" {
+			return true
+		}
 
-	if isProduction {
-		// Track production block in metrics
-		chaosmetrics.SafetyProductionBlocks.WithLabelValues(exp.Spec.Action, exp.Spec.Namespace).Inc()
+		// Check environment label
+		if val, exists := ns.Labels[ProductionLabel]; exists && (val == ProductionLabelValue || val == prodEnvValue) {
+			return true
+		}
 
-		return fmt.Errorf(
-			"chaos experiments in production namespace %q require explicit approval: set allowProduction: true",
-			exp.Spec.Namespace,
-		)
+		// Check env label
+		if val, exists := ns.Labels["env"]; exists && val == prodEnvValue {
+			return true
+		}
 	}
 
-	return nil
+	// Check namespace name patterns
+	return name == "production" || name == prodEnvValue ||
+		strings.HasPrefix(name, "prod-") || strings.HasPrefix(name, "production-") ||
+		strings.HasSuffix(name, "-prod") || strings.HasSuffix(name, "-production")
 }
 
-// filterExcludedPods removes pods with exclusion label
-func (w *ChaosExperimentWebhook) filterExcludedPods(pods []corev1.Pod) []corev1.Pod {
+// IsExcludedUntil reports whether annotations opt out of chaos with an exclude-until time after now.
+// A value that is not an RFC3339 time keeps the object excluded until it is fixed.
+func IsExcludedUntil(annotations map[string]string, now time.Time) bool {
+	value, ok := annotations[ExcludeUntilAnnotation]
+	if !ok {
+		return false
+	}
+	until, err := time.Parse(time.RFC3339, value)
+	return err != nil || now.Before(until)
+}
+
+// filterExcludedPods removes pods with exclusion label and counts the pods it removed because of
+// an exclude-until annotation that has not expired yet
+func (w *ChaosExperimentWebhook) filterExcludedPods(pods []corev1.Pod, now time.Time) ([]corev1.Pod, int) {
 	eligible := []corev1.Pod{}
+	temporary := 0
 	for _, pod := range pods {
 		// Check if pod has exclusion label
 		if val, exists := pod.Labels[ExclusionLabel]; exists && val == "true" {
 			continue
 		}
+		if IsExcludedUntil(pod.Annotations, now) {
+			temporary++
+			continue
+		}
 		// Check if pod's namespace has exclusion annotation
 		// Note: We can't easily check namespace here without additional API call
 		// This will be handled in the controller
 		eligible = append(eligible, pod)
 	}
-	return eligible
+	return eligible, temporary
 }
 
 // validateMaxPercentage checks if count exceeds maximum percentage limit
 func (w *ChaosExperimentWebhook) validateMaxPercentage(exp *ChaosExperiment, eligiblePods []corev1.Pod) error {
-	totalPods := len(eligiblePods)
-	if totalPods == 0 {
-		return nil
+	if err := CheckMaxPercentage(exp.Spec.Count, exp.Spec.MaxPercentage, len(eligiblePods)); err != nil {
+		// Track percentage violation in metrics
+		chaosmetrics.SafetyPercentageViolations.WithLabelValues(exp.Spec.Action, exp.Spec.Namespace).Inc()
+		return denied(webhookReasonMaxPercentage, err)
 	}
+	return nil
+}
 
-	count := exp.Spec.Count
+// CheckMaxPercentage returns an error when count resources out of total exceed maxPercentage
+func CheckMaxPercentage(count, maxPercentage, total int) error {
+	if total == 0 || maxPercentage <= 0 {
+		return nil
+	}
 	if count <= 0 {
 		count = 1
 	}
 
 	// Calculate actual percentage that would be affected
-	actualPercentage := (float64(count) / float64(totalPods)) * 100
-
-	if actualPercentage > float64(exp.Spec.MaxPercentage) {
-		// Track percentage violation in metrics
-		chaosmetrics.SafetyPercentageViolations.WithLabelValues(exp.Spec.Action, exp.Spec.Namespace).Inc()
+	actualPercentage := (float64(count) / float64(total)) * 100
 
+	if actualPercentage > float64(maxPercentage) {
 		return fmt.Errorf(
 			"count (%d) would affect %.1f%% of pods, exceeding maxPercentage limit of %d%%: reduce count to %d or lower",
 			count,
 			actualPercentage,
-			exp.Spec.MaxPercentage,
-			int(float64(totalPods)*float64(exp.Spec.MaxPercentage)/100),
+			maxPercentage,
+			int(float64(total)*float64(maxPercentage)/100),
 		)
 	}
 
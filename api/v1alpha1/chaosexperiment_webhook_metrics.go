@@ -0,0 +1,136 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	chaosmetrics "github.com/neogan74/k8s-chaos/internal/metrics"
+)
+
+// Reasons the validating webhook reports in chaosexperiment_webhook_decisions_total
+const (
+	webhookReasonNone              = "none"
+	webhookReasonFreeze            = "freeze"
+	webhookReasonSeverity          = "severity"
+	webhookReasonNamespaceNotFound = "namespace-not-found"
+	webhookReasonSelectorNoMatch   = "selector-no-match"
+	webhookReasonInvalidSpec       = "invalid-spec"
+	webhookReasonProduction        = "production-block"
+	webhookReasonAllExcluded       = "all-excluded"
+	webhookReasonMaxPercentage     = "max-percentage"
+	webhookReasonMaxNodes          = "max-nodes"
+	webhookReasonControlPlane      = "control-plane"
+	webhookReasonImmutable         = "immutable-field"
+	webhookReasonError             = "error"
+
+	webhookReasonCountExceedsPods  = "count-exceeds-pods"
+	webhookReasonCountExceedsNodes = "count-exceeds-nodes"
+	webhookReasonControlPlaneNodes = "control-plane-nodes"
+	webhookReasonExcludedPods      = "excluded-pods"
+	webhookReasonJobPods           = "job-pods"
+	webhookReasonLease             = "lease"
+	webhookReasonPriorityClass     = "priority-class"
+	webhookReasonResourceQuota     = "resource-quota"
+	webhookReasonApproval          = "approval-required"
+	webhookReasonDryRun            = "dry-run"
+	webhookReasonDangerousTarget   = "dangerous-target"
+	webhookReasonDefaultProtocol   = "default-protocol"
+	webhookReasonOther             = "other"
+)
+
+// webhookDenial is a validation error tagged with the safety rail that rejected the request
+type webhookDenial struct {
+	reason string
+	err    error
+}
+
+func (d *webhookDenial) Error() string { return d.err.Error() }
+
+func (d *webhookDenial) Unwrap() error { return d.err }
+
+// denied tags err with the reason reported in the webhook metrics; nil stays nil
+func denied(reason string, err error) error {
+	if err == nil {
+		return nil
+	}
+	return &webhookDenial{reason: reason, err: err}
+}
+
+// denialReason returns the reason err was tagged with, "error" for errors of failed lookups
+func denialReason(err error) string {
+	var denial *webhookDenial
+	if errors.As(err, &denial) {
+		return denial.reason
+	}
+	return webhookReasonError
+}
+
+// warningReason maps a warning of the validating webhook to its reason
+func warningReason(warning string) string {
+	switch {
+	case strings.HasPrefix(warning, "Count (") && strings.Contains(warning, "nodes"):
+		return webhookReasonCountExceedsNodes
+	case strings.HasPrefix(warning, "Count ("):
+		return webhookReasonCountExceedsPods
+	case strings.Contains(warning, "control-plane node(s)"):
+		return webhookReasonControlPlaneNodes
+	case strings.Contains(warning, "excluded via"):
+		return webhookReasonExcludedPods
+	case strings.Contains(warning, "owned by Jobs"):
+		return webhookReasonJobPods
+	case strings.HasPrefix(warning, "Lease "):
+		return webhookReasonLease
+	case strings.HasPrefix(warning, "PriorityClass "):
+		return webhookReasonPriorityClass
+	case strings.HasPrefix(warning, "ResourceQuota "):
+		return webhookReasonResourceQuota
+	case strings.Contains(warning, "requires approval"):
+		return webhookReasonApproval
+	case strings.HasPrefix(warning, "DRY RUN"):
+		return webhookReasonDryRun
+	case strings.HasPrefix(warning, "WARNING: "):
+		return webhookReasonDangerousTarget
+	case strings.HasPrefix(warning, "No targetProtocols"):
+		return webhookReasonDefaultProtocol
+	default:
+		return webhookReasonOther
+	}
+}
+
+// observeWebhookDecision records the outcome and latency of a validation request
+func observeWebhookDecision(operation string, start time.Time, warnings admission.Warnings, err error) {
+	decision, reason := "admitted", webhookReasonNone
+	if err != nil {
+		decision, reason = "denied", denialReason(err)
+	}
+	chaosmetrics.WebhookDecisions.WithLabelValues(operation, decision, reason).Inc()
+	chaosmetrics.WebhookDuration.WithLabelValues(operation, decision).Observe(time.Since(start).Seconds())
+
+	var reasons []string
+	for _, warning := range warnings {
+		if r := warningReason(warning); !slices.Contains(reasons, r) {
+			reasons = append(reasons, r)
+			chaosmetrics.WebhookDecisions.WithLabelValues(operation, "warned", r).Inc()
+		}
+	}
+}
@@ -18,13 +18,27 @@ package v1alpha1
 
 import (
 	"context"
+	"encoding/json"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	admissionv1 "k8s.io/api/admission/v1"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	coordinationv1 "k8s.io/api/coordination/v1"
 	corev1 "k8s.io/api/core/v1"
+	schedulingv1 "k8s.io/api/scheduling/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	chaosmetrics "github.com/neogan74/k8s-chaos/internal/metrics"
+	cronschedule "github.com/neogan74/k8s-chaos/internal/schedule"
 )
 
 func TestChaosExperimentWebhook_ValidateCreate(t *testing.T) {
@@ -242,7 +256,135 @@ func TestChaosExperimentWebhook_ValidateCreate(t *testing.T) {
 				},
 			},
 			wantErr:     true,
-			errContains: "duration must match pattern",
+			errContains: `invalid duration "invalid"`,
+		},
+		{
+			name: "duration with trailing junk",
+			experiment: &ChaosExperiment{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-experiment",
+					Namespace: "default",
+				},
+				Spec: ChaosExperimentSpec{
+					Action:    "pod-delay",
+					Namespace: "test-ns",
+					Selector:  map[string]string{"app": "test"},
+					Count:     1,
+					Duration:  "5x30s",
+				},
+			},
+			objects: []client.Object{
+				&corev1.Namespace{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "test-ns",
+					},
+				},
+				&corev1.Pod{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "test-pod-1",
+						Namespace: "test-ns",
+						Labels:    map[string]string{"app": "test"},
+					},
+				},
+			},
+			wantErr:     true,
+			errContains: `unit "x" is not one of ms, s, m or h at offset 1`,
+		},
+		{
+			name: "pod-delay duration in milliseconds",
+			experiment: &ChaosExperiment{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-experiment",
+					Namespace: "default",
+				},
+				Spec: ChaosExperimentSpec{
+					Action:    "pod-delay",
+					Namespace: "test-ns",
+					Selector:  map[string]string{"app": "test"},
+					Count:     1,
+					Duration:  "250ms",
+				},
+			},
+			objects: []client.Object{
+				&corev1.Namespace{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "test-ns",
+					},
+				},
+				&corev1.Pod{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "test-pod-1",
+						Namespace: "test-ns",
+						Labels:    map[string]string{"app": "test"},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "milliseconds only for pod-delay",
+			experiment: &ChaosExperiment{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-experiment",
+					Namespace: "default",
+				},
+				Spec: ChaosExperimentSpec{
+					Action:     "pod-cpu-stress",
+					Namespace:  "test-ns",
+					Selector:   map[string]string{"app": "test"},
+					Count:      1,
+					Duration:   "500ms",
+					CPULoad:    50,
+					CPUWorkers: 1,
+				},
+			},
+			objects: []client.Object{
+				&corev1.Namespace{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "test-ns",
+					},
+				},
+				&corev1.Pod{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "test-pod-1",
+						Namespace: "test-ns",
+						Labels:    map[string]string{"app": "test"},
+					},
+				},
+			},
+			wantErr:     true,
+			errContains: `unit "ms" is not one of s, m or h`,
+		},
+		{
+			name: "experimentDuration in days",
+			experiment: &ChaosExperiment{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-experiment",
+					Namespace: "default",
+				},
+				Spec: ChaosExperimentSpec{
+					Action:             "pod-kill",
+					Namespace:          "test-ns",
+					Selector:           map[string]string{"app": "test"},
+					Count:              1,
+					ExperimentDuration: "2d12h",
+				},
+			},
+			objects: []client.Object{
+				&corev1.Namespace{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "test-ns",
+					},
+				},
+				&corev1.Pod{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "test-pod-1",
+						Namespace: "test-ns",
+						Labels:    map[string]string{"app": "test"},
+					},
+				},
+			},
+			wantErr: false,
 		},
 		{
 			name: "valid experimentDuration",
@@ -307,6 +449,40 @@ func TestChaosExperimentWebhook_ValidateCreate(t *testing.T) {
 			wantErr:     true,
 			errContains: "invalid experimentDuration format",
 		},
+		{
+			name: "temporarily excluded pods only warn",
+			experiment: &ChaosExperiment{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-experiment",
+					Namespace: "default",
+				},
+				Spec: ChaosExperimentSpec{
+					Action:    "pod-kill",
+					Namespace: "test-ns",
+					Selector:  map[string]string{"app": "test"},
+					Count:     1,
+				},
+			},
+			objects: []client.Object{
+				&corev1.Namespace{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "test-ns",
+					},
+				},
+				&corev1.Pod{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "test-pod-1",
+						Namespace: "test-ns",
+						Labels:    map[string]string{"app": "test"},
+						Annotations: map[string]string{
+							ExcludeUntilAnnotation: time.Now().Add(time.Hour).UTC().Format(time.RFC3339),
+						},
+					},
+				},
+			},
+			wantErr:     false,
+			wantWarning: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -345,6 +521,28 @@ func TestChaosExperimentWebhook_ValidateCreate(t *testing.T) {
 	}
 }
 
+func TestIsExcludedUntil(t *testing.T) {
+	now := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		want        bool
+	}{
+		{name: "no annotation", want: false},
+		{name: "future", annotations: map[string]string{ExcludeUntilAnnotation: "2026-05-01T13:00:00Z"}, want: true},
+		{name: "future with offset", annotations: map[string]string{ExcludeUntilAnnotation: "2026-05-01T14:30:00+02:00"}, want: true},
+		{name: "expired", annotations: map[string]string{ExcludeUntilAnnotation: "2026-05-01T11:59:59Z"}, want: false},
+		{name: "unparsable stays excluded", annotations: map[string]string{ExcludeUntilAnnotation: "next week"}, want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsExcludedUntil(tt.annotations, now); got != tt.want {
+				t.Errorf("IsExcludedUntil() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestChaosExperimentWebhook_ValidateUpdate(t *testing.T) {
 	scheme := runtime.NewScheme()
 	_ = corev1.AddToScheme(scheme)
@@ -406,6 +604,65 @@ func TestChaosExperimentWebhook_ValidateUpdate(t *testing.T) {
 	}
 }
 
+func TestChaosExperimentWebhook_ImmutableFields(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = corev1.AddToScheme(scheme)
+	_ = AddToScheme(scheme)
+
+	webhook := &ChaosExperimentWebhook{
+		Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+			&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "test-ns"}},
+			&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "other-ns"}},
+			&corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "test-pod-1", Namespace: "test-ns", Labels: map[string]string{"app": "test"}}},
+			&corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "other-pod-1", Namespace: "other-ns", Labels: map[string]string{"app": "test"}}},
+		).Build(),
+	}
+	experiment := func(action, namespace, phase string) *ChaosExperiment {
+		exp := &ChaosExperiment{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-experiment", Namespace: "default"},
+			Spec: ChaosExperimentSpec{
+				Action:    action,
+				Namespace: namespace,
+				Selector:  map[string]string{"app": "test"},
+				Count:     1,
+			},
+			Status: ChaosExperimentStatus{Phase: phase},
+		}
+		if action == "pod-failure" {
+			exp.Spec.Duration = "1m"
+		}
+		return exp
+	}
+
+	tests := []struct {
+		name    string
+		phase   string
+		action  string
+		ns      string
+		wantErr bool
+	}{
+		{name: "same action and namespace while running", phase: "Running", action: "pod-kill", ns: "test-ns"},
+		{name: "action change while running", phase: "Running", action: "pod-failure", ns: "test-ns", wantErr: true},
+		{name: "namespace change while paused", phase: "Paused", action: "pod-kill", ns: "other-ns", wantErr: true},
+		{name: "action change while pending", phase: "Pending", action: "pod-failure", ns: "test-ns", wantErr: true},
+		{name: "action change before first run", phase: "", action: "pod-failure", ns: "test-ns"},
+		{name: "action change after completion", phase: "Completed", action: "pod-failure", ns: "test-ns"},
+		{name: "namespace change after failure", phase: "Failed", action: "pod-kill", ns: "other-ns"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			oldExp := experiment("pod-kill", "test-ns", tt.phase)
+			newExp := experiment(tt.action, tt.ns, tt.phase)
+
+			_, err := webhook.ValidateUpdate(context.Background(), oldExp, newExp)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateUpdate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
 func TestChaosExperimentWebhook_ValidateDelete(t *testing.T) {
 	scheme := runtime.NewScheme()
 	_ = corev1.AddToScheme(scheme)
@@ -452,3 +709,1587 @@ func findSubstring(s, substr string) bool {
 	}
 	return false
 }
+
+func TestChaosExperimentWebhook_Freeze(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = corev1.AddToScheme(scheme)
+	_ = AddToScheme(scheme)
+
+	exp := &ChaosExperiment{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-experiment", Namespace: "default"},
+		Spec: ChaosExperimentSpec{
+			Action:    "pod-kill",
+			Namespace: "test-ns",
+			Selector:  map[string]string{"app": "test"},
+			Count:     1,
+		},
+	}
+	baseObjects := []client.Object{
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "test-ns"}},
+		&corev1.Pod{ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-pod-1",
+			Namespace: "test-ns",
+			Labels:    map[string]string{"app": "test"},
+		}},
+	}
+	expired := metav1.NewTime(time.Now().Add(-time.Hour))
+
+	tests := []struct {
+		name          string
+		freeze        *ChaosFreeze
+		wantCreateErr bool
+	}{
+		{
+			name:          "no freeze",
+			wantCreateErr: false,
+		},
+		{
+			name: "active freeze blocks create",
+			freeze: &ChaosFreeze{
+				ObjectMeta: metav1.ObjectMeta{Name: "incident-42"},
+				Spec:       ChaosFreezeSpec{Reason: "incident 42"},
+			},
+			wantCreateErr: true,
+		},
+		{
+			name: "expired freeze is ignored",
+			freeze: &ChaosFreeze{
+				ObjectMeta: metav1.ObjectMeta{Name: "old-freeze"},
+				Spec:       ChaosFreezeSpec{ExpiresAt: &expired},
+			},
+			wantCreateErr: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			objects := append([]client.Object{}, baseObjects...)
+			if tt.freeze != nil {
+				objects = append(objects, tt.freeze)
+			}
+			webhook := &ChaosExperimentWebhook{
+				Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(objects...).Build(),
+			}
+
+			_, err := webhook.ValidateCreate(context.Background(), exp)
+			if (err != nil) != tt.wantCreateErr {
+				t.Errorf("ValidateCreate() error = %v, wantErr %v", err, tt.wantCreateErr)
+			}
+
+			// Updates must stay possible so experiments can be paused during a freeze
+			if _, err := webhook.ValidateUpdate(context.Background(), exp, exp); err != nil {
+				t.Errorf("ValidateUpdate() unexpected error during freeze: %v", err)
+			}
+		})
+	}
+}
+
+func TestChaosExperimentWebhook_NodeActions(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = corev1.AddToScheme(scheme)
+	_ = AddToScheme(scheme)
+
+	node := func(name string, labels map[string]string) *corev1.Node {
+		return &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: name, Labels: labels}}
+	}
+	webhook := &ChaosExperimentWebhook{
+		Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+			&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "test-ns"}},
+			node("worker-1", map[string]string{"pool": "spot"}),
+			node("worker-2", map[string]string{"pool": "spot"}),
+			node("cp-1", map[string]string{"pool": "system", NodeRoleControlPlaneLabel: ""}),
+			node("cp-2", map[string]string{"pool": "system", NodeRoleMasterLabel: ""}),
+		).Build(),
+	}
+
+	tests := []struct {
+		name              string
+		action            string
+		selector          map[string]string
+		count             int
+		maxNodes          int
+		maxPercentage     int
+		allowControlPlane bool
+		wantErr           bool
+		wantWarning       string
+	}{
+		{name: "matches worker nodes", action: "node-drain", selector: map[string]string{"pool": "spot"}, count: 1},
+		{name: "no matching nodes", action: "node-drain", selector: map[string]string{"pool": "gpu"}, count: 1, wantErr: true},
+		{
+			name: "count exceeds nodes", action: "node-drain", selector: map[string]string{"pool": "spot"}, count: 3,
+			wantWarning: "Count (3) exceeds number of eligible nodes",
+		},
+		{name: "count within maxNodes", action: "node-drain", selector: map[string]string{"pool": "spot"}, count: 2, maxNodes: 2},
+		{name: "count exceeds maxNodes", action: "node-drain", selector: map[string]string{"pool": "spot"}, count: 2, maxNodes: 1, wantErr: true},
+		{name: "count exceeds maxPercentage", action: "node-drain", selector: map[string]string{"pool": "spot"}, count: 2, maxPercentage: 50, wantErr: true},
+		{name: "drain of control-plane only", action: "node-drain", selector: map[string]string{"pool": "system"}, count: 1, wantErr: true},
+		{
+			name: "drain skips control-plane", action: "node-drain", selector: map[string]string{}, count: 1,
+			wantWarning: "Selector matches 2 control-plane node(s) (cp-1, cp-2), which node-drain skips",
+		},
+		{
+			name: "drain allowed on control-plane", action: "node-drain", selector: map[string]string{"pool": "system"}, count: 1,
+			allowControlPlane: true, wantWarning: "Selector matches 2 control-plane node(s)",
+		},
+		{
+			name: "stress on control-plane", action: "node-cpu-stress", selector: map[string]string{"pool": "system"}, count: 1,
+			wantWarning: "node-cpu-stress on them can disrupt the whole cluster",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exp := &ChaosExperiment{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-experiment", Namespace: "default"},
+				Spec: ChaosExperimentSpec{
+					Action:            tt.action,
+					Namespace:         "test-ns",
+					Selector:          tt.selector,
+					Count:             tt.count,
+					MaxNodes:          tt.maxNodes,
+					MaxPercentage:     tt.maxPercentage,
+					AllowControlPlane: tt.allowControlPlane,
+				},
+			}
+			if tt.action != "node-drain" {
+				exp.Spec.Duration = "1m"
+			}
+			if tt.action == "node-cpu-stress" {
+				exp.Spec.CPULoad = 50
+			}
+
+			warnings, err := webhook.ValidateCreate(context.Background(), exp)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ValidateCreate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantWarning == "" {
+				if !tt.wantErr && len(warnings) != 0 {
+					t.Errorf("unexpected warnings %v", warnings)
+				}
+				return
+			}
+			found := false
+			for _, w := range warnings {
+				if strings.Contains(w, tt.wantWarning) {
+					found = true
+				}
+			}
+			if !found {
+				t.Errorf("expected warning containing %q, got %v", tt.wantWarning, warnings)
+			}
+		})
+	}
+}
+
+func TestChaosExperimentWebhook_JobPods(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = corev1.AddToScheme(scheme)
+	_ = AddToScheme(scheme)
+
+	jobPod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{
+		Name:      "report-29312-abcde",
+		Namespace: "test-ns",
+		Labels:    map[string]string{"app": "test"},
+		OwnerReferences: []metav1.OwnerReference{{
+			APIVersion: "batch/v1", Kind: "Job", Name: "report-29312", UID: "report-29312", Controller: ptr.To(true),
+		}},
+	}}
+	webhook := &ChaosExperimentWebhook{
+		Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+			&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "test-ns"}},
+			jobPod,
+			&corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "web-1", Namespace: "test-ns", Labels: map[string]string{"app": "test"}}},
+		).Build(),
+	}
+	exp := &ChaosExperiment{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-experiment", Namespace: "default"},
+		Spec: ChaosExperimentSpec{
+			Action:    "pod-kill",
+			Namespace: "test-ns",
+			Selector:  map[string]string{"app": "test"},
+			Count:     1,
+		},
+	}
+
+	warnings, err := webhook.ValidateCreate(context.Background(), exp)
+	if err != nil {
+		t.Fatalf("ValidateCreate() error = %v", err)
+	}
+	if len(warnings) != 1 || !strings.Contains(warnings[0], "1 pod(s) are owned by Jobs") {
+		t.Errorf("expected a warning about the skipped Job pod, got %v", warnings)
+	}
+	if got := warningReason(warnings[0]); got != webhookReasonJobPods {
+		t.Errorf("warningReason() = %q, want %q", got, webhookReasonJobPods)
+	}
+
+	exp.Spec.IncludeJobPods = true
+	warnings, err = webhook.ValidateCreate(context.Background(), exp)
+	if err != nil || len(warnings) != 0 {
+		t.Errorf("expected no warnings with includeJobPods, got %v, %v", warnings, err)
+	}
+}
+
+func TestChaosExperimentWebhook_LeaseSteal(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = corev1.AddToScheme(scheme)
+	_ = coordinationv1.AddToScheme(scheme)
+	_ = AddToScheme(scheme)
+
+	newWebhook := func(holder string) *ChaosExperimentWebhook {
+		objs := []client.Object{
+			&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "ops"}},
+			&corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "billing-operator-5c8d7", Namespace: "ops", Labels: map[string]string{"app": "billing-operator"}}},
+		}
+		if holder != "" {
+			objs = append(objs, &coordinationv1.Lease{
+				ObjectMeta: metav1.ObjectMeta{Name: "billing-operator-leader", Namespace: "ops"},
+				Spec:       coordinationv1.LeaseSpec{HolderIdentity: ptr.To(holder)},
+			})
+		}
+		return &ChaosExperimentWebhook{Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).Build()}
+	}
+	experiment := func(mutate func(*ChaosExperimentSpec)) *ChaosExperiment {
+		exp := &ChaosExperiment{
+			ObjectMeta: metav1.ObjectMeta{Name: "operator-failover", Namespace: "default"},
+			Spec: ChaosExperimentSpec{
+				Action:    "lease-steal",
+				Namespace: "ops",
+				Selector:  map[string]string{"app": "billing-operator"},
+				LeaseName: "billing-operator-leader",
+				Duration:  "30s",
+			},
+		}
+		mutate(&exp.Spec)
+		return exp
+	}
+
+	tests := []struct {
+		name        string
+		holder      string
+		mutate      func(*ChaosExperimentSpec)
+		wantErr     string
+		wantWarning string
+	}{
+		{name: "held by a candidate", holder: "billing-operator-5c8d7_0f1e2d", mutate: func(*ChaosExperimentSpec) {}},
+		{
+			name:    "leaseName required",
+			holder:  "billing-operator-5c8d7",
+			mutate:  func(s *ChaosExperimentSpec) { s.LeaseName = "" },
+			wantErr: "leaseName must be specified",
+		},
+		{
+			name:    "duration required to acquire",
+			holder:  "billing-operator-5c8d7",
+			mutate:  func(s *ChaosExperimentSpec) { s.Duration = "" },
+			wantErr: "duration is required for lease-steal action",
+		},
+		{
+			name:   "delete needs no duration",
+			holder: "billing-operator-5c8d7",
+			mutate: func(s *ChaosExperimentSpec) { s.Duration = ""; s.LeaseMode = "Delete" },
+		},
+		{
+			name:    "leaseName on another action",
+			holder:  "billing-operator-5c8d7",
+			mutate:  func(s *ChaosExperimentSpec) { s.Action = "pod-kill" },
+			wantErr: "leaseName and leaseMode are only supported for lease-steal action",
+		},
+		{
+			name:        "missing lease",
+			mutate:      func(*ChaosExperimentSpec) {},
+			wantWarning: "Lease ops/billing-operator-leader not found",
+		},
+		{
+			name:        "held by another workload",
+			holder:      "payments-operator-7f6e5",
+			mutate:      func(*ChaosExperimentSpec) {},
+			wantWarning: `held by "payments-operator-7f6e5", which is not one of the pods matching the selector`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			warnings, err := newWebhook(tt.holder).ValidateCreate(context.Background(), experiment(tt.mutate))
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("ValidateCreate() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ValidateCreate() error = %v", err)
+			}
+			if tt.wantWarning == "" {
+				if len(warnings) != 0 {
+					t.Errorf("expected no warnings, got %v", warnings)
+				}
+				return
+			}
+			if len(warnings) != 1 || !strings.Contains(warnings[0], tt.wantWarning) {
+				t.Fatalf("expected warning %q, got %v", tt.wantWarning, warnings)
+			}
+			if got := warningReason(warnings[0]); got != webhookReasonLease {
+				t.Errorf("warningReason() = %q, want %q", got, webhookReasonLease)
+			}
+		})
+	}
+}
+
+func TestChaosExperimentWebhook_SchedulerPressure(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = corev1.AddToScheme(scheme)
+	_ = schedulingv1.AddToScheme(scheme)
+	_ = AddToScheme(scheme)
+
+	newWebhook := func(classValue int32) *ChaosExperimentWebhook {
+		objs := []client.Object{
+			&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "batch"}},
+			&corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{Name: "reports-7f9c", Namespace: "batch", Labels: map[string]string{"app": "reports"}},
+				Spec:       corev1.PodSpec{Priority: ptr.To[int32](100)},
+			},
+		}
+		if classValue != 0 {
+			objs = append(objs, &schedulingv1.PriorityClass{ObjectMeta: metav1.ObjectMeta{Name: "chaos-balloon"}, Value: classValue})
+		}
+		return &ChaosExperimentWebhook{Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).Build()}
+	}
+	experiment := func(mutate func(*ChaosExperimentSpec)) *ChaosExperiment {
+		exp := &ChaosExperiment{
+			ObjectMeta: metav1.ObjectMeta{Name: "batch-preemption", Namespace: "default"},
+			Spec: ChaosExperimentSpec{
+				Action:    "scheduler-pressure",
+				Namespace: "batch",
+				Selector:  map[string]string{"app": "reports"},
+				Duration:  "5m",
+				Balloons:  &Balloons{PriorityClassName: "chaos-balloon", Replicas: 2},
+			},
+		}
+		mutate(&exp.Spec)
+		return exp
+	}
+
+	tests := []struct {
+		name        string
+		classValue  int32
+		mutate      func(*ChaosExperimentSpec)
+		wantErr     string
+		wantWarning string
+	}{
+		{name: "preempts lower-priority pods", classValue: 1000, mutate: func(*ChaosExperimentSpec) {}},
+		{
+			name:       "duration required",
+			classValue: 1000,
+			mutate:     func(s *ChaosExperimentSpec) { s.Duration = "" },
+			wantErr:    "duration is required for scheduler-pressure action",
+		},
+		{
+			name:       "priorityClassName required",
+			classValue: 1000,
+			mutate:     func(s *ChaosExperimentSpec) { s.Balloons = nil },
+			wantErr:    "balloons.priorityClassName must be specified",
+		},
+		{
+			name:       "zero cpu",
+			classValue: 1000,
+			mutate:     func(s *ChaosExperimentSpec) { s.Balloons.CPU = "0" },
+			wantErr:    "balloons.cpu must be a positive quantity, got: 0",
+		},
+		{
+			name:       "balloons on another action",
+			classValue: 1000,
+			mutate:     func(s *ChaosExperimentSpec) { s.Action = "pod-kill" },
+			wantErr:    "balloons is only supported for scheduler-pressure action",
+		},
+		{
+			name:        "missing PriorityClass",
+			mutate:      func(*ChaosExperimentSpec) {},
+			wantWarning: `PriorityClass "chaos-balloon" not found`,
+		},
+		{
+			name:        "PriorityClass not above the workload",
+			classValue:  100,
+			mutate:      func(*ChaosExperimentSpec) {},
+			wantWarning: "is not above the priority of any of the 1 pod(s) matching the selector",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			warnings, err := newWebhook(tt.classValue).ValidateCreate(context.Background(), experiment(tt.mutate))
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("ValidateCreate() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ValidateCreate() error = %v", err)
+			}
+			if tt.wantWarning == "" {
+				if len(warnings) != 0 {
+					t.Errorf("expected no warnings, got %v", warnings)
+				}
+				return
+			}
+			if len(warnings) != 1 || !strings.Contains(warnings[0], tt.wantWarning) {
+				t.Fatalf("expected warning %q, got %v", tt.wantWarning, warnings)
+			}
+			if got := warningReason(warnings[0]); got != webhookReasonPriorityClass {
+				t.Errorf("warningReason() = %q, want %q", got, webhookReasonPriorityClass)
+			}
+		})
+	}
+}
+
+func TestChaosExperimentWebhook_QuotaSqueeze(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = corev1.AddToScheme(scheme)
+	_ = AddToScheme(scheme)
+
+	newWebhook := func(withQuota bool) *ChaosExperimentWebhook {
+		objs := []client.Object{
+			&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "shop"}},
+			&corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "checkout-5d8f-x2x9q", Namespace: "shop", Labels: map[string]string{"app": "checkout"}}},
+		}
+		if withQuota {
+			objs = append(objs, &corev1.ResourceQuota{
+				ObjectMeta: metav1.ObjectMeta{Name: "compute", Namespace: "shop"},
+				Spec:       corev1.ResourceQuotaSpec{Hard: corev1.ResourceList{corev1.ResourcePods: resource.MustParse("10")}},
+			})
+		}
+		return &ChaosExperimentWebhook{Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).Build()}
+	}
+	experiment := func(mutate func(*ChaosExperimentSpec)) *ChaosExperiment {
+		exp := &ChaosExperiment{
+			ObjectMeta: metav1.ObjectMeta{Name: "checkout-quota", Namespace: "default"},
+			Spec: ChaosExperimentSpec{
+				Action:    "quota-squeeze",
+				Namespace: "shop",
+				Selector:  map[string]string{"app": "checkout"},
+				Duration:  "10m",
+			},
+		}
+		mutate(&exp.Spec)
+		return exp
+	}
+
+	tests := []struct {
+		name        string
+		withQuota   bool
+		mutate      func(*ChaosExperimentSpec)
+		wantErr     string
+		wantWarning string
+	}{
+		{name: "every quota in the namespace", withQuota: true, mutate: func(*ChaosExperimentSpec) {}},
+		{
+			name:      "duration required",
+			withQuota: true,
+			mutate:    func(s *ChaosExperimentSpec) { s.Duration = "" },
+			wantErr:   "duration is required for quota-squeeze action",
+		},
+		{
+			name:      "quotaSqueeze on another action",
+			withQuota: true,
+			mutate:    func(s *ChaosExperimentSpec) { s.Action = "pod-kill"; s.QuotaSqueeze = &QuotaSqueeze{Name: "compute"} },
+			wantErr:   "quotaSqueeze is only supported for quota-squeeze action",
+		},
+		{
+			name:        "no quota in the namespace",
+			mutate:      func(*ChaosExperimentSpec) {},
+			wantWarning: "ResourceQuota not found in namespace shop",
+		},
+		{
+			name:        "named quota missing",
+			withQuota:   true,
+			mutate:      func(s *ChaosExperimentSpec) { s.QuotaSqueeze = &QuotaSqueeze{Name: "storage"} },
+			wantWarning: "ResourceQuota storage not found in namespace shop",
+		},
+		{
+			name:        "resources the quota does not limit",
+			withQuota:   true,
+			mutate:      func(s *ChaosExperimentSpec) { s.QuotaSqueeze = &QuotaSqueeze{Resources: []string{"requests.cpu"}} },
+			wantWarning: "include none of requests.cpu",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			warnings, err := newWebhook(tt.withQuota).ValidateCreate(context.Background(), experiment(tt.mutate))
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("ValidateCreate() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ValidateCreate() error = %v", err)
+			}
+			if tt.wantWarning == "" {
+				if len(warnings) != 0 {
+					t.Errorf("expected no warnings, got %v", warnings)
+				}
+				return
+			}
+			if len(warnings) != 1 || !strings.Contains(warnings[0], tt.wantWarning) {
+				t.Fatalf("expected warning %q, got %v", tt.wantWarning, warnings)
+			}
+			if got := warningReason(warnings[0]); got != webhookReasonResourceQuota {
+				t.Errorf("warningReason() = %q, want %q", got, webhookReasonResourceQuota)
+			}
+		})
+	}
+}
+
+func TestChaosExperimentWebhook_AdmissionDelay(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = corev1.AddToScheme(scheme)
+	_ = AddToScheme(scheme)
+
+	// No pods: the selector matches the objects whose requests are delayed
+	webhook := &ChaosExperimentWebhook{Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "shop"}},
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "prod", Labels: map[string]string{"environment": "production"}}},
+	).Build()}
+	experiment := func(mutate func(*ChaosExperimentSpec)) *ChaosExperiment {
+		exp := &ChaosExperiment{
+			ObjectMeta: metav1.ObjectMeta{Name: "slow-admission", Namespace: "default"},
+			Spec: ChaosExperimentSpec{
+				Action:    "admission-delay",
+				Namespace: "shop",
+				Selector:  map[string]string{"app": "checkout"},
+				Duration:  "10m",
+				AdmissionDelay: &AdmissionDelay{
+					Delay:     "5s",
+					Resources: []string{"configmaps", "deployments.apps"},
+				},
+			},
+		}
+		mutate(&exp.Spec)
+		return exp
+	}
+
+	tests := []struct {
+		name    string
+		mutate  func(*ChaosExperimentSpec)
+		wantErr string
+	}{
+		{name: "valid", mutate: func(*ChaosExperimentSpec) {}},
+		{
+			name:    "duration required",
+			mutate:  func(s *ChaosExperimentSpec) { s.Duration = "" },
+			wantErr: "duration is required for admission-delay action",
+		},
+		{
+			name:    "duration capped",
+			mutate:  func(s *ChaosExperimentSpec) { s.Duration = "2h" },
+			wantErr: "duration must be at most 1h0m0s for admission-delay action",
+		},
+		{
+			name:    "admissionDelay required",
+			mutate:  func(s *ChaosExperimentSpec) { s.AdmissionDelay = nil },
+			wantErr: "admissionDelay must be specified for admission-delay action",
+		},
+		{
+			name:    "delay above the webhook timeout",
+			mutate:  func(s *ChaosExperimentSpec) { s.AdmissionDelay.Delay = "30s" },
+			wantErr: "admissionDelay.delay must be a duration between 1ms and 25s",
+		},
+		{
+			name:    "subresource",
+			mutate:  func(s *ChaosExperimentSpec) { s.AdmissionDelay.Resources = []string{"pods/exec"} },
+			wantErr: "without wildcards or subresources",
+		},
+		{
+			name:    "wildcard",
+			mutate:  func(s *ChaosExperimentSpec) { s.AdmissionDelay.Resources = []string{"*"} },
+			wantErr: "without wildcards or subresources",
+		},
+		{
+			name:    "leases",
+			mutate:  func(s *ChaosExperimentSpec) { s.AdmissionDelay.Resources = []string{"leases.coordination.k8s.io"} },
+			wantErr: "must not include leases.coordination.k8s.io",
+		},
+		{
+			name: "chaos resources",
+			mutate: func(s *ChaosExperimentSpec) {
+				s.AdmissionDelay.Resources = []string{"chaosexperiments.chaos.gushchin.dev"}
+			},
+			wantErr: "must not include chaosexperiments.chaos.gushchin.dev",
+		},
+		{
+			name:    "production namespace",
+			mutate:  func(s *ChaosExperimentSpec) { s.Namespace = "prod" },
+			wantErr: "production",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			warnings, err := webhook.ValidateCreate(context.Background(), experiment(tt.mutate))
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("ValidateCreate() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ValidateCreate() error = %v", err)
+			}
+			if len(warnings) != 0 {
+				t.Errorf("expected no warnings, got %v", warnings)
+			}
+		})
+	}
+
+	podKill := experiment(func(s *ChaosExperimentSpec) { s.Action = "pod-kill" })
+	errs := ValidateSpecStructure(podKill.Name, &podKill.Spec)
+	if len(errs) != 1 || !strings.Contains(errs[0].Error(), "admissionDelay is only supported for admission-delay action") {
+		t.Errorf("ValidateSpecStructure() = %v, want admissionDelay rejected for pod-kill", errs)
+	}
+}
+
+func TestChaosExperimentWebhook_DecisionMetrics(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = corev1.AddToScheme(scheme)
+	_ = AddToScheme(scheme)
+
+	webhook := &ChaosExperimentWebhook{
+		Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+			&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "test-ns"}},
+			&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "prod", Labels: map[string]string{"environment": "production"}}},
+			&corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "test-pod-1", Namespace: "test-ns", Labels: map[string]string{"app": "test"}}},
+			&corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "prod-pod-1", Namespace: "prod", Labels: map[string]string{"app": "test"}}},
+		).Build(),
+	}
+	experiment := func(namespace string, selector map[string]string, count int, dryRun bool) *ChaosExperiment {
+		return &ChaosExperiment{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-experiment", Namespace: "default"},
+			Spec: ChaosExperimentSpec{
+				Action:    "pod-kill",
+				Namespace: namespace,
+				Selector:  selector,
+				Count:     count,
+				DryRun:    dryRun,
+			},
+		}
+	}
+	decisions := func(operation, decision, reason string) float64 {
+		return testutil.ToFloat64(chaosmetrics.WebhookDecisions.WithLabelValues(operation, decision, reason))
+	}
+
+	tests := []struct {
+		name     string
+		exp      *ChaosExperiment
+		decision string
+		reason   string
+		warned   []string
+	}{
+		{
+			name:     "admitted",
+			exp:      experiment("test-ns", map[string]string{"app": "test"}, 1, false),
+			decision: "admitted",
+			reason:   webhookReasonNone,
+		},
+		{
+			name:     "admitted with warnings",
+			exp:      experiment("test-ns", map[string]string{"app": "test"}, 3, true),
+			decision: "admitted",
+			reason:   webhookReasonNone,
+			warned:   []string{webhookReasonCountExceedsPods, webhookReasonDryRun},
+		},
+		{
+			name:     "selector without pods",
+			exp:      experiment("test-ns", map[string]string{"app": "missing"}, 1, false),
+			decision: "denied",
+			reason:   webhookReasonSelectorNoMatch,
+		},
+		{
+			name:     "production namespace",
+			exp:      experiment("prod", map[string]string{"app": "test"}, 1, false),
+			decision: "denied",
+			reason:   webhookReasonProduction,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			before := decisions("create", tt.decision, tt.reason)
+			warnedBefore := make([]float64, len(tt.warned))
+			for i, reason := range tt.warned {
+				warnedBefore[i] = decisions("create", "warned", reason)
+			}
+
+			_, _ = webhook.ValidateCreate(context.Background(), tt.exp)
+
+			if got := decisions("create", tt.decision, tt.reason); got != before+1 {
+				t.Errorf("expected one %s decision with reason %s, got %v", tt.decision, tt.reason, got-before)
+			}
+			for i, reason := range tt.warned {
+				if got := decisions("create", "warned", reason); got != warnedBefore[i]+1 {
+					t.Errorf("expected one warning with reason %s, got %v", reason, got-warnedBefore[i])
+				}
+			}
+		})
+	}
+
+	if got := testutil.CollectAndCount(chaosmetrics.WebhookDuration); got == 0 {
+		t.Error("expected webhook latencies to be observed")
+	}
+}
+
+func TestChaosExperimentWebhook_SeverityRules(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = corev1.AddToScheme(scheme)
+	_ = AddToScheme(scheme)
+
+	policy := &ChaosPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "prod-gate"},
+		Spec: ChaosPolicySpec{
+			Namespaces: []string{"test-ns"},
+			SeverityRules: []SeverityRule{
+				{Severity: SeverityMedium, RequireApproval: true},
+				{Severity: SeverityHigh, Deny: true},
+			},
+		},
+	}
+	objects := []client.Object{
+		policy,
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "test-ns"}},
+		&corev1.Pod{ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-pod-1",
+			Namespace: "test-ns",
+			Labels:    map[string]string{"app": "test"},
+		}},
+	}
+
+	tests := []struct {
+		name        string
+		severity    string
+		dryRun      bool
+		wantErr     bool
+		wantWarning bool
+	}{
+		{name: "low severity passes", severity: SeverityLow},
+		{name: "unset severity counts as medium and needs approval", wantWarning: true},
+		{name: "high severity is denied", severity: SeverityHigh, wantErr: true},
+		{name: "dry runs are not gated", severity: SeverityHigh, dryRun: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exp := &ChaosExperiment{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-experiment", Namespace: "default"},
+				Spec: ChaosExperimentSpec{
+					Action:    "pod-kill",
+					Namespace: "test-ns",
+					Selector:  map[string]string{"app": "test"},
+					Count:     1,
+					Severity:  tt.severity,
+					DryRun:    tt.dryRun,
+				},
+			}
+			webhook := &ChaosExperimentWebhook{
+				Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(objects...).Build(),
+			}
+
+			warnings, err := webhook.ValidateCreate(context.Background(), exp)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ValidateCreate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			gotWarning := false
+			for _, w := range warnings {
+				if strings.Contains(w, "requires approval") {
+					gotWarning = true
+				}
+			}
+			if gotWarning != tt.wantWarning {
+				t.Errorf("approval warning = %v, want %v (warnings: %v)", gotWarning, tt.wantWarning, warnings)
+			}
+		})
+	}
+}
+
+func TestChaosExperimentDefaulter_Default(t *testing.T) {
+	newExp := func(annotations map[string]string) *ChaosExperiment {
+		return &ChaosExperiment{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-experiment", Namespace: "default", Annotations: annotations},
+			Spec:       ChaosExperimentSpec{Action: "pod-kill", Namespace: "test-ns"},
+		}
+	}
+	rawOf := func(exp *ChaosExperiment) []byte {
+		raw, err := json.Marshal(exp)
+		if err != nil {
+			t.Fatalf("marshal: %v", err)
+		}
+		return raw
+	}
+
+	tests := []struct {
+		name          string
+		operation     admissionv1.Operation
+		username      string
+		exp           *ChaosExperiment
+		old           *ChaosExperiment
+		wantInitiator string
+		wantUserAgent string
+		wantApprover  string
+	}{
+		{
+			name:          "create records the requesting user",
+			operation:     admissionv1.Create,
+			username:      "jane@example.com",
+			exp:           newExp(map[string]string{UserAgentAnnotation: "k8s-chaos-cli"}),
+			wantInitiator: "jane@example.com",
+			wantUserAgent: "k8s-chaos-cli",
+		},
+		{
+			name:          "create overrides a forged initiator and infers GitOps agent",
+			operation:     admissionv1.Create,
+			username:      "system:serviceaccount:argocd:argocd-application-controller",
+			exp:           newExp(map[string]string{InitiatedByAnnotation: "someone-else"}),
+			wantInitiator: "system:serviceaccount:argocd:argocd-application-controller",
+			wantUserAgent: "argocd",
+		},
+		{
+			name:          "update keeps the original initiator",
+			operation:     admissionv1.Update,
+			username:      "mallory",
+			exp:           newExp(map[string]string{InitiatedByAnnotation: "mallory"}),
+			old:           newExp(map[string]string{InitiatedByAnnotation: "jane@example.com"}),
+			wantInitiator: "jane@example.com",
+		},
+		{
+			name:      "spec edit by another user makes them the initiator",
+			operation: admissionv1.Update,
+			username:  "mallory",
+			exp: func() *ChaosExperiment {
+				exp := newExp(map[string]string{InitiatedByAnnotation: "jane@example.com"})
+				exp.Spec.Namespace = "kube-system"
+				return exp
+			}(),
+			old:           newExp(map[string]string{InitiatedByAnnotation: "jane@example.com"}),
+			wantInitiator: "mallory",
+		},
+		{
+			name:      "pausing keeps the initiator",
+			operation: admissionv1.Update,
+			username:  "mallory",
+			exp: func() *ChaosExperiment {
+				exp := newExp(map[string]string{InitiatedByAnnotation: "jane@example.com"})
+				exp.Spec.Paused = true
+				return exp
+			}(),
+			old:           newExp(map[string]string{InitiatedByAnnotation: "jane@example.com"}),
+			wantInitiator: "jane@example.com",
+		},
+		{
+			name:          "update records who approved, whatever the value",
+			operation:     admissionv1.Update,
+			username:      "lead@example.com",
+			exp:           newExp(map[string]string{InitiatedByAnnotation: "jane@example.com", ApprovedByAnnotation: "yes"}),
+			old:           newExp(map[string]string{InitiatedByAnnotation: "jane@example.com"}),
+			wantInitiator: "jane@example.com",
+			wantApprover:  "lead@example.com",
+		},
+		{
+			name:      "unrelated update keeps the approver",
+			operation: admissionv1.Update,
+			username:  "jane@example.com",
+			exp: newExp(map[string]string{
+				InitiatedByAnnotation: "jane@example.com", ApprovedByAnnotation: "lead@example.com",
+			}),
+			old: newExp(map[string]string{
+				InitiatedByAnnotation: "jane@example.com", ApprovedByAnnotation: "lead@example.com",
+			}),
+			wantInitiator: "jane@example.com",
+			wantApprover:  "lead@example.com",
+		},
+		{
+			name:          "create cannot approve for someone else",
+			operation:     admissionv1.Create,
+			username:      "jane@example.com",
+			exp:           newExp(map[string]string{ApprovedByAnnotation: "lead@example.com"}),
+			wantInitiator: "jane@example.com",
+			wantApprover:  "jane@example.com",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := admission.Request{AdmissionRequest: admissionv1.AdmissionRequest{
+				Operation: tt.operation,
+				UserInfo:  authenticationv1.UserInfo{Username: tt.username},
+			}}
+			if tt.old != nil {
+				req.OldObject = runtime.RawExtension{Raw: rawOf(tt.old)}
+			}
+			ctx := admission.NewContextWithRequest(context.Background(), req)
+
+			if err := (&ChaosExperimentDefaulter{}).Default(ctx, tt.exp); err != nil {
+				t.Fatalf("Default() unexpected error: %v", err)
+			}
+			if got := tt.exp.Annotations[InitiatedByAnnotation]; got != tt.wantInitiator {
+				t.Errorf("initiated-by = %q, want %q", got, tt.wantInitiator)
+			}
+			if got := tt.exp.Annotations[UserAgentAnnotation]; got != tt.wantUserAgent {
+				t.Errorf("user-agent = %q, want %q", got, tt.wantUserAgent)
+			}
+			if got := tt.exp.Annotations[ApprovedByAnnotation]; got != tt.wantApprover {
+				t.Errorf("approved-by = %q, want %q", got, tt.wantApprover)
+			}
+		})
+	}
+}
+
+func TestSetNextRunsAnnotation(t *testing.T) {
+	// Friday 2026-10-16 at noon
+	now := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+	jitterOffset := cronschedule.Offset("default/nightly", 30*time.Minute)
+
+	tests := []struct {
+		name      string
+		expName   string
+		schedule  string
+		schedules []string
+		exclude   []ScheduleExclusion
+		jitter    string
+		want      string
+	}{
+		{
+			name:     "weekday schedule",
+			expName:  "nightly",
+			schedule: "0 3 * * 1-5",
+			want:     "Mon 2026-10-19 03:00 UTC, Tue 2026-10-20 03:00 UTC, Wed 2026-10-21 03:00 UTC",
+		},
+		{
+			name:     "jitter shifts every run by the offset of the experiment",
+			expName:  "nightly",
+			schedule: "@daily",
+			jitter:   "30m",
+			want: strings.Join([]string{
+				time.Date(2026, 10, 17, 0, 0, 0, 0, time.UTC).Add(jitterOffset).Format("Mon 2006-01-02 15:04 MST"),
+				time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC).Add(jitterOffset).Format("Mon 2006-01-02 15:04 MST"),
+				time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC).Add(jitterOffset).Format("Mon 2006-01-02 15:04 MST"),
+			}, ", "),
+		},
+		{
+			name:     "jitter of a generated name is not known yet",
+			schedule: "@daily",
+			jitter:   "30m",
+			want: "Sat 2026-10-17 00:00 UTC, Sun 2026-10-18 00:00 UTC, Mon 2026-10-19 00:00 UTC " +
+				"(each delayed by up to 30m)",
+		},
+		{
+			name:      "further schedules and a deploy window",
+			expName:   "nightly",
+			schedule:  "0 3 * * 1-5",
+			schedules: []string{"0 15 * * 6"},
+			exclude:   []ScheduleExclusion{{Schedule: "0 2 * * 1", Duration: "2h"}},
+			want:      "Sat 2026-10-17 15:00 UTC, Tue 2026-10-20 03:00 UTC, Wed 2026-10-21 03:00 UTC",
+		},
+		{
+			name:     "schedule that never fires",
+			expName:  "nightly",
+			schedule: "0 0 30 2 *",
+			want:     "never",
+		},
+		{
+			name:    "no schedule removes the preview",
+			expName: "nightly",
+		},
+		{
+			name:     "invalid schedule removes the preview",
+			expName:  "nightly",
+			schedule: "every night",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exp := &ChaosExperiment{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: tt.expName, Namespace: "default",
+					Annotations: map[string]string{NextRunsAnnotation: "stale"},
+				},
+				Spec: ChaosExperimentSpec{
+					Action: "pod-kill", Schedule: tt.schedule, Schedules: tt.schedules,
+					ExcludeSchedules: tt.exclude, ScheduleJitter: tt.jitter,
+				},
+			}
+			setNextRunsAnnotation(exp, now)
+			if got := exp.Annotations[NextRunsAnnotation]; got != tt.want {
+				t.Errorf("next-runs = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateSpecStructure_Schedules(t *testing.T) {
+	tests := []struct {
+		name      string
+		spec      ChaosExperimentSpec
+		wantField string
+		wantMsg   string
+	}{
+		{
+			name: "schedules alone with an exclusion",
+			spec: ChaosExperimentSpec{
+				Schedules:        []string{"@hourly", "0 9 * * 6"},
+				ExcludeSchedules: []ScheduleExclusion{{Schedule: "0 14 * * 3", Duration: "2h"}},
+				ScheduleJitter:   "5m",
+			},
+		},
+		{
+			name:      "invalid further schedule",
+			spec:      ChaosExperimentSpec{Schedule: "@daily", Schedules: []string{"@hourly", "every tuesday"}},
+			wantField: "spec.schedules[1]",
+			wantMsg:   `invalid cron schedule "every tuesday"`,
+		},
+		{
+			name:      "exclusions need a schedule",
+			spec:      ChaosExperimentSpec{ExcludeSchedules: []ScheduleExclusion{{Schedule: "0 14 * * 3"}}},
+			wantField: "spec.excludeSchedules",
+			wantMsg:   "excludeSchedules requires schedule or schedules",
+		},
+		{
+			name: "invalid exclusion duration",
+			spec: ChaosExperimentSpec{
+				Schedule:         "@hourly",
+				ExcludeSchedules: []ScheduleExclusion{{Schedule: "0 14 * * 3", Duration: "2x"}},
+			},
+			wantField: "spec.excludeSchedules[0].duration",
+			wantMsg:   `unit "x"`,
+		},
+		{
+			name:      "jitter with schedules only",
+			spec:      ChaosExperimentSpec{ScheduleJitter: "5m"},
+			wantField: "spec.scheduleJitter",
+			wantMsg:   "scheduleJitter requires schedule or schedules",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			spec := tt.spec
+			spec.Action, spec.Namespace, spec.Selector = "pod-kill", "default", map[string]string{"app": "test"}
+			var got *ValidationError
+			for _, e := range ValidateSpecStructure("test-experiment", &spec) {
+				if strings.HasPrefix(e.Field, "spec.schedule") || strings.HasPrefix(e.Field, "spec.excludeSchedules") {
+					got = &e
+					break
+				}
+			}
+			switch {
+			case tt.wantField == "" && got != nil:
+				t.Errorf("unexpected error on %s: %s", got.Field, got.Message)
+			case tt.wantField != "" && got == nil:
+				t.Errorf("expected an error on %s", tt.wantField)
+			case tt.wantField != "" && (got.Field != tt.wantField || !strings.Contains(got.Message, tt.wantMsg)):
+				t.Errorf("expected %s: ...%s..., got %s: %s", tt.wantField, tt.wantMsg, got.Field, got.Message)
+			}
+		})
+	}
+}
+
+func TestChaosExperimentDefaulter_NextRunsOnUpdate(t *testing.T) {
+	exp := &ChaosExperiment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "nightly", Namespace: "default",
+			Annotations: map[string]string{NextRunsAnnotation: "Mon 2026-10-19 03:00 UTC"},
+		},
+		Spec: ChaosExperimentSpec{Action: "pod-kill", Namespace: "test-ns", Schedule: "0 3 * * *"},
+	}
+	old := exp.DeepCopy()
+	raw, err := json.Marshal(old)
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+	ctx := admission.NewContextWithRequest(context.Background(), admission.Request{
+		AdmissionRequest: admissionv1.AdmissionRequest{
+			Operation: admissionv1.Update,
+			OldObject: runtime.RawExtension{Raw: raw},
+		},
+	})
+
+	// An unrelated change keeps the preview
+	exp.Spec.Paused = true
+	if err := (&ChaosExperimentDefaulter{}).Default(ctx, exp); err != nil {
+		t.Fatalf("Default() unexpected error: %v", err)
+	}
+	if got := exp.Annotations[NextRunsAnnotation]; got != "Mon 2026-10-19 03:00 UTC" {
+		t.Errorf("expected the preview to be kept, got %q", got)
+	}
+
+	// A new schedule is previewed again
+	exp.Spec.Schedule = "@hourly"
+	if err := (&ChaosExperimentDefaulter{}).Default(ctx, exp); err != nil {
+		t.Fatalf("Default() unexpected error: %v", err)
+	}
+	if got := exp.Annotations[NextRunsAnnotation]; got == "Mon 2026-10-19 03:00 UTC" || strings.Count(got, ", ") != 2 {
+		t.Errorf("expected three hourly runs, got %q", got)
+	}
+}
+
+func TestValidateSpecStructure(t *testing.T) {
+	spec := &ChaosExperimentSpec{
+		Action:             "pod-delay",
+		Namespace:          "default",
+		Selector:           map[string]string{"app": "test"},
+		DependsOn:          []string{"self"},
+		ExperimentDuration: "forever",
+	}
+
+	errs := ValidateSpecStructure("self", spec)
+	fields := map[string]bool{}
+	for _, e := range errs {
+		fields[e.Field] = true
+	}
+	for _, want := range []string{"spec.dependsOn", "spec.experimentDuration", "spec"} {
+		if !fields[want] {
+			t.Errorf("expected a validation error for %s, got %v", want, errs)
+		}
+	}
+
+	spec.DependsOn = nil
+	spec.ExperimentDuration = ""
+	spec.Duration = "30s"
+	if errs := ValidateSpecStructure("self", spec); len(errs) != 0 {
+		t.Errorf("expected valid spec, got %v", errs)
+	}
+
+	spec.AutoscalerPolicy = "Observe"
+	if errs := ValidateSpecStructure("self", spec); len(errs) != 1 || errs[0].Field != "spec.autoscalerPolicy" {
+		t.Errorf("expected autoscalerPolicy to be rejected for pod-delay, got %v", errs)
+	}
+}
+
+func TestValidateSpecStructure_Interval(t *testing.T) {
+	spec := &ChaosExperimentSpec{
+		Action:    "pod-cpu-stress",
+		Namespace: "default",
+		Selector:  map[string]string{"app": "test"},
+		Duration:  "5m",
+		CPULoad:   50,
+		Interval:  "1h30m",
+	}
+	if errs := ValidateSpecStructure("stress", spec); len(errs) != 0 {
+		t.Errorf("expected valid spec, got %v", errs)
+	}
+
+	spec.Interval = "2m"
+	if errs := ValidateSpecStructure("stress", spec); len(errs) != 1 || errs[0].Field != "spec.interval" ||
+		!strings.Contains(errs[0].Message, "must be at least duration") {
+		t.Errorf("expected an interval shorter than duration to be rejected, got %v", errs)
+	}
+
+	spec.Interval = "often"
+	if errs := ValidateSpecStructure("stress", spec); len(errs) != 1 || !strings.Contains(errs[0].Message, "invalid interval format") {
+		t.Errorf("expected a malformed interval to be rejected, got %v", errs)
+	}
+
+	// Actions without duration accept any interval
+	spec = &ChaosExperimentSpec{Action: "pod-kill", Namespace: "default", Interval: "10s"}
+	if errs := ValidateSpecStructure("kill", spec); len(errs) != 0 {
+		t.Errorf("expected valid spec, got %v", errs)
+	}
+}
+
+func TestValidateSpecStructure_ScheduleJitter(t *testing.T) {
+	spec := &ChaosExperimentSpec{
+		Action:         "pod-kill",
+		Namespace:      "default",
+		Schedule:       "@hourly",
+		ScheduleJitter: "10m",
+	}
+	if errs := ValidateSpecStructure("jitter", spec); len(errs) != 0 {
+		t.Errorf("expected valid spec, got %v", errs)
+	}
+
+	spec.ScheduleJitter = "a while"
+	if errs := ValidateSpecStructure("jitter", spec); len(errs) != 1 || !strings.Contains(errs[0].Message, "invalid scheduleJitter format") {
+		t.Errorf("expected a malformed jitter to be rejected, got %v", errs)
+	}
+
+	spec.Schedule = ""
+	spec.ScheduleJitter = "10m"
+	if errs := ValidateSpecStructure("jitter", spec); len(errs) != 1 || errs[0].Field != "spec.scheduleJitter" {
+		t.Errorf("expected jitter without a schedule to be rejected, got %v", errs)
+	}
+}
+
+func TestValidateSpecStructure_DiskFillReserve(t *testing.T) {
+	spec := &ChaosExperimentSpec{
+		Action:         "pod-disk-fill",
+		Namespace:      "default",
+		Selector:       map[string]string{"app": "test"},
+		Duration:       "1m",
+		FillPercentage: 80,
+		TargetPath:     "/tmp",
+		ReserveBytes:   "512Mi",
+	}
+	if errs := ValidateSpecStructure("fill", spec); len(errs) != 0 {
+		t.Errorf("expected valid spec, got %v", errs)
+	}
+
+	spec.ReserveBytes = "lots"
+	if errs := ValidateSpecStructure("fill", spec); len(errs) != 1 || !strings.Contains(errs[0].Message, "reserveBytes") {
+		t.Errorf("expected reserveBytes to be rejected, got %v", errs)
+	}
+}
+
+func TestValidateSpecStructure_PartitionPeers(t *testing.T) {
+	spec := &ChaosExperimentSpec{
+		Action:         "network-partition",
+		Namespace:      "default",
+		Selector:       map[string]string{"app": "api"},
+		Duration:       "1m",
+		PeerSelector:   map[string]string{"app": "db"},
+		PeerNamespaces: []string{"data"},
+	}
+	if errs := ValidateSpecStructure("split", spec); len(errs) != 0 {
+		t.Errorf("expected valid spec, got %v", errs)
+	}
+
+	spec.PeerSelector = nil
+	if errs := ValidateSpecStructure("split", spec); len(errs) != 1 || !strings.Contains(errs[0].Message, "peerNamespaces requires peerSelector") {
+		t.Errorf("expected peerNamespaces without peerSelector to be rejected, got %v", errs)
+	}
+
+	spec.Action = "pod-kill"
+	spec.PeerSelector = map[string]string{"app": "db"}
+	if errs := ValidateSpecStructure("split", spec); len(errs) != 1 || errs[0].Field != "spec.peerSelector" {
+		t.Errorf("expected peerSelector to be rejected for pod-kill, got %v", errs)
+	}
+}
+
+func TestValidateSpecStructure_NetworkMeasurement(t *testing.T) {
+	spec := &ChaosExperimentSpec{
+		Action:             "pod-network-loss",
+		Namespace:          "default",
+		Selector:           map[string]string{"app": "api"},
+		Duration:           "1m",
+		LossPercentage:     20,
+		NetworkMeasurement: &NetworkMeasurement{Target: "db.data.svc"},
+	}
+	if errs := ValidateSpecStructure("lossy", spec); len(errs) != 0 {
+		t.Errorf("expected valid spec, got %v", errs)
+	}
+
+	spec.Action = "pod-cpu-stress"
+	spec.LossPercentage = 0
+	spec.CPULoad = 50
+	if errs := ValidateSpecStructure("lossy", spec); len(errs) != 1 || errs[0].Field != "spec.networkMeasurement" {
+		t.Errorf("expected networkMeasurement to be rejected for pod-cpu-stress, got %v", errs)
+	}
+}
+
+func TestValidateSpecStructure_DrainTimeout(t *testing.T) {
+	spec := &ChaosExperimentSpec{
+		Action:       "node-drain",
+		Namespace:    "default",
+		Selector:     map[string]string{"pool": "spot"},
+		DrainTimeout: "5m",
+	}
+	if errs := ValidateSpecStructure("drain", spec); len(errs) != 0 {
+		t.Errorf("expected valid spec, got %v", errs)
+	}
+
+	spec.DrainTimeout = "5 minutes"
+	if errs := ValidateSpecStructure("drain", spec); len(errs) != 1 || errs[0].Field != "spec.drainTimeout" {
+		t.Errorf("expected malformed drainTimeout to be rejected, got %v", errs)
+	}
+
+	spec.Action = "pod-kill"
+	spec.DrainTimeout = "5m"
+	if errs := ValidateSpecStructure("drain", spec); len(errs) != 1 || errs[0].Field != "spec.drainTimeout" {
+		t.Errorf("expected drainTimeout to be rejected for pod-kill, got %v", errs)
+	}
+}
+
+func TestValidateSpecStructure_NodeReplacement(t *testing.T) {
+	spec := &ChaosExperimentSpec{
+		Action:          "node-drain",
+		Namespace:       "default",
+		Selector:        map[string]string{"pool": "spot"},
+		NodeReplacement: &NodeReplacement{Autoscaler: "karpenter", Timeout: "15m"},
+	}
+	if errs := ValidateSpecStructure("drain", spec); len(errs) != 0 {
+		t.Errorf("expected valid spec, got %v", errs)
+	}
+
+	spec.NodeReplacement.Timeout = "soon"
+	if errs := ValidateSpecStructure("drain", spec); len(errs) != 1 || errs[0].Field != "spec.nodeReplacement.timeout" {
+		t.Errorf("expected malformed timeout to be rejected, got %v", errs)
+	}
+
+	spec.Action = "pod-kill"
+	spec.NodeReplacement.Timeout = ""
+	if errs := ValidateSpecStructure("drain", spec); len(errs) != 1 || errs[0].Field != "spec.nodeReplacement" {
+		t.Errorf("expected nodeReplacement to be rejected for pod-kill, got %v", errs)
+	}
+}
+
+func TestValidateSpecStructure_MaxNodes(t *testing.T) {
+	spec := &ChaosExperimentSpec{
+		Action:    "node-drain",
+		Namespace: "default",
+		Selector:  map[string]string{"pool": "spot"},
+		Count:     2,
+		MaxNodes:  2,
+	}
+	if errs := ValidateSpecStructure("drain", spec); len(errs) != 0 {
+		t.Errorf("expected valid spec, got %v", errs)
+	}
+
+	spec.MaxNodes = 1
+	if errs := ValidateSpecStructure("drain", spec); len(errs) != 1 || errs[0].Field != "spec.maxNodes" {
+		t.Errorf("expected count above maxNodes to be rejected, got %v", errs)
+	}
+
+	spec.Action = "pod-kill"
+	spec.Count = 1
+	if errs := ValidateSpecStructure("drain", spec); len(errs) != 1 || errs[0].Field != "spec.maxNodes" {
+		t.Errorf("expected maxNodes to be rejected for pod-kill, got %v", errs)
+	}
+}
+
+func TestValidateSpecStructure_IgnoreEvictionAnnotations(t *testing.T) {
+	spec := &ChaosExperimentSpec{
+		Action:                    "pod-kill",
+		Namespace:                 "default",
+		Selector:                  map[string]string{"app": "api"},
+		IgnoreEvictionAnnotations: true,
+	}
+	if errs := ValidateSpecStructure("kill", spec); len(errs) != 0 {
+		t.Errorf("expected valid spec, got %v", errs)
+	}
+
+	spec.Action = "pod-cpu-stress"
+	spec.Duration = "1m"
+	spec.CPULoad = 50
+	if errs := ValidateSpecStructure("kill", spec); len(errs) != 1 || errs[0].Field != "spec.ignoreEvictionAnnotations" {
+		t.Errorf("expected ignoreEvictionAnnotations to be rejected for pod-cpu-stress, got %v", errs)
+	}
+}
+
+func TestValidateSpecStructure_ExternalTargets(t *testing.T) {
+	spec := &ChaosExperimentSpec{
+		Action:          "network-partition",
+		Namespace:       "default",
+		Selector:        map[string]string{"app": "api"},
+		Duration:        "1m",
+		ExternalTargets: []string{"db.example.com", "payments.example.com.", "203.0.113.0/24", "198.51.100.7", "2001:db8::/48"},
+	}
+	if errs := ValidateSpecStructure("egress", spec); len(errs) != 0 {
+		t.Errorf("expected valid spec, got %v", errs)
+	}
+
+	for _, target := range []string{"*.example.com", "10.0.0", "203.0.113.0/33", "db_primary.example.com", "2001:db8::zz", ""} {
+		spec.ExternalTargets = []string{target}
+		if errs := ValidateSpecStructure("egress", spec); len(errs) != 1 || !strings.Contains(errs[0].Message, "externalTargets[0]") {
+			t.Errorf("expected external target %q to be rejected, got %v", target, errs)
+		}
+	}
+
+	spec.Action = "pod-kill"
+	spec.ExternalTargets = []string{"db.example.com"}
+	if errs := ValidateSpecStructure("egress", spec); len(errs) != 1 || errs[0].Field != "spec.externalTargets" {
+		t.Errorf("expected externalTargets to be rejected for pod-kill, got %v", errs)
+	}
+}
+
+func TestValidateSpecStructure_OwnerPatterns(t *testing.T) {
+	spec := &ChaosExperimentSpec{
+		Action:        "pod-kill",
+		Namespace:     "default",
+		Selector:      map[string]string{"tier": "data"},
+		ExcludeOwners: []string{"kafka-*", "postgres"},
+		IncludeOwners: []string{"redis-[0-9]*"},
+	}
+	if errs := ValidateSpecStructure("owners", spec); len(errs) != 0 {
+		t.Errorf("expected valid spec, got %v", errs)
+	}
+
+	spec.IncludeOwners = []string{"redis-[0-9"}
+	if errs := ValidateSpecStructure("owners", spec); len(errs) != 1 || errs[0].Field != "spec.includeOwners" {
+		t.Errorf("expected malformed pattern to be rejected, got %v", errs)
+	}
+
+	spec.Action = "node-drain"
+	spec.IncludeOwners = nil
+	if errs := ValidateSpecStructure("owners", spec); len(errs) != 1 || errs[0].Field != "spec.excludeOwners" {
+		t.Errorf("expected excludeOwners to be rejected for node-drain, got %v", errs)
+	}
+}
+
+func TestValidateSpecStructure_NodeConstraints(t *testing.T) {
+	spec := &ChaosExperimentSpec{
+		Action:       "pod-kill",
+		Namespace:    "default",
+		Selector:     map[string]string{"app": "web"},
+		NodeSelector: map[string]string{"karpenter.sh/capacity-type": "spot"},
+		NodeAffinity: &corev1.NodeSelector{NodeSelectorTerms: []corev1.NodeSelectorTerm{{
+			MatchExpressions: []corev1.NodeSelectorRequirement{
+				{Key: "topology.kubernetes.io/zone", Operator: corev1.NodeSelectorOpIn, Values: []string{"eu-west-1a"}},
+				{Key: "node.example.com/generation", Operator: corev1.NodeSelectorOpGt, Values: []string{"3"}},
+			},
+		}}},
+	}
+	if errs := ValidateSpecStructure("nodes", spec); len(errs) != 0 {
+		t.Errorf("expected valid spec, got %v", errs)
+	}
+
+	invalid := []corev1.NodeSelectorRequirement{
+		{Key: "zone", Operator: corev1.NodeSelectorOpIn},
+		{Key: "zone", Operator: corev1.NodeSelectorOpExists, Values: []string{"a"}},
+		{Key: "generation", Operator: corev1.NodeSelectorOpLt, Values: []string{"new"}},
+		{Key: "zone", Operator: "Matches", Values: []string{"a"}},
+	}
+	for _, req := range invalid {
+		spec.NodeAffinity.NodeSelectorTerms[0].MatchExpressions = []corev1.NodeSelectorRequirement{req}
+		if errs := ValidateSpecStructure("nodes", spec); len(errs) != 1 || errs[0].Field != "spec.nodeAffinity" {
+			t.Errorf("expected %s %v to be rejected, got %v", req.Operator, req.Values, errs)
+		}
+	}
+
+	spec.NodeAffinity.NodeSelectorTerms[0] = corev1.NodeSelectorTerm{MatchFields: []corev1.NodeSelectorRequirement{
+		{Key: "metadata.labels", Operator: corev1.NodeSelectorOpIn, Values: []string{"a"}},
+	}}
+	if errs := ValidateSpecStructure("nodes", spec); len(errs) != 1 || errs[0].Field != "spec.nodeAffinity" {
+		t.Errorf("expected unsupported matchFields key to be rejected, got %v", errs)
+	}
+
+	spec.Action = "node-drain"
+	spec.NodeAffinity = nil
+	if errs := ValidateSpecStructure("nodes", spec); len(errs) != 1 || errs[0].Field != "spec.nodeSelector" {
+		t.Errorf("expected nodeSelector to be rejected for node-drain, got %v", errs)
+	}
+}
+
+func TestValidateSpecStructure_SpreadBy(t *testing.T) {
+	spec := &ChaosExperimentSpec{
+		Action:     "pod-kill",
+		Namespace:  "default",
+		Selector:   map[string]string{"app": "web"},
+		SpreadBy:   "zone",
+		SpreadMode: "concentrate",
+	}
+	if errs := ValidateSpecStructure("spread", spec); len(errs) != 0 {
+		t.Errorf("expected valid spec, got %v", errs)
+	}
+
+	spec.SelectionStrategy = "one-per-zone"
+	if errs := ValidateSpecStructure("spread", spec); len(errs) != 1 || errs[0].Field != "spec.spreadBy" {
+		t.Errorf("expected spreadBy to be rejected with one-per-zone, got %v", errs)
+	}
+
+	spec.SelectionStrategy = ""
+	spec.SpreadBy = ""
+	if errs := ValidateSpecStructure("spread", spec); len(errs) != 1 || errs[0].Field != "spec.spreadMode" {
+		t.Errorf("expected spreadMode without spreadBy to be rejected, got %v", errs)
+	}
+}
+
+func TestValidateSpecStructure_NetAdminFallback(t *testing.T) {
+	spec := &ChaosExperimentSpec{
+		Action:           "pod-delay",
+		Namespace:        "default",
+		Selector:         map[string]string{"app": "api"},
+		Duration:         "1s",
+		NetAdminFallback: true,
+	}
+	if errs := ValidateSpecStructure("delay", spec); len(errs) != 0 {
+		t.Errorf("expected valid spec, got %v", errs)
+	}
+
+	spec.Action = "pod-kill"
+	spec.Duration = ""
+	if errs := ValidateSpecStructure("delay", spec); len(errs) != 1 || errs[0].Field != "spec.netAdminFallback" {
+		t.Errorf("expected netAdminFallback to be rejected for pod-kill, got %v", errs)
+	}
+}
+
+func TestValidateSpecStructure_MemoryOvercommitPolicy(t *testing.T) {
+	spec := &ChaosExperimentSpec{
+		Action:                 "pod-memory-stress",
+		Namespace:              "default",
+		Selector:               map[string]string{"app": "api"},
+		Duration:               "1m",
+		MemorySize:             "512M",
+		MemoryOvercommitPolicy: "Clamp",
+	}
+	if errs := ValidateSpecStructure("stress", spec); len(errs) != 0 {
+		t.Errorf("expected valid spec, got %v", errs)
+	}
+
+	spec.Action = "pod-cpu-stress"
+	spec.MemorySize = ""
+	spec.CPULoad = 50
+	if errs := ValidateSpecStructure("stress", spec); len(errs) != 1 || errs[0].Field != "spec.memoryOvercommitPolicy" {
+		t.Errorf("expected memoryOvercommitPolicy to be rejected for pod-cpu-stress, got %v", errs)
+	}
+}
+
+func TestValidateSpecStructure_RelativeLoad(t *testing.T) {
+	spec := &ChaosExperimentSpec{
+		Action:       "pod-cpu-stress",
+		Namespace:    "default",
+		Selector:     map[string]string{"app": "api"},
+		Duration:     "1m",
+		RelativeLoad: "50%",
+	}
+	if errs := ValidateSpecStructure("stress", spec); len(errs) != 0 {
+		t.Errorf("expected valid spec, got %v", errs)
+	}
+
+	for _, load := range []string{"0%", "101%", "50"} {
+		spec.RelativeLoad = load
+		if errs := ValidateSpecStructure("stress", spec); len(errs) != 1 || errs[0].Field != "spec" {
+			t.Errorf("expected relativeLoad %q to be rejected, got %v", load, errs)
+		}
+	}
+
+	spec.RelativeLoad = "50%"
+	spec.CPULoad = 80
+	if errs := ValidateSpecStructure("stress", spec); len(errs) != 1 || errs[0].Message != "cpuLoad and relativeLoad are mutually exclusive" {
+		t.Errorf("expected cpuLoad and relativeLoad to be rejected together, got %v", errs)
+	}
+
+	spec.Action = "node-cpu-stress"
+	spec.CPULoad = 0
+	if errs := ValidateSpecStructure("stress", spec); len(errs) != 1 || errs[0].Field != "spec.relativeLoad" {
+		t.Errorf("expected relativeLoad to be rejected for node-cpu-stress, got %v", errs)
+	}
+}
+
+func TestValidateSpecStructure_SuccessCriteria(t *testing.T) {
+	spec := &ChaosExperimentSpec{
+		Action:             "pod-kill",
+		Namespace:          "default",
+		Selector:           map[string]string{"app": "api"},
+		ExperimentDuration: "10m",
+		SuccessCriteria: []SuccessCriterion{
+			{Name: "settled", Expression: `metrics["error-rate"]["after"] < 0.05`},
+			{Name: "recovered", Expression: `unrecovered == 0 && recoverySeconds < 60.0`},
+		},
+	}
+	if errs := ValidateSpecStructure("judged", spec); len(errs) != 0 {
+		t.Errorf("expected valid spec, got %v", errs)
+	}
+
+	spec.SuccessCriteria[1].Name = "settled"
+	if errs := ValidateSpecStructure("judged", spec); len(errs) != 1 || errs[0].Field != "spec.successCriteria[1].name" {
+		t.Errorf("expected duplicate criterion name to be rejected, got %v", errs)
+	}
+
+	spec.SuccessCriteria[1] = SuccessCriterion{Name: "count", Expression: `recovered + 1`}
+	if errs := ValidateSpecStructure("judged", spec); len(errs) != 1 || errs[0].Field != "spec.successCriteria[1].expression" {
+		t.Errorf("expected non-boolean expression to be rejected, got %v", errs)
+	}
+
+	spec.SuccessCriteria = spec.SuccessCriteria[:1]
+	spec.ExperimentDuration = ""
+	if errs := ValidateSpecStructure("judged", spec); len(errs) != 1 || errs[0].Field != "spec.successCriteria" {
+		t.Errorf("expected successCriteria without experimentDuration to be rejected, got %v", errs)
+	}
+}
@@ -38,6 +38,23 @@ type ChaosExperimentHistorySpec struct {
 	// +optional
 	AffectedResources []ResourceReference `json:"affectedResources,omitempty"`
 
+	// BlastRadius is the impact estimate computed when targets were selected
+	// +optional
+	BlastRadius *BlastRadius `json:"blastRadius,omitempty"`
+
+	// Autoscalers records how the HorizontalPodAutoscalers of the targets reacted up to this execution
+	// +optional
+	Autoscalers []AutoscalerActivity `json:"autoscalers,omitempty"`
+
+	// NetworkMeasurements holds the RTT and loss measured from the targets up to this execution
+	// +optional
+	NetworkMeasurements []NetworkMeasurementResult `json:"networkMeasurements,omitempty"`
+
+	// JobImpact reports, for experiments with includeJobPods, the state of the Jobs whose pods
+	// were affected when the record was written
+	// +optional
+	JobImpact []JobImpact `json:"jobImpact,omitempty"`
+
 	// Audit contains metadata for compliance and auditing
 	// +kubebuilder:validation:Required
 	Audit AuditMetadata `json:"audit"`
@@ -45,6 +62,47 @@ type ChaosExperimentHistorySpec struct {
 	// Error contains error information if the execution failed
 	// +optional
 	Error *ErrorDetails `json:"error,omitempty"`
+
+	// Regressions lists the metrics that got worse compared to the previous run of the same experiment
+	// +optional
+	Regressions []string `json:"regressions,omitempty"`
+
+	// Snapshot holds events and log tails of the affected resources captured when the record was
+	// written, so postmortems do not depend on the cluster's event and log retention
+	// +optional
+	Snapshot *ResourceSnapshot `json:"snapshot,omitempty"`
+
+	// Metrics holds the values of spec.metricsQueries at the start of this execution (before) and
+	// when the record was written (during)
+	// +optional
+	Metrics []MetricSample `json:"metrics,omitempty"`
+
+	// Verdict is the experiment's verdict on its successCriteria, added to the record of the
+	// judged execution
+	// +optional
+	Verdict *Verdict `json:"verdict,omitempty"`
+
+	// Compaction is set once the record was compacted to keep the experiment's history within the
+	// controller's size budget; per-target details are gone and only summaries remain
+	// +optional
+	Compaction *HistoryCompaction `json:"compaction,omitempty"`
+}
+
+// HistoryCompaction summarizes what compaction removed from a history record
+type HistoryCompaction struct {
+	// CompactedAt is when the record was compacted
+	CompactedAt metav1.Time `json:"compactedAt"`
+
+	// OriginalSize is the size of the record in bytes before it was compacted
+	OriginalSize int `json:"originalSize"`
+
+	// AffectedResources counts the removed affectedResources by kind and action (e.g., "Pod/deleted": 3)
+	// +optional
+	AffectedResources map[string]int `json:"affectedResources,omitempty"`
+
+	// Removed lists the spec fields that were dropped or trimmed
+	// +optional
+	Removed []string `json:"removed,omitempty"`
 }
 
 // ObjectReference contains information to locate a Kubernetes object
@@ -91,6 +149,59 @@ type ExecutionDetails struct {
 	// +kubebuilder:validation:Enum=Pending;Running;Completed;Failed
 	// +optional
 	Phase string `json:"phase,omitempty"`
+
+	// RecoveryTime is how long the affected targets took to become healthy again after injection
+	// (e.g., "45s"). Only set by actions that measure recovery.
+	// +optional
+	RecoveryTime string `json:"recoveryTime,omitempty"`
+
+	// Cancellation describes how far the injections got when a cancelled execution was cut short
+	// +optional
+	Cancellation *Cancellation `json:"cancellation,omitempty"`
+}
+
+// Reasons of a Cancellation
+const (
+	// CancellationDeleted executions ended because their experiment was deleted
+	CancellationDeleted = "Deleted"
+	// CancellationAborted executions ended because their experiment was aborted
+	CancellationAborted = "Aborted"
+)
+
+// Cancellation records an execution cut short by deleting or aborting its experiment while
+// injections were in place
+type Cancellation struct {
+	// Reason is Deleted or Aborted
+	// +kubebuilder:validation:Enum=Deleted;Aborted
+	Reason string `json:"reason"`
+
+	// RequestedBy is who asked for the abort, when known
+	// +optional
+	RequestedBy string `json:"requestedBy,omitempty"`
+
+	// IntendedDuration is how long each injection was meant to last (spec.duration)
+	IntendedDuration string `json:"intendedDuration"`
+
+	// Targets lists the injections in place when the execution was cancelled
+	// +optional
+	Targets []CancelledTarget `json:"targets,omitempty"`
+}
+
+// CancelledTarget is how much of the intended duration one injection ran
+type CancelledTarget struct {
+	// Kind of the target (e.g., Pod, ResourceQuota)
+	Kind string `json:"kind"`
+
+	// Name of the target, with its namespace for namespaced targets ("namespace/name")
+	Name string `json:"name"`
+
+	// Elapsed is how long the injection had been in place (e.g., "45s")
+	Elapsed string `json:"elapsed"`
+
+	// Percent is Elapsed as a share of the intended duration
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	Percent int32 `json:"percent"`
 }
 
 // ResourceReference identifies a Kubernetes resource affected by an experiment
@@ -119,6 +230,34 @@ type ResourceReference struct {
 	Details string `json:"details,omitempty"`
 }
 
+// JobImpact is the state of a Job whose pods an execution affected
+type JobImpact struct {
+	// Name of the Job
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+
+	// CronJob that created the Job, if any
+	// +optional
+	CronJob string `json:"cronJob,omitempty"`
+
+	// Outcome is Running while the Job has not finished, Complete or Failed once it has,
+	// and Missing when the Job no longer exists
+	// +kubebuilder:validation:Enum=Running;Complete;Failed;Missing
+	Outcome string `json:"outcome"`
+
+	// Failed is the number of failed pods of the Job, killed targets included
+	// +optional
+	Failed int32 `json:"failed,omitempty"`
+
+	// BackoffLimit is the number of pod failures after which the Job fails
+	// +optional
+	BackoffLimit *int32 `json:"backoffLimit,omitempty"`
+
+	// Reason is the reason of the Job's Failed condition
+	// +optional
+	Reason string `json:"reason,omitempty"`
+}
+
 // AuditMetadata contains information for compliance and auditing purposes
 type AuditMetadata struct {
 	// InitiatedBy identifies who or what triggered the experiment
@@ -126,6 +265,11 @@ type AuditMetadata struct {
 	// +optional
 	InitiatedBy string `json:"initiatedBy,omitempty"`
 
+	// InitiatedVia identifies the tool the experiment was created with (CLI, GitOps controller, ...)
+	// Taken from the chaos.gushchin.dev/user-agent annotation
+	// +optional
+	InitiatedVia string `json:"initiatedVia,omitempty"`
+
 	// ScheduledExecution indicates if this was triggered by a schedule (true) or manual (false)
 	// +optional
 	ScheduledExecution bool `json:"scheduledExecution,omitempty"`
@@ -138,6 +282,16 @@ type AuditMetadata struct {
 	// +optional
 	RetryCount int `json:"retryCount,omitempty"`
 
+	// ChangeTicket is the change ticket the experiment referenced through the
+	// chaos.gushchin.dev/change-ticket annotation
+	// +optional
+	ChangeTicket string `json:"changeTicket,omitempty"`
+
+	// ChangeTicketState is the state the change-management system reported for ChangeTicket when
+	// the last injection round was verified; empty when it was never verified
+	// +optional
+	ChangeTicketState string `json:"changeTicketState,omitempty"`
+
 	// CreationTimestamp is when the history record was created
 	// +optional
 	CreationTimestamp metav1.Time `json:"creationTimestamp,omitempty"`
@@ -145,7 +299,8 @@ type AuditMetadata struct {
 
 // ErrorDetails contains information about execution failures
 type ErrorDetails struct {
-	// Message is the error message
+	// Message is the error message. It may quote raw command output, such as stderr of a command
+	// run in a target pod; credentials in it are redacted.
 	// +optional
 	Message string `json:"message,omitempty"`
 
@@ -153,16 +308,80 @@ type ErrorDetails struct {
 	// +optional
 	Code string `json:"code,omitempty"`
 
-	// LastError is the last error encountered during retries
+	// LastError is the last error encountered during retries. Like Message, it may quote raw
+	// command output.
 	// +optional
 	LastError string `json:"lastError,omitempty"`
 
+	// Redacted is true when credentials were removed from Message or LastError
+	// +optional
+	Redacted bool `json:"redacted,omitempty"`
+
 	// FailureReason categorizes the type of failure
 	// +kubebuilder:validation:Enum=ValidationError;ResourceNotFound;PermissionDenied;ExecutionError;Timeout;Unknown
 	// +optional
 	FailureReason string `json:"failureReason,omitempty"`
 }
 
+// ResourceSnapshot is a size-bounded capture of events and logs of the affected resources
+type ResourceSnapshot struct {
+	// Events are the Kubernetes events recorded for the affected resources
+	// +optional
+	Events []CapturedEvent `json:"events,omitempty"`
+
+	// Logs are the last lines of the affected pods' containers
+	// +optional
+	Logs []ContainerLogTail `json:"logs,omitempty"`
+
+	// Truncated is true when some events or logs were dropped to stay within the size limit
+	// +optional
+	Truncated bool `json:"truncated,omitempty"`
+
+	// Redacted is true when credentials were removed from the events or logs
+	// +optional
+	Redacted bool `json:"redacted,omitempty"`
+}
+
+// CapturedEvent is a copy of a Kubernetes event involving an affected resource
+type CapturedEvent struct {
+	// Object is the involved object (e.g., "Pod/web-abc")
+	Object string `json:"object"`
+
+	// Type is the event type (Normal or Warning)
+	// +optional
+	Type string `json:"type,omitempty"`
+
+	// Reason is the short machine-readable reason of the event
+	// +optional
+	Reason string `json:"reason,omitempty"`
+
+	// Message is the human-readable event message
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// Count is how many times the event occurred
+	// +optional
+	Count int32 `json:"count,omitempty"`
+
+	// LastTimestamp is when the event was last seen
+	// +optional
+	LastTimestamp metav1.Time `json:"lastTimestamp,omitempty"`
+}
+
+// ContainerLogTail holds the last lines of a container's log
+type ContainerLogTail struct {
+	// Pod is the name of the pod
+	Pod string `json:"pod"`
+
+	// Container is the name of the container
+	Container string `json:"container"`
+
+	// Lines is the captured log output: raw container output, with credentials and the pod's
+	// literal env values redacted
+	// +optional
+	Lines string `json:"lines,omitempty"`
+}
+
 // ChaosExperimentHistoryStatus defines the observed state of ChaosExperimentHistory
 // Note: History records are immutable, so status is minimal
 type ChaosExperimentHistoryStatus struct {
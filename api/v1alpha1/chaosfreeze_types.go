@@ -0,0 +1,73 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ChaosFreezeSpec defines a cluster-wide chaos freeze
+type ChaosFreezeSpec struct {
+	// Reason explains why chaos is frozen (e.g. incident number or change freeze name)
+	// Shown in the status of every experiment held by the freeze
+	// +optional
+	Reason string `json:"reason,omitempty"`
+
+	// ExpiresAt lifts the freeze automatically at the given time
+	// If omitted, the freeze stays in effect until the ChaosFreeze is deleted
+	// +optional
+	ExpiresAt *metav1.Time `json:"expiresAt,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster,shortName=freeze
+// +kubebuilder:printcolumn:name="Reason",type="string",JSONPath=".spec.reason"
+// +kubebuilder:printcolumn:name="Expires",type="date",JSONPath=".spec.expiresAt"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// ChaosFreeze is the Schema for the chaosfreezes API
+// While any unexpired ChaosFreeze exists, all experiments are paused, their active
+// injections are reverted, and new experiments are rejected by the admission webhook
+type ChaosFreeze struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec ChaosFreezeSpec `json:"spec,omitempty"`
+}
+
+// IsActive reports whether the freeze is in effect at the given time
+func (f *ChaosFreeze) IsActive(now time.Time) bool {
+	if f.DeletionTimestamp != nil {
+		return false
+	}
+	return f.Spec.ExpiresAt == nil || now.Before(f.Spec.ExpiresAt.Time)
+}
+
+// +kubebuilder:object:root=true
+
+// ChaosFreezeList contains a list of ChaosFreeze
+type ChaosFreezeList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ChaosFreeze `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ChaosFreeze{}, &ChaosFreezeList{})
+}
@@ -0,0 +1,138 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// MonkeyLabel is set on the experiments a ChaosMonkey creates, with the monkey's name as value
+const MonkeyLabel = "chaos.gushchin.dev/monkey"
+
+// ChaosMonkeySpec defines continuous background chaos
+type ChaosMonkeySpec struct {
+	// Namespaces the monkey may pick workloads from
+	// If omitted, every namespace except the kube-* system namespaces is a candidate
+	// +optional
+	Namespaces []string `json:"namespaces,omitempty"`
+
+	// Actions the monkey chooses from at random for every run
+	// Only low-severity pod actions are allowed
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:items:Enum=pod-kill;pod-restart;pod-failure
+	// +kubebuilder:default={"pod-kill"}
+	// +optional
+	Actions []string `json:"actions,omitempty"`
+
+	// Interval is the mean time between two runs (e.g., "1h")
+	// Every gap is drawn at random between half and one and a half times the interval
+	// +kubebuilder:validation:Pattern="^([0-9]+(s|m|h))+$"
+	// +kubebuilder:default="1h"
+	// +optional
+	Interval string `json:"interval,omitempty"`
+
+	// MinReadyReplicas is the least number of ready replicas a Deployment or StatefulSet needs to be picked
+	// Workloads that are scaled below it or not fully ready are left alone
+	// +kubebuilder:validation:Minimum=2
+	// +kubebuilder:default=2
+	// +optional
+	MinReadyReplicas int32 `json:"minReadyReplicas,omitempty"`
+
+	// TimeWindows restricts runs to the given windows, e.g. business hours
+	// If omitted, the monkey runs at any time
+	// +optional
+	TimeWindows []TimeWindow `json:"timeWindows,omitempty"`
+
+	// AllowProduction lets the monkey pick workloads in production namespaces
+	// +kubebuilder:default=false
+	// +optional
+	AllowProduction bool `json:"allowProduction,omitempty"`
+
+	// Paused stops the monkey from starting new runs
+	// +kubebuilder:default=false
+	// +optional
+	Paused bool `json:"paused,omitempty"`
+
+	// ExperimentTTLSecondsAfterFinished is set as ttlSecondsAfterFinished on the experiments the monkey creates
+	// Their history records are kept regardless
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:default=3600
+	// +optional
+	ExperimentTTLSecondsAfterFinished *int32 `json:"experimentTTLSecondsAfterFinished,omitempty"`
+}
+
+// ChaosMonkeyStatus defines the observed state of ChaosMonkey
+type ChaosMonkeyStatus struct {
+	// Runs is the number of experiments the monkey has created
+	// +optional
+	Runs int64 `json:"runs,omitempty"`
+
+	// LastRunTime is when the monkey last created an experiment
+	// +optional
+	LastRunTime *metav1.Time `json:"lastRunTime,omitempty"`
+
+	// NextRunTime is when the monkey will pick its next target
+	// +optional
+	NextRunTime *metav1.Time `json:"nextRunTime,omitempty"`
+
+	// LastExperiment is the name of the experiment created by the last run
+	// +optional
+	LastExperiment string `json:"lastExperiment,omitempty"`
+
+	// LastTarget is the workload picked by the last run (namespace/Kind/name)
+	// +optional
+	LastTarget string `json:"lastTarget,omitempty"`
+
+	// Message explains what the monkey is doing or waiting for
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:shortName=monkey
+// +kubebuilder:printcolumn:name="Paused",type="boolean",JSONPath=".spec.paused"
+// +kubebuilder:printcolumn:name="Interval",type="string",JSONPath=".spec.interval"
+// +kubebuilder:printcolumn:name="Runs",type="integer",JSONPath=".status.runs"
+// +kubebuilder:printcolumn:name="Last Target",type="string",JSONPath=".status.lastTarget"
+// +kubebuilder:printcolumn:name="Next Run",type="date",JSONPath=".status.nextRunTime"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// ChaosMonkey is the Schema for the chaosmonkeys API
+// A ChaosMonkey keeps creating low-severity experiments against workloads picked at random,
+// one at a time, within ChaosPolicy rate limits and its time windows. Each run is an ordinary
+// ChaosExperiment owned by the monkey, so every run is recorded in history and held by a ChaosFreeze.
+type ChaosMonkey struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ChaosMonkeySpec   `json:"spec,omitempty"`
+	Status ChaosMonkeyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ChaosMonkeyList contains a list of ChaosMonkey
+type ChaosMonkeyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ChaosMonkey `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ChaosMonkey{}, &ChaosMonkeyList{})
+}
@@ -0,0 +1,195 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"slices"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ChaosPolicySpec defines limits the controller enforces on experiments
+type ChaosPolicySpec struct {
+	// Namespaces limits the policy to experiments targeting these namespaces (spec.namespace)
+	// If omitted, the policy applies to every namespace, each counted separately
+	// +optional
+	Namespaces []string `json:"namespaces,omitempty"`
+
+	// RateLimit bounds how often experiments inject chaos
+	// +optional
+	RateLimit *ChaosRateLimit `json:"rateLimit,omitempty"`
+
+	// SeverityRules gate experiments by severity, e.g. to allow low severity everywhere but
+	// high severity only with an approval
+	// +optional
+	SeverityRules []SeverityRule `json:"severityRules,omitempty"`
+
+	// HolidayCalendars block injections on the days of these calendars, such as public holidays
+	// and company freeze days, without listing the dates in every experiment
+	// +optional
+	HolidayCalendars []HolidayCalendar `json:"holidayCalendars,omitempty"`
+}
+
+// HolidayCalendar is an iCalendar (ICS) feed or a list of dates whose days block chaos
+// Exactly one of url and configMapRef must be set
+type HolidayCalendar struct {
+	// Name identifies the calendar in conditions and events
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+
+	// URL is an http(s) URL serving an ICS calendar, e.g. a public holiday feed
+	// +kubebuilder:validation:Pattern="^https?://"
+	// +optional
+	URL string `json:"url,omitempty"`
+
+	// ConfigMapRef points at a ConfigMap key holding an ICS calendar or one date per line
+	// ("2026-12-25 Christmas", "2026-12-20/2026-12-31 Year-end freeze")
+	// +optional
+	ConfigMapRef *CalendarConfigMapRef `json:"configMapRef,omitempty"`
+
+	// Timezone interprets all-day events and dates without a zone (IANA name, e.g. "Europe/Berlin")
+	// +kubebuilder:default="UTC"
+	// +optional
+	Timezone string `json:"timezone,omitempty"`
+}
+
+// CalendarConfigMapRef selects a key of a ConfigMap
+type CalendarConfigMapRef struct {
+	// Namespace of the ConfigMap
+	// +kubebuilder:validation:Required
+	Namespace string `json:"namespace"`
+
+	// Name of the ConfigMap
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+
+	// Key of the calendar in the ConfigMap's data
+	// +kubebuilder:default="calendar"
+	// +optional
+	Key string `json:"key,omitempty"`
+}
+
+// SeverityRule restricts experiments of a severity and every higher one
+type SeverityRule struct {
+	// Severity is the lowest severity the rule applies to
+	// +kubebuilder:validation:Enum=low;medium;high
+	// +kubebuilder:validation:Required
+	Severity string `json:"severity"`
+
+	// Deny rejects such experiments in the policy's namespaces; dry runs stay allowed
+	// +optional
+	Deny bool `json:"deny,omitempty"`
+
+	// RequireApproval holds injections until someone other than the experiment's creator sets
+	// the chaos.gushchin.dev/approved-by annotation
+	// +optional
+	RequireApproval bool `json:"requireApproval,omitempty"`
+
+	// RequireChangeTicket holds injections until the experiment references, through the
+	// chaos.gushchin.dev/change-ticket annotation, a change ticket the configured change-management
+	// system reports approved with an open change window
+	// +optional
+	RequireChangeTicket bool `json:"requireChangeTicket,omitempty"`
+
+	// TimeWindows restrict when injections may start
+	// +optional
+	TimeWindows []TimeWindow `json:"timeWindows,omitempty"`
+}
+
+// ChaosRateLimit bounds the injection rounds the controller starts
+type ChaosRateLimit struct {
+	// MaxInjections is the number of injection rounds that may start per target namespace within window
+	// Rounds of every experiment targeting the namespace count towards the limit
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MaxInjections int `json:"maxInjections,omitempty"`
+
+	// Window is the sliding window maxInjections applies to (e.g., "30m", "1h"), at most 24h
+	// +kubebuilder:validation:Pattern="^([0-9]+(s|m|h))+$"
+	// +kubebuilder:default="1h"
+	// +optional
+	Window string `json:"window,omitempty"`
+
+	// MinInterval is the least time between two injection rounds of the same experiment (e.g., "10m")
+	// +kubebuilder:validation:Pattern="^([0-9]+(s|m|h))+$"
+	// +optional
+	MinInterval string `json:"minInterval,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster,shortName=cpol
+// +kubebuilder:printcolumn:name="Namespaces",type="string",JSONPath=".spec.namespaces"
+// +kubebuilder:printcolumn:name="Max Injections",type="integer",JSONPath=".spec.rateLimit.maxInjections"
+// +kubebuilder:printcolumn:name="Window",type="string",JSONPath=".spec.rateLimit.window"
+// +kubebuilder:printcolumn:name="Min Interval",type="string",JSONPath=".spec.rateLimit.minInterval"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// ChaosPolicy is the Schema for the chaospolicies API
+// The controller holds back injection rounds that would exceed the limits of any policy
+// applying to the experiment's target namespace
+type ChaosPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec ChaosPolicySpec `json:"spec,omitempty"`
+}
+
+// AppliesTo reports whether the policy covers experiments targeting namespace
+func (p *ChaosPolicy) AppliesTo(namespace string) bool {
+	return len(p.Spec.Namespaces) == 0 || slices.Contains(p.Spec.Namespaces, namespace)
+}
+
+// SeverityRulesFor returns the severity rules of the policy covering severity
+func (p *ChaosPolicy) SeverityRulesFor(severity string) []SeverityRule {
+	var rules []SeverityRule
+	for _, rule := range p.Spec.SeverityRules {
+		if severityRank[rule.Severity] <= severityRank[severity] {
+			rules = append(rules, rule)
+		}
+	}
+	return rules
+}
+
+// severityRank orders the severities; unknown values rank below low
+var severityRank = map[string]int{SeverityLow: 1, SeverityMedium: 2, SeverityHigh: 3}
+
+// SeverityOf returns the severity of an experiment, medium when it is not set
+func SeverityOf(spec *ChaosExperimentSpec) string {
+	if spec.Severity == "" {
+		return SeverityMedium
+	}
+	return spec.Severity
+}
+
+// IsApproved reports whether someone other than the experiment's creator approved it
+func IsApproved(exp *ChaosExperiment) bool {
+	approver := exp.Annotations[ApprovedByAnnotation]
+	return approver != "" && approver != exp.Annotations[InitiatedByAnnotation]
+}
+
+// +kubebuilder:object:root=true
+
+// ChaosPolicyList contains a list of ChaosPolicy
+type ChaosPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ChaosPolicy `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ChaosPolicy{}, &ChaosPolicyList{})
+}
@@ -20,20 +20,24 @@ import (
 	"fmt"
 	"net"
 	"regexp"
+	"strconv"
 	"strings"
 	"time"
 
-	"github.com/robfig/cron/v3"
-)
+	"k8s.io/apimachinery/pkg/util/validation"
 
-// durationPattern matches the pattern used in the Duration field validation
-// Pattern: ^([0-9]+(s|m|h))+$
-var durationPattern = regexp.MustCompile(`^([0-9]+(s|m|h))+$`)
+	chaosduration "github.com/neogan74/k8s-chaos/internal/duration"
+	cronschedule "github.com/neogan74/k8s-chaos/internal/schedule"
+)
 
 // memorySizePattern matches the pattern used in the MemorySize field validation
 // Pattern: ^[0-9]+[MG]$
 var memorySizePattern = regexp.MustCompile(`^[0-9]+[MG]$`)
 
+// relativeLoadPattern matches the pattern used in the RelativeLoad field validation
+// Pattern: ^([1-9][0-9]?|100)%$
+var relativeLoadPattern = regexp.MustCompile(`^([1-9][0-9]?|100)%$`)
+
 // timeWindowClockPattern matches 24h HH:MM format.
 var timeWindowClockPattern = regexp.MustCompile(`^([01]\d|2[0-3]):[0-5]\d$`)
 
@@ -48,7 +52,7 @@ func (e *ValidationError) Error() string {
 }
 
 // ValidActions is the list of supported chaos actions
-var ValidActions = []string{"pod-kill", "pod-delay", "node-drain", "pod-cpu-stress", "pod-memory-stress", "pod-failure", "pod-network-loss", "network-partition", "pod-disk-fill", "pod-restart"}
+var ValidActions = []string{"pod-kill", "pod-delay", "node-drain", "pod-cpu-stress", "pod-memory-stress", "pod-failure", "pod-network-loss", "network-partition", "pod-disk-fill", "pod-restart", "lease-steal", "scheduler-pressure", "quota-squeeze", "admission-delay"}
 
 // IsValidAction checks if the given action is valid
 func IsValidAction(action string) bool {
@@ -60,15 +64,12 @@ func IsValidAction(action string) bool {
 	return false
 }
 
-// ValidateDurationFormat validates that a duration string matches the expected pattern
+// ValidateDurationFormat validates a duration of seconds, minutes and hours, such as "1h30m"
 func ValidateDurationFormat(duration string) error {
 	if duration == "" {
 		return nil // Duration is optional
 	}
-	if !durationPattern.MatchString(duration) {
-		return fmt.Errorf("duration must match pattern ^([0-9]+(s|m|h))+$, got: %s", duration)
-	}
-	return nil
+	return chaosduration.Validate(duration, chaosduration.Standard...)
 }
 
 // ValidateMemorySize validates that a memory size string matches the expected pattern
@@ -82,22 +83,39 @@ func ValidateMemorySize(memorySize string) error {
 	return nil
 }
 
+// ParseRelativeLoad returns the percentage of a relativeLoad such as "50%"
+func ParseRelativeLoad(load string) (int, error) {
+	if !relativeLoadPattern.MatchString(load) {
+		return 0, fmt.Errorf("relativeLoad must be a percentage from 1%% to 100%%, got: %s", load)
+	}
+	return strconv.Atoi(strings.TrimSuffix(load, "%"))
+}
+
 // ValidateSchedule validates that a cron schedule expression is valid
 func ValidateSchedule(schedule string) error {
 	if schedule == "" {
 		return nil // Schedule is optional
 	}
 
-	// Create a cron parser that supports standard cron format and special strings (@hourly, @daily, etc.)
-	parser := cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow | cron.Descriptor)
+	// Standard cron format and special strings (@hourly, @daily, etc.), as the controller parses them
+	_, err := cronschedule.Parse(schedule)
+	return err
+}
 
-	// Try to parse the schedule
-	_, err := parser.Parse(schedule)
-	if err != nil {
-		return fmt.Errorf("invalid cron schedule %q: %w", schedule, err)
+// ScheduleDefinition collects schedule, schedules and excludeSchedules of spec into the
+// definition the controller runs the experiment on. It is empty when the experiment has no
+// schedule. Invalid exclusion durations count as none; validation rejects them.
+func ScheduleDefinition(spec *ChaosExperimentSpec) cronschedule.Definition {
+	var d cronschedule.Definition
+	if spec.Schedule != "" {
+		d.Schedules = append(d.Schedules, spec.Schedule)
 	}
-
-	return nil
+	d.Schedules = append(d.Schedules, spec.Schedules...)
+	for _, exclusion := range spec.ExcludeSchedules {
+		window, _ := chaosduration.Parse(exclusion.Duration, chaosduration.WithDays...)
+		d.Exclusions = append(d.Exclusions, cronschedule.Exclusion{Schedule: exclusion.Schedule, Duration: window})
+	}
+	return d
 }
 
 // ValidateTimeWindows validates the time window configuration.
@@ -426,7 +444,7 @@ func parseMinute(minStr string) int {
 	return min
 }
 
-// ValidateCIDR validates that a string is a valid CIDR notation
+// ValidateCIDR validates that a string is a valid IPv4 or IPv6 CIDR notation
 // Returns error if the CIDR is invalid
 func ValidateCIDR(cidr string) error {
 	if cidr == "" {
@@ -439,15 +457,15 @@ func ValidateCIDR(cidr string) error {
 		return fmt.Errorf("invalid CIDR notation %q: %w", cidr, err)
 	}
 
-	// Additional validation: ensure it's IPv4 (we don't support IPv6 yet)
-	if ipNet.IP.To4() == nil {
-		return fmt.Errorf("CIDR %q is not IPv4 (IPv6 not supported)", cidr)
+	// iptables takes an IPv4 CIDR and ip6tables an IPv6 one; a mapped form fits neither
+	if ipNet.IP.To4() != nil && strings.Contains(cidr, ":") {
+		return fmt.Errorf("CIDR %q is an IPv4-mapped IPv6 CIDR, use the IPv4 form", cidr)
 	}
 
 	return nil
 }
 
-// ValidateIP validates that a string is a valid IP address
+// ValidateIP validates that a string is a valid IPv4 or IPv6 address
 // Returns error if the IP is invalid
 func ValidateIP(ip string) error {
 	if ip == "" {
@@ -460,14 +478,34 @@ func ValidateIP(ip string) error {
 		return fmt.Errorf("invalid IP address %q", ip)
 	}
 
-	// Additional validation: ensure it's IPv4
-	if parsedIP.To4() == nil {
-		return fmt.Errorf("IP %q is not IPv4 (IPv6 not supported)", ip)
+	if parsedIP.To4() != nil && strings.Contains(ip, ":") {
+		return fmt.Errorf("IP %q is an IPv4-mapped IPv6 address, use the IPv4 form", ip)
 	}
 
 	return nil
 }
 
+// ValidateExternalTarget validates an externalTargets entry: an IP address, a CIDR or a DNS name
+func ValidateExternalTarget(target string) error {
+	if target == "" {
+		return fmt.Errorf("external target cannot be empty")
+	}
+	if strings.Contains(target, "/") {
+		return ValidateCIDR(target)
+	}
+	// All-numeric entries and entries with colons are meant as addresses, even when they are not
+	// valid ones
+	if strings.Contains(target, ":") || numericTargetPattern.MatchString(target) {
+		return ValidateIP(target)
+	}
+	if errs := validation.IsDNS1123Subdomain(strings.TrimSuffix(target, ".")); len(errs) > 0 {
+		return fmt.Errorf("%q is not an IP address, CIDR or DNS name: %s", target, strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+var numericTargetPattern = regexp.MustCompile(`^[0-9.]+$`)
+
 // ValidatePortRange validates that a port number is in the valid range (1-65535)
 // Returns error if the port is out of range
 func ValidatePortRange(port int32) error {
@@ -488,12 +526,12 @@ func IsDangerousTarget(ip string) (bool, string) {
 
 	// Check for loopback (127.0.0.0/8)
 	if parsedIP.IsLoopback() {
-		return true, "Loopback address (127.0.0.1) - blocking this will break pod processes"
+		return true, "Loopback address (127.0.0.1, ::1) - blocking this will break pod processes"
 	}
 
-	// Check for link-local (169.254.0.0/16) - used for metadata services
+	// Check for link-local (169.254.0.0/16, fe80::/10) - used for metadata services
 	if parsedIP.IsLinkLocalUnicast() {
-		return true, "Link-local address (169.254.x.x) - may affect cloud metadata service"
+		return true, "Link-local address (169.254.x.x, fe80::/10) - may affect cloud metadata service"
 	}
 
 	// Common Kubernetes cluster service IP ranges
@@ -533,8 +571,7 @@ func IsDangerousCIDR(cidr string) (bool, string) {
 	}
 
 	// Check if this CIDR contains loopback
-	loopback := net.ParseIP("127.0.0.1")
-	if ipNet.Contains(loopback) {
+	if ipNet.Contains(net.IPv4(127, 0, 0, 1)) || ipNet.Contains(net.IPv6loopback) {
 		return true, "CIDR contains loopback range - will break pod processes"
 	}
 
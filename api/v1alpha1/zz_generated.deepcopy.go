@@ -21,10 +21,71 @@ limitations under the License.
 package v1alpha1
 
 import (
-	"k8s.io/apimachinery/pkg/apis/meta/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AdmissionDelay) DeepCopyInto(out *AdmissionDelay) {
+	*out = *in
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Operations != nil {
+		in, out := &in.Operations, &out.Operations
+		*out = make([]AdmissionOperation, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AdmissionDelay.
+func (in *AdmissionDelay) DeepCopy() *AdmissionDelay {
+	if in == nil {
+		return nil
+	}
+	out := new(AdmissionDelay)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AffectedPod) DeepCopyInto(out *AffectedPod) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AffectedPod.
+func (in *AffectedPod) DeepCopy() *AffectedPod {
+	if in == nil {
+		return nil
+	}
+	out := new(AffectedPod)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AffectedSummary) DeepCopyInto(out *AffectedSummary) {
+	*out = *in
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AffectedPod, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AffectedSummary.
+func (in *AffectedSummary) DeepCopy() *AffectedSummary {
+	if in == nil {
+		return nil
+	}
+	out := new(AffectedSummary)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AuditMetadata) DeepCopyInto(out *AuditMetadata) {
 	*out = *in
@@ -41,6 +102,282 @@ func (in *AuditMetadata) DeepCopy() *AuditMetadata {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AutoscalerActivity) DeepCopyInto(out *AutoscalerActivity) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoscalerActivity.
+func (in *AutoscalerActivity) DeepCopy() *AutoscalerActivity {
+	if in == nil {
+		return nil
+	}
+	out := new(AutoscalerActivity)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Balloons) DeepCopyInto(out *Balloons) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Balloons.
+func (in *Balloons) DeepCopy() *Balloons {
+	if in == nil {
+		return nil
+	}
+	out := new(Balloons)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BlastRadius) DeepCopyInto(out *BlastRadius) {
+	*out = *in
+	if in.Workloads != nil {
+		in, out := &in.Workloads, &out.Workloads
+		*out = make([]WorkloadImpact, len(*in))
+		copy(*out, *in)
+	}
+	if in.CPUSharePercent != nil {
+		in, out := &in.CPUSharePercent, &out.CPUSharePercent
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MemorySharePercent != nil {
+		in, out := &in.MemorySharePercent, &out.MemorySharePercent
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Topology != nil {
+		in, out := &in.Topology, &out.Topology
+		*out = new(BlastRadiusTopology)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BlastRadius.
+func (in *BlastRadius) DeepCopy() *BlastRadius {
+	if in == nil {
+		return nil
+	}
+	out := new(BlastRadius)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BlastRadiusTopology) DeepCopyInto(out *BlastRadiusTopology) {
+	*out = *in
+	if in.Nodes != nil {
+		in, out := &in.Nodes, &out.Nodes
+		*out = make([]TopologyNode, len(*in))
+		copy(*out, *in)
+	}
+	if in.Edges != nil {
+		in, out := &in.Edges, &out.Edges
+		*out = make([]TopologyEdge, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BlastRadiusTopology.
+func (in *BlastRadiusTopology) DeepCopy() *BlastRadiusTopology {
+	if in == nil {
+		return nil
+	}
+	out := new(BlastRadiusTopology)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CalendarConfigMapRef) DeepCopyInto(out *CalendarConfigMapRef) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CalendarConfigMapRef.
+func (in *CalendarConfigMapRef) DeepCopy() *CalendarConfigMapRef {
+	if in == nil {
+		return nil
+	}
+	out := new(CalendarConfigMapRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Cancellation) DeepCopyInto(out *Cancellation) {
+	*out = *in
+	if in.Targets != nil {
+		in, out := &in.Targets, &out.Targets
+		*out = make([]CancelledTarget, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Cancellation.
+func (in *Cancellation) DeepCopy() *Cancellation {
+	if in == nil {
+		return nil
+	}
+	out := new(Cancellation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CancelledTarget) DeepCopyInto(out *CancelledTarget) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CancelledTarget.
+func (in *CancelledTarget) DeepCopy() *CancelledTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(CancelledTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CapturedEvent) DeepCopyInto(out *CapturedEvent) {
+	*out = *in
+	in.LastTimestamp.DeepCopyInto(&out.LastTimestamp)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CapturedEvent.
+func (in *CapturedEvent) DeepCopy() *CapturedEvent {
+	if in == nil {
+		return nil
+	}
+	out := new(CapturedEvent)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChangeTicketStatus) DeepCopyInto(out *ChangeTicketStatus) {
+	*out = *in
+	if in.VerifiedAt != nil {
+		in, out := &in.VerifiedAt, &out.VerifiedAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChangeTicketStatus.
+func (in *ChangeTicketStatus) DeepCopy() *ChangeTicketStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ChangeTicketStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChaosCleanupTask) DeepCopyInto(out *ChaosCleanupTask) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChaosCleanupTask.
+func (in *ChaosCleanupTask) DeepCopy() *ChaosCleanupTask {
+	if in == nil {
+		return nil
+	}
+	out := new(ChaosCleanupTask)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ChaosCleanupTask) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChaosCleanupTaskList) DeepCopyInto(out *ChaosCleanupTaskList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ChaosCleanupTask, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChaosCleanupTaskList.
+func (in *ChaosCleanupTaskList) DeepCopy() *ChaosCleanupTaskList {
+	if in == nil {
+		return nil
+	}
+	out := new(ChaosCleanupTaskList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ChaosCleanupTaskList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChaosCleanupTaskSpec) DeepCopyInto(out *ChaosCleanupTaskSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChaosCleanupTaskSpec.
+func (in *ChaosCleanupTaskSpec) DeepCopy() *ChaosCleanupTaskSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ChaosCleanupTaskSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChaosCleanupTaskStatus) DeepCopyInto(out *ChaosCleanupTaskStatus) {
+	*out = *in
+	if in.LastAttemptTime != nil {
+		in, out := &in.LastAttemptTime, &out.LastAttemptTime
+		*out = (*in).DeepCopy()
+	}
+	if in.NextAttemptTime != nil {
+		in, out := &in.NextAttemptTime, &out.NextAttemptTime
+		*out = (*in).DeepCopy()
+	}
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChaosCleanupTaskStatus.
+func (in *ChaosCleanupTaskStatus) DeepCopy() *ChaosCleanupTaskStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ChaosCleanupTaskStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ChaosExperiment) DeepCopyInto(out *ChaosExperiment) {
 	*out = *in
@@ -138,12 +475,59 @@ func (in *ChaosExperimentHistorySpec) DeepCopyInto(out *ChaosExperimentHistorySp
 		*out = make([]ResourceReference, len(*in))
 		copy(*out, *in)
 	}
+	if in.BlastRadius != nil {
+		in, out := &in.BlastRadius, &out.BlastRadius
+		*out = new(BlastRadius)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Autoscalers != nil {
+		in, out := &in.Autoscalers, &out.Autoscalers
+		*out = make([]AutoscalerActivity, len(*in))
+		copy(*out, *in)
+	}
+	if in.NetworkMeasurements != nil {
+		in, out := &in.NetworkMeasurements, &out.NetworkMeasurements
+		*out = make([]NetworkMeasurementResult, len(*in))
+		copy(*out, *in)
+	}
+	if in.JobImpact != nil {
+		in, out := &in.JobImpact, &out.JobImpact
+		*out = make([]JobImpact, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	in.Audit.DeepCopyInto(&out.Audit)
 	if in.Error != nil {
 		in, out := &in.Error, &out.Error
 		*out = new(ErrorDetails)
 		**out = **in
 	}
+	if in.Regressions != nil {
+		in, out := &in.Regressions, &out.Regressions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Snapshot != nil {
+		in, out := &in.Snapshot, &out.Snapshot
+		*out = new(ResourceSnapshot)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Metrics != nil {
+		in, out := &in.Metrics, &out.Metrics
+		*out = make([]MetricSample, len(*in))
+		copy(*out, *in)
+	}
+	if in.Verdict != nil {
+		in, out := &in.Verdict, &out.Verdict
+		*out = new(Verdict)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Compaction != nil {
+		in, out := &in.Compaction, &out.Compaction
+		*out = new(HistoryCompaction)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChaosExperimentHistorySpec.
@@ -213,26 +597,95 @@ func (in *ChaosExperimentSpec) DeepCopyInto(out *ChaosExperimentSpec) {
 			(*out)[key] = val
 		}
 	}
-	if in.TargetIPs != nil {
-		in, out := &in.TargetIPs, &out.TargetIPs
+	if in.ExcludeOwners != nil {
+		in, out := &in.ExcludeOwners, &out.ExcludeOwners
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
-	if in.TargetCIDRs != nil {
-		in, out := &in.TargetCIDRs, &out.TargetCIDRs
+	if in.IncludeOwners != nil {
+		in, out := &in.IncludeOwners, &out.IncludeOwners
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
-	if in.TargetPorts != nil {
-		in, out := &in.TargetPorts, &out.TargetPorts
-		*out = make([]int32, len(*in))
-		copy(*out, *in)
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.NodeAffinity != nil {
+		in, out := &in.NodeAffinity, &out.NodeAffinity
+		*out = new(corev1.NodeSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TTLSecondsAfterFinished != nil {
+		in, out := &in.TTLSecondsAfterFinished, &out.TTLSecondsAfterFinished
+		*out = new(int32)
+		**out = **in
+	}
+	if in.TargetIPs != nil {
+		in, out := &in.TargetIPs, &out.TargetIPs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.TargetCIDRs != nil {
+		in, out := &in.TargetCIDRs, &out.TargetCIDRs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.TargetPorts != nil {
+		in, out := &in.TargetPorts, &out.TargetPorts
+		*out = make([]int32, len(*in))
+		copy(*out, *in)
 	}
 	if in.TargetProtocols != nil {
 		in, out := &in.TargetProtocols, &out.TargetProtocols
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.ExternalTargets != nil {
+		in, out := &in.ExternalTargets, &out.ExternalTargets
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.PeerSelector != nil {
+		in, out := &in.PeerSelector, &out.PeerSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.PeerNamespaces != nil {
+		in, out := &in.PeerNamespaces, &out.PeerNamespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.NetworkMeasurement != nil {
+		in, out := &in.NetworkMeasurement, &out.NetworkMeasurement
+		*out = new(NetworkMeasurement)
+		**out = **in
+	}
+	if in.SelectionSeed != nil {
+		in, out := &in.SelectionSeed, &out.SelectionSeed
+		*out = new(int64)
+		**out = **in
+	}
+	if in.NodeReplacement != nil {
+		in, out := &in.NodeReplacement, &out.NodeReplacement
+		*out = new(NodeReplacement)
+		**out = **in
+	}
+	if in.Schedules != nil {
+		in, out := &in.Schedules, &out.Schedules
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExcludeSchedules != nil {
+		in, out := &in.ExcludeSchedules, &out.ExcludeSchedules
+		*out = make([]ScheduleExclusion, len(*in))
+		copy(*out, *in)
+	}
 	if in.DependsOn != nil {
 		in, out := &in.DependsOn, &out.DependsOn
 		*out = make([]string, len(*in))
@@ -252,6 +705,31 @@ func (in *ChaosExperimentSpec) DeepCopyInto(out *ChaosExperimentSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Balloons != nil {
+		in, out := &in.Balloons, &out.Balloons
+		*out = new(Balloons)
+		**out = **in
+	}
+	if in.QuotaSqueeze != nil {
+		in, out := &in.QuotaSqueeze, &out.QuotaSqueeze
+		*out = new(QuotaSqueeze)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AdmissionDelay != nil {
+		in, out := &in.AdmissionDelay, &out.AdmissionDelay
+		*out = new(AdmissionDelay)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MetricsQueries != nil {
+		in, out := &in.MetricsQueries, &out.MetricsQueries
+		*out = make([]MetricsQuery, len(*in))
+		copy(*out, *in)
+	}
+	if in.SuccessCriteria != nil {
+		in, out := &in.SuccessCriteria, &out.SuccessCriteria
+		*out = make([]SuccessCriterion, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChaosExperimentSpec.
@@ -283,6 +761,27 @@ func (in *ChaosExperimentStatus) DeepCopyInto(out *ChaosExperimentStatus) {
 		in, out := &in.CompletedAt, &out.CompletedAt
 		*out = (*in).DeepCopy()
 	}
+	if in.FailureEndsAt != nil {
+		in, out := &in.FailureEndsAt, &out.FailureEndsAt
+		*out = (*in).DeepCopy()
+	}
+	if in.RollingRestart != nil {
+		in, out := &in.RollingRestart, &out.RollingRestart
+		*out = new(RollingRestartStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BalloonsEndAt != nil {
+		in, out := &in.BalloonsEndAt, &out.BalloonsEndAt
+		*out = (*in).DeepCopy()
+	}
+	if in.QuotaSqueezeEndsAt != nil {
+		in, out := &in.QuotaSqueezeEndsAt, &out.QuotaSqueezeEndsAt
+		*out = (*in).DeepCopy()
+	}
+	if in.AdmissionDelayEndsAt != nil {
+		in, out := &in.AdmissionDelayEndsAt, &out.AdmissionDelayEndsAt
+		*out = (*in).DeepCopy()
+	}
 	if in.LastScheduledTime != nil {
 		in, out := &in.LastScheduledTime, &out.LastScheduledTime
 		*out = (*in).DeepCopy()
@@ -296,14 +795,41 @@ func (in *ChaosExperimentStatus) DeepCopyInto(out *ChaosExperimentStatus) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.UnevictedPods != nil {
+		in, out := &in.UnevictedPods, &out.UnevictedPods
+		*out = make([]UnevictedPod, len(*in))
+		copy(*out, *in)
+	}
+	if in.NodeReplacements != nil {
+		in, out := &in.NodeReplacements, &out.NodeReplacements
+		*out = make([]NodeReplacementResult, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.TaintedNodes != nil {
 		in, out := &in.TaintedNodes, &out.TaintedNodes
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.StolenLeases != nil {
+		in, out := &in.StolenLeases, &out.StolenLeases
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.BalloonPods != nil {
+		in, out := &in.BalloonPods, &out.BalloonPods
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SqueezedQuotas != nil {
+		in, out := &in.SqueezedQuotas, &out.SqueezedQuotas
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
-		*out = make([]v1.Condition, len(*in))
+		*out = make([]metav1.Condition, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -313,6 +839,63 @@ func (in *ChaosExperimentStatus) DeepCopyInto(out *ChaosExperimentStatus) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.AffectedSummary != nil {
+		in, out := &in.AffectedSummary, &out.AffectedSummary
+		*out = new(AffectedSummary)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TargetResults != nil {
+		in, out := &in.TargetResults, &out.TargetResults
+		*out = make([]TargetResult, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.NetworkMeasurements != nil {
+		in, out := &in.NetworkMeasurements, &out.NetworkMeasurements
+		*out = make([]NetworkMeasurementResult, len(*in))
+		copy(*out, *in)
+	}
+	if in.SelectedTargets != nil {
+		in, out := &in.SelectedTargets, &out.SelectedTargets
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.BlastRadius != nil {
+		in, out := &in.BlastRadius, &out.BlastRadius
+		*out = new(BlastRadius)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Autoscalers != nil {
+		in, out := &in.Autoscalers, &out.Autoscalers
+		*out = make([]AutoscalerActivity, len(*in))
+		copy(*out, *in)
+	}
+	if in.ChangeTicket != nil {
+		in, out := &in.ChangeTicket, &out.ChangeTicket
+		*out = new(ChangeTicketStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Metrics != nil {
+		in, out := &in.Metrics, &out.Metrics
+		*out = make([]MetricSample, len(*in))
+		copy(*out, *in)
+	}
+	if in.Recovery != nil {
+		in, out := &in.Recovery, &out.Recovery
+		*out = new(RecoveryStatus)
+		**out = **in
+	}
+	if in.Verdict != nil {
+		in, out := &in.Verdict, &out.Verdict
+		*out = new(Verdict)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PendingCleanup != nil {
+		in, out := &in.PendingCleanup, &out.PendingCleanup
+		*out = make([]PendingCleanup, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChaosExperimentStatus.
@@ -326,101 +909,878 @@ func (in *ChaosExperimentStatus) DeepCopy() *ChaosExperimentStatus {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ErrorDetails) DeepCopyInto(out *ErrorDetails) {
+func (in *ChaosFreeze) DeepCopyInto(out *ChaosFreeze) {
 	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ErrorDetails.
-func (in *ErrorDetails) DeepCopy() *ErrorDetails {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChaosFreeze.
+func (in *ChaosFreeze) DeepCopy() *ChaosFreeze {
 	if in == nil {
 		return nil
 	}
-	out := new(ErrorDetails)
+	out := new(ChaosFreeze)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ChaosFreeze) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ExecutionDetails) DeepCopyInto(out *ExecutionDetails) {
+func (in *ChaosFreezeList) DeepCopyInto(out *ChaosFreezeList) {
 	*out = *in
-	in.StartTime.DeepCopyInto(&out.StartTime)
-	if in.EndTime != nil {
-		in, out := &in.EndTime, &out.EndTime
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ChaosFreeze, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChaosFreezeList.
+func (in *ChaosFreezeList) DeepCopy() *ChaosFreezeList {
+	if in == nil {
+		return nil
+	}
+	out := new(ChaosFreezeList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ChaosFreezeList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChaosFreezeSpec) DeepCopyInto(out *ChaosFreezeSpec) {
+	*out = *in
+	if in.ExpiresAt != nil {
+		in, out := &in.ExpiresAt, &out.ExpiresAt
 		*out = (*in).DeepCopy()
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExecutionDetails.
-func (in *ExecutionDetails) DeepCopy() *ExecutionDetails {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChaosFreezeSpec.
+func (in *ChaosFreezeSpec) DeepCopy() *ChaosFreezeSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ExecutionDetails)
+	out := new(ChaosFreezeSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ObjectReference) DeepCopyInto(out *ObjectReference) {
+func (in *ChaosMonkey) DeepCopyInto(out *ChaosMonkey) {
 	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectReference.
-func (in *ObjectReference) DeepCopy() *ObjectReference {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChaosMonkey.
+func (in *ChaosMonkey) DeepCopy() *ChaosMonkey {
 	if in == nil {
 		return nil
 	}
-	out := new(ObjectReference)
+	out := new(ChaosMonkey)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ChaosMonkey) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ResourceReference) DeepCopyInto(out *ResourceReference) {
+func (in *ChaosMonkeyList) DeepCopyInto(out *ChaosMonkeyList) {
 	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ChaosMonkey, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceReference.
-func (in *ResourceReference) DeepCopy() *ResourceReference {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChaosMonkeyList.
+func (in *ChaosMonkeyList) DeepCopy() *ChaosMonkeyList {
 	if in == nil {
 		return nil
 	}
-	out := new(ResourceReference)
+	out := new(ChaosMonkeyList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ChaosMonkeyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *TimeWindow) DeepCopyInto(out *TimeWindow) {
+func (in *ChaosMonkeySpec) DeepCopyInto(out *ChaosMonkeySpec) {
 	*out = *in
-	if in.DaysOfWeek != nil {
-		in, out := &in.DaysOfWeek, &out.DaysOfWeek
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Actions != nil {
+		in, out := &in.Actions, &out.Actions
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.TimeWindows != nil {
+		in, out := &in.TimeWindows, &out.TimeWindows
+		*out = make([]TimeWindow, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ExperimentTTLSecondsAfterFinished != nil {
+		in, out := &in.ExperimentTTLSecondsAfterFinished, &out.ExperimentTTLSecondsAfterFinished
+		*out = new(int32)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TimeWindow.
-func (in *TimeWindow) DeepCopy() *TimeWindow {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChaosMonkeySpec.
+func (in *ChaosMonkeySpec) DeepCopy() *ChaosMonkeySpec {
 	if in == nil {
 		return nil
 	}
-	out := new(TimeWindow)
+	out := new(ChaosMonkeySpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ValidationError) DeepCopyInto(out *ValidationError) {
+func (in *ChaosMonkeyStatus) DeepCopyInto(out *ChaosMonkeyStatus) {
 	*out = *in
+	if in.LastRunTime != nil {
+		in, out := &in.LastRunTime, &out.LastRunTime
+		*out = (*in).DeepCopy()
+	}
+	if in.NextRunTime != nil {
+		in, out := &in.NextRunTime, &out.NextRunTime
+		*out = (*in).DeepCopy()
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ValidationError.
-func (in *ValidationError) DeepCopy() *ValidationError {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChaosMonkeyStatus.
+func (in *ChaosMonkeyStatus) DeepCopy() *ChaosMonkeyStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ValidationError)
+	out := new(ChaosMonkeyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChaosPolicy) DeepCopyInto(out *ChaosPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChaosPolicy.
+func (in *ChaosPolicy) DeepCopy() *ChaosPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(ChaosPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ChaosPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChaosPolicyList) DeepCopyInto(out *ChaosPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ChaosPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChaosPolicyList.
+func (in *ChaosPolicyList) DeepCopy() *ChaosPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(ChaosPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ChaosPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChaosPolicySpec) DeepCopyInto(out *ChaosPolicySpec) {
+	*out = *in
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.RateLimit != nil {
+		in, out := &in.RateLimit, &out.RateLimit
+		*out = new(ChaosRateLimit)
+		**out = **in
+	}
+	if in.SeverityRules != nil {
+		in, out := &in.SeverityRules, &out.SeverityRules
+		*out = make([]SeverityRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.HolidayCalendars != nil {
+		in, out := &in.HolidayCalendars, &out.HolidayCalendars
+		*out = make([]HolidayCalendar, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChaosPolicySpec.
+func (in *ChaosPolicySpec) DeepCopy() *ChaosPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ChaosPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChaosRateLimit) DeepCopyInto(out *ChaosRateLimit) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChaosRateLimit.
+func (in *ChaosRateLimit) DeepCopy() *ChaosRateLimit {
+	if in == nil {
+		return nil
+	}
+	out := new(ChaosRateLimit)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContainerLogTail) DeepCopyInto(out *ContainerLogTail) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContainerLogTail.
+func (in *ContainerLogTail) DeepCopy() *ContainerLogTail {
+	if in == nil {
+		return nil
+	}
+	out := new(ContainerLogTail)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CriterionResult) DeepCopyInto(out *CriterionResult) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CriterionResult.
+func (in *CriterionResult) DeepCopy() *CriterionResult {
+	if in == nil {
+		return nil
+	}
+	out := new(CriterionResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ErrorDetails) DeepCopyInto(out *ErrorDetails) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ErrorDetails.
+func (in *ErrorDetails) DeepCopy() *ErrorDetails {
+	if in == nil {
+		return nil
+	}
+	out := new(ErrorDetails)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExecutionDetails) DeepCopyInto(out *ExecutionDetails) {
+	*out = *in
+	in.StartTime.DeepCopyInto(&out.StartTime)
+	if in.EndTime != nil {
+		in, out := &in.EndTime, &out.EndTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Cancellation != nil {
+		in, out := &in.Cancellation, &out.Cancellation
+		*out = new(Cancellation)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExecutionDetails.
+func (in *ExecutionDetails) DeepCopy() *ExecutionDetails {
+	if in == nil {
+		return nil
+	}
+	out := new(ExecutionDetails)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HistoryCompaction) DeepCopyInto(out *HistoryCompaction) {
+	*out = *in
+	in.CompactedAt.DeepCopyInto(&out.CompactedAt)
+	if in.AffectedResources != nil {
+		in, out := &in.AffectedResources, &out.AffectedResources
+		*out = make(map[string]int, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Removed != nil {
+		in, out := &in.Removed, &out.Removed
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HistoryCompaction.
+func (in *HistoryCompaction) DeepCopy() *HistoryCompaction {
+	if in == nil {
+		return nil
+	}
+	out := new(HistoryCompaction)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HolidayCalendar) DeepCopyInto(out *HolidayCalendar) {
+	*out = *in
+	if in.ConfigMapRef != nil {
+		in, out := &in.ConfigMapRef, &out.ConfigMapRef
+		*out = new(CalendarConfigMapRef)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HolidayCalendar.
+func (in *HolidayCalendar) DeepCopy() *HolidayCalendar {
+	if in == nil {
+		return nil
+	}
+	out := new(HolidayCalendar)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JobImpact) DeepCopyInto(out *JobImpact) {
+	*out = *in
+	if in.BackoffLimit != nil {
+		in, out := &in.BackoffLimit, &out.BackoffLimit
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JobImpact.
+func (in *JobImpact) DeepCopy() *JobImpact {
+	if in == nil {
+		return nil
+	}
+	out := new(JobImpact)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MetricSample) DeepCopyInto(out *MetricSample) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetricSample.
+func (in *MetricSample) DeepCopy() *MetricSample {
+	if in == nil {
+		return nil
+	}
+	out := new(MetricSample)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MetricsQuery) DeepCopyInto(out *MetricsQuery) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetricsQuery.
+func (in *MetricsQuery) DeepCopy() *MetricsQuery {
+	if in == nil {
+		return nil
+	}
+	out := new(MetricsQuery)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkMeasurement) DeepCopyInto(out *NetworkMeasurement) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkMeasurement.
+func (in *NetworkMeasurement) DeepCopy() *NetworkMeasurement {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkMeasurement)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkMeasurementResult) DeepCopyInto(out *NetworkMeasurementResult) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkMeasurementResult.
+func (in *NetworkMeasurementResult) DeepCopy() *NetworkMeasurementResult {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkMeasurementResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeReplacement) DeepCopyInto(out *NodeReplacement) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeReplacement.
+func (in *NodeReplacement) DeepCopy() *NodeReplacement {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeReplacement)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeReplacementResult) DeepCopyInto(out *NodeReplacementResult) {
+	*out = *in
+	in.DrainedAt.DeepCopyInto(&out.DrainedAt)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeReplacementResult.
+func (in *NodeReplacementResult) DeepCopy() *NodeReplacementResult {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeReplacementResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObjectReference) DeepCopyInto(out *ObjectReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectReference.
+func (in *ObjectReference) DeepCopy() *ObjectReference {
+	if in == nil {
+		return nil
+	}
+	out := new(ObjectReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PendingCleanup) DeepCopyInto(out *PendingCleanup) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PendingCleanup.
+func (in *PendingCleanup) DeepCopy() *PendingCleanup {
+	if in == nil {
+		return nil
+	}
+	out := new(PendingCleanup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QuotaSqueeze) DeepCopyInto(out *QuotaSqueeze) {
+	*out = *in
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuotaSqueeze.
+func (in *QuotaSqueeze) DeepCopy() *QuotaSqueeze {
+	if in == nil {
+		return nil
+	}
+	out := new(QuotaSqueeze)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RecoveryStatus) DeepCopyInto(out *RecoveryStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RecoveryStatus.
+func (in *RecoveryStatus) DeepCopy() *RecoveryStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RecoveryStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceReference) DeepCopyInto(out *ResourceReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceReference.
+func (in *ResourceReference) DeepCopy() *ResourceReference {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceSnapshot) DeepCopyInto(out *ResourceSnapshot) {
+	*out = *in
+	if in.Events != nil {
+		in, out := &in.Events, &out.Events
+		*out = make([]CapturedEvent, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Logs != nil {
+		in, out := &in.Logs, &out.Logs
+		*out = make([]ContainerLogTail, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceSnapshot.
+func (in *ResourceSnapshot) DeepCopy() *ResourceSnapshot {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceSnapshot)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RollingRestartStatus) DeepCopyInto(out *RollingRestartStatus) {
+	*out = *in
+	in.StartedAt.DeepCopyInto(&out.StartedAt)
+	if in.Pending != nil {
+		in, out := &in.Pending, &out.Pending
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.InjectedAt != nil {
+		in, out := &in.InjectedAt, &out.InjectedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.NextRestartAt != nil {
+		in, out := &in.NextRestartAt, &out.NextRestartAt
+		*out = (*in).DeepCopy()
+	}
+	if in.Restarted != nil {
+		in, out := &in.Restarted, &out.Restarted
+		*out = make([]ResourceReference, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RollingRestartStatus.
+func (in *RollingRestartStatus) DeepCopy() *RollingRestartStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RollingRestartStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScheduleExclusion) DeepCopyInto(out *ScheduleExclusion) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScheduleExclusion.
+func (in *ScheduleExclusion) DeepCopy() *ScheduleExclusion {
+	if in == nil {
+		return nil
+	}
+	out := new(ScheduleExclusion)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SeverityRule) DeepCopyInto(out *SeverityRule) {
+	*out = *in
+	if in.TimeWindows != nil {
+		in, out := &in.TimeWindows, &out.TimeWindows
+		*out = make([]TimeWindow, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeverityRule.
+func (in *SeverityRule) DeepCopy() *SeverityRule {
+	if in == nil {
+		return nil
+	}
+	out := new(SeverityRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SuccessCriterion) DeepCopyInto(out *SuccessCriterion) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SuccessCriterion.
+func (in *SuccessCriterion) DeepCopy() *SuccessCriterion {
+	if in == nil {
+		return nil
+	}
+	out := new(SuccessCriterion)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TargetResult) DeepCopyInto(out *TargetResult) {
+	*out = *in
+	if in.StartedAt != nil {
+		in, out := &in.StartedAt, &out.StartedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.ExitCode != nil {
+		in, out := &in.ExitCode, &out.ExitCode
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetResult.
+func (in *TargetResult) DeepCopy() *TargetResult {
+	if in == nil {
+		return nil
+	}
+	out := new(TargetResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TimeWindow) DeepCopyInto(out *TimeWindow) {
+	*out = *in
+	if in.DaysOfWeek != nil {
+		in, out := &in.DaysOfWeek, &out.DaysOfWeek
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TimeWindow.
+func (in *TimeWindow) DeepCopy() *TimeWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(TimeWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TopologyEdge) DeepCopyInto(out *TopologyEdge) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TopologyEdge.
+func (in *TopologyEdge) DeepCopy() *TopologyEdge {
+	if in == nil {
+		return nil
+	}
+	out := new(TopologyEdge)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TopologyNode) DeepCopyInto(out *TopologyNode) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TopologyNode.
+func (in *TopologyNode) DeepCopy() *TopologyNode {
+	if in == nil {
+		return nil
+	}
+	out := new(TopologyNode)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UnevictedPod) DeepCopyInto(out *UnevictedPod) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UnevictedPod.
+func (in *UnevictedPod) DeepCopy() *UnevictedPod {
+	if in == nil {
+		return nil
+	}
+	out := new(UnevictedPod)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ValidationError) DeepCopyInto(out *ValidationError) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ValidationError.
+func (in *ValidationError) DeepCopy() *ValidationError {
+	if in == nil {
+		return nil
+	}
+	out := new(ValidationError)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Verdict) DeepCopyInto(out *Verdict) {
+	*out = *in
+	in.EvaluatedAt.DeepCopyInto(&out.EvaluatedAt)
+	if in.Criteria != nil {
+		in, out := &in.Criteria, &out.Criteria
+		*out = make([]CriterionResult, len(*in))
+		copy(*out, *in)
+	}
+	if in.Observed != nil {
+		in, out := &in.Observed, &out.Observed
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Verdict.
+func (in *Verdict) DeepCopy() *Verdict {
+	if in == nil {
+		return nil
+	}
+	out := new(Verdict)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkloadImpact) DeepCopyInto(out *WorkloadImpact) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkloadImpact.
+func (in *WorkloadImpact) DeepCopy() *WorkloadImpact {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkloadImpact)
 	in.DeepCopyInto(out)
 	return out
 }
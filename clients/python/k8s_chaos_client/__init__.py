@@ -0,0 +1,5 @@
+"""Python client for the k8s-chaos experiment REST API."""
+
+from .client import TERMINAL_PHASES, ChaosAPIError, ChaosClient
+
+__all__ = ["ChaosAPIError", "ChaosClient", "TERMINAL_PHASES"]
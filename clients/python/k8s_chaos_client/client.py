@@ -0,0 +1,159 @@
+"""Thin client for the k8s-chaos experiment REST API, using only the standard library."""
+
+import json
+import time
+import urllib.error
+import urllib.parse
+import urllib.request
+from typing import Any, Dict, Iterator, Optional
+
+from .types import (
+    CapabilityReport,
+    ChaosExperiment,
+    ChaosExperimentHistoryList,
+    ChaosExperimentList,
+    ExperimentEvent,
+)
+
+TERMINAL_PHASES = ("Completed", "Failed")
+
+
+class ChaosAPIError(Exception):
+    """An error response of the API; status is the HTTP status code."""
+
+    def __init__(self, status: int, message: str):
+        super().__init__(f"{status}: {message}")
+        self.status = status
+        self.message = message
+
+
+class ChaosClient:
+    """Client for the REST API served by the controller with --api-bind-address.
+
+    base_url is the address of the API, e.g. http://chaos-api.chaos-system:8090, and token one of
+    the tokens of the --api-token-secret.
+    """
+
+    def __init__(self, base_url: str, token: str, timeout: float = 30.0):
+        self.base_url = base_url.rstrip("/")
+        self.token = token
+        self.timeout = timeout
+
+    # Experiments
+
+    def list_experiments(self, namespace: Optional[str] = None) -> ChaosExperimentList:
+        if namespace:
+            return self._request("GET", self._experiments(namespace))
+        return self._request("GET", "/api/v1/experiments")
+
+    def get_experiment(self, namespace: str, name: str) -> ChaosExperiment:
+        return self._request("GET", self._experiment(namespace, name))
+
+    def create_experiment(self, namespace: str, experiment: ChaosExperiment) -> ChaosExperiment:
+        return self._request("POST", self._experiments(namespace), experiment)
+
+    def update_experiment(self, namespace: str, name: str, experiment: ChaosExperiment) -> ChaosExperiment:
+        """Replace the spec of the experiment; labels, annotations and status are kept."""
+        return self._request("PUT", self._experiment(namespace, name), experiment)
+
+    def delete_experiment(self, namespace: str, name: str) -> None:
+        self._request("DELETE", self._experiment(namespace, name))
+
+    def pause(self, namespace: str, name: str) -> ChaosExperiment:
+        return self._request("POST", self._experiment(namespace, name) + "/pause")
+
+    def resume(self, namespace: str, name: str) -> ChaosExperiment:
+        return self._request("POST", self._experiment(namespace, name) + "/resume")
+
+    def abort(self, namespace: str, name: str) -> ChaosExperiment:
+        return self._request("POST", self._experiment(namespace, name) + "/abort")
+
+    def wait_for_phase(
+        self,
+        namespace: str,
+        name: str,
+        phases=TERMINAL_PHASES,
+        timeout: float = 600.0,
+        interval: float = 2.0,
+    ) -> ChaosExperiment:
+        """Poll the experiment until status.phase is one of phases and return it."""
+        deadline = time.monotonic() + timeout
+        while True:
+            experiment = self.get_experiment(namespace, name)
+            if experiment.get("status", {}).get("phase") in phases:
+                return experiment
+            if time.monotonic() >= deadline:
+                raise TimeoutError(f"experiment {namespace}/{name} did not reach {', '.join(phases)}")
+            time.sleep(interval)
+
+    # History
+
+    def experiment_history(self, namespace: str, name: str, **filters: Any) -> ChaosExperimentHistoryList:
+        """History records of the experiment, newest first. Accepts the filters of list_history."""
+        return self._request("GET", self._experiment(namespace, name) + "/history", query=filters)
+
+    def list_history(
+        self,
+        experiment: Optional[str] = None,
+        action: Optional[str] = None,
+        namespace: Optional[str] = None,
+        status: Optional[str] = None,
+        limit: Optional[int] = None,
+    ) -> ChaosExperimentHistoryList:
+        return self._request(
+            "GET",
+            "/api/v1/history",
+            query={"experiment": experiment, "action": action, "namespace": namespace, "status": status, "limit": limit},
+        )
+
+    # Capabilities and events
+
+    def capabilities(self, namespace: Optional[str] = None) -> CapabilityReport:
+        return self._request("GET", "/api/v1/capabilities", query={"namespace": namespace})
+
+    def events(self, namespace: Optional[str] = None, experiment: Optional[str] = None) -> Iterator[ExperimentEvent]:
+        """Yield experiment events as they happen until the connection is closed."""
+        request = self._build("GET", "/api/v1/events", query={"namespace": namespace, "experiment": experiment})
+        request.add_header("Accept", "text/event-stream")
+        with self._open(request, timeout=None) as response:
+            for raw in response:
+                line = raw.decode("utf-8").rstrip("\r\n")
+                if line.startswith("data:"):
+                    yield json.loads(line[len("data:"):].strip())
+
+    # Plumbing
+
+    @staticmethod
+    def _experiments(namespace: str) -> str:
+        return f"/api/v1/namespaces/{urllib.parse.quote(namespace)}/experiments"
+
+    def _experiment(self, namespace: str, name: str) -> str:
+        return f"{self._experiments(namespace)}/{urllib.parse.quote(name)}"
+
+    def _build(self, method: str, path: str, body: Any = None, query: Optional[Dict[str, Any]] = None):
+        url = self.base_url + path
+        params = {k: v for k, v in (query or {}).items() if v is not None}
+        if params:
+            url += "?" + urllib.parse.urlencode(params)
+        data = None if body is None else json.dumps(body).encode("utf-8")
+        request = urllib.request.Request(url, data=data, method=method)
+        request.add_header("Authorization", f"Bearer {self.token}")
+        if data is not None:
+            request.add_header("Content-Type", "application/json")
+        return request
+
+    def _open(self, request, timeout):
+        try:
+            return urllib.request.urlopen(request, timeout=timeout)
+        except urllib.error.HTTPError as err:
+            message = err.reason
+            try:
+                message = json.loads(err.read()).get("error", message)
+            except ValueError:
+                pass
+            raise ChaosAPIError(err.code, message) from None
+
+    def _request(self, method: str, path: str, body: Any = None, query: Optional[Dict[str, Any]] = None):
+        with self._open(self._build(method, path, body, query), timeout=self.timeout) as response:
+            payload = response.read()
+        return json.loads(payload) if payload else None
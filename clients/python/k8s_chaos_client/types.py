@@ -0,0 +1,1399 @@
+# Code generated by `make openapi` from api/openapi; DO NOT EDIT.
+# pylint: skip-file
+from typing import Any, Dict, List, Literal, TypedDict, Union
+
+CapabilityAction = TypedDict(
+    "CapabilityAction",
+    {
+        "capabilities": List[str],
+        "description": str,
+        "missing": List[str],
+        "name": str,
+        "privileged": bool,
+        "rbac": List[str],
+        "requiredFields": List[str],
+        "satisfied": bool,
+        "target": Literal["pod", "node"],
+    },
+    total=False,
+)
+
+CapabilityReport = TypedDict(
+    "CapabilityReport",
+    {
+        "actions": List["CapabilityAction"],
+        "namespace": str,
+        "podSecurityLevel": str,
+        "rbacChecked": bool,
+    },
+    total=False,
+)
+
+ChaosCleanupTaskSpec = TypedDict(
+    "ChaosCleanupTaskSpec",
+    {
+        "afterExperiment": bool,
+        "container": str,
+        "experiment": str,
+        "maxAttempts": int,
+        "node": str,
+        "operation": Literal["Uncordon", "Untaint", "StopContainer", "RestoreQuota", "RemoveWebhook"],
+        "pod": str,
+        "quota": str,
+        "taintEffect": Literal["NoSchedule", "PreferNoSchedule", "NoExecute"],
+        "taintKey": str,
+        "webhook": str,
+    },
+    total=False,
+)
+
+ChaosCleanupTaskStatus = TypedDict(
+    "ChaosCleanupTaskStatus",
+    {
+        "attempts": int,
+        "completionTime": str,
+        "lastAttemptTime": str,
+        "lastError": str,
+        "nextAttemptTime": str,
+        "phase": Literal["Pending", "Succeeded", "Failed"],
+    },
+    total=False,
+)
+
+ChaosCleanupTask = TypedDict(
+    "ChaosCleanupTask",
+    {
+        "apiVersion": str,
+        "kind": str,
+        "metadata": "ObjectMeta",
+        "spec": "ChaosCleanupTaskSpec",
+        "status": "ChaosCleanupTaskStatus",
+    },
+    total=False,
+)
+
+ChaosCleanupTaskList = TypedDict(
+    "ChaosCleanupTaskList",
+    {
+        "apiVersion": str,
+        "items": List["ChaosCleanupTask"],
+        "kind": str,
+        "metadata": "ListMeta",
+    },
+    total=False,
+)
+
+ChaosExperimentSpecAdmissionDelay = TypedDict(
+    "ChaosExperimentSpecAdmissionDelay",
+    {
+        "delay": str,
+        "operations": List[Literal["CREATE", "UPDATE", "DELETE"]],
+        "resources": List[str],
+    },
+    total=False,
+)
+
+ChaosExperimentSpecBalloons = TypedDict(
+    "ChaosExperimentSpecBalloons",
+    {
+        "cpu": str,
+        "memory": str,
+        "priorityClassName": str,
+        "replicas": int,
+    },
+    total=False,
+)
+
+ChaosExperimentSpecExcludeSchedules = TypedDict(
+    "ChaosExperimentSpecExcludeSchedules",
+    {
+        "duration": str,
+        "schedule": str,
+    },
+    total=False,
+)
+
+ChaosExperimentSpecMaintenanceWindows = TypedDict(
+    "ChaosExperimentSpecMaintenanceWindows",
+    {
+        "daysOfWeek": List[str],
+        "end": str,
+        "start": str,
+        "timezone": str,
+        "type": str,
+    },
+    total=False,
+)
+
+ChaosExperimentSpecMetricsQueries = TypedDict(
+    "ChaosExperimentSpecMetricsQueries",
+    {
+        "name": str,
+        "query": str,
+    },
+    total=False,
+)
+
+ChaosExperimentSpecNetworkMeasurement = TypedDict(
+    "ChaosExperimentSpecNetworkMeasurement",
+    {
+        "target": str,
+        "windowSeconds": int,
+    },
+    total=False,
+)
+
+ChaosExperimentSpecNodeAffinityNodeSelectorTermsMatchExpressions = TypedDict(
+    "ChaosExperimentSpecNodeAffinityNodeSelectorTermsMatchExpressions",
+    {
+        "key": str,
+        "operator": str,
+        "values": List[str],
+    },
+    total=False,
+)
+
+ChaosExperimentSpecNodeAffinityNodeSelectorTermsMatchFields = TypedDict(
+    "ChaosExperimentSpecNodeAffinityNodeSelectorTermsMatchFields",
+    {
+        "key": str,
+        "operator": str,
+        "values": List[str],
+    },
+    total=False,
+)
+
+ChaosExperimentSpecNodeAffinityNodeSelectorTerms = TypedDict(
+    "ChaosExperimentSpecNodeAffinityNodeSelectorTerms",
+    {
+        "matchExpressions": List["ChaosExperimentSpecNodeAffinityNodeSelectorTermsMatchExpressions"],
+        "matchFields": List["ChaosExperimentSpecNodeAffinityNodeSelectorTermsMatchFields"],
+    },
+    total=False,
+)
+
+ChaosExperimentSpecNodeAffinity = TypedDict(
+    "ChaosExperimentSpecNodeAffinity",
+    {
+        "nodeSelectorTerms": List["ChaosExperimentSpecNodeAffinityNodeSelectorTerms"],
+    },
+    total=False,
+)
+
+ChaosExperimentSpecNodeReplacement = TypedDict(
+    "ChaosExperimentSpecNodeReplacement",
+    {
+        "autoscaler": Literal["cluster-autoscaler", "karpenter"],
+        "timeout": str,
+    },
+    total=False,
+)
+
+ChaosExperimentSpecQuotaSqueeze = TypedDict(
+    "ChaosExperimentSpecQuotaSqueeze",
+    {
+        "headroomPercent": int,
+        "name": str,
+        "resources": List[str],
+    },
+    total=False,
+)
+
+ChaosExperimentSpecSuccessCriteria = TypedDict(
+    "ChaosExperimentSpecSuccessCriteria",
+    {
+        "expression": str,
+        "name": str,
+    },
+    total=False,
+)
+
+ChaosExperimentSpecTimeWindows = TypedDict(
+    "ChaosExperimentSpecTimeWindows",
+    {
+        "daysOfWeek": List[str],
+        "end": str,
+        "start": str,
+        "timezone": str,
+        "type": str,
+    },
+    total=False,
+)
+
+ChaosExperimentSpec = TypedDict(
+    "ChaosExperimentSpec",
+    {
+        "action": Literal["pod-kill", "pod-delay", "node-drain", "node-taint", "node-cpu-stress", "node-disk-fill", "pod-cpu-stress", "pod-memory-stress", "pod-failure", "pod-network-loss", "pod-network-corruption", "pod-disk-fill", "pod-restart", "network-partition", "lease-steal", "scheduler-pressure", "quota-squeeze", "admission-delay"],
+        "admissionDelay": "ChaosExperimentSpecAdmissionDelay",
+        "allowControlPlane": bool,
+        "allowProduction": bool,
+        "allowSingletonDisruption": bool,
+        "autoscalerPolicy": Literal["Observe", "HoldScaleDown"],
+        "balloons": "ChaosExperimentSpecBalloons",
+        "corruptionCorrelation": int,
+        "corruptionPercentage": int,
+        "count": int,
+        "cpuLoad": int,
+        "cpuWorkers": int,
+        "dependsOn": List[str],
+        "direction": Literal["both", "ingress", "egress"],
+        "drainTimeout": str,
+        "dryRun": bool,
+        "duration": str,
+        "excludeOwners": List[str],
+        "excludeSchedules": List["ChaosExperimentSpecExcludeSchedules"],
+        "experimentDuration": str,
+        "externalTargets": List[str],
+        "failureInterval": str,
+        "failureSignal": Literal["KILL", "TERM", "INT", "QUIT", "ABRT", "SEGV"],
+        "fillPercentage": int,
+        "ignoreEvictionAnnotations": bool,
+        "ignoreRollouts": bool,
+        "includeJobPods": bool,
+        "includeOwners": List[str],
+        "interval": str,
+        "leaseMode": Literal["Acquire", "Delete"],
+        "leaseName": str,
+        "lossCorrelation": int,
+        "lossPercentage": int,
+        "maintenanceWindows": List["ChaosExperimentSpecMaintenanceWindows"],
+        "maxNodes": int,
+        "maxPercentage": int,
+        "maxRetries": int,
+        "maxUnavailableNodes": int,
+        "memoryOvercommitPolicy": Literal["Refuse", "Clamp"],
+        "memorySize": str,
+        "memoryWorkers": int,
+        "metricsQueries": List["ChaosExperimentSpecMetricsQueries"],
+        "namespace": str,
+        "netAdminFallback": bool,
+        "networkMeasurement": "ChaosExperimentSpecNetworkMeasurement",
+        "nodeAffinity": "ChaosExperimentSpecNodeAffinity",
+        "nodeReplacement": "ChaosExperimentSpecNodeReplacement",
+        "nodeSelector": Dict[str, str],
+        "paused": bool,
+        "peerNamespaces": List[str],
+        "peerSelector": Dict[str, str],
+        "quotaSqueeze": "ChaosExperimentSpecQuotaSqueeze",
+        "relativeLoad": str,
+        "reserveBytes": str,
+        "reservePercentage": int,
+        "restartInterval": str,
+        "retryBackoff": Literal["exponential", "fixed"],
+        "retryDelay": str,
+        "schedule": str,
+        "scheduleJitter": str,
+        "schedules": List[str],
+        "selectionSeed": int,
+        "selectionStrategy": Literal["random", "oldest", "newest", "highest-cpu", "highest-memory", "one-per-node", "one-per-zone"],
+        "selector": Dict[str, str],
+        "severity": Literal["low", "medium", "high"],
+        "spreadBy": Literal["zone", "node", "owner"],
+        "spreadMode": Literal["spread", "concentrate"],
+        "stickyTargets": bool,
+        "successCriteria": List["ChaosExperimentSpecSuccessCriteria"],
+        "taintEffect": Literal["NoSchedule", "PreferNoSchedule", "NoExecute"],
+        "taintKey": str,
+        "taintValue": str,
+        "targetCIDRs": List[str],
+        "targetIPs": List[str],
+        "targetPath": str,
+        "targetPorts": List[int],
+        "targetProtocols": Literal["tcp", "udp", "icmp"],
+        "timeWindows": List["ChaosExperimentSpecTimeWindows"],
+        "ttlSecondsAfterFinished": int,
+        "volumeName": str,
+    },
+    total=False,
+)
+
+ChaosExperimentStatusAffectedSummaryItems = TypedDict(
+    "ChaosExperimentStatusAffectedSummaryItems",
+    {
+        "injections": int,
+        "name": str,
+        "namespace": str,
+    },
+    total=False,
+)
+
+ChaosExperimentStatusAffectedSummary = TypedDict(
+    "ChaosExperimentStatusAffectedSummary",
+    {
+        "injections": int,
+        "items": List["ChaosExperimentStatusAffectedSummaryItems"],
+        "pods": int,
+        "truncated": bool,
+    },
+    total=False,
+)
+
+ChaosExperimentStatusAutoscalers = TypedDict(
+    "ChaosExperimentStatusAutoscalers",
+    {
+        "currentReplicas": int,
+        "initialReplicas": int,
+        "name": str,
+        "peakReplicas": int,
+        "scaleDownHeld": bool,
+        "target": str,
+    },
+    total=False,
+)
+
+ChaosExperimentStatusBlastRadiusTopologyEdges = TypedDict(
+    "ChaosExperimentStatusBlastRadiusTopologyEdges",
+    {
+        "from": str,
+        "to": str,
+    },
+    total=False,
+)
+
+ChaosExperimentStatusBlastRadiusTopologyNodes = TypedDict(
+    "ChaosExperimentStatusBlastRadiusTopologyNodes",
+    {
+        "id": str,
+        "kind": str,
+        "name": str,
+    },
+    total=False,
+)
+
+ChaosExperimentStatusBlastRadiusTopology = TypedDict(
+    "ChaosExperimentStatusBlastRadiusTopology",
+    {
+        "edges": List["ChaosExperimentStatusBlastRadiusTopologyEdges"],
+        "nodes": List["ChaosExperimentStatusBlastRadiusTopologyNodes"],
+        "truncated": bool,
+    },
+    total=False,
+)
+
+ChaosExperimentStatusBlastRadiusWorkloads = TypedDict(
+    "ChaosExperimentStatusBlastRadiusWorkloads",
+    {
+        "affected": int,
+        "kind": str,
+        "name": str,
+        "percentage": int,
+        "total": int,
+    },
+    total=False,
+)
+
+ChaosExperimentStatusBlastRadius = TypedDict(
+    "ChaosExperimentStatusBlastRadius",
+    {
+        "affectedPods": int,
+        "cpuSharePercent": int,
+        "memorySharePercent": int,
+        "nodesTouched": int,
+        "topology": "ChaosExperimentStatusBlastRadiusTopology",
+        "workloads": List["ChaosExperimentStatusBlastRadiusWorkloads"],
+    },
+    total=False,
+)
+
+ChaosExperimentStatusChangeTicket = TypedDict(
+    "ChaosExperimentStatusChangeTicket",
+    {
+        "id": str,
+        "state": str,
+        "verifiedAt": str,
+    },
+    total=False,
+)
+
+ChaosExperimentStatusConditions = TypedDict(
+    "ChaosExperimentStatusConditions",
+    {
+        "lastTransitionTime": str,
+        "message": str,
+        "observedGeneration": int,
+        "reason": str,
+        "status": Literal["True", "False", "Unknown"],
+        "type": str,
+    },
+    total=False,
+)
+
+ChaosExperimentStatusMetrics = TypedDict(
+    "ChaosExperimentStatusMetrics",
+    {
+        "after": str,
+        "before": str,
+        "during": str,
+        "error": str,
+        "name": str,
+    },
+    total=False,
+)
+
+ChaosExperimentStatusNetworkMeasurements = TypedDict(
+    "ChaosExperimentStatusNetworkMeasurements",
+    {
+        "avgRTT": str,
+        "complete": bool,
+        "container": str,
+        "lossPercentage": int,
+        "maxRTT": str,
+        "packetsReceived": int,
+        "packetsSent": int,
+        "pod": str,
+        "samples": int,
+        "target": str,
+    },
+    total=False,
+)
+
+ChaosExperimentStatusNodeReplacements = TypedDict(
+    "ChaosExperimentStatusNodeReplacements",
+    {
+        "drainedAt": str,
+        "node": str,
+        "replacementNode": str,
+        "timeToReplacement": str,
+        "timedOut": bool,
+    },
+    total=False,
+)
+
+ChaosExperimentStatusPendingCleanup = TypedDict(
+    "ChaosExperimentStatusPendingCleanup",
+    {
+        "container": str,
+        "node": str,
+        "operation": Literal["Uncordon", "Untaint", "StopContainer", "RestoreQuota", "RemoveWebhook"],
+        "pod": str,
+        "quota": str,
+        "webhook": str,
+    },
+    total=False,
+)
+
+ChaosExperimentStatusRecovery = TypedDict(
+    "ChaosExperimentStatusRecovery",
+    {
+        "affected": int,
+        "gone": int,
+        "recovered": int,
+        "slowest": str,
+        "timedOut": int,
+        "tracked": int,
+    },
+    total=False,
+)
+
+ChaosExperimentStatusRollingRestartRestarted = TypedDict(
+    "ChaosExperimentStatusRollingRestartRestarted",
+    {
+        "action": str,
+        "details": str,
+        "kind": str,
+        "name": str,
+        "namespace": str,
+    },
+    total=False,
+)
+
+ChaosExperimentStatusRollingRestart = TypedDict(
+    "ChaosExperimentStatusRollingRestart",
+    {
+        "container": str,
+        "injectedAt": str,
+        "nextRestartAt": str,
+        "observed": bool,
+        "pending": List[str],
+        "pod": str,
+        "restartCount": int,
+        "restarted": List["ChaosExperimentStatusRollingRestartRestarted"],
+        "startedAt": str,
+    },
+    total=False,
+)
+
+ChaosExperimentStatusTargetResults = TypedDict(
+    "ChaosExperimentStatusTargetResults",
+    {
+        "container": str,
+        "exitCode": int,
+        "message": str,
+        "pod": str,
+        "reason": str,
+        "restarts": int,
+        "startedAt": str,
+        "state": Literal["Pending", "Running", "Succeeded", "Failed"],
+        "verification": Literal["Verified", "Unverified"],
+        "verificationMessage": str,
+    },
+    total=False,
+)
+
+ChaosExperimentStatusUnevictedPods = TypedDict(
+    "ChaosExperimentStatusUnevictedPods",
+    {
+        "node": str,
+        "pod": str,
+        "reason": str,
+    },
+    total=False,
+)
+
+ChaosExperimentStatusVerdictCriteria = TypedDict(
+    "ChaosExperimentStatusVerdictCriteria",
+    {
+        "message": str,
+        "name": str,
+        "passed": bool,
+    },
+    total=False,
+)
+
+ChaosExperimentStatusVerdict = TypedDict(
+    "ChaosExperimentStatusVerdict",
+    {
+        "criteria": List["ChaosExperimentStatusVerdictCriteria"],
+        "evaluatedAt": str,
+        "message": str,
+        "observed": Dict[str, str],
+        "result": Literal["Passed", "Failed"],
+    },
+    total=False,
+)
+
+ChaosExperimentStatus = TypedDict(
+    "ChaosExperimentStatus",
+    {
+        "admissionDelayEndsAt": str,
+        "admissionWebhook": str,
+        "affectedPods": List[str],
+        "affectedSummary": "ChaosExperimentStatusAffectedSummary",
+        "autoscalers": List["ChaosExperimentStatusAutoscalers"],
+        "balloonPods": List[str],
+        "balloonsEndAt": str,
+        "blastRadius": "ChaosExperimentStatusBlastRadius",
+        "changeTicket": "ChaosExperimentStatusChangeTicket",
+        "completedAt": str,
+        "conditions": List["ChaosExperimentStatusConditions"],
+        "cordonedNodes": List[str],
+        "failureEndsAt": str,
+        "lastError": str,
+        "lastRunTime": str,
+        "lastScheduledTime": str,
+        "message": str,
+        "metrics": List["ChaosExperimentStatusMetrics"],
+        "networkMeasurements": List["ChaosExperimentStatusNetworkMeasurements"],
+        "nextRetryTime": str,
+        "nextScheduledTime": str,
+        "nodeReplacements": List["ChaosExperimentStatusNodeReplacements"],
+        "pendingCleanup": List["ChaosExperimentStatusPendingCleanup"],
+        "phase": Literal["Pending", "Running", "Completed", "Failed", "Paused"],
+        "quotaSqueezeEndsAt": str,
+        "recovery": "ChaosExperimentStatusRecovery",
+        "retryCount": int,
+        "rollingRestart": "ChaosExperimentStatusRollingRestart",
+        "selectedTargets": List[str],
+        "squeezedQuotas": List[str],
+        "startTime": str,
+        "stolenLeases": List[str],
+        "taintedNodes": List[str],
+        "targetResults": List["ChaosExperimentStatusTargetResults"],
+        "unevictedPods": List["ChaosExperimentStatusUnevictedPods"],
+        "verdict": "ChaosExperimentStatusVerdict",
+    },
+    total=False,
+)
+
+ChaosExperiment = TypedDict(
+    "ChaosExperiment",
+    {
+        "apiVersion": str,
+        "kind": str,
+        "metadata": "ObjectMeta",
+        "spec": "ChaosExperimentSpec",
+        "status": "ChaosExperimentStatus",
+    },
+    total=False,
+)
+
+ChaosExperimentHistorySpecAffectedResources = TypedDict(
+    "ChaosExperimentHistorySpecAffectedResources",
+    {
+        "action": str,
+        "details": str,
+        "kind": str,
+        "name": str,
+        "namespace": str,
+    },
+    total=False,
+)
+
+ChaosExperimentHistorySpecAudit = TypedDict(
+    "ChaosExperimentHistorySpecAudit",
+    {
+        "changeTicket": str,
+        "changeTicketState": str,
+        "creationTimestamp": str,
+        "dryRun": bool,
+        "initiatedBy": str,
+        "initiatedVia": str,
+        "retryCount": int,
+        "scheduledExecution": bool,
+    },
+    total=False,
+)
+
+ChaosExperimentHistorySpecAutoscalers = TypedDict(
+    "ChaosExperimentHistorySpecAutoscalers",
+    {
+        "currentReplicas": int,
+        "initialReplicas": int,
+        "name": str,
+        "peakReplicas": int,
+        "scaleDownHeld": bool,
+        "target": str,
+    },
+    total=False,
+)
+
+ChaosExperimentHistorySpecBlastRadiusTopologyEdges = TypedDict(
+    "ChaosExperimentHistorySpecBlastRadiusTopologyEdges",
+    {
+        "from": str,
+        "to": str,
+    },
+    total=False,
+)
+
+ChaosExperimentHistorySpecBlastRadiusTopologyNodes = TypedDict(
+    "ChaosExperimentHistorySpecBlastRadiusTopologyNodes",
+    {
+        "id": str,
+        "kind": str,
+        "name": str,
+    },
+    total=False,
+)
+
+ChaosExperimentHistorySpecBlastRadiusTopology = TypedDict(
+    "ChaosExperimentHistorySpecBlastRadiusTopology",
+    {
+        "edges": List["ChaosExperimentHistorySpecBlastRadiusTopologyEdges"],
+        "nodes": List["ChaosExperimentHistorySpecBlastRadiusTopologyNodes"],
+        "truncated": bool,
+    },
+    total=False,
+)
+
+ChaosExperimentHistorySpecBlastRadiusWorkloads = TypedDict(
+    "ChaosExperimentHistorySpecBlastRadiusWorkloads",
+    {
+        "affected": int,
+        "kind": str,
+        "name": str,
+        "percentage": int,
+        "total": int,
+    },
+    total=False,
+)
+
+ChaosExperimentHistorySpecBlastRadius = TypedDict(
+    "ChaosExperimentHistorySpecBlastRadius",
+    {
+        "affectedPods": int,
+        "cpuSharePercent": int,
+        "memorySharePercent": int,
+        "nodesTouched": int,
+        "topology": "ChaosExperimentHistorySpecBlastRadiusTopology",
+        "workloads": List["ChaosExperimentHistorySpecBlastRadiusWorkloads"],
+    },
+    total=False,
+)
+
+ChaosExperimentHistorySpecCompaction = TypedDict(
+    "ChaosExperimentHistorySpecCompaction",
+    {
+        "affectedResources": Dict[str, int],
+        "compactedAt": str,
+        "originalSize": int,
+        "removed": List[str],
+    },
+    total=False,
+)
+
+ChaosExperimentHistorySpecError = TypedDict(
+    "ChaosExperimentHistorySpecError",
+    {
+        "code": str,
+        "failureReason": Literal["ValidationError", "ResourceNotFound", "PermissionDenied", "ExecutionError", "Timeout", "Unknown"],
+        "lastError": str,
+        "message": str,
+        "redacted": bool,
+    },
+    total=False,
+)
+
+ChaosExperimentHistorySpecExecutionCancellationTargets = TypedDict(
+    "ChaosExperimentHistorySpecExecutionCancellationTargets",
+    {
+        "elapsed": str,
+        "kind": str,
+        "name": str,
+        "percent": int,
+    },
+    total=False,
+)
+
+ChaosExperimentHistorySpecExecutionCancellation = TypedDict(
+    "ChaosExperimentHistorySpecExecutionCancellation",
+    {
+        "intendedDuration": str,
+        "reason": Literal["Deleted", "Aborted"],
+        "requestedBy": str,
+        "targets": List["ChaosExperimentHistorySpecExecutionCancellationTargets"],
+    },
+    total=False,
+)
+
+ChaosExperimentHistorySpecExecution = TypedDict(
+    "ChaosExperimentHistorySpecExecution",
+    {
+        "cancellation": "ChaosExperimentHistorySpecExecutionCancellation",
+        "duration": str,
+        "endTime": str,
+        "message": str,
+        "phase": Literal["Pending", "Running", "Completed", "Failed"],
+        "recoveryTime": str,
+        "startTime": str,
+        "status": Literal["success", "failure", "partial", "cancelled"],
+    },
+    total=False,
+)
+
+ChaosExperimentHistorySpecExperimentRef = TypedDict(
+    "ChaosExperimentHistorySpecExperimentRef",
+    {
+        "name": str,
+        "namespace": str,
+        "uid": str,
+    },
+    total=False,
+)
+
+ChaosExperimentHistorySpecExperimentSpecAdmissionDelay = TypedDict(
+    "ChaosExperimentHistorySpecExperimentSpecAdmissionDelay",
+    {
+        "delay": str,
+        "operations": List[Literal["CREATE", "UPDATE", "DELETE"]],
+        "resources": List[str],
+    },
+    total=False,
+)
+
+ChaosExperimentHistorySpecExperimentSpecBalloons = TypedDict(
+    "ChaosExperimentHistorySpecExperimentSpecBalloons",
+    {
+        "cpu": str,
+        "memory": str,
+        "priorityClassName": str,
+        "replicas": int,
+    },
+    total=False,
+)
+
+ChaosExperimentHistorySpecExperimentSpecExcludeSchedules = TypedDict(
+    "ChaosExperimentHistorySpecExperimentSpecExcludeSchedules",
+    {
+        "duration": str,
+        "schedule": str,
+    },
+    total=False,
+)
+
+ChaosExperimentHistorySpecExperimentSpecMaintenanceWindows = TypedDict(
+    "ChaosExperimentHistorySpecExperimentSpecMaintenanceWindows",
+    {
+        "daysOfWeek": List[str],
+        "end": str,
+        "start": str,
+        "timezone": str,
+        "type": str,
+    },
+    total=False,
+)
+
+ChaosExperimentHistorySpecExperimentSpecMetricsQueries = TypedDict(
+    "ChaosExperimentHistorySpecExperimentSpecMetricsQueries",
+    {
+        "name": str,
+        "query": str,
+    },
+    total=False,
+)
+
+ChaosExperimentHistorySpecExperimentSpecNetworkMeasurement = TypedDict(
+    "ChaosExperimentHistorySpecExperimentSpecNetworkMeasurement",
+    {
+        "target": str,
+        "windowSeconds": int,
+    },
+    total=False,
+)
+
+ChaosExperimentHistorySpecExperimentSpecNodeAffinityNodeSelectorTermsMatchExpressions = TypedDict(
+    "ChaosExperimentHistorySpecExperimentSpecNodeAffinityNodeSelectorTermsMatchExpressions",
+    {
+        "key": str,
+        "operator": str,
+        "values": List[str],
+    },
+    total=False,
+)
+
+ChaosExperimentHistorySpecExperimentSpecNodeAffinityNodeSelectorTermsMatchFields = TypedDict(
+    "ChaosExperimentHistorySpecExperimentSpecNodeAffinityNodeSelectorTermsMatchFields",
+    {
+        "key": str,
+        "operator": str,
+        "values": List[str],
+    },
+    total=False,
+)
+
+ChaosExperimentHistorySpecExperimentSpecNodeAffinityNodeSelectorTerms = TypedDict(
+    "ChaosExperimentHistorySpecExperimentSpecNodeAffinityNodeSelectorTerms",
+    {
+        "matchExpressions": List["ChaosExperimentHistorySpecExperimentSpecNodeAffinityNodeSelectorTermsMatchExpressions"],
+        "matchFields": List["ChaosExperimentHistorySpecExperimentSpecNodeAffinityNodeSelectorTermsMatchFields"],
+    },
+    total=False,
+)
+
+ChaosExperimentHistorySpecExperimentSpecNodeAffinity = TypedDict(
+    "ChaosExperimentHistorySpecExperimentSpecNodeAffinity",
+    {
+        "nodeSelectorTerms": List["ChaosExperimentHistorySpecExperimentSpecNodeAffinityNodeSelectorTerms"],
+    },
+    total=False,
+)
+
+ChaosExperimentHistorySpecExperimentSpecNodeReplacement = TypedDict(
+    "ChaosExperimentHistorySpecExperimentSpecNodeReplacement",
+    {
+        "autoscaler": Literal["cluster-autoscaler", "karpenter"],
+        "timeout": str,
+    },
+    total=False,
+)
+
+ChaosExperimentHistorySpecExperimentSpecQuotaSqueeze = TypedDict(
+    "ChaosExperimentHistorySpecExperimentSpecQuotaSqueeze",
+    {
+        "headroomPercent": int,
+        "name": str,
+        "resources": List[str],
+    },
+    total=False,
+)
+
+ChaosExperimentHistorySpecExperimentSpecSuccessCriteria = TypedDict(
+    "ChaosExperimentHistorySpecExperimentSpecSuccessCriteria",
+    {
+        "expression": str,
+        "name": str,
+    },
+    total=False,
+)
+
+ChaosExperimentHistorySpecExperimentSpecTimeWindows = TypedDict(
+    "ChaosExperimentHistorySpecExperimentSpecTimeWindows",
+    {
+        "daysOfWeek": List[str],
+        "end": str,
+        "start": str,
+        "timezone": str,
+        "type": str,
+    },
+    total=False,
+)
+
+ChaosExperimentHistorySpecExperimentSpec = TypedDict(
+    "ChaosExperimentHistorySpecExperimentSpec",
+    {
+        "action": Literal["pod-kill", "pod-delay", "node-drain", "node-taint", "node-cpu-stress", "node-disk-fill", "pod-cpu-stress", "pod-memory-stress", "pod-failure", "pod-network-loss", "pod-network-corruption", "pod-disk-fill", "pod-restart", "network-partition", "lease-steal", "scheduler-pressure", "quota-squeeze", "admission-delay"],
+        "admissionDelay": "ChaosExperimentHistorySpecExperimentSpecAdmissionDelay",
+        "allowControlPlane": bool,
+        "allowProduction": bool,
+        "allowSingletonDisruption": bool,
+        "autoscalerPolicy": Literal["Observe", "HoldScaleDown"],
+        "balloons": "ChaosExperimentHistorySpecExperimentSpecBalloons",
+        "corruptionCorrelation": int,
+        "corruptionPercentage": int,
+        "count": int,
+        "cpuLoad": int,
+        "cpuWorkers": int,
+        "dependsOn": List[str],
+        "direction": Literal["both", "ingress", "egress"],
+        "drainTimeout": str,
+        "dryRun": bool,
+        "duration": str,
+        "excludeOwners": List[str],
+        "excludeSchedules": List["ChaosExperimentHistorySpecExperimentSpecExcludeSchedules"],
+        "experimentDuration": str,
+        "externalTargets": List[str],
+        "failureInterval": str,
+        "failureSignal": Literal["KILL", "TERM", "INT", "QUIT", "ABRT", "SEGV"],
+        "fillPercentage": int,
+        "ignoreEvictionAnnotations": bool,
+        "ignoreRollouts": bool,
+        "includeJobPods": bool,
+        "includeOwners": List[str],
+        "leaseMode": Literal["Acquire", "Delete"],
+        "leaseName": str,
+        "lossCorrelation": int,
+        "lossPercentage": int,
+        "maintenanceWindows": List["ChaosExperimentHistorySpecExperimentSpecMaintenanceWindows"],
+        "maxNodes": int,
+        "maxPercentage": int,
+        "maxRetries": int,
+        "maxUnavailableNodes": int,
+        "memoryOvercommitPolicy": Literal["Refuse", "Clamp"],
+        "memorySize": str,
+        "memoryWorkers": int,
+        "metricsQueries": List["ChaosExperimentHistorySpecExperimentSpecMetricsQueries"],
+        "namespace": str,
+        "netAdminFallback": bool,
+        "networkMeasurement": "ChaosExperimentHistorySpecExperimentSpecNetworkMeasurement",
+        "nodeAffinity": "ChaosExperimentHistorySpecExperimentSpecNodeAffinity",
+        "nodeReplacement": "ChaosExperimentHistorySpecExperimentSpecNodeReplacement",
+        "nodeSelector": Dict[str, str],
+        "paused": bool,
+        "peerNamespaces": List[str],
+        "peerSelector": Dict[str, str],
+        "quotaSqueeze": "ChaosExperimentHistorySpecExperimentSpecQuotaSqueeze",
+        "relativeLoad": str,
+        "reserveBytes": str,
+        "reservePercentage": int,
+        "restartInterval": str,
+        "retryBackoff": Literal["exponential", "fixed"],
+        "retryDelay": str,
+        "schedule": str,
+        "schedules": List[str],
+        "selectionSeed": int,
+        "selectionStrategy": Literal["random", "oldest", "newest", "highest-cpu", "highest-memory", "one-per-node", "one-per-zone"],
+        "selector": Dict[str, str],
+        "severity": Literal["low", "medium", "high"],
+        "spreadBy": Literal["zone", "node", "owner"],
+        "spreadMode": Literal["spread", "concentrate"],
+        "stickyTargets": bool,
+        "successCriteria": List["ChaosExperimentHistorySpecExperimentSpecSuccessCriteria"],
+        "taintEffect": Literal["NoSchedule", "PreferNoSchedule", "NoExecute"],
+        "taintKey": str,
+        "taintValue": str,
+        "targetCIDRs": List[str],
+        "targetIPs": List[str],
+        "targetPath": str,
+        "targetPorts": List[int],
+        "targetProtocols": Literal["tcp", "udp", "icmp"],
+        "timeWindows": List["ChaosExperimentHistorySpecExperimentSpecTimeWindows"],
+        "ttlSecondsAfterFinished": int,
+        "volumeName": str,
+    },
+    total=False,
+)
+
+ChaosExperimentHistorySpecJobImpact = TypedDict(
+    "ChaosExperimentHistorySpecJobImpact",
+    {
+        "backoffLimit": int,
+        "cronJob": str,
+        "failed": int,
+        "name": str,
+        "outcome": Literal["Running", "Complete", "Failed", "Missing"],
+        "reason": str,
+    },
+    total=False,
+)
+
+ChaosExperimentHistorySpecMetrics = TypedDict(
+    "ChaosExperimentHistorySpecMetrics",
+    {
+        "after": str,
+        "before": str,
+        "during": str,
+        "error": str,
+        "name": str,
+    },
+    total=False,
+)
+
+ChaosExperimentHistorySpecNetworkMeasurements = TypedDict(
+    "ChaosExperimentHistorySpecNetworkMeasurements",
+    {
+        "avgRTT": str,
+        "complete": bool,
+        "container": str,
+        "lossPercentage": int,
+        "maxRTT": str,
+        "packetsReceived": int,
+        "packetsSent": int,
+        "pod": str,
+        "samples": int,
+        "target": str,
+    },
+    total=False,
+)
+
+ChaosExperimentHistorySpecSnapshotEvents = TypedDict(
+    "ChaosExperimentHistorySpecSnapshotEvents",
+    {
+        "count": int,
+        "lastTimestamp": str,
+        "message": str,
+        "object": str,
+        "reason": str,
+        "type": str,
+    },
+    total=False,
+)
+
+ChaosExperimentHistorySpecSnapshotLogs = TypedDict(
+    "ChaosExperimentHistorySpecSnapshotLogs",
+    {
+        "container": str,
+        "lines": str,
+        "pod": str,
+    },
+    total=False,
+)
+
+ChaosExperimentHistorySpecSnapshot = TypedDict(
+    "ChaosExperimentHistorySpecSnapshot",
+    {
+        "events": List["ChaosExperimentHistorySpecSnapshotEvents"],
+        "logs": List["ChaosExperimentHistorySpecSnapshotLogs"],
+        "redacted": bool,
+        "truncated": bool,
+    },
+    total=False,
+)
+
+ChaosExperimentHistorySpecVerdictCriteria = TypedDict(
+    "ChaosExperimentHistorySpecVerdictCriteria",
+    {
+        "message": str,
+        "name": str,
+        "passed": bool,
+    },
+    total=False,
+)
+
+ChaosExperimentHistorySpecVerdict = TypedDict(
+    "ChaosExperimentHistorySpecVerdict",
+    {
+        "criteria": List["ChaosExperimentHistorySpecVerdictCriteria"],
+        "evaluatedAt": str,
+        "message": str,
+        "observed": Dict[str, str],
+        "result": Literal["Passed", "Failed"],
+    },
+    total=False,
+)
+
+ChaosExperimentHistorySpec = TypedDict(
+    "ChaosExperimentHistorySpec",
+    {
+        "affectedResources": List["ChaosExperimentHistorySpecAffectedResources"],
+        "audit": "ChaosExperimentHistorySpecAudit",
+        "autoscalers": List["ChaosExperimentHistorySpecAutoscalers"],
+        "blastRadius": "ChaosExperimentHistorySpecBlastRadius",
+        "compaction": "ChaosExperimentHistorySpecCompaction",
+        "error": "ChaosExperimentHistorySpecError",
+        "execution": "ChaosExperimentHistorySpecExecution",
+        "experimentRef": "ChaosExperimentHistorySpecExperimentRef",
+        "experimentSpec": "ChaosExperimentHistorySpecExperimentSpec",
+        "jobImpact": List["ChaosExperimentHistorySpecJobImpact"],
+        "metrics": List["ChaosExperimentHistorySpecMetrics"],
+        "networkMeasurements": List["ChaosExperimentHistorySpecNetworkMeasurements"],
+        "regressions": List[str],
+        "snapshot": "ChaosExperimentHistorySpecSnapshot",
+        "verdict": "ChaosExperimentHistorySpecVerdict",
+    },
+    total=False,
+)
+
+ChaosExperimentHistoryStatus = TypedDict(
+    "ChaosExperimentHistoryStatus",
+    {
+        "archiveLocation": str,
+        "archived": bool,
+    },
+    total=False,
+)
+
+ChaosExperimentHistory = TypedDict(
+    "ChaosExperimentHistory",
+    {
+        "apiVersion": str,
+        "kind": str,
+        "metadata": "ObjectMeta",
+        "spec": "ChaosExperimentHistorySpec",
+        "status": "ChaosExperimentHistoryStatus",
+    },
+    total=False,
+)
+
+ChaosExperimentHistoryList = TypedDict(
+    "ChaosExperimentHistoryList",
+    {
+        "apiVersion": str,
+        "items": List["ChaosExperimentHistory"],
+        "kind": str,
+        "metadata": "ListMeta",
+    },
+    total=False,
+)
+
+ChaosExperimentList = TypedDict(
+    "ChaosExperimentList",
+    {
+        "apiVersion": str,
+        "items": List["ChaosExperiment"],
+        "kind": str,
+        "metadata": "ListMeta",
+    },
+    total=False,
+)
+
+ChaosFreezeSpec = TypedDict(
+    "ChaosFreezeSpec",
+    {
+        "expiresAt": str,
+        "reason": str,
+    },
+    total=False,
+)
+
+ChaosFreeze = TypedDict(
+    "ChaosFreeze",
+    {
+        "apiVersion": str,
+        "kind": str,
+        "metadata": "ObjectMeta",
+        "spec": "ChaosFreezeSpec",
+    },
+    total=False,
+)
+
+ChaosFreezeList = TypedDict(
+    "ChaosFreezeList",
+    {
+        "apiVersion": str,
+        "items": List["ChaosFreeze"],
+        "kind": str,
+        "metadata": "ListMeta",
+    },
+    total=False,
+)
+
+ChaosMonkeySpecTimeWindows = TypedDict(
+    "ChaosMonkeySpecTimeWindows",
+    {
+        "daysOfWeek": List[str],
+        "end": str,
+        "start": str,
+        "timezone": str,
+        "type": str,
+    },
+    total=False,
+)
+
+ChaosMonkeySpec = TypedDict(
+    "ChaosMonkeySpec",
+    {
+        "actions": List[Literal["pod-kill", "pod-restart", "pod-failure"]],
+        "allowProduction": bool,
+        "experimentTTLSecondsAfterFinished": int,
+        "interval": str,
+        "minReadyReplicas": int,
+        "namespaces": List[str],
+        "paused": bool,
+        "timeWindows": List["ChaosMonkeySpecTimeWindows"],
+    },
+    total=False,
+)
+
+ChaosMonkeyStatus = TypedDict(
+    "ChaosMonkeyStatus",
+    {
+        "lastExperiment": str,
+        "lastRunTime": str,
+        "lastTarget": str,
+        "message": str,
+        "nextRunTime": str,
+        "runs": int,
+    },
+    total=False,
+)
+
+ChaosMonkey = TypedDict(
+    "ChaosMonkey",
+    {
+        "apiVersion": str,
+        "kind": str,
+        "metadata": "ObjectMeta",
+        "spec": "ChaosMonkeySpec",
+        "status": "ChaosMonkeyStatus",
+    },
+    total=False,
+)
+
+ChaosMonkeyList = TypedDict(
+    "ChaosMonkeyList",
+    {
+        "apiVersion": str,
+        "items": List["ChaosMonkey"],
+        "kind": str,
+        "metadata": "ListMeta",
+    },
+    total=False,
+)
+
+ChaosPolicySpecHolidayCalendarsConfigMapRef = TypedDict(
+    "ChaosPolicySpecHolidayCalendarsConfigMapRef",
+    {
+        "key": str,
+        "name": str,
+        "namespace": str,
+    },
+    total=False,
+)
+
+ChaosPolicySpecHolidayCalendars = TypedDict(
+    "ChaosPolicySpecHolidayCalendars",
+    {
+        "configMapRef": "ChaosPolicySpecHolidayCalendarsConfigMapRef",
+        "name": str,
+        "timezone": str,
+        "url": str,
+    },
+    total=False,
+)
+
+ChaosPolicySpecRateLimit = TypedDict(
+    "ChaosPolicySpecRateLimit",
+    {
+        "maxInjections": int,
+        "minInterval": str,
+        "window": str,
+    },
+    total=False,
+)
+
+ChaosPolicySpecSeverityRulesTimeWindows = TypedDict(
+    "ChaosPolicySpecSeverityRulesTimeWindows",
+    {
+        "daysOfWeek": List[str],
+        "end": str,
+        "start": str,
+        "timezone": str,
+        "type": str,
+    },
+    total=False,
+)
+
+ChaosPolicySpecSeverityRules = TypedDict(
+    "ChaosPolicySpecSeverityRules",
+    {
+        "deny": bool,
+        "requireApproval": bool,
+        "requireChangeTicket": bool,
+        "severity": Literal["low", "medium", "high"],
+        "timeWindows": List["ChaosPolicySpecSeverityRulesTimeWindows"],
+    },
+    total=False,
+)
+
+ChaosPolicySpec = TypedDict(
+    "ChaosPolicySpec",
+    {
+        "holidayCalendars": List["ChaosPolicySpecHolidayCalendars"],
+        "namespaces": List[str],
+        "rateLimit": "ChaosPolicySpecRateLimit",
+        "severityRules": List["ChaosPolicySpecSeverityRules"],
+    },
+    total=False,
+)
+
+ChaosPolicy = TypedDict(
+    "ChaosPolicy",
+    {
+        "apiVersion": str,
+        "kind": str,
+        "metadata": "ObjectMeta",
+        "spec": "ChaosPolicySpec",
+    },
+    total=False,
+)
+
+ChaosPolicyList = TypedDict(
+    "ChaosPolicyList",
+    {
+        "apiVersion": str,
+        "items": List["ChaosPolicy"],
+        "kind": str,
+        "metadata": "ListMeta",
+    },
+    total=False,
+)
+
+Error = TypedDict(
+    "Error",
+    {
+        "code": int,
+        "error": str,
+    },
+    total=False,
+)
+
+ExperimentEvent = TypedDict(
+    "ExperimentEvent",
+    {
+        "experiment": str,
+        "message": str,
+        "namespace": str,
+        "phase": str,
+        "target": str,
+        "time": str,
+        "type": str,
+        "warning": bool,
+    },
+    total=False,
+)
+
+ListMeta = TypedDict(
+    "ListMeta",
+    {
+        "continue": str,
+        "resourceVersion": str,
+    },
+    total=False,
+)
+
+ObjectMeta = TypedDict(
+    "ObjectMeta",
+    {
+        "annotations": Dict[str, str],
+        "creationTimestamp": str,
+        "deletionTimestamp": str,
+        "finalizers": List[str],
+        "generation": int,
+        "labels": Dict[str, str],
+        "name": str,
+        "namespace": str,
+        "resourceVersion": str,
+        "uid": str,
+    },
+    total=False,
+)
@@ -0,0 +1,192 @@
+// Thin client for the k8s-chaos experiment REST API, built on fetch.
+import type {
+  CapabilityReport,
+  ChaosExperiment,
+  ChaosExperimentHistoryList,
+  ChaosExperimentList,
+  ExperimentEvent,
+} from "./types";
+
+export * from "./types";
+
+export const TERMINAL_PHASES = ["Completed", "Failed"];
+
+/** An error response of the API. */
+export class ChaosAPIError extends Error {
+  constructor(
+    public readonly status: number,
+    message: string,
+  ) {
+    super(`${status}: ${message}`);
+    this.name = "ChaosAPIError";
+  }
+}
+
+export interface HistoryFilters {
+  experiment?: string;
+  action?: string;
+  /** Target namespace of the recorded runs. */
+  namespace?: string;
+  status?: string;
+  limit?: number;
+}
+
+type Query = Record<string, string | number | undefined>;
+
+/**
+ * Client for the REST API served by the controller with --api-bind-address. baseURL is the
+ * address of the API, e.g. http://chaos-api.chaos-system:8090, and token one of the tokens of the
+ * --api-token-secret.
+ */
+export class ChaosClient {
+  private readonly baseURL: string;
+
+  constructor(
+    baseURL: string,
+    private readonly token: string,
+    private readonly fetchImpl: typeof fetch = globalThis.fetch.bind(globalThis),
+  ) {
+    this.baseURL = baseURL.replace(/\/+$/, "");
+  }
+
+  listExperiments(namespace?: string): Promise<ChaosExperimentList> {
+    return this.request("GET", namespace ? experimentsPath(namespace) : "/api/v1/experiments");
+  }
+
+  getExperiment(namespace: string, name: string): Promise<ChaosExperiment> {
+    return this.request("GET", experimentPath(namespace, name));
+  }
+
+  createExperiment(namespace: string, experiment: ChaosExperiment): Promise<ChaosExperiment> {
+    return this.request("POST", experimentsPath(namespace), experiment);
+  }
+
+  /** Replace the spec of the experiment; labels, annotations and status are kept. */
+  updateExperiment(namespace: string, name: string, experiment: ChaosExperiment): Promise<ChaosExperiment> {
+    return this.request("PUT", experimentPath(namespace, name), experiment);
+  }
+
+  async deleteExperiment(namespace: string, name: string): Promise<void> {
+    await this.request("DELETE", experimentPath(namespace, name));
+  }
+
+  pause(namespace: string, name: string): Promise<ChaosExperiment> {
+    return this.request("POST", `${experimentPath(namespace, name)}/pause`);
+  }
+
+  resume(namespace: string, name: string): Promise<ChaosExperiment> {
+    return this.request("POST", `${experimentPath(namespace, name)}/resume`);
+  }
+
+  abort(namespace: string, name: string): Promise<ChaosExperiment> {
+    return this.request("POST", `${experimentPath(namespace, name)}/abort`);
+  }
+
+  /** Poll the experiment until status.phase is one of phases and return it. */
+  async waitForPhase(
+    namespace: string,
+    name: string,
+    phases: string[] = TERMINAL_PHASES,
+    timeoutMs = 600_000,
+    intervalMs = 2_000,
+  ): Promise<ChaosExperiment> {
+    const deadline = Date.now() + timeoutMs;
+    for (;;) {
+      const experiment = await this.getExperiment(namespace, name);
+      if (phases.includes(experiment.status?.phase ?? "")) {
+        return experiment;
+      }
+      if (Date.now() >= deadline) {
+        throw new Error(`experiment ${namespace}/${name} did not reach ${phases.join(", ")}`);
+      }
+      await new Promise((resolve) => setTimeout(resolve, intervalMs));
+    }
+  }
+
+  /** History records of the experiment, newest first. */
+  experimentHistory(namespace: string, name: string, filters: HistoryFilters = {}): Promise<ChaosExperimentHistoryList> {
+    return this.request("GET", `${experimentPath(namespace, name)}/history`, undefined, { ...filters });
+  }
+
+  listHistory(filters: HistoryFilters = {}): Promise<ChaosExperimentHistoryList> {
+    return this.request("GET", "/api/v1/history", undefined, { ...filters });
+  }
+
+  capabilities(namespace?: string): Promise<CapabilityReport> {
+    return this.request("GET", "/api/v1/capabilities", undefined, { namespace });
+  }
+
+  /** Yield experiment events as they happen until the stream ends or signal is aborted. */
+  async *events(
+    filters: { namespace?: string; experiment?: string } = {},
+    signal?: AbortSignal,
+  ): AsyncGenerator<ExperimentEvent> {
+    const response = await this.fetch("GET", "/api/v1/events", undefined, { ...filters }, signal);
+    if (!response.body) {
+      return;
+    }
+    const reader = response.body.getReader();
+    const decoder = new TextDecoder();
+    let buffered = "";
+    for (;;) {
+      const { done, value } = await reader.read();
+      if (done) {
+        return;
+      }
+      buffered += decoder.decode(value, { stream: true });
+      let newline: number;
+      while ((newline = buffered.indexOf("\n")) >= 0) {
+        const line = buffered.slice(0, newline).replace(/\r$/, "");
+        buffered = buffered.slice(newline + 1);
+        if (line.startsWith("data:")) {
+          yield JSON.parse(line.slice("data:".length).trim()) as ExperimentEvent;
+        }
+      }
+    }
+  }
+
+  private async request<T>(method: string, path: string, body?: unknown, query?: Query): Promise<T> {
+    const response = await this.fetch(method, path, body, query);
+    const text = await response.text();
+    return (text ? JSON.parse(text) : undefined) as T;
+  }
+
+  private async fetch(method: string, path: string, body?: unknown, query?: Query, signal?: AbortSignal): Promise<Response> {
+    const params = new URLSearchParams();
+    for (const [key, value] of Object.entries(query ?? {})) {
+      if (value !== undefined && value !== "") {
+        params.set(key, String(value));
+      }
+    }
+    const search = params.toString();
+    const url = this.baseURL + path + (search ? `?${search}` : "");
+    const headers: Record<string, string> = { Authorization: `Bearer ${this.token}` };
+    if (body !== undefined) {
+      headers["Content-Type"] = "application/json";
+    }
+    const response = await this.fetchImpl(url, {
+      method,
+      headers,
+      body: body === undefined ? undefined : JSON.stringify(body),
+      signal,
+    });
+    if (!response.ok) {
+      let message = response.statusText;
+      try {
+        message = ((await response.json()) as { error?: string }).error ?? message;
+      } catch {
+        // not a JSON error body
+      }
+      throw new ChaosAPIError(response.status, message);
+    }
+    return response;
+  }
+}
+
+function experimentsPath(namespace: string): string {
+  return `/api/v1/namespaces/${encodeURIComponent(namespace)}/experiments`;
+}
+
+function experimentPath(namespace: string, name: string): string {
+  return `${experimentsPath(namespace)}/${encodeURIComponent(name)}`;
+}
@@ -0,0 +1,2339 @@
+// Code generated by `make openapi` from api/openapi; DO NOT EDIT.
+
+export interface CapabilityAction {
+  capabilities?: string[];
+  description: string;
+  missing?: string[];
+  name: string;
+  privileged?: boolean;
+  rbac: string[];
+  requiredFields?: string[];
+  satisfied?: boolean;
+  target: "pod" | "node";
+}
+
+export interface CapabilityReport {
+  actions: CapabilityAction[];
+  namespace?: string;
+  podSecurityLevel?: string;
+  rbacChecked: boolean;
+}
+
+/**
+ * ChaosCleanupTask is the Schema for the chaoscleanuptasks API
+ * The experiment controller creates a task for every revert it cannot finish itself, e.g. a node
+ * that failed to uncordon or a pod it has no room to track in its status. A dedicated controller
+ * retries the task until it succeeds, independent of the experiment, which may have completed or
+ * been deleted in the meantime.
+ */
+export interface ChaosCleanupTask {
+  /**
+   * APIVersion defines the versioned schema of this representation of an object.
+   * Servers should convert recognized schemas to the latest internal value, and
+   * may reject unrecognized values.
+   * More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
+   */
+  apiVersion?: string;
+  /**
+   * Kind is a string value representing the REST resource this object represents.
+   * Servers may infer this from the endpoint the client submits requests to.
+   * Cannot be updated.
+   * In CamelCase.
+   * More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+   */
+  kind?: string;
+  metadata?: ObjectMeta;
+  /** ChaosCleanupTaskSpec describes one revert left to do for an experiment */
+  spec?: {
+    /**
+     * AfterExperiment holds the revert until the experiment is no longer running. The controller
+     * sets it for injections that must last as long as the experiment, e.g. affected pods that did
+     * not fit status.affectedPods.
+     */
+    afterExperiment?: boolean;
+    /** Container is the ephemeral container StopContainer stops */
+    container?: string;
+    /**
+     * Experiment is the name of the experiment, in the task's namespace, whose injection is reverted.
+     * Tasks outlive their experiment, so it may be gone.
+     */
+    experiment?: string;
+    /** MaxAttempts is how often the revert is tried, with exponential backoff, before the task fails */
+    maxAttempts?: number;
+    /** Node to uncordon or untaint */
+    node?: string;
+    /** Operation is the revert to run */
+    operation: "Uncordon" | "Untaint" | "StopContainer" | "RestoreQuota" | "RemoveWebhook";
+    /** Pod whose ephemeral container StopContainer stops, as "namespace/name" */
+    pod?: string;
+    /** Quota is the ResourceQuota RestoreQuota restores, as "namespace/name" */
+    quota?: string;
+    /** TaintEffect is the effect of the taint Untaint removes */
+    taintEffect?: "NoSchedule" | "PreferNoSchedule" | "NoExecute";
+    /** TaintKey is the key of the taint Untaint removes */
+    taintKey?: string;
+    /** Webhook is the ValidatingWebhookConfiguration RemoveWebhook deletes */
+    webhook?: string;
+  };
+  /** ChaosCleanupTaskStatus defines the observed state of ChaosCleanupTask */
+  status?: {
+    /** Attempts is the number of times the revert was tried */
+    attempts?: number;
+    /** CompletionTime is when the task succeeded or failed */
+    completionTime?: string;
+    /** LastAttemptTime is when the revert was last tried */
+    lastAttemptTime?: string;
+    /** LastError is the error of the last failed attempt */
+    lastError?: string;
+    /** NextAttemptTime is when a pending task is tried again */
+    nextAttemptTime?: string;
+    /** Phase is Pending until the revert succeeds or runs out of attempts */
+    phase?: "Pending" | "Succeeded" | "Failed";
+  };
+}
+
+export interface ChaosCleanupTaskList {
+  apiVersion?: string;
+  items: ChaosCleanupTask[];
+  kind?: string;
+  metadata?: ListMeta;
+}
+
+/** ChaosExperiment is the Schema for the chaosexperiments API */
+export interface ChaosExperiment {
+  /**
+   * APIVersion defines the versioned schema of this representation of an object.
+   * Servers should convert recognized schemas to the latest internal value, and
+   * may reject unrecognized values.
+   * More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
+   */
+  apiVersion?: string;
+  /**
+   * Kind is a string value representing the REST resource this object represents.
+   * Servers may infer this from the endpoint the client submits requests to.
+   * Cannot be updated.
+   * In CamelCase.
+   * More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+   */
+  kind?: string;
+  metadata?: ObjectMeta;
+  /** spec defines the desired state of ChaosExperiment */
+  spec: {
+    /** Action specifies the chaos action to perform */
+    action: "pod-kill" | "pod-delay" | "node-drain" | "node-taint" | "node-cpu-stress" | "node-disk-fill" | "pod-cpu-stress" | "pod-memory-stress" | "pod-failure" | "pod-network-loss" | "pod-network-corruption" | "pod-disk-fill" | "pod-restart" | "network-partition" | "lease-steal" | "scheduler-pressure" | "quota-squeeze" | "admission-delay";
+    /**
+     * AdmissionDelay configures the no-op webhook admission-delay registers for spec.duration to slow
+     * down API requests for the given resources in spec.namespace (admission-delay only). Only objects
+     * matching spec.selector are delayed; the webhook fails open and is removed when the run ends.
+     */
+    admissionDelay?: {
+      /** Delay is how long each matching request is held (e.g., "5s"), at most 25s */
+      delay: string;
+      /** Operations are the request operations delayed */
+      operations?: Array<"CREATE" | "UPDATE" | "DELETE">;
+      /**
+       * Resources are the resources whose requests are delayed, as "resource" for the core group or
+       * "resource.group" (e.g., "configmaps", "deployments.apps"). Subresources, leases, events and
+       * chaos.gushchin.dev resources are refused.
+       */
+      resources: string[];
+    };
+    /**
+     * AllowControlPlane allows node-drain to target control-plane nodes
+     * Nodes labeled node-role.kubernetes.io/control-plane (or master) are skipped by default
+     */
+    allowControlPlane?: boolean;
+    /**
+     * AllowProduction explicitly allows experiments in production namespaces
+     * Production namespaces are identified by annotations or labels (environment=production, env=prod)
+     */
+    allowProduction?: boolean;
+    /**
+     * AllowSingletonDisruption allows targeting pods that are the only ready replica of their owner
+     * or that currently hold a leader-election lease. Such pods are skipped by default.
+     */
+    allowSingletonDisruption?: boolean;
+    /**
+     * AutoscalerPolicy controls how HorizontalPodAutoscalers of the targets are handled during
+     * pod-cpu-stress and pod-memory-stress. Observe records their replica counts in status.autoscalers;
+     * HoldScaleDown additionally disables scale-down until the experiment completes, so scale-up
+     * reactions to the stress can be measured without the autoscaler undoing them.
+     */
+    autoscalerPolicy?: "Observe" | "HoldScaleDown";
+    /**
+     * Balloons configures the placeholder pods scheduler-pressure creates in spec.namespace for
+     * spec.duration (scheduler-pressure only). spec.selector matches the workload pods whose
+     * priority is checked against the balloons' and whose preemption is recorded; spec.nodeSelector
+     * and spec.nodeAffinity pin the balloons to those nodes.
+     */
+    balloons?: {
+      /** CPU requested by each balloon pod (e.g., "500m") */
+      cpu?: string;
+      /** Memory requested by each balloon pod (e.g., "256Mi") */
+      memory?: string;
+      /**
+       * PriorityClassName is the PriorityClass of the balloon pods. A class above the priority of the
+       * workload pods makes the scheduler preempt them to fit the balloons; one below leaves the
+       * balloons Pending wherever capacity is short, churning the scheduling queue.
+       */
+      priorityClassName: string;
+      /** Replicas is the number of balloon pods */
+      replicas?: number;
+    };
+    /**
+     * CorruptionCorrelation specifies correlation for packet corruption (for pod-network-corruption)
+     * Higher values make corruptions cluster together. Range: 0-100.
+     */
+    corruptionCorrelation?: number;
+    /**
+     * CorruptionPercentage specifies the packet corruption percentage (for pod-network-corruption)
+     * Range: 1-100. Percentage of packets to corrupt.
+     */
+    corruptionPercentage?: number;
+    /** Count specifies the number of resources to affect */
+    count?: number;
+    /** CPULoad specifies the percentage of CPU to consume (for pod-cpu-stress) */
+    cpuLoad?: number;
+    /** CPUWorkers specifies the number of CPU workers (for pod-cpu-stress) */
+    cpuWorkers?: number;
+    /** DependsOn specifies a list of experiment names in the same namespace that must reach "Completed" phase before this experiment can start executing. */
+    dependsOn?: string[];
+    /** Direction specifies the direction of network traffic to block (for network-partition) */
+    direction?: "both" | "ingress" | "egress";
+    /**
+     * DrainTimeout bounds how long node-drain waits for a node's pods to be evicted (e.g., "5m").
+     * When set, pods are evicted through the Eviction API, so PodDisruptionBudgets are honored and
+     * blocked evictions are retried. A node that still hosts evictable pods when the timeout expires
+     * is uncordoned again, the round is recorded as partial, and the remaining pods are listed in
+     * status.unevictedPods. If not set, pods are deleted without waiting (node-drain only)
+     */
+    drainTimeout?: string;
+    /**
+     * DryRun mode previews affected resources without executing chaos
+     * When enabled, the controller lists resources that would be affected and updates status without performing actions
+     */
+    dryRun?: boolean;
+    /**
+     * Duration specifies how long the chaos action should last; for pod-delay it is the added
+     * latency and may also be given in milliseconds (e.g., "250ms")
+     */
+    duration?: string;
+    /**
+     * ExcludeOwners skips pods whose owning workload's name matches one of these glob patterns
+     * (e.g., "kafka-*", "postgres"); pods of a Deployment are owned by the Deployment
+     */
+    excludeOwners?: string[];
+    /**
+     * ExcludeSchedules skip the scheduled runs that fall into windows opened by other cron
+     * schedules, such as a weekly deploy window. Requires schedule or schedules
+     */
+    excludeSchedules?: Array<{
+      /**
+       * Duration is how long each window lasts (e.g., "2h"). If not set, only runs due at the
+       * same time as Schedule are skipped
+       */
+      duration?: string;
+      /** Schedule is a cron schedule opening the window, e.g. "0 14 * * 3" for Wednesdays at 14:00 */
+      schedule: string;
+    }>;
+    /**
+     * ExperimentDuration specifies how long the entire experiment should run before auto-stopping
+     * (e.g., "2h", "3d"). If not set, the experiment runs indefinitely until manually stopped
+     */
+    experimentDuration?: string;
+    /**
+     * ExternalTargets lists destinations outside the cluster to cut off (for network-partition):
+     * IP addresses, CIDRs or DNS names. DNS names are re-resolved every 30 seconds while the
+     * partition lasts, so services behind changing addresses stay blocked. Unlike an empty target
+     * list, which isolates the pod, only these destinations are blocked and in-cluster traffic is kept.
+     * Examples: ["db.example.com", "203.0.113.0/24"]
+     */
+    externalTargets?: string[];
+    /**
+     * FailureInterval is how often the container is failed again once it is running, while
+     * duration lasts (pod-failure only). Without duration the container is failed once per run.
+     * Default: "10s"
+     */
+    failureInterval?: string;
+    /**
+     * FailureSignal is the signal sent to PID 1 of the target container (pod-failure only)
+     * Default: "KILL"
+     */
+    failureSignal?: "KILL" | "TERM" | "INT" | "QUIT" | "ABRT" | "SEGV";
+    /**
+     * FillPercentage specifies the percentage of disk space to fill (for pod-disk-fill)
+     * Range: 50-95. Conservative limits to avoid total exhaustion.
+     */
+    fillPercentage?: number;
+    /**
+     * IgnoreEvictionAnnotations makes pod-kill and node-drain disregard the operational annotations
+     * other controllers use to protect pods. By default pods annotated
+     * cluster-autoscaler.kubernetes.io/safe-to-evict: "false" are not killed, nodes hosting such a pod
+     * are not drained, and victims with a lower controller.kubernetes.io/pod-deletion-cost go first.
+     */
+    ignoreEvictionAnnotations?: boolean;
+    /**
+     * IgnoreRollouts allows targeting pods whose Deployment or StatefulSet is in the middle of a
+     * rollout. By default such pods are skipped until the rollout settles.
+     */
+    ignoreRollouts?: boolean;
+    /**
+     * IncludeJobPods lets pod actions target pods owned by a Job, including the Jobs of a CronJob.
+     * Such pods are skipped by default so batch runs are not broken by accident. When included,
+     * history records report how the affected Jobs fared.
+     */
+    includeJobPods?: boolean;
+    /**
+     * IncludeOwners limits the targets to pods whose owning workload's name matches one of these
+     * glob patterns; pods without an owner are skipped. excludeOwners still applies
+     */
+    includeOwners?: string[];
+    /**
+     * Interval is the time between the starts of successive injection rounds while the experiment
+     * runs. Must be at least duration when both are set, so that rounds do not overlap.
+     * Default: "1m"
+     */
+    interval?: string;
+    /**
+     * LeaseMode is how lease-steal takes the lease (lease-steal only):
+     * - "Acquire": the controller becomes the holder for spec.duration, so the leader fails to
+     *   renew and steps down; candidates take over once the lease expires or is released
+     * - "Delete": the lease is deleted and the candidates race to create it again; the old leader
+     *   may win the race
+     * Default: "Acquire"
+     */
+    leaseMode?: "Acquire" | "Delete";
+    /**
+     * LeaseName is the coordination.k8s.io Lease in spec.namespace that lease-steal takes from the
+     * current leader. spec.selector matches the candidate pods that compete for it.
+     */
+    leaseName?: string;
+    /**
+     * LossCorrelation specifies correlation for packet loss (for pod-network-loss)
+     * Higher values make losses cluster together. Range: 0-100.
+     */
+    lossCorrelation?: number;
+    /**
+     * LossPercentage specifies the packet loss percentage (for pod-network-loss)
+     * Range: 1-40. Percentage of packets to drop.
+     */
+    lossPercentage?: number;
+    /**
+     * MaintenanceWindows define times when the experiment is strictly FORBIDDEN
+     * If the current time falls within ANY of these windows, the experiment will be blocked
+     */
+    maintenanceWindows?: Array<{
+      /**
+       * DaysOfWeek applies to recurring windows. Empty means every day.
+       * Values: Mon, Tue, Wed, Thu, Fri, Sat, Sun
+       */
+      daysOfWeek?: string[];
+      /** End time. For recurring windows: HH:MM. For absolute windows: RFC3339. */
+      end?: string;
+      /** Start time. For recurring windows: HH:MM. For absolute windows: RFC3339. */
+      start?: string;
+      /**
+       * Timezone applies to recurring windows (IANA TZ, e.g., "Europe/Berlin").
+       * Defaults to UTC when omitted.
+       */
+      timezone?: string;
+      /** Type selects recurring or absolute window semantics. */
+      type: string;
+    }>;
+    /**
+     * MaxNodes caps how many nodes a node action may affect per run; experiments whose count
+     * exceeds it fail validation. Only supported for node actions
+     */
+    maxNodes?: number;
+    /**
+     * MaxPercentage limits the percentage of matching resources that can be affected
+     * If count would affect more than this percentage, the experiment fails validation
+     * Range: 1-100. If not specified, no percentage limit is enforced.
+     */
+    maxPercentage?: number;
+    /** MaxRetries specifies the maximum number of retry attempts for failed experiments */
+    maxRetries?: number;
+    /**
+     * MaxUnavailableNodes caps how many nodes may be unavailable (cordoned or NotReady) cluster-wide
+     * while the experiment drains nodes. Nodes that would exceed the budget are skipped (node-drain only)
+     */
+    maxUnavailableNodes?: number;
+    /**
+     * MemoryOvercommitPolicy decides what pod-memory-stress does when memorySize * memoryWorkers does
+     * not fit under the target pod's memory limit or the memory left allocatable on its node.
+     * Refuse (the default) skips the pod; Clamp shrinks memorySize to fit and emits a warning.
+     */
+    memoryOvercommitPolicy?: "Refuse" | "Clamp";
+    /**
+     * MemorySize specifies the amount of memory to consume per worker (for pod-memory-stress)
+     * Format: number followed by M (megabytes) or G (gigabytes)
+     * Examples: "256M", "512M", "1G", "2G"
+     */
+    memorySize?: string;
+    /**
+     * MemoryWorkers specifies the number of memory workers (for pod-memory-stress)
+     * Total memory consumed = memorySize * memoryWorkers
+     */
+    memoryWorkers?: number;
+    /**
+     * MetricsQueries are PromQL queries sampled before, during and after the experiment and stored
+     * in status.metrics and in each history record, for before/after comparisons of latency or
+     * error rates. Each query must evaluate to a single value. Requires the controller's --prometheus-url.
+     */
+    metricsQueries?: Array<{
+      /** Name identifies the query in results (e.g., "p99-latency") */
+      name: string;
+      /** Query is the PromQL expression, evaluated as an instant query */
+      query: string;
+    }>;
+    /** Namespace specifies the target namespace for chaos experiments */
+    namespace: string;
+    /**
+     * NetAdminFallback applies the delay from an ephemeral helper container with NET_ADMIN and tc
+     * when the target container lacks either (for pod-delay). Without it such targets fail with a
+     * message naming what is missing. Pod Security admission must allow NET_ADMIN in the namespace.
+     */
+    netAdminFallback?: boolean;
+    /**
+     * NetworkMeasurement injects a measurement container next to the fault that pings a target
+     * from inside each affected pod while the fault lasts, and reports the observed RTT and loss in
+     * status.networkMeasurements and history (for pod-network-loss, pod-network-corruption and
+     * network-partition)
+     */
+    networkMeasurement?: {
+      /**
+       * Target is the host name or IP address pinged from inside the affected pods, such as a peer
+       * Service or the node's gateway
+       */
+      target: string;
+      /** WindowSeconds is how many pings, one per second, make up one sample */
+      windowSeconds?: number;
+    };
+    /**
+     * NodeAffinity limits pod actions to pods running on nodes matching one of its terms, like the
+     * requiredDuringSchedulingIgnoredDuringExecution node affinity of a pod
+     */
+    nodeAffinity?: {
+      /** Required. A list of node selector terms. The terms are ORed. */
+      nodeSelectorTerms: Array<{
+        /** A list of node selector requirements by node's labels. */
+        matchExpressions?: Array<{
+          /** The label key that the selector applies to. */
+          key: string;
+          /**
+           * Represents a key's relationship to a set of values.
+           * Valid operators are In, NotIn, Exists, DoesNotExist. Gt, and Lt.
+           */
+          operator: string;
+          /**
+           * An array of string values. If the operator is In or NotIn,
+           * the values array must be non-empty. If the operator is Exists or DoesNotExist,
+           * the values array must be empty. If the operator is Gt or Lt, the values
+           * array must have a single element, which will be interpreted as an integer.
+           * This array is replaced during a strategic merge patch.
+           */
+          values?: string[];
+        }>;
+        /** A list of node selector requirements by node's fields. */
+        matchFields?: Array<{
+          /** The label key that the selector applies to. */
+          key: string;
+          /**
+           * Represents a key's relationship to a set of values.
+           * Valid operators are In, NotIn, Exists, DoesNotExist. Gt, and Lt.
+           */
+          operator: string;
+          /**
+           * An array of string values. If the operator is In or NotIn,
+           * the values array must be non-empty. If the operator is Exists or DoesNotExist,
+           * the values array must be empty. If the operator is Gt or Lt, the values
+           * array must have a single element, which will be interpreted as an integer.
+           * This array is replaced during a strategic merge patch.
+           */
+          values?: string[];
+        }>;
+      }>;
+    };
+    /**
+     * NodeReplacement makes node-drain work with the cluster's node autoscaler: drained nodes can be
+     * cordoned with the autoscaler's own taint, and the controller waits for a new node to replace
+     * each drained one, reporting the time it took in status.nodeReplacements (node-drain only)
+     */
+    nodeReplacement?: {
+      /**
+       * Autoscaler cordons drained nodes with the taint this autoscaler puts on nodes it is about to
+       * remove instead of marking them unschedulable: ToBeDeletedByClusterAutoscaler for
+       * cluster-autoscaler, karpenter.sh/disrupted for karpenter. If not set, nodes are cordoned as usual.
+       */
+      autoscaler?: "cluster-autoscaler" | "karpenter";
+      /**
+       * Timeout is how long to wait for a replacement node (e.g., "10m"). A replacement is a node that
+       * matches spec.selector, was created after the drain and became Ready.
+       */
+      timeout?: string;
+    };
+    /**
+     * NodeSelector limits pod actions to pods running on nodes with these labels, e.g. a spot
+     * node pool. Node actions select nodes with selector instead
+     */
+    nodeSelector?: { [key: string]: string };
+    /** Paused indicates whether the experiment is currently paused */
+    paused?: boolean;
+    /**
+     * PeerNamespaces lists the namespaces searched for peerSelector pods
+     * Default: the experiment's target namespace
+     */
+    peerNamespaces?: string[];
+    /**
+     * PeerSelector selects a second group of pods for network-partition. When set, only traffic
+     * between the target pods and the peer pods is blocked instead of isolating the targets from
+     * everything. Peer pod IPs are resolved when the partition is injected.
+     */
+    peerSelector?: { [key: string]: string };
+    /**
+     * QuotaSqueeze selects the ResourceQuotas in spec.namespace that quota-squeeze shrinks for
+     * spec.duration, and by how much (quota-squeeze only). Without it every ResourceQuota in the
+     * namespace is shrunk to its current usage.
+     */
+    quotaSqueeze?: {
+      /**
+       * HeadroomPercent is how much of each limit's free room is left: 0 sets the limit to the
+       * current usage, 50 halves the room between usage and the original limit
+       */
+      headroomPercent?: number;
+      /** Name is the ResourceQuota to shrink; every ResourceQuota in spec.namespace when empty */
+      name?: string;
+      /**
+       * Resources limits the squeeze to these hard limits of the quota (e.g., "pods",
+       * "requests.cpu"); all of them when empty
+       */
+      resources?: string[];
+    };
+    /**
+     * RelativeLoad stresses a share of the target pod's CPU limit, such as "50%" for half of it,
+     * whatever the size of the node (for pod-cpu-stress). It replaces cpuLoad and cpuWorkers, which
+     * are derived from the limit; pods without a CPU limit are skipped.
+     */
+    relativeLoad?: string;
+    /**
+     * ReserveBytes is free space pod-disk-fill always leaves on the filesystem, as a quantity such
+     * as "500Mi". The fill stops short of fillPercentage rather than cross it, and shrinks when the
+     * workload's own writes do.
+     */
+    reserveBytes?: string;
+    /**
+     * ReservePercentage is free space pod-disk-fill always leaves, as a percentage of the filesystem
+     * Range: 0-50
+     */
+    reservePercentage?: number;
+    /**
+     * RestartInterval specifies delay between restarting each pod (pod-restart only)
+     * Format: "30s", "1m", "2m30s"
+     * Default: "" (restart the next pod as soon as the previous one is Ready)
+     */
+    restartInterval?: string;
+    /** RetryBackoff specifies the backoff strategy for retries (exponential or fixed) */
+    retryBackoff?: "exponential" | "fixed";
+    /** RetryDelay specifies the initial delay between retries (e.g., "30s", "1m") */
+    retryDelay?: string;
+    /**
+     * Schedule defines a cron schedule for automatic experiment execution
+     * When set, the experiment will run automatically according to this schedule
+     * Format follows standard cron syntax: "minute hour day-of-month month day-of-week"
+     * Special strings: @hourly, @daily, @weekly, @monthly, @yearly
+     * Examples: "0 2 * * *" (daily at 2am), "* /30 * * * *" (every 30 minutes), "@hourly"
+     * If not set, the experiment runs once immediately after creation
+     */
+    schedule?: string;
+    /**
+     * ScheduleJitter delays each scheduled run by a fixed offset within this window (e.g., "10m"),
+     * so that experiments sharing a schedule do not all start at once. The offset is derived from
+     * the experiment's namespace and name. Should be shorter than the time between runs.
+     */
+    scheduleJitter?: string;
+    /**
+     * Schedules are further cron schedules in the same format. The experiment runs whenever
+     * Schedule or any of them fires, e.g. "0 10 * * 1-5" and "0 14 * * 6" for weekday mornings and
+     * Saturday afternoons
+     */
+    schedules?: string[];
+    /**
+     * SelectionSeed makes target selection deterministic
+     * When set, eligible pods are ordered by name and shuffled with this seed instead of a random source,
+     * so the same set of candidates always yields the same victims
+     */
+    selectionSeed?: number;
+    /**
+     * SelectionStrategy controls which eligible pods are picked as targets
+     * random: any pod (default); oldest/newest: by creation time;
+     * highest-cpu/highest-memory: busiest pods according to metrics-server;
+     * one-per-node/one-per-zone: at most one pod per node or topology zone
+     */
+    selectionStrategy?: "random" | "oldest" | "newest" | "highest-cpu" | "highest-memory" | "one-per-node" | "one-per-zone";
+    /** Selector specifies the label selector for target resources */
+    selector: { [key: string]: string };
+    /**
+     * Severity classifies how disruptive the experiment is: low, medium or high
+     * ChaosPolicy severity rules may deny higher severities or require approvals and time windows for them
+     */
+    severity?: "low" | "medium" | "high";
+    /**
+     * SpreadBy groups eligible pods into failure domains before targets are taken: zone (topology
+     * zone of the pod's node, falling back to the node), node, or owner (the owning workload)
+     */
+    spreadBy?: "zone" | "node" | "owner";
+    /**
+     * SpreadMode decides how targets use the spreadBy domains
+     * spread: take one pod per domain before taking a second from any (default);
+     * concentrate: take targets from a single domain only, e.g. all replicas in one zone
+     */
+    spreadMode?: "spread" | "concentrate";
+    /**
+     * StickyTargets keeps affecting the same pods on repeated runs
+     * The first run records its victims in status.selectedTargets; later runs prefer those pods
+     * for as long as they remain eligible and only pick replacements for the ones that disappeared
+     */
+    stickyTargets?: boolean;
+    /**
+     * SuccessCriteria are CEL expressions judged once the experiment completes, after the same
+     * settling time as the "after" value of metricsQueries. The verdict in status and history is
+     * Passed when all of them hold. Requires experimentDuration.
+     */
+    successCriteria?: Array<{
+      /**
+       * Expression is a CEL expression evaluating to a bool. It can read metrics (the samples of
+       * metricsQueries by name, e.g. metrics["p99"].after), affected, recovered, unrecovered,
+       * recoverySeconds, failedTargets and unverifiedTargets.
+       */
+      expression: string;
+      /** Name identifies the criterion in the verdict (e.g., "all-recovered") */
+      name: string;
+    }>;
+    /** TaintEffect specifies the effect of the taint (for node-taint) */
+    taintEffect?: "NoSchedule" | "PreferNoSchedule" | "NoExecute";
+    /** TaintKey specifies the key of the taint to apply to nodes (for node-taint) */
+    taintKey?: string;
+    /** TaintValue specifies the value of the taint to apply to nodes (for node-taint) */
+    taintValue?: string;
+    /**
+     * TargetCIDRs specifies IP ranges to block using CIDR notation (for network-partition)
+     * If empty along with targetIPs, blocks all traffic (full partition)
+     * Examples: ["10.96.0.0/12", "192.168.0.0/16", "fd00::/64"]
+     * Format: x.x.x.x/y where each x is 0-255 and y is 0-32, or an IPv6 prefix
+     * Can be combined with targetIPs, targetPorts, and targetProtocols
+     * Applied based on direction field (ingress, egress, or both)
+     */
+    targetCIDRs?: string[];
+    /**
+     * TargetIPs specifies exact IP addresses to block (for network-partition)
+     * If empty, blocks all traffic (full partition - current behavior)
+     * Examples: ["10.96.0.50", "192.168.1.100", "fd00::50"]
+     * IPv6 addresses are blocked with ip6tables in pods that have an IPv6 address
+     * Can be combined with targetCIDRs, targetPorts, and targetProtocols
+     * Applied based on direction field (ingress, egress, or both)
+     */
+    targetIPs?: string[];
+    /**
+     * TargetPath specifies where to create the fill file (for pod-disk-fill)
+     * Default: /tmp
+     */
+    targetPath?: string;
+    /**
+     * TargetPorts specifies ports to block (for network-partition)
+     * If specified without targetIPs/targetCIDRs, blocks these ports for all IPs
+     * Can be combined with targetIPs/targetCIDRs for more specific targeting
+     * Examples: [80, 443, 8080]
+     * If targetProtocols is not specified, defaults to TCP
+     * Applied based on direction field (ingress, egress, or both)
+     * Port range: 1-65535
+     */
+    targetPorts?: number[];
+    /**
+     * TargetProtocols specifies protocols to block (for network-partition)
+     * If specified with targetPorts, applies to those specific ports
+     * If specified without targetPorts, applies to all ports of the protocol
+     * Examples: ["tcp"], ["tcp", "udp"], ["icmp"]
+     * If targetPorts is specified but targetProtocols is not, defaults to ["tcp"]
+     */
+    targetProtocols?: "tcp" | "udp" | "icmp";
+    /**
+     * TimeWindows restrict when the experiment may execute
+     * If empty or omitted, the experiment can run at any time
+     */
+    timeWindows?: Array<{
+      /**
+       * DaysOfWeek applies to recurring windows. Empty means every day.
+       * Values: Mon, Tue, Wed, Thu, Fri, Sat, Sun
+       */
+      daysOfWeek?: string[];
+      /** End time. For recurring windows: HH:MM. For absolute windows: RFC3339. */
+      end?: string;
+      /** Start time. For recurring windows: HH:MM. For absolute windows: RFC3339. */
+      start?: string;
+      /**
+       * Timezone applies to recurring windows (IANA TZ, e.g., "Europe/Berlin").
+       * Defaults to UTC when omitted.
+       */
+      timezone?: string;
+      /** Type selects recurring or absolute window semantics. */
+      type: string;
+    }>;
+    /**
+     * TTLSecondsAfterFinished deletes the experiment this many seconds after it reaches the
+     * Completed or Failed phase, like the Job field of the same name. If not set, finished
+     * experiments are kept until deleted manually. History records are not affected.
+     */
+    ttlSecondsAfterFinished?: number;
+    /**
+     * VolumeName optionally targets a specific mounted volume (for pod-disk-fill)
+     * If set, the controller resolves the first matching mount path and uses it instead of targetPath.
+     */
+    volumeName?: string;
+  };
+  /** status defines the observed state of ChaosExperiment */
+  status?: {
+    /** AdmissionDelayEndsAt is when the webhook of the current admission-delay run is removed */
+    admissionDelayEndsAt?: string;
+    /**
+     * AdmissionWebhook is the ValidatingWebhookConfiguration of the current admission-delay run
+     * Used to remove it when the run ends or the experiment is aborted
+     */
+    admissionWebhook?: string;
+    /**
+     * AffectedPods tracks pods that have ephemeral containers injected by this experiment
+     * Used for cleanup when the experiment completes (pod-cpu-stress, pod-memory-stress, pod-network-loss, pod-disk-fill)
+     * Format: "namespace/podName:containerName"
+     * Holds at most MaxAffectedPodRefs entries; beyond that the oldest injections are handed to
+     * ChaosCleanupTasks that stop them once the experiment is no longer running
+     */
+    affectedPods?: string[];
+    /**
+     * AffectedSummary counts the pods injected into since the last cleanup. It stays small on big
+     * selectors; the full target list of every run is kept in its history record
+     */
+    affectedSummary?: {
+      /** Injections is the number of containers injected across those pods */
+      injections: number;
+      /** Items lists the first MaxAffectedSummaryItems pods with their injection counts */
+      items?: Array<{
+        /** Injections is the number of containers injected into the pod */
+        injections: number;
+        /** Name of the pod */
+        name: string;
+        /** Namespace of the pod */
+        namespace: string;
+      }>;
+      /**
+       * Pods is the number of distinct pods injected into
+       * Once Truncated is set a pod whose entries were dropped may be counted again
+       */
+      pods: number;
+      /** Truncated is set when items or status.affectedPods had to leave entries out */
+      truncated?: boolean;
+    };
+    /**
+     * Autoscalers records how the HorizontalPodAutoscalers of the targets reacted to the experiment
+     * Only set when spec.autoscalerPolicy is defined
+     */
+    autoscalers?: Array<{
+      /** CurrentReplicas is the replica count at the last observation */
+      currentReplicas: number;
+      /** InitialReplicas is the replica count when the experiment first touched the workload */
+      initialReplicas: number;
+      /** Name of the HorizontalPodAutoscaler */
+      name: string;
+      /** PeakReplicas is the highest replica count observed during the experiment */
+      peakReplicas: number;
+      /** ScaleDownHeld is true while the controller has scale-down disabled on this autoscaler */
+      scaleDownHeld?: boolean;
+      /** Target is the scaled workload (e.g., "Deployment/web") */
+      target: string;
+    }>;
+    /**
+     * BalloonPods tracks the balloon pods of the current scheduler-pressure run, as "namespace/name"
+     * Used to remove them when the run ends or the experiment is aborted
+     */
+    balloonPods?: string[];
+    /** BalloonsEndAt is when the balloon pods of the current scheduler-pressure run are removed */
+    balloonsEndAt?: string;
+    /** BlastRadius is the impact estimate computed when targets were selected for the last run */
+    blastRadius?: {
+      /** AffectedPods is the number of pods selected for this run */
+      affectedPods: number;
+      /**
+       * CPUSharePercent is the share of the namespace's current CPU usage consumed by the selected pods.
+       * Only set when metrics-server is available.
+       */
+      cpuSharePercent?: number;
+      /**
+       * MemorySharePercent is the share of the namespace's current memory usage consumed by the selected pods.
+       * Only set when metrics-server is available.
+       */
+      memorySharePercent?: number;
+      /** NodesTouched is the number of distinct nodes hosting the selected pods */
+      nodesTouched: number;
+      /**
+       * Topology is a snapshot of what the selected pods serve, for rendering a blast-radius graph:
+       * the pods, their owning workloads, the Services selecting them and the Ingresses routing to those Services
+       */
+      topology?: {
+        /** Edges point from a resource to the one it backs: pod to workload, workload to Service, Service to Ingress */
+        edges?: Array<{
+          /** From is the ID of the backing resource */
+          from: string;
+          /** To is the ID of the resource it backs */
+          to: string;
+        }>;
+        /** Nodes are the resources in the graph */
+        nodes: Array<{
+          /** ID identifies the node within the graph as Kind/Name */
+          id: string;
+          /** Kind of the resource (Pod, Deployment, StatefulSet, Service, Ingress, ...) */
+          kind: string;
+          /** Name of the resource */
+          name: string;
+        }>;
+        /** Truncated is set when selected pods were left out of the graph to bound the status size */
+        truncated?: boolean;
+      };
+      /** Workloads breaks the selection down by owning workload */
+      workloads?: Array<{
+        /** Affected is the number of the workload's pods selected for this run */
+        affected: number;
+        /** Kind of the owning workload (e.g. Deployment, StatefulSet). "Pod" for unowned pods. */
+        kind: string;
+        /** Name of the owning workload */
+        name: string;
+        /** Percentage is Affected as a percentage of Total */
+        percentage: number;
+        /** Total is the number of pods the workload currently has */
+        total: number;
+      }>;
+    };
+    /**
+     * ChangeTicket is the change ticket the last injection round was verified against
+     * Only set when the experiment references one and a change-management system is configured
+     */
+    changeTicket?: {
+      /** ID is the ticket referenced by the chaos.gushchin.dev/change-ticket annotation */
+      id: string;
+      /** State is the approval state or status the change-management system reported */
+      state?: string;
+      /** VerifiedAt is when the ticket was last found approved within its change window */
+      verifiedAt?: string;
+    };
+    /** CompletedAt indicates when the experiment completed (either by duration or manually) */
+    completedAt?: string;
+    /** Conditions represents the latest available observations of the experiment */
+    conditions?: Array<{
+      /**
+       * lastTransitionTime is the last time the condition transitioned from one status to another.
+       * This should be when the underlying condition changed.  If that is not known, then using the time when the API field changed is acceptable.
+       */
+      lastTransitionTime: string;
+      /**
+       * message is a human readable message indicating details about the transition.
+       * This may be an empty string.
+       */
+      message: string;
+      /**
+       * observedGeneration represents the .metadata.generation that the condition was set based upon.
+       * For instance, if .metadata.generation is currently 12, but the .status.conditions[x].observedGeneration is 9, the condition is out of date
+       * with respect to the current state of the instance.
+       */
+      observedGeneration?: number;
+      /**
+       * reason contains a programmatic identifier indicating the reason for the condition's last transition.
+       * Producers of specific condition types may define expected values and meanings for this field,
+       * and whether the values are considered a guaranteed API.
+       * The value should be a CamelCase string.
+       * This field may not be empty.
+       */
+      reason: string;
+      /** status of the condition, one of True, False, Unknown. */
+      status: "True" | "False" | "Unknown";
+      /** type of condition in CamelCase or in foo.example.com/CamelCase. */
+      type: string;
+    }>;
+    /**
+     * CordonedNodes tracks nodes that were cordoned by this experiment
+     * Used for auto-uncordon when the experiment completes
+     */
+    cordonedNodes?: string[];
+    /** FailureEndsAt is when the current pod-failure run stops failing its targets */
+    failureEndsAt?: string;
+    /** LastError stores the last error message encountered */
+    lastError?: string;
+    /** LastRunTime indicates when the experiment was last executed */
+    lastRunTime?: string;
+    /**
+     * LastScheduledTime indicates when the scheduled experiment was last triggered
+     * Only set when spec.schedule is defined
+     */
+    lastScheduledTime?: string;
+    /** Message provides human-readable status information */
+    message?: string;
+    /**
+     * Metrics holds the before, during and after values of spec.metricsQueries, filled in when the
+     * experiment completes
+     */
+    metrics?: Array<{
+      /** After is the value once the experiment completed and the targets had time to settle */
+      after?: string;
+      /** Before is the value when the experiment (or run) started */
+      before?: string;
+      /** During is the value while chaos was active */
+      during?: string;
+      /** Error is the last error encountered while sampling the query */
+      error?: string;
+      /** Name of the query */
+      name: string;
+    }>;
+    /**
+     * NetworkMeasurements reports the RTT and loss seen by the spec.networkMeasurement containers,
+     * one entry per container, most recent last
+     */
+    networkMeasurements?: Array<{
+      /** AvgRTT is the mean round-trip time of the replies, such as "12.4ms" */
+      avgRTT?: string;
+      /** Complete is set once the container exited and its whole output was read */
+      complete?: boolean;
+      /** Container is the measurement container */
+      container: string;
+      /** LossPercentage is the share of pings without a reply */
+      lossPercentage?: number;
+      /** MaxRTT is the slowest reply */
+      maxRTT?: string;
+      packetsReceived?: number;
+      /** PacketsSent and PacketsReceived count the pings across all samples */
+      packetsSent?: number;
+      /** Pod is the affected pod, as "namespace/podName" */
+      pod: string;
+      /** Samples counts the completed ping windows */
+      samples?: number;
+      /** Target is the pinged host */
+      target: string;
+    }>;
+    /** NextRetryTime indicates when the next retry will be attempted */
+    nextRetryTime?: string;
+    /**
+     * NextScheduledTime indicates when the next scheduled run will occur
+     * Only set when spec.schedule is defined
+     */
+    nextScheduledTime?: string;
+    /**
+     * NodeReplacements tracks, for each node drained with spec.nodeReplacement, the node that replaced it
+     * Keeps the 50 most recent drains
+     */
+    nodeReplacements?: Array<{
+      /** DrainedAt is when the node was drained */
+      drainedAt: string;
+      /** Node is the drained node */
+      node: string;
+      /** ReplacementNode is the new node that took its place, once found */
+      replacementNode?: string;
+      /** TimeToReplacement is the time from the drain until the replacement node was Ready (e.g., "2m14s") */
+      timeToReplacement?: string;
+      /** TimedOut is set when no replacement was Ready within spec.nodeReplacement.timeout */
+      timedOut?: boolean;
+    }>;
+    /**
+     * PendingCleanup lists reverts a controller shutting down in the middle of an injection left
+     * behind. The next controller runs them before reconciling the experiment any further.
+     */
+    pendingCleanup?: Array<{
+      /** Container is the ephemeral container to stop */
+      container?: string;
+      /** Node to uncordon or untaint */
+      node?: string;
+      /** Operation is the revert to run */
+      operation: "Uncordon" | "Untaint" | "StopContainer" | "RestoreQuota" | "RemoveWebhook";
+      /** Pod whose ephemeral container to stop, as "namespace/name" */
+      pod?: string;
+      /** Quota is the ResourceQuota to restore, as "namespace/name" */
+      quota?: string;
+      /** Webhook is the ValidatingWebhookConfiguration to delete */
+      webhook?: string;
+    }>;
+    /** Phase represents the current state of the experiment */
+    phase?: "Pending" | "Running" | "Completed" | "Failed" | "Paused";
+    /**
+     * QuotaSqueezeEndsAt is when the ResourceQuotas squeezed by the current quota-squeeze run are
+     * restored
+     */
+    quotaSqueezeEndsAt?: string;
+    /** Recovery counts the affected targets and how the injected pods recovered, for spec.successCriteria */
+    recovery?: {
+      /**
+       * Affected counts the targets the injection rounds affected: pods, or nodes for node actions.
+       * It is recorded at injection time and kept when the injections are reverted.
+       */
+      affected?: number;
+      /** Gone counts the targets deleted or replaced before their recovery could be measured */
+      gone?: number;
+      /** Recovered counts the targets that recovered */
+      recovered?: number;
+      /** Slowest is the longest recovery of a recovered target, such as "20s" */
+      slowest?: string;
+      /** TimedOut counts the targets that did not recover within 30 minutes */
+      timedOut?: number;
+      /** Tracked is the number of injected targets whose recovery is measured */
+      tracked: number;
+    };
+    /** RetryCount tracks the current number of retry attempts */
+    retryCount?: number;
+    /** RollingRestart is the progress of the current pod-restart run */
+    rollingRestart?: {
+      /** Container is the restarted container of Pod */
+      container?: string;
+      /** InjectedAt is when Container was sent SIGTERM; the timing of the restart is relative to it */
+      injectedAt?: string;
+      /** NextRestartAt is when the next target is restarted, restartInterval after the previous one */
+      nextRestartAt?: string;
+      /** Observed is set once Container was seen restarting */
+      observed?: boolean;
+      /** Pending are the targets left to restart, as "namespace/podName", in restart order */
+      pending?: string[];
+      /** Pod is the target restarted last, as "namespace/podName", until it is Ready again */
+      pod?: string;
+      /** RestartCount is the restart count of Container before it was restarted */
+      restartCount?: number;
+      /** Restarted are the targets restarted so far, with their timing in details */
+      restarted?: Array<{
+        /** Action performed on the resource (e.g., deleted, delayed, stressed) */
+        action: string;
+        /** Details provides additional information about the action */
+        details?: string;
+        /** Kind of the resource (e.g., Pod, Node) */
+        kind: string;
+        /** Name of the resource */
+        name: string;
+        /** Namespace of the resource (empty for cluster-scoped resources) */
+        namespace?: string;
+      }>;
+      /** StartedAt is when the run began */
+      startedAt: string;
+    };
+    /**
+     * SelectedTargets records the pods picked by the last run when spec.stickyTargets is enabled
+     * Format: "namespace/podName"
+     */
+    selectedTargets?: string[];
+    /**
+     * SqueezedQuotas tracks the ResourceQuotas this experiment shrank, as "namespace/name"
+     * Used to restore their original limits when the run ends or the experiment is aborted
+     */
+    squeezedQuotas?: string[];
+    /** StartTime indicates when the experiment started running */
+    startTime?: string;
+    /**
+     * StolenLeases tracks the leases this experiment holds, as "namespace/name"
+     * Used to release them when the experiment completes or is aborted
+     */
+    stolenLeases?: string[];
+    /**
+     * TaintedNodes tracks nodes that were tainted by this experiment
+     * Used for removing taints when the experiment completes
+     */
+    taintedNodes?: string[];
+    /**
+     * TargetResults reports whether the container injected into each target pod started, and how
+     * it exited (pod-cpu-stress, pod-memory-stress, pod-network-loss, pod-network-corruption,
+     * network-partition, pod-disk-fill)
+     */
+    targetResults?: Array<{
+      /** Container is the last ephemeral container injected into the pod */
+      container: string;
+      /** ExitCode of the container once it exited */
+      exitCode?: number;
+      /** Message explains a failure: the container's termination message or the tail of its log */
+      message?: string;
+      /** Pod is the target, as "namespace/podName" */
+      pod: string;
+      /** Reason of the container's waiting or terminated state, such as ErrImagePull or Error */
+      reason?: string;
+      /** Restarts counts the injections retried after the container failed */
+      restarts?: number;
+      /** StartedAt is when the container started running */
+      startedAt?: string;
+      /**
+       * State is Pending until the container runs, then Running; Succeeded or Failed once it exited.
+       * A container whose image cannot be pulled is Failed.
+       */
+      state: "Pending" | "Running" | "Succeeded" | "Failed";
+      /**
+       * Verification is Verified when a probe run in the running container saw the fault in place,
+       * such as the netem qdisc or the stressor's CPU usage, and Unverified when it did not. Empty
+       * when the action has no probe or the container has not run yet.
+       */
+      verification?: "Verified" | "Unverified";
+      /** VerificationMessage explains why an injection is Unverified, from the probe's output */
+      verificationMessage?: string;
+    }>;
+    /** UnevictedPods lists the pods the last node-drain round could not evict within spec.drainTimeout */
+    unevictedPods?: Array<{
+      /** Node is the node the drain was rolled back on */
+      node: string;
+      /** Pod is the pod that was left behind, as "namespace/podName" */
+      pod: string;
+      /**
+       * Reason is why the pod was not evicted, such as a PodDisruptionBudget blocking the eviction
+       * or the pod being stuck terminating
+       */
+      reason?: string;
+    }>;
+    /** Verdict judges spec.successCriteria once the experiment completed */
+    verdict?: {
+      /** Criteria holds the outcome of each criterion */
+      criteria?: Array<{
+        /** Message explains a failed criterion, such as an evaluation error */
+        message?: string;
+        /** Name of the criterion */
+        name: string;
+        /** Passed is set when the expression held */
+        passed: boolean;
+      }>;
+      /** EvaluatedAt is when the criteria were judged */
+      evaluatedAt: string;
+      /** Message summarizes the verdict */
+      message?: string;
+      /**
+       * Observed holds the values the criteria were judged with, other than metrics
+       * (e.g., "recovered": "2")
+       */
+      observed?: { [key: string]: string };
+      /** Result is Passed when every criterion held, Failed otherwise */
+      result: "Passed" | "Failed";
+    };
+  };
+}
+
+/**
+ * ChaosExperimentHistory is the Schema for the chaosexperimenthistories API
+ * It provides an immutable audit log of chaos experiment executions
+ */
+export interface ChaosExperimentHistory {
+  /**
+   * APIVersion defines the versioned schema of this representation of an object.
+   * Servers should convert recognized schemas to the latest internal value, and
+   * may reject unrecognized values.
+   * More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
+   */
+  apiVersion?: string;
+  /**
+   * Kind is a string value representing the REST resource this object represents.
+   * Servers may infer this from the endpoint the client submits requests to.
+   * Cannot be updated.
+   * In CamelCase.
+   * More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+   */
+  kind?: string;
+  metadata?: ObjectMeta;
+  /** ChaosExperimentHistorySpec defines the historical record of a chaos experiment execution */
+  spec: {
+    /** AffectedResources lists all resources that were affected by this execution */
+    affectedResources?: Array<{
+      /** Action performed on the resource (e.g., deleted, delayed, stressed) */
+      action: string;
+      /** Details provides additional information about the action */
+      details?: string;
+      /** Kind of the resource (e.g., Pod, Node) */
+      kind: string;
+      /** Name of the resource */
+      name: string;
+      /** Namespace of the resource (empty for cluster-scoped resources) */
+      namespace?: string;
+    }>;
+    /** Audit contains metadata for compliance and auditing */
+    audit: {
+      /**
+       * ChangeTicket is the change ticket the experiment referenced through the
+       * chaos.gushchin.dev/change-ticket annotation
+       */
+      changeTicket?: string;
+      /**
+       * ChangeTicketState is the state the change-management system reported for ChangeTicket when
+       * the last injection round was verified; empty when it was never verified
+       */
+      changeTicketState?: string;
+      /** CreationTimestamp is when the history record was created */
+      creationTimestamp?: string;
+      /** DryRun indicates if this was a dry-run execution */
+      dryRun?: boolean;
+      /**
+       * InitiatedBy identifies who or what triggered the experiment
+       * Typically a ServiceAccount for scheduled experiments or User for manual triggers
+       */
+      initiatedBy?: string;
+      /**
+       * InitiatedVia identifies the tool the experiment was created with (CLI, GitOps controller, ...)
+       * Taken from the chaos.gushchin.dev/user-agent annotation
+       */
+      initiatedVia?: string;
+      /** RetryCount indicates which retry attempt this was (0 for first attempt) */
+      retryCount?: number;
+      /** ScheduledExecution indicates if this was triggered by a schedule (true) or manual (false) */
+      scheduledExecution?: boolean;
+    };
+    /** Autoscalers records how the HorizontalPodAutoscalers of the targets reacted up to this execution */
+    autoscalers?: Array<{
+      /** CurrentReplicas is the replica count at the last observation */
+      currentReplicas: number;
+      /** InitialReplicas is the replica count when the experiment first touched the workload */
+      initialReplicas: number;
+      /** Name of the HorizontalPodAutoscaler */
+      name: string;
+      /** PeakReplicas is the highest replica count observed during the experiment */
+      peakReplicas: number;
+      /** ScaleDownHeld is true while the controller has scale-down disabled on this autoscaler */
+      scaleDownHeld?: boolean;
+      /** Target is the scaled workload (e.g., "Deployment/web") */
+      target: string;
+    }>;
+    /** BlastRadius is the impact estimate computed when targets were selected */
+    blastRadius?: {
+      /** AffectedPods is the number of pods selected for this run */
+      affectedPods: number;
+      /**
+       * CPUSharePercent is the share of the namespace's current CPU usage consumed by the selected pods.
+       * Only set when metrics-server is available.
+       */
+      cpuSharePercent?: number;
+      /**
+       * MemorySharePercent is the share of the namespace's current memory usage consumed by the selected pods.
+       * Only set when metrics-server is available.
+       */
+      memorySharePercent?: number;
+      /** NodesTouched is the number of distinct nodes hosting the selected pods */
+      nodesTouched: number;
+      /**
+       * Topology is a snapshot of what the selected pods serve, for rendering a blast-radius graph:
+       * the pods, their owning workloads, the Services selecting them and the Ingresses routing to those Services
+       */
+      topology?: {
+        /** Edges point from a resource to the one it backs: pod to workload, workload to Service, Service to Ingress */
+        edges?: Array<{
+          /** From is the ID of the backing resource */
+          from: string;
+          /** To is the ID of the resource it backs */
+          to: string;
+        }>;
+        /** Nodes are the resources in the graph */
+        nodes: Array<{
+          /** ID identifies the node within the graph as Kind/Name */
+          id: string;
+          /** Kind of the resource (Pod, Deployment, StatefulSet, Service, Ingress, ...) */
+          kind: string;
+          /** Name of the resource */
+          name: string;
+        }>;
+        /** Truncated is set when selected pods were left out of the graph to bound the status size */
+        truncated?: boolean;
+      };
+      /** Workloads breaks the selection down by owning workload */
+      workloads?: Array<{
+        /** Affected is the number of the workload's pods selected for this run */
+        affected: number;
+        /** Kind of the owning workload (e.g. Deployment, StatefulSet). "Pod" for unowned pods. */
+        kind: string;
+        /** Name of the owning workload */
+        name: string;
+        /** Percentage is Affected as a percentage of Total */
+        percentage: number;
+        /** Total is the number of pods the workload currently has */
+        total: number;
+      }>;
+    };
+    /**
+     * Compaction is set once the record was compacted to keep the experiment's history within the
+     * controller's size budget; per-target details are gone and only summaries remain
+     */
+    compaction?: {
+      /** AffectedResources counts the removed affectedResources by kind and action (e.g., "Pod/deleted": 3) */
+      affectedResources?: { [key: string]: number };
+      /** CompactedAt is when the record was compacted */
+      compactedAt: string;
+      /** OriginalSize is the size of the record in bytes before it was compacted */
+      originalSize: number;
+      /** Removed lists the spec fields that were dropped or trimmed */
+      removed?: string[];
+    };
+    /** Error contains error information if the execution failed */
+    error?: {
+      /** Code is an optional error code */
+      code?: string;
+      /** FailureReason categorizes the type of failure */
+      failureReason?: "ValidationError" | "ResourceNotFound" | "PermissionDenied" | "ExecutionError" | "Timeout" | "Unknown";
+      /**
+       * LastError is the last error encountered during retries. Like Message, it may quote raw
+       * command output.
+       */
+      lastError?: string;
+      /**
+       * Message is the error message. It may quote raw command output, such as stderr of a command
+       * run in a target pod; credentials in it are redacted.
+       */
+      message?: string;
+      /** Redacted is true when credentials were removed from Message or LastError */
+      redacted?: boolean;
+    };
+    /** Execution contains details about the experiment execution */
+    execution: {
+      /** Cancellation describes how far the injections got when a cancelled execution was cut short */
+      cancellation?: {
+        /** IntendedDuration is how long each injection was meant to last (spec.duration) */
+        intendedDuration: string;
+        /** Reason is Deleted or Aborted */
+        reason: "Deleted" | "Aborted";
+        /** RequestedBy is who asked for the abort, when known */
+        requestedBy?: string;
+        /** Targets lists the injections in place when the execution was cancelled */
+        targets?: Array<{
+          /** Elapsed is how long the injection had been in place (e.g., "45s") */
+          elapsed: string;
+          /** Kind of the target (e.g., Pod, ResourceQuota) */
+          kind: string;
+          /** Name of the target, with its namespace for namespaced targets ("namespace/name") */
+          name: string;
+          /** Percent is Elapsed as a share of the intended duration */
+          percent: number;
+        }>;
+      };
+      /** Duration is the total execution time (e.g., "3.5s", "2m") */
+      duration?: string;
+      /** EndTime is when the experiment execution completed */
+      endTime?: string;
+      /** Message provides human-readable status information */
+      message?: string;
+      /** Phase is the experiment phase during execution */
+      phase?: "Pending" | "Running" | "Completed" | "Failed";
+      /**
+       * RecoveryTime is how long the affected targets took to become healthy again after injection
+       * (e.g., "45s"). Only set by actions that measure recovery.
+       */
+      recoveryTime?: string;
+      /** StartTime is when the experiment execution began */
+      startTime: string;
+      /** Status indicates the outcome of the execution */
+      status: "success" | "failure" | "partial" | "cancelled";
+    };
+    /** ExperimentRef references the original ChaosExperiment resource */
+    experimentRef: {
+      /** Name of the referenced object */
+      name: string;
+      /** Namespace of the referenced object */
+      namespace: string;
+      /** UID of the referenced object */
+      uid?: string;
+    };
+    /** ExperimentSpec captures the experiment configuration at execution time */
+    experimentSpec: {
+      /** Action specifies the chaos action to perform */
+      action: "pod-kill" | "pod-delay" | "node-drain" | "node-taint" | "node-cpu-stress" | "node-disk-fill" | "pod-cpu-stress" | "pod-memory-stress" | "pod-failure" | "pod-network-loss" | "pod-network-corruption" | "pod-disk-fill" | "pod-restart" | "network-partition" | "lease-steal" | "scheduler-pressure" | "quota-squeeze" | "admission-delay";
+      /**
+       * AdmissionDelay configures the no-op webhook admission-delay registers for spec.duration to slow
+       * down API requests for the given resources in spec.namespace (admission-delay only). Only objects
+       * matching spec.selector are delayed; the webhook fails open and is removed when the run ends.
+       */
+      admissionDelay?: {
+        /** Delay is how long each matching request is held (e.g., "5s"), at most 25s */
+        delay: string;
+        /** Operations are the request operations delayed */
+        operations?: Array<"CREATE" | "UPDATE" | "DELETE">;
+        /**
+         * Resources are the resources whose requests are delayed, as "resource" for the core group or
+         * "resource.group" (e.g., "configmaps", "deployments.apps"). Subresources, leases, events and
+         * chaos.gushchin.dev resources are refused.
+         */
+        resources: string[];
+      };
+      /**
+       * AllowControlPlane allows node-drain to target control-plane nodes
+       * Nodes labeled node-role.kubernetes.io/control-plane (or master) are skipped by default
+       */
+      allowControlPlane?: boolean;
+      /**
+       * AllowProduction explicitly allows experiments in production namespaces
+       * Production namespaces are identified by annotations or labels (environment=production, env=prod)
+       */
+      allowProduction?: boolean;
+      /**
+       * AllowSingletonDisruption allows targeting pods that are the only ready replica of their owner
+       * or that currently hold a leader-election lease. Such pods are skipped by default.
+       */
+      allowSingletonDisruption?: boolean;
+      /**
+       * AutoscalerPolicy controls how HorizontalPodAutoscalers of the targets are handled during
+       * pod-cpu-stress and pod-memory-stress. Observe records their replica counts in status.autoscalers;
+       * HoldScaleDown additionally disables scale-down until the experiment completes, so scale-up
+       * reactions to the stress can be measured without the autoscaler undoing them.
+       */
+      autoscalerPolicy?: "Observe" | "HoldScaleDown";
+      /**
+       * Balloons configures the placeholder pods scheduler-pressure creates in spec.namespace for
+       * spec.duration (scheduler-pressure only). spec.selector matches the workload pods whose
+       * priority is checked against the balloons' and whose preemption is recorded; spec.nodeSelector
+       * and spec.nodeAffinity pin the balloons to those nodes.
+       */
+      balloons?: {
+        /** CPU requested by each balloon pod (e.g., "500m") */
+        cpu?: string;
+        /** Memory requested by each balloon pod (e.g., "256Mi") */
+        memory?: string;
+        /**
+         * PriorityClassName is the PriorityClass of the balloon pods. A class above the priority of the
+         * workload pods makes the scheduler preempt them to fit the balloons; one below leaves the
+         * balloons Pending wherever capacity is short, churning the scheduling queue.
+         */
+        priorityClassName: string;
+        /** Replicas is the number of balloon pods */
+        replicas?: number;
+      };
+      /**
+       * CorruptionCorrelation specifies correlation for packet corruption (for pod-network-corruption)
+       * Higher values make corruptions cluster together. Range: 0-100.
+       */
+      corruptionCorrelation?: number;
+      /**
+       * CorruptionPercentage specifies the packet corruption percentage (for pod-network-corruption)
+       * Range: 1-100. Percentage of packets to corrupt.
+       */
+      corruptionPercentage?: number;
+      /** Count specifies the number of resources to affect */
+      count?: number;
+      /** CPULoad specifies the percentage of CPU to consume (for pod-cpu-stress) */
+      cpuLoad?: number;
+      /** CPUWorkers specifies the number of CPU workers (for pod-cpu-stress) */
+      cpuWorkers?: number;
+      /** DependsOn specifies a list of experiment names in the same namespace that must reach "Completed" phase before this experiment can start executing. */
+      dependsOn?: string[];
+      /** Direction specifies the direction of network traffic to block (for network-partition) */
+      direction?: "both" | "ingress" | "egress";
+      /**
+       * DrainTimeout bounds how long node-drain waits for a node's pods to be evicted (e.g., "5m").
+       * When set, pods are evicted through the Eviction API, so PodDisruptionBudgets are honored and
+       * blocked evictions are retried. A node that still hosts evictable pods when the timeout expires
+       * is uncordoned again, the round is recorded as partial, and the remaining pods are listed in
+       * status.unevictedPods. If not set, pods are deleted without waiting (node-drain only)
+       */
+      drainTimeout?: string;
+      /**
+       * DryRun mode previews affected resources without executing chaos
+       * When enabled, the controller lists resources that would be affected and updates status without performing actions
+       */
+      dryRun?: boolean;
+      /**
+       * Duration specifies how long the chaos action should last; for pod-delay it is the added
+       * latency and may also be given in milliseconds (e.g., "250ms")
+       */
+      duration?: string;
+      /**
+       * ExcludeOwners skips pods whose owning workload's name matches one of these glob patterns
+       * (e.g., "kafka-*", "postgres"); pods of a Deployment are owned by the Deployment
+       */
+      excludeOwners?: string[];
+      /**
+       * ExcludeSchedules skip the scheduled runs that fall into windows opened by other cron
+       * schedules, such as a weekly deploy window. Requires schedule or schedules
+       */
+      excludeSchedules?: Array<{
+        /**
+         * Duration is how long each window lasts (e.g., "2h"). If not set, only runs due at the
+         * same time as Schedule are skipped
+         */
+        duration?: string;
+        /** Schedule is a cron schedule opening the window, e.g. "0 14 * * 3" for Wednesdays at 14:00 */
+        schedule: string;
+      }>;
+      /**
+       * ExperimentDuration specifies how long the entire experiment should run before auto-stopping
+       * (e.g., "2h", "3d"). If not set, the experiment runs indefinitely until manually stopped
+       */
+      experimentDuration?: string;
+      /**
+       * ExternalTargets lists destinations outside the cluster to cut off (for network-partition):
+       * IP addresses, CIDRs or DNS names. DNS names are re-resolved every 30 seconds while the
+       * partition lasts, so services behind changing addresses stay blocked. Unlike an empty target
+       * list, which isolates the pod, only these destinations are blocked and in-cluster traffic is kept.
+       * Examples: ["db.example.com", "203.0.113.0/24"]
+       */
+      externalTargets?: string[];
+      /**
+       * FailureInterval is how often the container is failed again once it is running, while
+       * duration lasts (pod-failure only). Without duration the container is failed once per run.
+       * Default: "10s"
+       */
+      failureInterval?: string;
+      /**
+       * FailureSignal is the signal sent to PID 1 of the target container (pod-failure only)
+       * Default: "KILL"
+       */
+      failureSignal?: "KILL" | "TERM" | "INT" | "QUIT" | "ABRT" | "SEGV";
+      /**
+       * FillPercentage specifies the percentage of disk space to fill (for pod-disk-fill)
+       * Range: 50-95. Conservative limits to avoid total exhaustion.
+       */
+      fillPercentage?: number;
+      /**
+       * IgnoreEvictionAnnotations makes pod-kill and node-drain disregard the operational annotations
+       * other controllers use to protect pods. By default pods annotated
+       * cluster-autoscaler.kubernetes.io/safe-to-evict: "false" are not killed, nodes hosting such a pod
+       * are not drained, and victims with a lower controller.kubernetes.io/pod-deletion-cost go first.
+       */
+      ignoreEvictionAnnotations?: boolean;
+      /**
+       * IgnoreRollouts allows targeting pods whose Deployment or StatefulSet is in the middle of a
+       * rollout. By default such pods are skipped until the rollout settles.
+       */
+      ignoreRollouts?: boolean;
+      /**
+       * IncludeJobPods lets pod actions target pods owned by a Job, including the Jobs of a CronJob.
+       * Such pods are skipped by default so batch runs are not broken by accident. When included,
+       * history records report how the affected Jobs fared.
+       */
+      includeJobPods?: boolean;
+      /**
+       * IncludeOwners limits the targets to pods whose owning workload's name matches one of these
+       * glob patterns; pods without an owner are skipped. excludeOwners still applies
+       */
+      includeOwners?: string[];
+      /**
+       * LeaseMode is how lease-steal takes the lease (lease-steal only):
+       * - "Acquire": the controller becomes the holder for spec.duration, so the leader fails to
+       *   renew and steps down; candidates take over once the lease expires or is released
+       * - "Delete": the lease is deleted and the candidates race to create it again; the old leader
+       *   may win the race
+       * Default: "Acquire"
+       */
+      leaseMode?: "Acquire" | "Delete";
+      /**
+       * LeaseName is the coordination.k8s.io Lease in spec.namespace that lease-steal takes from the
+       * current leader. spec.selector matches the candidate pods that compete for it.
+       */
+      leaseName?: string;
+      /**
+       * LossCorrelation specifies correlation for packet loss (for pod-network-loss)
+       * Higher values make losses cluster together. Range: 0-100.
+       */
+      lossCorrelation?: number;
+      /**
+       * LossPercentage specifies the packet loss percentage (for pod-network-loss)
+       * Range: 1-40. Percentage of packets to drop.
+       */
+      lossPercentage?: number;
+      /**
+       * MaintenanceWindows define times when the experiment is strictly FORBIDDEN
+       * If the current time falls within ANY of these windows, the experiment will be blocked
+       */
+      maintenanceWindows?: Array<{
+        /**
+         * DaysOfWeek applies to recurring windows. Empty means every day.
+         * Values: Mon, Tue, Wed, Thu, Fri, Sat, Sun
+         */
+        daysOfWeek?: string[];
+        /** End time. For recurring windows: HH:MM. For absolute windows: RFC3339. */
+        end?: string;
+        /** Start time. For recurring windows: HH:MM. For absolute windows: RFC3339. */
+        start?: string;
+        /**
+         * Timezone applies to recurring windows (IANA TZ, e.g., "Europe/Berlin").
+         * Defaults to UTC when omitted.
+         */
+        timezone?: string;
+        /** Type selects recurring or absolute window semantics. */
+        type: string;
+      }>;
+      /**
+       * MaxNodes caps how many nodes a node action may affect per run; experiments whose count
+       * exceeds it fail validation. Only supported for node actions
+       */
+      maxNodes?: number;
+      /**
+       * MaxPercentage limits the percentage of matching resources that can be affected
+       * If count would affect more than this percentage, the experiment fails validation
+       * Range: 1-100. If not specified, no percentage limit is enforced.
+       */
+      maxPercentage?: number;
+      /** MaxRetries specifies the maximum number of retry attempts for failed experiments */
+      maxRetries?: number;
+      /**
+       * MaxUnavailableNodes caps how many nodes may be unavailable (cordoned or NotReady) cluster-wide
+       * while the experiment drains nodes. Nodes that would exceed the budget are skipped (node-drain only)
+       */
+      maxUnavailableNodes?: number;
+      /**
+       * MemoryOvercommitPolicy decides what pod-memory-stress does when memorySize * memoryWorkers does
+       * not fit under the target pod's memory limit or the memory left allocatable on its node.
+       * Refuse (the default) skips the pod; Clamp shrinks memorySize to fit and emits a warning.
+       */
+      memoryOvercommitPolicy?: "Refuse" | "Clamp";
+      /**
+       * MemorySize specifies the amount of memory to consume per worker (for pod-memory-stress)
+       * Format: number followed by M (megabytes) or G (gigabytes)
+       * Examples: "256M", "512M", "1G", "2G"
+       */
+      memorySize?: string;
+      /**
+       * MemoryWorkers specifies the number of memory workers (for pod-memory-stress)
+       * Total memory consumed = memorySize * memoryWorkers
+       */
+      memoryWorkers?: number;
+      /**
+       * MetricsQueries are PromQL queries sampled before, during and after the experiment and stored
+       * in status.metrics and in each history record, for before/after comparisons of latency or
+       * error rates. Each query must evaluate to a single value. Requires the controller's --prometheus-url.
+       */
+      metricsQueries?: Array<{
+        /** Name identifies the query in results (e.g., "p99-latency") */
+        name: string;
+        /** Query is the PromQL expression, evaluated as an instant query */
+        query: string;
+      }>;
+      /** Namespace specifies the target namespace for chaos experiments */
+      namespace: string;
+      /**
+       * NetAdminFallback applies the delay from an ephemeral helper container with NET_ADMIN and tc
+       * when the target container lacks either (for pod-delay). Without it such targets fail with a
+       * message naming what is missing. Pod Security admission must allow NET_ADMIN in the namespace.
+       */
+      netAdminFallback?: boolean;
+      /**
+       * NetworkMeasurement injects a measurement container next to the fault that pings a target
+       * from inside each affected pod while the fault lasts, and reports the observed RTT and loss in
+       * status.networkMeasurements and history (for pod-network-loss, pod-network-corruption and
+       * network-partition)
+       */
+      networkMeasurement?: {
+        /**
+         * Target is the host name or IP address pinged from inside the affected pods, such as a peer
+         * Service or the node's gateway
+         */
+        target: string;
+        /** WindowSeconds is how many pings, one per second, make up one sample */
+        windowSeconds?: number;
+      };
+      /**
+       * NodeAffinity limits pod actions to pods running on nodes matching one of its terms, like the
+       * requiredDuringSchedulingIgnoredDuringExecution node affinity of a pod
+       */
+      nodeAffinity?: {
+        /** Required. A list of node selector terms. The terms are ORed. */
+        nodeSelectorTerms: Array<{
+          /** A list of node selector requirements by node's labels. */
+          matchExpressions?: Array<{
+            /** The label key that the selector applies to. */
+            key: string;
+            /**
+             * Represents a key's relationship to a set of values.
+             * Valid operators are In, NotIn, Exists, DoesNotExist. Gt, and Lt.
+             */
+            operator: string;
+            /**
+             * An array of string values. If the operator is In or NotIn,
+             * the values array must be non-empty. If the operator is Exists or DoesNotExist,
+             * the values array must be empty. If the operator is Gt or Lt, the values
+             * array must have a single element, which will be interpreted as an integer.
+             * This array is replaced during a strategic merge patch.
+             */
+            values?: string[];
+          }>;
+          /** A list of node selector requirements by node's fields. */
+          matchFields?: Array<{
+            /** The label key that the selector applies to. */
+            key: string;
+            /**
+             * Represents a key's relationship to a set of values.
+             * Valid operators are In, NotIn, Exists, DoesNotExist. Gt, and Lt.
+             */
+            operator: string;
+            /**
+             * An array of string values. If the operator is In or NotIn,
+             * the values array must be non-empty. If the operator is Exists or DoesNotExist,
+             * the values array must be empty. If the operator is Gt or Lt, the values
+             * array must have a single element, which will be interpreted as an integer.
+             * This array is replaced during a strategic merge patch.
+             */
+            values?: string[];
+          }>;
+        }>;
+      };
+      /**
+       * NodeReplacement makes node-drain work with the cluster's node autoscaler: drained nodes can be
+       * cordoned with the autoscaler's own taint, and the controller waits for a new node to replace
+       * each drained one, reporting the time it took in status.nodeReplacements (node-drain only)
+       */
+      nodeReplacement?: {
+        /**
+         * Autoscaler cordons drained nodes with the taint this autoscaler puts on nodes it is about to
+         * remove instead of marking them unschedulable: ToBeDeletedByClusterAutoscaler for
+         * cluster-autoscaler, karpenter.sh/disrupted for karpenter. If not set, nodes are cordoned as usual.
+         */
+        autoscaler?: "cluster-autoscaler" | "karpenter";
+        /**
+         * Timeout is how long to wait for a replacement node (e.g., "10m"). A replacement is a node that
+         * matches spec.selector, was created after the drain and became Ready.
+         */
+        timeout?: string;
+      };
+      /**
+       * NodeSelector limits pod actions to pods running on nodes with these labels, e.g. a spot
+       * node pool. Node actions select nodes with selector instead
+       */
+      nodeSelector?: { [key: string]: string };
+      /** Paused indicates whether the experiment is currently paused */
+      paused?: boolean;
+      /**
+       * PeerNamespaces lists the namespaces searched for peerSelector pods
+       * Default: the experiment's target namespace
+       */
+      peerNamespaces?: string[];
+      /**
+       * PeerSelector selects a second group of pods for network-partition. When set, only traffic
+       * between the target pods and the peer pods is blocked instead of isolating the targets from
+       * everything. Peer pod IPs are resolved when the partition is injected.
+       */
+      peerSelector?: { [key: string]: string };
+      /**
+       * QuotaSqueeze selects the ResourceQuotas in spec.namespace that quota-squeeze shrinks for
+       * spec.duration, and by how much (quota-squeeze only). Without it every ResourceQuota in the
+       * namespace is shrunk to its current usage.
+       */
+      quotaSqueeze?: {
+        /**
+         * HeadroomPercent is how much of each limit's free room is left: 0 sets the limit to the
+         * current usage, 50 halves the room between usage and the original limit
+         */
+        headroomPercent?: number;
+        /** Name is the ResourceQuota to shrink; every ResourceQuota in spec.namespace when empty */
+        name?: string;
+        /**
+         * Resources limits the squeeze to these hard limits of the quota (e.g., "pods",
+         * "requests.cpu"); all of them when empty
+         */
+        resources?: string[];
+      };
+      /**
+       * RelativeLoad stresses a share of the target pod's CPU limit, such as "50%" for half of it,
+       * whatever the size of the node (for pod-cpu-stress). It replaces cpuLoad and cpuWorkers, which
+       * are derived from the limit; pods without a CPU limit are skipped.
+       */
+      relativeLoad?: string;
+      /**
+       * ReserveBytes is free space pod-disk-fill always leaves on the filesystem, as a quantity such
+       * as "500Mi". The fill stops short of fillPercentage rather than cross it, and shrinks when the
+       * workload's own writes do.
+       */
+      reserveBytes?: string;
+      /**
+       * ReservePercentage is free space pod-disk-fill always leaves, as a percentage of the filesystem
+       * Range: 0-50
+       */
+      reservePercentage?: number;
+      /**
+       * RestartInterval specifies delay between restarting each pod (pod-restart only)
+       * Format: "30s", "1m", "2m30s"
+       * Default: "" (restart the next pod as soon as the previous one is Ready)
+       */
+      restartInterval?: string;
+      /** RetryBackoff specifies the backoff strategy for retries (exponential or fixed) */
+      retryBackoff?: "exponential" | "fixed";
+      /** RetryDelay specifies the initial delay between retries (e.g., "30s", "1m") */
+      retryDelay?: string;
+      /**
+       * Schedule defines a cron schedule for automatic experiment execution
+       * When set, the experiment will run automatically according to this schedule
+       * Format follows standard cron syntax: "minute hour day-of-month month day-of-week"
+       * Special strings: @hourly, @daily, @weekly, @monthly, @yearly
+       * Examples: "0 2 * * *" (daily at 2am), "* /30 * * * *" (every 30 minutes), "@hourly"
+       * If not set, the experiment runs once immediately after creation
+       */
+      schedule?: string;
+      /**
+       * Schedules are further cron schedules in the same format. The experiment runs whenever
+       * Schedule or any of them fires, e.g. "0 10 * * 1-5" and "0 14 * * 6" for weekday mornings and
+       * Saturday afternoons
+       */
+      schedules?: string[];
+      /**
+       * SelectionSeed makes target selection deterministic
+       * When set, eligible pods are ordered by name and shuffled with this seed instead of a random source,
+       * so the same set of candidates always yields the same victims
+       */
+      selectionSeed?: number;
+      /**
+       * SelectionStrategy controls which eligible pods are picked as targets
+       * random: any pod (default); oldest/newest: by creation time;
+       * highest-cpu/highest-memory: busiest pods according to metrics-server;
+       * one-per-node/one-per-zone: at most one pod per node or topology zone
+       */
+      selectionStrategy?: "random" | "oldest" | "newest" | "highest-cpu" | "highest-memory" | "one-per-node" | "one-per-zone";
+      /** Selector specifies the label selector for target resources */
+      selector: { [key: string]: string };
+      /**
+       * Severity classifies how disruptive the experiment is: low, medium or high
+       * ChaosPolicy severity rules may deny higher severities or require approvals and time windows for them
+       */
+      severity?: "low" | "medium" | "high";
+      /**
+       * SpreadBy groups eligible pods into failure domains before targets are taken: zone (topology
+       * zone of the pod's node, falling back to the node), node, or owner (the owning workload)
+       */
+      spreadBy?: "zone" | "node" | "owner";
+      /**
+       * SpreadMode decides how targets use the spreadBy domains
+       * spread: take one pod per domain before taking a second from any (default);
+       * concentrate: take targets from a single domain only, e.g. all replicas in one zone
+       */
+      spreadMode?: "spread" | "concentrate";
+      /**
+       * StickyTargets keeps affecting the same pods on repeated runs
+       * The first run records its victims in status.selectedTargets; later runs prefer those pods
+       * for as long as they remain eligible and only pick replacements for the ones that disappeared
+       */
+      stickyTargets?: boolean;
+      /**
+       * SuccessCriteria are CEL expressions judged once the experiment completes, after the same
+       * settling time as the "after" value of metricsQueries. The verdict in status and history is
+       * Passed when all of them hold. Requires experimentDuration.
+       */
+      successCriteria?: Array<{
+        /**
+         * Expression is a CEL expression evaluating to a bool. It can read metrics (the samples of
+         * metricsQueries by name, e.g. metrics["p99"].after), affected, recovered, unrecovered,
+         * recoverySeconds, failedTargets and unverifiedTargets.
+         */
+        expression: string;
+        /** Name identifies the criterion in the verdict (e.g., "all-recovered") */
+        name: string;
+      }>;
+      /** TaintEffect specifies the effect of the taint (for node-taint) */
+      taintEffect?: "NoSchedule" | "PreferNoSchedule" | "NoExecute";
+      /** TaintKey specifies the key of the taint to apply to nodes (for node-taint) */
+      taintKey?: string;
+      /** TaintValue specifies the value of the taint to apply to nodes (for node-taint) */
+      taintValue?: string;
+      /**
+       * TargetCIDRs specifies IP ranges to block using CIDR notation (for network-partition)
+       * If empty along with targetIPs, blocks all traffic (full partition)
+       * Examples: ["10.96.0.0/12", "192.168.0.0/16", "fd00::/64"]
+       * Format: x.x.x.x/y where each x is 0-255 and y is 0-32, or an IPv6 prefix
+       * Can be combined with targetIPs, targetPorts, and targetProtocols
+       * Applied based on direction field (ingress, egress, or both)
+       */
+      targetCIDRs?: string[];
+      /**
+       * TargetIPs specifies exact IP addresses to block (for network-partition)
+       * If empty, blocks all traffic (full partition - current behavior)
+       * Examples: ["10.96.0.50", "192.168.1.100", "fd00::50"]
+       * IPv6 addresses are blocked with ip6tables in pods that have an IPv6 address
+       * Can be combined with targetCIDRs, targetPorts, and targetProtocols
+       * Applied based on direction field (ingress, egress, or both)
+       */
+      targetIPs?: string[];
+      /**
+       * TargetPath specifies where to create the fill file (for pod-disk-fill)
+       * Default: /tmp
+       */
+      targetPath?: string;
+      /**
+       * TargetPorts specifies ports to block (for network-partition)
+       * If specified without targetIPs/targetCIDRs, blocks these ports for all IPs
+       * Can be combined with targetIPs/targetCIDRs for more specific targeting
+       * Examples: [80, 443, 8080]
+       * If targetProtocols is not specified, defaults to TCP
+       * Applied based on direction field (ingress, egress, or both)
+       * Port range: 1-65535
+       */
+      targetPorts?: number[];
+      /**
+       * TargetProtocols specifies protocols to block (for network-partition)
+       * If specified with targetPorts, applies to those specific ports
+       * If specified without targetPorts, applies to all ports of the protocol
+       * Examples: ["tcp"], ["tcp", "udp"], ["icmp"]
+       * If targetPorts is specified but targetProtocols is not, defaults to ["tcp"]
+       */
+      targetProtocols?: "tcp" | "udp" | "icmp";
+      /**
+       * TimeWindows restrict when the experiment may execute
+       * If empty or omitted, the experiment can run at any time
+       */
+      timeWindows?: Array<{
+        /**
+         * DaysOfWeek applies to recurring windows. Empty means every day.
+         * Values: Mon, Tue, Wed, Thu, Fri, Sat, Sun
+         */
+        daysOfWeek?: string[];
+        /** End time. For recurring windows: HH:MM. For absolute windows: RFC3339. */
+        end?: string;
+        /** Start time. For recurring windows: HH:MM. For absolute windows: RFC3339. */
+        start?: string;
+        /**
+         * Timezone applies to recurring windows (IANA TZ, e.g., "Europe/Berlin").
+         * Defaults to UTC when omitted.
+         */
+        timezone?: string;
+        /** Type selects recurring or absolute window semantics. */
+        type: string;
+      }>;
+      /**
+       * TTLSecondsAfterFinished deletes the experiment this many seconds after it reaches the
+       * Completed or Failed phase, like the Job field of the same name. If not set, finished
+       * experiments are kept until deleted manually. History records are not affected.
+       */
+      ttlSecondsAfterFinished?: number;
+      /**
+       * VolumeName optionally targets a specific mounted volume (for pod-disk-fill)
+       * If set, the controller resolves the first matching mount path and uses it instead of targetPath.
+       */
+      volumeName?: string;
+    };
+    /**
+     * JobImpact reports, for experiments with includeJobPods, the state of the Jobs whose pods
+     * were affected when the record was written
+     */
+    jobImpact?: Array<{
+      /** BackoffLimit is the number of pod failures after which the Job fails */
+      backoffLimit?: number;
+      /** CronJob that created the Job, if any */
+      cronJob?: string;
+      /** Failed is the number of failed pods of the Job, killed targets included */
+      failed?: number;
+      /** Name of the Job */
+      name: string;
+      /**
+       * Outcome is Running while the Job has not finished, Complete or Failed once it has,
+       * and Missing when the Job no longer exists
+       */
+      outcome: "Running" | "Complete" | "Failed" | "Missing";
+      /** Reason is the reason of the Job's Failed condition */
+      reason?: string;
+    }>;
+    /**
+     * Metrics holds the values of spec.metricsQueries at the start of this execution (before) and
+     * when the record was written (during)
+     */
+    metrics?: Array<{
+      /** After is the value once the experiment completed and the targets had time to settle */
+      after?: string;
+      /** Before is the value when the experiment (or run) started */
+      before?: string;
+      /** During is the value while chaos was active */
+      during?: string;
+      /** Error is the last error encountered while sampling the query */
+      error?: string;
+      /** Name of the query */
+      name: string;
+    }>;
+    /** NetworkMeasurements holds the RTT and loss measured from the targets up to this execution */
+    networkMeasurements?: Array<{
+      /** AvgRTT is the mean round-trip time of the replies, such as "12.4ms" */
+      avgRTT?: string;
+      /** Complete is set once the container exited and its whole output was read */
+      complete?: boolean;
+      /** Container is the measurement container */
+      container: string;
+      /** LossPercentage is the share of pings without a reply */
+      lossPercentage?: number;
+      /** MaxRTT is the slowest reply */
+      maxRTT?: string;
+      packetsReceived?: number;
+      /** PacketsSent and PacketsReceived count the pings across all samples */
+      packetsSent?: number;
+      /** Pod is the affected pod, as "namespace/podName" */
+      pod: string;
+      /** Samples counts the completed ping windows */
+      samples?: number;
+      /** Target is the pinged host */
+      target: string;
+    }>;
+    /** Regressions lists the metrics that got worse compared to the previous run of the same experiment */
+    regressions?: string[];
+    /**
+     * Snapshot holds events and log tails of the affected resources captured when the record was
+     * written, so postmortems do not depend on the cluster's event and log retention
+     */
+    snapshot?: {
+      /** Events are the Kubernetes events recorded for the affected resources */
+      events?: Array<{
+        /** Count is how many times the event occurred */
+        count?: number;
+        /** LastTimestamp is when the event was last seen */
+        lastTimestamp?: string;
+        /** Message is the human-readable event message */
+        message?: string;
+        /** Object is the involved object (e.g., "Pod/web-abc") */
+        object: string;
+        /** Reason is the short machine-readable reason of the event */
+        reason?: string;
+        /** Type is the event type (Normal or Warning) */
+        type?: string;
+      }>;
+      /** Logs are the last lines of the affected pods' containers */
+      logs?: Array<{
+        /** Container is the name of the container */
+        container: string;
+        /**
+         * Lines is the captured log output: raw container output, with credentials and the pod's
+         * literal env values redacted
+         */
+        lines?: string;
+        /** Pod is the name of the pod */
+        pod: string;
+      }>;
+      /** Redacted is true when credentials were removed from the events or logs */
+      redacted?: boolean;
+      /** Truncated is true when some events or logs were dropped to stay within the size limit */
+      truncated?: boolean;
+    };
+    /**
+     * Verdict is the experiment's verdict on its successCriteria, added to the record of the
+     * judged execution
+     */
+    verdict?: {
+      /** Criteria holds the outcome of each criterion */
+      criteria?: Array<{
+        /** Message explains a failed criterion, such as an evaluation error */
+        message?: string;
+        /** Name of the criterion */
+        name: string;
+        /** Passed is set when the expression held */
+        passed: boolean;
+      }>;
+      /** EvaluatedAt is when the criteria were judged */
+      evaluatedAt: string;
+      /** Message summarizes the verdict */
+      message?: string;
+      /**
+       * Observed holds the values the criteria were judged with, other than metrics
+       * (e.g., "recovered": "2")
+       */
+      observed?: { [key: string]: string };
+      /** Result is Passed when every criterion held, Failed otherwise */
+      result: "Passed" | "Failed";
+    };
+  };
+  /**
+   * ChaosExperimentHistoryStatus defines the observed state of ChaosExperimentHistory
+   * Note: History records are immutable, so status is minimal
+   */
+  status?: {
+    /** ArchiveLocation is the external storage location if archived */
+    archiveLocation?: string;
+    /** Archived indicates if this record has been archived to external storage */
+    archived?: boolean;
+  };
+}
+
+export interface ChaosExperimentHistoryList {
+  apiVersion?: string;
+  items: ChaosExperimentHistory[];
+  kind?: string;
+  metadata?: ListMeta;
+}
+
+export interface ChaosExperimentList {
+  apiVersion?: string;
+  items: ChaosExperiment[];
+  kind?: string;
+  metadata?: ListMeta;
+}
+
+/**
+ * ChaosFreeze is the Schema for the chaosfreezes API
+ * While any unexpired ChaosFreeze exists, all experiments are paused, their active
+ * injections are reverted, and new experiments are rejected by the admission webhook
+ */
+export interface ChaosFreeze {
+  /**
+   * APIVersion defines the versioned schema of this representation of an object.
+   * Servers should convert recognized schemas to the latest internal value, and
+   * may reject unrecognized values.
+   * More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
+   */
+  apiVersion?: string;
+  /**
+   * Kind is a string value representing the REST resource this object represents.
+   * Servers may infer this from the endpoint the client submits requests to.
+   * Cannot be updated.
+   * In CamelCase.
+   * More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+   */
+  kind?: string;
+  metadata?: ObjectMeta;
+  /** ChaosFreezeSpec defines a cluster-wide chaos freeze */
+  spec?: {
+    /**
+     * ExpiresAt lifts the freeze automatically at the given time
+     * If omitted, the freeze stays in effect until the ChaosFreeze is deleted
+     */
+    expiresAt?: string;
+    /**
+     * Reason explains why chaos is frozen (e.g. incident number or change freeze name)
+     * Shown in the status of every experiment held by the freeze
+     */
+    reason?: string;
+  };
+}
+
+export interface ChaosFreezeList {
+  apiVersion?: string;
+  items: ChaosFreeze[];
+  kind?: string;
+  metadata?: ListMeta;
+}
+
+/**
+ * ChaosMonkey is the Schema for the chaosmonkeys API
+ * A ChaosMonkey keeps creating low-severity experiments against workloads picked at random,
+ * one at a time, within ChaosPolicy rate limits and its time windows. Each run is an ordinary
+ * ChaosExperiment owned by the monkey, so every run is recorded in history and held by a ChaosFreeze.
+ */
+export interface ChaosMonkey {
+  /**
+   * APIVersion defines the versioned schema of this representation of an object.
+   * Servers should convert recognized schemas to the latest internal value, and
+   * may reject unrecognized values.
+   * More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
+   */
+  apiVersion?: string;
+  /**
+   * Kind is a string value representing the REST resource this object represents.
+   * Servers may infer this from the endpoint the client submits requests to.
+   * Cannot be updated.
+   * In CamelCase.
+   * More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+   */
+  kind?: string;
+  metadata?: ObjectMeta;
+  /** ChaosMonkeySpec defines continuous background chaos */
+  spec?: {
+    /**
+     * Actions the monkey chooses from at random for every run
+     * Only low-severity pod actions are allowed
+     */
+    actions?: Array<"pod-kill" | "pod-restart" | "pod-failure">;
+    /** AllowProduction lets the monkey pick workloads in production namespaces */
+    allowProduction?: boolean;
+    /**
+     * ExperimentTTLSecondsAfterFinished is set as ttlSecondsAfterFinished on the experiments the monkey creates
+     * Their history records are kept regardless
+     */
+    experimentTTLSecondsAfterFinished?: number;
+    /**
+     * Interval is the mean time between two runs (e.g., "1h")
+     * Every gap is drawn at random between half and one and a half times the interval
+     */
+    interval?: string;
+    /**
+     * MinReadyReplicas is the least number of ready replicas a Deployment or StatefulSet needs to be picked
+     * Workloads that are scaled below it or not fully ready are left alone
+     */
+    minReadyReplicas?: number;
+    /**
+     * Namespaces the monkey may pick workloads from
+     * If omitted, every namespace except the kube-* system namespaces is a candidate
+     */
+    namespaces?: string[];
+    /** Paused stops the monkey from starting new runs */
+    paused?: boolean;
+    /**
+     * TimeWindows restricts runs to the given windows, e.g. business hours
+     * If omitted, the monkey runs at any time
+     */
+    timeWindows?: Array<{
+      /**
+       * DaysOfWeek applies to recurring windows. Empty means every day.
+       * Values: Mon, Tue, Wed, Thu, Fri, Sat, Sun
+       */
+      daysOfWeek?: string[];
+      /** End time. For recurring windows: HH:MM. For absolute windows: RFC3339. */
+      end?: string;
+      /** Start time. For recurring windows: HH:MM. For absolute windows: RFC3339. */
+      start?: string;
+      /**
+       * Timezone applies to recurring windows (IANA TZ, e.g., "Europe/Berlin").
+       * Defaults to UTC when omitted.
+       */
+      timezone?: string;
+      /** Type selects recurring or absolute window semantics. */
+      type: string;
+    }>;
+  };
+  /** ChaosMonkeyStatus defines the observed state of ChaosMonkey */
+  status?: {
+    /** LastExperiment is the name of the experiment created by the last run */
+    lastExperiment?: string;
+    /** LastRunTime is when the monkey last created an experiment */
+    lastRunTime?: string;
+    /** LastTarget is the workload picked by the last run (namespace/Kind/name) */
+    lastTarget?: string;
+    /** Message explains what the monkey is doing or waiting for */
+    message?: string;
+    /** NextRunTime is when the monkey will pick its next target */
+    nextRunTime?: string;
+    /** Runs is the number of experiments the monkey has created */
+    runs?: number;
+  };
+}
+
+export interface ChaosMonkeyList {
+  apiVersion?: string;
+  items: ChaosMonkey[];
+  kind?: string;
+  metadata?: ListMeta;
+}
+
+/**
+ * ChaosPolicy is the Schema for the chaospolicies API
+ * The controller holds back injection rounds that would exceed the limits of any policy
+ * applying to the experiment's target namespace
+ */
+export interface ChaosPolicy {
+  /**
+   * APIVersion defines the versioned schema of this representation of an object.
+   * Servers should convert recognized schemas to the latest internal value, and
+   * may reject unrecognized values.
+   * More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
+   */
+  apiVersion?: string;
+  /**
+   * Kind is a string value representing the REST resource this object represents.
+   * Servers may infer this from the endpoint the client submits requests to.
+   * Cannot be updated.
+   * In CamelCase.
+   * More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+   */
+  kind?: string;
+  metadata?: ObjectMeta;
+  /** ChaosPolicySpec defines limits the controller enforces on experiments */
+  spec?: {
+    /**
+     * HolidayCalendars block injections on the days of these calendars, such as public holidays
+     * and company freeze days, without listing the dates in every experiment
+     */
+    holidayCalendars?: Array<{
+      /**
+       * ConfigMapRef points at a ConfigMap key holding an ICS calendar or one date per line
+       * ("2026-12-25 Christmas", "2026-12-20/2026-12-31 Year-end freeze")
+       */
+      configMapRef?: {
+        /** Key of the calendar in the ConfigMap's data */
+        key?: string;
+        /** Name of the ConfigMap */
+        name: string;
+        /** Namespace of the ConfigMap */
+        namespace: string;
+      };
+      /** Name identifies the calendar in conditions and events */
+      name: string;
+      /** Timezone interprets all-day events and dates without a zone (IANA name, e.g. "Europe/Berlin") */
+      timezone?: string;
+      /** URL is an http(s) URL serving an ICS calendar, e.g. a public holiday feed */
+      url?: string;
+    }>;
+    /**
+     * Namespaces limits the policy to experiments targeting these namespaces (spec.namespace)
+     * If omitted, the policy applies to every namespace, each counted separately
+     */
+    namespaces?: string[];
+    /** RateLimit bounds how often experiments inject chaos */
+    rateLimit?: {
+      /**
+       * MaxInjections is the number of injection rounds that may start per target namespace within window
+       * Rounds of every experiment targeting the namespace count towards the limit
+       */
+      maxInjections?: number;
+      /** MinInterval is the least time between two injection rounds of the same experiment (e.g., "10m") */
+      minInterval?: string;
+      /** Window is the sliding window maxInjections applies to (e.g., "30m", "1h"), at most 24h */
+      window?: string;
+    };
+    /**
+     * SeverityRules gate experiments by severity, e.g. to allow low severity everywhere but
+     * high severity only with an approval
+     */
+    severityRules?: Array<{
+      /** Deny rejects such experiments in the policy's namespaces; dry runs stay allowed */
+      deny?: boolean;
+      /**
+       * RequireApproval holds injections until someone other than the experiment's creator sets
+       * the chaos.gushchin.dev/approved-by annotation
+       */
+      requireApproval?: boolean;
+      /**
+       * RequireChangeTicket holds injections until the experiment references, through the
+       * chaos.gushchin.dev/change-ticket annotation, a change ticket the configured change-management
+       * system reports approved with an open change window
+       */
+      requireChangeTicket?: boolean;
+      /** Severity is the lowest severity the rule applies to */
+      severity: "low" | "medium" | "high";
+      /** TimeWindows restrict when injections may start */
+      timeWindows?: Array<{
+        /**
+         * DaysOfWeek applies to recurring windows. Empty means every day.
+         * Values: Mon, Tue, Wed, Thu, Fri, Sat, Sun
+         */
+        daysOfWeek?: string[];
+        /** End time. For recurring windows: HH:MM. For absolute windows: RFC3339. */
+        end?: string;
+        /** Start time. For recurring windows: HH:MM. For absolute windows: RFC3339. */
+        start?: string;
+        /**
+         * Timezone applies to recurring windows (IANA TZ, e.g., "Europe/Berlin").
+         * Defaults to UTC when omitted.
+         */
+        timezone?: string;
+        /** Type selects recurring or absolute window semantics. */
+        type: string;
+      }>;
+    }>;
+  };
+}
+
+export interface ChaosPolicyList {
+  apiVersion?: string;
+  items: ChaosPolicy[];
+  kind?: string;
+  metadata?: ListMeta;
+}
+
+export interface Error {
+  code: number;
+  error: string;
+}
+
+export interface ExperimentEvent {
+  experiment: string;
+  message?: string;
+  namespace: string;
+  phase?: string;
+  /** Kind/name of the node or pod for Injected and Reverted */
+  target?: string;
+  time: string;
+  /**
+   * Created, Started, Injected, Reverted, Paused, Resumed, Completed, Failed, Deleted, or the
+   * reason of a Kubernetes event recorded on the experiment
+   */
+  type: string;
+  warning?: boolean;
+}
+
+export interface ListMeta {
+  continue?: string;
+  resourceVersion?: string;
+}
+
+/** Standard Kubernetes object metadata (the fields clients commonly use) */
+export interface ObjectMeta {
+  annotations?: { [key: string]: string };
+  creationTimestamp?: string;
+  deletionTimestamp?: string;
+  finalizers?: string[];
+  generation?: number;
+  labels?: { [key: string]: string };
+  name?: string;
+  namespace?: string;
+  resourceVersion?: string;
+  uid?: string;
+}
@@ -20,19 +20,28 @@ import (
 	"context"
 	"crypto/tls"
 	"flag"
+	"fmt"
 	"net/http"
 	"os"
+	"strings"
 	"time"
 
 	// Import all Kubernetes client auth plugins (e.g. Azure, GCP, OIDC, etc.)
 	// to ensure that exec-entrypoint and run can make use of them.
 	_ "k8s.io/client-go/plugin/pkg/client/auth"
 
+	authenticationv1 "k8s.io/api/authentication/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	"k8s.io/client-go/kubernetes"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
 	"sigs.k8s.io/controller-runtime/pkg/metrics/filters"
@@ -40,8 +49,20 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 
 	chaosv1alpha1 "github.com/neogan74/k8s-chaos/api/v1alpha1"
+	"github.com/neogan74/k8s-chaos/internal/admissiondelay"
+	"github.com/neogan74/k8s-chaos/internal/apiserver"
+	"github.com/neogan74/k8s-chaos/internal/changemgmt"
 	"github.com/neogan74/k8s-chaos/internal/controller"
+	"github.com/neogan74/k8s-chaos/internal/diagnostics"
+	"github.com/neogan74/k8s-chaos/internal/digest"
+	"github.com/neogan74/k8s-chaos/internal/eventbus"
 	_ "github.com/neogan74/k8s-chaos/internal/metrics" // Import to register custom metrics
+	"github.com/neogan74/k8s-chaos/internal/preflight"
+	"github.com/neogan74/k8s-chaos/internal/promquery"
+	"github.com/neogan74/k8s-chaos/internal/redact"
+	"github.com/neogan74/k8s-chaos/internal/resulthook"
+	"github.com/neogan74/k8s-chaos/internal/siem"
+	"github.com/neogan74/k8s-chaos/internal/signing"
 	// +kubebuilder:scaffold:imports
 )
 
@@ -94,6 +115,43 @@ func main() {
 	var historyNamespace string
 	var historyRetentionLimit int
 	var historyTTL time.Duration
+	var historySigningSecret string
+	var historyRegressionThreshold int
+	var historySnapshotMaxBytes int
+	var historySnapshotLogLines int
+	var historySizeBudget int
+	var resultWebhookURLs []string
+	var resultWebhookSecret string
+	var resultWebhookMaxRetries int
+	var eventBusSecret string
+	var changeManagementSecret string
+	var digestSecret, digestPeriod string
+	var siemAddr, siemProtocol, siemFormat string
+	var prometheusURL string
+	var apiAddr string
+	var apiTokenSecret string
+	var apiCertPath string
+	var dashboardEnabled bool
+	var dashboardIssuer string
+	var dashboardSecret string
+	var dashboardURL string
+	var slackSecret, slackTemplates string
+	var diagnosticsAddr string
+	var stressImage, stressFallbackImage string
+	var ephemeralStartTimeout time.Duration
+	var verifyInjections bool
+	var cleanupTaskRetention time.Duration
+	var impersonateInitiator bool
+	var monkeyServiceAccount string
+	var tagDestructiveRequests bool
+	var redactPatterns []string
+	var listPodsFromAPI bool
+	var podListPageSize int64
+	var podCacheLabelSelector string
+	var watchNamespaces string
+	var watchNamespacesFromPolicies bool
+	var shardCount int
+	var shardIndex int
 	flag.StringVar(&metricsAddr, "metrics-bind-address", "0", "The address the metrics endpoint binds to. "+
 		"Use :8443 for HTTPS or :8080 for HTTP, or leave as 0 to disable the metrics service.")
 	flag.StringVar(&probeAddr, "health-probe-bind-address", ":8081", "The address the probe endpoint binds to.")
@@ -122,6 +180,129 @@ func main() {
 	flag.DurationVar(&historyTTL, "history-ttl", 30*24*time.Hour,
 		"Time-to-live for history records. Records older than this duration will be automatically deleted. "+
 			"Set to 0 to disable TTL-based cleanup. Minimum value: 1h. Default: 720h (30 days)")
+	flag.StringVar(&historySigningSecret, "history-signing-secret", "",
+		"Secret (namespace/name) holding an HMAC or ed25519 key used to sign history records. "+
+			"Leave empty to disable signing.")
+	flag.IntVar(&historyRegressionThreshold, "history-regression-threshold", 20,
+		"Percentage by which recovery time may grow over the previous run of an experiment before "+
+			"the run is flagged as a regression. Set to 0 to disable regression detection.")
+	flag.IntVar(&historySnapshotMaxBytes, "history-snapshot-max-bytes", 16*1024,
+		"Maximum size of the events and container logs captured into each history record. Set to 0 to disable capture.")
+	flag.IntVar(&historySnapshotLogLines, "history-snapshot-log-lines", 20,
+		"Number of trailing log lines captured per affected container. Set to 0 to capture events only.")
+	flag.IntVar(&historySizeBudget, "history-size-budget", 0,
+		"Maximum total size in bytes of the history records kept per experiment. Older records are compacted "+
+			"(per-target details stripped, summaries kept) and then deleted to stay within it. Set to 0 to disable.")
+	flag.Func("result-webhook-url",
+		"HTTP endpoint that receives every history record as JSON after each execution, e.g. the ingestion "+
+			"endpoint of a data platform. Repeat the flag for several endpoints.",
+		func(url string) error {
+			resultWebhookURLs = append(resultWebhookURLs, url)
+			return nil
+		})
+	flag.StringVar(&resultWebhookSecret, "result-webhook-secret", "",
+		"Secret (namespace/name) whose \"hmac-key\" signs result webhook requests in the X-Chaos-Signature header. "+
+			"Leave empty to send unsigned requests.")
+	flag.IntVar(&resultWebhookMaxRetries, "result-webhook-max-retries", resulthook.DefaultMaxRetries,
+		"How often a failed result webhook delivery is retried with exponential backoff before it is dropped.")
+	flag.StringVar(&eventBusSecret, "event-bus-secret", "",
+		"Secret (namespace/name) configuring a Kafka REST Proxy or NATS server that receives experiment lifecycle "+
+			"events (keys: type, url, topic, username, password, token). Leave empty to disable publishing.")
+	flag.StringVar(&changeManagementSecret, "change-management-secret", "",
+		"Secret (namespace/name) configuring the ServiceNow or Jira instance that change tickets referenced by the "+
+			"chaos.gushchin.dev/change-ticket annotation are verified against (keys: type, url, username, password, "+
+			"token, approved-states, window-start-field, window-end-field). Leave empty to disable verification.")
+	flag.StringVar(&digestSecret, "digest-secret", "",
+		"Secret (namespace/name) configuring the SMTP server that mails a digest of chaos activity (keys: host, "+
+			"port, username, password, from, to). Leave empty to disable the digest.")
+	flag.StringVar(&digestPeriod, "digest-period", digest.PeriodDaily,
+		"How often the digest is mailed, covering the period before: daily (at midnight) or weekly (on Monday at midnight).")
+	flag.StringVar(&siemAddr, "siem-address", "",
+		"Address (host:port) of a syslog collector that receives every history record as an audit event, "+
+			"e.g. siem.example.com:6514. Leave empty to disable forwarding.")
+	flag.StringVar(&siemProtocol, "siem-protocol", siem.ProtocolTCP,
+		"Transport to the syslog collector: udp, tcp or tls.")
+	flag.StringVar(&siemFormat, "siem-format", siem.FormatCEF,
+		"Format of the forwarded audit events: cef (ArcSight Common Event Format) or json (the history record).")
+	flag.StringVar(&prometheusURL, "prometheus-url", "",
+		"Base URL of the Prometheus server used to evaluate experiment metricsQueries "+
+			"(e.g. http://prometheus.monitoring:9090). Leave empty to disable metrics sampling.")
+	flag.StringVar(&apiAddr, "api-bind-address", "0",
+		"The address the experiment REST API binds to (e.g. :8090). Set to 0 to disable the API.")
+	flag.StringVar(&apiTokenSecret, "api-token-secret", "",
+		"Secret (namespace/name) holding the REST API bearer tokens, one data key per client. Required with --api-bind-address.")
+	flag.StringVar(&apiCertPath, "api-cert-path", "",
+		"The directory that contains tls.crt and tls.key for the REST API. Leave empty to serve plain HTTP.")
+	flag.BoolVar(&dashboardEnabled, "dashboard-enabled", false,
+		"Serve the web dashboard under /ui/ on the REST API address. Requires --api-bind-address.")
+	flag.StringVar(&dashboardIssuer, "dashboard-oidc-issuer", "",
+		"OIDC issuer URL that authenticates dashboard users (e.g. https://dex.example.com).")
+	flag.StringVar(&dashboardSecret, "dashboard-oidc-secret", "",
+		"Secret (namespace/name) with the dashboard's OIDC client-id and client-secret keys.")
+	flag.StringVar(&dashboardURL, "dashboard-url", "",
+		"External base URL of the dashboard (e.g. https://chaos.example.com); /ui/callback must be "+
+			"registered as redirect URI at the OIDC provider.")
+	flag.StringVar(&slackSecret, "slack-secret", "",
+		"Secret (namespace/name) with the signing-secret of a Slack app whose slash command is served at "+
+			"/slack/command on the REST API address. Requires --api-bind-address and --slack-templates.")
+	flag.StringVar(&slackTemplates, "slack-templates", "",
+		"ConfigMap (namespace/name) of experiment templates the Slack command may run: every key is a "+
+			"template name and its value a ChaosExperiment manifest.")
+	flag.StringVar(&diagnosticsAddr, "diagnostics-bind-address", "0",
+		"The address the pprof and expvar diagnostics endpoint binds to, e.g. 127.0.0.1:6060 to reach it "+
+			"with kubectl port-forward. Use the default value \"0\" to disable it.")
+	flag.StringVar(&stressImage, "stress-image", "",
+		"stress-ng image for pod-cpu-stress and pod-memory-stress, e.g. a mirror in a private registry. "+
+			"Empty uses the built-in defaults.")
+	flag.StringVar(&stressFallbackImage, "stress-fallback-image", "",
+		"BusyBox image running the built-in stressors when the stress-ng image cannot be pulled. "+
+			"Empty uses busybox:1.36.")
+	flag.DurationVar(&ephemeralStartTimeout, "ephemeral-start-timeout", 30*time.Second,
+		"How long a reconcile waits for injected ephemeral containers to start before reporting them in "+
+			"status.targetResults; containers still starting are checked again on the next reconcile.")
+	flag.BoolVar(&verifyInjections, "verify-injections", true,
+		"Probe every running injected container for its fault (netem qdisc, partition chains, stressor CPU and "+
+			"memory, fill file) and report it as verified or unverified in status.targetResults.")
+	flag.DurationVar(&cleanupTaskRetention, "cleanup-task-retention", time.Hour,
+		"How long succeeded ChaosCleanupTasks are kept before they are deleted. Failed tasks are kept "+
+			"until deleted by hand.")
+	flag.Func("redact-pattern",
+		"Regular expression of text to redact from command output and errors stored in status and history, "+
+			"in addition to the built-in credential patterns. A group named \"value\" limits the redaction to it. "+
+			"Repeat the flag for several patterns.",
+		func(pattern string) error {
+			redactPatterns = append(redactPatterns, pattern)
+			return nil
+		})
+	flag.BoolVar(&impersonateInitiator, "impersonate-initiator", false,
+		"Perform the destructive operations of each experiment as the ServiceAccount that created it, as "+
+			"recorded by the mutating webhook. Experiments created by users, by the controller itself or without a "+
+			"recorded creator fail.")
+	flag.StringVar(&monkeyServiceAccount, "chaos-monkey-service-account", "",
+		"ServiceAccount (namespace/name) ChaosMonkeys create their experiments as, so that they can run with "+
+			"--impersonate-initiator. Leave empty to create them as the controller.")
+	flag.BoolVar(&tagDestructiveRequests, "tag-destructive-requests", false,
+		"Send the destructive operations of each experiment with the user-agent k8s-chaos-injector/<experiment UID>, "+
+			"so the API server audit log can be correlated with experiments (k8s-chaos audit).")
+	flag.BoolVar(&listPodsFromAPI, "list-pods-from-api", false,
+		"List the pods of experiments from the API server in pages instead of from the informer cache. "+
+			"Use it for selectors matching thousands of pods.")
+	flag.Int64Var(&podListPageSize, "pod-list-page-size", 250,
+		"The page size of pod lists read from the API server.")
+	flag.StringVar(&podCacheLabelSelector, "pod-cache-label-selector", "",
+		"Only cache pods matching this label selector, e.g. 'chaos.gushchin.dev/exclude!=true'. "+
+			"Pods outside it are invisible to experiments.")
+	flag.StringVar(&watchNamespaces, "watch-namespaces", "",
+		"Comma-separated namespaces to restrict the cache to. Experiments targeting other namespaces fail. "+
+			"Empty watches all namespaces.")
+	flag.BoolVar(&watchNamespacesFromPolicies, "watch-namespaces-from-policies", false,
+		"Also watch the namespaces named by ChaosPolicies at startup. Restart the controller after "+
+			"changing them.")
+	flag.IntVar(&shardCount, "shard-count", 1,
+		"The number of shards experiments and monkeys are split into by a hash of namespace/name. "+
+			"Every replica must use the same value.")
+	flag.IntVar(&shardIndex, "shard-index", -1,
+		"The shard this replica reconciles. -1 takes it from the StatefulSet ordinal of the hostname.")
 	opts := zap.Options{
 		Development: true,
 	}
@@ -211,13 +392,73 @@ func main() {
 		metricsServerOptions.KeyName = metricsCertKey
 	}
 
+	// Restrict the pod informer to the pods chaos may target
+	var podCacheSelector labels.Selector
+	cacheOptions := cache.Options{}
+	if podCacheLabelSelector != "" {
+		selector, err := labels.Parse(podCacheLabelSelector)
+		if err != nil {
+			setupLog.Error(err, "invalid pod-cache-label-selector", "value", podCacheLabelSelector)
+			os.Exit(1)
+		}
+		podCacheSelector = selector
+		cacheOptions.ByObject = map[client.Object]cache.ByObject{&corev1.Pod{}: {Label: podCacheSelector}}
+		setupLog.Info("Pod cache restricted by label selector", "selector", podCacheSelector.String())
+	}
+
+	// Split experiments across replicas; each shard elects its own leader
+	var shard *controller.Shard
+	leaderElectionID := "139434bb.gushchin.dev"
+	if shardCount > 1 {
+		if shardIndex < 0 {
+			hostname, err := os.Hostname()
+			if err == nil {
+				shardIndex, err = controller.ShardIndexFromHostname(hostname)
+			}
+			if err != nil {
+				setupLog.Error(err, "unable to derive shard-index; set it explicitly")
+				os.Exit(1)
+			}
+		}
+		var err error
+		if shard, err = controller.NewShard(shardIndex, shardCount); err != nil {
+			setupLog.Error(err, "invalid sharding configuration")
+			os.Exit(1)
+		}
+		leaderElectionID = fmt.Sprintf("shard-%d.%s", shard.Index, leaderElectionID)
+		setupLog.Info("Sharding experiments", "shard", shard.String())
+	}
+
+	// Restrict the cache to the namespaces chaos is enabled in
+	var watchedNamespaces []string
+	if watchNamespaces != "" || watchNamespacesFromPolicies {
+		reader, err := client.New(ctrl.GetConfigOrDie(), client.Options{Scheme: scheme})
+		if err != nil {
+			setupLog.Error(err, "unable to create client for ChaosPolicies")
+			os.Exit(1)
+		}
+		watchedNamespaces, err = controller.WatchedNamespaces(context.Background(), reader,
+			strings.Split(watchNamespaces, ","), watchNamespacesFromPolicies)
+		if err != nil {
+			setupLog.Error(err, "unable to resolve watched namespaces")
+			os.Exit(1)
+		}
+		if len(watchedNamespaces) == 0 {
+			setupLog.Info("No namespace selected by watch-namespaces or ChaosPolicies; watching all namespaces")
+		} else {
+			setupLog.Info("Cache restricted to namespaces", "namespaces", watchedNamespaces)
+		}
+		controller.RestrictCacheToNamespaces(&cacheOptions, watchedNamespaces)
+	}
+
 	mgr, err := ctrl.NewManager(ctrl.GetConfigOrDie(), ctrl.Options{
 		Scheme:                 scheme,
+		Cache:                  cacheOptions,
 		Metrics:                metricsServerOptions,
 		WebhookServer:          webhookServer,
 		HealthProbeBindAddress: probeAddr,
 		LeaderElection:         enableLeaderElection,
-		LeaderElectionID:       "139434bb.gushchin.dev",
+		LeaderElectionID:       leaderElectionID,
 		// LeaderElectionReleaseOnCancel defines if the leader should step down voluntarily
 		// when the Manager ends. This requires the binary to immediately end when the
 		// Manager is stopped, otherwise, this setting is unsafe. Setting this significantly
@@ -243,25 +484,346 @@ func main() {
 		os.Exit(1)
 	}
 
+	// Experiments the controller creates itself are refused when impersonating their initiator
+	var controllerUsername string
+	if impersonateInitiator {
+		if controllerUsername, err = selfUsername(clientset); err != nil {
+			setupLog.Error(err, "unable to determine the controller's own username for impersonate-initiator")
+			os.Exit(1)
+		}
+	}
+
 	// Configure history settings
 	historyConfig := controller.HistoryConfig{
-		Enabled:        historyEnabled,
-		Namespace:      historyNamespace,
-		RetentionLimit: historyRetentionLimit,
-		RetentionTTL:   historyTTL,
-	}
-
-	if err := (&controller.ChaosExperimentReconciler{
-		Client:        mgr.GetClient(),
-		Scheme:        mgr.GetScheme(),
-		Config:        config,
-		Clientset:     clientset,
-		Recorder:      mgr.GetEventRecorderFor("chaosexperiment-controller"),
-		HistoryConfig: historyConfig,
-	}).SetupWithManager(mgr); err != nil {
+		Enabled:             historyEnabled,
+		Namespace:           historyNamespace,
+		RetentionLimit:      historyRetentionLimit,
+		RetentionTTL:        historyTTL,
+		RegressionThreshold: historyRegressionThreshold,
+		SnapshotMaxBytes:    historySnapshotMaxBytes,
+		SnapshotLogLines:    historySnapshotLogLines,
+		SizeBudget:          historySizeBudget,
+	}
+	if historySigningSecret != "" {
+		secretNamespace, secretName, found := strings.Cut(historySigningSecret, "/")
+		if !found || secretNamespace == "" || secretName == "" {
+			setupLog.Error(nil, "history-signing-secret must be in namespace/name format", "value", historySigningSecret)
+			os.Exit(1)
+		}
+		secret, err := clientset.CoreV1().Secrets(secretNamespace).Get(context.Background(), secretName, metav1.GetOptions{})
+		if err != nil {
+			setupLog.Error(err, "unable to read history signing secret", "secret", historySigningSecret)
+			os.Exit(1)
+		}
+		signingKey, err := signing.KeyFromSecret(secret)
+		if err != nil {
+			setupLog.Error(err, "invalid history signing secret", "secret", historySigningSecret)
+			os.Exit(1)
+		}
+		historyConfig.SigningKey = signingKey
+		setupLog.Info("History record signing enabled", "algorithm", signingKey.Algorithm)
+	}
+
+	// Deliver history records to external result webhooks
+	var resultWebhooks *resulthook.Sender
+	if len(resultWebhookURLs) > 0 {
+		if !historyEnabled {
+			setupLog.Error(nil, "result-webhook-url requires history-enabled")
+			os.Exit(1)
+		}
+		var secret []byte
+		if resultWebhookSecret != "" {
+			s, err := readSecret(clientset, resultWebhookSecret)
+			if err != nil {
+				setupLog.Error(err, "unable to read result webhook secret", "secret", resultWebhookSecret)
+				os.Exit(1)
+			}
+			if secret = s.Data[signing.SecretKeyHMAC]; len(secret) == 0 {
+				setupLog.Error(nil, "result webhook secret has no hmac-key", "secret", resultWebhookSecret)
+				os.Exit(1)
+			}
+		}
+		resultWebhooks = resulthook.NewSender(resultWebhookURLs, secret, resultWebhookMaxRetries)
+		if err := mgr.Add(resultWebhooks); err != nil {
+			setupLog.Error(err, "unable to add result webhook sender")
+			os.Exit(1)
+		}
+		setupLog.Info("Result webhooks enabled", "endpoints", len(resultWebhookURLs), "signed", len(secret) > 0)
+	}
+
+	// Forward history records to the SIEM
+	var auditLog *siem.Forwarder
+	if siemAddr != "" {
+		if !historyEnabled {
+			setupLog.Error(nil, "siem-address requires history-enabled")
+			os.Exit(1)
+		}
+		if auditLog, err = siem.NewForwarder(siemProtocol, siemAddr, siemFormat); err != nil {
+			setupLog.Error(err, "invalid SIEM configuration")
+			os.Exit(1)
+		}
+		if err := mgr.Add(auditLog); err != nil {
+			setupLog.Error(err, "unable to add SIEM forwarder")
+			os.Exit(1)
+		}
+	}
+
+	// Publish lifecycle events to Kafka or NATS
+	var bus *eventbus.Bus
+	if eventBusSecret != "" {
+		secret, err := readSecret(clientset, eventBusSecret)
+		if err != nil {
+			setupLog.Error(err, "unable to read event bus secret", "secret", eventBusSecret)
+			os.Exit(1)
+		}
+		backend, err := eventbus.BackendFromSecret(secret)
+		if err != nil {
+			setupLog.Error(err, "invalid event bus secret", "secret", eventBusSecret)
+			os.Exit(1)
+		}
+		bus = eventbus.New(backend, mgr.GetCache(), shard)
+		if err := mgr.Add(bus); err != nil {
+			setupLog.Error(err, "unable to add event bus publisher")
+			os.Exit(1)
+		}
+		setupLog.Info("Event bus publishing enabled", "type", string(secret.Data[eventbus.SecretKeyType]))
+	}
+
+	// Verify change tickets against ServiceNow or Jira before injecting
+	var changeManagement changemgmt.System
+	if changeManagementSecret != "" {
+		secret, err := readSecret(clientset, changeManagementSecret)
+		if err != nil {
+			setupLog.Error(err, "unable to read change management secret", "secret", changeManagementSecret)
+			os.Exit(1)
+		}
+		changeManagement, err = changemgmt.SystemFromSecret(secret)
+		if err != nil {
+			setupLog.Error(err, "invalid change management secret", "secret", changeManagementSecret)
+			os.Exit(1)
+		}
+		setupLog.Info("Change ticket verification enabled", "type", string(secret.Data[changemgmt.SecretKeyType]))
+	}
+
+	// Mail a periodic digest of experiments run, verdicts and upcoming scheduled chaos
+	if digestSecret != "" {
+		if !historyEnabled {
+			setupLog.Error(nil, "digest-secret requires history-enabled")
+			os.Exit(1)
+		}
+		if err := digest.ValidatePeriod(digestPeriod); err != nil {
+			setupLog.Error(err, "invalid digest period")
+			os.Exit(1)
+		}
+		secret, err := readSecret(clientset, digestSecret)
+		if err != nil {
+			setupLog.Error(err, "unable to read digest secret", "secret", digestSecret)
+			os.Exit(1)
+		}
+		mailer, err := digest.SMTPFromSecret(secret)
+		if err != nil {
+			setupLog.Error(err, "invalid digest secret", "secret", digestSecret)
+			os.Exit(1)
+		}
+		if !shard.Primary() {
+			// Every shard leader sees all history; only the first one mails it
+			setupLog.Info("Chaos digest is mailed by shard 0", "shard", shard.String())
+		} else if err := mgr.Add(&digest.Digest{
+			Reader:           mgr.GetAPIReader(),
+			Mailer:           mailer,
+			Period:           digestPeriod,
+			HistoryNamespace: historyNamespace,
+		}); err != nil {
+			setupLog.Error(err, "unable to add digest sender")
+			os.Exit(1)
+		} else {
+			setupLog.Info("Chaos digest enabled", "period", digestPeriod, "recipients", len(mailer.To))
+		}
+	}
+
+	var prometheusClient *promquery.Client
+	if prometheusURL != "" {
+		prometheusClient = promquery.NewClient(prometheusURL)
+		setupLog.Info("Metrics query sampling enabled", "prometheus", prometheusURL)
+	}
+
+	// Serve pprof profiles and runtime state for support requests
+	var reconcileErrors *diagnostics.ErrorLog
+	if diagnosticsAddr != "0" && diagnosticsAddr != "" {
+		flagValues := map[string]string{}
+		flag.VisitAll(func(f *flag.Flag) { flagValues[f.Name] = f.Value.String() })
+		reconcileErrors = diagnostics.NewErrorLog(50)
+		diagnostics.Publish(flagValues, reconcileErrors)
+		if err := mgr.Add(&diagnostics.Server{Addr: diagnosticsAddr}); err != nil {
+			setupLog.Error(err, "unable to add diagnostics server")
+			os.Exit(1)
+		}
+	}
+
+	redactor, err := redact.New(redactPatterns)
+	if err != nil {
+		setupLog.Error(err, "invalid redact-pattern")
+		os.Exit(1)
+	}
+
+	experimentReconciler := &controller.ChaosExperimentReconciler{
+		Client:                 mgr.GetClient(),
+		Scheme:                 mgr.GetScheme(),
+		Config:                 config,
+		Clientset:              clientset,
+		APIReader:              mgr.GetAPIReader(),
+		Recorder:               bus.Recorder(mgr.GetEventRecorderFor("chaosexperiment-controller")),
+		HistoryConfig:          historyConfig,
+		Prometheus:             prometheusClient,
+		ResultWebhooks:         resultWebhooks,
+		AuditLog:               auditLog,
+		ReconcileErrors:        reconcileErrors,
+		StressImage:            stressImage,
+		StressFallbackImage:    stressFallbackImage,
+		EphemeralStartTimeout:  ephemeralStartTimeout,
+		VerifyInjections:       verifyInjections,
+		ImpersonateInitiator:   impersonateInitiator,
+		ControllerUsername:     controllerUsername,
+		TagDestructiveRequests: tagDestructiveRequests,
+		Redactor:               redactor,
+		ChangeManagement:       changeManagement,
+		ListPodsFromAPI:        listPodsFromAPI,
+		PodListPageSize:        podListPageSize,
+		PodCacheSelector:       podCacheSelector,
+		WatchNamespaces:        watchedNamespaces,
+		Shard:                  shard,
+	}
+	if err := experimentReconciler.SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "ChaosExperiment")
 		os.Exit(1)
 	}
+	var monkeyWriter client.Client
+	if monkeyServiceAccount != "" {
+		user, err := apiserver.ServiceAccountUser(monkeyServiceAccount)
+		if err != nil {
+			setupLog.Error(err, "invalid chaos-monkey-service-account")
+			os.Exit(1)
+		}
+		if monkeyWriter, err = impersonatingClient(mgr, user); err != nil {
+			setupLog.Error(err, "unable to create client for chaos-monkey-service-account")
+			os.Exit(1)
+		}
+	}
+	if err := (&controller.ChaosMonkeyReconciler{
+		Client:           mgr.GetClient(),
+		Scheme:           mgr.GetScheme(),
+		Recorder:         mgr.GetEventRecorderFor("chaosmonkey-controller"),
+		Experiments:      experimentReconciler,
+		WatchNamespaces:  watchedNamespaces,
+		Shard:            shard,
+		ExperimentWriter: monkeyWriter,
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "ChaosMonkey")
+		os.Exit(1)
+	}
+	if err := (&controller.ChaosCleanupTaskReconciler{
+		Client:      mgr.GetClient(),
+		Scheme:      mgr.GetScheme(),
+		Recorder:    mgr.GetEventRecorderFor("chaoscleanuptask-controller"),
+		Experiments: experimentReconciler,
+		Retention:   cleanupTaskRetention,
+		Shard:       shard,
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "ChaosCleanupTask")
+		os.Exit(1)
+	}
+
+	// Serve the experiment REST API and, optionally, the dashboard on top of it
+	if dashboardEnabled && (apiAddr == "0" || apiAddr == "") {
+		setupLog.Error(nil, "dashboard-enabled requires api-bind-address")
+		os.Exit(1)
+	}
+	if slackSecret != "" && (apiAddr == "0" || apiAddr == "") {
+		setupLog.Error(nil, "slack-secret requires api-bind-address")
+		os.Exit(1)
+	}
+	// Check the RBAC permissions of every action at startup rather than failing experiments with 403s
+	rbacChecker := &preflight.Checker{Reviewer: preflight.SelfReviewer(clientset.AuthorizationV1())}
+
+	if apiAddr != "0" && apiAddr != "" {
+		watcher, err := client.NewWithWatch(mgr.GetConfig(), client.Options{Scheme: mgr.GetScheme()})
+		if err != nil {
+			setupLog.Error(err, "unable to create watch client for the REST API")
+			os.Exit(1)
+		}
+		server := &apiserver.Server{
+			Client:           mgr.GetClient(),
+			Watcher:          watcher,
+			Tokens:           map[string]string{},
+			HistoryNamespace: historyNamespace,
+			Addr:             apiAddr,
+			CertDir:          apiCertPath,
+			Actions:          controller.SupportedActions(),
+			RBAC:             rbacChecker.Result,
+		}
+		// Bearer tokens are optional when the dashboard is the only consumer of the API
+		if apiTokenSecret != "" || !dashboardEnabled {
+			secret, err := readSecret(clientset, apiTokenSecret)
+			if err != nil {
+				setupLog.Error(err, "unable to read API token secret", "secret", apiTokenSecret)
+				os.Exit(1)
+			}
+			if server.Tokens, err = apiserver.TokensFromSecret(secret); err != nil {
+				setupLog.Error(err, "invalid API token secret", "secret", apiTokenSecret)
+				os.Exit(1)
+			}
+			accounts, err := apiserver.ServiceAccountsFromSecret(secret)
+			if err != nil {
+				setupLog.Error(err, "invalid API token secret", "secret", apiTokenSecret)
+				os.Exit(1)
+			}
+			if server.Namespaces, err = apiserver.NamespacesFromSecret(secret); err != nil {
+				setupLog.Error(err, "invalid API token secret", "secret", apiTokenSecret)
+				os.Exit(1)
+			}
+			server.Writers = map[string]client.Client{}
+			for name, user := range accounts {
+				if server.Writers[name], err = impersonatingClient(mgr, user); err != nil {
+					setupLog.Error(err, "unable to create client for API client", "client", name, "serviceAccount", user)
+					os.Exit(1)
+				}
+			}
+		}
+		if dashboardEnabled {
+			secret, err := readSecret(clientset, dashboardSecret)
+			if err != nil {
+				setupLog.Error(err, "unable to read dashboard OIDC secret", "secret", dashboardSecret)
+				os.Exit(1)
+			}
+			if server.Dashboard, err = apiserver.DashboardConfigFromSecret(secret, dashboardIssuer, dashboardURL); err != nil {
+				setupLog.Error(err, "invalid dashboard configuration")
+				os.Exit(1)
+			}
+		}
+		if slackSecret != "" {
+			secret, err := readSecret(clientset, slackSecret)
+			if err != nil {
+				setupLog.Error(err, "unable to read Slack secret", "secret", slackSecret)
+				os.Exit(1)
+			}
+			// Templates are read from the API server so the cache does not hold every ConfigMap
+			server.Slack, err = apiserver.SlackConfigFromSecret(secret, slackTemplates, mgr.GetAPIReader(), dashboardURL)
+			if err != nil {
+				setupLog.Error(err, "invalid Slack configuration")
+				os.Exit(1)
+			}
+			if server.Slack.ServiceAccount != "" {
+				if server.Slack.Writer, err = impersonatingClient(mgr, server.Slack.ServiceAccount); err != nil {
+					setupLog.Error(err, "unable to create client for the Slack ServiceAccount")
+					os.Exit(1)
+				}
+			}
+		}
+		if err := mgr.Add(server); err != nil {
+			setupLog.Error(err, "unable to add REST API server")
+			os.Exit(1)
+		}
+	}
 
 	// Setup webhooks
 	if webhookEnabled {
@@ -269,6 +831,8 @@ func main() {
 			setupLog.Error(err, "unable to create webhook", "webhook", "ChaosExperiment")
 			os.Exit(1)
 		}
+		// Target of the ValidatingWebhookConfigurations admission-delay experiments register
+		mgr.GetWebhookServer().Register(admissiondelay.Path, admissiondelay.Handler())
 	}
 	// +kubebuilder:scaffold:builder
 
@@ -280,6 +844,21 @@ func main() {
 		setupLog.Error(err, "unable to set up ready check")
 		os.Exit(1)
 	}
+	if webhookEnabled {
+		if err := mgr.AddReadyzCheck("webhook", mgr.GetWebhookServer().StartedChecker()); err != nil {
+			setupLog.Error(err, "unable to set up webhook ready check")
+			os.Exit(1)
+		}
+	}
+
+	if err := mgr.Add(rbacChecker); err != nil {
+		setupLog.Error(err, "unable to add RBAC preflight check")
+		os.Exit(1)
+	}
+	if err := mgr.AddReadyzCheck("rbac", rbacChecker.ReadyzCheck); err != nil {
+		setupLog.Error(err, "unable to set up RBAC ready check")
+		os.Exit(1)
+	}
 
 	setupLog.Info("starting manager")
 	if err := mgr.Start(ctrl.SetupSignalHandler()); err != nil {
@@ -287,3 +866,30 @@ func main() {
 		os.Exit(1)
 	}
 }
+
+// selfUsername asks the API server which user the controller authenticates as
+func selfUsername(clientset kubernetes.Interface) (string, error) {
+	review, err := clientset.AuthenticationV1().SelfSubjectReviews().Create(context.Background(),
+		&authenticationv1.SelfSubjectReview{}, metav1.CreateOptions{})
+	if err != nil {
+		return "", err
+	}
+	return review.Status.UserInfo.Username, nil
+}
+
+// impersonatingClient returns a client acting as username, so the mutating webhook records it as
+// the creator of the experiments created with it
+func impersonatingClient(mgr ctrl.Manager, username string) (client.Client, error) {
+	cfg := rest.CopyConfig(mgr.GetConfig())
+	cfg.Impersonate = rest.ImpersonationConfig{UserName: username}
+	return client.New(cfg, client.Options{Scheme: mgr.GetScheme(), Mapper: mgr.GetRESTMapper()})
+}
+
+// readSecret fetches a Secret referenced by a namespace/name flag value
+func readSecret(clientset kubernetes.Interface, ref string) (*corev1.Secret, error) {
+	namespace, name, found := strings.Cut(ref, "/")
+	if !found || namespace == "" || name == "" {
+		return nil, fmt.Errorf("secret reference %q is not in namespace/name format", ref)
+	}
+	return clientset.CoreV1().Secrets(namespace).Get(context.Background(), name, metav1.GetOptions{})
+}
@@ -0,0 +1,59 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Command openapi regenerates api/openapi/openapi.json from the CRD manifests and the types of
+// the Python and TypeScript clients from it. Run it from the repository root with `make openapi`.
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/neogan74/k8s-chaos/api/openapi"
+)
+
+func main() {
+	if err := run(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func run() error {
+	spec, err := openapi.Generate("config/crd/bases")
+	if err != nil {
+		return err
+	}
+	typescript, err := openapi.TypeScript(spec)
+	if err != nil {
+		return err
+	}
+	python, err := openapi.Python(spec)
+	if err != nil {
+		return err
+	}
+
+	for path, content := range map[string][]byte{
+		"api/openapi/openapi.json":                 spec,
+		"clients/typescript/src/types.ts":          typescript,
+		"clients/python/k8s_chaos_client/types.py": python,
+	} {
+		if err := os.WriteFile(path, content, 0o644); err != nil {
+			return fmt.Errorf("failed to write %s: %w", path, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,87 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package admissiondelay serves the no-op admission webhook of the admission-delay action. The
+// ValidatingWebhookConfiguration an experiment registers points at a path carrying the delay and
+// the end of the run; the handler holds each request for the delay and then admits it.
+package admissiondelay
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	chaosv1alpha1 "github.com/neogan74/k8s-chaos/api/v1alpha1"
+)
+
+// Path is where the handler is served; the rest of a request path is "<delay ms>/<expiry unix>"
+const Path = "/chaos-admission-delay/"
+
+// PathFor returns the webhook path that delays requests by delay until expires
+func PathFor(delay time.Duration, expires time.Time) string {
+	return fmt.Sprintf("%s%d/%d", Path, delay.Milliseconds(), expires.Unix())
+}
+
+// parsePath reads the delay and expiry back from a path built by PathFor
+func parsePath(path string) (time.Duration, time.Time, error) {
+	parts := strings.Split(strings.TrimPrefix(path, Path), "/")
+	if len(parts) != 2 {
+		return 0, time.Time{}, fmt.Errorf("malformed admission delay path %q", path)
+	}
+	delay, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return 0, time.Time{}, fmt.Errorf("malformed delay in %q: %w", path, err)
+	}
+	expires, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return 0, time.Time{}, fmt.Errorf("malformed expiry in %q: %w", path, err)
+	}
+	return time.Duration(delay) * time.Millisecond, time.Unix(expires, 0), nil
+}
+
+// Handler admits every request after holding it for the delay in its path. The delay is capped at
+// chaosv1alpha1.MaxAdmissionDelay, and once the expiry in the path has passed requests are admitted
+// at once, so a configuration the controller failed to remove costs nothing.
+func Handler() http.Handler {
+	allow := &admission.Webhook{Handler: admission.HandlerFunc(func(context.Context, admission.Request) admission.Response {
+		return admission.Allowed("")
+	})}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		delay, expires, err := parsePath(r.URL.Path)
+		if err == nil {
+			hold(r.Context(), min(delay, chaosv1alpha1.MaxAdmissionDelay, time.Until(expires)))
+		}
+		allow.ServeHTTP(w, r)
+	})
+}
+
+// hold waits for d unless the API server gives up on the request first
+func hold(ctx context.Context, d time.Duration) {
+	if d <= 0 {
+		return
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+	case <-ctx.Done():
+	}
+}
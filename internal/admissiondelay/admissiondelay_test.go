@@ -0,0 +1,103 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package admissiondelay
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestPathForRoundTrip(t *testing.T) {
+	expires := time.Unix(1760000000, 0)
+	path := PathFor(1500*time.Millisecond, expires)
+	if path != "/chaos-admission-delay/1500/1760000000" {
+		t.Fatalf("PathFor = %q", path)
+	}
+	delay, gotExpires, err := parsePath(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if delay != 1500*time.Millisecond || !gotExpires.Equal(expires) {
+		t.Errorf("parsePath = %s, %s", delay, gotExpires)
+	}
+
+	for _, bad := range []string{Path, Path + "1500", Path + "x/1", Path + "1/x", Path + "1/2/3"} {
+		if _, _, err := parsePath(bad); err == nil {
+			t.Errorf("parsePath(%q) succeeded", bad)
+		}
+	}
+}
+
+// review posts an AdmissionReview to the handler and returns the response and how long it took
+func review(t *testing.T, path string) (*admissionv1.AdmissionResponse, time.Duration) {
+	t.Helper()
+	body, err := json.Marshal(admissionv1.AdmissionReview{
+		TypeMeta: metav1.TypeMeta{APIVersion: "admission.k8s.io/v1", Kind: "AdmissionReview"},
+		Request:  &admissionv1.AdmissionRequest{UID: "4b1d", Operation: admissionv1.Create},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	req := httptest.NewRequest(http.MethodPost, path, bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+
+	start := time.Now()
+	Handler().ServeHTTP(rec, req)
+	elapsed := time.Since(start)
+
+	var resp admissionv1.AdmissionReview
+	if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("decoding response %q: %v", rec.Body.String(), err)
+	}
+	if resp.Response == nil {
+		t.Fatalf("no response in %q", rec.Body.String())
+	}
+	return resp.Response, elapsed
+}
+
+func TestHandlerDelaysThenAdmits(t *testing.T) {
+	resp, elapsed := review(t, PathFor(200*time.Millisecond, time.Now().Add(time.Minute)))
+	if !resp.Allowed || resp.UID != "4b1d" {
+		t.Errorf("response = %+v, want the request allowed", resp)
+	}
+	if elapsed < 200*time.Millisecond {
+		t.Errorf("request held for %s, want at least 200ms", elapsed)
+	}
+}
+
+func TestHandlerAdmitsAtOnceAfterExpiry(t *testing.T) {
+	for _, path := range []string{
+		PathFor(5*time.Second, time.Now().Add(-time.Second)),
+		Path + "garbage",
+	} {
+		resp, elapsed := review(t, path)
+		if !resp.Allowed {
+			t.Errorf("%s: response = %+v, want the request allowed", path, resp)
+		}
+		if elapsed > time.Second {
+			t.Errorf("%s: request held for %s, want no delay", path, elapsed)
+		}
+	}
+}
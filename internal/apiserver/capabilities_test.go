@@ -0,0 +1,75 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apiserver
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/neogan74/k8s-chaos/internal/capabilities"
+	"github.com/neogan74/k8s-chaos/internal/preflight"
+)
+
+func TestCapabilities(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := corev1.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	shop := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{
+		Name: "shop", Labels: map[string]string{"pod-security.kubernetes.io/enforce": "baseline"},
+	}}
+	s := &Server{
+		Client:  fake.NewClientBuilder().WithScheme(scheme).WithObjects(shop).Build(),
+		Tokens:  map[string]string{testToken: "portal"},
+		Actions: []string{"pod-network-loss", "pod-kill"},
+		RBAC: func() *preflight.Result {
+			return &preflight.Result{MissingByAction: map[string][]preflight.Permission{
+				"pod-kill": {{Resource: "pods", Verb: "delete"}},
+			}}
+		},
+	}
+	server := httptest.NewServer(s.Handler())
+	t.Cleanup(server.Close)
+
+	code, out := do(t, server, http.MethodGet, "/api/v1/capabilities?namespace=shop", "")
+	if code != http.StatusOK {
+		t.Fatalf("got %d: %s", code, out)
+	}
+	report := &capabilities.Report{}
+	if err := json.Unmarshal([]byte(out), report); err != nil {
+		t.Fatal(err)
+	}
+	if report.PodSecurityLevel != "baseline" || !report.RBACChecked || len(report.Actions) != 2 {
+		t.Fatalf("unexpected report: %s", out)
+	}
+	for _, a := range report.Actions {
+		if a.Satisfied == nil || *a.Satisfied || len(a.Missing) != 1 {
+			t.Errorf("%s: expected one missing requirement, got %v", a.Name, a.Missing)
+		}
+	}
+
+	if code, _ := do(t, server, http.MethodGet, "/api/v1/capabilities?namespace=absent", ""); code != http.StatusNotFound {
+		t.Errorf("unknown namespace: got %d, want 404", code)
+	}
+}
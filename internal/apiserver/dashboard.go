@@ -0,0 +1,320 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apiserver
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"embed"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/oauth2"
+	corev1 "k8s.io/api/core/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+const (
+	sessionCookie = "k8s-chaos-session"
+	stateCookie   = "k8s-chaos-oauth-state"
+	// csrfHeader must accompany cookie-authenticated requests that change state
+	csrfHeader = "X-K8s-Chaos-Dashboard"
+
+	sessionTTL = 8 * time.Hour
+)
+
+//go:embed ui
+var uiFiles embed.FS
+
+// DashboardConfig configures the web UI and the OIDC provider that authenticates its users
+type DashboardConfig struct {
+	// IssuerURL is the OIDC issuer; its discovery document is read on the first login
+	IssuerURL string
+	// ClientID and ClientSecret identify the dashboard at the OIDC provider
+	ClientID     string
+	ClientSecret string
+	// RedirectURL is the externally reachable /ui/callback URL registered at the provider
+	RedirectURL string
+	// HTTPClient is used to talk to the provider; http.DefaultClient when nil
+	HTTPClient *http.Client
+
+	mu       sync.Mutex
+	endpoint *oidcEndpoints
+}
+
+// oidcEndpoints are the parts of the OIDC discovery document the dashboard uses
+type oidcEndpoints struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	UserinfoEndpoint      string `json:"userinfo_endpoint"`
+}
+
+func (d *DashboardConfig) httpClient() *http.Client {
+	if d.HTTPClient != nil {
+		return d.HTTPClient
+	}
+	return http.DefaultClient
+}
+
+// endpoints fetches and caches the provider's discovery document
+func (d *DashboardConfig) endpoints(ctx context.Context) (*oidcEndpoints, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.endpoint != nil {
+		return d.endpoint, nil
+	}
+
+	issuer := strings.TrimSuffix(d.IssuerURL, "/")
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, issuer+"/.well-known/openid-configuration", nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := d.httpClient().Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch OIDC discovery document: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("OIDC discovery returned HTTP %d", resp.StatusCode)
+	}
+	endpoints := &oidcEndpoints{}
+	if err := json.NewDecoder(resp.Body).Decode(endpoints); err != nil {
+		return nil, fmt.Errorf("failed to decode OIDC discovery document: %w", err)
+	}
+	if strings.TrimSuffix(endpoints.Issuer, "/") != issuer {
+		return nil, fmt.Errorf("OIDC issuer mismatch: configured %q, provider reports %q", issuer, endpoints.Issuer)
+	}
+	if endpoints.AuthorizationEndpoint == "" || endpoints.TokenEndpoint == "" || endpoints.UserinfoEndpoint == "" {
+		return nil, fmt.Errorf("OIDC discovery document lacks authorization, token or userinfo endpoint")
+	}
+	d.endpoint = endpoints
+	return endpoints, nil
+}
+
+func (d *DashboardConfig) oauth2Config(endpoints *oidcEndpoints) *oauth2.Config {
+	return &oauth2.Config{
+		ClientID:     d.ClientID,
+		ClientSecret: d.ClientSecret,
+		RedirectURL:  d.RedirectURL,
+		Endpoint: oauth2.Endpoint{
+			AuthURL:  endpoints.AuthorizationEndpoint,
+			TokenURL: endpoints.TokenEndpoint,
+		},
+		Scopes: []string{"openid", "email", "profile"},
+	}
+}
+
+// registerDashboard adds the UI and its login routes
+func (s *Server) registerDashboard(mux *http.ServeMux) {
+	static, _ := fs.Sub(uiFiles, "ui")
+	files := http.StripPrefix("/ui/", http.FileServer(http.FS(static)))
+
+	mux.HandleFunc("GET /ui/login", s.login)
+	mux.HandleFunc("GET /ui/callback", s.callback)
+	mux.HandleFunc("POST /ui/logout", s.logout)
+	mux.Handle("GET /ui/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if s.sessionUser(r) == "" {
+			http.Redirect(w, r, "/ui/login", http.StatusFound)
+			return
+		}
+		files.ServeHTTP(w, r)
+	}))
+	mux.Handle("GET /{$}", http.RedirectHandler("/ui/", http.StatusFound))
+}
+
+// login redirects the browser to the OIDC provider
+func (s *Server) login(w http.ResponseWriter, r *http.Request) {
+	endpoints, err := s.Dashboard.endpoints(r.Context())
+	if err != nil {
+		ctrl.Log.WithName("apiserver").Error(err, "OIDC discovery failed")
+		http.Error(w, "identity provider unavailable", http.StatusBadGateway)
+		return
+	}
+	state := make([]byte, 16)
+	if _, err := rand.Read(state); err != nil {
+		http.Error(w, "failed to start login", http.StatusInternalServerError)
+		return
+	}
+	stateValue := hex.EncodeToString(state)
+	http.SetCookie(w, &http.Cookie{
+		Name:     stateCookie,
+		Value:    stateValue,
+		Path:     "/ui/",
+		MaxAge:   600,
+		HttpOnly: true,
+		Secure:   s.CertDir != "",
+		SameSite: http.SameSiteLaxMode,
+	})
+	http.Redirect(w, r, s.Dashboard.oauth2Config(endpoints).AuthCodeURL(stateValue), http.StatusFound)
+}
+
+// callback completes the authorization code flow and starts a session for the user
+func (s *Server) callback(w http.ResponseWriter, r *http.Request) {
+	log := ctrl.Log.WithName("apiserver")
+	state, err := r.Cookie(stateCookie)
+	if err != nil || state.Value == "" || !hmac.Equal([]byte(state.Value), []byte(r.URL.Query().Get("state"))) {
+		http.Error(w, "invalid login state, please retry", http.StatusBadRequest)
+		return
+	}
+	if errCode := r.URL.Query().Get("error"); errCode != "" {
+		http.Error(w, "login failed: "+errCode, http.StatusUnauthorized)
+		return
+	}
+	endpoints, err := s.Dashboard.endpoints(r.Context())
+	if err != nil {
+		http.Error(w, "identity provider unavailable", http.StatusBadGateway)
+		return
+	}
+
+	ctx := context.WithValue(r.Context(), oauth2.HTTPClient, s.Dashboard.httpClient())
+	config := s.Dashboard.oauth2Config(endpoints)
+	token, err := config.Exchange(ctx, r.URL.Query().Get("code"))
+	if err != nil {
+		log.Error(err, "OIDC code exchange failed")
+		http.Error(w, "login failed", http.StatusUnauthorized)
+		return
+	}
+	user, err := s.userinfo(ctx, config, token, endpoints.UserinfoEndpoint)
+	if err != nil {
+		log.Error(err, "OIDC userinfo request failed")
+		http.Error(w, "login failed", http.StatusUnauthorized)
+		return
+	}
+
+	http.SetCookie(w, &http.Cookie{Name: stateCookie, Path: "/ui/", MaxAge: -1})
+	http.SetCookie(w, &http.Cookie{
+		Name:     sessionCookie,
+		Value:    s.signSession(user, time.Now().Add(sessionTTL)),
+		Path:     "/",
+		MaxAge:   int(sessionTTL.Seconds()),
+		HttpOnly: true,
+		Secure:   s.CertDir != "",
+		SameSite: http.SameSiteLaxMode,
+	})
+	log.Info("Dashboard login", "user", user)
+	http.Redirect(w, r, "/ui/", http.StatusFound)
+}
+
+// userinfo returns the e-mail (or username, or subject) of the logged-in user
+func (s *Server) userinfo(ctx context.Context, config *oauth2.Config, token *oauth2.Token, endpoint string) (string, error) {
+	resp, err := config.Client(ctx, token).Get(endpoint)
+	if err != nil {
+		return "", err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("userinfo returned HTTP %d", resp.StatusCode)
+	}
+	var claims struct {
+		Subject           string `json:"sub"`
+		Email             string `json:"email"`
+		PreferredUsername string `json:"preferred_username"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&claims); err != nil {
+		return "", fmt.Errorf("failed to decode userinfo: %w", err)
+	}
+	for _, identity := range []string{claims.Email, claims.PreferredUsername, claims.Subject} {
+		if identity != "" {
+			return identity, nil
+		}
+	}
+	return "", fmt.Errorf("userinfo response has no subject")
+}
+
+func (s *Server) logout(w http.ResponseWriter, r *http.Request) {
+	http.SetCookie(w, &http.Cookie{Name: sessionCookie, Path: "/", MaxAge: -1})
+	http.Redirect(w, r, "/ui/login", http.StatusSeeOther)
+}
+
+// sessionKey derives the cookie signing key from the client secret, so every replica accepts
+// sessions started on another one
+func (s *Server) sessionKey() []byte {
+	key := sha256.Sum256([]byte("k8s-chaos-dashboard-session:" + s.Dashboard.ClientSecret))
+	return key[:]
+}
+
+// signSession encodes a session as "<user>|<expiry>|<mac>", each part base64url encoded
+func (s *Server) signSession(user string, expires time.Time) string {
+	payload := base64.RawURLEncoding.EncodeToString([]byte(user)) + "|" + strconv.FormatInt(expires.Unix(), 10)
+	mac := hmac.New(sha256.New, s.sessionKey())
+	mac.Write([]byte(payload))
+	return payload + "|" + base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// sessionUser returns the user of a valid, unexpired session cookie, or "" when there is none
+func (s *Server) sessionUser(r *http.Request) string {
+	if s.Dashboard == nil {
+		return ""
+	}
+	cookie, err := r.Cookie(sessionCookie)
+	if err != nil {
+		return ""
+	}
+	parts := strings.Split(cookie.Value, "|")
+	if len(parts) != 3 {
+		return ""
+	}
+	mac := hmac.New(sha256.New, s.sessionKey())
+	mac.Write([]byte(parts[0] + "|" + parts[1]))
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil || !hmac.Equal(signature, mac.Sum(nil)) {
+		return ""
+	}
+	expires, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil || time.Now().Unix() > expires {
+		return ""
+	}
+	user, err := base64.RawURLEncoding.DecodeString(parts[0])
+	if err != nil {
+		return ""
+	}
+	return string(user)
+}
+
+// DashboardConfigFromSecret builds the dashboard configuration from a Secret holding the OIDC
+// client-id and client-secret keys. baseURL is the external address the browser reaches the
+// dashboard on.
+func DashboardConfigFromSecret(secret *corev1.Secret, issuerURL, baseURL string) (*DashboardConfig, error) {
+	if issuerURL == "" {
+		return nil, fmt.Errorf("an OIDC issuer URL is required")
+	}
+	if baseURL == "" {
+		return nil, fmt.Errorf("the dashboard's external URL is required")
+	}
+	clientID := strings.TrimSpace(string(secret.Data["client-id"]))
+	clientSecret := strings.TrimSpace(string(secret.Data["client-secret"]))
+	if clientID == "" || clientSecret == "" {
+		return nil, fmt.Errorf("secret %s/%s must contain client-id and client-secret", secret.Namespace, secret.Name)
+	}
+	return &DashboardConfig{
+		IssuerURL:    issuerURL,
+		ClientID:     clientID,
+		ClientSecret: clientSecret,
+		RedirectURL:  strings.TrimSuffix(baseURL, "/") + "/ui/callback",
+	}, nil
+}
@@ -0,0 +1,263 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apiserver
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/cookiejar"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	chaosv1alpha1 "github.com/neogan74/k8s-chaos/api/v1alpha1"
+)
+
+// newFakeIdP serves the discovery, authorization, token and userinfo endpoints of an OIDC
+// provider that logs in alice@example.com without asking
+func newFakeIdP(t *testing.T) *httptest.Server {
+	t.Helper()
+	mux := http.NewServeMux()
+	idp := httptest.NewServer(mux)
+	t.Cleanup(idp.Close)
+
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]string{
+			"issuer":                 idp.URL,
+			"authorization_endpoint": idp.URL + "/authorize",
+			"token_endpoint":         idp.URL + "/token",
+			"userinfo_endpoint":      idp.URL + "/userinfo",
+		})
+	})
+	mux.HandleFunc("/authorize", func(w http.ResponseWriter, r *http.Request) {
+		redirect := r.URL.Query().Get("redirect_uri") + "?code=abc&state=" + r.URL.Query().Get("state")
+		http.Redirect(w, r, redirect, http.StatusFound)
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil || r.PostForm.Get("code") != "abc" {
+			http.Error(w, "bad code", http.StatusBadRequest)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"access_token":"at-1","token_type":"Bearer","expires_in":3600}`))
+	})
+	mux.HandleFunc("/userinfo", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer at-1" {
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		_, _ = w.Write([]byte(`{"sub":"1234","email":"alice@example.com"}`))
+	})
+	return idp
+}
+
+func newDashboardServer(t *testing.T, issuer string, objs ...client.Object) (*httptest.Server, *Server, client.Client) {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	if err := chaosv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	cl := fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).Build()
+	s := &Server{
+		Client:           cl,
+		Tokens:           map[string]string{},
+		HistoryNamespace: "chaos-system",
+		Dashboard:        &DashboardConfig{IssuerURL: issuer, ClientID: "chaos", ClientSecret: "shh"},
+	}
+	server := httptest.NewServer(s.Handler())
+	t.Cleanup(server.Close)
+	s.Dashboard.RedirectURL = server.URL + "/ui/callback"
+	return server, s, cl
+}
+
+func TestSessionCookie(t *testing.T) {
+	s := &Server{Dashboard: &DashboardConfig{ClientSecret: "shh"}}
+	withCookie := func(value string) *http.Request {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.AddCookie(&http.Cookie{Name: sessionCookie, Value: value})
+		return req
+	}
+
+	valid := s.signSession("alice@example.com", time.Now().Add(time.Hour))
+	if got := s.sessionUser(withCookie(valid)); got != "alice@example.com" {
+		t.Errorf("valid session: got user %q", got)
+	}
+
+	expired := s.signSession("alice@example.com", time.Now().Add(-time.Minute))
+	if got := s.sessionUser(withCookie(expired)); got != "" {
+		t.Errorf("expired session accepted for %q", got)
+	}
+
+	parts := strings.Split(valid, "|")
+	forged := "Ym9i|" + parts[1] + "|" + parts[2]
+	if got := s.sessionUser(withCookie(forged)); got != "" {
+		t.Errorf("forged session accepted for %q", got)
+	}
+
+	other := &Server{Dashboard: &DashboardConfig{ClientSecret: "other"}}
+	if got := other.sessionUser(withCookie(valid)); got != "" {
+		t.Errorf("session signed with another secret accepted for %q", got)
+	}
+}
+
+func TestDashboardLoginFlow(t *testing.T) {
+	idp := newFakeIdP(t)
+	exp := testExperiment("web-kill")
+	server, _, _ := newDashboardServer(t, idp.URL, exp)
+
+	jar, _ := cookiejar.New(nil)
+	browser := &http.Client{Jar: jar}
+
+	// Opening the UI without a session walks through the provider and lands on the UI
+	resp, err := browser.Get(server.URL + "/")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_ = resp.Body.Close()
+	if resp.StatusCode != http.StatusOK || resp.Request.URL.Path != "/ui/" {
+		t.Fatalf("login flow ended at %s with %d", resp.Request.URL, resp.StatusCode)
+	}
+	if !strings.Contains(resp.Header.Get("Content-Type"), "text/html") {
+		t.Errorf("UI served %q, want HTML", resp.Header.Get("Content-Type"))
+	}
+
+	// The session cookie authenticates API reads
+	resp, err = browser.Get(server.URL + "/api/v1/experiments")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_ = resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("list with session: got %d, want 200", resp.StatusCode)
+	}
+
+	// Logging out drops the session; the fake provider would log straight back in, so stop at
+	// the first redirect
+	browser.CheckRedirect = func(*http.Request, []*http.Request) error { return http.ErrUseLastResponse }
+	resp, err = browser.Post(server.URL+"/ui/logout", "", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_ = resp.Body.Close()
+	resp, err = browser.Get(server.URL + "/ui/")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_ = resp.Body.Close()
+	if resp.StatusCode != http.StatusFound || resp.Header.Get("Location") != "/ui/login" {
+		t.Errorf("UI after logout: got %d to %q, want redirect to /ui/login", resp.StatusCode, resp.Header.Get("Location"))
+	}
+}
+
+func TestDashboardCallbackRejectsBadState(t *testing.T) {
+	idp := newFakeIdP(t)
+	server, _, _ := newDashboardServer(t, idp.URL)
+
+	req, _ := http.NewRequest(http.MethodGet, server.URL+"/ui/callback?code=abc&state=forged", nil)
+	req.AddCookie(&http.Cookie{Name: stateCookie, Value: "expected"})
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_ = resp.Body.Close()
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Errorf("mismatched state: got %d, want 400", resp.StatusCode)
+	}
+}
+
+func TestDashboardActionsRequireCSRFHeader(t *testing.T) {
+	exp := testExperiment("web-kill")
+	server, s, cl := newDashboardServer(t, "http://unused", exp)
+	session := &http.Cookie{Name: sessionCookie, Value: s.signSession("alice@example.com", time.Now().Add(time.Hour))}
+	pause := server.URL + "/api/v1/namespaces/team-a/experiments/web-kill/pause"
+
+	req, _ := http.NewRequest(http.MethodPost, pause, nil)
+	req.AddCookie(session)
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_ = resp.Body.Close()
+	if resp.StatusCode != http.StatusForbidden {
+		t.Errorf("pause without %s: got %d, want 403", csrfHeader, resp.StatusCode)
+	}
+
+	req, _ = http.NewRequest(http.MethodPost, pause, nil)
+	req.AddCookie(session)
+	req.Header.Set(csrfHeader, "1")
+	resp, err = http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_ = resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("pause: got %d, want 200", resp.StatusCode)
+	}
+	got := &chaosv1alpha1.ChaosExperiment{}
+	if err := cl.Get(context.Background(), client.ObjectKeyFromObject(exp), got); err != nil {
+		t.Fatal(err)
+	}
+	if !got.Spec.Paused {
+		t.Error("experiment not paused")
+	}
+}
+
+func TestPauseResume(t *testing.T) {
+	server, cl := newTestServer(t, testExperiment("web-kill"))
+	key := client.ObjectKey{Namespace: "team-a", Name: "web-kill"}
+
+	for _, tc := range []struct {
+		verb   string
+		paused bool
+	}{{"pause", true}, {"resume", false}} {
+		if code, body := do(t, server, http.MethodPost, "/api/v1/namespaces/team-a/experiments/web-kill/"+tc.verb, ""); code != http.StatusOK {
+			t.Fatalf("%s: got %d: %s", tc.verb, code, body)
+		}
+		got := &chaosv1alpha1.ChaosExperiment{}
+		if err := cl.Get(context.Background(), key, got); err != nil {
+			t.Fatal(err)
+		}
+		if got.Spec.Paused != tc.paused {
+			t.Errorf("after %s: paused = %v", tc.verb, got.Spec.Paused)
+		}
+	}
+
+	if code, _ := do(t, server, http.MethodPost, "/api/v1/namespaces/team-a/experiments/missing/pause", ""); code != http.StatusNotFound {
+		t.Errorf("pause missing experiment: got %d, want 404", code)
+	}
+}
+
+func TestDashboardConfigFromSecret(t *testing.T) {
+	secret := &corev1.Secret{Data: map[string][]byte{"client-id": []byte("chaos"), "client-secret": []byte("shh\n")}}
+	cfg, err := DashboardConfigFromSecret(secret, "https://dex.example.com", "https://chaos.example.com/")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg.ClientSecret != "shh" || cfg.RedirectURL != "https://chaos.example.com/ui/callback" {
+		t.Errorf("unexpected config %+v", cfg)
+	}
+	if _, err := DashboardConfigFromSecret(&corev1.Secret{}, "https://dex.example.com", "https://chaos.example.com"); err == nil {
+		t.Error("expected an error for a secret without client credentials")
+	}
+}
@@ -0,0 +1,597 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package apiserver serves an authenticated HTTP/JSON API for ChaosExperiments and their
+// history, so internal portals can drive chaos without direct access to the CRDs. Requests are
+// served with the controller's own Kubernetes client, or with a client impersonating the
+// ServiceAccount configured for the caller; callers only need an API token, which may be limited
+// to some namespaces.
+package apiserver
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/neogan74/k8s-chaos/api/openapi"
+	chaosv1alpha1 "github.com/neogan74/k8s-chaos/api/v1alpha1"
+	"github.com/neogan74/k8s-chaos/internal/capabilities"
+	"github.com/neogan74/k8s-chaos/internal/preflight"
+)
+
+const (
+	// UserAgent is recorded in the user-agent annotation of experiments created through the API
+	UserAgent = "k8s-chaos-api"
+
+	// ServiceAccountKeySuffix marks the token Secret key naming the ServiceAccount ("namespace/name")
+	// a client's writes are made as, e.g. "portal.service-account"
+	ServiceAccountKeySuffix = ".service-account"
+
+	// NamespacesKeySuffix marks the token Secret key listing, comma-separated, the namespaces a
+	// client is limited to, e.g. "portal.namespaces"
+	NamespacesKeySuffix = ".namespaces"
+
+	// serviceAccountUserPrefix starts the username of every ServiceAccount
+	serviceAccountUserPrefix = "system:serviceaccount:"
+
+	// maxBodyBytes bounds request bodies; experiments are small
+	maxBodyBytes = 1 << 20
+)
+
+// settingSuffixes mark token Secret keys that configure a client rather than hold its token
+var settingSuffixes = []string{ServiceAccountKeySuffix, NamespacesKeySuffix}
+
+// Server is the HTTP/JSON API. It implements manager.Runnable so it starts and stops with the manager.
+type Server struct {
+	// Client reads and writes ChaosExperiments and history records
+	Client client.Client
+	// Watcher reads directly from the API server to stream experiment events; streaming is
+	// unavailable when nil
+	Watcher client.WithWatch
+	// Tokens maps accepted bearer tokens to the name of the client presenting them
+	Tokens map[string]string
+	// Writers holds, by client name, the clients that create, change and delete experiments for
+	// an API client, impersonating its ServiceAccount so the webhook records it as their creator.
+	// Other clients write with Client.
+	Writers map[string]client.Client
+	// Namespaces holds, by client name, the namespaces an API client may see and act in. Clients
+	// without an entry, such as dashboard users, are not limited.
+	Namespaces map[string][]string
+	// HistoryNamespace is where the controller stores ChaosExperimentHistory records
+	HistoryNamespace string
+	// Addr is the listen address (e.g., ":8090")
+	Addr string
+	// CertDir optionally holds tls.crt and tls.key; the API is served over plain HTTP when empty
+	CertDir string
+	// Dashboard enables the web UI under /ui/ when set
+	Dashboard *DashboardConfig
+	// Slack serves a slash command running experiment templates under /slack/command when set
+	Slack *SlackConfig
+	// Actions are the actions the controller executes, described by /api/v1/capabilities
+	Actions []string
+	// RBAC returns the controller's RBAC preflight result, nil until it is known; optional
+	RBAC func() *preflight.Result
+}
+
+// TokensFromSecret reads API tokens from a Secret: every data key is a client name and its value
+// is that client's token. Keys ending in a setting suffix such as ServiceAccountKeySuffix are skipped.
+func TokensFromSecret(secret *corev1.Secret) (map[string]string, error) {
+	tokens := map[string]string{}
+	for name, token := range secret.Data {
+		if isSetting(name) {
+			continue
+		}
+		value := strings.TrimSpace(string(token))
+		if value == "" {
+			return nil, fmt.Errorf("token for client %q is empty", name)
+		}
+		tokens[value] = name
+	}
+	if len(tokens) == 0 {
+		return nil, fmt.Errorf("secret %s/%s contains no tokens", secret.Namespace, secret.Name)
+	}
+	return tokens, nil
+}
+
+// ServiceAccountsFromSecret reads the "<client>.service-account" keys of a token Secret, returning
+// the username of each client's ServiceAccount by client name
+func ServiceAccountsFromSecret(secret *corev1.Secret) (map[string]string, error) {
+	accounts := map[string]string{}
+	for key, value := range secret.Data {
+		name, ok := strings.CutSuffix(key, ServiceAccountKeySuffix)
+		if !ok {
+			continue
+		}
+		if _, hasToken := secret.Data[name]; !hasToken {
+			return nil, fmt.Errorf("%s configures client %q, which has no token", key, name)
+		}
+		user, err := ServiceAccountUser(strings.TrimSpace(string(value)))
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", key, err)
+		}
+		accounts[name] = user
+	}
+	return accounts, nil
+}
+
+// NamespacesFromSecret reads the "<client>.namespaces" keys of a token Secret, returning the
+// namespaces each client is limited to by client name
+func NamespacesFromSecret(secret *corev1.Secret) (map[string][]string, error) {
+	scopes := map[string][]string{}
+	for key, value := range secret.Data {
+		name, ok := strings.CutSuffix(key, NamespacesKeySuffix)
+		if !ok {
+			continue
+		}
+		if _, hasToken := secret.Data[name]; !hasToken {
+			return nil, fmt.Errorf("%s configures client %q, which has no token", key, name)
+		}
+		var namespaces []string
+		for _, namespace := range strings.Split(string(value), ",") {
+			if namespace = strings.TrimSpace(namespace); namespace != "" {
+				namespaces = append(namespaces, namespace)
+			}
+		}
+		if len(namespaces) == 0 {
+			return nil, fmt.Errorf("%s lists no namespaces", key)
+		}
+		scopes[name] = namespaces
+	}
+	return scopes, nil
+}
+
+// ServiceAccountUser returns the username of the ServiceAccount "namespace/name"
+func ServiceAccountUser(ref string) (string, error) {
+	namespace, name, ok := strings.Cut(ref, "/")
+	if !ok || namespace == "" || name == "" || strings.Contains(name, "/") {
+		return "", fmt.Errorf("ServiceAccount %q is not in namespace/name format", ref)
+	}
+	return serviceAccountUserPrefix + namespace + ":" + name, nil
+}
+
+func isSetting(key string) bool {
+	for _, suffix := range settingSuffixes {
+		if strings.HasSuffix(key, suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+// NeedLeaderElection lets every replica serve the API
+func (s *Server) NeedLeaderElection() bool {
+	return false
+}
+
+// Start serves the API until ctx is cancelled
+func (s *Server) Start(ctx context.Context) error {
+	log := ctrl.Log.WithName("apiserver")
+	srv := &http.Server{
+		Addr:              s.Addr,
+		Handler:           s.Handler(),
+		ReadHeaderTimeout: 10 * time.Second,
+		// End event streams when the manager stops instead of waiting for clients to disconnect
+		BaseContext: func(net.Listener) context.Context { return ctx },
+	}
+
+	errCh := make(chan error, 1)
+	go func() {
+		log.Info("Serving chaos API", "addr", s.Addr, "tls", s.CertDir != "")
+		var err error
+		if s.CertDir != "" {
+			err = srv.ListenAndServeTLS(filepath.Join(s.CertDir, "tls.crt"), filepath.Join(s.CertDir, "tls.key"))
+		} else {
+			err = srv.ListenAndServe()
+		}
+		if !errors.Is(err, http.ErrServerClosed) {
+			errCh <- err
+		}
+		close(errCh)
+	}()
+
+	select {
+	case err := <-errCh:
+		return err
+	case <-ctx.Done():
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		return srv.Shutdown(shutdownCtx)
+	}
+}
+
+// Handler returns the API routes, all but /healthz, the OpenAPI document and the Slack command
+// (which checks Slack's signature) behind token authentication
+func (s *Server) Handler() http.Handler {
+	api := http.NewServeMux()
+	api.HandleFunc("GET /api/v1/experiments", s.listExperiments)
+	api.HandleFunc("GET /api/v1/namespaces/{namespace}/experiments", s.listExperiments)
+	api.HandleFunc("POST /api/v1/namespaces/{namespace}/experiments", s.createExperiment)
+	api.HandleFunc("GET /api/v1/namespaces/{namespace}/experiments/{name}", s.getExperiment)
+	api.HandleFunc("PUT /api/v1/namespaces/{namespace}/experiments/{name}", s.updateExperiment)
+	api.HandleFunc("DELETE /api/v1/namespaces/{namespace}/experiments/{name}", s.deleteExperiment)
+	api.HandleFunc("POST /api/v1/namespaces/{namespace}/experiments/{name}/pause", s.setPaused(true))
+	api.HandleFunc("POST /api/v1/namespaces/{namespace}/experiments/{name}/resume", s.setPaused(false))
+	api.HandleFunc("POST /api/v1/namespaces/{namespace}/experiments/{name}/abort", s.abortExperiment)
+	api.HandleFunc("GET /api/v1/namespaces/{namespace}/experiments/{name}/history", s.experimentHistory)
+	api.HandleFunc("GET /api/v1/namespaces/{namespace}/experiments/{name}/events", s.streamEvents)
+	api.HandleFunc("GET /api/v1/history", s.listHistory)
+	api.HandleFunc("GET /api/v1/events", s.streamEvents)
+	api.HandleFunc("GET /api/v1/capabilities", s.capabilities)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /healthz", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	mux.HandleFunc("GET /api/v1/openapi.json", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(openapi.Spec)
+	})
+	mux.Handle("/api/", s.authenticate(api))
+	if s.Dashboard != nil {
+		s.registerDashboard(mux)
+	}
+	if s.Slack != nil {
+		mux.HandleFunc("POST "+SlackCommandPath, s.slackCommand)
+	}
+	return mux
+}
+
+type contextKey struct{}
+
+// authenticate accepts requests carrying one of the configured bearer tokens or, with the
+// dashboard enabled, a dashboard session cookie
+func (s *Server) authenticate(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		clientName := ""
+		if ok {
+			for known, name := range s.Tokens {
+				if subtle.ConstantTimeCompare([]byte(token), []byte(known)) == 1 {
+					clientName = name
+				}
+			}
+		} else if user := s.sessionUser(r); user != "" {
+			// Browsers attach cookies to cross-site requests; require a header a plain form cannot send
+			if r.Method != http.MethodGet && r.Header.Get(csrfHeader) == "" {
+				writeError(w, http.StatusForbidden, fmt.Errorf("missing %s header", csrfHeader))
+				return
+			}
+			clientName = "dashboard:" + user
+		}
+		if clientName == "" {
+			w.Header().Set("WWW-Authenticate", `Bearer realm="k8s-chaos"`)
+			writeError(w, http.StatusUnauthorized, errors.New("missing or invalid bearer token"))
+			return
+		}
+		next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), contextKey{}, clientName)))
+	})
+}
+
+// clientName returns the authenticated API client of a request
+func clientName(r *http.Request) string {
+	name, _ := r.Context().Value(contextKey{}).(string)
+	return name
+}
+
+// writer returns the client experiments are written with for the API client of a request
+func (s *Server) writer(r *http.Request) client.Client {
+	if c, ok := s.Writers[clientName(r)]; ok {
+		return c
+	}
+	return s.Client
+}
+
+// allowed reports whether the API client of a request may act in namespace; an empty namespace
+// stands for all of them
+func (s *Server) allowed(r *http.Request, namespace string) bool {
+	namespaces, limited := s.Namespaces[clientName(r)]
+	return !limited || namespace != "" && slices.Contains(namespaces, namespace)
+}
+
+// authorize writes 403 Forbidden unless the API client of a request may act in every namespace
+func (s *Server) authorize(w http.ResponseWriter, r *http.Request, namespaces ...string) bool {
+	for _, namespace := range namespaces {
+		if !s.allowed(r, namespace) {
+			writeError(w, http.StatusForbidden, fmt.Errorf("client %q may not access namespace %q", clientName(r), namespace))
+			return false
+		}
+	}
+	return true
+}
+
+// specNamespaces returns the namespaces an experiment spec injects into
+func specNamespaces(spec *chaosv1alpha1.ChaosExperimentSpec) []string {
+	return append([]string{spec.Namespace}, spec.PeerNamespaces...)
+}
+
+func (s *Server) listExperiments(w http.ResponseWriter, r *http.Request) {
+	namespace := r.PathValue("namespace")
+	if namespace == "" {
+		namespace = r.URL.Query().Get("namespace")
+	}
+	if namespace != "" && !s.authorize(w, r, namespace) {
+		return
+	}
+	list := &chaosv1alpha1.ChaosExperimentList{}
+	if err := s.Client.List(r.Context(), list, client.InNamespace(namespace)); err != nil {
+		writeK8sError(w, err)
+		return
+	}
+	list.Items = slices.DeleteFunc(list.Items, func(exp chaosv1alpha1.ChaosExperiment) bool {
+		return !s.allowed(r, exp.Namespace)
+	})
+	writeJSON(w, http.StatusOK, list)
+}
+
+func (s *Server) getExperiment(w http.ResponseWriter, r *http.Request) {
+	if !s.authorize(w, r, r.PathValue("namespace")) {
+		return
+	}
+	exp := &chaosv1alpha1.ChaosExperiment{}
+	if err := s.Client.Get(r.Context(), experimentKey(r), exp); err != nil {
+		writeK8sError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, exp)
+}
+
+func (s *Server) createExperiment(w http.ResponseWriter, r *http.Request) {
+	exp := &chaosv1alpha1.ChaosExperiment{}
+	if err := decodeBody(w, r, exp); err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	namespace := r.PathValue("namespace")
+	if exp.Namespace != "" && exp.Namespace != namespace {
+		writeError(w, http.StatusBadRequest, fmt.Errorf("metadata.namespace %q does not match the request path", exp.Namespace))
+		return
+	}
+	if !s.authorize(w, r, append([]string{namespace}, specNamespaces(&exp.Spec)...)...) {
+		return
+	}
+	exp.Namespace = namespace
+	exp.ResourceVersion = ""
+	if exp.Annotations == nil {
+		exp.Annotations = map[string]string{}
+	}
+	exp.Annotations[chaosv1alpha1.UserAgentAnnotation] = UserAgent + ":" + clientName(r)
+
+	if err := s.writer(r).Create(r.Context(), exp); err != nil {
+		writeK8sError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusCreated, exp)
+}
+
+// updateExperiment replaces the spec of an experiment; metadata and status are left untouched
+func (s *Server) updateExperiment(w http.ResponseWriter, r *http.Request) {
+	update := &chaosv1alpha1.ChaosExperiment{}
+	if err := decodeBody(w, r, update); err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	if !s.authorize(w, r, append([]string{r.PathValue("namespace")}, specNamespaces(&update.Spec)...)...) {
+		return
+	}
+	exp := &chaosv1alpha1.ChaosExperiment{}
+	if err := s.Client.Get(r.Context(), experimentKey(r), exp); err != nil {
+		writeK8sError(w, err)
+		return
+	}
+	// Honour optimistic concurrency when the caller sends the version it read
+	if update.ResourceVersion != "" {
+		exp.ResourceVersion = update.ResourceVersion
+	}
+	exp.Spec = update.Spec
+	if err := s.writer(r).Update(r.Context(), exp); err != nil {
+		writeK8sError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, exp)
+}
+
+func (s *Server) deleteExperiment(w http.ResponseWriter, r *http.Request) {
+	if !s.authorize(w, r, r.PathValue("namespace")) {
+		return
+	}
+	exp := &chaosv1alpha1.ChaosExperiment{}
+	if err := s.Client.Get(r.Context(), experimentKey(r), exp); err != nil {
+		writeK8sError(w, err)
+		return
+	}
+	if err := s.writer(r).Delete(r.Context(), exp); err != nil {
+		writeK8sError(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// abortExperiment marks the experiment for abort; the controller reverts it on its next reconcile
+func (s *Server) abortExperiment(w http.ResponseWriter, r *http.Request) {
+	if !s.authorize(w, r, r.PathValue("namespace")) {
+		return
+	}
+	exp := &chaosv1alpha1.ChaosExperiment{}
+	if err := s.Client.Get(r.Context(), experimentKey(r), exp); err != nil {
+		writeK8sError(w, err)
+		return
+	}
+	patch := client.MergeFrom(exp.DeepCopy())
+	if exp.Annotations == nil {
+		exp.Annotations = map[string]string{}
+	}
+	exp.Annotations[chaosv1alpha1.AbortAnnotation] = UserAgent + ":" + clientName(r)
+	if err := s.writer(r).Patch(r.Context(), exp, patch); err != nil {
+		writeK8sError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusAccepted, exp)
+}
+
+// setPaused returns a handler that pauses or resumes an experiment through spec.paused
+func (s *Server) setPaused(paused bool) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if !s.authorize(w, r, r.PathValue("namespace")) {
+			return
+		}
+		exp := &chaosv1alpha1.ChaosExperiment{}
+		if err := s.Client.Get(r.Context(), experimentKey(r), exp); err != nil {
+			writeK8sError(w, err)
+			return
+		}
+		patch := client.MergeFrom(exp.DeepCopy())
+		exp.Spec.Paused = paused
+		if err := s.writer(r).Patch(r.Context(), exp, patch); err != nil {
+			writeK8sError(w, err)
+			return
+		}
+		writeJSON(w, http.StatusOK, exp)
+	}
+}
+
+func (s *Server) experimentHistory(w http.ResponseWriter, r *http.Request) {
+	if !s.authorize(w, r, r.PathValue("namespace")) {
+		return
+	}
+	query := r.URL.Query()
+	query.Set("experiment", r.PathValue("name"))
+	s.writeHistory(w, r, query)
+}
+
+func (s *Server) listHistory(w http.ResponseWriter, r *http.Request) {
+	s.writeHistory(w, r, r.URL.Query())
+}
+
+// historyFilters maps query parameters to the labels the controller puts on history records
+var historyFilters = map[string]string{
+	"experiment": "chaos.gushchin.dev/experiment",
+	"action":     "chaos.gushchin.dev/action",
+	"namespace":  "chaos.gushchin.dev/target-namespace",
+	"status":     "chaos.gushchin.dev/status",
+}
+
+// writeHistory lists history records matching the query filters, newest first, up to ?limit. Records
+// of experiments in namespaces the client may not access are left out.
+func (s *Server) writeHistory(w http.ResponseWriter, r *http.Request, query map[string][]string) {
+	labels := client.MatchingLabels{}
+	for param, label := range historyFilters {
+		if values := query[param]; len(values) > 0 && values[0] != "" {
+			labels[label] = values[0]
+		}
+	}
+	limit := 0
+	if values := query["limit"]; len(values) > 0 {
+		parsed, err := strconv.Atoi(values[0])
+		if err != nil || parsed < 0 {
+			writeError(w, http.StatusBadRequest, fmt.Errorf("invalid limit %q", values[0]))
+			return
+		}
+		limit = parsed
+	}
+
+	list := &chaosv1alpha1.ChaosExperimentHistoryList{}
+	if err := s.Client.List(r.Context(), list, client.InNamespace(s.HistoryNamespace), labels); err != nil {
+		writeK8sError(w, err)
+		return
+	}
+	list.Items = slices.DeleteFunc(list.Items, func(record chaosv1alpha1.ChaosExperimentHistory) bool {
+		return !s.allowed(r, record.Spec.ExperimentRef.Namespace) || !s.allowed(r, record.Spec.ExperimentSpec.Namespace)
+	})
+	sort.SliceStable(list.Items, func(i, j int) bool {
+		return list.Items[j].Spec.Execution.StartTime.Before(&list.Items[i].Spec.Execution.StartTime)
+	})
+	if limit > 0 && len(list.Items) > limit {
+		list.Items = list.Items[:limit]
+	}
+	writeJSON(w, http.StatusOK, list)
+}
+
+// capabilities describes every supported action and whether the controller can run it, checking
+// the Pod Security level of ?namespace when given
+func (s *Server) capabilities(w http.ResponseWriter, r *http.Request) {
+	actions, err := capabilities.Describe(s.Actions)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	report := capabilities.Report{Namespace: r.URL.Query().Get("namespace"), Actions: actions}
+	if report.Namespace != "" {
+		if !s.authorize(w, r, report.Namespace) {
+			return
+		}
+		ns := &corev1.Namespace{}
+		if err := s.Client.Get(r.Context(), client.ObjectKey{Name: report.Namespace}, ns); err != nil {
+			writeK8sError(w, err)
+			return
+		}
+		report.PodSecurityLevel = capabilities.PodSecurityLevel(ns)
+	}
+	var rbac *preflight.Result
+	if s.RBAC != nil {
+		rbac = s.RBAC()
+	}
+	report.RBACChecked = rbac != nil
+	capabilities.Evaluate(report.Actions, rbac, report.Namespace, report.PodSecurityLevel)
+	writeJSON(w, http.StatusOK, report)
+}
+
+func experimentKey(r *http.Request) client.ObjectKey {
+	return client.ObjectKey{Namespace: r.PathValue("namespace"), Name: r.PathValue("name")}
+}
+
+func decodeBody(w http.ResponseWriter, r *http.Request, into any) error {
+	decoder := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxBodyBytes))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(into); err != nil {
+		return fmt.Errorf("invalid request body: %w", err)
+	}
+	return nil
+}
+
+// writeK8sError translates a Kubernetes API error into the matching HTTP status
+func writeK8sError(w http.ResponseWriter, err error) {
+	var status apierrors.APIStatus
+	if errors.As(err, &status) && status.Status().Code != 0 {
+		writeError(w, int(status.Status().Code), err)
+		return
+	}
+	writeError(w, http.StatusInternalServerError, err)
+}
+
+func writeError(w http.ResponseWriter, code int, err error) {
+	writeJSON(w, code, map[string]any{"code": code, "error": err.Error()})
+}
+
+func writeJSON(w http.ResponseWriter, code int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(body)
+}
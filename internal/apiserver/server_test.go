@@ -0,0 +1,423 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apiserver
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	chaosv1alpha1 "github.com/neogan74/k8s-chaos/api/v1alpha1"
+)
+
+const testToken = "s3cret"
+
+func newTestServer(t *testing.T, objs ...client.Object) (*httptest.Server, client.Client) {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	if err := chaosv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	cl := fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).Build()
+	s := &Server{
+		Client:           cl,
+		Tokens:           map[string]string{testToken: "portal"},
+		HistoryNamespace: "chaos-system",
+	}
+	server := httptest.NewServer(s.Handler())
+	t.Cleanup(server.Close)
+	return server, cl
+}
+
+func do(t *testing.T, server *httptest.Server, method, path, body string) (int, string) {
+	t.Helper()
+	req, err := http.NewRequest(method, server.URL+path, strings.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("Authorization", "Bearer "+testToken)
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+	data, _ := io.ReadAll(resp.Body)
+	return resp.StatusCode, string(data)
+}
+
+func testExperiment(name string) *chaosv1alpha1.ChaosExperiment {
+	return &chaosv1alpha1.ChaosExperiment{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "team-a"},
+		Spec: chaosv1alpha1.ChaosExperimentSpec{
+			Action:    "pod-kill",
+			Namespace: "team-a",
+			Selector:  map[string]string{"app": "web"},
+			Count:     1,
+		},
+	}
+}
+
+func TestAuthentication(t *testing.T) {
+	server, _ := newTestServer(t)
+
+	resp, err := http.Get(server.URL + "/api/v1/experiments")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_ = resp.Body.Close()
+	if resp.StatusCode != http.StatusUnauthorized {
+		t.Errorf("request without token: got %d, want 401", resp.StatusCode)
+	}
+
+	req, _ := http.NewRequest(http.MethodGet, server.URL+"/api/v1/experiments", nil)
+	req.Header.Set("Authorization", "Bearer wrong")
+	resp, err = http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_ = resp.Body.Close()
+	if resp.StatusCode != http.StatusUnauthorized {
+		t.Errorf("request with wrong token: got %d, want 401", resp.StatusCode)
+	}
+
+	resp, err = http.Get(server.URL + "/healthz")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_ = resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("healthz: got %d, want 200", resp.StatusCode)
+	}
+}
+
+func TestOpenAPIDocumentIsPublic(t *testing.T) {
+	server, _ := newTestServer(t)
+
+	resp, err := http.Get(server.URL + "/api/v1/openapi.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("got %d, want 200", resp.StatusCode)
+	}
+	var doc struct {
+		OpenAPI string `json:"openapi"`
+		Paths   map[string]any
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&doc); err != nil {
+		t.Fatal(err)
+	}
+	if doc.OpenAPI == "" || doc.Paths["/api/v1/namespaces/{namespace}/experiments/{name}"] == nil {
+		t.Errorf("unexpected OpenAPI document: %+v", doc)
+	}
+}
+
+func TestExperimentCRUD(t *testing.T) {
+	server, cl := newTestServer(t)
+	ctx := context.Background()
+
+	body, _ := json.Marshal(testExperiment("web-kill"))
+	if code, out := do(t, server, http.MethodPost, "/api/v1/namespaces/team-a/experiments", string(body)); code != http.StatusCreated {
+		t.Fatalf("create: got %d: %s", code, out)
+	}
+	created := &chaosv1alpha1.ChaosExperiment{}
+	if err := cl.Get(ctx, client.ObjectKey{Namespace: "team-a", Name: "web-kill"}, created); err != nil {
+		t.Fatal(err)
+	}
+	if got := created.Annotations[chaosv1alpha1.UserAgentAnnotation]; got != "k8s-chaos-api:portal" {
+		t.Errorf("user agent annotation = %q", got)
+	}
+
+	code, out := do(t, server, http.MethodGet, "/api/v1/namespaces/team-a/experiments/web-kill", "")
+	if code != http.StatusOK || !strings.Contains(out, `"action":"pod-kill"`) {
+		t.Errorf("get: got %d: %s", code, out)
+	}
+
+	update := testExperiment("web-kill")
+	update.Spec.Count = 3
+	body, _ = json.Marshal(update)
+	if code, out := do(t, server, http.MethodPut, "/api/v1/namespaces/team-a/experiments/web-kill", string(body)); code != http.StatusOK {
+		t.Fatalf("update: got %d: %s", code, out)
+	}
+	if err := cl.Get(ctx, client.ObjectKey{Namespace: "team-a", Name: "web-kill"}, created); err != nil {
+		t.Fatal(err)
+	}
+	if created.Spec.Count != 3 {
+		t.Errorf("count after update = %d, want 3", created.Spec.Count)
+	}
+
+	if code, out := do(t, server, http.MethodPost, "/api/v1/namespaces/team-a/experiments/web-kill/abort", ""); code != http.StatusAccepted {
+		t.Fatalf("abort: got %d: %s", code, out)
+	}
+	if err := cl.Get(ctx, client.ObjectKey{Namespace: "team-a", Name: "web-kill"}, created); err != nil {
+		t.Fatal(err)
+	}
+	if got := created.Annotations[chaosv1alpha1.AbortAnnotation]; got != "k8s-chaos-api:portal" {
+		t.Errorf("abort annotation = %q", got)
+	}
+
+	if code, out := do(t, server, http.MethodDelete, "/api/v1/namespaces/team-a/experiments/web-kill", ""); code != http.StatusNoContent {
+		t.Fatalf("delete: got %d: %s", code, out)
+	}
+	if code, _ := do(t, server, http.MethodGet, "/api/v1/namespaces/team-a/experiments/web-kill", ""); code != http.StatusNotFound {
+		t.Errorf("get after delete: got %d, want 404", code)
+	}
+}
+
+func TestCreateRejectsMismatchedNamespace(t *testing.T) {
+	server, _ := newTestServer(t)
+	exp := testExperiment("web-kill")
+	exp.Namespace = "team-b"
+	body, _ := json.Marshal(exp)
+	if code, _ := do(t, server, http.MethodPost, "/api/v1/namespaces/team-a/experiments", string(body)); code != http.StatusBadRequest {
+		t.Errorf("got %d, want 400", code)
+	}
+}
+
+func TestHistoryQueries(t *testing.T) {
+	record := func(name, experiment, status string, started time.Time) *chaosv1alpha1.ChaosExperimentHistory {
+		return &chaosv1alpha1.ChaosExperimentHistory{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "chaos-system",
+				Labels: map[string]string{
+					"chaos.gushchin.dev/experiment": experiment,
+					"chaos.gushchin.dev/status":     status,
+				},
+			},
+			Spec: chaosv1alpha1.ChaosExperimentHistorySpec{
+				Execution: chaosv1alpha1.ExecutionDetails{StartTime: metav1.NewTime(started), Status: status},
+			},
+		}
+	}
+	now := time.Now()
+	server, _ := newTestServer(t,
+		record("web-kill-1", "web-kill", "success", now.Add(-2*time.Hour)),
+		record("web-kill-2", "web-kill", "failure", now.Add(-time.Hour)),
+		record("db-kill-1", "db-kill", "success", now),
+	)
+
+	names := func(out string) []string {
+		list := &chaosv1alpha1.ChaosExperimentHistoryList{}
+		if err := json.Unmarshal([]byte(out), list); err != nil {
+			t.Fatal(err)
+		}
+		var result []string
+		for _, item := range list.Items {
+			result = append(result, item.Name)
+		}
+		return result
+	}
+
+	_, out := do(t, server, http.MethodGet, "/api/v1/namespaces/team-a/experiments/web-kill/history", "")
+	if got := strings.Join(names(out), ","); got != "web-kill-2,web-kill-1" {
+		t.Errorf("experiment history = %s", got)
+	}
+	_, out = do(t, server, http.MethodGet, "/api/v1/history?status=success&limit=1", "")
+	if got := strings.Join(names(out), ","); got != "db-kill-1" {
+		t.Errorf("filtered history = %s", got)
+	}
+	if code, _ := do(t, server, http.MethodGet, "/api/v1/history?limit=-1", ""); code != http.StatusBadRequest {
+		t.Errorf("invalid limit: got %d, want 400", code)
+	}
+}
+
+func TestTokensFromSecret(t *testing.T) {
+	secret := &corev1.Secret{Data: map[string][]byte{"portal": []byte("abc\n"), "ci": []byte("def")}}
+	tokens, err := TokensFromSecret(secret)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tokens["abc"] != "portal" || tokens["def"] != "ci" {
+		t.Errorf("tokens = %v", tokens)
+	}
+	if _, err := TokensFromSecret(&corev1.Secret{}); err == nil {
+		t.Error("expected an error for a secret without tokens")
+	}
+}
+
+func TestServiceAccountsFromSecret(t *testing.T) {
+	secret := &corev1.Secret{Data: map[string][]byte{
+		"portal":                 []byte("abc"),
+		"portal.service-account": []byte("team-a/portal\n"),
+		"ci":                     []byte("def"),
+	}}
+	tokens, err := TokensFromSecret(secret)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tokens) != 2 {
+		t.Errorf("tokens = %v, want the settings left out", tokens)
+	}
+	accounts, err := ServiceAccountsFromSecret(secret)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(accounts) != 1 || accounts["portal"] != "system:serviceaccount:team-a:portal" {
+		t.Errorf("accounts = %v", accounts)
+	}
+
+	for key, value := range map[string]string{"ghost.service-account": "team-a/ghost", "ci.service-account": "ci"} {
+		invalid := &corev1.Secret{Data: map[string][]byte{"ci": []byte("def"), key: []byte(value)}}
+		if _, err := ServiceAccountsFromSecret(invalid); err == nil {
+			t.Errorf("expected %s=%s to be rejected", key, value)
+		}
+	}
+}
+
+func TestWritesUseClientServiceAccount(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := chaosv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	cl := fake.NewClientBuilder().WithScheme(scheme).Build()
+	var writes []string
+	s := &Server{
+		Client: cl,
+		Tokens: map[string]string{testToken: "portal"},
+		Writers: map[string]client.Client{"portal": interceptor.NewClient(cl, interceptor.Funcs{
+			Create: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.CreateOption) error {
+				writes = append(writes, "create")
+				return c.Create(ctx, obj, opts...)
+			},
+			Patch: func(ctx context.Context, c client.WithWatch, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+				writes = append(writes, "patch")
+				return c.Patch(ctx, obj, patch, opts...)
+			},
+			Delete: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.DeleteOption) error {
+				writes = append(writes, "delete")
+				return c.Delete(ctx, obj, opts...)
+			},
+		})},
+	}
+	server := httptest.NewServer(s.Handler())
+	t.Cleanup(server.Close)
+
+	body, _ := json.Marshal(testExperiment("web-kill"))
+	for _, call := range []struct{ method, path, body string }{
+		{http.MethodPost, "/api/v1/namespaces/team-a/experiments", string(body)},
+		{http.MethodPost, "/api/v1/namespaces/team-a/experiments/web-kill/abort", ""},
+		{http.MethodDelete, "/api/v1/namespaces/team-a/experiments/web-kill", ""},
+	} {
+		if code, out := do(t, server, call.method, call.path, call.body); code >= 300 {
+			t.Fatalf("%s %s: got %d: %s", call.method, call.path, code, out)
+		}
+	}
+	if strings.Join(writes, ",") != "create,patch,delete" {
+		t.Errorf("writes through the client's ServiceAccount = %v", writes)
+	}
+}
+
+func TestNamespacesFromSecret(t *testing.T) {
+	secret := &corev1.Secret{Data: map[string][]byte{
+		"portal":            []byte("abc"),
+		"portal.namespaces": []byte("team-a, team-b,"),
+		"ci":                []byte("def"),
+	}}
+	tokens, err := TokensFromSecret(secret)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tokens) != 2 {
+		t.Errorf("tokens = %v, want the settings left out", tokens)
+	}
+	scopes, err := NamespacesFromSecret(secret)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(scopes) != 1 || strings.Join(scopes["portal"], ",") != "team-a,team-b" {
+		t.Errorf("namespaces = %v", scopes)
+	}
+
+	for key, value := range map[string]string{"ghost.namespaces": "team-a", "ci.namespaces": " , "} {
+		invalid := &corev1.Secret{Data: map[string][]byte{"ci": []byte("def"), key: []byte(value)}}
+		if _, err := NamespacesFromSecret(invalid); err == nil {
+			t.Errorf("expected %s=%s to be rejected", key, value)
+		}
+	}
+}
+
+func TestNamespaceScopedToken(t *testing.T) {
+	other := testExperiment("other-kill")
+	other.Namespace, other.Spec.Namespace = "team-b", "team-b"
+	history := &chaosv1alpha1.ChaosExperimentHistory{
+		ObjectMeta: metav1.ObjectMeta{Name: "other-kill-1", Namespace: "chaos-system"},
+		Spec: chaosv1alpha1.ChaosExperimentHistorySpec{
+			ExperimentRef:  chaosv1alpha1.ObjectReference{Name: "other-kill", Namespace: "team-b"},
+			ExperimentSpec: other.Spec,
+		},
+	}
+	scheme := runtime.NewScheme()
+	if err := chaosv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	cl := fake.NewClientBuilder().WithScheme(scheme).WithObjects(testExperiment("web-kill"), other, history).Build()
+	s := &Server{
+		Client:           cl,
+		Tokens:           map[string]string{testToken: "portal"},
+		Namespaces:       map[string][]string{"portal": {"team-a"}},
+		HistoryNamespace: "chaos-system",
+	}
+	server := httptest.NewServer(s.Handler())
+	t.Cleanup(server.Close)
+
+	code, out := do(t, server, http.MethodGet, "/api/v1/experiments", "")
+	if code != http.StatusOK || !strings.Contains(out, "web-kill") || strings.Contains(out, "other-kill") {
+		t.Errorf("listing all namespaces: got %d: %s, want team-a only", code, out)
+	}
+	code, out = do(t, server, http.MethodGet, "/api/v1/history", "")
+	if code != http.StatusOK || strings.Contains(out, "other-kill") {
+		t.Errorf("history: got %d: %s, want team-b records left out", code, out)
+	}
+
+	// Injecting into another namespace is refused even from an allowed one
+	crossing := testExperiment("crossing")
+	crossing.Spec.Namespace = "team-b"
+	body, _ := json.Marshal(crossing)
+	for _, call := range []struct{ method, path, body string }{
+		{http.MethodGet, "/api/v1/experiments?namespace=team-b", ""},
+		{http.MethodGet, "/api/v1/namespaces/team-b/experiments/other-kill", ""},
+		{http.MethodPost, "/api/v1/namespaces/team-a/experiments", string(body)},
+		{http.MethodPut, "/api/v1/namespaces/team-a/experiments/web-kill", string(body)},
+		{http.MethodPost, "/api/v1/namespaces/team-b/experiments/other-kill/pause", ""},
+		{http.MethodPost, "/api/v1/namespaces/team-b/experiments/other-kill/abort", ""},
+		{http.MethodDelete, "/api/v1/namespaces/team-b/experiments/other-kill", ""},
+		{http.MethodGet, "/api/v1/namespaces/team-b/experiments/other-kill/history", ""},
+		{http.MethodGet, "/api/v1/capabilities?namespace=team-b", ""},
+	} {
+		if code, out := do(t, server, call.method, call.path, call.body); code != http.StatusForbidden {
+			t.Errorf("%s %s: got %d: %s, want 403", call.method, call.path, code, out)
+		}
+	}
+
+	if code, out := do(t, server, http.MethodPost, "/api/v1/namespaces/team-a/experiments/web-kill/pause", ""); code != http.StatusOK {
+		t.Errorf("pausing in an allowed namespace: got %d: %s", code, out)
+	}
+}
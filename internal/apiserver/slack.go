@@ -0,0 +1,279 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apiserver
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	chaosv1alpha1 "github.com/neogan74/k8s-chaos/api/v1alpha1"
+)
+
+const (
+	// SlackUserAgent is recorded in the user-agent annotation of experiments started from Slack,
+	// followed by the Slack user who ran the command
+	SlackUserAgent = "k8s-chaos-slack"
+
+	// SlackCommandPath receives the slash command; it is authenticated by Slack's request signature
+	SlackCommandPath = "/slack/command"
+
+	// slackMaxSkew is how old a signed request may be before it is refused as a replay
+	slackMaxSkew = 5 * time.Minute
+)
+
+// SlackConfig lets a Slack slash command (e.g. /chaos) start pre-approved experiment templates
+type SlackConfig struct {
+	// SigningSecret verifies that requests come from the Slack app
+	SigningSecret []byte
+	// Templates is the ConfigMap holding the templates: every key is a template name and its
+	// value a ChaosExperiment manifest. Experiments are created in the manifest's namespace, or
+	// the ConfigMap's when it has none.
+	Templates client.ObjectKey
+	// Reader reads the templates ConfigMap, typically from the API server rather than the cache
+	Reader client.Reader
+	// DashboardURL is the dashboard's external base URL; replies link to the experiment there when set
+	DashboardURL string
+	// ServiceAccount is the username of the ServiceAccount experiments are created as, from the
+	// optional "service-account" key ("namespace/name"); empty creates them as the controller
+	ServiceAccount string
+	// Writer creates the experiments, impersonating ServiceAccount; the server's client when nil
+	Writer client.Client
+}
+
+// SlackConfigFromSecret reads the Slack app's signing secret from the "signing-secret" key and
+// the ServiceAccount experiments are created as from "service-account"
+func SlackConfigFromSecret(secret *corev1.Secret, templates string, reader client.Reader, dashboardURL string) (*SlackConfig, error) {
+	signingSecret := strings.TrimSpace(string(secret.Data["signing-secret"]))
+	if signingSecret == "" {
+		return nil, fmt.Errorf("secret %s/%s must contain signing-secret", secret.Namespace, secret.Name)
+	}
+	namespace, name, ok := strings.Cut(templates, "/")
+	if !ok || namespace == "" || name == "" {
+		return nil, fmt.Errorf("templates ConfigMap %q must be namespace/name", templates)
+	}
+	var serviceAccount string
+	if ref := strings.TrimSpace(string(secret.Data["service-account"])); ref != "" {
+		user, err := ServiceAccountUser(ref)
+		if err != nil {
+			return nil, fmt.Errorf("secret %s/%s: %w", secret.Namespace, secret.Name, err)
+		}
+		serviceAccount = user
+	}
+	return &SlackConfig{
+		SigningSecret:  []byte(signingSecret),
+		Templates:      client.ObjectKey{Namespace: namespace, Name: name},
+		Reader:         reader,
+		DashboardURL:   strings.TrimSuffix(dashboardURL, "/"),
+		ServiceAccount: serviceAccount,
+	}, nil
+}
+
+// slackReply is the response to a slash command; ephemeral replies are only shown to the caller
+type slackReply struct {
+	ResponseType string `json:"response_type"`
+	Text         string `json:"text"`
+}
+
+// verify checks the X-Slack-Signature of a request body, see
+// https://api.slack.com/authentication/verifying-requests-from-slack
+func (c *SlackConfig) verify(header http.Header, body []byte, now time.Time) error {
+	timestamp := header.Get("X-Slack-Request-Timestamp")
+	seconds, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return errors.New("missing or invalid X-Slack-Request-Timestamp header")
+	}
+	if skew := now.Sub(time.Unix(seconds, 0)); skew > slackMaxSkew || skew < -slackMaxSkew {
+		return errors.New("request timestamp is too far from the current time")
+	}
+	mac := hmac.New(sha256.New, c.SigningSecret)
+	_, _ = fmt.Fprintf(mac, "v0:%s:%s", timestamp, body)
+	want := "v0=" + hex.EncodeToString(mac.Sum(nil))
+	if !hmac.Equal([]byte(header.Get("X-Slack-Signature")), []byte(want)) {
+		return errors.New("invalid request signature")
+	}
+	return nil
+}
+
+// slackCommand handles "/chaos run <template>", "/chaos list" and "/chaos status <namespace>/<name>"
+func (s *Server) slackCommand(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxBodyBytes))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	if err := s.Slack.verify(r.Header, body, time.Now()); err != nil {
+		writeError(w, http.StatusUnauthorized, err)
+		return
+	}
+	form, err := url.ParseQuery(string(body))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	// Slack shows anything but a 200 as a delivery failure, so command errors are replies too
+	user := fmt.Sprintf("%s (%s/%s)", form.Get("user_name"), form.Get("team_id"), form.Get("user_id"))
+	verb, arg, _ := strings.Cut(strings.TrimSpace(form.Get("text")), " ")
+	arg = strings.TrimSpace(arg)
+	var reply slackReply
+	switch verb {
+	case "run":
+		reply, err = s.slackRun(r, arg, form.Get("user_id"), user)
+	case "list":
+		reply, err = s.slackList(r)
+	case "status":
+		reply, err = s.slackStatus(r, arg)
+	default:
+		command := form.Get("command")
+		reply = slackReply{Text: fmt.Sprintf("Usage: `%[1]s run <template>`, `%[1]s list` or `%[1]s status <namespace>/<name>`", command)}
+	}
+	if err != nil {
+		reply = slackReply{Text: "Error: " + err.Error()}
+	}
+	if reply.ResponseType == "" {
+		reply.ResponseType = "ephemeral"
+	}
+	writeJSON(w, http.StatusOK, reply)
+}
+
+// slackTemplates reads the pre-approved templates
+func (s *Server) slackTemplates(r *http.Request) (map[string]string, error) {
+	cm := &corev1.ConfigMap{}
+	if err := s.Slack.Reader.Get(r.Context(), s.Slack.Templates, cm); err != nil {
+		return nil, fmt.Errorf("failed to read templates ConfigMap %s: %w", s.Slack.Templates, err)
+	}
+	return cm.Data, nil
+}
+
+// slackRun creates an experiment from a template, recording the Slack user as its user agent
+func (s *Server) slackRun(r *http.Request, name, userID, user string) (slackReply, error) {
+	templates, err := s.slackTemplates(r)
+	if err != nil {
+		return slackReply{}, err
+	}
+	manifest, ok := templates[name]
+	if !ok {
+		return slackReply{}, fmt.Errorf("no template named %q; `list` shows the available ones", name)
+	}
+	exp := &chaosv1alpha1.ChaosExperiment{}
+	if err := yaml.UnmarshalStrict([]byte(manifest), exp); err != nil {
+		return slackReply{}, fmt.Errorf("template %q is not a valid ChaosExperiment: %w", name, err)
+	}
+
+	exp.ObjectMeta = templateObjectMeta(exp, name, s.Slack.Templates.Namespace)
+	exp.Annotations[chaosv1alpha1.UserAgentAnnotation] = SlackUserAgent + ":" + user
+	exp.Status = chaosv1alpha1.ChaosExperimentStatus{}
+	writer := s.Slack.Writer
+	if writer == nil {
+		writer = s.Client
+	}
+	if err := writer.Create(r.Context(), exp); err != nil {
+		return slackReply{}, err
+	}
+	ctrl.Log.WithName("apiserver").Info("Started experiment from Slack",
+		"template", name, "experiment", client.ObjectKeyFromObject(exp), "user", user)
+	return slackReply{
+		ResponseType: "in_channel",
+		Text:         fmt.Sprintf("<@%s> started template `%s` as %s", userID, name, s.slackLink(exp)),
+	}, nil
+}
+
+// templateObjectMeta keeps the namespace, labels and annotations of a template's metadata and
+// names the experiment after the template
+func templateObjectMeta(exp *chaosv1alpha1.ChaosExperiment, template, defaultNamespace string) metav1.ObjectMeta {
+	namespace := exp.Namespace
+	if namespace == "" {
+		namespace = defaultNamespace
+	}
+	annotations := map[string]string{}
+	for k, v := range exp.Annotations {
+		annotations[k] = v
+	}
+	return metav1.ObjectMeta{
+		GenerateName: template + "-",
+		Namespace:    namespace,
+		Labels:       exp.Labels,
+		Annotations:  annotations,
+	}
+}
+
+// slackList replies with the template names
+func (s *Server) slackList(r *http.Request) (slackReply, error) {
+	templates, err := s.slackTemplates(r)
+	if err != nil {
+		return slackReply{}, err
+	}
+	if len(templates) == 0 {
+		return slackReply{Text: "No templates are available."}, nil
+	}
+	names := make([]string, 0, len(templates))
+	for name := range templates {
+		names = append(names, "`"+name+"`")
+	}
+	sort.Strings(names)
+	return slackReply{Text: "Templates: " + strings.Join(names, ", ")}, nil
+}
+
+// slackStatus replies with the phase and message of an experiment
+func (s *Server) slackStatus(r *http.Request, ref string) (slackReply, error) {
+	namespace, name, ok := strings.Cut(ref, "/")
+	if !ok || namespace == "" || name == "" {
+		return slackReply{}, fmt.Errorf("name the experiment as <namespace>/<name>")
+	}
+	exp := &chaosv1alpha1.ChaosExperiment{}
+	if err := s.Client.Get(r.Context(), client.ObjectKey{Namespace: namespace, Name: name}, exp); err != nil {
+		if apierrors.IsNotFound(err) {
+			return slackReply{}, fmt.Errorf("experiment %s not found", ref)
+		}
+		return slackReply{}, err
+	}
+	phase := exp.Status.Phase
+	if phase == "" {
+		phase = "Pending"
+	}
+	text := fmt.Sprintf("%s is *%s*", s.slackLink(exp), phase)
+	if exp.Status.Message != "" {
+		text += ": " + exp.Status.Message
+	}
+	return slackReply{Text: text}, nil
+}
+
+// slackLink names an experiment, linking to it in the dashboard when its URL is known
+func (s *Server) slackLink(exp *chaosv1alpha1.ChaosExperiment) string {
+	key := exp.Namespace + "/" + exp.Name
+	if s.Slack.DashboardURL == "" {
+		return "`" + key + "`"
+	}
+	return fmt.Sprintf("<%s/ui/#%s|%s>", s.Slack.DashboardURL, url.PathEscape(key), key)
+}
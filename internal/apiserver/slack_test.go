@@ -0,0 +1,212 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apiserver
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	chaosv1alpha1 "github.com/neogan74/k8s-chaos/api/v1alpha1"
+)
+
+const testSigningSecret = "8f742231b10e8888abcd99yyyzzz85a5"
+
+func newSlackTestServer(t *testing.T, objs ...client.Object) (*httptest.Server, client.Client) {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	if err := chaosv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	if err := corev1.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	templates := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "chaos-templates", Namespace: "chaos-system"},
+		Data: map[string]string{
+			"pod-kill-payments": `apiVersion: chaos.gushchin.dev/v1alpha1
+kind: ChaosExperiment
+metadata:
+  namespace: payments
+  labels:
+    team: payments
+spec:
+  action: pod-kill
+  namespace: payments
+  selector:
+    app: api
+  count: 1
+`,
+			"broken": "spec:\n  action: pod-kill\n  bogus: true\n",
+		},
+	}
+	cl := fake.NewClientBuilder().WithScheme(scheme).WithObjects(append(objs, templates)...).Build()
+	s := &Server{
+		Client: cl,
+		Tokens: map[string]string{testToken: "portal"},
+		Slack: &SlackConfig{
+			SigningSecret: []byte(testSigningSecret),
+			Templates:     client.ObjectKey{Namespace: "chaos-system", Name: "chaos-templates"},
+			Reader:        cl,
+			DashboardURL:  "https://chaos.example.com",
+		},
+	}
+	server := httptest.NewServer(s.Handler())
+	t.Cleanup(server.Close)
+	return server, cl
+}
+
+// slackCommand sends a signed slash command and decodes the reply
+func slackCommand(t *testing.T, server *httptest.Server, text string, sign func(*http.Request, string)) (int, slackReply) {
+	t.Helper()
+	form := url.Values{
+		"command": {"/chaos"}, "text": {text},
+		"team_id": {"T0001"}, "user_id": {"U2147483697"}, "user_name": {"jane"},
+	}
+	body := form.Encode()
+	req, err := http.NewRequest(http.MethodPost, server.URL+SlackCommandPath, strings.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	sign(req, body)
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+	data, _ := io.ReadAll(resp.Body)
+	var reply slackReply
+	if resp.StatusCode == http.StatusOK {
+		if err := json.Unmarshal(data, &reply); err != nil {
+			t.Fatalf("invalid reply %s: %v", data, err)
+		}
+	}
+	return resp.StatusCode, reply
+}
+
+func signedAt(at time.Time, secret string) func(*http.Request, string) {
+	return func(req *http.Request, body string) {
+		timestamp := strconv.FormatInt(at.Unix(), 10)
+		mac := hmac.New(sha256.New, []byte(secret))
+		mac.Write([]byte("v0:" + timestamp + ":" + body))
+		req.Header.Set("X-Slack-Request-Timestamp", timestamp)
+		req.Header.Set("X-Slack-Signature", "v0="+hex.EncodeToString(mac.Sum(nil)))
+	}
+}
+
+func TestSlackCommandSignature(t *testing.T) {
+	server, _ := newSlackTestServer(t)
+
+	tests := []struct {
+		name string
+		sign func(*http.Request, string)
+		want int
+	}{
+		{name: "valid signature", sign: signedAt(time.Now(), testSigningSecret), want: http.StatusOK},
+		{name: "wrong secret", sign: signedAt(time.Now(), "other"), want: http.StatusUnauthorized},
+		{name: "replayed request", sign: signedAt(time.Now().Add(-10*time.Minute), testSigningSecret), want: http.StatusUnauthorized},
+		{name: "unsigned", sign: func(*http.Request, string) {}, want: http.StatusUnauthorized},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if code, _ := slackCommand(t, server, "list", tt.sign); code != tt.want {
+				t.Errorf("status = %d, want %d", code, tt.want)
+			}
+		})
+	}
+}
+
+func TestSlackCommandRun(t *testing.T) {
+	server, cl := newSlackTestServer(t)
+	sign := signedAt(time.Now(), testSigningSecret)
+
+	code, reply := slackCommand(t, server, "run pod-kill-payments", sign)
+	if code != http.StatusOK {
+		t.Fatalf("status = %d", code)
+	}
+	if reply.ResponseType != "in_channel" || !strings.HasPrefix(reply.Text, "<@U2147483697> started template `pod-kill-payments` as <https://chaos.example.com/ui/#payments%2Fpod-kill-payments-") {
+		t.Errorf("reply = %+v", reply)
+	}
+
+	list := &chaosv1alpha1.ChaosExperimentList{}
+	if err := cl.List(t.Context(), list, client.InNamespace("payments")); err != nil {
+		t.Fatal(err)
+	}
+	if len(list.Items) != 1 {
+		t.Fatalf("got %d experiments, want 1", len(list.Items))
+	}
+	exp := list.Items[0]
+	if !strings.HasPrefix(exp.Name, "pod-kill-payments-") || exp.Labels["team"] != "payments" || exp.Spec.Selector["app"] != "api" {
+		t.Errorf("experiment = %s %v %v", exp.Name, exp.Labels, exp.Spec.Selector)
+	}
+	if got := exp.Annotations[chaosv1alpha1.UserAgentAnnotation]; got != "k8s-chaos-slack:jane (T0001/U2147483697)" {
+		t.Errorf("user agent = %q", got)
+	}
+
+	code, reply = slackCommand(t, server, "status payments/"+exp.Name, sign)
+	if code != http.StatusOK || !strings.HasSuffix(reply.Text, "|payments/"+exp.Name+"> is *Pending*") {
+		t.Errorf("status reply = %d %+v", code, reply)
+	}
+
+	for text, want := range map[string]string{
+		"run unknown":            `Error: no template named "unknown"`,
+		"run broken":             `Error: template "broken" is not a valid ChaosExperiment`,
+		"status payments/absent": "Error: experiment payments/absent not found",
+		"list":                   "Templates: `broken`, `pod-kill-payments`",
+		"":                       "Usage: `/chaos run <template>`",
+	} {
+		_, reply := slackCommand(t, server, text, sign)
+		if reply.ResponseType != "ephemeral" || !strings.HasPrefix(reply.Text, want) {
+			t.Errorf("%q: reply = %+v, want prefix %q", text, reply, want)
+		}
+	}
+}
+
+func TestSlackConfigFromSecret_ServiceAccount(t *testing.T) {
+	secret := &corev1.Secret{Data: map[string][]byte{
+		"signing-secret":  []byte(testSigningSecret),
+		"service-account": []byte("chaos-system/slack"),
+	}}
+	config, err := SlackConfigFromSecret(secret, "chaos-system/chaos-templates", nil, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if config.ServiceAccount != "system:serviceaccount:chaos-system:slack" {
+		t.Errorf("ServiceAccount = %q", config.ServiceAccount)
+	}
+
+	secret.Data["service-account"] = []byte("slack")
+	if _, err := SlackConfigFromSecret(secret, "chaos-system/chaos-templates", nil, ""); err == nil {
+		t.Error("expected a ServiceAccount without namespace to be rejected")
+	}
+}
@@ -0,0 +1,296 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apiserver
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/watch"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	chaosv1alpha1 "github.com/neogan74/k8s-chaos/api/v1alpha1"
+)
+
+// Lifecycle event types derived from experiment status changes. Kubernetes events recorded on an
+// experiment are streamed with their reason as type.
+const (
+	EventCreated   = "Created"
+	EventStarted   = "Started"
+	EventInjected  = "Injected"
+	EventReverted  = "Reverted"
+	EventPaused    = "Paused"
+	EventResumed   = "Resumed"
+	EventCompleted = "Completed"
+	EventFailed    = "Failed"
+	EventDeleted   = "Deleted"
+)
+
+// keepaliveInterval keeps idle streams from being closed by proxies
+const keepaliveInterval = 30 * time.Second
+
+// ExperimentEvent is one message of the experiment event stream
+type ExperimentEvent struct {
+	// Type is a lifecycle event type or the reason of a Kubernetes event
+	Type       string    `json:"type"`
+	Namespace  string    `json:"namespace"`
+	Experiment string    `json:"experiment"`
+	Phase      string    `json:"phase,omitempty"`
+	Severity   string    `json:"severity,omitempty"`
+	Target     string    `json:"target,omitempty"`
+	Message    string    `json:"message,omitempty"`
+	Warning    bool      `json:"warning,omitempty"`
+	Time       time.Time `json:"time"`
+}
+
+// LifecycleEvents compares two versions of an experiment and describes what happened in between.
+// before is nil for a newly created experiment.
+func LifecycleEvents(before, after *chaosv1alpha1.ChaosExperiment, now time.Time) []ExperimentEvent {
+	event := func(eventType, target, message string) ExperimentEvent {
+		return ExperimentEvent{
+			Type:       eventType,
+			Namespace:  after.Namespace,
+			Experiment: after.Name,
+			Phase:      after.Status.Phase,
+			Severity:   chaosv1alpha1.SeverityOf(&after.Spec),
+			Target:     target,
+			Message:    message,
+			Time:       now,
+		}
+	}
+
+	if before == nil {
+		return []ExperimentEvent{event(EventCreated, "", "")}
+	}
+
+	var events []ExperimentEvent
+	if before.Spec.Paused != after.Spec.Paused {
+		if after.Spec.Paused {
+			events = append(events, event(EventPaused, "", ""))
+		} else {
+			events = append(events, event(EventResumed, "", ""))
+		}
+	}
+	if before.Status.Phase != after.Status.Phase || !before.Status.LastRunTime.Equal(after.Status.LastRunTime) {
+		switch after.Status.Phase {
+		case "Running":
+			events = append(events, event(EventStarted, "", after.Status.Message))
+		case "Completed":
+			events = append(events, event(EventCompleted, "", after.Status.Message))
+		case "Failed":
+			events = append(events, event(EventFailed, "", after.Status.Message))
+		}
+	}
+
+	targets := []struct {
+		kind          string
+		before, after []string
+	}{
+		{"Node", before.Status.CordonedNodes, after.Status.CordonedNodes},
+		{"Node", before.Status.TaintedNodes, after.Status.TaintedNodes},
+		{"Pod", before.Status.AffectedPods, after.Status.AffectedPods},
+	}
+	for _, t := range targets {
+		for _, name := range missingFrom(t.before, t.after) {
+			events = append(events, event(EventInjected, t.kind+"/"+name, ""))
+		}
+		for _, name := range missingFrom(t.after, t.before) {
+			events = append(events, event(EventReverted, t.kind+"/"+name, ""))
+		}
+	}
+	return events
+}
+
+// missingFrom returns the entries of b that are not in a
+func missingFrom(a, b []string) []string {
+	seen := make(map[string]bool, len(a))
+	for _, v := range a {
+		seen[v] = true
+	}
+	var missing []string
+	for _, v := range b {
+		if !seen[v] {
+			missing = append(missing, v)
+		}
+	}
+	return missing
+}
+
+// kubernetesEvent converts an event recorded on a ChaosExperiment
+func kubernetesEvent(ev *corev1.Event) ExperimentEvent {
+	when := ev.LastTimestamp.Time
+	if when.IsZero() {
+		when = ev.EventTime.Time
+	}
+	if when.IsZero() {
+		when = ev.CreationTimestamp.Time
+	}
+	return ExperimentEvent{
+		Type:       ev.Reason,
+		Namespace:  ev.InvolvedObject.Namespace,
+		Experiment: ev.InvolvedObject.Name,
+		Message:    ev.Message,
+		Warning:    ev.Type == corev1.EventTypeWarning,
+		Time:       when,
+	}
+}
+
+// streamEvents serves experiment events as server-sent events until the client disconnects
+func (s *Server) streamEvents(w http.ResponseWriter, r *http.Request) {
+	flusher, ok := w.(http.Flusher)
+	if !ok || s.Watcher == nil {
+		writeError(w, http.StatusNotImplemented, fmt.Errorf("event streaming is not available"))
+		return
+	}
+	namespace := r.PathValue("namespace")
+	if namespace == "" {
+		namespace = r.URL.Query().Get("namespace")
+	}
+	if namespace != "" && !s.authorize(w, r, namespace) {
+		return
+	}
+	name := r.PathValue("name")
+	if name == "" {
+		name = r.URL.Query().Get("experiment")
+	}
+
+	ctx := r.Context()
+	experiments, known, err := s.watchExperiments(ctx, namespace)
+	if err != nil {
+		writeK8sError(w, err)
+		return
+	}
+	defer experiments.Stop()
+	events, err := s.watchKubernetesEvents(ctx, namespace)
+	if err != nil {
+		writeK8sError(w, err)
+		return
+	}
+	defer events.Stop()
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	send := func(ev ExperimentEvent) bool {
+		if name != "" && ev.Experiment != name || !s.allowed(r, ev.Namespace) {
+			return true
+		}
+		// Messages carry no SSE event name so EventSource.onmessage sees all of them; the type is in the payload
+		data, _ := json.Marshal(ev)
+		if _, err := fmt.Fprintf(w, "data: %s\n\n", data); err != nil {
+			return false
+		}
+		flusher.Flush()
+		return true
+	}
+
+	keepalive := time.NewTicker(keepaliveInterval)
+	defer keepalive.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-keepalive.C:
+			if _, err := fmt.Fprint(w, ": keepalive\n\n"); err != nil {
+				return
+			}
+			flusher.Flush()
+		case change, open := <-experiments.ResultChan():
+			if !open {
+				return
+			}
+			for _, ev := range experimentChange(known, change) {
+				if !send(ev) {
+					return
+				}
+			}
+		case change, open := <-events.ResultChan():
+			if !open {
+				return
+			}
+			ev, isEvent := change.Object.(*corev1.Event)
+			if !isEvent || change.Type != watch.Added && change.Type != watch.Modified ||
+				ev.InvolvedObject.Kind != "ChaosExperiment" {
+				continue
+			}
+			if !send(kubernetesEvent(ev)) {
+				return
+			}
+		}
+	}
+}
+
+// watchExperiments lists the experiments to remember their current state, then watches for changes
+func (s *Server) watchExperiments(ctx context.Context, namespace string) (watch.Interface, map[string]*chaosv1alpha1.ChaosExperiment, error) {
+	list := &chaosv1alpha1.ChaosExperimentList{}
+	if err := s.Watcher.List(ctx, list, client.InNamespace(namespace)); err != nil {
+		return nil, nil, err
+	}
+	known := make(map[string]*chaosv1alpha1.ChaosExperiment, len(list.Items))
+	for i := range list.Items {
+		known[client.ObjectKeyFromObject(&list.Items[i]).String()] = &list.Items[i]
+	}
+	w, err := s.Watcher.Watch(ctx, &chaosv1alpha1.ChaosExperimentList{}, client.InNamespace(namespace),
+		&client.ListOptions{Raw: &metav1.ListOptions{ResourceVersion: list.ResourceVersion}})
+	if err != nil {
+		return nil, nil, err
+	}
+	return w, known, nil
+}
+
+// watchKubernetesEvents watches new events; older ones were already reflected in the status
+func (s *Server) watchKubernetesEvents(ctx context.Context, namespace string) (watch.Interface, error) {
+	list := &corev1.EventList{}
+	if err := s.Watcher.List(ctx, list, client.InNamespace(namespace), client.Limit(1)); err != nil {
+		return nil, err
+	}
+	return s.Watcher.Watch(ctx, &corev1.EventList{}, client.InNamespace(namespace),
+		client.MatchingFields{"involvedObject.kind": "ChaosExperiment"},
+		&client.ListOptions{Raw: &metav1.ListOptions{ResourceVersion: list.ResourceVersion}})
+}
+
+// experimentChange updates known with a watch event and returns the lifecycle events it implies
+func experimentChange(known map[string]*chaosv1alpha1.ChaosExperiment, change watch.Event) []ExperimentEvent {
+	exp, ok := change.Object.(*chaosv1alpha1.ChaosExperiment)
+	if !ok {
+		if change.Type == watch.Error {
+			ctrl.Log.WithName("apiserver").Info("Experiment watch error", "status", change.Object)
+		}
+		return nil
+	}
+	key := client.ObjectKeyFromObject(exp).String()
+	now := time.Now()
+	switch change.Type {
+	case watch.Added, watch.Modified:
+		events := LifecycleEvents(known[key], exp, now)
+		known[key] = exp
+		return events
+	case watch.Deleted:
+		delete(known, key)
+		return []ExperimentEvent{{Type: EventDeleted, Namespace: exp.Namespace, Experiment: exp.Name, Time: now}}
+	}
+	return nil
+}
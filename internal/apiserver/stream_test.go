@@ -0,0 +1,162 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apiserver
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	chaosv1alpha1 "github.com/neogan74/k8s-chaos/api/v1alpha1"
+)
+
+func TestLifecycleEvents(t *testing.T) {
+	now := time.Now()
+	before := testExperiment("web-stress")
+	before.Status.Phase = "Pending"
+	before.Status.AffectedPods = []string{"team-a/web-1:chaos-stress"}
+
+	after := before.DeepCopy()
+	after.Status.Phase = "Running"
+	after.Status.AffectedPods = []string{"team-a/web-2:chaos-stress"}
+
+	var got []string
+	for _, ev := range LifecycleEvents(before, after, now) {
+		got = append(got, ev.Type+" "+ev.Target)
+	}
+	want := []string{"Started ", "Injected Pod/team-a/web-2:chaos-stress", "Reverted Pod/team-a/web-1:chaos-stress"}
+	if strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Errorf("got %q, want %q", got, want)
+	}
+
+	paused := after.DeepCopy()
+	paused.Spec.Paused = true
+	if events := LifecycleEvents(after, paused, now); len(events) != 1 || events[0].Type != EventPaused {
+		t.Errorf("pause: got %+v", events)
+	}
+
+	if events := LifecycleEvents(nil, after, now); len(events) != 1 || events[0].Type != EventCreated {
+		t.Errorf("creation: got %+v", events)
+	}
+
+	if events := LifecycleEvents(after, after.DeepCopy(), now); len(events) != 0 {
+		t.Errorf("no change: got %+v", events)
+	}
+}
+
+func TestStreamEvents(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := chaosv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	if err := corev1.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	exp := testExperiment("web-kill")
+	other := testExperiment("db-kill")
+	cl := fake.NewClientBuilder().WithScheme(scheme).WithObjects(exp, other).Build()
+	s := &Server{Client: cl, Watcher: cl, Tokens: map[string]string{testToken: "bot"}}
+	server := httptest.NewServer(s.Handler())
+	t.Cleanup(server.Close)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet,
+		server.URL+"/api/v1/namespaces/team-a/experiments/web-kill/events", nil)
+	req.Header.Set("Authorization", "Bearer "+testToken)
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.Header.Get("Content-Type") != "text/event-stream" {
+		t.Fatalf("Content-Type = %q", resp.Header.Get("Content-Type"))
+	}
+
+	received := make(chan ExperimentEvent, 10)
+	go func() {
+		scanner := bufio.NewScanner(resp.Body)
+		for scanner.Scan() {
+			data, ok := strings.CutPrefix(scanner.Text(), "data: ")
+			if !ok {
+				continue
+			}
+			var ev ExperimentEvent
+			if err := json.Unmarshal([]byte(data), &ev); err == nil {
+				received <- ev
+			}
+		}
+	}()
+
+	// Changes to other experiments are filtered out
+	other.Status.Phase = "Running"
+	if err := cl.Update(ctx, other); err != nil {
+		t.Fatal(err)
+	}
+	exp.Status.Phase = "Running"
+	exp.Status.CordonedNodes = []string{"node-1"}
+	if err := cl.Update(ctx, exp); err != nil {
+		t.Fatal(err)
+	}
+	if err := cl.Create(ctx, &corev1.Event{
+		ObjectMeta:     metav1.ObjectMeta{Name: "web-kill.1", Namespace: "team-a"},
+		InvolvedObject: corev1.ObjectReference{Kind: "ChaosExperiment", Namespace: "team-a", Name: "web-kill"},
+		Reason:         "Aborted",
+		Message:        "Experiment aborted by jane",
+		Type:           corev1.EventTypeNormal,
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	var got []string
+	timeout := time.After(5 * time.Second)
+	for len(got) < 3 {
+		select {
+		case ev := <-received:
+			if ev.Experiment != "web-kill" {
+				t.Errorf("received event of %s", ev.Experiment)
+			}
+			got = append(got, ev.Type+" "+ev.Target)
+		case <-timeout:
+			t.Fatalf("timed out, received %q", got)
+		}
+	}
+	// The experiment and event watches are independent, so only the set of events is fixed
+	sort.Strings(got)
+	want := []string{"Aborted ", "Injected Node/node-1", "Started "}
+	if strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+func TestStreamEventsUnavailable(t *testing.T) {
+	server, _ := newTestServer(t)
+	if code, _ := do(t, server, http.MethodGet, "/api/v1/events", ""); code != http.StatusNotImplemented {
+		t.Errorf("got %d, want 501", code)
+	}
+}
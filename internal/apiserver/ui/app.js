@@ -0,0 +1,246 @@
+// k8s-chaos dashboard. Talks to the controller's REST API with the session cookie set at login.
+"use strict";
+
+// Updates are pushed over /api/v1/events; polling only catches what a dropped stream missed
+const refreshInterval = 30000;
+// Links such as those in Slack replies select an experiment with #namespace/name
+let selected = decodeURIComponent(window.location.hash.slice(1)) || null;
+
+// el builds a DOM element; children are nodes or strings (always inserted as text)
+function el(tag, attrs, ...children) {
+  const node = document.createElement(tag);
+  for (const [key, value] of Object.entries(attrs || {})) {
+    if (key.startsWith("on")) {
+      node.addEventListener(key.slice(2), value);
+    } else {
+      node.setAttribute(key, value);
+    }
+  }
+  for (const child of children) {
+    node.append(child instanceof Node ? child : String(child ?? ""));
+  }
+  return node;
+}
+
+async function api(method, path) {
+  const response = await fetch(path, {
+    method,
+    credentials: "same-origin",
+    headers: { "X-K8s-Chaos-Dashboard": "1" },
+  });
+  if (response.status === 401) {
+    window.location = "/ui/login";
+    return null;
+  }
+  const body = response.status === 204 ? null : await response.json();
+  if (!response.ok) {
+    throw new Error(body && body.error ? body.error : `HTTP ${response.status}`);
+  }
+  return body;
+}
+
+function showError(err) {
+  const box = document.getElementById("error");
+  box.hidden = !err;
+  box.textContent = err ? err.message : "";
+}
+
+function formatTime(value) {
+  return value ? new Date(value).toLocaleString() : "-";
+}
+
+function blastRadiusSummary(br) {
+  if (!br) {
+    return "-";
+  }
+  return `${br.affectedPods} pod(s) on ${br.nodesTouched} node(s)`;
+}
+
+function experimentPath(exp) {
+  return `/api/v1/namespaces/${encodeURIComponent(exp.metadata.namespace)}/experiments/${encodeURIComponent(exp.metadata.name)}`;
+}
+
+async function act(exp, verb) {
+  if (verb === "abort" && !window.confirm(`Abort ${exp.metadata.name}? Injections will be reverted.`)) {
+    return;
+  }
+  try {
+    await api("POST", `${experimentPath(exp)}/${verb}`);
+    await refresh();
+  } catch (err) {
+    showError(err);
+  }
+}
+
+function actionButtons(exp) {
+  const finished = exp.status && (exp.status.phase === "Completed" || exp.status.phase === "Failed");
+  const buttons = el("td");
+  if (finished) {
+    return buttons;
+  }
+  if (exp.spec.paused) {
+    buttons.append(el("button", { onclick: (e) => { e.stopPropagation(); act(exp, "resume"); } }, "Resume"));
+  } else {
+    buttons.append(el("button", { onclick: (e) => { e.stopPropagation(); act(exp, "pause"); } }, "Pause"));
+  }
+  buttons.append(el("button", { class: "danger", onclick: (e) => { e.stopPropagation(); act(exp, "abort"); } }, "Abort"));
+  return buttons;
+}
+
+function renderExperiments(list) {
+  const tbody = document.querySelector("#experiments tbody");
+  tbody.replaceChildren();
+  for (const exp of list.items || []) {
+    const key = `${exp.metadata.namespace}/${exp.metadata.name}`;
+    const status = exp.status || {};
+    const phase = status.phase || "Pending";
+    const row = el("tr", { class: key === selected ? "selectable selected" : "selectable", onclick: () => select(key) },
+      el("td", {}, key),
+      el("td", {}, exp.spec.action),
+      el("td", {}, exp.spec.namespace),
+      el("td", { class: `phase phase-${phase}` }, phase),
+      el("td", {}, blastRadiusSummary(status.blastRadius)),
+      el("td", {}, formatTime(status.lastRunTime)),
+      actionButtons(exp));
+    tbody.append(row);
+  }
+  if (!list.items || list.items.length === 0) {
+    tbody.append(el("tr", {}, el("td", { colspan: "7" }, "No experiments")));
+  }
+}
+
+function renderBlastRadius(br) {
+  const box = document.getElementById("blast-radius");
+  box.replaceChildren();
+  if (!br) {
+    box.append(el("p", {}, "Not computed yet"));
+    return;
+  }
+  const facts = el("ul", {},
+    el("li", {}, `${br.affectedPods} pod(s) on ${br.nodesTouched} node(s)`));
+  if (br.cpuSharePercent !== undefined) {
+    facts.append(el("li", {}, `${br.cpuSharePercent}% of namespace CPU`));
+  }
+  if (br.memorySharePercent !== undefined) {
+    facts.append(el("li", {}, `${br.memorySharePercent}% of namespace memory`));
+  }
+  for (const w of br.workloads || []) {
+    facts.append(el("li", {}, `${w.kind}/${w.name}: ${w.affected}/${w.total} (${w.percentage}%)`));
+  }
+  box.append(facts);
+  if (br.topology && br.topology.edges && br.topology.edges.length > 0) {
+    box.append(el("h4", {}, "Reaches"));
+    box.append(topologyList(br.topology));
+  }
+}
+
+// topologyList nests every resource under the ones it backs, starting from the resources nothing points to
+function topologyList(topology) {
+  const children = new Map();
+  const backed = new Set();
+  for (const edge of topology.edges) {
+    if (!children.has(edge.from)) {
+      children.set(edge.from, []);
+    }
+    children.get(edge.from).push(edge.to);
+    backed.add(edge.to);
+  }
+  const render = (ids, seen) => {
+    const list = el("ul");
+    for (const id of ids) {
+      const item = el("li", {}, id);
+      if (children.has(id) && !seen.has(id)) {
+        item.append(render(children.get(id), new Set([...seen, id])));
+      }
+      list.append(item);
+    }
+    return list;
+  };
+  const roots = topology.nodes.map((n) => n.id).filter((id) => !backed.has(id) && children.has(id));
+  const list = render(roots, new Set());
+  if (topology.truncated) {
+    list.append(el("li", {}, "more pods not shown"));
+  }
+  return list;
+}
+
+function renderTimeline(exp, history) {
+  const status = exp.status || {};
+  const events = [
+    [exp.metadata.creationTimestamp, "Created"],
+    [status.startTime, "Started"],
+    ...history.map((h) => [h.spec.execution.startTime, `Run ${h.spec.execution.status}`]),
+    [status.completedAt, "Completed"],
+    [status.nextScheduledTime, "Next scheduled run"],
+  ].filter(([time]) => time);
+  events.sort((a, b) => new Date(a[0]) - new Date(b[0]));
+
+  const list = document.getElementById("timeline");
+  list.replaceChildren(...events.map(([time, label]) => el("li", {}, `${formatTime(time)} - ${label}`)));
+}
+
+function renderHistory(history) {
+  const tbody = document.querySelector("#history tbody");
+  tbody.replaceChildren(...history.map((h) => el("tr", {},
+    el("td", {}, formatTime(h.spec.execution.startTime)),
+    el("td", {}, h.spec.execution.status),
+    el("td", {}, h.spec.execution.duration || "-"),
+    el("td", {}, (h.spec.affectedResources || []).length),
+    el("td", {}, h.spec.execution.message || ""))));
+}
+
+async function renderDetails(exp) {
+  const details = document.getElementById("details");
+  if (!exp) {
+    details.hidden = true;
+    return;
+  }
+  details.hidden = false;
+  document.getElementById("details-title").textContent = `${exp.metadata.namespace}/${exp.metadata.name}`;
+  document.getElementById("details-message").textContent = (exp.status && exp.status.message) || "";
+  renderBlastRadius(exp.status && exp.status.blastRadius);
+
+  const history = await api("GET", `${experimentPath(exp)}/history?limit=20`);
+  const items = (history && history.items) || [];
+  renderTimeline(exp, items);
+  renderHistory(items);
+}
+
+let experiments = [];
+
+function select(key) {
+  selected = key;
+  window.history.replaceState(null, "", `#${encodeURIComponent(key)}`);
+  refresh();
+}
+
+async function refresh() {
+  const namespace = document.getElementById("namespace").value.trim();
+  try {
+    const list = await api("GET", `/api/v1/experiments?namespace=${encodeURIComponent(namespace)}`);
+    if (!list) {
+      return;
+    }
+    experiments = list.items || [];
+    renderExperiments(list);
+    await renderDetails(experiments.find((e) => `${e.metadata.namespace}/${e.metadata.name}` === selected));
+    showError(null);
+    document.getElementById("updated").textContent = `Updated ${new Date().toLocaleTimeString()}`;
+  } catch (err) {
+    showError(err);
+  }
+}
+
+let pending = null;
+
+// refreshSoon coalesces bursts of events (e.g. one per injected pod) into a single refresh
+function refreshSoon() {
+  if (pending === null) {
+    pending = setTimeout(() => { pending = null; refresh(); }, 500);
+  }
+}
+
+document.getElementById("namespace").addEventListener("change", refresh);
+refresh();
+setInterval(refresh, refreshInterval);
+new EventSource("/api/v1/events").onmessage = refreshSoon;
@@ -0,0 +1,219 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package audittrail tags the API requests experiments make with a user-agent naming the
+// experiment, and reads them back from Kubernetes audit logs, so that each delete, eviction or
+// injection a security team reviews can be traced to the experiment that caused it.
+package audittrail
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"k8s.io/client-go/rest"
+)
+
+const (
+	// Product is the user-agent product of tagged requests; the experiment UID is its version,
+	// e.g. "k8s-chaos-injector/3f2b...". Requests made outside an experiment carry Product alone.
+	Product = "k8s-chaos-injector"
+
+	// maxLineSize bounds a single audit log line; request bodies can make entries large
+	maxLineSize = 4 * 1024 * 1024
+)
+
+// experimentKey is the context key of the experiment UID requests are tagged with
+type experimentKey struct{}
+
+// WithExperiment returns ctx tagging the requests made with it as made for the experiment uid
+func WithExperiment(ctx context.Context, uid string) context.Context {
+	return context.WithValue(ctx, experimentKey{}, uid)
+}
+
+// UserAgent returns the user-agent of the requests made for the experiment uid
+func UserAgent(uid string) string {
+	if uid == "" {
+		return Product
+	}
+	return Product + "/" + uid
+}
+
+// ExperimentUID returns the experiment UID a user-agent was tagged with
+func ExperimentUID(userAgent string) (string, bool) {
+	uid, ok := strings.CutPrefix(userAgent, Product+"/")
+	if !ok || uid == "" {
+		return "", false
+	}
+	uid, _, _ = strings.Cut(uid, " ")
+	return uid, true
+}
+
+// Config returns a copy of config whose requests carry the user-agent of the experiment in their
+// context
+func Config(config *rest.Config) *rest.Config {
+	tagged := rest.CopyConfig(config)
+	tagged.UserAgent = Product
+	tagged.Wrap(func(rt http.RoundTripper) http.RoundTripper { return &tagger{next: rt} })
+	return tagged
+}
+
+// tagger sets the user-agent of requests from their context
+type tagger struct {
+	next http.RoundTripper
+}
+
+func (t *tagger) RoundTrip(req *http.Request) (*http.Response, error) {
+	uid, _ := req.Context().Value(experimentKey{}).(string)
+	if uid == "" {
+		return t.next.RoundTrip(req)
+	}
+	req = req.Clone(req.Context())
+	req.Header.Set("User-Agent", UserAgent(uid))
+	return t.next.RoundTrip(req)
+}
+
+// Event holds the fields of an audit.k8s.io/v1 Event needed to correlate it with an experiment
+type Event struct {
+	AuditID   string `json:"auditID"`
+	Stage     string `json:"stage"`
+	Verb      string `json:"verb"`
+	UserAgent string `json:"userAgent"`
+
+	User                     User            `json:"user"`
+	ImpersonatedUser         *User           `json:"impersonatedUser,omitempty"`
+	ObjectRef                *ObjectRef      `json:"objectRef,omitempty"`
+	ResponseStatus           *ResponseStatus `json:"responseStatus,omitempty"`
+	RequestReceivedTimestamp time.Time       `json:"requestReceivedTimestamp"`
+
+	// Experiment is the UID the request was tagged with
+	Experiment string `json:"-"`
+}
+
+// User is the user of an audit Event
+type User struct {
+	Username string `json:"username"`
+}
+
+// ObjectRef is the object an audit Event refers to
+type ObjectRef struct {
+	Resource    string `json:"resource"`
+	Subresource string `json:"subresource,omitempty"`
+	Namespace   string `json:"namespace,omitempty"`
+	Name        string `json:"name,omitempty"`
+}
+
+// ResponseStatus is the response of an audit Event
+type ResponseStatus struct {
+	Code int32 `json:"code"`
+}
+
+// Actor is who the API server authorized the request as: the impersonated user if any
+func (e *Event) Actor() string {
+	if e.ImpersonatedUser != nil {
+		return e.ImpersonatedUser.Username
+	}
+	return e.User.Username
+}
+
+// Resource is the resource the request targeted, e.g. "pods/eviction"
+func (e *Event) Resource() string {
+	if e.ObjectRef == nil {
+		return ""
+	}
+	if e.ObjectRef.Subresource != "" {
+		return e.ObjectRef.Resource + "/" + e.ObjectRef.Subresource
+	}
+	return e.ObjectRef.Resource
+}
+
+// Object is the namespace/name of the object the request targeted
+func (e *Event) Object() string {
+	if e.ObjectRef == nil {
+		return ""
+	}
+	if e.ObjectRef.Namespace != "" {
+		return e.ObjectRef.Namespace + "/" + e.ObjectRef.Name
+	}
+	return e.ObjectRef.Name
+}
+
+// Code is the HTTP status of the response, or 0 when the entry was logged before it completed
+func (e *Event) Code() int32 {
+	if e.ResponseStatus == nil {
+		return 0
+	}
+	return e.ResponseStatus.Code
+}
+
+// ReadEvents returns the tagged requests in an audit log, oldest first, keeping the last stage
+// logged for each request. The log holds one JSON Event or EventList per line, as written by the
+// log and webhook backends.
+func ReadEvents(r io.Reader) ([]Event, error) {
+	byID := map[string]int{}
+	var events []Event
+	keep := func(event Event) {
+		tagged, ok := ExperimentUID(event.UserAgent)
+		if !ok {
+			return
+		}
+		event.Experiment = tagged
+		if i, seen := byID[event.AuditID]; seen && event.AuditID != "" {
+			events[i] = event
+			return
+		}
+		byID[event.AuditID] = len(events)
+		events = append(events, event)
+	}
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), maxLineSize)
+	for line := 1; scanner.Scan(); line++ {
+		data := strings.TrimSpace(scanner.Text())
+		if data == "" {
+			continue
+		}
+		var entry struct {
+			Kind  string  `json:"kind"`
+			Items []Event `json:"items"`
+			Event
+		}
+		if err := json.Unmarshal([]byte(data), &entry); err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		if entry.Kind == "EventList" {
+			for _, event := range entry.Items {
+				keep(event)
+			}
+			continue
+		}
+		keep(entry.Event)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read audit log: %w", err)
+	}
+
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].RequestReceivedTimestamp.Before(events[j].RequestReceivedTimestamp)
+	})
+	return events, nil
+}
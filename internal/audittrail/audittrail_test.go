@@ -0,0 +1,111 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audittrail
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"k8s.io/client-go/rest"
+)
+
+func TestExperimentUID(t *testing.T) {
+	tests := []struct {
+		userAgent string
+		uid       string
+		ok        bool
+	}{
+		{"k8s-chaos-injector/3f2b-uid", "3f2b-uid", true},
+		{"k8s-chaos-injector/3f2b-uid (linux/amd64)", "3f2b-uid", true},
+		{"k8s-chaos-injector", "", false},
+		{"k8s-chaos-injector/", "", false},
+		{"manager/v0.0.0 (linux/amd64) kubernetes/$Format", "", false},
+	}
+	for _, tt := range tests {
+		uid, ok := ExperimentUID(tt.userAgent)
+		if uid != tt.uid || ok != tt.ok {
+			t.Errorf("ExperimentUID(%q) = %q, %v; want %q, %v", tt.userAgent, uid, ok, tt.uid, tt.ok)
+		}
+	}
+}
+
+func TestConfig_TagsRequestsOfExperiments(t *testing.T) {
+	var userAgents []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		userAgents = append(userAgents, r.UserAgent())
+	}))
+	defer server.Close()
+
+	base := &rest.Config{Host: server.URL, UserAgent: "manager"}
+	config := Config(base)
+	if base.UserAgent != "manager" {
+		t.Errorf("the original config must not be modified, user-agent is %q", base.UserAgent)
+	}
+	httpClient, err := rest.HTTPClientFor(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, ctx := range []context.Context{WithExperiment(context.Background(), "3f2b-uid"), context.Background()} {
+		req, err := http.NewRequestWithContext(ctx, http.MethodDelete, server.URL+"/api/v1/namespaces/shop/pods/web-1", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp, err := httpClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_ = resp.Body.Close()
+	}
+
+	if len(userAgents) != 2 || userAgents[0] != "k8s-chaos-injector/3f2b-uid" || userAgents[1] != "k8s-chaos-injector" {
+		t.Errorf("unexpected user-agents %q", userAgents)
+	}
+}
+
+func TestReadEvents(t *testing.T) {
+	log := `{"kind":"Event","auditID":"a1","stage":"RequestReceived","verb":"delete","userAgent":"k8s-chaos-injector/uid-1","requestReceivedTimestamp":"2026-01-01T10:00:02Z","objectRef":{"resource":"pods","namespace":"shop","name":"web-1"}}
+{"kind":"Event","auditID":"a1","stage":"ResponseComplete","verb":"delete","userAgent":"k8s-chaos-injector/uid-1","user":{"username":"system:serviceaccount:chaos-system:controller"},"requestReceivedTimestamp":"2026-01-01T10:00:02Z","objectRef":{"resource":"pods","namespace":"shop","name":"web-1"},"responseStatus":{"code":200}}
+{"kind":"Event","auditID":"a2","stage":"ResponseComplete","verb":"get","userAgent":"kubectl/v1.31.0","requestReceivedTimestamp":"2026-01-01T10:00:01Z"}
+
+{"kind":"EventList","items":[{"auditID":"a3","stage":"ResponseComplete","verb":"create","userAgent":"k8s-chaos-injector/uid-2","user":{"username":"system:serviceaccount:chaos-system:controller"},"impersonatedUser":{"username":"system:serviceaccount:team-a:chaos"},"requestReceivedTimestamp":"2026-01-01T10:00:00Z","objectRef":{"resource":"pods","subresource":"eviction","namespace":"team-a","name":"api-0"},"responseStatus":{"code":403}}]}
+`
+	events, err := ReadEvents(strings.NewReader(log))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(events) != 2 {
+		t.Fatalf("expected the two tagged requests, got %d: %+v", len(events), events)
+	}
+
+	eviction, deletion := events[0], events[1]
+	if eviction.Experiment != "uid-2" || eviction.Resource() != "pods/eviction" || eviction.Object() != "team-a/api-0" ||
+		eviction.Code() != 403 || eviction.Actor() != "system:serviceaccount:team-a:chaos" {
+		t.Errorf("unexpected eviction %+v", eviction)
+	}
+	if deletion.Experiment != "uid-1" || deletion.Stage != "ResponseComplete" || deletion.Code() != 200 ||
+		deletion.Actor() != "system:serviceaccount:chaos-system:controller" {
+		t.Errorf("expected the completed deletion, got %+v", deletion)
+	}
+
+	if _, err := ReadEvents(strings.NewReader("{\"kind\":\"Event\"}\nnot json\n")); err == nil || !strings.Contains(err.Error(), "line 2") {
+		t.Errorf("expected an error naming line 2, got %v", err)
+	}
+}
@@ -0,0 +1,188 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package capabilities describes what each chaos action needs to run: the spec fields it
+// requires, the RBAC permissions of the controller, and the Linux capabilities or privileges of
+// the containers it injects. It backs the /api/v1/capabilities endpoint and `k8s-chaos actions`.
+package capabilities
+
+import (
+	"fmt"
+	"sort"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/neogan74/k8s-chaos/internal/preflight"
+)
+
+const (
+	// TargetPod actions act on the pods matching spec.selector in spec.namespace
+	TargetPod = "pod"
+	// TargetNode actions act on the nodes matching spec.selector
+	TargetNode = "node"
+	// TargetLease actions act on the Lease spec.leaseName in spec.namespace
+	TargetLease = "lease"
+	// TargetQuota actions act on the ResourceQuotas in spec.namespace
+	TargetQuota = "quota"
+	// TargetAdmission actions act on API requests for objects matching spec.selector in spec.namespace
+	TargetAdmission = "admission"
+
+	// podSecurityEnforceLabel selects the Pod Security admission level enforced in a namespace
+	podSecurityEnforceLabel = "pod-security.kubernetes.io/enforce"
+	// PodSecurityPrivileged is the only Pod Security level allowing NET_ADMIN and privileged
+	// containers; it is also what a namespace without the label enforces
+	PodSecurityPrivileged = "privileged"
+)
+
+// Action describes one chaos action and, once evaluated, whether the cluster can run it
+type Action struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	// Target is TargetPod or TargetNode
+	Target string `json:"target"`
+	// RequiredFields are the spec fields the action needs besides action, namespace and selector
+	RequiredFields []string `json:"requiredFields,omitempty"`
+	// RBAC are the permissions the controller needs, formatted like "create pods/exec"
+	RBAC []string `json:"rbac"`
+	// Capabilities are the Linux capabilities added to the containers the action injects
+	Capabilities []string `json:"capabilities,omitempty"`
+	// Privileged is true when the action runs privileged pods on the target nodes
+	Privileged bool `json:"privileged,omitempty"`
+
+	// Satisfied reports whether the cluster meets every requirement; nil when not evaluated
+	Satisfied *bool `json:"satisfied,omitempty"`
+	// Missing lists the requirements the cluster does not meet
+	Missing []string `json:"missing,omitempty"`
+}
+
+// Report is the capability report of a cluster, as served by the API and printed by the CLI
+type Report struct {
+	// Namespace is the namespace whose Pod Security level was checked, if any
+	Namespace string `json:"namespace,omitempty"`
+	// PodSecurityLevel is the level enforced in Namespace
+	PodSecurityLevel string `json:"podSecurityLevel,omitempty"`
+	// RBACChecked is false when the RBAC permissions could not be verified
+	RBACChecked bool     `json:"rbacChecked"`
+	Actions     []Action `json:"actions"`
+}
+
+// detail is the part of an Action that is not derived from preflight.Actions
+type detail struct {
+	description    string
+	target         string
+	requiredFields []string
+	capabilities   []string
+	privileged     bool
+}
+
+var netAdmin = []string{"NET_ADMIN"}
+
+var details = map[string]detail{
+	"pod-kill":               {"Deletes target pods", TargetPod, nil, nil, false},
+	"pod-delay":              {"Adds network latency with tc inside the target container, or from a helper with netAdminFallback", TargetPod, []string{"duration"}, netAdmin, false},
+	"pod-failure":            {"Kills the main process of the target container", TargetPod, nil, nil, false},
+	"pod-restart":            {"Restarts the target container by signalling its main process", TargetPod, nil, nil, false},
+	"pod-cpu-stress":         {"Runs stress-ng in an ephemeral container, sized to the pod's CPU limit", TargetPod, []string{"duration", "cpuLoad or relativeLoad"}, nil, false},
+	"pod-memory-stress":      {"Allocates memory in an ephemeral container", TargetPod, []string{"duration", "memorySize"}, nil, false},
+	"pod-disk-fill":          {"Fills a volume or path of the target pod from an ephemeral container", TargetPod, []string{"duration", "fillPercentage", "volumeName or targetPath"}, nil, false},
+	"pod-network-loss":       {"Drops packets with tc netem from an ephemeral container", TargetPod, []string{"duration", "lossPercentage"}, netAdmin, false},
+	"pod-network-corruption": {"Corrupts packets with tc netem from an ephemeral container", TargetPod, []string{"duration", "corruptionPercentage"}, netAdmin, false},
+	"network-partition":      {"Blocks traffic between target pods and peers or external CIDRs with iptables", TargetPod, []string{"duration", "peerSelector, peerNamespaces or externalTargets"}, netAdmin, false},
+	"node-drain":             {"Cordons target nodes and evicts their pods", TargetNode, nil, nil, false},
+	"node-taint":             {"Adds a taint to target nodes for the duration", TargetNode, []string{"duration", "taintKey", "taintEffect"}, nil, false},
+	"node-cpu-stress":        {"Runs a privileged stress-ng pod on each target node", TargetNode, []string{"duration", "cpuLoad"}, nil, true},
+	"node-disk-fill":         {"Fills the node filesystem from a privileged pod on each target node", TargetNode, []string{"duration", "fillPercentage"}, nil, true},
+	"scheduler-pressure":     {"Creates balloon pods of a PriorityClass to force preemption and scheduling churn", TargetPod, []string{"duration", "balloons.priorityClassName"}, nil, false},
+	"lease-steal":            {"Acquires or deletes a leader-election Lease to force a re-election", TargetLease, []string{"leaseName", "duration (Acquire mode)"}, nil, false},
+	"quota-squeeze":          {"Lowers ResourceQuota limits towards current usage for the duration", TargetQuota, []string{"duration"}, nil, false},
+	"admission-delay":        {"Registers a fail-open webhook that holds API requests for the delay before admitting them", TargetAdmission, []string{"duration", "admissionDelay.delay", "admissionDelay.resources"}, nil, false},
+}
+
+// Describe returns the actions in names, sorted, with their static requirements. Every name must
+// be an action the controller executes.
+func Describe(names []string) ([]Action, error) {
+	sorted := append([]string(nil), names...)
+	sort.Strings(sorted)
+
+	actions := make([]Action, 0, len(sorted))
+	for _, name := range sorted {
+		d, ok := details[name]
+		perms, known := preflight.Actions[name]
+		if !ok || !known {
+			return nil, fmt.Errorf("no capability description for action %q", name)
+		}
+		rbac := make([]string, len(perms))
+		for i, p := range perms {
+			rbac[i] = p.String()
+		}
+		actions = append(actions, Action{
+			Name:           name,
+			Description:    d.description,
+			Target:         d.target,
+			RequiredFields: d.requiredFields,
+			RBAC:           rbac,
+			Capabilities:   d.capabilities,
+			Privileged:     d.privileged,
+		})
+	}
+	return actions, nil
+}
+
+// Known returns every action with a capability description, sorted
+func Known() []string {
+	names := make([]string, 0, len(details))
+	for name := range details {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// PodSecurityLevel returns the Pod Security level enforced in ns
+func PodSecurityLevel(ns *corev1.Namespace) string {
+	if level := ns.Labels[podSecurityEnforceLabel]; level != "" {
+		return level
+	}
+	return PodSecurityPrivileged
+}
+
+// Evaluate fills in Satisfied and Missing from the RBAC preflight result and the Pod Security
+// level of the namespace the experiments would target. An empty level skips that check, and a
+// nil result skips RBAC.
+func Evaluate(actions []Action, rbac *preflight.Result, namespace, level string) {
+	for i := range actions {
+		a := &actions[i]
+		a.Missing = nil
+		if rbac != nil {
+			for _, p := range rbac.MissingCore {
+				a.Missing = append(a.Missing, "RBAC: "+p.String())
+			}
+			for _, p := range rbac.MissingByAction[a.Name] {
+				a.Missing = append(a.Missing, "RBAC: "+p.String())
+			}
+		}
+		if level != "" && level != PodSecurityPrivileged {
+			for _, c := range a.Capabilities {
+				a.Missing = append(a.Missing, fmt.Sprintf("%s: namespace %s enforces Pod Security %q", c, namespace, level))
+			}
+			if a.Privileged {
+				a.Missing = append(a.Missing, fmt.Sprintf("privileged pods: namespace %s enforces Pod Security %q", namespace, level))
+			}
+		}
+		satisfied := len(a.Missing) == 0
+		a.Satisfied = &satisfied
+	}
+}
@@ -0,0 +1,102 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package capabilities
+
+import (
+	"reflect"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/neogan74/k8s-chaos/internal/preflight"
+)
+
+func TestKnown_MatchesPreflight(t *testing.T) {
+	var rbac []string
+	for action := range preflight.Actions {
+		rbac = append(rbac, action)
+	}
+	actions, err := Describe(rbac)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(actions) != len(Known()) {
+		t.Fatalf("%d actions have RBAC rules but %d have descriptions", len(actions), len(Known()))
+	}
+}
+
+func TestDescribe(t *testing.T) {
+	actions, err := Describe([]string{"pod-network-loss", "node-disk-fill"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if actions[0].Name != "node-disk-fill" || !actions[0].Privileged || actions[0].Target != TargetNode {
+		t.Errorf("unexpected node-disk-fill: %+v", actions[0])
+	}
+	want := []string{"list pods", "update pods/ephemeralcontainers"}
+	if !reflect.DeepEqual(actions[1].RBAC, want) || !reflect.DeepEqual(actions[1].Capabilities, []string{"NET_ADMIN"}) {
+		t.Errorf("unexpected pod-network-loss: %+v", actions[1])
+	}
+
+	if _, err := Describe([]string{"pod-teleport"}); err == nil {
+		t.Error("expected an error for an unknown action")
+	}
+}
+
+func TestEvaluate(t *testing.T) {
+	actions, _ := Describe([]string{"node-cpu-stress", "pod-kill", "pod-network-loss"})
+	rbac := &preflight.Result{MissingByAction: map[string][]preflight.Permission{
+		"pod-kill": {{Resource: "pods", Verb: "delete"}},
+	}}
+
+	Evaluate(actions, rbac, "shop", "restricted")
+
+	missing := map[string][]string{}
+	for _, a := range actions {
+		if a.Satisfied == nil || *a.Satisfied != (len(a.Missing) == 0) {
+			t.Errorf("%s: satisfied does not match missing %v", a.Name, a.Missing)
+		}
+		missing[a.Name] = a.Missing
+	}
+	want := map[string][]string{
+		"node-cpu-stress":  {`privileged pods: namespace shop enforces Pod Security "restricted"`},
+		"pod-kill":         {"RBAC: delete pods"},
+		"pod-network-loss": {`NET_ADMIN: namespace shop enforces Pod Security "restricted"`},
+	}
+	if !reflect.DeepEqual(missing, want) {
+		t.Errorf("missing = %v, want %v", missing, want)
+	}
+
+	// Privileged namespaces and a passing preflight satisfy everything
+	Evaluate(actions, &preflight.Result{}, "shop", PodSecurityPrivileged)
+	for _, a := range actions {
+		if !*a.Satisfied {
+			t.Errorf("%s: unexpected missing %v", a.Name, a.Missing)
+		}
+	}
+}
+
+func TestPodSecurityLevel(t *testing.T) {
+	if got := PodSecurityLevel(&corev1.Namespace{}); got != PodSecurityPrivileged {
+		t.Errorf("unlabelled namespace = %q", got)
+	}
+	ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{podSecurityEnforceLabel: "baseline"}}}
+	if got := PodSecurityLevel(ns); got != "baseline" {
+		t.Errorf("labelled namespace = %q", got)
+	}
+}
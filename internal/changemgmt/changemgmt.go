@@ -0,0 +1,286 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package changemgmt looks up change tickets in a change-management system, ServiceNow or Jira.
+// The controller checks that the ticket an experiment references is approved and that its change
+// window is open before injecting chaos.
+package changemgmt
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+const (
+	// ProviderServiceNow selects ServiceNow change requests in the Secret's "type" key
+	ProviderServiceNow = "servicenow"
+	// ProviderJira selects Jira issues in the Secret's "type" key
+	ProviderJira = "jira"
+
+	// Secret data keys configuring the system
+	SecretKeyType             = "type"
+	SecretKeyURL              = "url"
+	SecretKeyUsername         = "username"
+	SecretKeyPassword         = "password"
+	SecretKeyToken            = "token"
+	SecretKeyApprovedStates   = "approved-states"
+	SecretKeyWindowStartField = "window-start-field"
+	SecretKeyWindowEndField   = "window-end-field"
+
+	// serviceNowTimeLayout is how the ServiceNow Table API returns dates, in UTC
+	serviceNowTimeLayout = "2006-01-02 15:04:05"
+	// jiraTimeLayout is how Jira returns date-time fields
+	jiraTimeLayout = "2006-01-02T15:04:05.000-0700"
+
+	requestTimeout = 10 * time.Second
+)
+
+// ErrNotFound is returned when the system has no ticket with the ID
+var ErrNotFound = errors.New("change ticket not found")
+
+// ticketID matches the ticket IDs of both systems, such as "CHG0030042" and "CHG-1234". IDs end
+// up in query strings, so nothing else is looked up.
+var ticketID = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9_.-]{0,63}$`)
+
+// ValidateID rejects strings that cannot be a ticket ID
+func ValidateID(id string) error {
+	if !ticketID.MatchString(id) {
+		return fmt.Errorf("invalid change ticket ID %q: use letters, digits, '.', '_' and '-'", id)
+	}
+	return nil
+}
+
+// Ticket is the state of a change ticket
+type Ticket struct {
+	ID string
+	// State is the approval (ServiceNow) or status (Jira) of the ticket
+	State string
+	// Approved is true when State is one of the system's approved states
+	Approved bool
+	// Start and End bound the planned change window; zero when the ticket has none
+	Start, End time.Time
+}
+
+// Open reports whether t falls in the ticket's change window. Tickets without a window are
+// always open, and a missing bound leaves that side of the window open.
+func (t *Ticket) Open(at time.Time) bool {
+	return (t.Start.IsZero() || !at.Before(t.Start)) && (t.End.IsZero() || at.Before(t.End))
+}
+
+// System looks up change tickets
+type System interface {
+	Ticket(ctx context.Context, id string) (*Ticket, error)
+}
+
+// SystemFromSecret builds the system described by a Secret with "type" ("servicenow" or "jira"),
+// "url", either "token" or "username" and "password", and optionally "approved-states"
+// (comma-separated) and, for Jira, the "window-start-field" and "window-end-field" custom fields
+func SystemFromSecret(secret *corev1.Secret) (System, error) {
+	get := func(key string) string { return strings.TrimSpace(string(secret.Data[key])) }
+	if get(SecretKeyURL) == "" {
+		return nil, fmt.Errorf("secret %s/%s: %q is required", secret.Namespace, secret.Name, SecretKeyURL)
+	}
+	c := client{
+		url:        strings.TrimSuffix(get(SecretKeyURL), "/"),
+		username:   get(SecretKeyUsername),
+		password:   get(SecretKeyPassword),
+		token:      get(SecretKeyToken),
+		httpClient: &http.Client{Timeout: requestTimeout},
+	}
+	var approved []string
+	for _, state := range strings.Split(get(SecretKeyApprovedStates), ",") {
+		if state = strings.TrimSpace(state); state != "" {
+			approved = append(approved, state)
+		}
+	}
+
+	switch get(SecretKeyType) {
+	case ProviderServiceNow:
+		if len(approved) == 0 {
+			approved = []string{"approved"}
+		}
+		return &ServiceNow{client: c, ApprovedStates: approved}, nil
+	case ProviderJira:
+		if len(approved) == 0 {
+			approved = []string{"Approved"}
+		}
+		return &Jira{
+			client:           c,
+			ApprovedStates:   approved,
+			WindowStartField: get(SecretKeyWindowStartField),
+			WindowEndField:   get(SecretKeyWindowEndField),
+		}, nil
+	default:
+		return nil, fmt.Errorf("secret %s/%s: %q must be %q or %q, got %q",
+			secret.Namespace, secret.Name, SecretKeyType, ProviderServiceNow, ProviderJira, get(SecretKeyType))
+	}
+}
+
+// client is the HTTP part shared by the systems
+type client struct {
+	url                string
+	username, password string
+	token              string
+	httpClient         *http.Client
+}
+
+// getJSON fetches path from the system and decodes the JSON response into out
+func (c *client) getJSON(ctx context.Context, path string, out any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.url+path, nil)
+	if err != nil {
+		return fmt.Errorf("failed to build request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+	if c.token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.token)
+	} else if c.username != "" {
+		req.SetBasicAuth(c.username, c.password)
+	}
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	switch {
+	case resp.StatusCode == http.StatusNotFound:
+		return ErrNotFound
+	case resp.StatusCode != http.StatusOK:
+		return fmt.Errorf("unexpected HTTP status %d", resp.StatusCode)
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+	return nil
+}
+
+// ServiceNow reads change requests from the Table API. A change is approved by its approval
+// field; its window is the planned start and end date.
+type ServiceNow struct {
+	client
+	ApprovedStates []string
+}
+
+// Ticket returns the change request with the number id, e.g. "CHG0030042"
+func (s *ServiceNow) Ticket(ctx context.Context, id string) (*Ticket, error) {
+	if err := ValidateID(id); err != nil {
+		return nil, err
+	}
+	params := url.Values{}
+	params.Set("sysparm_query", "number="+id)
+	params.Set("sysparm_fields", "number,approval,start_date,end_date")
+	params.Set("sysparm_limit", "1")
+	var body struct {
+		Result []struct {
+			Number    string `json:"number"`
+			Approval  string `json:"approval"`
+			StartDate string `json:"start_date"`
+			EndDate   string `json:"end_date"`
+		} `json:"result"`
+	}
+	if err := s.getJSON(ctx, "/api/now/table/change_request?"+params.Encode(), &body); err != nil {
+		return nil, fmt.Errorf("failed to look up ServiceNow change %s: %w", id, err)
+	}
+	if len(body.Result) == 0 {
+		return nil, fmt.Errorf("no ServiceNow change %s: %w", id, ErrNotFound)
+	}
+	change := body.Result[0]
+	ticket := &Ticket{ID: change.Number, State: change.Approval, Approved: slices.Contains(s.ApprovedStates, change.Approval)}
+	var err error
+	if ticket.Start, err = parseTime(serviceNowTimeLayout, change.StartDate); err != nil {
+		return nil, fmt.Errorf("invalid start_date of ServiceNow change %s: %w", id, err)
+	}
+	if ticket.End, err = parseTime(serviceNowTimeLayout, change.EndDate); err != nil {
+		return nil, fmt.Errorf("invalid end_date of ServiceNow change %s: %w", id, err)
+	}
+	return ticket, nil
+}
+
+// Jira reads issues from the REST API. An issue is approved by its status; its window comes
+// from two date-time custom fields, if configured.
+type Jira struct {
+	client
+	ApprovedStates                   []string
+	WindowStartField, WindowEndField string
+}
+
+// Ticket returns the issue with the key id, e.g. "CHG-1234"
+func (j *Jira) Ticket(ctx context.Context, id string) (*Ticket, error) {
+	if err := ValidateID(id); err != nil {
+		return nil, err
+	}
+	fields := []string{"status"}
+	for _, field := range []string{j.WindowStartField, j.WindowEndField} {
+		if field != "" {
+			fields = append(fields, field)
+		}
+	}
+	var body struct {
+		Key    string                     `json:"key"`
+		Fields map[string]json.RawMessage `json:"fields"`
+	}
+	path := "/rest/api/2/issue/" + url.PathEscape(id) + "?fields=" + url.QueryEscape(strings.Join(fields, ","))
+	if err := j.getJSON(ctx, path, &body); err != nil {
+		return nil, fmt.Errorf("failed to look up Jira issue %s: %w", id, err)
+	}
+	var status struct {
+		Name string `json:"name"`
+	}
+	if raw, ok := body.Fields["status"]; ok {
+		if err := json.Unmarshal(raw, &status); err != nil {
+			return nil, fmt.Errorf("invalid status of Jira issue %s: %w", id, err)
+		}
+	}
+	ticket := &Ticket{ID: body.Key, State: status.Name, Approved: slices.Contains(j.ApprovedStates, status.Name)}
+	for _, bound := range []struct {
+		field string
+		into  *time.Time
+	}{{j.WindowStartField, &ticket.Start}, {j.WindowEndField, &ticket.End}} {
+		if bound.field == "" {
+			continue
+		}
+		var value string
+		if raw, ok := body.Fields[bound.field]; ok && string(raw) != "null" {
+			if err := json.Unmarshal(raw, &value); err != nil {
+				return nil, fmt.Errorf("field %s of Jira issue %s is not a date-time: %w", bound.field, id, err)
+			}
+		}
+		parsed, err := parseTime(jiraTimeLayout, value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s of Jira issue %s: %w", bound.field, id, err)
+		}
+		*bound.into = parsed
+	}
+	return ticket, nil
+}
+
+// parseTime parses value with layout, in UTC when the layout has no zone; empty values are zero
+func parseTime(layout, value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+	return time.ParseInLocation(layout, value, time.UTC)
+}
@@ -0,0 +1,160 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package changemgmt
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func secretWith(data map[string]string) *corev1.Secret {
+	secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: "chaos-system", Name: "change-management"}, Data: map[string][]byte{}}
+	for k, v := range data {
+		secret.Data[k] = []byte(v)
+	}
+	return secret
+}
+
+func TestSystemFromSecret(t *testing.T) {
+	system, err := SystemFromSecret(secretWith(map[string]string{"type": "jira", "url": "https://jira.example.com/", "approved-states": "Approved, Implementing"}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	jira, ok := system.(*Jira)
+	if !ok {
+		t.Fatalf("SystemFromSecret() = %T, want *Jira", system)
+	}
+	if jira.url != "https://jira.example.com" || strings.Join(jira.ApprovedStates, "|") != "Approved|Implementing" {
+		t.Errorf("Jira = %+v", jira)
+	}
+
+	system, err = SystemFromSecret(secretWith(map[string]string{"type": "servicenow", "url": "https://acme.service-now.com"}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sn, ok := system.(*ServiceNow); !ok || strings.Join(sn.ApprovedStates, "|") != "approved" {
+		t.Errorf("SystemFromSecret() = %+v, want ServiceNow approving \"approved\"", system)
+	}
+
+	for _, data := range []map[string]string{
+		{"type": "jira"},
+		{"type": "remedy", "url": "https://remedy.example.com"},
+	} {
+		if _, err := SystemFromSecret(secretWith(data)); err == nil {
+			t.Errorf("SystemFromSecret(%v) succeeded", data)
+		}
+	}
+}
+
+func TestServiceNowTicket(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if user, pass, ok := r.BasicAuth(); !ok || user != "chaos" || pass != "s3cret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		if r.URL.Path != "/api/now/table/change_request" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		switch r.URL.Query().Get("sysparm_query") {
+		case "number=CHG0030042":
+			_, _ = w.Write([]byte(`{"result":[{"number":"CHG0030042","approval":"approved",` +
+				`"start_date":"2026-10-15 08:00:00","end_date":"2026-10-15 12:00:00"}]}`))
+		default:
+			_, _ = w.Write([]byte(`{"result":[]}`))
+		}
+	}))
+	defer server.Close()
+
+	system, err := SystemFromSecret(secretWith(map[string]string{
+		"type": "servicenow", "url": server.URL, "username": "chaos", "password": "s3cret",
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ticket, err := system.Ticket(context.Background(), "CHG0030042")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !ticket.Approved || ticket.State != "approved" {
+		t.Errorf("ticket = %+v, want approved", ticket)
+	}
+	if want := time.Date(2026, 10, 15, 8, 0, 0, 0, time.UTC); !ticket.Start.Equal(want) {
+		t.Errorf("Start = %s, want %s", ticket.Start, want)
+	}
+	if ticket.Open(time.Date(2026, 10, 15, 7, 59, 0, 0, time.UTC)) || !ticket.Open(time.Date(2026, 10, 15, 9, 0, 0, 0, time.UTC)) ||
+		ticket.Open(time.Date(2026, 10, 15, 12, 0, 0, 0, time.UTC)) {
+		t.Errorf("Open() does not follow the window %s - %s", ticket.Start, ticket.End)
+	}
+
+	if _, err := system.Ticket(context.Background(), "CHG0099999"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Ticket() of a missing change error = %v, want ErrNotFound", err)
+	}
+	if _, err := system.Ticket(context.Background(), "CHG1^ORnumber!=x"); err == nil {
+		t.Error("Ticket() accepted an ID with query syntax")
+	}
+}
+
+func TestJiraTicket(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer tok" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		switch r.URL.Path {
+		case "/rest/api/2/issue/CHG-1234":
+			if got := r.URL.Query().Get("fields"); got != "status,customfield_10057,customfield_10058" {
+				t.Errorf("fields = %q", got)
+			}
+			_, _ = w.Write([]byte(`{"key":"CHG-1234","fields":{"status":{"name":"Implementing"},` +
+				`"customfield_10057":"2026-10-15T10:00:00.000+0200","customfield_10058":null}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	system, err := SystemFromSecret(secretWith(map[string]string{
+		"type": "jira", "url": server.URL, "token": "tok",
+		"window-start-field": "customfield_10057", "window-end-field": "customfield_10058",
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ticket, err := system.Ticket(context.Background(), "CHG-1234")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ticket.Approved || ticket.State != "Implementing" {
+		t.Errorf("ticket = %+v, want not approved with the default approved states", ticket)
+	}
+	if want := time.Date(2026, 10, 15, 8, 0, 0, 0, time.UTC); !ticket.Start.Equal(want) || !ticket.End.IsZero() {
+		t.Errorf("window = %s - %s, want %s - open", ticket.Start, ticket.End, want)
+	}
+
+	if _, err := system.Ticket(context.Background(), "CHG-9"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Ticket() of a missing issue error = %v, want ErrNotFound", err)
+	}
+}
@@ -0,0 +1,65 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	chaosv1alpha1 "github.com/neogan74/k8s-chaos/api/v1alpha1"
+)
+
+// handleAbort stops an experiment carrying the abort annotation: active injections are reverted
+// and the experiment is completed so it never runs again. It reports whether the experiment was
+// aborted, in which case reconciliation should stop.
+func (r *ChaosExperimentReconciler) handleAbort(ctx context.Context, exp *chaosv1alpha1.ChaosExperiment) (ctrl.Result, bool, error) {
+	requestedBy, ok := exp.Annotations[chaosv1alpha1.AbortAnnotation]
+	if !ok || exp.Status.Phase == phaseCompleted || exp.Status.Phase == phaseFailed {
+		return ctrl.Result{}, false, nil
+	}
+	log := ctrl.LoggerFrom(ctx)
+	log.Info("Aborting experiment", "requestedBy", requestedBy)
+
+	// Measure how far the injections got before they are reverted
+	now := metav1.Now()
+	cancellation, start := cancelledExecution(exp, chaosv1alpha1.CancellationAborted, requestedBy, now.Time)
+	r.revertActiveInjections(ctx, exp)
+
+	exp.Status.CompletedAt = &now
+	exp.Status.Phase = phaseCompleted
+	exp.Status.NextRetryTime = nil
+	exp.Status.Message = "Experiment aborted"
+	if requestedBy != "" {
+		exp.Status.Message = fmt.Sprintf("Experiment aborted by %s", requestedBy)
+	}
+	if err := r.Status().Update(ctx, exp); err != nil {
+		log.Error(err, "Failed to update status for aborted experiment")
+		return ctrl.Result{}, true, err
+	}
+
+	r.Recorder.Event(exp, corev1.EventTypeNormal, "Aborted", exp.Status.Message)
+	if cancellation != nil {
+		if err := r.recordCancellation(ctx, exp, cancellation, start); err != nil {
+			log.Error(err, "Failed to record cancelled execution")
+		}
+	}
+	return ctrl.Result{}, true, nil
+}
@@ -0,0 +1,69 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	chaosv1alpha1 "github.com/neogan74/k8s-chaos/api/v1alpha1"
+)
+
+func TestReconcile_Abort(t *testing.T) {
+	ctx := context.Background()
+	node := &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: "worker-1"},
+		Spec:       corev1.NodeSpec{Unschedulable: true},
+	}
+	exp := &chaosv1alpha1.ChaosExperiment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "drain",
+			Namespace:   "default",
+			Annotations: map[string]string{chaosv1alpha1.AbortAnnotation: "k8s-chaos-api:portal"},
+		},
+		Spec: chaosv1alpha1.ChaosExperimentSpec{
+			Action:    "node-drain",
+			Namespace: "default",
+			Selector:  map[string]string{"pool": "workers"},
+		},
+		Status: chaosv1alpha1.ChaosExperimentStatus{
+			Phase:         phaseRunning,
+			CordonedNodes: []string{"worker-1"},
+		},
+	}
+	r := newReconcilerWithObjects(t, node, exp)
+
+	_, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: client.ObjectKeyFromObject(exp)})
+	require.NoError(t, err)
+
+	stored := fetchExperiment(t, r, exp.Name, exp.Namespace)
+	assert.Equal(t, phaseCompleted, stored.Status.Phase)
+	assert.NotNil(t, stored.Status.CompletedAt)
+	assert.Equal(t, "Experiment aborted by k8s-chaos-api:portal", stored.Status.Message)
+	assert.Empty(t, stored.Status.CordonedNodes)
+
+	uncordoned := &corev1.Node{}
+	require.NoError(t, r.Get(ctx, client.ObjectKey{Name: "worker-1"}, uncordoned))
+	assert.False(t, uncordoned.Spec.Unschedulable, "abort should uncordon nodes drained by the experiment")
+}
@@ -0,0 +1,127 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	chaosv1alpha1 "github.com/neogan74/k8s-chaos/api/v1alpha1"
+	chaosmetrics "github.com/neogan74/k8s-chaos/internal/metrics"
+)
+
+// activeMetricsInterval is how often the active-experiment gauges are recomputed
+const activeMetricsInterval = 15 * time.Second
+
+// activeExperiment is a Running experiment as reported by chaosexperiment_active_experiment
+type activeExperiment struct {
+	Namespace string
+	Name      string
+	Action    string
+}
+
+// activeExperimentsCollector reports the Running experiments from a periodic list of cluster
+// state, so retries and controller restarts cannot skew the gauges. Only the leader refreshes the
+// snapshot; other replicas report nothing, which keeps sums across replicas correct.
+type activeExperimentsCollector struct {
+	reader client.Reader
+
+	mu       sync.Mutex
+	byAction map[string]int
+	running  []activeExperiment
+}
+
+func newActiveExperimentsCollector(reader client.Reader) *activeExperimentsCollector {
+	return &activeExperimentsCollector{reader: reader}
+}
+
+// Describe implements prometheus.Collector
+func (c *activeExperimentsCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- chaosmetrics.ActiveExperimentsDesc
+	ch <- chaosmetrics.ActiveExperimentDesc
+}
+
+// Collect implements prometheus.Collector with the last snapshot
+func (c *activeExperimentsCollector) Collect(ch chan<- prometheus.Metric) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for action, count := range c.byAction {
+		ch <- prometheus.MustNewConstMetric(chaosmetrics.ActiveExperimentsDesc, prometheus.GaugeValue,
+			float64(count), action)
+	}
+	for _, exp := range c.running {
+		ch <- prometheus.MustNewConstMetric(chaosmetrics.ActiveExperimentDesc, prometheus.GaugeValue,
+			1, exp.Namespace, exp.Name, exp.Action)
+	}
+}
+
+// refresh recomputes the snapshot. Every action with an experiment gets a series, at 0 when none
+// of its experiments is running, so rate and absence alerts keep working.
+func (c *activeExperimentsCollector) refresh(ctx context.Context) error {
+	list := &chaosv1alpha1.ChaosExperimentList{}
+	if err := c.reader.List(ctx, list); err != nil {
+		return err
+	}
+	byAction := map[string]int{}
+	var running []activeExperiment
+	for _, exp := range list.Items {
+		if _, seen := byAction[exp.Spec.Action]; !seen {
+			byAction[exp.Spec.Action] = 0
+		}
+		if exp.Status.Phase != phaseRunning {
+			continue
+		}
+		byAction[exp.Spec.Action]++
+		running = append(running, activeExperiment{Namespace: exp.Namespace, Name: exp.Name, Action: exp.Spec.Action})
+	}
+	sort.Slice(running, func(i, j int) bool {
+		if running[i].Namespace != running[j].Namespace {
+			return running[i].Namespace < running[j].Namespace
+		}
+		return running[i].Name < running[j].Name
+	})
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.byAction = byAction
+	c.running = running
+	return nil
+}
+
+// run refreshes the snapshot until ctx is cancelled; as a manager Runnable it only runs on the leader
+func (c *activeExperimentsCollector) run(ctx context.Context) error {
+	log := ctrl.Log.WithName("active-metrics")
+	ticker := time.NewTicker(activeMetricsInterval)
+	defer ticker.Stop()
+	for {
+		if err := c.refresh(ctx); err != nil && !errors.Is(err, context.Canceled) {
+			log.Error(err, "Failed to compute active experiment metrics")
+		}
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
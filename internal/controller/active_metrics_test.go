@@ -0,0 +1,89 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	chaosv1alpha1 "github.com/neogan74/k8s-chaos/api/v1alpha1"
+)
+
+func activeTestExperiment(namespace, name, action, phase string) *chaosv1alpha1.ChaosExperiment {
+	return &chaosv1alpha1.ChaosExperiment{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+		Spec:       chaosv1alpha1.ChaosExperimentSpec{Action: action, Namespace: namespace},
+		Status:     chaosv1alpha1.ChaosExperimentStatus{Phase: phase},
+	}
+}
+
+func TestActiveExperimentsCollector(t *testing.T) {
+	r := newReconcilerWithObjects(t,
+		activeTestExperiment("default", "kill-a", "pod-kill", phaseRunning),
+		activeTestExperiment("staging", "kill-b", "pod-kill", phaseRunning),
+		activeTestExperiment("default", "delay", "pod-delay", phaseCompleted),
+	)
+	c := newActiveExperimentsCollector(r.Client)
+
+	// Nothing is reported before the first refresh, as on a replica that is not the leader
+	require.Equal(t, 0, testutil.CollectAndCount(c))
+
+	require.NoError(t, c.refresh(context.Background()))
+	expected := `
+# HELP chaosexperiment_active Number of currently active chaos experiments
+# TYPE chaosexperiment_active gauge
+chaosexperiment_active{action="pod-delay"} 0
+chaosexperiment_active{action="pod-kill"} 2
+# HELP chaosexperiment_active_experiment Chaos experiments currently in the Running phase (always 1)
+# TYPE chaosexperiment_active_experiment gauge
+chaosexperiment_active_experiment{action="pod-kill",experiment="kill-a",namespace="default"} 1
+chaosexperiment_active_experiment{action="pod-kill",experiment="kill-b",namespace="staging"} 1
+`
+	require.NoError(t, testutil.CollectAndCompare(c, strings.NewReader(expected)))
+}
+
+func TestActiveExperimentsCollector_FollowsClusterState(t *testing.T) {
+	exp := activeTestExperiment("default", "kill", "pod-kill", phaseRunning)
+	r := newReconcilerWithObjects(t, exp)
+	c := newActiveExperimentsCollector(r.Client)
+	ctx := context.Background()
+
+	// Repeated refreshes, like retried reconciles, never count an experiment twice
+	require.NoError(t, c.refresh(ctx))
+	require.NoError(t, c.refresh(ctx))
+	require.Equal(t, 2, testutil.CollectAndCount(c))
+
+	exp.Status.Phase = phaseCompleted
+	require.NoError(t, r.Status().Update(ctx, exp))
+	require.NoError(t, c.refresh(ctx))
+	expected := `
+# HELP chaosexperiment_active Number of currently active chaos experiments
+# TYPE chaosexperiment_active gauge
+chaosexperiment_active{action="pod-kill"} 0
+`
+	require.NoError(t, testutil.CollectAndCompare(c, strings.NewReader(expected)))
+
+	require.NoError(t, r.Delete(ctx, exp, &client.DeleteOptions{}))
+	require.NoError(t, c.refresh(ctx))
+	require.Equal(t, 0, testutil.CollectAndCount(c))
+}
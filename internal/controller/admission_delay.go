@@ -0,0 +1,352 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"math"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	chaosv1alpha1 "github.com/neogan74/k8s-chaos/api/v1alpha1"
+	"github.com/neogan74/k8s-chaos/internal/admissiondelay"
+	chaosduration "github.com/neogan74/k8s-chaos/internal/duration"
+	chaosmetrics "github.com/neogan74/k8s-chaos/internal/metrics"
+)
+
+const (
+	// admissionWebhookPrefix starts the name of every ValidatingWebhookConfiguration admission-delay
+	// registers; nothing else is ever deleted by a RemoveWebhook cleanup
+	admissionWebhookPrefix = "k8s-chaos-admission-delay-"
+	// controllerWebhookName is the controller's own ChaosExperiment validating webhook, whose
+	// service and CA bundle the slow webhook reuses
+	controllerWebhookName = "vchaosexperiment.kb.io"
+	// maxWebhookTimeout is the longest timeout the API server accepts for an admission webhook
+	maxWebhookTimeout = 30
+)
+
+// defaultAdmissionOperations are delayed when spec.admissionDelay.operations is empty
+var defaultAdmissionOperations = []chaosv1alpha1.AdmissionOperation{"CREATE", "UPDATE"}
+
+// handleAdmissionDelay registers a ValidatingWebhookConfiguration for spec.duration that sends
+// requests for spec.admissionDelay.resources in spec.namespace to the controller's webhook server,
+// which holds each one for the delay and then admits it. The webhook fails open, never waits past
+// its timeout, only sees namespaced objects matching spec.selector and is deleted when the run ends.
+func (r *ChaosExperimentReconciler) handleAdmissionDelay(ctx context.Context, exp *chaosv1alpha1.ChaosExperiment) (ctrl.Result, error) {
+	log := ctrl.LoggerFrom(ctx)
+	startTime := time.Now()
+
+	if exp.Status.AdmissionDelayEndsAt != nil {
+		return r.finishAdmissionDelay(ctx, exp)
+	}
+
+	duration, err := r.parseDuration(exp.Spec.Duration)
+	if err != nil {
+		return r.handleExperimentFailure(ctx, exp, &ChaosError{
+			Original:  fmt.Errorf("invalid duration format: %s", exp.Spec.Duration),
+			Type:      ErrorTypeValidation,
+			Operation: "validate admission-delay config",
+		})
+	}
+	if exp.Spec.AdmissionDelay == nil {
+		return r.handleExperimentFailure(ctx, exp, &ChaosError{
+			Original:  fmt.Errorf("admissionDelay must be specified for admission-delay action"),
+			Type:      ErrorTypeValidation,
+			Operation: "validate admission-delay config",
+		})
+	}
+	delay, err := chaosduration.Parse(exp.Spec.AdmissionDelay.Delay)
+	if err != nil || delay <= 0 || delay > chaosv1alpha1.MaxAdmissionDelay || duration > chaosv1alpha1.MaxAdmissionDelayDuration {
+		return r.handleExperimentFailure(ctx, exp, &ChaosError{
+			Original: fmt.Errorf("admission-delay needs a delay of at most %s and a duration of at most %s, got %s and %s",
+				chaosv1alpha1.MaxAdmissionDelay, chaosv1alpha1.MaxAdmissionDelayDuration, exp.Spec.AdmissionDelay.Delay, exp.Spec.Duration),
+			Type:      ErrorTypeValidation,
+			Operation: "validate admission-delay config",
+		})
+	}
+
+	clientConfig, err := r.controllerWebhookClientConfig(ctx)
+	if err != nil {
+		if isPermissionDeniedError(err) {
+			return ctrl.Result{}, r.handlePermissionDenied(ctx, exp, "reading ValidatingWebhookConfigurations for admission-delay", err)
+		}
+		return r.handleExperimentFailure(ctx, exp, WrapK8sError(err, "find controller webhook"))
+	}
+
+	if exp.Spec.DryRun {
+		now := metav1.Now()
+		exp.Status.LastRunTime = &now
+		exp.Status.Message = fmt.Sprintf("DRY RUN: Would delay %s in %s by %s for %s",
+			describeAdmissionDelay(exp.Spec.AdmissionDelay), exp.Spec.Namespace, delay, exp.Spec.Duration)
+		exp.Status.Phase = phaseCompleted
+
+		if err := r.Status().Update(ctx, exp); err != nil {
+			log.Error(err, "Failed to update ChaosExperiment status")
+			return ctrl.Result{}, err
+		}
+
+		log.Info("Dry run completed", "action", "admission-delay", "delay", delay)
+		return ctrl.Result{}, nil
+	}
+
+	now := metav1.Now()
+	endsAt := metav1.NewTime(now.Add(duration))
+	webhook := admissionDelayWebhook(exp, clientConfig, delay, endsAt.Time)
+	if err := r.registerAdmissionWebhook(ctx, webhook); err != nil {
+		chaosmetrics.ExperimentErrors.WithLabelValues("admission-delay", exp.Spec.Namespace, string(WrapK8sError(err, "register webhook").Type)).Inc()
+		if isPermissionDeniedError(err) {
+			return ctrl.Result{}, r.handlePermissionDenied(ctx, exp, "creating the admission-delay ValidatingWebhookConfiguration", err)
+		}
+		return r.handleExperimentFailure(ctx, exp, WrapK8sError(err, "register admission-delay webhook"))
+	}
+	r.cleanups.record(ctx, chaosv1alpha1.PendingCleanup{Operation: chaosv1alpha1.CleanupRemoveWebhook, Webhook: webhook.Name})
+
+	exp.Status.LastRunTime = &now
+	exp.Status.AdmissionDelayEndsAt = &endsAt
+	exp.Status.AdmissionWebhook = webhook.Name
+	exp.Status.Message = fmt.Sprintf("Delaying %s in %s by %s for %s",
+		describeAdmissionDelay(exp.Spec.AdmissionDelay), exp.Spec.Namespace, delay, exp.Spec.Duration)
+	countAffected(exp, 1)
+	if err := r.Status().Update(ctx, exp); err != nil {
+		log.Error(err, "Failed to update ChaosExperiment status")
+		return ctrl.Result{}, err
+	}
+	r.Recorder.Event(exp, corev1.EventTypeWarning, "ChaosAdmissionDelay", exp.Status.Message)
+
+	chaosmetrics.ExperimentsTotal.WithLabelValues("admission-delay", exp.Spec.Namespace, statusSuccess).Inc()
+	chaosmetrics.ExperimentDuration.WithLabelValues("admission-delay", exp.Spec.Namespace).Observe(time.Since(startTime).Seconds())
+
+	return ctrl.Result{RequeueAfter: duration}, nil
+}
+
+// finishAdmissionDelay waits for the end of the current run, then removes the webhook and records
+// what was delayed
+func (r *ChaosExperimentReconciler) finishAdmissionDelay(ctx context.Context, exp *chaosv1alpha1.ChaosExperiment) (ctrl.Result, error) {
+	log := ctrl.LoggerFrom(ctx)
+
+	if remaining := time.Until(exp.Status.AdmissionDelayEndsAt.Time); remaining > 0 {
+		return ctrl.Result{RequeueAfter: remaining}, nil
+	}
+
+	startTime := exp.Status.AdmissionDelayEndsAt.Time
+	if exp.Status.LastRunTime != nil {
+		startTime = exp.Status.LastRunTime.Time
+	}
+	delayed := chaosv1alpha1.ResourceReference{
+		Kind:   "ValidatingWebhookConfiguration",
+		Name:   exp.Status.AdmissionWebhook,
+		Action: "admission-delayed",
+	}
+	if exp.Spec.AdmissionDelay != nil {
+		delayed.Details = fmt.Sprintf("%s in %s delayed by %s",
+			describeAdmissionDelay(exp.Spec.AdmissionDelay), exp.Spec.Namespace, exp.Spec.AdmissionDelay.Delay)
+	}
+
+	r.removeAdmissionDelay(ctx, exp)
+	exp.Status.Message = fmt.Sprintf("Removed admission delay webhook after %s", exp.Spec.Duration)
+	if err := r.Status().Update(ctx, exp); err != nil {
+		log.Error(err, "Failed to update ChaosExperiment status")
+		return ctrl.Result{}, err
+	}
+	log.Info("Admission delay run finished", "webhook", delayed.Name)
+
+	if err := r.createHistoryRecord(ctx, exp, statusSuccess, []chaosv1alpha1.ResourceReference{delayed}, startTime, nil); err != nil {
+		log.Error(err, "Failed to create history record")
+		// Don't fail the experiment if history recording fails
+	}
+
+	// The next round starts one interval after this one did
+	next := r.roundInterval(exp)
+	if exp.Status.LastRunTime != nil {
+		next = max(time.Until(exp.Status.LastRunTime.Add(next)), time.Second)
+	}
+	return ctrl.Result{RequeueAfter: next}, nil
+}
+
+// controllerWebhookClientConfig returns how the API server reaches the controller's webhook server,
+// taken from the controller's own ChaosExperiment webhook. Without it installed the slow webhook
+// would have nothing to call.
+func (r *ChaosExperimentReconciler) controllerWebhookClientConfig(ctx context.Context) (admissionregistrationv1.WebhookClientConfig, error) {
+	configs := &admissionregistrationv1.ValidatingWebhookConfigurationList{}
+	if err := r.liveReader().List(ctx, configs); err != nil {
+		return admissionregistrationv1.WebhookClientConfig{}, err
+	}
+	for _, config := range configs.Items {
+		for _, webhook := range config.Webhooks {
+			if webhook.Name == controllerWebhookName {
+				return *webhook.ClientConfig.DeepCopy(), nil
+			}
+		}
+	}
+	return admissionregistrationv1.WebhookClientConfig{}, &ChaosError{
+		Original:  fmt.Errorf("webhook %s not found; admission-delay needs the controller's webhooks enabled", controllerWebhookName),
+		Type:      ErrorTypeValidation,
+		Operation: "find controller webhook",
+	}
+}
+
+// admissionWebhookName derives the name of an experiment's slow webhook, unique per experiment
+func admissionWebhookName(exp *chaosv1alpha1.ChaosExperiment) string {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(exp.Namespace + "/" + exp.Name))
+	name := exp.Name
+	if len(name) > 200 {
+		name = name[:200]
+	}
+	return fmt.Sprintf("%s%s-%08x", admissionWebhookPrefix, name, h.Sum32())
+}
+
+// admissionDelayWebhook builds the slow webhook of an experiment, calling the controller's webhook
+// server the way clientConfig does, at a path that holds requests for delay until expires
+func admissionDelayWebhook(exp *chaosv1alpha1.ChaosExperiment, clientConfig admissionregistrationv1.WebhookClientConfig, delay time.Duration, expires time.Time) *admissionregistrationv1.ValidatingWebhookConfiguration {
+	path := admissiondelay.PathFor(delay, expires)
+	switch {
+	case clientConfig.Service != nil:
+		clientConfig.Service.Path = &path
+	case clientConfig.URL != nil:
+		if u, err := url.Parse(*clientConfig.URL); err == nil {
+			u.Path = path
+			clientConfig.URL = ptr.To(u.String())
+		}
+	}
+
+	operations := defaultAdmissionOperations
+	if len(exp.Spec.AdmissionDelay.Operations) > 0 {
+		operations = exp.Spec.AdmissionDelay.Operations
+	}
+	ops := make([]admissionregistrationv1.OperationType, 0, len(operations))
+	for _, op := range operations {
+		ops = append(ops, admissionregistrationv1.OperationType(op))
+	}
+
+	return &admissionregistrationv1.ValidatingWebhookConfiguration{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   admissionWebhookName(exp),
+			Labels: experimentLabels(exp),
+		},
+		Webhooks: []admissionregistrationv1.ValidatingWebhook{{
+			Name:                    "admission-delay.chaos.gushchin.dev",
+			ClientConfig:            clientConfig,
+			Rules:                   admissionDelayRules(exp.Spec.AdmissionDelay.Resources, ops),
+			FailurePolicy:           ptr.To(admissionregistrationv1.Ignore),
+			SideEffects:             ptr.To(admissionregistrationv1.SideEffectClassNone),
+			TimeoutSeconds:          ptr.To(int32(min(math.Ceil(delay.Seconds())+2, maxWebhookTimeout))),
+			AdmissionReviewVersions: []string{"v1"},
+			NamespaceSelector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{corev1.LabelMetadataName: exp.Spec.Namespace},
+			},
+			ObjectSelector: &metav1.LabelSelector{MatchLabels: exp.Spec.Selector},
+		}},
+	}
+}
+
+// admissionDelayRules turns "resource" and "resource.group" names into one namespaced rule per
+// API group, sorted by group
+func admissionDelayRules(resources []string, ops []admissionregistrationv1.OperationType) []admissionregistrationv1.RuleWithOperations {
+	byGroup := map[string][]string{}
+	for _, name := range resources {
+		resource, group, _ := strings.Cut(name, ".")
+		byGroup[group] = append(byGroup[group], resource)
+	}
+	groups := make([]string, 0, len(byGroup))
+	for group := range byGroup {
+		groups = append(groups, group)
+	}
+	sort.Strings(groups)
+
+	rules := make([]admissionregistrationv1.RuleWithOperations, 0, len(groups))
+	for _, group := range groups {
+		rules = append(rules, admissionregistrationv1.RuleWithOperations{
+			Operations: ops,
+			Rule: admissionregistrationv1.Rule{
+				APIGroups:   []string{group},
+				APIVersions: []string{"*"},
+				Resources:   byGroup[group],
+				Scope:       ptr.To(admissionregistrationv1.NamespacedScope),
+			},
+		})
+	}
+	return rules
+}
+
+// describeAdmissionDelay names the operations and resources a delay applies to, e.g.
+// "CREATE, UPDATE of configmaps, deployments.apps"
+func describeAdmissionDelay(delay *chaosv1alpha1.AdmissionDelay) string {
+	operations := defaultAdmissionOperations
+	if len(delay.Operations) > 0 {
+		operations = delay.Operations
+	}
+	ops := make([]string, 0, len(operations))
+	for _, op := range operations {
+		ops = append(ops, string(op))
+	}
+	return fmt.Sprintf("%s of %s", strings.Join(ops, ", "), strings.Join(delay.Resources, ", "))
+}
+
+// registerAdmissionWebhook creates the slow webhook. One left behind by an interrupted run of the
+// same experiment is replaced, so its path carries the new expiry.
+func (r *ChaosExperimentReconciler) registerAdmissionWebhook(ctx context.Context, webhook *admissionregistrationv1.ValidatingWebhookConfiguration) error {
+	err := r.writer(ctx).Create(ctx, webhook)
+	if !apierrors.IsAlreadyExists(err) {
+		return err
+	}
+	existing := &admissionregistrationv1.ValidatingWebhookConfiguration{}
+	if err := r.liveReader().Get(ctx, client.ObjectKeyFromObject(webhook), existing); err != nil {
+		return err
+	}
+	existing.Labels = webhook.Labels
+	existing.Webhooks = webhook.Webhooks
+	return r.writer(ctx).Update(ctx, existing)
+}
+
+// removeAdmissionWebhook deletes a slow webhook by name. Names outside admissionWebhookPrefix are
+// refused, so a cleanup task can never remove anyone else's webhook.
+func (r *ChaosExperimentReconciler) removeAdmissionWebhook(ctx context.Context, name string) error {
+	if !strings.HasPrefix(name, admissionWebhookPrefix) {
+		return fmt.Errorf("refusing to delete ValidatingWebhookConfiguration %s not created by admission-delay", name)
+	}
+	webhook := &admissionregistrationv1.ValidatingWebhookConfiguration{ObjectMeta: metav1.ObjectMeta{Name: name}}
+	return client.IgnoreNotFound(r.Delete(ctx, webhook))
+}
+
+// removeAdmissionDelay removes the webhook of the current run. A webhook that cannot be removed is
+// handed to a cleanup task; until then it fails open and stops delaying once the run's expiry in
+// its path has passed.
+func (r *ChaosExperimentReconciler) removeAdmissionDelay(ctx context.Context, exp *chaosv1alpha1.ChaosExperiment) {
+	if name := exp.Status.AdmissionWebhook; name != "" {
+		if err := r.removeAdmissionWebhook(ctx, name); err != nil {
+			ctrl.LoggerFrom(ctx).Error(err, "Failed to remove admission delay webhook", "webhook", name)
+			r.delegateCleanup(ctx, exp, chaosv1alpha1.PendingCleanup{
+				Operation: chaosv1alpha1.CleanupRemoveWebhook, Webhook: name,
+			})
+		}
+	}
+	exp.Status.AdmissionWebhook = ""
+	exp.Status.AdmissionDelayEndsAt = nil
+}
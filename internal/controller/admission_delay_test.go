@@ -0,0 +1,192 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	chaosv1alpha1 "github.com/neogan74/k8s-chaos/api/v1alpha1"
+	"github.com/neogan74/k8s-chaos/internal/admissiondelay"
+)
+
+func admissionDelayFixtures() (*chaosv1alpha1.ChaosExperiment, *admissionregistrationv1.ValidatingWebhookConfiguration) {
+	exp := &chaosv1alpha1.ChaosExperiment{
+		ObjectMeta: metav1.ObjectMeta{Name: "slow-admission", Namespace: "chaos", UID: "7c1e4b90"},
+		Spec: chaosv1alpha1.ChaosExperimentSpec{
+			Action:    "admission-delay",
+			Namespace: "shop",
+			Selector:  map[string]string{"app": "checkout"},
+			Duration:  "10m",
+			AdmissionDelay: &chaosv1alpha1.AdmissionDelay{
+				Delay:     "5s",
+				Resources: []string{"deployments.apps", "configmaps"},
+			},
+		},
+	}
+	controllerWebhook := &admissionregistrationv1.ValidatingWebhookConfiguration{
+		ObjectMeta: metav1.ObjectMeta{Name: "k8s-chaos-validating-webhook-configuration"},
+		Webhooks: []admissionregistrationv1.ValidatingWebhook{{
+			Name: controllerWebhookName,
+			ClientConfig: admissionregistrationv1.WebhookClientConfig{
+				Service: &admissionregistrationv1.ServiceReference{
+					Namespace: "k8s-chaos-system", Name: "k8s-chaos-webhook-service",
+					Path: ptr.To("/validate-chaos-gushchin-dev-v1alpha1-chaosexperiment"),
+				},
+				CABundle: []byte("ca"),
+			},
+		}},
+	}
+	return exp, controllerWebhook
+}
+
+func TestHandleAdmissionDelay_RegistersAndRemoves(t *testing.T) {
+	ctx := context.Background()
+	exp, controllerWebhook := admissionDelayFixtures()
+	r := newReconcilerWithObjects(t, exp, controllerWebhook)
+
+	result, err := r.handleAdmissionDelay(ctx, exp)
+	require.NoError(t, err)
+	assert.Equal(t, 10*time.Minute, result.RequeueAfter)
+
+	exp = fetchExperiment(t, r, exp.Name, exp.Namespace)
+	require.NotNil(t, exp.Status.AdmissionDelayEndsAt)
+	assert.Equal(t, admissionWebhookName(exp), exp.Status.AdmissionWebhook)
+	assert.Equal(t, "Delaying CREATE, UPDATE of deployments.apps, configmaps in shop by 5s for 10m", exp.Status.Message)
+
+	created := &admissionregistrationv1.ValidatingWebhookConfiguration{}
+	require.NoError(t, r.Get(ctx, client.ObjectKey{Name: exp.Status.AdmissionWebhook}, created))
+	assert.Equal(t, "7c1e4b90", created.Labels[chaosv1alpha1.ExperimentUIDLabel])
+	require.Len(t, created.Webhooks, 1)
+	webhook := created.Webhooks[0]
+	assert.Equal(t, admissionregistrationv1.Ignore, *webhook.FailurePolicy)
+	assert.Equal(t, int32(7), *webhook.TimeoutSeconds)
+	assert.Equal(t, []byte("ca"), webhook.ClientConfig.CABundle)
+	assert.Equal(t, "k8s-chaos-webhook-service", webhook.ClientConfig.Service.Name)
+	assert.Equal(t, admissiondelay.PathFor(5*time.Second, exp.Status.AdmissionDelayEndsAt.Time), *webhook.ClientConfig.Service.Path)
+	assert.Equal(t, map[string]string{"kubernetes.io/metadata.name": "shop"}, webhook.NamespaceSelector.MatchLabels)
+	assert.Equal(t, exp.Spec.Selector, webhook.ObjectSelector.MatchLabels)
+	require.Len(t, webhook.Rules, 2)
+	assert.Equal(t, []string{""}, webhook.Rules[0].APIGroups)
+	assert.Equal(t, []string{"configmaps"}, webhook.Rules[0].Resources)
+	assert.Equal(t, []string{"apps"}, webhook.Rules[1].APIGroups)
+	assert.Equal(t, admissionregistrationv1.NamespacedScope, *webhook.Rules[1].Scope)
+	assert.Equal(t, []admissionregistrationv1.OperationType{"CREATE", "UPDATE"}, webhook.Rules[1].Operations)
+
+	// The controller's own webhook is left alone
+	require.NoError(t, r.Get(ctx, client.ObjectKeyFromObject(controllerWebhook), &admissionregistrationv1.ValidatingWebhookConfiguration{}))
+
+	ended := metav1.NewTime(time.Now().Add(-time.Second))
+	exp.Status.AdmissionDelayEndsAt = &ended
+	_, err = r.handleAdmissionDelay(ctx, exp)
+	require.NoError(t, err)
+
+	err = r.Get(ctx, client.ObjectKeyFromObject(created), &admissionregistrationv1.ValidatingWebhookConfiguration{})
+	assert.True(t, apierrors.IsNotFound(err), "webhook should be removed, got %v", err)
+	updated := fetchExperiment(t, r, exp.Name, exp.Namespace)
+	assert.Nil(t, updated.Status.AdmissionDelayEndsAt)
+	assert.Empty(t, updated.Status.AdmissionWebhook)
+
+	history := &chaosv1alpha1.ChaosExperimentHistoryList{}
+	require.NoError(t, r.List(ctx, history))
+	require.Len(t, history.Items, 1)
+	require.Len(t, history.Items[0].Spec.AffectedResources, 1)
+	assert.Equal(t, "CREATE, UPDATE of deployments.apps, configmaps in shop delayed by 5s",
+		history.Items[0].Spec.AffectedResources[0].Details)
+}
+
+func TestHandleAdmissionDelay_RequiresControllerWebhook(t *testing.T) {
+	ctx := context.Background()
+	exp, _ := admissionDelayFixtures()
+	r := newReconcilerWithObjects(t, exp)
+
+	_, _ = r.handleAdmissionDelay(ctx, exp)
+
+	updated := fetchExperiment(t, r, exp.Name, exp.Namespace)
+	assert.Equal(t, 1, updated.Status.RetryCount)
+	assert.Contains(t, updated.Status.Message, "webhook vchaosexperiment.kb.io not found")
+	configs := &admissionregistrationv1.ValidatingWebhookConfigurationList{}
+	require.NoError(t, r.List(ctx, configs))
+	assert.Empty(t, configs.Items)
+}
+
+func TestHandleAdmissionDelay_DryRun(t *testing.T) {
+	ctx := context.Background()
+	exp, controllerWebhook := admissionDelayFixtures()
+	exp.Spec.DryRun = true
+	exp.Spec.AdmissionDelay.Operations = []chaosv1alpha1.AdmissionOperation{"DELETE"}
+	r := newReconcilerWithObjects(t, exp, controllerWebhook)
+
+	_, err := r.handleAdmissionDelay(ctx, exp)
+	require.NoError(t, err)
+
+	updated := fetchExperiment(t, r, exp.Name, exp.Namespace)
+	assert.Equal(t, phaseCompleted, updated.Status.Phase)
+	assert.Equal(t, "DRY RUN: Would delay DELETE of deployments.apps, configmaps in shop by 5s for 10m", updated.Status.Message)
+	configs := &admissionregistrationv1.ValidatingWebhookConfigurationList{}
+	require.NoError(t, r.List(ctx, configs))
+	assert.Len(t, configs.Items, 1)
+}
+
+func TestRemoveAdmissionDelay_OnRevert(t *testing.T) {
+	ctx := context.Background()
+	exp, controllerWebhook := admissionDelayFixtures()
+	r := newReconcilerWithObjects(t, exp, controllerWebhook)
+
+	_, err := r.handleAdmissionDelay(ctx, exp)
+	require.NoError(t, err)
+	updated := fetchExperiment(t, r, exp.Name, exp.Namespace)
+
+	r.revertActiveInjections(ctx, updated)
+
+	configs := &admissionregistrationv1.ValidatingWebhookConfigurationList{}
+	require.NoError(t, r.List(ctx, configs))
+	require.Len(t, configs.Items, 1)
+	assert.Equal(t, controllerWebhook.Name, configs.Items[0].Name)
+	assert.Empty(t, updated.Status.AdmissionWebhook)
+	assert.Nil(t, updated.Status.AdmissionDelayEndsAt)
+}
+
+func TestRemoveAdmissionWebhook_RefusesForeignWebhooks(t *testing.T) {
+	_, controllerWebhook := admissionDelayFixtures()
+	r := newReconcilerWithObjects(t, controllerWebhook)
+
+	err := r.removeAdmissionWebhook(context.Background(), controllerWebhook.Name)
+	require.Error(t, err)
+	require.NoError(t, r.Get(context.Background(), client.ObjectKeyFromObject(controllerWebhook), &admissionregistrationv1.ValidatingWebhookConfiguration{}))
+
+	assert.NoError(t, r.removeAdmissionWebhook(context.Background(), admissionWebhookPrefix+"gone-0000"))
+}
+
+func TestAdmissionWebhookName(t *testing.T) {
+	exp, _ := admissionDelayFixtures()
+	name := admissionWebhookName(exp)
+	assert.True(t, strings.HasPrefix(name, admissionWebhookPrefix+"slow-admission-"))
+
+	exp.Name = strings.Repeat("a", 253)
+	assert.LessOrEqual(t, len(admissionWebhookName(exp)), 253)
+}
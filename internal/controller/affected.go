@@ -0,0 +1,104 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	chaosv1alpha1 "github.com/neogan74/k8s-chaos/api/v1alpha1"
+)
+
+// trackAffectedPod adds a pod to the affected pods list in the experiment status and counts it in
+// the affected summary. Both stay bounded: past MaxAffectedPodRefs the oldest refs are handed to
+// ChaosCleanupTasks that stop their containers once the experiment stops running, and past
+// MaxAffectedSummaryItems pods are only counted.
+func (r *ChaosExperimentReconciler) trackAffectedPod(ctx context.Context, exp *chaosv1alpha1.ChaosExperiment, namespace, podName, containerName string) {
+	podRef := fmt.Sprintf("%s/%s:%s", namespace, podName, containerName)
+
+	// Check if already tracked (avoid duplicates)
+	seenPod := false
+	for _, existing := range exp.Status.AffectedPods {
+		if existing == podRef {
+			return
+		}
+		if strings.HasPrefix(existing, namespace+"/"+podName+":") {
+			seenPod = true
+		}
+	}
+
+	summary := exp.Status.AffectedSummary
+	if summary == nil {
+		summary = &chaosv1alpha1.AffectedSummary{}
+		exp.Status.AffectedSummary = summary
+	}
+	summary.Injections++
+	listed := false
+	for i := range summary.Items {
+		if summary.Items[i].Namespace == namespace && summary.Items[i].Name == podName {
+			summary.Items[i].Injections++
+			listed, seenPod = true, true
+			break
+		}
+	}
+	if !seenPod {
+		summary.Pods++
+	}
+	if !listed {
+		if len(summary.Items) < chaosv1alpha1.MaxAffectedSummaryItems {
+			summary.Items = append(summary.Items, chaosv1alpha1.AffectedPod{Namespace: namespace, Name: podName, Injections: 1})
+		} else {
+			summary.Truncated = true
+		}
+	}
+
+	exp.Status.AffectedPods = append(exp.Status.AffectedPods, podRef)
+	if overflow := len(exp.Status.AffectedPods) - chaosv1alpha1.MaxAffectedPodRefs; overflow > 0 {
+		for _, ref := range exp.Status.AffectedPods[:overflow] {
+			r.deferAffectedPodCleanup(ctx, exp, ref)
+		}
+		exp.Status.AffectedPods = exp.Status.AffectedPods[overflow:]
+		summary.Truncated = true
+	}
+}
+
+// deferAffectedPodCleanup hands the revert of a ref that no longer fits status.affectedPods to a
+// cleanup task. pod-failure refs name the target containers themselves, there is nothing to stop.
+func (r *ChaosExperimentReconciler) deferAffectedPodCleanup(ctx context.Context, exp *chaosv1alpha1.ChaosExperiment, ref string) {
+	key, containerName, ok := parseAffectedPodRef(ref)
+	if !ok || exp.Spec.Action == "pod-failure" {
+		return
+	}
+	cleanup := chaosv1alpha1.PendingCleanup{
+		Operation: chaosv1alpha1.CleanupStopContainer,
+		Pod:       key.String(),
+		Container: containerName,
+	}
+	if err := r.createDeferredCleanupTask(ctx, exp, cleanup); err != nil {
+		ctrl.LoggerFrom(ctx).Error(err, "Failed to hand off revert of an affected pod past the status limit",
+			"pod", cleanup.Pod, "container", containerName)
+	}
+}
+
+// clearAffectedPods forgets the injections of the experiment once they were cleaned up
+func clearAffectedPods(exp *chaosv1alpha1.ChaosExperiment) {
+	exp.Status.AffectedPods = nil
+	exp.Status.AffectedSummary = nil
+}
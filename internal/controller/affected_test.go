@@ -0,0 +1,93 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	chaosv1alpha1 "github.com/neogan74/k8s-chaos/api/v1alpha1"
+)
+
+func TestTrackAffectedPod_Summary(t *testing.T) {
+	ctx := context.Background()
+	r := &ChaosExperimentReconciler{}
+	exp := &chaosv1alpha1.ChaosExperiment{}
+
+	r.trackAffectedPod(ctx, exp, "shop", "web-1", "chaos-cpu-1")
+	r.trackAffectedPod(ctx, exp, "shop", "web-1", "chaos-cpu-1")
+	r.trackAffectedPod(ctx, exp, "shop", "web-1", "chaos-cpu-2")
+	r.trackAffectedPod(ctx, exp, "shop", "web-2", "chaos-cpu-3")
+
+	assert.Equal(t, []string{"shop/web-1:chaos-cpu-1", "shop/web-1:chaos-cpu-2", "shop/web-2:chaos-cpu-3"}, exp.Status.AffectedPods)
+	require.NotNil(t, exp.Status.AffectedSummary)
+	assert.Equal(t, &chaosv1alpha1.AffectedSummary{
+		Pods:       2,
+		Injections: 3,
+		Items: []chaosv1alpha1.AffectedPod{
+			{Namespace: "shop", Name: "web-1", Injections: 2},
+			{Namespace: "shop", Name: "web-2", Injections: 1},
+		},
+	}, exp.Status.AffectedSummary)
+
+	clearAffectedPods(exp)
+	assert.Nil(t, exp.Status.AffectedPods)
+	assert.Nil(t, exp.Status.AffectedSummary)
+}
+
+func TestTrackAffectedPod_Bounded(t *testing.T) {
+	ctx := context.Background()
+	exp := &chaosv1alpha1.ChaosExperiment{
+		ObjectMeta: metav1.ObjectMeta{Name: "cpu-stress", Namespace: "chaos", UID: "exp-uid"},
+		Spec:       chaosv1alpha1.ChaosExperimentSpec{Action: "pod-cpu-stress", Namespace: "shop"},
+	}
+	r := newReconcilerWithObjects(t, exp)
+
+	// Three rounds over the largest allowed selection
+	for round := 0; round < 3; round++ {
+		for i := 0; i < chaosv1alpha1.MaxAffectedPodRefs; i++ {
+			r.trackAffectedPod(ctx, exp, "shop", fmt.Sprintf("web-%d", i), fmt.Sprintf("chaos-cpu-%d", round))
+		}
+	}
+
+	summary := exp.Status.AffectedSummary
+	require.NotNil(t, summary)
+	assert.Len(t, exp.Status.AffectedPods, chaosv1alpha1.MaxAffectedPodRefs)
+	assert.Equal(t, "shop/web-0:chaos-cpu-2", exp.Status.AffectedPods[0], "oldest refs are handed off first")
+
+	// The refs that did not fit are stopped by cleanup tasks once the experiment stops running
+	tasks := &chaosv1alpha1.ChaosCleanupTaskList{}
+	require.NoError(t, r.List(ctx, tasks))
+	require.Len(t, tasks.Items, 2*chaosv1alpha1.MaxAffectedPodRefs)
+	for _, task := range tasks.Items {
+		assert.Equal(t, chaosv1alpha1.CleanupStopContainer, task.Spec.Operation)
+		assert.True(t, task.Spec.AfterExperiment)
+		assert.NotEqual(t, "chaos-cpu-2", task.Spec.Container)
+	}
+	assert.True(t, controllerutil.ContainsFinalizer(exp, cleanupTaskFinalizer))
+	assert.Len(t, summary.Items, chaosv1alpha1.MaxAffectedSummaryItems)
+	assert.Equal(t, 3, summary.Items[0].Injections)
+	assert.Equal(t, 3*chaosv1alpha1.MaxAffectedPodRefs, summary.Injections)
+	assert.Equal(t, chaosv1alpha1.MaxAffectedPodRefs, summary.Pods)
+	assert.True(t, summary.Truncated)
+}
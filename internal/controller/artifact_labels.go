@@ -0,0 +1,117 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	chaosv1alpha1 "github.com/neogan74/k8s-chaos/api/v1alpha1"
+)
+
+// experimentLabels returns the labels tying an artifact to the experiment that created or touched
+// it, so `-l chaos.gushchin.dev/experiment-uid=<uid>` inventories everything one run affected
+func experimentLabels(exp *chaosv1alpha1.ChaosExperiment) map[string]string {
+	return map[string]string{
+		chaosv1alpha1.ExperimentLabel:    exp.Name,
+		chaosv1alpha1.ExperimentUIDLabel: string(exp.UID),
+		chaosv1alpha1.ActionLabel:        exp.Spec.Action,
+	}
+}
+
+// artifactsKey is the context key of the experiment whose artifacts a reconcile marks
+type artifactsKey struct{}
+
+// withArtifactMarking returns ctx marking the pods and nodes the executors touch as artifacts
+// of exp. Like the actor, it travels in the context so the injection helpers keep their signatures.
+func withArtifactMarking(ctx context.Context, exp *chaosv1alpha1.ChaosExperiment) context.Context {
+	return context.WithValue(ctx, artifactsKey{}, exp)
+}
+
+// artifactExperiment returns the experiment artifacts are marked for, or nil outside a reconcile
+func artifactExperiment(ctx context.Context) *chaosv1alpha1.ChaosExperiment {
+	exp, _ := ctx.Value(artifactsKey{}).(*chaosv1alpha1.ChaosExperiment)
+	return exp
+}
+
+// markInjectedPod labels a pod that received an ephemeral container and records the container
+// name. Ephemeral containers cannot be removed, so the marks stay with the pod.
+func (r *ChaosExperimentReconciler) markInjectedPod(ctx context.Context, pod *corev1.Pod, containerName string) error {
+	exp := artifactExperiment(ctx)
+	if exp == nil {
+		return nil
+	}
+	current := &corev1.Pod{}
+	if err := r.Get(ctx, client.ObjectKeyFromObject(pod), current); err != nil {
+		return fmt.Errorf("failed to get pod to label: %w", err)
+	}
+	patch := client.MergeFrom(current.DeepCopy())
+	if current.Labels == nil {
+		current.Labels = map[string]string{}
+	}
+	for key, value := range experimentLabels(exp) {
+		current.Labels[key] = value
+	}
+	var containers []string
+	if value := current.Annotations[chaosv1alpha1.EphemeralContainersAnnotation]; value != "" {
+		containers = strings.Split(value, ",")
+	}
+	if !slices.Contains(containers, containerName) {
+		containers = append(containers, containerName)
+	}
+	if current.Annotations == nil {
+		current.Annotations = map[string]string{}
+	}
+	current.Annotations[chaosv1alpha1.EphemeralContainersAnnotation] = strings.Join(containers, ",")
+	if err := r.writer(ctx).Patch(ctx, current, patch); err != nil {
+		return fmt.Errorf("failed to label pod %s: %w", pod.Name, err)
+	}
+	return nil
+}
+
+// markCordonedNode sets the experiment labels and cordoned-by annotation on a node about to be
+// cordoned; the caller persists them with the cordon itself
+func markCordonedNode(ctx context.Context, node *corev1.Node) {
+	exp := artifactExperiment(ctx)
+	if exp == nil {
+		return
+	}
+	if node.Labels == nil {
+		node.Labels = map[string]string{}
+	}
+	for key, value := range experimentLabels(exp) {
+		node.Labels[key] = value
+	}
+	if node.Annotations == nil {
+		node.Annotations = map[string]string{}
+	}
+	node.Annotations[chaosv1alpha1.CordonedByAnnotation] = exp.Namespace + "/" + exp.Name
+}
+
+// unmarkCordonedNode removes what markCordonedNode set, so uncordoned nodes no longer show up as
+// artifacts of the experiment
+func unmarkCordonedNode(node *corev1.Node) {
+	for key := range experimentLabels(&chaosv1alpha1.ChaosExperiment{}) {
+		delete(node.Labels, key)
+	}
+	delete(node.Annotations, chaosv1alpha1.CordonedByAnnotation)
+}
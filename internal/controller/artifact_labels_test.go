@@ -0,0 +1,103 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	chaosv1alpha1 "github.com/neogan74/k8s-chaos/api/v1alpha1"
+)
+
+func artifactTestExperiment(action string) *chaosv1alpha1.ChaosExperiment {
+	return &chaosv1alpha1.ChaosExperiment{
+		ObjectMeta: metav1.ObjectMeta{Name: "stress-web", Namespace: "chaos", UID: types.UID("uid-1")},
+		Spec:       chaosv1alpha1.ChaosExperimentSpec{Action: action},
+	}
+}
+
+func TestUpdatePodWithEphemeralContainer_LabelsPod(t *testing.T) {
+	pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{
+		Name:      "web-1",
+		Namespace: "shop",
+		Labels:    map[string]string{"app": "web"},
+	}}
+	r := newReconcilerWithObjects(t, pod)
+	ctx := withArtifactMarking(context.Background(), artifactTestExperiment("pod-cpu-stress"))
+
+	for _, name := range []string{"chaos-cpu-1", "chaos-cpu-2", "chaos-cpu-2"} {
+		container := corev1.EphemeralContainer{EphemeralContainerCommon: corev1.EphemeralContainerCommon{Name: name, Image: "busybox"}}
+		require.NoError(t, r.updatePodWithEphemeralContainer(ctx, pod, container))
+	}
+
+	updated := &corev1.Pod{}
+	require.NoError(t, r.Get(ctx, client.ObjectKeyFromObject(pod), updated))
+	assert.Equal(t, "web", updated.Labels["app"])
+	assert.Equal(t, "stress-web", updated.Labels[chaosv1alpha1.ExperimentLabel])
+	assert.Equal(t, "uid-1", updated.Labels[chaosv1alpha1.ExperimentUIDLabel])
+	assert.Equal(t, "pod-cpu-stress", updated.Labels[chaosv1alpha1.ActionLabel])
+	assert.Equal(t, "chaos-cpu-1,chaos-cpu-2", updated.Annotations[chaosv1alpha1.EphemeralContainersAnnotation])
+}
+
+func TestCordonNode_MarksAndUncordonUnmarks(t *testing.T) {
+	node := &corev1.Node{ObjectMeta: metav1.ObjectMeta{
+		Name:   "worker-1",
+		Labels: map[string]string{"pool": "spot"},
+	}}
+	r := newReconcilerWithObjects(t, node)
+	ctx := withArtifactMarking(context.Background(), artifactTestExperiment("node-drain"))
+
+	_, err := r.cordonNode(ctx, node)
+	require.NoError(t, err)
+
+	cordoned := &corev1.NodeList{}
+	require.NoError(t, r.List(ctx, cordoned, client.MatchingLabels{chaosv1alpha1.ExperimentUIDLabel: "uid-1"}))
+	require.Len(t, cordoned.Items, 1)
+	assert.Equal(t, "chaos/stress-web", cordoned.Items[0].Annotations[chaosv1alpha1.CordonedByAnnotation])
+
+	require.NoError(t, r.uncordonNode(ctx, "worker-1"))
+
+	updated := &corev1.Node{}
+	require.NoError(t, r.Get(ctx, types.NamespacedName{Name: "worker-1"}, updated))
+	assert.False(t, updated.Spec.Unschedulable)
+	assert.Equal(t, map[string]string{"pool": "spot"}, updated.Labels)
+	assert.NotContains(t, updated.Annotations, chaosv1alpha1.CordonedByAnnotation)
+}
+
+func TestCordonNode_AlreadyCordonedIsNotMarked(t *testing.T) {
+	node := &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: "worker-1"},
+		Spec:       corev1.NodeSpec{Unschedulable: true},
+	}
+	r := newReconcilerWithObjects(t, node)
+	ctx := withArtifactMarking(context.Background(), artifactTestExperiment("node-drain"))
+
+	wasCordoned, err := r.cordonNode(ctx, node)
+	require.NoError(t, err)
+	assert.True(t, wasCordoned)
+
+	updated := &corev1.Node{}
+	require.NoError(t, r.Get(ctx, types.NamespacedName{Name: "worker-1"}, updated))
+	assert.NotContains(t, updated.Labels, chaosv1alpha1.ExperimentLabel)
+}
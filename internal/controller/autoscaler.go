@@ -0,0 +1,185 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	corev1 "k8s.io/api/core/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	chaosv1alpha1 "github.com/neogan74/k8s-chaos/api/v1alpha1"
+)
+
+const (
+	autoscalerPolicyHoldScaleDown = "HoldScaleDown"
+
+	// annotationScaleDownHeldBy marks an HPA whose scale-down was disabled, with the owning experiment as "namespace/name"
+	annotationScaleDownHeldBy = "chaos.gushchin.dev/scale-down-held-by"
+	// annotationOriginalScaleDown stores the HPA's scale-down rules as JSON so they can be restored
+	annotationOriginalScaleDown = "chaos.gushchin.dev/original-scale-down"
+)
+
+// observeAutoscalers records the replica counts of the HorizontalPodAutoscalers scaling the target
+// workloads in exp.Status.Autoscalers and, with the HoldScaleDown policy, disables their scale-down.
+// Autoscaler errors are logged rather than failing the experiment: they affect the measurement,
+// not the injected fault.
+func (r *ChaosExperimentReconciler) observeAutoscalers(ctx context.Context, exp *chaosv1alpha1.ChaosExperiment, targets []corev1.Pod) {
+	log := ctrl.LoggerFrom(ctx)
+
+	hpas := &autoscalingv2.HorizontalPodAutoscalerList{}
+	if err := r.List(ctx, hpas, client.InNamespace(exp.Spec.Namespace)); err != nil {
+		log.Error(err, "Failed to list horizontal pod autoscalers", "namespace", exp.Spec.Namespace)
+		return
+	}
+
+	targeted := map[string]bool{}
+	for i := range targets {
+		workload := workloadOf(&targets[i])
+		targeted[workload.kind+"/"+workload.name] = true
+	}
+
+	for i := range hpas.Items {
+		hpa := &hpas.Items[i]
+		target := hpa.Spec.ScaleTargetRef.Kind + "/" + hpa.Spec.ScaleTargetRef.Name
+		activity := findAutoscalerActivity(exp, hpa.Name)
+		if activity == nil {
+			if !targeted[target] {
+				continue
+			}
+			exp.Status.Autoscalers = append(exp.Status.Autoscalers, chaosv1alpha1.AutoscalerActivity{
+				Name:            hpa.Name,
+				Target:          target,
+				InitialReplicas: hpa.Status.CurrentReplicas,
+			})
+			activity = &exp.Status.Autoscalers[len(exp.Status.Autoscalers)-1]
+		}
+		activity.CurrentReplicas = hpa.Status.CurrentReplicas
+		if activity.CurrentReplicas > activity.PeakReplicas {
+			activity.PeakReplicas = activity.CurrentReplicas
+		}
+
+		if exp.Spec.AutoscalerPolicy != autoscalerPolicyHoldScaleDown || activity.ScaleDownHeld {
+			continue
+		}
+		if err := r.holdScaleDown(ctx, exp, hpa); err != nil {
+			log.Error(err, "Failed to hold autoscaler scale-down", "hpa", hpa.Name)
+			continue
+		}
+		activity.ScaleDownHeld = true
+		r.Recorder.Eventf(exp, corev1.EventTypeNormal, "ScaleDownHeld",
+			"Disabled scale-down of HorizontalPodAutoscaler %s (%s) for the duration of the experiment", hpa.Name, target)
+	}
+}
+
+// findAutoscalerActivity returns the status entry for the named HPA, or nil if it is not tracked yet
+func findAutoscalerActivity(exp *chaosv1alpha1.ChaosExperiment, name string) *chaosv1alpha1.AutoscalerActivity {
+	for i := range exp.Status.Autoscalers {
+		if exp.Status.Autoscalers[i].Name == name {
+			return &exp.Status.Autoscalers[i]
+		}
+	}
+	return nil
+}
+
+// holdScaleDown disables scale-down on an HPA, saving its previous scale-down rules in an annotation
+func (r *ChaosExperimentReconciler) holdScaleDown(ctx context.Context, exp *chaosv1alpha1.ChaosExperiment, hpa *autoscalingv2.HorizontalPodAutoscaler) error {
+	if hpa.Annotations[annotationScaleDownHeldBy] != "" {
+		return fmt.Errorf("scale-down is already held by %s", hpa.Annotations[annotationScaleDownHeldBy])
+	}
+
+	var original *autoscalingv2.HPAScalingRules
+	if hpa.Spec.Behavior != nil {
+		original = hpa.Spec.Behavior.ScaleDown
+	}
+	saved, err := json.Marshal(original)
+	if err != nil {
+		return fmt.Errorf("failed to save scale-down rules: %w", err)
+	}
+
+	if hpa.Annotations == nil {
+		hpa.Annotations = map[string]string{}
+	}
+	hpa.Annotations[annotationScaleDownHeldBy] = exp.Namespace + "/" + exp.Name
+	hpa.Annotations[annotationOriginalScaleDown] = string(saved)
+	if hpa.Spec.Behavior == nil {
+		hpa.Spec.Behavior = &autoscalingv2.HorizontalPodAutoscalerBehavior{}
+	}
+	disabled := autoscalingv2.DisabledPolicySelect
+	hpa.Spec.Behavior.ScaleDown = &autoscalingv2.HPAScalingRules{SelectPolicy: &disabled}
+
+	if err := r.writer(ctx).Update(ctx, hpa); err != nil {
+		return fmt.Errorf("failed to update horizontal pod autoscaler: %w", err)
+	}
+	return nil
+}
+
+// releaseAutoscalers restores the scale-down rules of every HPA held by this experiment. The
+// recorded replica counts are kept in status as the outcome of the experiment.
+func (r *ChaosExperimentReconciler) releaseAutoscalers(ctx context.Context, exp *chaosv1alpha1.ChaosExperiment) {
+	log := ctrl.LoggerFrom(ctx)
+
+	for i := range exp.Status.Autoscalers {
+		activity := &exp.Status.Autoscalers[i]
+		if !activity.ScaleDownHeld {
+			continue
+		}
+		if err := r.restoreScaleDown(ctx, exp, activity.Name); err != nil {
+			log.Error(err, "Failed to restore autoscaler scale-down", "hpa", activity.Name)
+			// Continue with other autoscalers even if one fails
+			continue
+		}
+		activity.ScaleDownHeld = false
+		r.Recorder.Eventf(exp, corev1.EventTypeNormal, "ScaleDownRestored",
+			"Restored scale-down of HorizontalPodAutoscaler %s", activity.Name)
+	}
+}
+
+// restoreScaleDown puts back the scale-down rules saved by holdScaleDown
+func (r *ChaosExperimentReconciler) restoreScaleDown(ctx context.Context, exp *chaosv1alpha1.ChaosExperiment, name string) error {
+	hpa := &autoscalingv2.HorizontalPodAutoscaler{}
+	if err := r.Get(ctx, client.ObjectKey{Namespace: exp.Spec.Namespace, Name: name}, hpa); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+
+	// Leave the HPA alone if someone else took it over in the meantime
+	if hpa.Annotations[annotationScaleDownHeldBy] != exp.Namespace+"/"+exp.Name {
+		return nil
+	}
+
+	var original *autoscalingv2.HPAScalingRules
+	if err := json.Unmarshal([]byte(hpa.Annotations[annotationOriginalScaleDown]), &original); err != nil {
+		return fmt.Errorf("failed to parse saved scale-down rules: %w", err)
+	}
+	if hpa.Spec.Behavior != nil {
+		hpa.Spec.Behavior.ScaleDown = original
+		if hpa.Spec.Behavior.ScaleUp == nil && original == nil {
+			hpa.Spec.Behavior = nil
+		}
+	}
+	delete(hpa.Annotations, annotationScaleDownHeldBy)
+	delete(hpa.Annotations, annotationOriginalScaleDown)
+
+	if err := r.Update(ctx, hpa); err != nil {
+		return fmt.Errorf("failed to update horizontal pod autoscaler: %w", err)
+	}
+	return nil
+}
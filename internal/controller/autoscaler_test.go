@@ -0,0 +1,124 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	chaosv1alpha1 "github.com/neogan74/k8s-chaos/api/v1alpha1"
+)
+
+func testHPA(name, deployment string, replicas int32, behavior *autoscalingv2.HorizontalPodAutoscalerBehavior) *autoscalingv2.HorizontalPodAutoscaler {
+	return &autoscalingv2.HorizontalPodAutoscaler{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+		Spec: autoscalingv2.HorizontalPodAutoscalerSpec{
+			ScaleTargetRef: autoscalingv2.CrossVersionObjectReference{APIVersion: "apps/v1", Kind: "Deployment", Name: deployment},
+			MaxReplicas:    10,
+			Behavior:       behavior,
+		},
+		Status: autoscalingv2.HorizontalPodAutoscalerStatus{CurrentReplicas: replicas},
+	}
+}
+
+func TestObserveAutoscalers_HoldAndRelease(t *testing.T) {
+	ctx := context.Background()
+	window := ptr.To[int32](60)
+	webHPA := testHPA("web", "web", 2, &autoscalingv2.HorizontalPodAutoscalerBehavior{
+		ScaleDown: &autoscalingv2.HPAScalingRules{StabilizationWindowSeconds: window},
+	})
+	otherHPA := testHPA("api", "api", 3, nil)
+	exp := &chaosv1alpha1.ChaosExperiment{
+		ObjectMeta: metav1.ObjectMeta{Name: "stress", Namespace: "default"},
+		Spec: chaosv1alpha1.ChaosExperimentSpec{
+			Action:           "pod-cpu-stress",
+			Namespace:        "default",
+			AutoscalerPolicy: autoscalerPolicyHoldScaleDown,
+		},
+	}
+	r := newReconcilerWithObjects(t, webHPA, otherHPA)
+	targets := []corev1.Pod{*ownedPod("web-abc-1", "node-a", "ReplicaSet", "web-abc", "abc")}
+
+	r.observeAutoscalers(ctx, exp, targets)
+
+	require.Len(t, exp.Status.Autoscalers, 1)
+	activity := exp.Status.Autoscalers[0]
+	assert.Equal(t, "web", activity.Name)
+	assert.Equal(t, "Deployment/web", activity.Target)
+	assert.Equal(t, int32(2), activity.InitialReplicas)
+	assert.Equal(t, int32(2), activity.PeakReplicas)
+	assert.True(t, activity.ScaleDownHeld)
+
+	held := &autoscalingv2.HorizontalPodAutoscaler{}
+	require.NoError(t, r.Get(ctx, client.ObjectKey{Namespace: "default", Name: "web"}, held))
+	assert.Equal(t, "default/stress", held.Annotations[annotationScaleDownHeldBy])
+	require.NotNil(t, held.Spec.Behavior.ScaleDown.SelectPolicy)
+	assert.Equal(t, autoscalingv2.DisabledPolicySelect, *held.Spec.Behavior.ScaleDown.SelectPolicy)
+
+	// The autoscaler reacts to the stress; the next run records the new peak
+	held.Status.CurrentReplicas = 5
+	require.NoError(t, r.Update(ctx, held))
+	r.observeAutoscalers(ctx, exp, targets)
+	assert.Equal(t, int32(5), exp.Status.Autoscalers[0].PeakReplicas)
+	assert.Equal(t, int32(5), exp.Status.Autoscalers[0].CurrentReplicas)
+
+	r.releaseAutoscalers(ctx, exp)
+	assert.False(t, exp.Status.Autoscalers[0].ScaleDownHeld)
+
+	restored := &autoscalingv2.HorizontalPodAutoscaler{}
+	require.NoError(t, r.Get(ctx, client.ObjectKey{Namespace: "default", Name: "web"}, restored))
+	assert.NotContains(t, restored.Annotations, annotationScaleDownHeldBy)
+	assert.NotContains(t, restored.Annotations, annotationOriginalScaleDown)
+	require.NotNil(t, restored.Spec.Behavior.ScaleDown)
+	assert.Nil(t, restored.Spec.Behavior.ScaleDown.SelectPolicy)
+	assert.Equal(t, window, restored.Spec.Behavior.ScaleDown.StabilizationWindowSeconds)
+
+	untouched := &autoscalingv2.HorizontalPodAutoscaler{}
+	require.NoError(t, r.Get(ctx, client.ObjectKey{Namespace: "default", Name: "api"}, untouched))
+	assert.Nil(t, untouched.Spec.Behavior)
+}
+
+func TestObserveAutoscalers_ObserveOnly(t *testing.T) {
+	ctx := context.Background()
+	exp := &chaosv1alpha1.ChaosExperiment{
+		ObjectMeta: metav1.ObjectMeta{Name: "stress", Namespace: "default"},
+		Spec: chaosv1alpha1.ChaosExperimentSpec{
+			Action:           "pod-memory-stress",
+			Namespace:        "default",
+			AutoscalerPolicy: "Observe",
+		},
+	}
+	r := newReconcilerWithObjects(t, testHPA("web", "web", 2, nil))
+
+	r.observeAutoscalers(ctx, exp, []corev1.Pod{*ownedPod("web-abc-1", "node-a", "ReplicaSet", "web-abc", "abc")})
+
+	require.Len(t, exp.Status.Autoscalers, 1)
+	assert.False(t, exp.Status.Autoscalers[0].ScaleDownHeld)
+
+	hpa := &autoscalingv2.HorizontalPodAutoscaler{}
+	require.NoError(t, r.Get(ctx, client.ObjectKey{Namespace: "default", Name: "web"}, hpa))
+	assert.Nil(t, hpa.Spec.Behavior)
+	assert.Empty(t, hpa.Annotations)
+}
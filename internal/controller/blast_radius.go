@@ -0,0 +1,242 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	chaosv1alpha1 "github.com/neogan74/k8s-chaos/api/v1alpha1"
+)
+
+// workloadKey identifies the workload that owns a pod
+type workloadKey struct {
+	kind string
+	name string
+}
+
+// estimateBlastRadius summarises the impact of disrupting the given target pods: how many pods and
+// nodes are hit, what fraction of each owning workload that is, and, when metrics-server is available,
+// the share of the namespace's current CPU and memory usage those pods account for.
+func (r *ChaosExperimentReconciler) estimateBlastRadius(ctx context.Context, exp *chaosv1alpha1.ChaosExperiment, targets []corev1.Pod) *chaosv1alpha1.BlastRadius {
+	log := ctrl.LoggerFrom(ctx)
+
+	estimate := &chaosv1alpha1.BlastRadius{AffectedPods: len(targets)}
+
+	nodes := map[string]bool{}
+	affected := map[workloadKey]int{}
+	for i := range targets {
+		if targets[i].Spec.NodeName != "" {
+			nodes[targets[i].Spec.NodeName] = true
+		}
+		affected[workloadOf(&targets[i])]++
+	}
+	estimate.NodesTouched = len(nodes)
+
+	allPods, err := r.listPods(ctx, client.InNamespace(exp.Spec.Namespace))
+	if err != nil {
+		log.Error(err, "Failed to list pods for blast radius estimate")
+	}
+	totals := map[workloadKey]int{}
+	for i := range allPods {
+		if allPods[i].DeletionTimestamp == nil {
+			totals[workloadOf(&allPods[i])]++
+		}
+	}
+
+	for key, count := range affected {
+		total := totals[key]
+		if total < count {
+			total = count
+		}
+		estimate.Workloads = append(estimate.Workloads, chaosv1alpha1.WorkloadImpact{
+			Kind:       key.kind,
+			Name:       key.name,
+			Affected:   count,
+			Total:      total,
+			Percentage: int32(count * 100 / total),
+		})
+	}
+	sort.Slice(estimate.Workloads, func(i, j int) bool {
+		if estimate.Workloads[i].Kind != estimate.Workloads[j].Kind {
+			return estimate.Workloads[i].Kind < estimate.Workloads[j].Kind
+		}
+		return estimate.Workloads[i].Name < estimate.Workloads[j].Name
+	})
+
+	estimate.CPUSharePercent = r.usageShare(ctx, exp.Spec.Namespace, strategyHighestCPU, targets)
+	estimate.MemorySharePercent = r.usageShare(ctx, exp.Spec.Namespace, strategyHighestMemory, targets)
+	estimate.Topology = r.blastRadiusTopology(ctx, exp.Spec.Namespace, targets)
+
+	return estimate
+}
+
+// maxTopologyPods bounds the number of pods drawn in the blast-radius graph; workloads, Services and
+// Ingresses are always included
+const maxTopologyPods = 50
+
+// blastRadiusTopology maps the target pods to their owning workloads, the Services selecting them
+// and the Ingresses routing to those Services. Services and Ingresses that cannot be listed are left out.
+func (r *ChaosExperimentReconciler) blastRadiusTopology(ctx context.Context, namespace string, targets []corev1.Pod) *chaosv1alpha1.BlastRadiusTopology {
+	log := ctrl.LoggerFrom(ctx)
+	topology := &chaosv1alpha1.BlastRadiusTopology{}
+	added := map[string]bool{}
+	addNode := func(kind, name string) string {
+		id := kind + "/" + name
+		if !added[id] {
+			added[id] = true
+			topology.Nodes = append(topology.Nodes, chaosv1alpha1.TopologyNode{ID: id, Kind: kind, Name: name})
+		}
+		return id
+	}
+	linked := map[chaosv1alpha1.TopologyEdge]bool{}
+	addEdge := func(from, to string) {
+		edge := chaosv1alpha1.TopologyEdge{From: from, To: to}
+		if from != to && !linked[edge] {
+			linked[edge] = true
+			topology.Edges = append(topology.Edges, edge)
+		}
+	}
+
+	// Services are attached to the workload of the pods they select, or to the pod itself when it has no owner
+	backends := make([]string, len(targets))
+	for i := range targets {
+		workload := workloadOf(&targets[i])
+		if i >= maxTopologyPods {
+			topology.Truncated = true
+			if workload.kind == "Pod" {
+				continue
+			}
+		}
+		backends[i] = addNode(workload.kind, workload.name)
+		if i < maxTopologyPods {
+			addEdge(addNode("Pod", targets[i].Name), backends[i])
+		}
+	}
+
+	services := &corev1.ServiceList{}
+	if err := r.List(ctx, services, client.InNamespace(namespace)); err != nil {
+		log.Error(err, "Failed to list services for blast radius topology")
+	}
+	for _, svc := range services.Items {
+		if len(svc.Spec.Selector) == 0 {
+			continue
+		}
+		selector := labels.SelectorFromSet(svc.Spec.Selector)
+		for i := range targets {
+			if backends[i] != "" && selector.Matches(labels.Set(targets[i].Labels)) {
+				addEdge(backends[i], addNode("Service", svc.Name))
+			}
+		}
+	}
+
+	ingresses := &networkingv1.IngressList{}
+	if err := r.List(ctx, ingresses, client.InNamespace(namespace)); err != nil {
+		log.Error(err, "Failed to list ingresses for blast radius topology")
+	}
+	for i := range ingresses.Items {
+		for _, service := range ingressServices(&ingresses.Items[i]) {
+			if id := "Service/" + service; added[id] {
+				addEdge(id, addNode("Ingress", ingresses.Items[i].Name))
+			}
+		}
+	}
+
+	return topology
+}
+
+// ingressServices returns the names of the Services an Ingress routes to
+func ingressServices(ingress *networkingv1.Ingress) []string {
+	var services []string
+	if backend := ingress.Spec.DefaultBackend; backend != nil && backend.Service != nil {
+		services = append(services, backend.Service.Name)
+	}
+	for _, rule := range ingress.Spec.Rules {
+		if rule.HTTP == nil {
+			continue
+		}
+		for _, path := range rule.HTTP.Paths {
+			if path.Backend.Service != nil {
+				services = append(services, path.Backend.Service.Name)
+			}
+		}
+	}
+	return services
+}
+
+// usageShare returns the percentage of namespace usage consumed by the targets, or nil when
+// metrics-server is unavailable or reports no usage
+func (r *ChaosExperimentReconciler) usageShare(ctx context.Context, namespace, strategy string, targets []corev1.Pod) *int32 {
+	usage, err := r.getPodUsage(ctx, namespace, strategy)
+	if err != nil {
+		ctrl.LoggerFrom(ctx).V(1).Info("Pod metrics unavailable, skipping usage share", "error", err.Error())
+		return nil
+	}
+
+	var total, selected int64
+	for _, value := range usage {
+		total += value
+	}
+	for i := range targets {
+		selected += usage[targets[i].Name]
+	}
+	if total == 0 {
+		return nil
+	}
+	share := int32(selected * 100 / total)
+	return &share
+}
+
+// workloadOf returns the workload owning a pod. Pods owned by a Deployment's ReplicaSet are
+// attributed to the Deployment; unowned pods are their own workload.
+func workloadOf(pod *corev1.Pod) workloadKey {
+	owner := metav1.GetControllerOf(pod)
+	if owner == nil {
+		return workloadKey{kind: "Pod", name: pod.Name}
+	}
+	if owner.Kind == "ReplicaSet" {
+		if hash := pod.Labels[appsv1.DefaultDeploymentUniqueLabelKey]; hash != "" && strings.HasSuffix(owner.Name, "-"+hash) {
+			return workloadKey{kind: "Deployment", name: strings.TrimSuffix(owner.Name, "-"+hash)}
+		}
+	}
+	return workloadKey{kind: owner.Kind, name: owner.Name}
+}
+
+// formatBlastRadius renders an estimate as a short human-readable summary
+func formatBlastRadius(estimate *chaosv1alpha1.BlastRadius) string {
+	parts := []string{fmt.Sprintf("%d pod(s) on %d node(s)", estimate.AffectedPods, estimate.NodesTouched)}
+	for _, w := range estimate.Workloads {
+		parts = append(parts, fmt.Sprintf("%s/%s %d/%d (%d%%)", strings.ToLower(w.Kind), w.Name, w.Affected, w.Total, w.Percentage))
+	}
+	if estimate.CPUSharePercent != nil {
+		parts = append(parts, fmt.Sprintf("%d%% of namespace CPU", *estimate.CPUSharePercent))
+	}
+	if estimate.MemorySharePercent != nil {
+		parts = append(parts, fmt.Sprintf("%d%% of namespace memory", *estimate.MemorySharePercent))
+	}
+	return strings.Join(parts, ", ")
+}
@@ -0,0 +1,175 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+
+	chaosv1alpha1 "github.com/neogan74/k8s-chaos/api/v1alpha1"
+)
+
+func ownedPod(name, node, ownerKind, ownerName, hash string) *corev1.Pod {
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "default",
+			Labels:    map[string]string{"app": "web"},
+		},
+		Spec: corev1.PodSpec{NodeName: node},
+	}
+	if hash != "" {
+		pod.Labels["pod-template-hash"] = hash
+	}
+	if ownerKind != "" {
+		pod.OwnerReferences = []metav1.OwnerReference{{
+			APIVersion: "apps/v1",
+			Kind:       ownerKind,
+			Name:       ownerName,
+			UID:        types.UID("uid-" + ownerName),
+			Controller: ptr.To(true),
+		}}
+	}
+	return pod
+}
+
+func TestEstimateBlastRadius(t *testing.T) {
+	ctx := context.Background()
+	exp := &chaosv1alpha1.ChaosExperiment{
+		ObjectMeta: metav1.ObjectMeta{Name: "blast", Namespace: "default"},
+		Spec:       chaosv1alpha1.ChaosExperimentSpec{Action: "pod-kill", Namespace: "default"},
+	}
+	web1 := ownedPod("web-abc-1", "node-a", "ReplicaSet", "web-abc", "abc")
+	web2 := ownedPod("web-abc-2", "node-b", "ReplicaSet", "web-abc", "abc")
+	web3 := ownedPod("web-abc-3", "node-b", "ReplicaSet", "web-abc", "abc")
+	web4 := ownedPod("web-abc-4", "node-c", "ReplicaSet", "web-abc", "abc")
+	db0 := ownedPod("db-0", "node-a", "StatefulSet", "db", "")
+	db1 := ownedPod("db-1", "node-c", "StatefulSet", "db", "")
+	bare := ownedPod("debug", "node-b", "", "", "")
+
+	r := newReconcilerWithObjects(t, exp, web1, web2, web3, web4, db0, db1, bare)
+
+	estimate := r.estimateBlastRadius(ctx, exp, []corev1.Pod{*web1, *web2, *db0, *bare})
+	require.NotNil(t, estimate)
+	assert.Equal(t, 4, estimate.AffectedPods)
+	assert.Equal(t, 2, estimate.NodesTouched)
+	assert.Equal(t, []chaosv1alpha1.WorkloadImpact{
+		{Kind: "Deployment", Name: "web", Affected: 2, Total: 4, Percentage: 50},
+		{Kind: "Pod", Name: "debug", Affected: 1, Total: 1, Percentage: 100},
+		{Kind: "StatefulSet", Name: "db", Affected: 1, Total: 2, Percentage: 50},
+	}, estimate.Workloads)
+	// metrics-server is not available in the fake client
+	assert.Nil(t, estimate.CPUSharePercent)
+	assert.Nil(t, estimate.MemorySharePercent)
+
+	summary := formatBlastRadius(estimate)
+	assert.Contains(t, summary, "4 pod(s) on 2 node(s)")
+	assert.Contains(t, summary, "deployment/web 2/4 (50%)")
+}
+
+func TestBlastRadiusTopology(t *testing.T) {
+	ctx := context.Background()
+	web1 := ownedPod("web-abc-1", "node-a", "ReplicaSet", "web-abc", "abc")
+	web2 := ownedPod("web-abc-2", "node-b", "ReplicaSet", "web-abc", "abc")
+	bare := ownedPod("debug", "node-b", "", "", "")
+	bare.Labels = map[string]string{"app": "debug"}
+	webSvc := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default"},
+		Spec:       corev1.ServiceSpec{Selector: map[string]string{"app": "web"}},
+	}
+	otherSvc := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Name: "api", Namespace: "default"},
+		Spec:       corev1.ServiceSpec{Selector: map[string]string{"app": "api"}},
+	}
+	headless := &corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: "external", Namespace: "default"}}
+	ingress := &networkingv1.Ingress{
+		ObjectMeta: metav1.ObjectMeta{Name: "shop", Namespace: "default"},
+		Spec: networkingv1.IngressSpec{Rules: []networkingv1.IngressRule{{
+			IngressRuleValue: networkingv1.IngressRuleValue{HTTP: &networkingv1.HTTPIngressRuleValue{
+				Paths: []networkingv1.HTTPIngressPath{
+					{Path: "/", Backend: networkingv1.IngressBackend{Service: &networkingv1.IngressServiceBackend{Name: "web"}}},
+					{Path: "/api", Backend: networkingv1.IngressBackend{Service: &networkingv1.IngressServiceBackend{Name: "api"}}},
+				},
+			}},
+		}}},
+	}
+
+	r := newReconcilerWithObjects(t, web1, web2, bare, webSvc, otherSvc, headless, ingress)
+
+	topology := r.blastRadiusTopology(ctx, "default", []corev1.Pod{*web1, *web2, *bare})
+	require.NotNil(t, topology)
+	ids := make([]string, 0, len(topology.Nodes))
+	for _, node := range topology.Nodes {
+		ids = append(ids, node.ID)
+	}
+	assert.ElementsMatch(t, []string{
+		"Deployment/web", "Pod/web-abc-1", "Pod/web-abc-2", "Pod/debug", "Service/web", "Ingress/shop",
+	}, ids)
+	assert.ElementsMatch(t, []chaosv1alpha1.TopologyEdge{
+		{From: "Pod/web-abc-1", To: "Deployment/web"},
+		{From: "Pod/web-abc-2", To: "Deployment/web"},
+		{From: "Deployment/web", To: "Service/web"},
+		{From: "Service/web", To: "Ingress/shop"},
+	}, topology.Edges)
+	assert.False(t, topology.Truncated)
+}
+
+func TestBlastRadiusTopology_Truncated(t *testing.T) {
+	targets := make([]corev1.Pod, 0, maxTopologyPods+1)
+	for i := 0; i <= maxTopologyPods; i++ {
+		targets = append(targets, *ownedPod(fmt.Sprintf("web-abc-%d", i), "node-a", "ReplicaSet", "web-abc", "abc"))
+	}
+	r := newReconcilerWithObjects(t)
+
+	topology := r.blastRadiusTopology(context.Background(), "default", targets)
+	assert.True(t, topology.Truncated)
+	assert.Len(t, topology.Nodes, maxTopologyPods+1, "pods over the limit are dropped, their workload is kept")
+	assert.Len(t, topology.Edges, maxTopologyPods)
+}
+
+func TestHandleDryRun_IncludesBlastRadius(t *testing.T) {
+	ctx := context.Background()
+	exp := &chaosv1alpha1.ChaosExperiment{
+		ObjectMeta: metav1.ObjectMeta{Name: "dry-run-blast", Namespace: "default"},
+		Spec: chaosv1alpha1.ChaosExperimentSpec{
+			Action:    "pod-kill",
+			Namespace: "default",
+			Count:     1,
+		},
+	}
+	web1 := ownedPod("web-abc-1", "node-a", "ReplicaSet", "web-abc", "abc")
+	web2 := ownedPod("web-abc-2", "node-b", "ReplicaSet", "web-abc", "abc")
+
+	r := newReconcilerWithObjects(t, exp, web1, web2)
+
+	pods := r.orderTargetPods(ctx, exp, []corev1.Pod{*web1, *web2})
+	require.NoError(t, r.handleDryRun(ctx, exp, pods, "delete"))
+
+	refreshed := fetchExperiment(t, r, exp.Name, exp.Namespace)
+	require.NotNil(t, refreshed.Status.BlastRadius)
+	assert.Equal(t, 1, refreshed.Status.BlastRadius.AffectedPods)
+	assert.Contains(t, refreshed.Status.Message, "blast radius: 1 pod(s) on 1 node(s), deployment/web 1/2 (50%)")
+}
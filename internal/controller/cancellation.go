@@ -0,0 +1,159 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	chaosv1alpha1 "github.com/neogan74/k8s-chaos/api/v1alpha1"
+	chaosduration "github.com/neogan74/k8s-chaos/internal/duration"
+)
+
+// cancellationFinalizer keeps an experiment whose injections are in flight until the execution
+// they belong to is recorded as cancelled, so deleting it mid-injection leaves a trace
+const cancellationFinalizer = "chaos.gushchin.dev/cancellation-record"
+
+// cancelledExecution describes the injections of exp still within spec.duration at now and
+// returns when the first of them started, or nil when nothing is in flight. Injected containers
+// report when they started; windowed actions started spec.duration before their window ends.
+func cancelledExecution(exp *chaosv1alpha1.ChaosExperiment, reason, requestedBy string, now time.Time) (*chaosv1alpha1.Cancellation, time.Time) {
+	intended, err := chaosduration.Parse(exp.Spec.Duration)
+	if err != nil || intended <= 0 {
+		return nil, time.Time{}
+	}
+
+	var targets []chaosv1alpha1.CancelledTarget
+	var start time.Time
+	add := func(kind, name string, started time.Time) {
+		elapsed := max(now.Sub(started), 0)
+		targets = append(targets, chaosv1alpha1.CancelledTarget{
+			Kind:    kind,
+			Name:    name,
+			Elapsed: elapsed.Round(time.Second).String(),
+			Percent: int32(min(elapsed*100/intended, 100)),
+		})
+		if start.IsZero() || started.Before(start) {
+			start = started
+		}
+	}
+	window := func(endsAt *metav1.Time, kind string, names ...string) {
+		if endsAt == nil || !endsAt.After(now) {
+			return
+		}
+		for _, name := range names {
+			add(kind, name, endsAt.Add(-intended))
+		}
+	}
+
+	for _, result := range exp.Status.TargetResults {
+		if result.State == targetRunning && result.StartedAt != nil && result.StartedAt.Add(intended).After(now) {
+			add("Pod", result.Pod, result.StartedAt.Time)
+		}
+	}
+	window(exp.Status.FailureEndsAt, "Namespace", exp.Spec.Namespace)
+	window(exp.Status.BalloonsEndAt, "Pod", exp.Status.BalloonPods...)
+	window(exp.Status.QuotaSqueezeEndsAt, "ResourceQuota", exp.Status.SqueezedQuotas...)
+	if exp.Status.AdmissionWebhook != "" {
+		window(exp.Status.AdmissionDelayEndsAt, "ValidatingWebhookConfiguration", exp.Status.AdmissionWebhook)
+	}
+
+	if len(targets) == 0 {
+		return nil, time.Time{}
+	}
+	return &chaosv1alpha1.Cancellation{
+		Reason:           reason,
+		RequestedBy:      requestedBy,
+		IntendedDuration: exp.Spec.Duration,
+		Targets:          targets,
+	}, start
+}
+
+// recordCancellation writes the history record of an execution cut short by cancellation
+func (r *ChaosExperimentReconciler) recordCancellation(ctx context.Context, exp *chaosv1alpha1.ChaosExperiment, cancellation *chaosv1alpha1.Cancellation, start time.Time) error {
+	affected := make([]chaosv1alpha1.ResourceReference, 0, len(cancellation.Targets))
+	for _, target := range cancellation.Targets {
+		ref := chaosv1alpha1.ResourceReference{
+			Kind:    target.Kind,
+			Name:    target.Name,
+			Action:  statusCancelled,
+			Details: fmt.Sprintf("ran %s of %s (%d%%)", target.Elapsed, cancellation.IntendedDuration, target.Percent),
+		}
+		if namespace, name, ok := strings.Cut(target.Name, "/"); ok {
+			ref.Namespace, ref.Name = namespace, name
+		}
+		affected = append(affected, ref)
+	}
+	return r.recordExecution(ctx, exp, statusCancelled, affected, start, nil, cancellation)
+}
+
+// syncCancellationFinalizer holds exp with cancellationFinalizer exactly while its injections
+// are in flight. Only the finalizer is patched, status changes not written yet are kept.
+func (r *ChaosExperimentReconciler) syncCancellationFinalizer(ctx context.Context, exp *chaosv1alpha1.ChaosExperiment, now time.Time) error {
+	if !exp.DeletionTimestamp.IsZero() {
+		return nil
+	}
+	inFlight, _ := cancelledExecution(exp, "", "", now)
+	if (inFlight != nil) == controllerutil.ContainsFinalizer(exp, cancellationFinalizer) {
+		return nil
+	}
+	patched := exp.DeepCopy()
+	if inFlight != nil {
+		controllerutil.AddFinalizer(patched, cancellationFinalizer)
+	} else {
+		controllerutil.RemoveFinalizer(patched, cancellationFinalizer)
+	}
+	if err := r.Patch(ctx, patched, client.MergeFrom(exp)); client.IgnoreNotFound(err) != nil {
+		return fmt.Errorf("failed to update cancellation finalizer: %w", err)
+	}
+	exp.Finalizers = patched.Finalizers
+	exp.ResourceVersion = patched.ResourceVersion
+	return nil
+}
+
+// reconcileCancellationFinalizer records the in-flight injections of a deleted experiment as a
+// cancelled execution before releasing it. It returns done=true once the experiment is gone.
+func (r *ChaosExperimentReconciler) reconcileCancellationFinalizer(ctx context.Context, exp *chaosv1alpha1.ChaosExperiment) (bool, error) {
+	if exp.DeletionTimestamp.IsZero() || !controllerutil.ContainsFinalizer(exp, cancellationFinalizer) {
+		return false, nil
+	}
+
+	if cancellation, start := cancelledExecution(exp, chaosv1alpha1.CancellationDeleted, "", time.Now()); cancellation != nil {
+		ctrl.LoggerFrom(ctx).Info("Recording execution cut short by deletion", "targets", len(cancellation.Targets))
+		deleted := exp.DeepCopy()
+		deleted.Status.Message = "Experiment deleted while injecting"
+		if err := r.recordCancellation(ctx, deleted, cancellation, start); err != nil {
+			return true, err
+		}
+	}
+
+	patched := exp.DeepCopy()
+	controllerutil.RemoveFinalizer(patched, cancellationFinalizer)
+	if err := r.Patch(ctx, patched, client.MergeFrom(exp)); err != nil {
+		return true, client.IgnoreNotFound(err)
+	}
+	exp.Finalizers = patched.Finalizers
+	exp.ResourceVersion = patched.ResourceVersion
+	return len(exp.Finalizers) == 0, nil
+}
@@ -0,0 +1,161 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	chaosv1alpha1 "github.com/neogan74/k8s-chaos/api/v1alpha1"
+)
+
+// stressedExperiment is a cpu-stress experiment of 2m whose container in pod default/web-1
+// started 30s before now
+func stressedExperiment(now time.Time) *chaosv1alpha1.ChaosExperiment {
+	started := metav1.NewTime(now.Add(-30 * time.Second))
+	return &chaosv1alpha1.ChaosExperiment{
+		ObjectMeta: metav1.ObjectMeta{Name: "stress", Namespace: "default", UID: "stress-uid"},
+		Spec: chaosv1alpha1.ChaosExperimentSpec{
+			Action:    "cpu-stress",
+			Namespace: "default",
+			Selector:  map[string]string{"app": "web"},
+			Duration:  "2m",
+		},
+		Status: chaosv1alpha1.ChaosExperimentStatus{
+			Phase: phaseRunning,
+			TargetResults: []chaosv1alpha1.TargetResult{
+				{Pod: "default/web-1", Container: "chaos-cpu-stress-1", State: targetRunning, StartedAt: &started},
+				{Pod: "default/web-2", Container: "chaos-cpu-stress-2", State: targetSucceeded, StartedAt: &started},
+			},
+		},
+	}
+}
+
+func listHistoryRecords(t *testing.T, r *ChaosExperimentReconciler) []chaosv1alpha1.ChaosExperimentHistory {
+	t.Helper()
+	records := &chaosv1alpha1.ChaosExperimentHistoryList{}
+	require.NoError(t, r.List(context.Background(), records))
+	return records.Items
+}
+
+func TestCancelledExecution(t *testing.T) {
+	now := time.Now()
+
+	exp := stressedExperiment(now)
+	cancellation, start := cancelledExecution(exp, chaosv1alpha1.CancellationAborted, "alice", now)
+	require.NotNil(t, cancellation)
+	assert.Equal(t, "2m", cancellation.IntendedDuration)
+	assert.Equal(t, "alice", cancellation.RequestedBy)
+	assert.Equal(t, []chaosv1alpha1.CancelledTarget{
+		{Kind: "Pod", Name: "default/web-1", Elapsed: "30s", Percent: 25},
+	}, cancellation.Targets, "only the running container is in flight")
+	assert.True(t, start.Equal(exp.Status.TargetResults[0].StartedAt.Time))
+
+	// A container running past spec.duration is no longer cut short
+	cancellation, _ = cancelledExecution(exp, chaosv1alpha1.CancellationAborted, "", now.Add(2*time.Minute))
+	assert.Nil(t, cancellation)
+
+	// Windowed actions started spec.duration before their window ends
+	endsAt := metav1.NewTime(now.Add(90 * time.Second))
+	squeeze := &chaosv1alpha1.ChaosExperiment{
+		Spec: chaosv1alpha1.ChaosExperimentSpec{Action: "quota-squeeze", Namespace: "team-a", Duration: "2m"},
+		Status: chaosv1alpha1.ChaosExperimentStatus{
+			QuotaSqueezeEndsAt: &endsAt,
+			SqueezedQuotas:     []string{"team-a/compute"},
+		},
+	}
+	cancellation, _ = cancelledExecution(squeeze, chaosv1alpha1.CancellationDeleted, "", now)
+	require.NotNil(t, cancellation)
+	assert.Equal(t, []chaosv1alpha1.CancelledTarget{
+		{Kind: "ResourceQuota", Name: "team-a/compute", Elapsed: "30s", Percent: 25},
+	}, cancellation.Targets)
+}
+
+func TestReconcile_AbortRecordsCancelledExecution(t *testing.T) {
+	exp := stressedExperiment(time.Now())
+	exp.Annotations = map[string]string{chaosv1alpha1.AbortAnnotation: "alice"}
+	r := newReconcilerWithObjects(t, exp)
+	r.HistoryConfig.Namespace = ""
+
+	_, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: client.ObjectKeyFromObject(exp)})
+	require.NoError(t, err)
+
+	records := listHistoryRecords(t, r)
+	require.Len(t, records, 1)
+	execution := records[0].Spec.Execution
+	assert.Equal(t, statusCancelled, execution.Status)
+	assert.Equal(t, "Experiment aborted by alice", execution.Message)
+	require.NotNil(t, execution.Cancellation)
+	assert.Equal(t, chaosv1alpha1.CancellationAborted, execution.Cancellation.Reason)
+	assert.Equal(t, "alice", execution.Cancellation.RequestedBy)
+	require.Len(t, execution.Cancellation.Targets, 1)
+	assert.Equal(t, int32(25), execution.Cancellation.Targets[0].Percent)
+	require.Len(t, records[0].Spec.AffectedResources, 1)
+	affected := records[0].Spec.AffectedResources[0]
+	assert.Equal(t, "web-1", affected.Name)
+	assert.Equal(t, "default", affected.Namespace)
+	assert.Equal(t, statusCancelled, affected.Action)
+	assert.Contains(t, affected.Details, "of 2m (25%)")
+	assert.NotEmpty(t, records[0].OwnerReferences, "aborted experiments keep their records")
+}
+
+func TestReconcile_DeletionRecordsCancelledExecution(t *testing.T) {
+	exp := stressedExperiment(time.Now())
+	deleted := metav1.Now()
+	exp.DeletionTimestamp = &deleted
+	exp.Finalizers = []string{cancellationFinalizer}
+	r := newReconcilerWithObjects(t, exp)
+	r.HistoryConfig.Namespace = ""
+
+	_, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: client.ObjectKeyFromObject(exp)})
+	require.NoError(t, err)
+
+	records := listHistoryRecords(t, r)
+	require.Len(t, records, 1)
+	execution := records[0].Spec.Execution
+	assert.Equal(t, statusCancelled, execution.Status)
+	require.NotNil(t, execution.Cancellation)
+	assert.Equal(t, chaosv1alpha1.CancellationDeleted, execution.Cancellation.Reason)
+	assert.Empty(t, records[0].OwnerReferences, "the record of a deletion must outlive the experiment")
+
+	err = r.Get(context.Background(), client.ObjectKeyFromObject(exp), &chaosv1alpha1.ChaosExperiment{})
+	assert.True(t, apierrors.IsNotFound(err), "the experiment should be released, got %v", err)
+}
+
+func TestSyncCancellationFinalizer(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	exp := stressedExperiment(now)
+	r := newReconcilerWithObjects(t, exp)
+
+	require.NoError(t, r.syncCancellationFinalizer(ctx, exp, now))
+	assert.True(t, controllerutil.ContainsFinalizer(fetchExperiment(t, r, exp.Name, exp.Namespace), cancellationFinalizer),
+		"in-flight injections should hold the experiment")
+
+	require.NoError(t, r.syncCancellationFinalizer(ctx, exp, now.Add(2*time.Minute)))
+	assert.False(t, controllerutil.ContainsFinalizer(fetchExperiment(t, r, exp.Name, exp.Namespace), cancellationFinalizer),
+		"the finalizer should go once the injections ran their course")
+}
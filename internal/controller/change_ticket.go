@@ -0,0 +1,146 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	chaosv1alpha1 "github.com/neogan74/k8s-chaos/api/v1alpha1"
+	"github.com/neogan74/k8s-chaos/internal/changemgmt"
+)
+
+const (
+	// conditionChangeTicketHeld is set on experiments whose injections wait for their change ticket
+	conditionChangeTicketHeld = "ChangeTicketHeld"
+	// changeTicketRecheck is how often a held experiment asks the change-management system again;
+	// approvals there do not trigger a reconcile
+	changeTicketRecheck = time.Minute
+)
+
+// changeTicketGate checks the change ticket the experiment references through the
+// chaos.gushchin.dev/change-ticket annotation. It returns why the next injection round may not
+// start, or "" when it may, and when to look again. A verified ticket is recorded in
+// status.changeTicket, which the round's status update persists.
+func (r *ChaosExperimentReconciler) changeTicketGate(ctx context.Context, exp *chaosv1alpha1.ChaosExperiment, now time.Time) (string, time.Duration, error) {
+	policies := &chaosv1alpha1.ChaosPolicyList{}
+	if err := r.List(ctx, policies); err != nil {
+		return "", 0, fmt.Errorf("failed to list chaos policies: %w", err)
+	}
+
+	severity := chaosv1alpha1.SeverityOf(&exp.Spec)
+	requiredBy := ""
+	for i := range policies.Items {
+		policy := &policies.Items[i]
+		if policy.DeletionTimestamp != nil || !policy.AppliesTo(exp.Spec.Namespace) {
+			continue
+		}
+		for _, rule := range policy.SeverityRulesFor(severity) {
+			if rule.RequireChangeTicket {
+				requiredBy = policy.Name
+			}
+		}
+	}
+
+	id := exp.Annotations[chaosv1alpha1.ChangeTicketAnnotation]
+	switch {
+	case id == "" && requiredBy == "":
+		return "", 0, nil
+	case id == "":
+		return fmt.Sprintf("ChaosPolicy %q requires an approved change ticket for %s severity experiments (%s annotation)",
+			requiredBy, severity, chaosv1alpha1.ChangeTicketAnnotation), 0, nil
+	case r.ChangeManagement == nil:
+		return fmt.Sprintf("no change-management system is configured to verify change ticket %q", id), 0, nil
+	}
+	if err := changemgmt.ValidateID(id); err != nil {
+		return err.Error(), 0, nil
+	}
+
+	ticket, err := r.ChangeManagement.Ticket(ctx, id)
+	if errors.Is(err, changemgmt.ErrNotFound) {
+		return fmt.Sprintf("change ticket %s was not found", id), changeTicketRecheck, nil
+	}
+	if err != nil {
+		return "", 0, fmt.Errorf("failed to look up change ticket %s: %w", id, err)
+	}
+	switch {
+	case !ticket.Approved:
+		return fmt.Sprintf("change ticket %s is %q, not approved", id, ticket.State), changeTicketRecheck, nil
+	case !ticket.Start.IsZero() && now.Before(ticket.Start):
+		return fmt.Sprintf("change window of ticket %s opens at %s", id, ticket.Start.UTC().Format(time.RFC3339)),
+			ticket.Start.Sub(now), nil
+	case !ticket.Open(now):
+		return fmt.Sprintf("change window of ticket %s closed at %s", id, ticket.End.UTC().Format(time.RFC3339)),
+			changeTicketRecheck, nil
+	}
+
+	verifiedAt := metav1.NewTime(now)
+	exp.Status.ChangeTicket = &chaosv1alpha1.ChangeTicketStatus{ID: id, State: ticket.State, VerifiedAt: &verifiedAt}
+	return "", 0, nil
+}
+
+// handleChangeTicketHeld holds the experiment, keeping its phase, until its change ticket allows
+// the next injection round
+func (r *ChaosExperimentReconciler) handleChangeTicketHeld(ctx context.Context, exp *chaosv1alpha1.ChaosExperiment, reason string, wait time.Duration) (ctrl.Result, error) {
+	log := ctrl.LoggerFrom(ctx)
+	message := "Waiting for change ticket: " + reason
+	if wait > 0 {
+		wait = wait.Round(time.Second) + time.Second
+		message = fmt.Sprintf("%s; checking again in %s", message, wait)
+	}
+
+	// Only record once per hold; later reconciles just keep waiting
+	if !meta.IsStatusConditionTrue(exp.Status.Conditions, conditionChangeTicketHeld) {
+		log.Info("Injection round held back by change ticket", "reason", reason,
+			"ticket", exp.Annotations[chaosv1alpha1.ChangeTicketAnnotation])
+		r.Recorder.Event(exp, corev1.EventTypeWarning, "ChaosChangeTicketHeld", message)
+	}
+
+	meta.SetStatusCondition(&exp.Status.Conditions, metav1.Condition{
+		Type:               conditionChangeTicketHeld,
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: exp.Generation,
+		Reason:             "ChangeTicketNotApproved",
+		Message:            reason,
+	})
+	exp.Status.Message = message
+	if err := r.Status().Update(ctx, exp); err != nil {
+		log.Error(err, "Failed to update status for experiment waiting for its change ticket")
+		return ctrl.Result{}, err
+	}
+	return ctrl.Result{RequeueAfter: wait}, nil
+}
+
+// clearChangeTicketHeldCondition removes the ChangeTicketHeld condition once the experiment may run
+func (r *ChaosExperimentReconciler) clearChangeTicketHeldCondition(ctx context.Context, exp *chaosv1alpha1.ChaosExperiment) {
+	if meta.FindStatusCondition(exp.Status.Conditions, conditionChangeTicketHeld) == nil {
+		return
+	}
+
+	meta.RemoveStatusCondition(&exp.Status.Conditions, conditionChangeTicketHeld)
+	if err := r.Status().Update(ctx, exp); err != nil {
+		log := ctrl.LoggerFrom(ctx)
+		log.Error(err, "Failed to clear ChangeTicketHeld condition")
+	}
+}
@@ -0,0 +1,162 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	chaosv1alpha1 "github.com/neogan74/k8s-chaos/api/v1alpha1"
+	"github.com/neogan74/k8s-chaos/internal/changemgmt"
+)
+
+// fakeChangeSystem serves tickets from a map; a missing ID is not found
+type fakeChangeSystem struct {
+	tickets map[string]*changemgmt.Ticket
+	err     error
+}
+
+func (f *fakeChangeSystem) Ticket(_ context.Context, id string) (*changemgmt.Ticket, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	ticket, ok := f.tickets[id]
+	if !ok {
+		return nil, changemgmt.ErrNotFound
+	}
+	return ticket, nil
+}
+
+func TestReconcile_ChangeTicket(t *testing.T) {
+	ctx := context.Background()
+	exp := rateLimitTestExperiment("kill")
+	exp.Spec.Severity = chaosv1alpha1.SeverityHigh
+	policy := &chaosv1alpha1.ChaosPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "change-control"},
+		Spec: chaosv1alpha1.ChaosPolicySpec{
+			SeverityRules: []chaosv1alpha1.SeverityRule{{Severity: chaosv1alpha1.SeverityHigh, RequireChangeTicket: true}},
+		},
+	}
+	objs := []client.Object{exp, policy, &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "shop"}}}
+	for _, name := range []string{"web-1", "web-2"} {
+		objs = append(objs, &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "shop", Labels: map[string]string{"app": "web"}}})
+	}
+	r := newReconcilerWithObjects(t, objs...)
+	changes := &fakeChangeSystem{tickets: map[string]*changemgmt.Ticket{
+		"CHG0030042": {ID: "CHG0030042", State: "requested"},
+	}}
+	r.ChangeManagement = changes
+
+	result, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: client.ObjectKeyFromObject(exp)})
+	require.NoError(t, err)
+	assert.Zero(t, result.RequeueAfter, "the ticket reference is picked up from the experiment update")
+	updated := fetchExperiment(t, r, exp.Name, exp.Namespace)
+	assert.Contains(t, updated.Status.Message, `ChaosPolicy "change-control" requires an approved change ticket`)
+	assert.True(t, meta.IsStatusConditionTrue(updated.Status.Conditions, conditionChangeTicketHeld))
+
+	updated.Annotations = map[string]string{chaosv1alpha1.ChangeTicketAnnotation: "CHG0030042"}
+	require.NoError(t, r.Update(ctx, updated))
+	result, err = r.Reconcile(ctx, ctrl.Request{NamespacedName: client.ObjectKeyFromObject(exp)})
+	require.NoError(t, err)
+	assert.Equal(t, changeTicketRecheck+time.Second, result.RequeueAfter, "approvals are polled")
+	pods := &corev1.PodList{}
+	require.NoError(t, r.List(ctx, pods, client.InNamespace("shop")))
+	assert.Len(t, pods.Items, 2)
+	updated = fetchExperiment(t, r, exp.Name, exp.Namespace)
+	assert.Contains(t, updated.Status.Message, `change ticket CHG0030042 is "requested", not approved`)
+
+	changes.tickets["CHG0030042"] = &changemgmt.Ticket{ID: "CHG0030042", State: "approved", Approved: true}
+	_, err = r.Reconcile(ctx, ctrl.Request{NamespacedName: client.ObjectKeyFromObject(exp)})
+	require.NoError(t, err)
+	require.NoError(t, r.List(ctx, pods, client.InNamespace("shop")))
+	assert.Len(t, pods.Items, 1)
+	updated = fetchExperiment(t, r, exp.Name, exp.Namespace)
+	assert.Nil(t, meta.FindStatusCondition(updated.Status.Conditions, conditionChangeTicketHeld))
+	require.NotNil(t, updated.Status.ChangeTicket)
+	assert.Equal(t, "CHG0030042", updated.Status.ChangeTicket.ID)
+	assert.Equal(t, "approved", verifiedChangeTicketState(updated))
+
+	// A ticket verified for another reference is not reported for the current one
+	updated.Annotations[chaosv1alpha1.ChangeTicketAnnotation] = "CHG0030043"
+	assert.Empty(t, verifiedChangeTicketState(updated))
+}
+
+func TestChangeTicketGate(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 10, 15, 9, 0, 0, 0, time.UTC)
+	r := newReconcilerWithObjects(t)
+	changes := &fakeChangeSystem{tickets: map[string]*changemgmt.Ticket{
+		"CHG-1": {ID: "CHG-1", State: "Approved", Approved: true, Start: now.Add(-time.Hour), End: now.Add(time.Hour)},
+		"CHG-2": {ID: "CHG-2", State: "Approved", Approved: true, Start: now.Add(30 * time.Minute)},
+		"CHG-3": {ID: "CHG-3", State: "Approved", Approved: true, End: now},
+	}}
+
+	tests := []struct {
+		name       string
+		ticket     string
+		system     changemgmt.System
+		wantReason string
+		wantWait   time.Duration
+		wantErr    bool
+	}{
+		{name: "no ticket and no rule", system: changes},
+		{name: "approved within its window", ticket: "CHG-1", system: changes},
+		{name: "no system to verify against", ticket: "CHG-1",
+			wantReason: `no change-management system is configured to verify change ticket "CHG-1"`},
+		{name: "window not open yet", ticket: "CHG-2", system: changes,
+			wantReason: "change window of ticket CHG-2 opens at 2026-10-15T09:30:00Z", wantWait: 30 * time.Minute},
+		{name: "window closed", ticket: "CHG-3", system: changes,
+			wantReason: "change window of ticket CHG-3 closed at 2026-10-15T09:00:00Z", wantWait: changeTicketRecheck},
+		{name: "unknown ticket", ticket: "CHG-4", system: changes,
+			wantReason: "change ticket CHG-4 was not found", wantWait: changeTicketRecheck},
+		{name: "malformed ticket", ticket: "CHG 1&x=y", system: changes,
+			wantReason: `invalid change ticket ID "CHG 1&x=y": use letters, digits, '.', '_' and '-'`},
+		{name: "system unreachable", ticket: "CHG-1", system: &fakeChangeSystem{err: errors.New("connection refused")},
+			wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exp := rateLimitTestExperiment("kill")
+			if tt.ticket != "" {
+				exp.Annotations = map[string]string{chaosv1alpha1.ChangeTicketAnnotation: tt.ticket}
+			}
+			r.ChangeManagement = tt.system
+			reason, wait, err := r.changeTicketGate(ctx, exp, now)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantReason, reason)
+			assert.Equal(t, tt.wantWait, wait)
+			if tt.ticket != "" && reason == "" {
+				require.NotNil(t, exp.Status.ChangeTicket)
+				assert.Equal(t, tt.ticket, exp.Status.ChangeTicket.ID)
+			}
+		})
+	}
+}
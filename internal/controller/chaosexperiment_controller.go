@@ -22,12 +22,13 @@ import (
 	"errors"
 	"fmt"
 	"math/rand"
-	"regexp"
+	"path"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
 
-	"github.com/robfig/cron/v3"
+	"github.com/prometheus/client_golang/prometheus"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
@@ -41,17 +42,33 @@ import (
 	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/tools/remotecommand"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	chaosv1alpha1 "github.com/neogan74/k8s-chaos/api/v1alpha1"
+	"github.com/neogan74/k8s-chaos/internal/audittrail"
+	"github.com/neogan74/k8s-chaos/internal/changemgmt"
+	"github.com/neogan74/k8s-chaos/internal/diagnostics"
+	chaosduration "github.com/neogan74/k8s-chaos/internal/duration"
+	"github.com/neogan74/k8s-chaos/internal/holidays"
 	chaosmetrics "github.com/neogan74/k8s-chaos/internal/metrics"
+	"github.com/neogan74/k8s-chaos/internal/promquery"
+	"github.com/neogan74/k8s-chaos/internal/redact"
+	"github.com/neogan74/k8s-chaos/internal/resulthook"
+	cronschedule "github.com/neogan74/k8s-chaos/internal/schedule"
+	"github.com/neogan74/k8s-chaos/internal/siem"
 )
 
 const (
 	// Status constants for experiment execution
-	statusSuccess = "success"
-	statusFailure = "failure"
+	statusSuccess   = "success"
+	statusFailure   = "failure"
+	statusPartial   = "partial"
+	statusCancelled = "cancelled"
 
 	// Phase constants for experiment lifecycle
 	phaseRunning   = "Running"
@@ -65,6 +82,9 @@ const (
 	defaultRetryDelay   = 30 * time.Second
 	defaultRetryBackoff = "exponential"
 
+	// defaultInterval is the time between injection rounds when spec.interval is not set
+	defaultInterval = time.Minute
+
 	// Message constants for repeated status messages
 	msgNoEligiblePodsWithExclusions = "No eligible pods found matching selector (or all are excluded)"
 	msgNoEligiblePods               = "No eligible pods found matching selector"
@@ -78,19 +98,110 @@ type ChaosExperimentReconciler struct {
 	Clientset     *kubernetes.Clientset
 	Recorder      record.EventRecorder
 	HistoryConfig HistoryConfig
+	// Prometheus evaluates spec.metricsQueries; experiments with queries record an error when nil
+	Prometheus *promquery.Client
+	// ResultWebhooks receives every history record for delivery to external endpoints; optional
+	ResultWebhooks *resulthook.Sender
+	// AuditLog forwards every history record to the SIEM syslog collector; optional
+	AuditLog *siem.Forwarder
+	// ReconcileErrors keeps recent reconcile errors for the diagnostics endpoint; optional
+	ReconcileErrors *diagnostics.ErrorLog
+	// APIReader reads from the API server instead of the cache, paginated; node-drain uses it to
+	// list the pods of a node. Optional: without it the cache is used.
+	APIReader client.Reader
+	// ListPodsFromAPI lists the pods of experiments through APIReader as well, so selectors
+	// matching thousands of pods are read in pages instead of from a cache holding all of them
+	ListPodsFromAPI bool
+	// PodListPageSize is the page size of pod lists read through APIReader; zero means 250
+	PodListPageSize int64
+	// PodCacheSelector is the label selector the manager's pod cache is restricted to, if any.
+	// Pod lists read through APIReader apply it too, so every listed pod can be read back from the cache
+	PodCacheSelector labels.Selector
+	// WatchNamespaces are the namespaces the manager's cache is restricted to; empty means all.
+	// Experiments targeting other namespaces fail validation.
+	WatchNamespaces []string
+	// Shard restricts the reconciler to the experiments of one shard when several replicas split
+	// them; nil reconciles every experiment
+	Shard *Shard
+	// StressImage overrides the stress-ng image of pod-cpu-stress and pod-memory-stress; optional
+	StressImage string
+	// StressFallbackImage overrides the BusyBox image of the built-in stressors used when the
+	// stress image cannot be pulled; optional
+	StressFallbackImage string
+	// EphemeralStartTimeout is how long a reconcile waits for injected ephemeral containers to
+	// run before reporting them; zero means 30s
+	EphemeralStartTimeout time.Duration
+	// VerifyInjections probes each running injected container for its fault, such as the netem
+	// qdisc or the stressor's CPU usage, and reports it in status.targetResults
+	VerifyInjections bool
+	// ImpersonateInitiator runs the destructive operations of an experiment as the ServiceAccount
+	// that created it, so an experiment cannot reach beyond its creator's RBAC
+	ImpersonateInitiator bool
+	// ControllerUsername is the username the controller authenticates as; ImpersonateInitiator
+	// refuses experiments it created itself
+	ControllerUsername string
+	// TagDestructiveRequests sends the destructive operations of an experiment with a user-agent
+	// naming its UID, so they can be picked out of the API server audit log
+	TagDestructiveRequests bool
+	// Redactor scrubs credentials from command output and errors copied into status and history;
+	// nil uses redact.Default()
+	Redactor *redact.Filter
+	// ChangeManagement looks up the change tickets experiments reference; without it experiments
+	// referencing a ticket, or under a ChaosPolicy requiring one, are held back
+	ChangeManagement changemgmt.System
+
+	// recovery measures injection-to-recovery latency; set up by SetupWithManager
+	recovery *recoveryTracker
+	// impersonator builds the clients of ImpersonateInitiator; set up by SetupWithManager
+	impersonator *impersonator
+	// tagged is the client and taggedConfig the rest config of TagDestructiveRequests; set up by
+	// SetupWithManager
+	tagged       client.Client
+	taggedConfig *rest.Config
+	// injections remembers injection rounds for ChaosPolicy rate limits; set up by SetupWithManager
+	injections *injectionLog
+	// schedules caches parsed cron schedules and next runs; set up by SetupWithManager
+	schedules *cronschedule.Cache
+	// holidayFeeds caches the holiday calendars ChaosPolicies fetch from URLs and holidayConfigMaps
+	// those they read from ConfigMaps; set up by SetupWithManager
+	holidayFeeds, holidayConfigMaps *holidays.Cache
+	// cleanups remembers the reverts of in-flight injections for handoff on shutdown; set up by
+	// SetupWithManager
+	cleanups *cleanupLedger
+	// probeExec runs injection probes instead of execInPod; tests replace it
+	probeExec func(ctx context.Context, pod types.NamespacedName, container string, command []string) (string, string, error)
+	// measurementLogs reads the output of measurement containers instead of the pod log API; tests
+	// replace it
+	measurementLogs func(ctx context.Context, pod types.NamespacedName, container string) (string, error)
 }
 
 // +kubebuilder:rbac:groups=chaos.gushchin.dev,resources=chaosexperiments,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=chaos.gushchin.dev,resources=chaosexperiments/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=chaos.gushchin.dev,resources=chaosexperiments/finalizers,verbs=update
-// +kubebuilder:rbac:groups=chaos.gushchin.dev,resources=chaosexperimenthistories,verbs=create;get;list;watch;delete
-// +kubebuilder:rbac:groups="",resources=pods,verbs=get;list;watch;delete;patch
+// +kubebuilder:rbac:groups=chaos.gushchin.dev,resources=chaosexperimenthistories,verbs=create;get;list;watch;update;delete
+// +kubebuilder:rbac:groups=chaos.gushchin.dev,resources=chaosfreezes,verbs=get;list;watch
+// +kubebuilder:rbac:groups=chaos.gushchin.dev,resources=chaospolicies,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=pods,verbs=get;list;watch;create;delete;patch
 // +kubebuilder:rbac:groups="",resources=pods/exec,verbs=create
 // +kubebuilder:rbac:groups="",resources=pods/ephemeralcontainers,verbs=get;update;patch
 // +kubebuilder:rbac:groups="",resources=nodes,verbs=get;list;watch;update;patch
 // +kubebuilder:rbac:groups="",resources=pods/eviction,verbs=create
 // +kubebuilder:rbac:groups="",resources=namespaces,verbs=get;list;watch
-// +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
+// +kubebuilder:rbac:groups="",resources=events,verbs=create;patch;get;list;watch
+// +kubebuilder:rbac:groups="",resources=secrets,verbs=get
+// +kubebuilder:rbac:groups="",resources=configmaps,verbs=get
+// +kubebuilder:rbac:groups="",resources=pods/log,verbs=get
+// +kubebuilder:rbac:groups="",resources=resourcequotas,verbs=get;list;watch;update
+// +kubebuilder:rbac:groups="",resources=services,verbs=get;list;watch
+// +kubebuilder:rbac:groups=admissionregistration.k8s.io,resources=validatingwebhookconfigurations,verbs=get;list;watch;create;update;delete
+// +kubebuilder:rbac:groups=apps,resources=deployments;replicasets;statefulsets,verbs=get;list;watch
+// +kubebuilder:rbac:groups=autoscaling,resources=horizontalpodautoscalers,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=batch,resources=jobs,verbs=get
+// +kubebuilder:rbac:groups=coordination.k8s.io,resources=leases,verbs=get;list;watch;update;delete
+// +kubebuilder:rbac:groups=metrics.k8s.io,resources=pods,verbs=get;list
+// +kubebuilder:rbac:groups=networking.k8s.io,resources=ingresses,verbs=get;list;watch
+// +kubebuilder:rbac:groups=scheduling.k8s.io,resources=priorityclasses,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=serviceaccounts,verbs=impersonate
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
 // move the current state of the cluster closer to the desired state.
@@ -103,12 +214,53 @@ type ChaosExperimentReconciler struct {
 // - https://pkg.go.dev/sigs.k8s.io/controller-runtime@v0.21.0/pkg/reconcile
 func (r *ChaosExperimentReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
 	log := ctrl.LoggerFrom(ctx)
+	defer r.handOffCleanup(ctx, req.NamespacedName)
 
 	var exp chaosv1alpha1.ChaosExperiment
 	if err := r.Get(ctx, req.NamespacedName, &exp); err != nil {
+		if apierrors.IsNotFound(err) {
+			r.schedules.Forget(req.String())
+		}
 		return ctrl.Result{}, client.IgnoreNotFound(err)
 	}
 
+	// Record injections cut short by deleting the experiment
+	if done, err := r.reconcileCancellationFinalizer(ctx, &exp); done || err != nil {
+		return ctrl.Result{}, err
+	}
+
+	// Finish reverting injections a previous controller was interrupted in
+	if len(exp.Status.PendingCleanup) > 0 {
+		if err := r.finishPendingCleanup(ctx, &exp); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	// Remove fill files before a deleted pod-disk-fill experiment goes away
+	if done, err := r.reconcileDiskFillFinalizer(ctx, &exp); done {
+		return ctrl.Result{}, err
+	}
+
+	// Let the cleanup tasks of a deleted experiment finish first
+	if result, done, err := r.reconcileCleanupTaskFinalizer(ctx, &exp); done {
+		return result, err
+	}
+
+	// Garbage-collect finished experiments once their TTL expires
+	if result, done, err := r.handleTTLAfterFinished(ctx, &exp); done {
+		return result, err
+	}
+
+	// Stop experiments aborted through the API or by annotating them
+	if result, done, err := r.handleAbort(ctx, &exp); done {
+		return result, err
+	}
+
+	// Hold the experiment while its injections are in flight, so deleting it is recorded
+	if err := r.syncCancellationFinalizer(ctx, &exp, time.Now()); err != nil {
+		return ctrl.Result{}, err
+	}
+
 	if exp.Spec.Action == "" {
 		log.Error(nil, "Action not specified")
 		exp.Status.Message = "Error: Action not specified"
@@ -128,6 +280,32 @@ func (r *ChaosExperimentReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 		return ctrl.Result{}, nil
 	}
 
+	// Validate the spec in case the admission webhook is not installed
+	errs := chaosv1alpha1.ValidateSpecStructure(exp.Name, &exp.Spec)
+	if errs = append(errs, r.unwatchedNamespaceErrors(&exp.Spec)...); len(errs) > 0 {
+		return r.handleInvalidSpec(ctx, &exp, errs)
+	}
+	r.clearInvalidCondition(ctx, &exp)
+
+	// Show when a scheduled experiment fires before any gate holds it back
+	if definition := chaosv1alpha1.ScheduleDefinition(&exp.Spec); !definition.IsZero() &&
+		exp.Status.Phase != phaseCompleted && exp.Status.Phase != phaseFailed {
+		if _, err := r.publishNextScheduledTime(ctx, &exp, time.Now()); err != nil {
+			log.Error(err, "Failed to compute next scheduled time", "schedule", definition.String())
+		}
+	}
+
+	// Check for a cluster-wide chaos freeze
+	freeze, err := r.getActiveFreeze(ctx)
+	if err != nil {
+		log.Error(err, "Failed to check for chaos freeze")
+		return ctrl.Result{}, err
+	}
+	if freeze != nil && exp.Status.Phase != phaseCompleted && exp.Status.Phase != phaseFailed {
+		return r.handleFreeze(ctx, &exp, freeze)
+	}
+	r.clearFrozenCondition(ctx, &exp)
+
 	// If resuming from pause, ensure phase is updated (cleared or set to running)
 	// The specific handler or next steps will update the phase appropriately
 	if exp.Status.Phase == phasePaused {
@@ -144,21 +322,41 @@ func (r *ChaosExperimentReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 		return ctrl.Result{}, err
 	}
 	if !shouldContinue {
-		// Experiment has completed its duration or is already completed
-		return ctrl.Result{}, nil
+		// Experiment has completed its duration or is already completed; finish sampling
+		// metricsQueries, then judge successCriteria
+		result, err := r.handleCompletedMetrics(ctx, &exp)
+		if err != nil {
+			return result, err
+		}
+		return r.handleVerdict(ctx, &exp, result)
+	}
+
+	// Check if scheduled experiment should run now; a rolling restart in progress belongs to a
+	// scheduled run that already fired
+	if exp.Status.RollingRestart == nil {
+		shouldRun, requeueAfter, err := r.checkSchedule(ctx, &exp)
+		if err != nil {
+			log.Error(err, "Failed to check schedule")
+			exp.Status.Message = fmt.Sprintf("Schedule error: %v", err)
+			_ = r.Status().Update(ctx, &exp)
+			return ctrl.Result{}, err
+		}
+		if !shouldRun {
+			// Not time to run yet, requeue for the next scheduled time
+			return ctrl.Result{RequeueAfter: requeueAfter}, nil
+		}
 	}
 
-	// Check if scheduled experiment should run now
-	shouldRun, requeueAfter, err := r.checkSchedule(ctx, &exp)
+	// Public holidays and freeze days of ChaosPolicy holiday calendars block chaos like a maintenance window
+	holiday, holidayEnds, err := r.holidayGate(ctx, &exp, time.Now())
 	if err != nil {
-		log.Error(err, "Failed to check schedule")
-		exp.Status.Message = fmt.Sprintf("Schedule error: %v", err)
-		_ = r.Status().Update(ctx, &exp)
+		log.Error(err, "Failed to check holiday calendars")
 		return ctrl.Result{}, err
 	}
-	if !shouldRun {
-		// Not time to run yet, requeue for the next scheduled time
-		return ctrl.Result{RequeueAfter: requeueAfter}, nil
+	if holiday != "" {
+		log.Info("Experiment blocked by holiday calendar", "reason", holiday)
+		r.setBlockedByTimeWindowCondition(ctx, &exp, holiday, holidayEnds)
+		return ctrl.Result{RequeueAfter: time.Until(holidayEnds)}, nil
 	}
 
 	// Check if we're within allowed time windows
@@ -181,62 +379,112 @@ func (r *ChaosExperimentReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 		return ctrl.Result{RequeueAfter: 15 * time.Second}, nil
 	}
 
-	switch exp.Spec.Action {
-	case "pod-kill":
-		return r.handlePodKill(ctx, &exp)
-	case "pod-delay":
-		return r.handlePodDelay(ctx, &exp)
-	case "node-drain":
-		return r.handleNodeDrain(ctx, &exp)
-	case "node-taint":
-		return r.handleNodeTaint(ctx, &exp)
-	case "node-cpu-stress":
-		return r.handleNodeCPUStress(ctx, &exp)
-	case "node-disk-fill":
-		return r.handleNodeDiskFill(ctx, &exp)
-	case "pod-cpu-stress":
-		return r.handlePodCPUStress(ctx, &exp)
-	case "pod-memory-stress":
-		return r.handlePodMemoryStress(ctx, &exp)
-	case "pod-failure":
-		return r.handlePodFailure(ctx, &exp)
-	case "pod-restart":
-		return r.handlePodRestart(ctx, &exp)
-	case "pod-network-loss":
-		return r.handlePodNetworkLoss(ctx, &exp)
-	case "pod-network-corruption":
-		return r.handlePodNetworkCorruption(ctx, &exp)
-	case "network-partition":
-		return r.handleNetworkPartition(ctx, &exp)
-	case "pod-disk-fill":
-		return r.handlePodDiskFill(ctx, &exp)
-	default:
+	// Hold back injection rounds that would exceed a ChaosPolicy rate limit, that its severity
+	// rules do not allow yet or whose change ticket is not approved; a pod-failure, pod-restart,
+	// scheduler-pressure, quota-squeeze or admission-delay run in progress belongs to a round that
+	// already started
+	if !exp.Spec.DryRun && exp.Status.FailureEndsAt == nil && exp.Status.RollingRestart == nil &&
+		exp.Status.BalloonsEndAt == nil && exp.Status.QuotaSqueezeEndsAt == nil && exp.Status.AdmissionDelayEndsAt == nil {
+		reason, wait, err := r.severityGate(ctx, &exp, time.Now())
+		if err != nil {
+			log.Error(err, "Failed to check chaos policies")
+			return ctrl.Result{}, err
+		}
+		if reason != "" {
+			return r.handleSeverityGated(ctx, &exp, reason, wait)
+		}
+		r.clearSeverityGatedCondition(ctx, &exp)
+
+		reason, wait, err = r.changeTicketGate(ctx, &exp, time.Now())
+		if err != nil {
+			log.Error(err, "Failed to check change ticket")
+			return ctrl.Result{}, err
+		}
+		if reason != "" {
+			return r.handleChangeTicketHeld(ctx, &exp, reason, wait)
+		}
+		r.clearChangeTicketHeldCondition(ctx, &exp)
+
+		wait, reason, err = r.rateLimitDelay(ctx, &exp, time.Now())
+		if err != nil {
+			log.Error(err, "Failed to check chaos policies")
+			return ctrl.Result{}, err
+		}
+		if wait > 0 {
+			return r.handleRateLimited(ctx, &exp, wait, reason)
+		}
+		r.clearRateLimitedCondition(ctx, &exp)
+	}
+
+	// Inject as the experiment's creator when impersonation is enabled
+	ctx, err = r.actAsInitiator(ctx, &exp)
+	if err != nil {
+		return r.handleExperimentFailure(ctx, &exp, &ChaosError{
+			Original: err, Type: ErrorTypePermission, Operation: "impersonate initiator",
+		})
+	}
+	ctx = withArtifactMarking(ctx, &exp)
+	ctx = audittrail.WithExperiment(ctx, string(exp.UID))
+
+	execute, ok := executors[exp.Spec.Action]
+	if !ok {
 		log.Info("Unsupported action", "action", exp.Spec.Action)
 		exp.Status.Message = "Error: Unsupported action: " + exp.Spec.Action
 		_ = r.Status().Update(ctx, &exp)
 		return ctrl.Result{}, nil
 	}
+	lastRun := exp.Status.LastRunTime
+	result, err := execute(r, ctx, &exp)
+	r.recordInjectionRound(&exp, lastRun)
+	return result, err
+}
+
+// actionExecutor runs an experiment of one action
+type actionExecutor func(r *ChaosExperimentReconciler, ctx context.Context, exp *chaosv1alpha1.ChaosExperiment) (ctrl.Result, error)
+
+// executors maps every action the controller supports to its handler
+var executors = map[string]actionExecutor{
+	"pod-kill":               (*ChaosExperimentReconciler).handlePodKill,
+	"pod-delay":              (*ChaosExperimentReconciler).handlePodDelay,
+	"node-drain":             (*ChaosExperimentReconciler).handleNodeDrain,
+	"node-taint":             (*ChaosExperimentReconciler).handleNodeTaint,
+	"node-cpu-stress":        (*ChaosExperimentReconciler).handleNodeCPUStress,
+	"node-disk-fill":         (*ChaosExperimentReconciler).handleNodeDiskFill,
+	"pod-cpu-stress":         (*ChaosExperimentReconciler).handlePodCPUStress,
+	"pod-memory-stress":      (*ChaosExperimentReconciler).handlePodMemoryStress,
+	"pod-failure":            (*ChaosExperimentReconciler).handlePodFailure,
+	"pod-restart":            (*ChaosExperimentReconciler).handlePodRestart,
+	"pod-network-loss":       (*ChaosExperimentReconciler).handlePodNetworkLoss,
+	"pod-network-corruption": (*ChaosExperimentReconciler).handlePodNetworkCorruption,
+	"network-partition":      (*ChaosExperimentReconciler).handleNetworkPartition,
+	"pod-disk-fill":          (*ChaosExperimentReconciler).handlePodDiskFill,
+	"lease-steal":            (*ChaosExperimentReconciler).handleLeaseSteal,
+	"scheduler-pressure":     (*ChaosExperimentReconciler).handleSchedulerPressure,
+	"quota-squeeze":          (*ChaosExperimentReconciler).handleQuotaSqueeze,
+	"admission-delay":        (*ChaosExperimentReconciler).handleAdmissionDelay,
+}
+
+// SupportedActions returns the actions the controller can execute, sorted
+func SupportedActions() []string {
+	actions := make([]string, 0, len(executors))
+	for action := range executors {
+		actions = append(actions, action)
+	}
+	sort.Strings(actions)
+	return actions
 }
 
 func (r *ChaosExperimentReconciler) handlePodKill(ctx context.Context, exp *chaosv1alpha1.ChaosExperiment) (ctrl.Result, error) {
 	log := ctrl.LoggerFrom(ctx)
 	startTime := time.Now()
 
-	// Track active experiments
-	chaosmetrics.ActiveExperiments.WithLabelValues("pod-kill").Inc()
-	defer chaosmetrics.ActiveExperiments.WithLabelValues("pod-kill").Dec()
-
 	// Get eligible pods (includes namespace validation and exclusion filtering)
 	eligiblePods, err := r.getEligiblePods(ctx, exp)
 	if err != nil {
 		if isPermissionDeniedError(err) {
 			return ctrl.Result{}, r.handlePermissionDenied(ctx, exp, "listing pods for pod-kill", err)
 		}
-		return r.handleExperimentFailure(ctx, exp, &ChaosError{
-			Original:  fmt.Errorf("failed to get eligible pods: %w", err),
-			Type:      ErrorTypeExecution,
-			Operation: "list eligible pods",
-		})
+		return r.handleExperimentFailure(ctx, exp, WrapK8sError(fmt.Errorf("failed to get eligible pods: %w", err), "list eligible pods"))
 	}
 
 	if len(eligiblePods) == 0 {
@@ -251,10 +499,8 @@ func (r *ChaosExperimentReconciler) handlePodKill(ctx context.Context, exp *chao
 		return ctrl.Result{}, r.handleDryRun(ctx, exp, eligiblePods, "delete")
 	}
 
-	// Shuffle the list of eligible pods
-	rand.Shuffle(len(eligiblePods), func(i, j int) {
-		eligiblePods[i], eligiblePods[j] = eligiblePods[j], eligiblePods[i]
-	})
+	// Order eligible pods so the first Count entries are the targets
+	eligiblePods = r.orderTargetPods(ctx, exp, eligiblePods)
 
 	// Delete the specified number of pods
 	killCount := exp.Spec.Count
@@ -274,12 +520,14 @@ func (r *ChaosExperimentReconciler) handlePodKill(ctx context.Context, exp *chao
 		r.Recorder.Event(&pod, corev1.EventTypeWarning, "ChaosPodKill",
 			fmt.Sprintf("Pod killed by chaos experiment %s", exp.Name))
 
-		if err := r.Delete(ctx, &pod); err != nil {
+		injectedAt := time.Now()
+		if err := r.writer(ctx).Delete(ctx, &pod); err != nil {
 			log.Error(err, "Failed to delete pod", "pod", pod.Name)
 			chaosErr := WrapK8sError(err, "delete pod")
 			chaosmetrics.ExperimentErrors.WithLabelValues("pod-kill", exp.Spec.Namespace, string(chaosErr.Type)).Inc()
 		} else {
 			killedPods = append(killedPods, pod.Name)
+			r.trackPodReplacement(exp, "pod-kill", exp.Spec.Namespace, &pod, injectedAt)
 		}
 	}
 
@@ -296,6 +544,7 @@ func (r *ChaosExperimentReconciler) handlePodKill(ctx context.Context, exp *chao
 	now := metav1.Now()
 	exp.Status.LastRunTime = &now
 	exp.Status.Message = fmt.Sprintf("Successfully killed %d pod(s)", len(killedPods))
+	countAffected(exp, len(killedPods))
 
 	// Reset retry counters on success
 	if err := r.handleExperimentSuccess(ctx, exp); err != nil {
@@ -316,17 +565,13 @@ func (r *ChaosExperimentReconciler) handlePodKill(ctx context.Context, exp *chao
 		// Don't fail the experiment if history recording fails
 	}
 
-	return ctrl.Result{RequeueAfter: time.Minute}, nil
+	return ctrl.Result{RequeueAfter: r.roundInterval(exp)}, nil
 }
 
 func (r *ChaosExperimentReconciler) handlePodDelay(ctx context.Context, exp *chaosv1alpha1.ChaosExperiment) (ctrl.Result, error) {
 	log := ctrl.LoggerFrom(ctx)
 	startTime := time.Now()
 
-	// Track active experiments
-	chaosmetrics.ActiveExperiments.WithLabelValues("pod-delay").Inc()
-	defer chaosmetrics.ActiveExperiments.WithLabelValues("pod-delay").Dec()
-
 	// Validate namespace
 	if exp.Spec.Namespace == "" {
 		log.Error(nil, "Namespace not specified")
@@ -376,10 +621,8 @@ func (r *ChaosExperimentReconciler) handlePodDelay(ctx context.Context, exp *cha
 		return ctrl.Result{}, r.handleDryRun(ctx, exp, eligiblePods, fmt.Sprintf("add %dms network delay to", delayMs))
 	}
 
-	// Shuffle the list of eligible pods
-	rand.Shuffle(len(eligiblePods), func(i, j int) {
-		eligiblePods[i], eligiblePods[j] = eligiblePods[j], eligiblePods[i]
-	})
+	// Order eligible pods so the first Count entries are the targets
+	eligiblePods = r.orderTargetPods(ctx, exp, eligiblePods)
 
 	// Determine how many pods to affect
 	affectCount := exp.Spec.Count
@@ -392,13 +635,20 @@ func (r *ChaosExperimentReconciler) handlePodDelay(ctx context.Context, exp *cha
 
 	// Apply network delay to selected pods
 	affectedPods := []string{}
+	// failureReason explains the first target that could not be delayed, when actionable
+	failureReason := ""
 	for i := 0; i < affectCount; i++ {
 		pod := eligiblePods[i]
 		log.Info("Adding network delay to pod", "pod", pod.Name, "namespace", pod.Namespace, "delay", delayMs)
 
 		// Apply delay using tc (traffic control)
-		if err := r.applyNetworkDelay(ctx, &pod, delayMs); err != nil {
+		if err := r.applyNetworkDelay(ctx, &pod, delayMs, exp.Spec.NetAdminFallback); err != nil {
 			log.Error(err, "Failed to apply network delay", "pod", pod.Name)
+			chaosErr := WrapK8sError(err, "exec pod")
+			chaosmetrics.ExperimentErrors.WithLabelValues("pod-delay", exp.Spec.Namespace, string(chaosErr.Type)).Inc()
+			if failureReason == "" {
+				failureReason = injectionFailureReason(err)
+			}
 		} else {
 			// Emit event on the affected pod
 			r.Recorder.Eventf(&pod, corev1.EventTypeWarning, "ChaosPodNetworkDelay",
@@ -415,8 +665,12 @@ func (r *ChaosExperimentReconciler) handlePodDelay(ctx context.Context, exp *cha
 		exp.Status.Message = fmt.Sprintf("Successfully added %dms delay to %d pod(s)", delayMs, len(affectedPods))
 	} else {
 		exp.Status.Message = "Failed to add delay to any pods"
+		if failureReason != "" {
+			exp.Status.Message += ": " + failureReason
+		}
 		status = statusFailure
 	}
+	countAffected(exp, len(affectedPods))
 	if err := r.Status().Update(ctx, exp); err != nil {
 		log.Error(err, "Failed to update ChaosExperiment status")
 		return ctrl.Result{}, err
@@ -442,7 +696,7 @@ func (r *ChaosExperimentReconciler) handlePodDelay(ctx context.Context, exp *cha
 		// Don't fail the experiment if history recording fails
 	}
 
-	return ctrl.Result{RequeueAfter: time.Minute}, nil
+	return ctrl.Result{RequeueAfter: r.roundInterval(exp)}, nil
 }
 
 // handlePodCPUStress injects ephemeral containers with stress-ng to consume CPU resources
@@ -450,10 +704,6 @@ func (r *ChaosExperimentReconciler) handlePodCPUStress(ctx context.Context, exp
 	log := ctrl.LoggerFrom(ctx)
 	startTime := time.Now()
 
-	// Track active experiments
-	chaosmetrics.ActiveExperiments.WithLabelValues("pod-cpu-stress").Inc()
-	defer chaosmetrics.ActiveExperiments.WithLabelValues("pod-cpu-stress").Dec()
-
 	// Validate namespace
 	if exp.Spec.Namespace == "" {
 		chaosErr := &ChaosError{
@@ -464,9 +714,9 @@ func (r *ChaosExperimentReconciler) handlePodCPUStress(ctx context.Context, exp
 	}
 
 	// Validate required fields for pod-cpu-stress
-	if exp.Spec.CPULoad <= 0 {
+	if exp.Spec.CPULoad <= 0 && exp.Spec.RelativeLoad == "" {
 		chaosErr := &ChaosError{
-			Original: fmt.Errorf("CPULoad must be specified and greater than 0 for pod-cpu-stress"),
+			Original: fmt.Errorf("CPULoad or RelativeLoad must be specified for pod-cpu-stress"),
 			Type:     ErrorTypeValidation,
 		}
 		return r.handleExperimentFailure(ctx, exp, chaosErr)
@@ -496,11 +746,7 @@ func (r *ChaosExperimentReconciler) handlePodCPUStress(ctx context.Context, exp
 		if isPermissionDeniedError(err) {
 			return ctrl.Result{}, r.handlePermissionDenied(ctx, exp, "listing pods for pod-cpu-stress", err)
 		}
-		return r.handleExperimentFailure(ctx, exp, &ChaosError{
-			Original:  fmt.Errorf("failed to get eligible pods: %w", err),
-			Type:      ErrorTypeExecution,
-			Operation: "list eligible pods",
-		})
+		return r.handleExperimentFailure(ctx, exp, WrapK8sError(fmt.Errorf("failed to get eligible pods: %w", err), "list eligible pods"))
 	}
 
 	if len(eligiblePods) == 0 {
@@ -512,13 +758,11 @@ func (r *ChaosExperimentReconciler) handlePodCPUStress(ctx context.Context, exp
 
 	// Handle dry-run mode
 	if exp.Spec.DryRun {
-		return ctrl.Result{}, r.handleDryRun(ctx, exp, eligiblePods, fmt.Sprintf("apply %d%% CPU stress to", exp.Spec.CPULoad))
+		return ctrl.Result{}, r.handleDryRun(ctx, exp, eligiblePods, fmt.Sprintf("apply %s CPU stress to", cpuLoadLabel(&exp.Spec)))
 	}
 
-	// Shuffle the list of eligible pods
-	rand.Shuffle(len(eligiblePods), func(i, j int) {
-		eligiblePods[i], eligiblePods[j] = eligiblePods[j], eligiblePods[i]
-	})
+	// Order eligible pods so the first Count entries are the targets
+	eligiblePods = r.orderTargetPods(ctx, exp, eligiblePods)
 
 	// Determine how many pods to affect
 	affectCount := exp.Spec.Count
@@ -529,48 +773,96 @@ func (r *ChaosExperimentReconciler) handlePodCPUStress(ctx context.Context, exp
 		affectCount = len(eligiblePods)
 	}
 
-	// Set default CPU workers if not specified
-	cpuWorkers := exp.Spec.CPUWorkers
-	if cpuWorkers <= 0 {
-		cpuWorkers = 1
-	}
-
 	// Apply CPU stress to selected pods
-	affectedPods := []string{}
+	injected := []injectedContainer{}
+	// planErr is the first pod whose CPU limit the stress cannot be sized against
+	var planErr *ChaosError
+	// pullErr is the first pod where neither the stress image nor the fallback can be pulled
+	var pullErr *ChaosError
+	// fellBack is the first pod that got the built-in stressor instead of stress-ng
+	var fellBack *imagePullError
 	for i := 0; i < affectCount; i++ {
 		pod := eligiblePods[i]
+		if !r.targetInjectable(ctx, exp, &pod) {
+			continue
+		}
+		// Size the stress against the pod's CPU limit rather than the node's CPUs
+		plan, err := planCPUStress(&exp.Spec, &pod)
+		if err != nil {
+			log.Error(err, "Cannot size CPU stress for pod", "pod", pod.Name)
+			if planErr == nil {
+				planErr = &ChaosError{Original: err, Type: ErrorTypeValidation, Operation: "size cpu stress"}
+			}
+			continue
+		}
 		log.Info("Injecting CPU stress into pod",
 			"pod", pod.Name,
 			"namespace", pod.Namespace,
-			"cpuLoad", exp.Spec.CPULoad,
-			"cpuWorkers", cpuWorkers,
+			"cpuLoad", plan.Load,
+			"cpuWorkers", plan.Workers,
+			"cpuLimitMillis", plan.LimitMillis,
 			"duration", durationSeconds)
 
 		// Inject ephemeral container with stress-ng
-		containerName, err := r.injectCPUStressContainer(ctx, &pod, exp.Spec.CPULoad, cpuWorkers, durationSeconds)
+		containerName, podFellBack, err := r.injectCPUStressContainer(ctx, &pod, plan.Load, plan.Workers, durationSeconds)
 		if err != nil {
 			log.Error(err, "Failed to inject CPU stress container", "pod", pod.Name)
 			chaosErr := WrapK8sError(err, "update pod/ephemeralcontainers")
 			chaosmetrics.ExperimentErrors.WithLabelValues("pod-cpu-stress", exp.Spec.Namespace, string(chaosErr.Type)).Inc()
+			if chaosErr.Type == ErrorTypeImagePull && pullErr == nil {
+				pullErr = chaosErr
+			}
 		} else if containerName != "" {
+			if podFellBack != nil {
+				r.Recorder.Eventf(&pod, corev1.EventTypeWarning, "ChaosStressImageFallback",
+					"%s; using the built-in stressor", podFellBack.Error())
+				if fellBack == nil {
+					fellBack = podFellBack
+				}
+			}
+
 			// Emit event on the affected pod
 			r.Recorder.Eventf(&pod, corev1.EventTypeWarning, "ChaosPodCPUStress",
 				"Injected CPU stress (%d%% load, %d workers) by chaos experiment %s",
-				exp.Spec.CPULoad, cpuWorkers, exp.Name)
+				plan.Load, plan.Workers, exp.Name)
 
 			// Track the affected pod for cleanup later
-			r.trackAffectedPod(exp, pod.Namespace, pod.Name, containerName)
-			affectedPods = append(affectedPods, pod.Name)
+			r.trackAffectedPod(ctx, exp, pod.Namespace, pod.Name, containerName)
+			injected = append(injected, injectedContainer{
+				Pod: client.ObjectKeyFromObject(&pod), Container: containerName, Probe: cpuStressProbe(plan),
+			})
 		}
 	}
 
+	// Wait for the injected containers to start; those that failed do not count as affected
+	affectedPods, startErr := r.verifyInjections(ctx, exp, injected)
+	if len(affectedPods) == 0 && startErr != nil {
+		return r.handleExperimentFailure(ctx, exp, startErr)
+	}
+
+	// Record how the targets' autoscalers react to the stress
+	if exp.Spec.AutoscalerPolicy != "" {
+		r.observeAutoscalers(ctx, exp, eligiblePods[:affectCount])
+	}
+
+	if len(affectedPods) == 0 && pullErr != nil {
+		// Waiting on an image that cannot be pulled would keep the experiment running forever
+		return r.handleExperimentFailure(ctx, exp, pullErr)
+	}
+	if len(affectedPods) == 0 && planErr != nil {
+		return r.handleExperimentFailure(ctx, exp, planErr)
+	}
+
 	// Update status
 	now := metav1.Now()
 	exp.Status.LastRunTime = &now
 	status := statusSuccess
 	if len(affectedPods) > 0 {
-		exp.Status.Message = fmt.Sprintf("Successfully applied %d%% CPU stress to %d pod(s) for %ds",
-			exp.Spec.CPULoad, len(affectedPods), durationSeconds)
+		exp.Status.Message = fmt.Sprintf("Successfully applied %s CPU stress to %d pod(s) for %ds",
+			cpuLoadLabel(&exp.Spec), len(affectedPods), durationSeconds)
+		if fellBack != nil {
+			exp.Status.Message += "; used the built-in stressor because " + fellBack.Error()
+		}
 		// Reset retry count on success
 		exp.Status.RetryCount = 0
 		exp.Status.LastError = ""
@@ -579,6 +871,7 @@ func (r *ChaosExperimentReconciler) handlePodCPUStress(ctx context.Context, exp
 		exp.Status.Message = "Failed to apply CPU stress to any pods"
 		status = statusFailure
 	}
+	countAffected(exp, len(affectedPods))
 	if err := r.Status().Update(ctx, exp); err != nil {
 		log.Error(err, "Failed to update ChaosExperiment status")
 		return ctrl.Result{}, err
@@ -591,7 +884,7 @@ func (r *ChaosExperimentReconciler) handlePodCPUStress(ctx context.Context, exp
 	chaosmetrics.ResourcesAffected.WithLabelValues("pod-cpu-stress", exp.Spec.Namespace, exp.Name).Set(float64(len(affectedPods)))
 
 	// Create history record
-	affectedResources := buildResourceReferences(fmt.Sprintf("cpu-stress-%d%%", exp.Spec.CPULoad), exp.Spec.Namespace, affectedPods, "Pod")
+	affectedResources := buildResourceReferences("cpu-stress-"+strings.ReplaceAll(cpuLoadLabel(&exp.Spec), " ", "-"), exp.Spec.Namespace, affectedPods, "Pod")
 	var errorDetails *chaosv1alpha1.ErrorDetails
 	if status == statusFailure {
 		errorDetails = &chaosv1alpha1.ErrorDetails{
@@ -604,7 +897,7 @@ func (r *ChaosExperimentReconciler) handlePodCPUStress(ctx context.Context, exp
 		// Don't fail the experiment if history recording fails
 	}
 
-	return ctrl.Result{RequeueAfter: time.Minute}, nil
+	return ctrl.Result{RequeueAfter: r.roundInterval(exp)}, nil
 }
 
 // handleNodeCPUStress deploys a privileged pod running stress-ng to consume CPU resources on the target node
@@ -612,10 +905,6 @@ func (r *ChaosExperimentReconciler) handleNodeCPUStress(ctx context.Context, exp
 	log := ctrl.LoggerFrom(ctx)
 	startTime := time.Now()
 
-	// Track active experiments
-	chaosmetrics.ActiveExperiments.WithLabelValues("node-cpu-stress").Inc()
-	defer chaosmetrics.ActiveExperiments.WithLabelValues("node-cpu-stress").Dec()
-
 	// Validate required fields for node-cpu-stress
 	if exp.Spec.CPULoad <= 0 {
 		return r.handleExperimentFailure(ctx, exp, &ChaosError{
@@ -651,11 +940,7 @@ func (r *ChaosExperimentReconciler) handleNodeCPUStress(ctx context.Context, exp
 		if isPermissionDeniedError(err) {
 			return ctrl.Result{}, r.handlePermissionDenied(ctx, exp, "listing nodes for node-cpu-stress", err)
 		}
-		return r.handleExperimentFailure(ctx, exp, &ChaosError{
-			Original:  fmt.Errorf("failed to list nodes: %w", err),
-			Type:      ErrorTypeExecution,
-			Operation: "list nodes for node-cpu-stress",
-		})
+		return r.handleExperimentFailure(ctx, exp, WrapK8sError(fmt.Errorf("failed to list nodes: %w", err), "list nodes for node-cpu-stress"))
 	}
 
 	if len(nodeList.Items) == 0 {
@@ -728,7 +1013,8 @@ func (r *ChaosExperimentReconciler) handleNodeCPUStress(ctx context.Context, exp
 		podName, err := r.deployNodeCPUStressPod(ctx, exp, node.Name, cpuWorkers, exp.Spec.CPULoad, durationSeconds)
 		if err != nil {
 			log.Error(err, "Failed to deploy CPU stress pod", "node", node.Name)
-			chaosmetrics.ExperimentErrors.WithLabelValues("node-cpu-stress", exp.Spec.Namespace).Inc()
+			chaosErr := WrapK8sError(err, "create stress pod")
+			chaosmetrics.ExperimentErrors.WithLabelValues("node-cpu-stress", exp.Spec.Namespace, string(chaosErr.Type)).Inc()
 			continue
 		}
 
@@ -755,6 +1041,7 @@ func (r *ChaosExperimentReconciler) handleNodeCPUStress(ctx context.Context, exp
 		exp.Status.Message = "Failed to apply CPU stress to any nodes"
 		status = statusFailure
 	}
+	countAffected(exp, len(affectedNodes))
 	if err := r.Status().Update(ctx, exp); err != nil {
 		log.Error(err, "Failed to update ChaosExperiment status")
 		return ctrl.Result{}, err
@@ -780,7 +1067,7 @@ func (r *ChaosExperimentReconciler) handleNodeCPUStress(ctx context.Context, exp
 		// Don't fail the experiment if history recording fails
 	}
 
-	return ctrl.Result{RequeueAfter: time.Minute}, nil
+	return ctrl.Result{RequeueAfter: r.roundInterval(exp)}, nil
 }
 
 // deployNodeCPUStressPod creates a pod directly assigned to the target node running stress-ng
@@ -796,15 +1083,10 @@ func (r *ChaosExperimentReconciler) deployNodeCPUStressPod(ctx context.Context,
 
 	pod := &corev1.Pod{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      podName,
-			Namespace: namespace,
-			Labels: map[string]string{
-				"chaos.gushchin.dev/experiment": exp.Name,
-				"chaos.gushchin.dev/action":     "node-cpu-stress",
-			},
-			OwnerReferences: []metav1.OwnerReference{
-				*metav1.NewControllerRef(exp, chaosv1alpha1.GroupVersion.WithKind("ChaosExperiment")),
-			},
+			Name:            podName,
+			Namespace:       namespace,
+			Labels:          experimentLabels(exp),
+			OwnerReferences: []metav1.OwnerReference{experimentControllerRef(exp)},
 		},
 		Spec: corev1.PodSpec{
 			NodeName:      targetNode,
@@ -842,7 +1124,7 @@ func (r *ChaosExperimentReconciler) deployNodeCPUStressPod(ctx context.Context,
 		},
 	}
 
-	if err := r.Create(ctx, pod); err != nil {
+	if err := r.writer(ctx).Create(ctx, pod); err != nil {
 		return "", fmt.Errorf("failed to create stress pod on node %s: %w", targetNode, err)
 	}
 
@@ -854,9 +1136,6 @@ func (r *ChaosExperimentReconciler) handleNodeDiskFill(ctx context.Context, exp
 	log := ctrl.LoggerFrom(ctx)
 	startTime := time.Now()
 
-	chaosmetrics.ActiveExperiments.WithLabelValues("node-disk-fill").Inc()
-	defer chaosmetrics.ActiveExperiments.WithLabelValues("node-disk-fill").Dec()
-
 	if exp.Spec.Duration == "" {
 		return r.handleExperimentFailure(ctx, exp, &ChaosError{
 			Original:  fmt.Errorf("duration is required for node-disk-fill action"),
@@ -892,11 +1171,7 @@ func (r *ChaosExperimentReconciler) handleNodeDiskFill(ctx context.Context, exp
 		if isPermissionDeniedError(err) {
 			return ctrl.Result{}, r.handlePermissionDenied(ctx, exp, "listing nodes for node-disk-fill", err)
 		}
-		return r.handleExperimentFailure(ctx, exp, &ChaosError{
-			Original:  fmt.Errorf("failed to list nodes: %w", err),
-			Type:      ErrorTypeExecution,
-			Operation: "list nodes for node-disk-fill",
-		})
+		return r.handleExperimentFailure(ctx, exp, WrapK8sError(fmt.Errorf("failed to list nodes: %w", err), "list nodes for node-disk-fill"))
 	}
 
 	if len(nodeList.Items) == 0 {
@@ -957,7 +1232,8 @@ func (r *ChaosExperimentReconciler) handleNodeDiskFill(ctx context.Context, exp
 		podName, err := r.deployNodeDiskFillPod(ctx, exp, node.Name, fillPercentage, targetPath, durationSeconds)
 		if err != nil {
 			log.Error(err, "Failed to deploy disk fill pod", "node", node.Name)
-			chaosmetrics.ExperimentErrors.WithLabelValues("node-disk-fill", exp.Spec.Namespace).Inc()
+			chaosErr := WrapK8sError(err, "create disk fill pod")
+			chaosmetrics.ExperimentErrors.WithLabelValues("node-disk-fill", exp.Spec.Namespace, string(chaosErr.Type)).Inc()
 			continue
 		}
 
@@ -982,6 +1258,7 @@ func (r *ChaosExperimentReconciler) handleNodeDiskFill(ctx context.Context, exp
 		exp.Status.Message = "Failed to fill disk on any nodes"
 		status = statusFailure
 	}
+	countAffected(exp, len(affectedNodes))
 	if err := r.Status().Update(ctx, exp); err != nil {
 		log.Error(err, "Failed to update ChaosExperiment status")
 		return ctrl.Result{}, err
@@ -1006,7 +1283,7 @@ func (r *ChaosExperimentReconciler) handleNodeDiskFill(ctx context.Context, exp
 		log.Error(err, "Failed to create history record")
 	}
 
-	return ctrl.Result{RequeueAfter: time.Minute}, nil
+	return ctrl.Result{RequeueAfter: r.roundInterval(exp)}, nil
 }
 
 // deployNodeDiskFillPod creates a privileged pod on the target node that fills disk space via a hostPath volume
@@ -1064,15 +1341,10 @@ rm -f "$FILE"
 
 	pod := &corev1.Pod{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      podName,
-			Namespace: namespace,
-			Labels: map[string]string{
-				"chaos.gushchin.dev/experiment": exp.Name,
-				"chaos.gushchin.dev/action":     "node-disk-fill",
-			},
-			OwnerReferences: []metav1.OwnerReference{
-				*metav1.NewControllerRef(exp, chaosv1alpha1.GroupVersion.WithKind("ChaosExperiment")),
-			},
+			Name:            podName,
+			Namespace:       namespace,
+			Labels:          experimentLabels(exp),
+			OwnerReferences: []metav1.OwnerReference{experimentControllerRef(exp)},
 		},
 		Spec: corev1.PodSpec{
 			NodeName:      targetNode,
@@ -1110,7 +1382,7 @@ rm -f "$FILE"
 		},
 	}
 
-	if err := r.Create(ctx, pod); err != nil {
+	if err := r.writer(ctx).Create(ctx, pod); err != nil {
 		return "", fmt.Errorf("failed to create disk fill pod on node %s: %w", targetNode, err)
 	}
 
@@ -1148,8 +1420,17 @@ func (r *ChaosExperimentReconciler) updatePodWithEphemeralContainer(ctx context.
 		}
 
 		// Try to update the pod with the ephemeral container
-		err := r.Client.SubResource("ephemeralcontainers").Update(ctx, currentPod)
+		err := r.writer(ctx).SubResource("ephemeralcontainers").Update(ctx, currentPod)
 		if err == nil {
+			// The labels only serve inventory; a pod that cannot be labeled is still injected
+			if err := r.markInjectedPod(ctx, currentPod, ephemeralContainer.Name); err != nil {
+				log.Error(err, "Failed to label injected pod", "pod", pod.Name)
+			}
+			r.cleanups.record(ctx, chaosv1alpha1.PendingCleanup{
+				Operation: chaosv1alpha1.CleanupStopContainer,
+				Pod:       pod.Namespace + "/" + pod.Name,
+				Container: ephemeralContainer.Name,
+			})
 			return nil // Success
 		}
 
@@ -1175,8 +1456,9 @@ func (r *ChaosExperimentReconciler) updatePodWithEphemeralContainer(ctx context.
 }
 
 // injectCPUStressContainer adds an ephemeral container with stress-ng to the pod
-// Returns the container name for tracking purposes
-func (r *ChaosExperimentReconciler) injectCPUStressContainer(ctx context.Context, pod *corev1.Pod, cpuLoad, cpuWorkers, durationSeconds int) (string, error) {
+// Returns the container name for tracking purposes, and the pull error of the stress image when
+// the built-in stressor was used instead
+func (r *ChaosExperimentReconciler) injectCPUStressContainer(ctx context.Context, pod *corev1.Pod, cpuLoad, cpuWorkers, durationSeconds int) (string, *imagePullError, error) {
 	log := ctrl.LoggerFrom(ctx)
 
 	// Generate unique container name based on experiment
@@ -1185,7 +1467,7 @@ func (r *ChaosExperimentReconciler) injectCPUStressContainer(ctx context.Context
 	// Get the current pod to check container statuses
 	currentPod := &corev1.Pod{}
 	if err := r.Get(ctx, client.ObjectKeyFromObject(pod), currentPod); err != nil {
-		return "", fmt.Errorf("failed to get current pod state: %w", err)
+		return "", nil, fmt.Errorf("failed to get current pod state: %w", err)
 	}
 
 	// Check if a chaos-cpu-stress ephemeral container is still running
@@ -1197,7 +1479,7 @@ func (r *ChaosExperimentReconciler) injectCPUStressContainer(ctx context.Context
 				log.Info("Chaos CPU stress container is already running, skipping injection",
 					"pod", pod.Name,
 					"container", ec.Name)
-				return "", nil // Return empty name to indicate skipped
+				return "", nil, nil // Return empty name to indicate skipped
 			}
 			// Container exists but has completed, we can inject a new one
 			log.Info("Found completed chaos CPU stress container, will inject new one",
@@ -1207,32 +1489,39 @@ func (r *ChaosExperimentReconciler) injectCPUStressContainer(ctx context.Context
 		}
 	}
 
+	// Use the built-in stressor when an earlier container could not pull the stress-ng image
+	stressImage, fallbackImage := r.stressImages(defaultCPUStressImage)
+	image, fellBack, pullErr := chooseStressImage(currentPod, "chaos-cpu-stress", stressImage, fallbackImage)
+	if pullErr != nil {
+		return "", nil, pullErr
+	}
+	command := []string{
+		"stress-ng",
+		"--cpu", fmt.Sprintf("%d", cpuWorkers),
+		"--cpu-load", fmt.Sprintf("%d", cpuLoad),
+		"--timeout", fmt.Sprintf("%ds", durationSeconds),
+		"--metrics-brief",
+	}
+	if fellBack != nil {
+		log.Info("Stress image cannot be pulled, using the built-in CPU stressor",
+			"pod", pod.Name, "image", fellBack.Image, "reason", fellBack.Reason)
+		command = []string{"/bin/sh", "-c", builtinCPUStressScript(cpuLoad, cpuWorkers, durationSeconds)}
+	}
+
 	// Create ephemeral container spec with stress-ng
 	ephemeralContainer := corev1.EphemeralContainer{
 		EphemeralContainerCommon: corev1.EphemeralContainerCommon{
-			Name:  containerName,
-			Image: "alexeiled/stress-ng:latest-alpine",
-			Command: []string{
-				"stress-ng",
-				"--cpu", fmt.Sprintf("%d", cpuWorkers),
-				"--cpu-load", fmt.Sprintf("%d", cpuLoad),
-				"--timeout", fmt.Sprintf("%ds", durationSeconds),
-				"--metrics-brief",
-			},
-			Resources: corev1.ResourceRequirements{
-				Limits: corev1.ResourceList{
-					corev1.ResourceCPU: resource.MustParse(fmt.Sprintf("%d", cpuWorkers)),
-				},
-				Requests: corev1.ResourceList{
-					corev1.ResourceCPU: resource.MustParse("100m"),
-				},
-			},
+			Name:    containerName,
+			Image:   image,
+			Command: command,
+			// Ephemeral containers cannot set resources; the stressor runs in the pod's cgroup
+			// and is throttled by its CPU limit, which planCPUStress sized the load against
 		},
 	}
 
 	// Update the pod with the ephemeral container using retry logic
 	if err := r.updatePodWithEphemeralContainer(ctx, pod, ephemeralContainer); err != nil {
-		return "", err
+		return "", nil, err
 	}
 
 	log.Info("Successfully injected CPU stress ephemeral container",
@@ -1242,7 +1531,7 @@ func (r *ChaosExperimentReconciler) injectCPUStressContainer(ctx context.Context
 		"cpuWorkers", cpuWorkers,
 		"duration", durationSeconds)
 
-	return containerName, nil
+	return containerName, fellBack, nil
 }
 
 // parseDurationToSeconds converts duration string to seconds
@@ -1254,36 +1543,19 @@ func (r *ChaosExperimentReconciler) parseDurationToSeconds(durationStr string) (
 	return int(duration.Seconds()), nil
 }
 
-// parseDurationToMs parses a duration string (e.g., "30s", "5m", "1h") and returns milliseconds
+// parseDurationToMs parses a duration string (e.g., "250ms", "30s", "1h") and returns milliseconds
 func (r *ChaosExperimentReconciler) parseDurationToMs(durationStr string) (int, error) {
-	// Pattern: ^([0-9]+(s|m|h))+$
-	re := regexp.MustCompile(`(\d+)([smh])`)
-	matches := re.FindAllStringSubmatch(durationStr, -1)
-
-	if len(matches) == 0 {
-		return 0, fmt.Errorf("invalid duration format")
-	}
-
-	totalMs := 0
-	for _, match := range matches {
-		value, _ := strconv.Atoi(match[1])
-		unit := match[2]
-
-		switch unit {
-		case "s":
-			totalMs += value * 1000
-		case "m":
-			totalMs += value * 60 * 1000
-		case "h":
-			totalMs += value * 60 * 60 * 1000
-		}
+	duration, err := r.parseDuration(durationStr)
+	if err != nil {
+		return 0, err
 	}
-
-	return totalMs, nil
+	return int(duration.Milliseconds()), nil
 }
 
-// applyNetworkDelay adds network latency to a pod using tc (traffic control)
-func (r *ChaosExperimentReconciler) applyNetworkDelay(ctx context.Context, pod *corev1.Pod, delayMs int) error {
+// applyNetworkDelay adds network latency to a pod using tc (traffic control). The first container
+// is probed for NET_ADMIN and tc first; when it lacks either, the delay is applied from a helper
+// container if fallback is set and a *netAdminError is returned otherwise.
+func (r *ChaosExperimentReconciler) applyNetworkDelay(ctx context.Context, pod *corev1.Pod, delayMs int, fallback bool) error {
 	log := ctrl.LoggerFrom(ctx)
 
 	// Find the first container (we'll apply delay to the pod network namespace)
@@ -1292,30 +1564,32 @@ func (r *ChaosExperimentReconciler) applyNetworkDelay(ctx context.Context, pod *
 	}
 	containerName := pod.Spec.Containers[0].Name
 
-	// Commands to apply network delay using tc
-	commands := [][]string{
-		// First, try to delete any existing qdisc (ignore errors)
-		{"tc", "qdisc", "del", "dev", "eth0", "root"},
-		// Add delay using netem
-		{"tc", "qdisc", "add", "dev", "eth0", "root", "netem", "delay", fmt.Sprintf("%dms", delayMs)},
-	}
-
-	for i, command := range commands {
-		stdout, stderr, err := r.execInPod(ctx, pod.Namespace, pod.Name, containerName, command)
-		if err != nil && i > 0 { // Ignore error for delete command (first command)
-			log.Error(err, "Failed to execute command in pod",
-				"pod", pod.Name,
-				"command", strings.Join(command, " "),
-				"stdout", stdout,
-				"stderr", stderr)
+	if err := r.probeNetAdmin(ctx, pod, containerName); err != nil {
+		var netAdminErr *netAdminError
+		if !fallback || !errors.As(err, &netAdminErr) {
 			return err
 		}
-		log.Info("Executed command in pod",
+		log.Info("Target container cannot apply the delay, using a helper container",
+			"pod", pod.Name, "container", containerName, "missing", netAdminErr.Missing)
+		_, err := r.injectNetworkDelayHelper(ctx, pod, delayMs)
+		return err
+	}
+
+	// Replace the root qdisc of every default-route interface with a netem delay
+	command := []string{"/bin/sh", "-c", netemReplaceScript(fmt.Sprintf("delay %dms", delayMs))}
+	stdout, stderr, err := r.execInPod(ctx, pod.Namespace, pod.Name, containerName, command)
+	if err != nil {
+		log.Error(err, "Failed to execute command in pod",
 			"pod", pod.Name,
-			"command", strings.Join(command, " "),
 			"stdout", stdout,
 			"stderr", stderr)
+		return err
 	}
+	log.Info("Executed command in pod",
+		"pod", pod.Name,
+		"delayMs", delayMs,
+		"stdout", stdout,
+		"stderr", stderr)
 
 	return nil
 }
@@ -1336,7 +1610,7 @@ func (r *ChaosExperimentReconciler) execInPod(ctx context.Context, namespace, po
 			TTY:       false,
 		}, scheme.ParameterCodec)
 
-	exec, err := remotecommand.NewSPDYExecutor(r.Config, "POST", req.URL())
+	exec, err := remotecommand.NewSPDYExecutor(r.execConfig(ctx), "POST", req.URL())
 	if err != nil {
 		return "", "", fmt.Errorf("failed to create executor: %w", err)
 	}
@@ -1355,10 +1629,6 @@ func (r *ChaosExperimentReconciler) handleNodeDrain(ctx context.Context, exp *ch
 	log := ctrl.LoggerFrom(ctx)
 	startTime := time.Now()
 
-	// Track active experiments
-	chaosmetrics.ActiveExperiments.WithLabelValues("node-drain").Inc()
-	defer chaosmetrics.ActiveExperiments.WithLabelValues("node-drain").Dec()
-
 	// List nodes by selector
 	nodeList := &corev1.NodeList{}
 	selector := labels.SelectorFromSet(exp.Spec.Selector)
@@ -1379,6 +1649,32 @@ func (r *ChaosExperimentReconciler) handleNodeDrain(ctx context.Context, exp *ch
 		return ctrl.Result{RequeueAfter: time.Minute}, nil
 	}
 
+	// Exclude control-plane nodes unless explicitly allowed
+	if !exp.Spec.AllowControlPlane {
+		workerNodes := []corev1.Node{}
+		for _, node := range nodeList.Items {
+			if isControlPlaneNode(&node) {
+				log.Info("Skipping control-plane node", "node", node.Name)
+				continue
+			}
+			workerNodes = append(workerNodes, node)
+		}
+		if excluded := len(nodeList.Items) - len(workerNodes); excluded > 0 {
+			chaosmetrics.SafetyExcludedResources.WithLabelValues(
+				exp.Spec.Action,
+				exp.Spec.Namespace,
+				"control-plane",
+			).Add(float64(excluded))
+		}
+		nodeList.Items = workerNodes
+
+		if len(nodeList.Items) == 0 {
+			exp.Status.Message = "No eligible nodes found (control-plane nodes are excluded)"
+			_ = r.Status().Update(ctx, exp)
+			return ctrl.Result{RequeueAfter: time.Minute}, nil
+		}
+	}
+
 	// Handle dry-run mode for nodes
 	if exp.Spec.DryRun {
 		count := exp.Spec.Count
@@ -1422,19 +1718,100 @@ func (r *ChaosExperimentReconciler) handleNodeDrain(ctx context.Context, exp *ch
 		drainCount = len(nodeList.Items)
 	}
 
-	// Cordon and drain selected nodes
+	// Gather cluster state for the maxUnavailableNodes guard and the capacity pre-check
+	allNodes := &corev1.NodeList{}
+	if err := r.List(ctx, allNodes); err != nil {
+		log.Error(err, "Failed to list nodes")
+		return ctrl.Result{}, err
+	}
+	allPods, err := r.listPods(ctx)
+	if err != nil {
+		log.Error(err, "Failed to list pods")
+		return ctrl.Result{}, err
+	}
+	podsByNode := make(map[string][]corev1.Pod)
+	for _, pod := range allPods {
+		if pod.Spec.NodeName != "" {
+			podsByNode[pod.Spec.NodeName] = append(podsByNode[pod.Spec.NodeName], pod)
+		}
+	}
+	unavailableNodes := 0
+	for i := range allNodes.Items {
+		if isNodeUnavailable(&allNodes.Items[i]) {
+			unavailableNodes++
+		}
+	}
+	// Prefer nodes whose pods are the cheapest to delete
+	if honorsEvictionAnnotations(&exp.Spec) {
+		sortNodesByDeletionCost(nodeList.Items, podsByNode)
+	}
+
+	// A drain timeout makes each node's drain wait for its pods and roll back when they don't go
+	var drainTimeout time.Duration
+	if exp.Spec.DrainTimeout != "" {
+		if drainTimeout, err = r.parseDuration(exp.Spec.DrainTimeout); err != nil {
+			return r.handleExperimentFailure(ctx, exp, &ChaosError{
+				Original:  fmt.Errorf("invalid drainTimeout: %w", err),
+				Type:      ErrorTypeValidation,
+				Operation: "validate node-drain config",
+			})
+		}
+	}
+
+	// Cordon and drain selected nodes, moving on to the next candidate when a safety check fails
 	drainedNodes := []string{}
+	rolledBackNodes := []string{}
+	var unevicted []chaosv1alpha1.UnevictedPod
 	newlyCordonedNodes := []string{}
-	for i := 0; i < drainCount; i++ {
+	skippedNodes := []string{}
+	draining := make(map[string]bool)
+	attempted := 0
+	for i := 0; i < len(nodeList.Items) && attempted < drainCount; i++ {
 		node := &nodeList.Items[i]
+
+		// Respect the maxUnavailableNodes budget (nodes that are already down don't consume it)
+		nodeUnavailable := isNodeUnavailable(node)
+		if exp.Spec.MaxUnavailableNodes > 0 && !nodeUnavailable && unavailableNodes >= exp.Spec.MaxUnavailableNodes {
+			log.Info("Skipping node: maxUnavailableNodes reached", "node", node.Name,
+				"unavailable", unavailableNodes, "max", exp.Spec.MaxUnavailableNodes)
+			skippedNodes = append(skippedNodes, node.Name)
+			continue
+		}
+
+		// Nodes hosting a pod that is not safe to evict are off limits, as they are to the cluster-autoscaler
+		if honorsEvictionAnnotations(&exp.Spec) {
+			if blocker := nodeEvictionBlocker(podsByNode[node.Name]); blocker != "" {
+				log.Info("Skipping node: hosts a pod annotated not safe to evict", "node", node.Name, "pod", blocker)
+				skippedNodes = append(skippedNodes, node.Name)
+				continue
+			}
+		}
+
+		// Make sure the rest of the cluster can host the evicted pods. With nodeReplacement the
+		// autoscaler is expected to add the room, prompted by the pods left pending.
+		if exp.Spec.NodeReplacement == nil {
+			if err := checkDrainCapacity(node, allNodes.Items, podsByNode, draining); err != nil {
+				log.Info("Skipping node: capacity pre-check failed", "node", node.Name, "reason", err.Error())
+				skippedNodes = append(skippedNodes, node.Name)
+				continue
+			}
+		}
+
+		attempted++
 		log.Info("Cordoning and draining node", "node", node.Name)
 
-		// Cordon the node (mark as unschedulable)
-		wasAlreadyCordoned, err := r.cordonNode(ctx, node)
+		// Cordon the node (mark as unschedulable, or taint it for the autoscaler)
+		wasAlreadyCordoned, err := r.cordonForDrain(ctx, exp, node)
 		if err != nil {
 			log.Error(err, "Failed to cordon node", "node", node.Name)
+			chaosErr := WrapK8sError(err, "cordon node")
+			chaosmetrics.ExperimentErrors.WithLabelValues("node-drain", exp.Spec.Namespace, string(chaosErr.Type)).Inc()
 			continue
 		}
+		draining[node.Name] = true
+		if !nodeUnavailable {
+			unavailableNodes++
+		}
 
 		// Track nodes that we cordoned (not ones that were already cordoned)
 		if !wasAlreadyCordoned {
@@ -1442,8 +1819,31 @@ func (r *ChaosExperimentReconciler) handleNodeDrain(ctx context.Context, exp *ch
 		}
 
 		// Drain the node (evict pods)
-		if err := r.drainNode(ctx, node); err != nil {
+		if drainTimeout > 0 {
+			left, err := r.drainNodeWithin(ctx, node, drainTimeout)
+			if err != nil {
+				log.Error(err, "Failed to drain node", "node", node.Name)
+				chaosErr := WrapK8sError(err, "drain node")
+				chaosmetrics.ExperimentErrors.WithLabelValues("node-drain", exp.Spec.Namespace, string(chaosErr.Type)).Inc()
+				continue
+			}
+			if len(left) > 0 {
+				unevicted = append(unevicted, left...)
+				rolledBackNodes = append(rolledBackNodes, node.Name)
+				if r.rollbackDrain(ctx, exp, node, wasAlreadyCordoned, left) {
+					// Uncordoned again: the node neither needs reverting nor counts against the budget
+					newlyCordonedNodes = newlyCordonedNodes[:len(newlyCordonedNodes)-1]
+					delete(draining, node.Name)
+					if !nodeUnavailable {
+						unavailableNodes--
+					}
+				}
+				continue
+			}
+		} else if err := r.drainNode(ctx, node); err != nil {
 			log.Error(err, "Failed to drain node", "node", node.Name)
+			chaosErr := WrapK8sError(err, "drain node")
+			chaosmetrics.ExperimentErrors.WithLabelValues("node-drain", exp.Spec.Namespace, string(chaosErr.Type)).Inc()
 			continue
 		}
 
@@ -1471,13 +1871,28 @@ func (r *ChaosExperimentReconciler) handleNodeDrain(ctx context.Context, exp *ch
 	// Update status
 	now := metav1.Now()
 	exp.Status.LastRunTime = &now
+	exp.Status.UnevictedPods = unevicted
+	if exp.Spec.NodeReplacement != nil {
+		r.checkNodeReplacements(ctx, exp, now.Time)
+		recordDrainedNodes(exp, drainedNodes, now)
+	}
 	status := statusSuccess
-	if len(drainedNodes) > 0 {
+	switch {
+	case len(rolledBackNodes) > 0:
+		// Some pods moved before the drain was given up, so the round counts as partial
+		exp.Status.Message = fmt.Sprintf("Drained %d node(s): %v; rolled back %d node(s) after drainTimeout %s: %v (%d pod(s) could not be evicted)",
+			len(drainedNodes), drainedNodes, len(rolledBackNodes), exp.Spec.DrainTimeout, rolledBackNodes, len(unevicted))
+		status = statusPartial
+	case len(drainedNodes) > 0:
 		exp.Status.Message = fmt.Sprintf("Successfully drained %d node(s): %v", len(drainedNodes), drainedNodes)
-	} else {
+	default:
 		exp.Status.Message = "Failed to drain any nodes"
 		status = statusFailure
 	}
+	if len(skippedNodes) > 0 {
+		exp.Status.Message += fmt.Sprintf(" (skipped by safety checks: %v)", skippedNodes)
+	}
+	countAffected(exp, len(drainedNodes))
 	if err := r.Status().Update(ctx, exp); err != nil {
 		log.Error(err, "Failed to update ChaosExperiment status")
 		return ctrl.Result{}, err
@@ -1491,19 +1906,36 @@ func (r *ChaosExperimentReconciler) handleNodeDrain(ctx context.Context, exp *ch
 
 	// Create history record
 	affectedResources := buildResourceReferences("drained", "", drainedNodes, "Node")
+	affectedResources = append(affectedResources, buildResourceReferences("drain-rolled-back", "", rolledBackNodes, "Node")...)
+	for _, pod := range unevicted {
+		namespace, name, _ := strings.Cut(pod.Pod, "/")
+		affectedResources = append(affectedResources, chaosv1alpha1.ResourceReference{
+			Kind:      "Pod",
+			Name:      name,
+			Namespace: namespace,
+			Action:    "not-evicted",
+			Details:   pod.Reason,
+		})
+	}
 	var errorDetails *chaosv1alpha1.ErrorDetails
-	if status == statusFailure {
+	switch status {
+	case statusFailure:
 		errorDetails = &chaosv1alpha1.ErrorDetails{
 			Message:       exp.Status.Message,
 			FailureReason: "ExecutionError",
 		}
+	case statusPartial:
+		errorDetails = &chaosv1alpha1.ErrorDetails{
+			Message:       exp.Status.Message,
+			FailureReason: "Timeout",
+		}
 	}
 	if err := r.createHistoryRecord(ctx, exp, status, affectedResources, startTime, errorDetails); err != nil {
 		log.Error(err, "Failed to create history record")
 		// Don't fail the experiment if history recording fails
 	}
 
-	return ctrl.Result{RequeueAfter: time.Minute}, nil
+	return ctrl.Result{RequeueAfter: r.roundInterval(exp)}, nil
 }
 
 // cordonNode marks a node as unschedulable
@@ -1517,11 +1949,13 @@ func (r *ChaosExperimentReconciler) cordonNode(ctx context.Context, node *corev1
 		return true, nil
 	}
 
-	// Mark as unschedulable
+	// Mark as unschedulable, labeled with the experiment doing it
 	node.Spec.Unschedulable = true
-	if err := r.Update(ctx, node); err != nil {
+	markCordonedNode(ctx, node)
+	if err := r.writer(ctx).Update(ctx, node); err != nil {
 		return false, fmt.Errorf("failed to cordon node: %w", err)
 	}
+	r.cleanups.record(ctx, chaosv1alpha1.PendingCleanup{Operation: chaosv1alpha1.CleanupUncordon, Node: node.Name})
 
 	log.Info("Successfully cordoned node", "node", node.Name)
 	return false, nil
@@ -1537,14 +1971,17 @@ func (r *ChaosExperimentReconciler) uncordonNode(ctx context.Context, nodeName s
 		return fmt.Errorf("failed to get node: %w", err)
 	}
 
-	// Check if already uncordoned
-	if !node.Spec.Unschedulable {
+	// Check if already uncordoned; the experiment's marks are still removed
+	if !node.Spec.Unschedulable && node.Annotations[chaosv1alpha1.CordonedByAnnotation] == "" &&
+		node.Annotations[chaosv1alpha1.AutoscalerTaintAnnotation] == "" {
 		log.Info("Node is already uncordoned", "node", nodeName)
 		return nil
 	}
 
 	// Mark as schedulable
 	node.Spec.Unschedulable = false
+	removeAutoscalerTaint(node)
+	unmarkCordonedNode(node)
 	if err := r.Update(ctx, node); err != nil {
 		return fmt.Errorf("failed to uncordon node: %w", err)
 	}
@@ -1558,10 +1995,6 @@ func (r *ChaosExperimentReconciler) handleNodeTaint(ctx context.Context, exp *ch
 	log := ctrl.LoggerFrom(ctx)
 	startTime := time.Now()
 
-	// Track active experiments
-	chaosmetrics.ActiveExperiments.WithLabelValues("node-taint").Inc()
-	defer chaosmetrics.ActiveExperiments.WithLabelValues("node-taint").Dec()
-
 	// Validate required fields
 	if exp.Spec.TaintKey == "" || exp.Spec.TaintEffect == "" {
 		return r.handleExperimentFailure(ctx, exp, &ChaosError{
@@ -1645,6 +2078,8 @@ func (r *ChaosExperimentReconciler) handleNodeTaint(ctx context.Context, exp *ch
 		wasAlreadyTainted, err := r.taintNode(ctx, node, exp.Spec.TaintKey, exp.Spec.TaintValue, exp.Spec.TaintEffect)
 		if err != nil {
 			log.Error(err, "Failed to taint node", "node", node.Name)
+			chaosErr := WrapK8sError(err, "taint node")
+			chaosmetrics.ExperimentErrors.WithLabelValues("node-taint", exp.Spec.Namespace, string(chaosErr.Type)).Inc()
 			continue
 		}
 
@@ -1684,6 +2119,7 @@ func (r *ChaosExperimentReconciler) handleNodeTaint(ctx context.Context, exp *ch
 		exp.Status.Message = "Failed to taint any nodes"
 		status = statusFailure
 	}
+	countAffected(exp, len(taintedNodes))
 	if err := r.Status().Update(ctx, exp); err != nil {
 		log.Error(err, "Failed to update ChaosExperiment status")
 		return ctrl.Result{}, err
@@ -1709,7 +2145,7 @@ func (r *ChaosExperimentReconciler) handleNodeTaint(ctx context.Context, exp *ch
 		// Don't fail the experiment if history recording fails
 	}
 
-	return ctrl.Result{RequeueAfter: time.Minute}, nil
+	return ctrl.Result{RequeueAfter: r.roundInterval(exp)}, nil
 }
 
 // taintNode adds a taint to the node if it doesn't already have it
@@ -1730,9 +2166,10 @@ func (r *ChaosExperimentReconciler) taintNode(ctx context.Context, node *corev1.
 		Effect: taintEffect,
 	})
 
-	if err := r.Update(ctx, node); err != nil {
+	if err := r.writer(ctx).Update(ctx, node); err != nil {
 		return false, fmt.Errorf("failed to taint node: %w", err)
 	}
+	r.cleanups.record(ctx, chaosv1alpha1.PendingCleanup{Operation: chaosv1alpha1.CleanupUntaint, Node: node.Name})
 
 	return false, nil
 }
@@ -1785,17 +2222,17 @@ func (r *ChaosExperimentReconciler) untaintNode(ctx context.Context, nodeName, k
 func (r *ChaosExperimentReconciler) drainNode(ctx context.Context, node *corev1.Node) error {
 	log := ctrl.LoggerFrom(ctx)
 
-	// List all pods on this node
-	podList := &corev1.PodList{}
-	if err := r.List(ctx, podList, client.MatchingFields{"spec.nodeName": node.Name}); err != nil {
+	// List all pods on this node, in every namespace
+	pods, err := r.listPodsOnNode(ctx, node.Name)
+	if err != nil {
 		return fmt.Errorf("failed to list pods on node: %w", err)
 	}
 
-	log.Info("Found pods on node", "node", node.Name, "count", len(podList.Items))
+	log.Info("Found pods on node", "node", node.Name, "count", len(pods))
 
 	// Evict each pod
 	evictedCount := 0
-	for _, pod := range podList.Items {
+	for _, pod := range pods {
 		// Skip pods that are already terminating or in a final state
 		if pod.DeletionTimestamp != nil {
 			continue
@@ -1819,7 +2256,7 @@ func (r *ChaosExperimentReconciler) drainNode(ctx context.Context, node *corev1.
 		log.Info("Evicting pod from node", "pod", pod.Name, "namespace", pod.Namespace, "node", node.Name)
 
 		// Try to delete the pod gracefully
-		if err := r.Delete(ctx, &pod, client.GracePeriodSeconds(30)); err != nil {
+		if err := r.writer(ctx).Delete(ctx, &pod, client.GracePeriodSeconds(30)); err != nil {
 			log.Error(err, "Failed to evict pod", "pod", pod.Name, "namespace", pod.Namespace)
 			continue
 		}
@@ -1827,10 +2264,22 @@ func (r *ChaosExperimentReconciler) drainNode(ctx context.Context, node *corev1.
 		evictedCount++
 	}
 
-	log.Info("Evicted pods from node", "node", node.Name, "evicted", evictedCount, "total", len(podList.Items))
+	log.Info("Evicted pods from node", "node", node.Name, "evicted", evictedCount, "total", len(pods))
 	return nil
 }
 
+// podNodeNameField indexes pods by the node they are scheduled to
+const podNodeNameField = "spec.nodeName"
+
+// indexPodNodeName is the field indexer for podNodeNameField
+func indexPodNodeName(obj client.Object) []string {
+	pod, ok := obj.(*corev1.Pod)
+	if !ok || pod.Spec.NodeName == "" {
+		return nil
+	}
+	return []string{pod.Spec.NodeName}
+}
+
 // isDaemonSetPod checks if a pod is managed by a DaemonSet
 func isDaemonSetPod(pod *corev1.Pod) bool {
 	for _, owner := range pod.OwnerReferences {
@@ -1841,6 +2290,12 @@ func isDaemonSetPod(pod *corev1.Pod) bool {
 	return false
 }
 
+// isJobPod checks if a pod is run by a Job, which includes the Jobs of a CronJob
+func isJobPod(pod *corev1.Pod) bool {
+	owner := metav1.GetControllerOf(pod)
+	return owner != nil && owner.Kind == "Job"
+}
+
 // isStaticPod checks if a pod is a static pod (managed by kubelet)
 func isStaticPod(pod *corev1.Pod) bool {
 	for _, owner := range pod.OwnerReferences {
@@ -1886,31 +2341,20 @@ func (r *ChaosExperimentReconciler) calculateRetryDelay(exp *chaosv1alpha1.Chaos
 	return baseDelay
 }
 
-// parseDuration parses a duration string (e.g., "30s", "5m", "1h") and returns time.Duration
-func (r *ChaosExperimentReconciler) parseDuration(durationStr string) (time.Duration, error) {
-	re := regexp.MustCompile(`(\d+)([smh])`)
-	matches := re.FindAllStringSubmatch(durationStr, -1)
-
-	if len(matches) == 0 {
-		return 0, fmt.Errorf("invalid duration format")
-	}
-
-	var totalDuration time.Duration
-	for _, match := range matches {
-		value, _ := strconv.Atoi(match[1])
-		unit := match[2]
-
-		switch unit {
-		case "s":
-			totalDuration += time.Duration(value) * time.Second
-		case "m":
-			totalDuration += time.Duration(value) * time.Minute
-		case "h":
-			totalDuration += time.Duration(value) * time.Hour
+// roundInterval returns spec.interval, the time from the start of one injection round to the next
+func (r *ChaosExperimentReconciler) roundInterval(exp *chaosv1alpha1.ChaosExperiment) time.Duration {
+	if exp.Spec.Interval != "" {
+		if interval, err := r.parseDuration(exp.Spec.Interval); err == nil && interval > 0 {
+			return interval
 		}
 	}
+	return defaultInterval
+}
 
-	return totalDuration, nil
+// parseDuration parses a duration string (e.g., "30s", "5m", "1h", "2d") and returns time.Duration.
+// The webhook restricts the units of each field; any unit is accepted here.
+func (r *ChaosExperimentReconciler) parseDuration(durationStr string) (time.Duration, error) {
+	return chaosduration.Parse(durationStr)
 }
 
 // shouldRetry determines if the experiment should be retried
@@ -1927,7 +2371,7 @@ func (r *ChaosExperimentReconciler) shouldRetry(exp *chaosv1alpha1.ChaosExperime
 // RBAC denial (403 Forbidden or 401 Unauthorized). It unwraps wrapped errors
 // so callers passing fmt.Errorf("%w", apiErr) are handled correctly.
 func isPermissionDeniedError(err error) bool {
-	if err == nil {
+	if err == nil || isPodSecurityRejection(err) {
 		return false
 	}
 	if apierrors.IsForbidden(err) || apierrors.IsUnauthorized(err) {
@@ -1960,6 +2404,9 @@ func (r *ChaosExperimentReconciler) handlePermissionDenied(
 			"Run: kubectl describe clusterrole chaos-operator-role",
 		operation, err,
 	)
+	chaosErr := WrapK8sError(err, operation)
+	chaosErr.Type = ErrorTypePermission
+	chaosmetrics.ExperimentErrors.WithLabelValues(exp.Spec.Action, exp.Spec.Namespace, string(chaosErr.Type)).Inc()
 
 	log.Error(err, "Permission denied",
 		"operation", operation,
@@ -1971,7 +2418,7 @@ func (r *ChaosExperimentReconciler) handlePermissionDenied(
 	exp.Status.LastRunTime = &now
 	exp.Status.Phase = phaseFailed
 	exp.Status.Message = msg
-	exp.Status.LastError = msg
+	exp.Status.LastError = chaosErr.StatusMessage()
 	// Clear retry state — no point retrying an RBAC issue
 	exp.Status.NextRetryTime = nil
 
@@ -1990,14 +2437,15 @@ func (r *ChaosExperimentReconciler) handlePermissionDenied(
 func (r *ChaosExperimentReconciler) handleExperimentFailure(ctx context.Context, exp *chaosv1alpha1.ChaosExperiment, chaosErr *ChaosError) (ctrl.Result, error) {
 	log := ctrl.LoggerFrom(ctx)
 
-	// Extract formatted error message
-	errorMsg := chaosErr.Error()
+	// Extract formatted error message; errors may quote stderr of commands run in target pods
+	errorMsg := r.Redactor.Scrub(chaosErr.Error())
 
 	// Update error information and last run time
 	now := metav1.Now()
 	exp.Status.LastRunTime = &now
-	exp.Status.LastError = errorMsg
+	exp.Status.LastError = r.Redactor.Scrub(chaosErr.StatusMessage())
 	exp.Status.Message = fmt.Sprintf("Failed: %s", errorMsg)
+	chaosmetrics.ExperimentErrors.WithLabelValues(exp.Spec.Action, exp.Spec.Namespace, string(chaosErr.Type)).Inc()
 
 	// Determine max retries and delay based on error type
 	maxRetries := exp.Spec.MaxRetries
@@ -2051,6 +2499,8 @@ func (r *ChaosExperimentReconciler) handleExperimentFailure(ctx context.Context,
 
 	// Max retries exceeded
 	exp.Status.Phase = phaseFailed
+	completedAt := metav1.Now()
+	exp.Status.CompletedAt = &completedAt
 	exp.Status.Message = fmt.Sprintf("Failed after %d retries: %s", exp.Status.RetryCount, errorMsg)
 	exp.Status.NextRetryTime = nil
 
@@ -2114,6 +2564,9 @@ func (r *ChaosExperimentReconciler) handleDryRun(ctx context.Context, exp *chaos
 	exp.Status.LastRunTime = &now
 	exp.Status.Message = fmt.Sprintf("DRY RUN: Would %s %d pod(s): %v",
 		actionType, count, podNames)
+	if exp.Status.BlastRadius != nil {
+		exp.Status.Message += fmt.Sprintf(" (blast radius: %s)", formatBlastRadius(exp.Status.BlastRadius))
+	}
 	exp.Status.Phase = phaseCompleted
 
 	if err := r.Status().Update(ctx, exp); err != nil {
@@ -2181,40 +2634,7 @@ func (r *ChaosExperimentReconciler) checkExperimentLifecycle(ctx context.Context
 			"duration", duration,
 			"endTime", endTime)
 
-		// Uncordon nodes that were cordoned by this experiment (for node-drain action)
-		if exp.Spec.Action == "node-drain" && len(exp.Status.CordonedNodes) > 0 {
-			log.Info("Uncordoning nodes that were cordoned by this experiment",
-				"nodes", exp.Status.CordonedNodes)
-			for _, nodeName := range exp.Status.CordonedNodes {
-				if err := r.uncordonNode(ctx, nodeName); err != nil {
-					log.Error(err, "Failed to uncordon node", "node", nodeName)
-					// Continue with other nodes even if one fails
-				}
-			}
-			// Clear the list after uncordoning
-			exp.Status.CordonedNodes = nil
-		}
-
-		// Untaint nodes that were tainted by this experiment (for node-taint action)
-		if exp.Spec.Action == "node-taint" && len(exp.Status.TaintedNodes) > 0 {
-			log.Info("Removing taints from nodes that were tainted by this experiment",
-				"nodes", exp.Status.TaintedNodes)
-			for _, nodeName := range exp.Status.TaintedNodes {
-				if err := r.untaintNode(ctx, nodeName, exp.Spec.TaintKey, exp.Spec.TaintEffect); err != nil {
-					log.Error(err, "Failed to untaint node", "node", nodeName)
-					// Continue with other nodes even if one fails
-				}
-			}
-			// Clear the list after untainting
-			exp.Status.TaintedNodes = nil
-		}
-
-		// Cleanup ephemeral containers for experiments using them (pod-cpu-stress, pod-memory-stress, pod-network-loss, pod-disk-fill)
-		if (exp.Spec.Action == "pod-cpu-stress" || exp.Spec.Action == "pod-memory-stress" || exp.Spec.Action == "pod-network-loss" || exp.Spec.Action == "pod-disk-fill") && len(exp.Status.AffectedPods) > 0 {
-			log.Info("Cleaning up ephemeral containers injected by this experiment",
-				"affectedPods", len(exp.Status.AffectedPods))
-			r.cleanupEphemeralContainers(ctx, exp)
-		}
+		r.revertActiveInjections(ctx, exp)
 
 		// Mark as completed
 		completedAt := metav1.Now()
@@ -2240,98 +2660,281 @@ func (r *ChaosExperimentReconciler) checkExperimentLifecycle(ctx context.Context
 	return true, nil
 }
 
-// getEligiblePods returns pods that match the selector and are not excluded
-func (r *ChaosExperimentReconciler) getEligiblePods(ctx context.Context, exp *chaosv1alpha1.ChaosExperiment) ([]corev1.Pod, error) {
+// revertActiveInjections undoes the lasting effects of an experiment: uncordons and untaints
+// the nodes it touched, removes injected ephemeral containers and balloon pods, releases stolen
+// leases and restores squeezed quotas and held autoscalers
+func (r *ChaosExperimentReconciler) revertActiveInjections(ctx context.Context, exp *chaosv1alpha1.ChaosExperiment) {
 	log := ctrl.LoggerFrom(ctx)
 
-	// Validate namespace
-	if exp.Spec.Namespace == "" {
-		return nil, fmt.Errorf("namespace not specified")
+	// Read the measurements before their containers are stopped
+	if len(exp.Status.NetworkMeasurements) > 0 {
+		r.collectNetworkMeasurements(ctx, exp)
 	}
 
-	// Choose Pods by selector
-	podList := &corev1.PodList{}
-	selector := labels.SelectorFromSet(exp.Spec.Selector)
-	if err := r.List(ctx, podList, client.InNamespace(exp.Spec.Namespace),
-		client.MatchingLabelsSelector{Selector: selector}); err != nil {
-		log.Error(err, "Failed to list pods")
-		return nil, fmt.Errorf("failed to list pods: %w", err)
+	// Give the last drains a final chance to see their replacement
+	if exp.Spec.NodeReplacement != nil {
+		r.checkNodeReplacements(ctx, exp, time.Now())
 	}
 
-	// Get namespace to check for exclusion annotation
-	ns := &corev1.Namespace{}
-	namespaceExcluded := false
-	if err := r.Get(ctx, client.ObjectKey{Name: exp.Spec.Namespace}, ns); err == nil {
-		if val, exists := ns.Annotations[chaosv1alpha1.ExclusionLabel]; exists && val == "true" {
-			namespaceExcluded = true
+	// Uncordon nodes that were cordoned by this experiment (for node-drain action)
+	if exp.Spec.Action == "node-drain" && len(exp.Status.CordonedNodes) > 0 {
+		log.Info("Uncordoning nodes that were cordoned by this experiment",
+			"nodes", exp.Status.CordonedNodes)
+		for _, nodeName := range exp.Status.CordonedNodes {
+			if err := r.uncordonNode(ctx, nodeName); err != nil {
+				log.Error(err, "Failed to uncordon node", "node", nodeName)
+				// Retry from a cleanup task and continue with other nodes
+				r.delegateCleanup(ctx, exp, chaosv1alpha1.PendingCleanup{
+					Operation: chaosv1alpha1.CleanupUncordon, Node: nodeName,
+				})
+				continue
+			}
+			observeNodeRecovery("node-drain", exp.Spec.Namespace, exp.Status.LastRunTime)
 		}
+		// Clear the list after uncordoning
+		exp.Status.CordonedNodes = nil
 	}
 
-	// Filter out excluded pods, terminating pods, and track exclusions in metrics
-	eligiblePods := []corev1.Pod{}
-	excludedByNamespace := 0
-	excludedByLabel := 0
-	excludedByTerminating := 0
-
-	for _, pod := range podList.Items {
-		// Skip if namespace is excluded
-		if namespaceExcluded {
-			excludedByNamespace++
-			continue
+	// Untaint nodes that were tainted by this experiment (for node-taint action)
+	if exp.Spec.Action == "node-taint" && len(exp.Status.TaintedNodes) > 0 {
+		log.Info("Removing taints from nodes that were tainted by this experiment",
+			"nodes", exp.Status.TaintedNodes)
+		for _, nodeName := range exp.Status.TaintedNodes {
+			if err := r.untaintNode(ctx, nodeName, exp.Spec.TaintKey, exp.Spec.TaintEffect); err != nil {
+				log.Error(err, "Failed to untaint node", "node", nodeName)
+				// Retry from a cleanup task and continue with other nodes
+				r.delegateCleanup(ctx, exp, chaosv1alpha1.PendingCleanup{
+					Operation: chaosv1alpha1.CleanupUntaint, Node: nodeName,
+				})
+				continue
+			}
+			observeNodeRecovery("node-taint", exp.Spec.Namespace, exp.Status.LastRunTime)
 		}
+		// Clear the list after untainting
+		exp.Status.TaintedNodes = nil
+	}
+
+	// Cleanup ephemeral containers for experiments using them (pod-cpu-stress, pod-memory-stress, pod-network-loss, pod-disk-fill)
+	if (exp.Spec.Action == "pod-cpu-stress" || exp.Spec.Action == "pod-memory-stress" || exp.Spec.Action == "pod-network-loss" || exp.Spec.Action == "pod-disk-fill") && len(exp.Status.AffectedPods) > 0 {
+		log.Info("Cleaning up ephemeral containers injected by this experiment",
+			"affectedPods", len(exp.Status.AffectedPods))
+		r.cleanupEphemeralContainers(ctx, exp)
+	}
+
+	// Stop failing the containers of a pod-failure run
+	if exp.Spec.Action == "pod-failure" && exp.Status.FailureEndsAt != nil {
+		exp.Status.FailureEndsAt = nil
+		clearAffectedPods(exp)
+	}
+
+	// Leave the targets a pod-restart run did not get to alone
+	if exp.Spec.Action == "pod-restart" && exp.Status.RollingRestart != nil {
+		log.Info("Stopping rolling restart", "remaining", len(exp.Status.RollingRestart.Pending))
+		exp.Status.RollingRestart = nil
+	}
+
+	// Hand back leases taken by lease-steal
+	if exp.Spec.Action == "lease-steal" && len(exp.Status.StolenLeases) > 0 {
+		r.releaseStolenLeases(ctx, exp)
+	}
 
-		// Skip if pod has exclusion label
-		if val, exists := pod.Labels[chaosv1alpha1.ExclusionLabel]; exists && val == "true" {
-			log.Info("Skipping excluded pod", "pod", pod.Name, "namespace", pod.Namespace)
-			excludedByLabel++
+	// Remove the balloon pods of a scheduler-pressure run
+	if exp.Spec.Action == "scheduler-pressure" && exp.Status.BalloonsEndAt != nil {
+		r.deleteBalloons(ctx, exp)
+		exp.Status.BalloonsEndAt = nil
+	}
+
+	// Put back the limits of ResourceQuotas shrunk by quota-squeeze
+	if exp.Spec.Action == "quota-squeeze" && len(exp.Status.SqueezedQuotas) > 0 {
+		r.restoreSqueezedQuotas(ctx, exp)
+	}
+
+	// Remove the slow webhook of an admission-delay run
+	if exp.Spec.Action == "admission-delay" && exp.Status.AdmissionWebhook != "" {
+		r.removeAdmissionDelay(ctx, exp)
+	}
+
+	// Give back scale-down to autoscalers held by this experiment (autoscalerPolicy: HoldScaleDown)
+	if len(exp.Status.Autoscalers) > 0 {
+		r.releaseAutoscalers(ctx, exp)
+	}
+}
+
+// getEligiblePods returns pods that match the selector and are not excluded
+func (r *ChaosExperimentReconciler) getEligiblePods(ctx context.Context, exp *chaosv1alpha1.ChaosExperiment) ([]corev1.Pod, error) {
+	log := ctrl.LoggerFrom(ctx)
+
+	// Validate namespace
+	if exp.Spec.Namespace == "" {
+		return nil, fmt.Errorf("namespace not specified")
+	}
+
+	// Choose Pods by selector
+	selector := labels.SelectorFromSet(exp.Spec.Selector)
+	selected, err := r.listPods(ctx, client.InNamespace(exp.Spec.Namespace),
+		client.MatchingLabelsSelector{Selector: selector})
+	if err != nil {
+		log.Error(err, "Failed to list pods")
+		return nil, fmt.Errorf("failed to list pods: %w", err)
+	}
+
+	// Filter out excluded pods, terminating pods, and track exclusions in metrics
+	now := time.Now()
+	namespaceExclusion := r.namespaceExclusion(ctx, exp.Spec.Namespace, now)
+	eligiblePods := []corev1.Pod{}
+	excluded := map[string]int{}
+
+	for _, pod := range selected {
+		reason := podExclusion(&pod, &exp.Spec, namespaceExclusion, now)
+		switch reason {
+		case "":
+			eligiblePods = append(eligiblePods, pod)
 			continue
+		case exclusionLabel, exclusionPodTemporary, exclusionOwner, exclusionJob:
+			log.Info("Skipping excluded pod", "pod", pod.Name, "namespace", pod.Namespace, "reason", reason)
+		case exclusionTerminating:
+			log.Info("Skipping terminating pod", "pod", pod.Name, "namespace", pod.Namespace, "deletionTimestamp", pod.DeletionTimestamp)
 		}
+		excluded[reason]++
+	}
 
-		// Skip if pod is terminating (has DeletionTimestamp set)
-		if pod.DeletionTimestamp != nil {
-			log.Info("Skipping terminating pod", "pod", pod.Name, "namespace", pod.Namespace, "deletionTimestamp", pod.DeletionTimestamp)
-			excludedByTerminating++
-			continue
+	// Keep only pods on nodes matching nodeSelector and nodeAffinity
+	if hasNodeConstraints(&exp.Spec) && len(eligiblePods) > 0 {
+		before := len(eligiblePods)
+		var err error
+		eligiblePods, err = r.filterPodsByNode(ctx, &exp.Spec, eligiblePods)
+		if err != nil {
+			return nil, err
 		}
+		excluded[exclusionNode] += before - len(eligiblePods)
+	}
 
-		eligiblePods = append(eligiblePods, pod)
+	// Skip singleton and leader pods unless explicitly allowed
+	if !exp.Spec.AllowSingletonDisruption && len(eligiblePods) > 0 {
+		var singletons, leaders int
+		var err error
+		eligiblePods, singletons, leaders, err = r.filterProtectedPods(ctx, exp.Spec.Namespace, eligiblePods)
+		if err != nil {
+			return nil, err
+		}
+		excluded["singleton"] += singletons
+		excluded["leader"] += leaders
 	}
 
-	// Track excluded resources in metrics
-	if excludedByNamespace > 0 {
-		chaosmetrics.SafetyExcludedResources.WithLabelValues(
-			exp.Spec.Action,
-			exp.Spec.Namespace,
-			"namespace",
-		).Add(float64(excludedByNamespace))
+	// Skip pods of workloads that are rolling out unless explicitly ignored
+	if !exp.Spec.IgnoreRollouts && len(eligiblePods) > 0 {
+		before := len(eligiblePods)
+		var rollingOut []string
+		var err error
+		eligiblePods, rollingOut, err = r.filterRollingOutPods(ctx, exp.Spec.Namespace, eligiblePods)
+		if err != nil {
+			return nil, err
+		}
+		excluded["rollout"] += before - len(eligiblePods)
+		if len(rollingOut) > 0 {
+			r.Recorder.Event(exp, corev1.EventTypeNormal, "RolloutInProgress",
+				fmt.Sprintf("Delaying chaos for pods of %v until the rollout settles", rollingOut))
+		}
 	}
-	if excludedByLabel > 0 {
-		chaosmetrics.SafetyExcludedResources.WithLabelValues(
-			exp.Spec.Action,
-			exp.Spec.Namespace,
-			"pod",
-		).Add(float64(excludedByLabel))
+
+	// Leave pods annotated safe-to-evict: "false" alone unless the experiment ignores the annotation
+	if honorsEvictionAnnotations(&exp.Spec) && len(eligiblePods) > 0 {
+		var protected []string
+		eligiblePods, protected = filterNotSafeToEvict(eligiblePods)
+		if len(protected) > 0 {
+			log.Info("Skipping pods annotated not safe to evict", "pods", protected)
+			excluded[exclusionNotSafeToEvict] += len(protected)
+		}
 	}
-	if excludedByTerminating > 0 {
-		chaosmetrics.SafetyExcludedResources.WithLabelValues(
-			exp.Spec.Action,
-			exp.Spec.Namespace,
-			"terminating",
-		).Add(float64(excludedByTerminating))
+
+	// Track excluded resources in metrics, by reason
+	for reason, count := range excluded {
+		if count > 0 {
+			chaosmetrics.SafetyExcludedResources.WithLabelValues(exp.Spec.Action, exp.Spec.Namespace, reason).Add(float64(count))
+		}
 	}
 
 	return eligiblePods, nil
 }
 
+// Reasons a selected pod is not eligible, as reported in the excluded resources metric
+const (
+	exclusionNamespace          = "namespace"
+	exclusionNamespaceTemporary = "namespace-until"
+	exclusionLabel              = "pod"
+	exclusionPodTemporary       = "pod-until"
+	exclusionOwner              = "owner"
+	exclusionJob                = "job"
+	exclusionTerminating        = "terminating"
+	exclusionNode               = "node"
+)
+
+// namespaceExclusion returns why the namespace opts out of chaos at now, or "" when it does not
+func (r *ChaosExperimentReconciler) namespaceExclusion(ctx context.Context, name string, now time.Time) string {
+	ns := &corev1.Namespace{}
+	if err := r.Get(ctx, client.ObjectKey{Name: name}, ns); err != nil {
+		return ""
+	}
+	switch {
+	case ns.Annotations[chaosv1alpha1.ExclusionLabel] == "true":
+		return exclusionNamespace
+	case chaosv1alpha1.IsExcludedUntil(ns.Annotations, now):
+		return exclusionNamespaceTemporary
+	}
+	return ""
+}
+
+// podExclusion returns why a selected pod cannot be targeted at now, or "" when it can
+func podExclusion(pod *corev1.Pod, spec *chaosv1alpha1.ChaosExperimentSpec, namespaceExclusion string, now time.Time) string {
+	switch {
+	case namespaceExclusion != "":
+		return namespaceExclusion
+	case pod.Labels[chaosv1alpha1.ExclusionLabel] == "true":
+		return exclusionLabel
+	case ownerExcluded(pod, spec):
+		return exclusionOwner
+	case !spec.IncludeJobPods && isJobPod(pod):
+		return exclusionJob
+	case chaosv1alpha1.IsExcludedUntil(pod.Annotations, now):
+		return exclusionPodTemporary
+	case pod.DeletionTimestamp != nil:
+		return exclusionTerminating
+	}
+	return ""
+}
+
+// ownerExcluded reports whether the excludeOwners and includeOwners patterns leave the pod out.
+// Patterns match the name of the owning workload; pods without an owner only pass when no
+// includeOwners are set.
+func ownerExcluded(pod *corev1.Pod, spec *chaosv1alpha1.ChaosExperimentSpec) bool {
+	if len(spec.ExcludeOwners) == 0 && len(spec.IncludeOwners) == 0 {
+		return false
+	}
+	if metav1.GetControllerOf(pod) == nil {
+		return len(spec.IncludeOwners) > 0
+	}
+	owner := workloadOf(pod).name
+	if matchesOwnerPattern(spec.ExcludeOwners, owner) {
+		return true
+	}
+	return len(spec.IncludeOwners) > 0 && !matchesOwnerPattern(spec.IncludeOwners, owner)
+}
+
+// matchesOwnerPattern reports whether name matches one of the glob patterns
+func matchesOwnerPattern(patterns []string, name string) bool {
+	for _, pattern := range patterns {
+		if ok, _ := path.Match(pattern, name); ok {
+			return true
+		}
+	}
+	return false
+}
+
 // handlePodMemoryStress injects ephemeral containers with stress-ng to stress memory
 func (r *ChaosExperimentReconciler) handlePodMemoryStress(ctx context.Context, exp *chaosv1alpha1.ChaosExperiment) (ctrl.Result, error) {
 	log := ctrl.LoggerFrom(ctx)
 	startTime := time.Now()
 
-	// Track active experiments
-	chaosmetrics.ActiveExperiments.WithLabelValues("pod-memory-stress").Inc()
-	defer chaosmetrics.ActiveExperiments.WithLabelValues("pod-memory-stress").Dec()
-
 	// Validate required fields
 	if exp.Spec.Duration == "" {
 		chaosErr := &ChaosError{
@@ -2377,10 +2980,8 @@ func (r *ChaosExperimentReconciler) handlePodMemoryStress(ctx context.Context, e
 		return ctrl.Result{}, r.handleDryRun(ctx, exp, eligiblePods, "pod-memory-stress")
 	}
 
-	// Shuffle the list of pods
-	rand.Shuffle(len(eligiblePods), func(i, j int) {
-		eligiblePods[i], eligiblePods[j] = eligiblePods[j], eligiblePods[i]
-	})
+	// Order eligible pods so the first Count entries are the targets
+	eligiblePods = r.orderTargetPods(ctx, exp, eligiblePods)
 
 	// Determine how many pods to stress
 	stressCount := exp.Spec.Count
@@ -2398,25 +2999,87 @@ func (r *ChaosExperimentReconciler) handlePodMemoryStress(ctx context.Context, e
 	}
 
 	// Inject ephemeral containers to stress memory
-	stressedPods := []string{}
+	injected := []injectedContainer{}
+	// pullErr is the first pod where neither the stress image nor the fallback can be pulled
+	var pullErr *ChaosError
+	// fellBack is the first pod that got the built-in stressor instead of stress-ng
+	var fellBack *imagePullError
+	// fitErr is the first pod the stress does not fit into
+	var fitErr *ChaosError
+	clamped := 0
 	for i := 0; i < stressCount; i++ {
 		pod := eligiblePods[i]
-		log.Info("Injecting memory stress into pod", "pod", pod.Name, "namespace", pod.Namespace)
+		if !r.targetInjectable(ctx, exp, &pod) {
+			continue
+		}
 
-		containerName, err := r.injectMemoryStressContainer(ctx, &pod, memoryWorkers, exp.Spec.MemorySize, timeoutSeconds)
+		// Keep the stress under the pod's memory limit and the node's free memory
+		memorySize, podClamped, err := r.fitMemoryStress(ctx, exp, &pod, memoryWorkers)
+		if err != nil {
+			log.Error(err, "Memory stress does not fit into pod", "pod", pod.Name, "namespace", pod.Namespace)
+			chaosErr := WrapK8sError(err, "check memory headroom")
+			chaosmetrics.ExperimentErrors.WithLabelValues("pod-memory-stress", exp.Spec.Namespace, string(chaosErr.Type)).Inc()
+			if fitErr == nil {
+				fitErr = chaosErr
+			}
+			continue
+		}
+		if podClamped {
+			r.Recorder.Eventf(&pod, corev1.EventTypeWarning, "ChaosMemoryStressClamped",
+				"Memory stress of %s x %d workers does not fit into the pod's memory headroom; clamped to %s per worker",
+				exp.Spec.MemorySize, memoryWorkers, memorySize)
+			clamped++
+		}
+		log.Info("Injecting memory stress into pod", "pod", pod.Name, "namespace", pod.Namespace, "memorySize", memorySize)
+
+		containerName, podFellBack, err := r.injectMemoryStressContainer(ctx, &pod, memoryWorkers, memorySize, timeoutSeconds)
 		if err != nil {
 			log.Error(err, "Failed to inject memory stress container", "pod", pod.Name)
+			chaosErr := WrapK8sError(err, "update pod/ephemeralcontainers")
+			chaosmetrics.ExperimentErrors.WithLabelValues("pod-memory-stress", exp.Spec.Namespace, string(chaosErr.Type)).Inc()
+			if chaosErr.Type == ErrorTypeImagePull && pullErr == nil {
+				pullErr = chaosErr
+			}
 			continue
 		}
+		if podFellBack != nil {
+			r.Recorder.Eventf(&pod, corev1.EventTypeWarning, "ChaosStressImageFallback",
+				"%s; using the built-in stressor", podFellBack.Error())
+			if fellBack == nil {
+				fellBack = podFellBack
+			}
+		}
 
 		// Emit event on the affected pod
 		r.Recorder.Eventf(&pod, corev1.EventTypeWarning, "ChaosPodMemoryStress",
 			"Injected memory stress (%s, %d workers) by chaos experiment %s",
-			exp.Spec.MemorySize, memoryWorkers, exp.Name)
+			memorySize, memoryWorkers, exp.Name)
 
 		// Track the affected pod for cleanup later
-		r.trackAffectedPod(exp, pod.Namespace, pod.Name, containerName)
-		stressedPods = append(stressedPods, pod.Name)
+		r.trackAffectedPod(ctx, exp, pod.Namespace, pod.Name, containerName)
+		injected = append(injected, injectedContainer{
+			Pod: client.ObjectKeyFromObject(&pod), Container: containerName, Probe: memoryStressProbe(memorySize, memoryWorkers),
+		})
+	}
+
+	// Wait for the injected containers to start; those that failed do not count as affected
+	stressedPods, startErr := r.verifyInjections(ctx, exp, injected)
+	if len(stressedPods) == 0 && startErr != nil {
+		return r.handleExperimentFailure(ctx, exp, startErr)
+	}
+
+	// Record how the targets' autoscalers react to the stress
+	if exp.Spec.AutoscalerPolicy != "" {
+		r.observeAutoscalers(ctx, exp, eligiblePods[:stressCount])
+	}
+
+	if len(stressedPods) == 0 && pullErr != nil {
+		// Waiting on an image that cannot be pulled would keep the experiment running forever
+		return r.handleExperimentFailure(ctx, exp, pullErr)
+	}
+	if len(stressedPods) == 0 && fitErr != nil {
+		// Report why the stress was refused rather than a generic failure
+		return r.handleExperimentFailure(ctx, exp, fitErr)
 	}
 
 	// Update status
@@ -2429,10 +3092,17 @@ func (r *ChaosExperimentReconciler) handlePodMemoryStress(ctx context.Context, e
 	status := statusSuccess
 	if len(stressedPods) > 0 {
 		exp.Status.Message = fmt.Sprintf("Successfully injected memory stress into %d pod(s) for %s", len(stressedPods), exp.Spec.Duration)
+		if clamped > 0 {
+			exp.Status.Message += fmt.Sprintf("; clamped memorySize on %d pod(s) to fit their memory headroom", clamped)
+		}
+		if fellBack != nil {
+			exp.Status.Message += "; used the built-in stressor because " + fellBack.Error()
+		}
 	} else {
 		exp.Status.Message = "Failed to stress any pods"
 		status = statusFailure
 	}
+	countAffected(exp, len(stressedPods))
 	if err := r.Status().Update(ctx, exp); err != nil {
 		log.Error(err, "Failed to update ChaosExperiment status")
 		return ctrl.Result{}, err
@@ -2458,37 +3128,62 @@ func (r *ChaosExperimentReconciler) handlePodMemoryStress(ctx context.Context, e
 		// Don't fail the experiment if history recording fails
 	}
 
-	return ctrl.Result{RequeueAfter: time.Minute}, nil
+	return ctrl.Result{RequeueAfter: r.roundInterval(exp)}, nil
 }
 
 // injectMemoryStressContainer injects an ephemeral container that stresses memory
-// Returns the container name for tracking purposes
-func (r *ChaosExperimentReconciler) injectMemoryStressContainer(ctx context.Context, pod *corev1.Pod, workers int, memorySize string, timeoutSeconds int) (string, error) {
+// Returns the container name for tracking purposes, and the pull error of the stress image when
+// the built-in stressor was used instead
+func (r *ChaosExperimentReconciler) injectMemoryStressContainer(ctx context.Context, pod *corev1.Pod, workers int, memorySize string, timeoutSeconds int) (string, *imagePullError, error) {
 	log := ctrl.LoggerFrom(ctx)
 
 	// Build stress-ng command
 	stressCmd := fmt.Sprintf("stress-ng --vm %d --vm-bytes %s --timeout %ds --metrics-brief", workers, memorySize, timeoutSeconds)
+	limit, err := memoryStressLimit(memorySize)
+	if err != nil {
+		return "", nil, err
+	}
+
+	// Use the built-in stressor when an earlier container could not pull the stress-ng image
+	currentPod := &corev1.Pod{}
+	if err := r.Get(ctx, client.ObjectKeyFromObject(pod), currentPod); err != nil {
+		return "", nil, fmt.Errorf("failed to get current pod state: %w", err)
+	}
+	stressImage, fallbackImage := r.stressImages(defaultMemoryStressImage)
+	image, fellBack, pullErr := chooseStressImage(currentPod, "memory-stress", stressImage, fallbackImage)
+	if pullErr != nil {
+		return "", nil, pullErr
+	}
+	if fellBack != nil {
+		log.Info("Stress image cannot be pulled, using the built-in memory stressor",
+			"pod", pod.Name, "image", fellBack.Image, "reason", fellBack.Reason)
+		script, err := builtinMemoryStressScript(workers, memorySize, timeoutSeconds)
+		if err != nil {
+			return "", nil, err
+		}
+		stressCmd = script
+	}
+	stressCmd = limit + stressCmd
 
 	// Generate unique container name
 	containerName := fmt.Sprintf("memory-stress-%d", time.Now().Unix())
 
-	// Create ephemeral container with resource limits
+	// Create ephemeral container; the stressors are bounded by the ulimit above
 	ephemeralContainer := corev1.EphemeralContainer{
 		EphemeralContainerCommon: corev1.EphemeralContainerCommon{
 			Name:    containerName,
-			Image:   "ghcr.io/neogan74/stress-ng:latest",
+			Image:   image,
 			Command: []string{"/bin/sh", "-c", stressCmd},
 		},
 	}
 
-	// Get the latest pod version
 	// Update the pod with the ephemeral container using retry logic
 	if err := r.updatePodWithEphemeralContainer(ctx, pod, ephemeralContainer); err != nil {
-		return "", err
+		return "", nil, err
 	}
 
 	log.Info("Successfully injected memory stress ephemeral container", "pod", pod.Name, "container", containerName)
-	return containerName, nil
+	return containerName, fellBack, nil
 }
 
 // handlePodFailure kills the main process in pods to cause container crashes and restarts
@@ -2496,9 +3191,10 @@ func (r *ChaosExperimentReconciler) handlePodFailure(ctx context.Context, exp *c
 	log := ctrl.LoggerFrom(ctx)
 	startTime := time.Now()
 
-	// Track active experiments
-	chaosmetrics.ActiveExperiments.WithLabelValues("pod-failure").Inc()
-	defer chaosmetrics.ActiveExperiments.WithLabelValues("pod-failure").Dec()
+	// A run with duration keeps failing the same targets until it ends
+	if exp.Status.FailureEndsAt != nil {
+		return r.continuePodFailure(ctx, exp)
+	}
 
 	// Get eligible pods (includes namespace validation and exclusion filtering)
 	eligiblePods, err := r.getEligiblePods(ctx, exp)
@@ -2506,11 +3202,7 @@ func (r *ChaosExperimentReconciler) handlePodFailure(ctx context.Context, exp *c
 		if isPermissionDeniedError(err) {
 			return ctrl.Result{}, r.handlePermissionDenied(ctx, exp, "listing pods for pod-failure", err)
 		}
-		return r.handleExperimentFailure(ctx, exp, &ChaosError{
-			Original:  fmt.Errorf("failed to get eligible pods: %w", err),
-			Type:      ErrorTypeExecution,
-			Operation: "list eligible pods",
-		})
+		return r.handleExperimentFailure(ctx, exp, WrapK8sError(fmt.Errorf("failed to get eligible pods: %w", err), "list eligible pods"))
 	}
 
 	if len(eligiblePods) == 0 {
@@ -2525,10 +3217,24 @@ func (r *ChaosExperimentReconciler) handlePodFailure(ctx context.Context, exp *c
 		return ctrl.Result{}, r.handleDryRun(ctx, exp, eligiblePods, "cause container failure in")
 	}
 
-	// Shuffle the list of eligible pods
-	rand.Shuffle(len(eligiblePods), func(i, j int) {
-		eligiblePods[i], eligiblePods[j] = eligiblePods[j], eligiblePods[i]
-	})
+	// Repeated failures need a valid interval before anything is killed
+	var failureDuration time.Duration
+	if exp.Spec.Duration != "" {
+		if failureDuration, err = r.parseDuration(exp.Spec.Duration); err != nil {
+			return r.handleExperimentFailure(ctx, exp, &ChaosError{
+				Original: fmt.Errorf("invalid duration format: %w", err),
+				Type:     ErrorTypeValidation,
+			})
+		}
+	}
+	interval, err := r.failureInterval(&exp.Spec)
+	if err != nil {
+		return r.handleExperimentFailure(ctx, exp, &ChaosError{Original: err, Type: ErrorTypeValidation})
+	}
+	signal := failureSignal(&exp.Spec)
+
+	// Order eligible pods so the first Count entries are the targets
+	eligiblePods = r.orderTargetPods(ctx, exp, eligiblePods)
 
 	// Determine how many pods to affect
 	affectCount := exp.Spec.Count
@@ -2543,18 +3249,31 @@ func (r *ChaosExperimentReconciler) handlePodFailure(ctx context.Context, exp *c
 	failedPods := []string{}
 	for i := 0; i < affectCount; i++ {
 		pod := eligiblePods[i]
-		log.Info("Causing container failure in pod", "pod", pod.Name, "namespace", pod.Namespace)
+		log.Info("Causing container failure in pod", "pod", pod.Name, "namespace", pod.Namespace, "signal", signal)
 
 		// Kill the main process (PID 1) in the first container
-		if err := r.killContainerProcess(ctx, &pod); err != nil {
+		if len(pod.Spec.Containers) == 0 {
+			log.Error(nil, "No containers found in pod", "pod", pod.Name)
+			continue
+		}
+		containerName := pod.Spec.Containers[0].Name
+		_, restarts, statusErr := getPrimaryContainerRestartCount(&pod)
+		injectedAt := time.Now()
+		if err := r.killContainerProcess(ctx, &pod, containerName, signal); err != nil {
 			log.Error(err, "Failed to kill container process", "pod", pod.Name)
 			chaosErr := WrapK8sError(err, "exec pod")
 			chaosmetrics.ExperimentErrors.WithLabelValues("pod-failure", exp.Spec.Namespace, string(chaosErr.Type)).Inc()
 		} else {
 			// Emit event on the affected pod
 			r.Recorder.Event(&pod, corev1.EventTypeWarning, "ChaosPodFailure",
-				fmt.Sprintf("Caused container failure by chaos experiment %s", exp.Name))
+				fmt.Sprintf("Caused container failure (SIG%s) by chaos experiment %s", signal, exp.Name))
 			failedPods = append(failedPods, pod.Name)
+			if failureDuration > 0 {
+				r.trackAffectedPod(ctx, exp, pod.Namespace, pod.Name, containerName)
+			}
+			if statusErr == nil {
+				r.trackContainerRestart(exp, "pod-failure", exp.Spec.Namespace, &pod, containerName, restarts, injectedAt)
+			}
 		}
 	}
 
@@ -2571,6 +3290,15 @@ func (r *ChaosExperimentReconciler) handlePodFailure(ctx context.Context, exp *c
 	now := metav1.Now()
 	exp.Status.LastRunTime = &now
 	exp.Status.Message = fmt.Sprintf("Successfully caused container failure in %d pod(s)", len(failedPods))
+	requeueAfter := r.roundInterval(exp)
+	if failureDuration > 0 {
+		endsAt := metav1.NewTime(startTime.Add(failureDuration))
+		exp.Status.FailureEndsAt = &endsAt
+		exp.Status.Message = fmt.Sprintf("Failing containers in %d pod(s) every %s for %s",
+			len(failedPods), interval, exp.Spec.Duration)
+		requeueAfter = min(interval, failureDuration)
+	}
+	countAffected(exp, len(failedPods))
 
 	// Reset retry counters on success
 	if err := r.handleExperimentSuccess(ctx, exp); err != nil {
@@ -2591,17 +3319,17 @@ func (r *ChaosExperimentReconciler) handlePodFailure(ctx context.Context, exp *c
 		// Don't fail the experiment if history recording fails
 	}
 
-	return ctrl.Result{RequeueAfter: time.Minute}, nil
+	return ctrl.Result{RequeueAfter: requeueAfter}, nil
 }
 
-// handlePodRestart gracefully restarts containers by sending SIGTERM to PID 1
+// handlePodRestart gracefully restarts containers by sending SIGTERM to PID 1. The restarts of a
+// run roll over the targets one per reconcile; see continueRollingRestart.
 func (r *ChaosExperimentReconciler) handlePodRestart(ctx context.Context, exp *chaosv1alpha1.ChaosExperiment) (ctrl.Result, error) {
 	log := ctrl.LoggerFrom(ctx)
-	startTime := time.Now()
 
-	// Track active experiments
-	chaosmetrics.ActiveExperiments.WithLabelValues("pod-restart").Inc()
-	defer chaosmetrics.ActiveExperiments.WithLabelValues("pod-restart").Dec()
+	if exp.Status.RollingRestart != nil {
+		return r.continueRollingRestart(ctx, exp)
+	}
 
 	// Get eligible pods (includes namespace validation and exclusion filtering)
 	eligiblePods, err := r.getEligiblePods(ctx, exp)
@@ -2609,11 +3337,7 @@ func (r *ChaosExperimentReconciler) handlePodRestart(ctx context.Context, exp *c
 		if isPermissionDeniedError(err) {
 			return ctrl.Result{}, r.handlePermissionDenied(ctx, exp, "listing pods for pod-restart", err)
 		}
-		return r.handleExperimentFailure(ctx, exp, &ChaosError{
-			Original:  fmt.Errorf("failed to get eligible pods: %w", err),
-			Type:      ErrorTypeExecution,
-			Operation: "list eligible pods",
-		})
+		return r.handleExperimentFailure(ctx, exp, WrapK8sError(fmt.Errorf("failed to get eligible pods: %w", err), "list eligible pods"))
 	}
 
 	if len(eligiblePods) == 0 {
@@ -2628,25 +3352,13 @@ func (r *ChaosExperimentReconciler) handlePodRestart(ctx context.Context, exp *c
 		return ctrl.Result{}, r.handleDryRun(ctx, exp, eligiblePods, "gracefully restart")
 	}
 
-	// Parse restart interval if provided
-	var restartInterval time.Duration
-	if exp.Spec.RestartInterval != "" {
-		interval, err := r.parseDuration(exp.Spec.RestartInterval)
-		if err != nil {
-			chaosErr := &ChaosError{
-				Original: fmt.Errorf("invalid restartInterval: %w", err),
-				Type:     ErrorTypeValidation,
-			}
-			return r.handleExperimentFailure(ctx, exp, chaosErr)
-		}
-		restartInterval = interval
-		log.Info("Using restart interval", "interval", restartInterval)
+	// Validate the restart interval before the first restart
+	if _, err := r.restartInterval(&exp.Spec); err != nil {
+		return r.handleExperimentFailure(ctx, exp, &ChaosError{Original: err, Type: ErrorTypeValidation})
 	}
 
-	// Shuffle the list of eligible pods
-	rand.Shuffle(len(eligiblePods), func(i, j int) {
-		eligiblePods[i], eligiblePods[j] = eligiblePods[j], eligiblePods[i]
-	})
+	// Order eligible pods so the first Count entries are the targets
+	eligiblePods = r.orderTargetPods(ctx, exp, eligiblePods)
 
 	// Determine how many pods to affect
 	affectCount := exp.Spec.Count
@@ -2657,83 +3369,17 @@ func (r *ChaosExperimentReconciler) handlePodRestart(ctx context.Context, exp *c
 		affectCount = len(eligiblePods)
 	}
 
-	// Gracefully restart containers in selected pods
-	restartedPods := []string{}
-	for i := 0; i < affectCount; i++ {
-		// Apply delay between restarts (except first)
-		if i > 0 && restartInterval > 0 {
-			log.Info("Waiting before next restart", "interval", restartInterval)
-			time.Sleep(restartInterval)
-		}
-
-		pod := eligiblePods[i]
-		log.Info("Gracefully restarting pod", "pod", pod.Name, "namespace", pod.Namespace)
-
-		containerName, initialRestartCount, err := getPrimaryContainerRestartCount(&pod)
-		if err != nil {
-			log.Error(err, "Failed to restart pod", "pod", pod.Name)
-			chaosmetrics.ExperimentErrors.WithLabelValues("pod-restart", exp.Spec.Namespace, string(ErrorTypeExecution)).Inc()
-			// Continue with other pods even if one fails
-			continue
-		}
-
-		// Send SIGTERM to gracefully restart the container.
-		restartErr := r.gracefullyRestartContainer(ctx, &pod)
-		if restartErr != nil {
-			log.Error(restartErr, "Exec returned an error while sending restart signal", "pod", pod.Name)
-		}
-
-		if err := r.waitForContainerRestart(ctx, pod.Namespace, pod.Name, containerName, initialRestartCount); err != nil {
-			log.Error(err, "Failed to observe container restart", "pod", pod.Name, "container", containerName)
-			if restartErr != nil {
-				err = fmt.Errorf("%w; restart signal error: %v", err, restartErr)
-			}
-			chaosErr := WrapK8sError(err, "observe pod restart")
-			chaosmetrics.ExperimentErrors.WithLabelValues("pod-restart", exp.Spec.Namespace, string(chaosErr.Type)).Inc()
-			// Continue with other pods even if one fails
-			continue
-		}
-
-		// Emit event on the affected pod
-		r.Recorder.Event(&pod, corev1.EventTypeWarning, "ChaosPodRestart",
-			fmt.Sprintf("Restarted pod by chaos experiment %s", exp.Name))
-		restartedPods = append(restartedPods, pod.Name)
-	}
-
-	// Check if we restarted any pods
-	if len(restartedPods) == 0 {
-		chaosErr := &ChaosError{
-			Original: fmt.Errorf("failed to restart any pods"),
-			Type:     ErrorTypeExecution,
-		}
-		return r.handleExperimentFailure(ctx, exp, chaosErr)
-	}
-
-	// Update status - success
-	now := metav1.Now()
-	exp.Status.LastRunTime = &now
-	exp.Status.Message = fmt.Sprintf("Successfully restarted %d pod(s)", len(restartedPods))
-
-	// Reset retry counters on success
-	if err := r.handleExperimentSuccess(ctx, exp); err != nil {
-		log.Error(err, "Failed to update ChaosExperiment status")
-		return ctrl.Result{}, err
-	}
-
-	// Record metrics
-	duration := time.Since(startTime).Seconds()
-	chaosmetrics.ExperimentsTotal.WithLabelValues("pod-restart", exp.Spec.Namespace, statusSuccess).Inc()
-	chaosmetrics.ExperimentDuration.WithLabelValues("pod-restart", exp.Spec.Namespace).Observe(duration)
-	chaosmetrics.ResourcesAffected.WithLabelValues("pod-restart", exp.Spec.Namespace, exp.Name).Set(float64(len(restartedPods)))
-
-	// Create history record
-	affectedResources := buildResourceReferences("container-restarted", exp.Spec.Namespace, restartedPods, "Pod")
-	if err := r.createHistoryRecord(ctx, exp, statusSuccess, affectedResources, startTime, nil); err != nil {
-		log.Error(err, "Failed to create history record")
-		// Don't fail the experiment if history recording fails
+	// Restart the selected pods one at a time in a deterministic, owner-aware order, waiting for
+	// each to be Ready again before the next
+	targets := rollingRestartOrder(eligiblePods[:affectCount])
+	pending := make([]string, 0, len(targets))
+	for i := range targets {
+		pending = append(pending, podKey(&targets[i]))
 	}
-
-	return ctrl.Result{RequeueAfter: time.Minute}, nil
+	log.Info("Starting rolling restart", "pods", pending)
+	exp.Status.Phase = phaseRunning
+	exp.Status.RollingRestart = &chaosv1alpha1.RollingRestartStatus{StartedAt: metav1.Now(), Pending: pending}
+	return r.continueRollingRestart(ctx, exp)
 }
 
 // handlePodNetworkLoss injects packet loss into pods using tc netem via ephemeral containers
@@ -2741,10 +3387,6 @@ func (r *ChaosExperimentReconciler) handlePodNetworkLoss(ctx context.Context, ex
 	log := ctrl.LoggerFrom(ctx)
 	startTime := time.Now()
 
-	// Track active experiments
-	chaosmetrics.ActiveExperiments.WithLabelValues("pod-network-loss").Inc()
-	defer chaosmetrics.ActiveExperiments.WithLabelValues("pod-network-loss").Dec()
-
 	// Validate required fields
 	if exp.Spec.Duration == "" {
 		chaosErr := &ChaosError{
@@ -2790,10 +3432,8 @@ func (r *ChaosExperimentReconciler) handlePodNetworkLoss(ctx context.Context, ex
 		return ctrl.Result{}, r.handleDryRun(ctx, exp, eligiblePods, "pod-network-loss")
 	}
 
-	// Shuffle the list of pods
-	rand.Shuffle(len(eligiblePods), func(i, j int) {
-		eligiblePods[i], eligiblePods[j] = eligiblePods[j], eligiblePods[i]
-	})
+	// Order eligible pods so the first Count entries are the targets
+	eligiblePods = r.orderTargetPods(ctx, exp, eligiblePods)
 
 	// Determine how many pods to affect
 	affectCount := exp.Spec.Count
@@ -2805,18 +3445,29 @@ func (r *ChaosExperimentReconciler) handlePodNetworkLoss(ctx context.Context, ex
 	}
 
 	// Inject ephemeral containers to apply packet loss
-	affectedPods := []string{}
+	injected := []injectedContainer{}
+	// failureReason explains the first pod the container could not be injected into, when actionable
+	failureReason := ""
 	for i := 0; i < affectCount; i++ {
 		pod := eligiblePods[i]
+		if !r.targetInjectable(ctx, exp, &pod) {
+			continue
+		}
 		log.Info("Injecting network loss into pod",
 			"pod", pod.Name,
 			"namespace", pod.Namespace,
 			"lossPercentage", exp.Spec.LossPercentage,
 			"correlation", exp.Spec.LossCorrelation)
 
+		injectedAt := time.Now()
 		containerName, err := r.injectNetworkLossContainer(ctx, &pod, exp.Spec.LossPercentage, exp.Spec.LossCorrelation, timeoutSeconds)
 		if err != nil {
 			log.Error(err, "Failed to inject network loss container", "pod", pod.Name)
+			if failureReason == "" {
+				failureReason = injectionFailureReason(err)
+			}
+			chaosErr := WrapK8sError(err, "update pod/ephemeralcontainers")
+			chaosmetrics.ExperimentErrors.WithLabelValues("pod-network-loss", exp.Spec.Namespace, string(chaosErr.Type)).Inc()
 			continue
 		}
 
@@ -2825,10 +3476,22 @@ func (r *ChaosExperimentReconciler) handlePodNetworkLoss(ctx context.Context, ex
 			"Injected %d%% packet loss by chaos experiment %s", exp.Spec.LossPercentage, exp.Name)
 
 		// Track the affected pod for cleanup later
-		r.trackAffectedPod(exp, pod.Namespace, pod.Name, containerName)
-		affectedPods = append(affectedPods, pod.Name)
+		r.trackAffectedPod(ctx, exp, pod.Namespace, pod.Name, containerName)
+		r.trackEphemeralExit(exp, "pod-network-loss", exp.Spec.Namespace, &pod, containerName, injectedAt)
+		injected = append(injected, injectedContainer{
+			Pod: client.ObjectKeyFromObject(&pod), Container: containerName, Probe: netemProbe("loss"),
+		})
+	}
+
+	// Wait for the injected containers to start; those that failed do not count as affected
+	affectedPods, startErr := r.verifyInjections(ctx, exp, injected)
+	if len(affectedPods) == 0 && startErr != nil {
+		return r.handleExperimentFailure(ctx, exp, startErr)
 	}
 
+	// Measure what the targets actually see while the fault lasts
+	r.startNetworkMeasurements(ctx, exp, affectedPods, timeoutSeconds)
+
 	// Update status
 	now := metav1.Now()
 	exp.Status.LastRunTime = &now
@@ -2842,8 +3505,12 @@ func (r *ChaosExperimentReconciler) handlePodNetworkLoss(ctx context.Context, ex
 			exp.Spec.LossPercentage, len(affectedPods), exp.Spec.Duration)
 	} else {
 		exp.Status.Message = "Failed to inject network loss into any pods"
+		if failureReason != "" {
+			exp.Status.Message += ": " + failureReason
+		}
 		status = statusFailure
 	}
+	countAffected(exp, len(affectedPods))
 	if err := r.Status().Update(ctx, exp); err != nil {
 		log.Error(err, "Failed to update ChaosExperiment status")
 		return ctrl.Result{}, err
@@ -2862,7 +3529,7 @@ func (r *ChaosExperimentReconciler) handlePodNetworkLoss(ctx context.Context, ex
 		// Don't fail the experiment if history recording fails
 	}
 
-	return ctrl.Result{RequeueAfter: time.Minute}, nil
+	return ctrl.Result{RequeueAfter: r.roundInterval(exp)}, nil
 }
 
 // handlePodDiskFill injects disk usage into pods using an ephemeral container
@@ -2870,10 +3537,6 @@ func (r *ChaosExperimentReconciler) handlePodDiskFill(ctx context.Context, exp *
 	log := ctrl.LoggerFrom(ctx)
 	startTime := time.Now()
 
-	// Track active experiments
-	chaosmetrics.ActiveExperiments.WithLabelValues("pod-disk-fill").Inc()
-	defer chaosmetrics.ActiveExperiments.WithLabelValues("pod-disk-fill").Dec()
-
 	// Validate required fields
 	if exp.Spec.Duration == "" {
 		chaosErr := &ChaosError{
@@ -2917,10 +3580,18 @@ func (r *ChaosExperimentReconciler) handlePodDiskFill(ctx context.Context, exp *
 		return ctrl.Result{}, r.handleDryRun(ctx, exp, eligiblePods, "pod-disk-fill")
 	}
 
-	// Shuffle the list of pods
-	rand.Shuffle(len(eligiblePods), func(i, j int) {
-		eligiblePods[i], eligiblePods[j] = eligiblePods[j], eligiblePods[i]
-	})
+	reserve, err := diskFillReserveFor(&exp.Spec)
+	if err != nil {
+		return r.handleExperimentFailure(ctx, exp, &ChaosError{Original: err, Type: ErrorTypeValidation})
+	}
+
+	// Keep the experiment until its fill files are removed
+	if err := r.addDiskFillFinalizer(ctx, exp); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	// Order eligible pods so the first Count entries are the targets
+	eligiblePods = r.orderTargetPods(ctx, exp, eligiblePods)
 
 	// Determine how many pods to affect
 	affectCount := exp.Spec.Count
@@ -2932,15 +3603,23 @@ func (r *ChaosExperimentReconciler) handlePodDiskFill(ctx context.Context, exp *
 	}
 
 	// Fill disk on selected pods
-	affectedPods := []string{}
+	injected := []injectedContainer{}
+	// targetErr is the first pod whose volume cannot be filled
+	var targetErr *ChaosError
 	for i := 0; i < affectCount; i++ {
 		pod := eligiblePods[i]
+		if !r.targetInjectable(ctx, exp, &pod) {
+			continue
+		}
 
-		targetPath, err := resolveDiskFillTarget(&pod, exp.Spec.VolumeName, exp.Spec.TargetPath)
+		target, err := resolveDiskFillTarget(&pod, exp.Spec.VolumeName, exp.Spec.TargetPath)
 		if err != nil {
 			log.Error(err, "Failed to resolve disk fill target", "pod", pod.Name, "namespace", pod.Namespace)
-			chaosErr := WrapK8sError(err, "resolve disk fill target")
+			chaosErr := &ChaosError{Original: err, Type: ErrorTypeValidation}
 			chaosmetrics.ExperimentErrors.WithLabelValues("pod-disk-fill", exp.Spec.Namespace, string(chaosErr.Type)).Inc()
+			if targetErr == nil {
+				targetErr = chaosErr
+			}
 			continue
 		}
 
@@ -2948,10 +3627,11 @@ func (r *ChaosExperimentReconciler) handlePodDiskFill(ctx context.Context, exp *
 			"pod", pod.Name,
 			"namespace", pod.Namespace,
 			"fillPercentage", fillPercentage,
-			"targetPath", targetPath,
+			"targetPath", target.Path,
+			"volume", exp.Spec.VolumeName,
 			"duration", timeoutSeconds)
 
-		containerName, err := r.injectDiskFillContainer(ctx, &pod, fillPercentage, targetPath, timeoutSeconds)
+		containerName, err := r.injectDiskFillContainer(ctx, &pod, fillPercentage, reserve, target, timeoutSeconds)
 		if err != nil {
 			log.Error(err, "Failed to inject disk fill container", "pod", pod.Name)
 			chaosErr := WrapK8sError(err, "update pod/ephemeralcontainers")
@@ -2967,8 +3647,21 @@ func (r *ChaosExperimentReconciler) handlePodDiskFill(ctx context.Context, exp *
 			"Injected disk fill (%d%%) by chaos experiment %s", fillPercentage, exp.Name)
 
 		// Track the affected pod for cleanup later
-		r.trackAffectedPod(exp, pod.Namespace, pod.Name, containerName)
-		affectedPods = append(affectedPods, pod.Name)
+		r.trackAffectedPod(ctx, exp, pod.Namespace, pod.Name, containerName)
+		injected = append(injected, injectedContainer{
+			Pod: client.ObjectKeyFromObject(&pod), Container: containerName, Probe: diskFillProbe(target.Path),
+		})
+	}
+
+	// Wait for the injected containers to start; those that failed do not count as affected
+	affectedPods, startErr := r.verifyInjections(ctx, exp, injected)
+	if len(affectedPods) == 0 && startErr != nil {
+		return r.handleExperimentFailure(ctx, exp, startErr)
+	}
+
+	if len(affectedPods) == 0 && targetErr != nil {
+		// Report why the volume cannot be filled rather than a generic failure
+		return r.handleExperimentFailure(ctx, exp, targetErr)
 	}
 
 	// Update status
@@ -2986,6 +3679,7 @@ func (r *ChaosExperimentReconciler) handlePodDiskFill(ctx context.Context, exp *
 		exp.Status.Message = "Failed to fill disk on any pods"
 		status = statusFailure
 	}
+	countAffected(exp, len(affectedPods))
 	if err := r.Status().Update(ctx, exp); err != nil {
 		log.Error(err, "Failed to update ChaosExperiment status")
 		return ctrl.Result{}, err
@@ -3004,7 +3698,7 @@ func (r *ChaosExperimentReconciler) handlePodDiskFill(ctx context.Context, exp *
 		// Don't fail the experiment if history recording fails
 	}
 
-	return ctrl.Result{RequeueAfter: time.Minute}, nil
+	return ctrl.Result{RequeueAfter: r.roundInterval(exp)}, nil
 }
 
 // handlePodNetworkCorruption injects ephemeral containers to corrupt packets
@@ -3012,10 +3706,6 @@ func (r *ChaosExperimentReconciler) handlePodNetworkCorruption(ctx context.Conte
 	log := ctrl.LoggerFrom(ctx)
 	startTime := time.Now()
 
-	// Track active experiments
-	chaosmetrics.ActiveExperiments.WithLabelValues("pod-network-corruption").Inc()
-	defer chaosmetrics.ActiveExperiments.WithLabelValues("pod-network-corruption").Dec()
-
 	// Validate namespace
 	if exp.Spec.Namespace == "" {
 		return r.handleExperimentFailure(ctx, exp, &ChaosError{
@@ -3071,10 +3761,8 @@ func (r *ChaosExperimentReconciler) handlePodNetworkCorruption(ctx context.Conte
 		return ctrl.Result{}, r.handleDryRun(ctx, exp, eligiblePods, "pod-network-corruption")
 	}
 
-	// Shuffle the list of pods
-	rand.Shuffle(len(eligiblePods), func(i, j int) {
-		eligiblePods[i], eligiblePods[j] = eligiblePods[j], eligiblePods[i]
-	})
+	// Order eligible pods so the first Count entries are the targets
+	eligiblePods = r.orderTargetPods(ctx, exp, eligiblePods)
 
 	// Determine how many pods to affect
 	affectCount := exp.Spec.Count
@@ -3086,18 +3774,29 @@ func (r *ChaosExperimentReconciler) handlePodNetworkCorruption(ctx context.Conte
 	}
 
 	// Inject ephemeral containers to apply packet corruption
-	affectedPods := []string{}
+	injected := []injectedContainer{}
+	// failureReason explains the first pod the container could not be injected into, when actionable
+	failureReason := ""
 	for i := 0; i < affectCount; i++ {
 		pod := eligiblePods[i]
+		if !r.targetInjectable(ctx, exp, &pod) {
+			continue
+		}
 		log.Info("Injecting network corruption into pod",
 			"pod", pod.Name,
 			"namespace", pod.Namespace,
 			"corruptionPercentage", exp.Spec.CorruptionPercentage,
 			"correlation", exp.Spec.CorruptionCorrelation)
 
+		injectedAt := time.Now()
 		containerName, err := r.injectNetworkCorruptionContainer(ctx, &pod, exp.Spec.CorruptionPercentage, exp.Spec.CorruptionCorrelation, timeoutSeconds)
 		if err != nil {
 			log.Error(err, "Failed to inject network corruption container", "pod", pod.Name)
+			if failureReason == "" {
+				failureReason = injectionFailureReason(err)
+			}
+			chaosErr := WrapK8sError(err, "update pod/ephemeralcontainers")
+			chaosmetrics.ExperimentErrors.WithLabelValues("pod-network-corruption", exp.Spec.Namespace, string(chaosErr.Type)).Inc()
 			continue
 		}
 
@@ -3106,10 +3805,22 @@ func (r *ChaosExperimentReconciler) handlePodNetworkCorruption(ctx context.Conte
 			"Injected %d%% packet corruption by chaos experiment %s", exp.Spec.CorruptionPercentage, exp.Name)
 
 		// Track the affected pod for cleanup later
-		r.trackAffectedPod(exp, pod.Namespace, pod.Name, containerName)
-		affectedPods = append(affectedPods, pod.Name)
+		r.trackAffectedPod(ctx, exp, pod.Namespace, pod.Name, containerName)
+		r.trackEphemeralExit(exp, "pod-network-corruption", exp.Spec.Namespace, &pod, containerName, injectedAt)
+		injected = append(injected, injectedContainer{
+			Pod: client.ObjectKeyFromObject(&pod), Container: containerName, Probe: netemProbe("corrupt"),
+		})
 	}
 
+	// Wait for the injected containers to start; those that failed do not count as affected
+	affectedPods, startErr := r.verifyInjections(ctx, exp, injected)
+	if len(affectedPods) == 0 && startErr != nil {
+		return r.handleExperimentFailure(ctx, exp, startErr)
+	}
+
+	// Measure what the targets actually see while the fault lasts
+	r.startNetworkMeasurements(ctx, exp, affectedPods, timeoutSeconds)
+
 	// Update status
 	now := metav1.Now()
 	exp.Status.LastRunTime = &now
@@ -3119,8 +3830,12 @@ func (r *ChaosExperimentReconciler) handlePodNetworkCorruption(ctx context.Conte
 			exp.Spec.CorruptionPercentage, len(affectedPods), exp.Spec.Duration)
 	} else {
 		exp.Status.Message = "Failed to inject network corruption into any pods"
+		if failureReason != "" {
+			exp.Status.Message += ": " + failureReason
+		}
 		status = statusFailure
 	}
+	countAffected(exp, len(affectedPods))
 	if err := r.Status().Update(ctx, exp); err != nil {
 		log.Error(err, "Failed to update ChaosExperiment status")
 		return ctrl.Result{}, err
@@ -3145,22 +3860,19 @@ func (r *ChaosExperimentReconciler) handlePodNetworkCorruption(ctx context.Conte
 		log.Error(err, "Failed to create history record")
 	}
 
-	return ctrl.Result{RequeueAfter: time.Minute}, nil
+	return ctrl.Result{RequeueAfter: r.roundInterval(exp)}, nil
 }
 
 // injectNetworkCorruptionContainer adds an ephemeral container with tc netem to corrupt packets
 func (r *ChaosExperimentReconciler) injectNetworkCorruptionContainer(ctx context.Context, pod *corev1.Pod, percentage, correlation, durationSeconds int) (string, error) {
 	log := ctrl.LoggerFrom(ctx)
 
-	// Build tc command with correlation if specified
-	var tcCmd string
+	// Build netem arguments with correlation if specified
+	netemArgs := fmt.Sprintf("corrupt %d%%", percentage)
 	if correlation > 0 {
-		tcCmd = fmt.Sprintf("tc qdisc add dev eth0 root netem corrupt %d%% %d%% && sleep %d && tc qdisc del dev eth0 root",
-			percentage, correlation, durationSeconds)
-	} else {
-		tcCmd = fmt.Sprintf("tc qdisc add dev eth0 root netem corrupt %d%% && sleep %d && tc qdisc del dev eth0 root",
-			percentage, durationSeconds)
+		netemArgs = fmt.Sprintf("corrupt %d%% %d%%", percentage, correlation)
 	}
+	tcCmd := netemScript(netemArgs, durationSeconds)
 
 	// Generate unique container name
 	containerName := fmt.Sprintf("network-corrupt-%d", time.Now().Unix())
@@ -3168,9 +3880,10 @@ func (r *ChaosExperimentReconciler) injectNetworkCorruptionContainer(ctx context
 	// Create ephemeral container with NET_ADMIN capability
 	ephemeralContainer := corev1.EphemeralContainer{
 		EphemeralContainerCommon: corev1.EphemeralContainerCommon{
-			Name:    containerName,
-			Image:   "ghcr.io/neogan74/iproute2:latest",
-			Command: []string{"/bin/sh", "-c", tcCmd},
+			Name:                     containerName,
+			Image:                    "ghcr.io/neogan74/iproute2:latest",
+			Command:                  []string{"/bin/sh", "-c", tcCmd},
+			TerminationMessagePolicy: corev1.TerminationMessageFallbackToLogsOnError,
 			SecurityContext: &corev1.SecurityContext{
 				Capabilities: &corev1.Capabilities{
 					Add: []corev1.Capability{"NET_ADMIN"},
@@ -3197,15 +3910,12 @@ func (r *ChaosExperimentReconciler) injectNetworkCorruptionContainer(ctx context
 func (r *ChaosExperimentReconciler) injectNetworkLossContainer(ctx context.Context, pod *corev1.Pod, lossPercentage, correlation, timeoutSeconds int) (string, error) {
 	log := ctrl.LoggerFrom(ctx)
 
-	// Build tc command with correlation if specified
-	var tcCmd string
+	// Build netem arguments with correlation if specified
+	netemArgs := fmt.Sprintf("loss %d%%", lossPercentage)
 	if correlation > 0 {
-		tcCmd = fmt.Sprintf("tc qdisc add dev eth0 root netem loss %d%% %d%% && sleep %d && tc qdisc del dev eth0 root",
-			lossPercentage, correlation, timeoutSeconds)
-	} else {
-		tcCmd = fmt.Sprintf("tc qdisc add dev eth0 root netem loss %d%% && sleep %d && tc qdisc del dev eth0 root",
-			lossPercentage, timeoutSeconds)
+		netemArgs = fmt.Sprintf("loss %d%% %d%%", lossPercentage, correlation)
 	}
+	tcCmd := netemScript(netemArgs, timeoutSeconds)
 
 	// Generate unique container name
 	containerName := fmt.Sprintf("network-loss-%d", time.Now().Unix())
@@ -3213,9 +3923,10 @@ func (r *ChaosExperimentReconciler) injectNetworkLossContainer(ctx context.Conte
 	// Create ephemeral container with NET_ADMIN capability
 	ephemeralContainer := corev1.EphemeralContainer{
 		EphemeralContainerCommon: corev1.EphemeralContainerCommon{
-			Name:    containerName,
-			Image:   "ghcr.io/neogan74/iproute2:latest",
-			Command: []string{"/bin/sh", "-c", tcCmd},
+			Name:                     containerName,
+			Image:                    "ghcr.io/neogan74/iproute2:latest",
+			Command:                  []string{"/bin/sh", "-c", tcCmd},
+			TerminationMessagePolicy: corev1.TerminationMessageFallbackToLogsOnError,
 			SecurityContext: &corev1.SecurityContext{
 				Capabilities: &corev1.Capabilities{
 					Add: []corev1.Capability{"NET_ADMIN"},
@@ -3241,7 +3952,7 @@ func (r *ChaosExperimentReconciler) injectNetworkLossContainer(ctx context.Conte
 
 // injectDiskFillContainer injects an ephemeral container that fills disk space
 // Returns the container name for tracking purposes
-func (r *ChaosExperimentReconciler) injectDiskFillContainer(ctx context.Context, pod *corev1.Pod, fillPercentage int, targetPath string, timeoutSeconds int) (string, error) {
+func (r *ChaosExperimentReconciler) injectDiskFillContainer(ctx context.Context, pod *corev1.Pod, fillPercentage int, reserve diskFillReserve, target diskFillTarget, timeoutSeconds int) (string, error) {
 	log := ctrl.LoggerFrom(ctx)
 
 	// Generate unique container name
@@ -3265,53 +3976,20 @@ func (r *ChaosExperimentReconciler) injectDiskFillContainer(ctx context.Context,
 		}
 	}
 
-	diskFillCmd := fmt.Sprintf(`set -e
-TARGET=%q
-FILE="$TARGET/chaos-disk-fill.img"
-PERCENT=%d
-DURATION=%d
-
-mkdir -p "$TARGET"
-df_out=$(df -Pk "$TARGET" | tail -1)
-total_kb=$(echo "$df_out" | awk '{print $2}')
-used_kb=$(echo "$df_out" | awk '{print $3}')
-if [ -z "$total_kb" ] || [ -z "$used_kb" ]; then
-  echo "failed to read disk usage"
-  exit 1
-fi
-target_kb=$((total_kb * PERCENT / 100))
-fill_kb=$((target_kb - used_kb))
-if [ "$fill_kb" -le 0 ]; then
-  echo "disk already above target"
-  sleep "$DURATION"
-  exit 0
-fi
-fallocate_failed=0
-if command -v fallocate >/dev/null 2>&1; then
-  if ! fallocate -l "${fill_kb}K" "$FILE"; then
-    fallocate_failed=1
-  fi
-else
-  fallocate_failed=1
-fi
-if [ "$fallocate_failed" -ne 0 ]; then
-  count=$((fill_kb / 1024))
-  if [ "$count" -le 0 ]; then
-    count=1
-  fi
-  dd if=/dev/zero of="$FILE" bs=1M count="$count" conv=fsync 2>/dev/null
-fi
-sleep "$DURATION"
-rm -f "$FILE"
-`, targetPath, fillPercentage, timeoutSeconds)
+	diskFillCmd := diskFillScript(target.Path, fillPercentage, reserve, timeoutSeconds)
 
 	ephemeralContainer := corev1.EphemeralContainer{
 		EphemeralContainerCommon: corev1.EphemeralContainerCommon{
 			Name:    containerName,
 			Image:   "busybox:1.36",
 			Command: []string{"/bin/sh", "-c", diskFillCmd},
+			// Surface "not writable" and similar script errors in the container status
+			TerminationMessagePolicy: corev1.TerminationMessageFallbackToLogsOnError,
 		},
 	}
+	if target.Mount != nil {
+		ephemeralContainer.VolumeMounts = []corev1.VolumeMount{*target.Mount}
+	}
 
 	// Update the pod with the ephemeral container using retry logic
 	if err := r.updatePodWithEphemeralContainer(ctx, pod, ephemeralContainer); err != nil {
@@ -3322,57 +4000,27 @@ rm -f "$FILE"
 		"pod", pod.Name,
 		"container", containerName,
 		"fillPercentage", fillPercentage,
-		"targetPath", targetPath,
+		"reserveKB", reserve.KB,
+		"reservePercentage", reserve.Percentage,
+		"targetPath", target.Path,
 		"duration", timeoutSeconds)
 
 	return containerName, nil
 }
 
-func resolveDiskFillTarget(pod *corev1.Pod, volumeName, targetPath string) (string, error) {
-	if volumeName == "" {
-		if targetPath == "" {
-			return "", fmt.Errorf("target path is empty")
-		}
-		return targetPath, nil
-	}
-
-	for _, container := range pod.Spec.Containers {
-		for _, mount := range container.VolumeMounts {
-			if mount.Name == volumeName {
-				return mount.MountPath, nil
-			}
-		}
-	}
-
-	for _, container := range pod.Spec.InitContainers {
-		for _, mount := range container.VolumeMounts {
-			if mount.Name == volumeName {
-				return mount.MountPath, nil
-			}
-		}
-	}
-
-	return "", fmt.Errorf("volume %q not found in pod %s/%s", volumeName, pod.Namespace, pod.Name)
-}
-
-// killContainerProcess kills the main process (PID 1) in the pod's first container to cause a crash
-func (r *ChaosExperimentReconciler) killContainerProcess(ctx context.Context, pod *corev1.Pod) error {
+// killContainerProcess sends signal to the main process (PID 1) of a container to cause a crash
+func (r *ChaosExperimentReconciler) killContainerProcess(ctx context.Context, pod *corev1.Pod, containerName, signal string) error {
 	log := ctrl.LoggerFrom(ctx)
 
-	// Find the first container
-	if len(pod.Spec.Containers) == 0 {
-		return fmt.Errorf("no containers found in pod")
-	}
-	containerName := pod.Spec.Containers[0].Name
-
 	// Kill PID 1 (main process) to cause container crash
-	command := []string{"kill", "-9", "1"}
+	command := []string{"kill", "-" + signal, "1"}
 
 	stdout, stderr, err := r.execInPod(ctx, pod.Namespace, pod.Name, containerName, command)
 	if err != nil {
 		log.Error(err, "Failed to kill main process in container",
 			"pod", pod.Name,
 			"container", containerName,
+			"signal", signal,
 			"stdout", stdout,
 			"stderr", stderr)
 		return err
@@ -3381,6 +4029,7 @@ func (r *ChaosExperimentReconciler) killContainerProcess(ctx context.Context, po
 	log.Info("Successfully killed main process in container",
 		"pod", pod.Name,
 		"container", containerName,
+		"signal", signal,
 		"stdout", stdout,
 		"stderr", stderr)
 
@@ -3396,6 +4045,9 @@ func (r *ChaosExperimentReconciler) gracefullyRestartContainer(ctx context.Conte
 		return fmt.Errorf("no containers found in pod")
 	}
 	containerName := pod.Spec.Containers[0].Name
+	if r.Clientset == nil {
+		return fmt.Errorf("no clientset to exec into pod %s/%s", pod.Namespace, pod.Name)
+	}
 
 	// Send SIGTERM (signal 15) to PID 1 for graceful shutdown
 	// Using fallback command to handle different environments
@@ -3435,61 +4087,76 @@ func getPrimaryContainerRestartCount(pod *corev1.Pod) (string, int32, error) {
 	return "", 0, fmt.Errorf("container status not found for %q", containerName)
 }
 
-func (r *ChaosExperimentReconciler) waitForContainerRestart(ctx context.Context, namespace, podName, containerName string, initialRestartCount int32) error {
-	log := ctrl.LoggerFrom(ctx)
-	deadline := time.Now().Add(45 * time.Second)
-
-	for {
-		currentPod := &corev1.Pod{}
-		if err := r.Get(ctx, types.NamespacedName{Namespace: namespace, Name: podName}, currentPod); err != nil {
-			return fmt.Errorf("failed to get pod while waiting for restart: %w", err)
-		}
-
-		for _, status := range currentPod.Status.ContainerStatuses {
-			if status.Name == containerName && status.RestartCount > initialRestartCount {
-				log.Info("Observed container restart",
-					"pod", podName,
-					"container", containerName,
-					"initialRestartCount", initialRestartCount,
-					"currentRestartCount", status.RestartCount)
-				return nil
-			}
-		}
-
-		if time.Now().After(deadline) {
-			return fmt.Errorf("container %q in pod %s/%s did not restart within 45s", containerName, namespace, podName)
+// scheduleKeyAndOffset returns the cache key of the experiment's schedule and the offset that
+// spreads experiments sharing a schedule over the jitter window
+func (r *ChaosExperimentReconciler) scheduleKeyAndOffset(exp *chaosv1alpha1.ChaosExperiment) (string, time.Duration) {
+	key := client.ObjectKeyFromObject(exp).String()
+	var offset time.Duration
+	if exp.Spec.ScheduleJitter != "" {
+		if window, err := r.parseDuration(exp.Spec.ScheduleJitter); err == nil {
+			offset = cronschedule.Offset(key, window)
 		}
+	}
+	return key, offset
+}
 
-		select {
-		case <-ctx.Done():
-			return ctx.Err()
-		case <-time.After(1 * time.Second):
+// publishNextScheduledTime records the next run after now of a scheduled experiment in
+// status.nextScheduledTime and returns it. It runs ahead of the freeze and window gates, so a
+// new experiment shows when it fires on its first reconcile. The time is zero, and the status
+// field cleared, when excludeSchedules leave no run.
+func (r *ChaosExperimentReconciler) publishNextScheduledTime(ctx context.Context, exp *chaosv1alpha1.ChaosExperiment, now time.Time) (time.Time, error) {
+	key, offset := r.scheduleKeyAndOffset(exp)
+	next, err := r.schedules.Next(key, chaosv1alpha1.ScheduleDefinition(&exp.Spec), offset, now)
+	if err != nil {
+		return time.Time{}, err
+	}
+	var nextTime *metav1.Time
+	if !next.IsZero() {
+		t := metav1.NewTime(next)
+		nextTime = &t
+	}
+	if (exp.Status.NextScheduledTime == nil) != (nextTime == nil) ||
+		(nextTime != nil && !exp.Status.NextScheduledTime.Equal(nextTime)) {
+		exp.Status.NextScheduledTime = nextTime
+		if err := r.Status().Update(ctx, exp); err != nil {
+			ctrl.LoggerFrom(ctx).Error(err, "Failed to update next scheduled time")
+			// Don't fail the reconciliation for this
 		}
 	}
+	return next, nil
 }
 
+// noScheduledRunRecheck is how often an experiment whose schedules are entirely excluded is
+// looked at again
+const noScheduledRunRecheck = time.Hour
+
 // checkSchedule determines if a scheduled experiment should run now
 // Returns: shouldRun (bool), requeueAfter (time.Duration), error
 func (r *ChaosExperimentReconciler) checkSchedule(ctx context.Context, exp *chaosv1alpha1.ChaosExperiment) (bool, time.Duration, error) {
 	log := ctrl.LoggerFrom(ctx)
 
 	// If no schedule is defined, always run (immediate execution)
-	if exp.Spec.Schedule == "" {
+	definition := chaosv1alpha1.ScheduleDefinition(&exp.Spec)
+	if definition.IsZero() {
 		return true, time.Minute, nil // Requeue after 1 minute for continuous experiments
 	}
 
-	// Parse the cron schedule
-	parser := cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow | cron.Descriptor)
-	schedule, err := parser.Parse(exp.Spec.Schedule)
+	key, offset := r.scheduleKeyAndOffset(exp)
+
+	// Parse the cron schedules, or reuse them from the previous reconcile
+	schedule, err := r.schedules.Schedule(key, definition, offset)
 	if err != nil {
-		log.Error(err, "Failed to parse cron schedule", "schedule", exp.Spec.Schedule)
-		return false, 0, fmt.Errorf("invalid schedule: %w", err)
+		log.Error(err, "Failed to parse cron schedule", "schedule", definition.String())
+		return false, 0, err
 	}
 
 	now := time.Now()
 
 	// Calculate when the experiment should next run
-	nextScheduledTime := schedule.Next(now)
+	nextScheduledTime, err := r.publishNextScheduledTime(ctx, exp, now)
+	if err != nil {
+		return false, 0, err
+	}
 
 	// Determine the reference time for checking if we should run
 	// Use LastScheduledTime if set, otherwise use StartTime or creation time
@@ -3510,19 +4177,9 @@ func (r *ChaosExperimentReconciler) checkSchedule(ctx context.Context, exp *chao
 	// we haven't run since then
 	shouldRun := !lastScheduleShouldHaveFired.After(now) && lastScheduleShouldHaveFired.After(lastScheduledTime)
 
-	// Update NextScheduledTime in status
-	nextTime := metav1.NewTime(nextScheduledTime)
-	if exp.Status.NextScheduledTime == nil || !exp.Status.NextScheduledTime.Equal(&nextTime) {
-		exp.Status.NextScheduledTime = &nextTime
-		if err := r.Status().Update(ctx, exp); err != nil {
-			log.Error(err, "Failed to update next scheduled time")
-			// Don't fail the reconciliation for this
-		}
-	}
-
 	if shouldRun {
 		log.Info("Scheduled experiment should run now",
-			"schedule", exp.Spec.Schedule,
+			"schedule", definition.String(),
 			"lastScheduledTime", lastScheduledTime,
 			"nextScheduledTime", nextScheduledTime)
 
@@ -3537,10 +4194,16 @@ func (r *ChaosExperimentReconciler) checkSchedule(ctx context.Context, exp *chao
 		return true, 0, nil
 	}
 
+	if nextScheduledTime.IsZero() {
+		log.Info("Scheduled experiment has no run left outside its excluded schedules",
+			"schedule", definition.String())
+		return false, noScheduledRunRecheck, nil
+	}
+
 	// Calculate how long until the next scheduled run
 	untilNext := time.Until(nextScheduledTime)
 	log.Info("Scheduled experiment not due yet, requeuing",
-		"schedule", exp.Spec.Schedule,
+		"schedule", definition.String(),
 		"nextRun", nextScheduledTime,
 		"requeueAfter", untilNext)
 
@@ -3783,6 +4446,11 @@ func (r *ChaosExperimentReconciler) cleanupEphemeralContainers(ctx context.Conte
 						"namespace", namespace,
 						"container", containerName)
 					stillRunning++
+					if exp.Spec.Action == "pod-disk-fill" {
+						if err := r.stopDiskFill(ctx, pod, containerName); err != nil {
+							log.Error(err, "Failed to stop disk filler", "pod", podName, "namespace", namespace)
+						}
+					}
 				}
 				break
 			}
@@ -3805,36 +4473,81 @@ func (r *ChaosExperimentReconciler) cleanupEphemeralContainers(ctx context.Conte
 		"total", len(exp.Status.AffectedPods))
 
 	// Clear the affected pods list after cleanup attempt
-	exp.Status.AffectedPods = nil
+	clearAffectedPods(exp)
 }
 
-// trackAffectedPod adds a pod to the affected pods list in the experiment status
-func (r *ChaosExperimentReconciler) trackAffectedPod(exp *chaosv1alpha1.ChaosExperiment, namespace, podName, containerName string) {
-	podRef := fmt.Sprintf("%s/%s:%s", namespace, podName, containerName)
-
-	// Check if already tracked (avoid duplicates)
-	for _, existing := range exp.Status.AffectedPods {
-		if existing == podRef {
-			return
-		}
+// parseAffectedPodRef splits a "namespace/pod:container" status.affectedPods entry
+func parseAffectedPodRef(ref string) (types.NamespacedName, string, bool) {
+	podKey, containerName, ok := strings.Cut(ref, ":")
+	if !ok {
+		return types.NamespacedName{}, "", false
 	}
-
-	exp.Status.AffectedPods = append(exp.Status.AffectedPods, podRef)
+	namespace, name, ok := strings.Cut(podKey, "/")
+	if !ok {
+		return types.NamespacedName{}, "", false
+	}
+	return types.NamespacedName{Namespace: namespace, Name: name}, containerName, true
 }
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *ChaosExperimentReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	// Start periodic TTL cleanup as a manager-managed Runnable
-	if r.HistoryConfig.Enabled && r.HistoryConfig.RetentionTTL > 0 {
+	if r.HistoryConfig.Enabled && r.HistoryConfig.RetentionTTL > 0 && r.Shard.Primary() {
 		if err := mgr.Add(manager.RunnableFunc(r.startPeriodicTTLCleanup)); err != nil {
 			return err
 		}
 	}
 
+	// Report running experiments from cluster state instead of counting in handlers; with shards
+	// only the first reports them so the totals are not multiplied
+	if r.Shard.Primary() {
+		active := newActiveExperimentsCollector(mgr.GetClient())
+		if err := ctrlmetrics.Registry.Register(active); err != nil {
+			if !errors.As(err, &prometheus.AlreadyRegisteredError{}) {
+				return err
+			}
+		} else if err := mgr.Add(manager.RunnableFunc(active.run)); err != nil {
+			return err
+		}
+	}
+
+	// node-drain looks up the pods of a node in the cache when no APIReader is set
+	if err := mgr.GetFieldIndexer().IndexField(context.Background(), &corev1.Pod{}, podNodeNameField, indexPodNodeName); err != nil {
+		return err
+	}
+
+	r.recovery = newRecoveryTracker(mgr.GetClient())
+	r.injections = newInjectionLog()
+	r.schedules = cronschedule.NewCache()
+	r.holidayFeeds = holidays.NewCache(holidayFeedRefresh)
+	r.holidayConfigMaps = holidays.NewCache(holidayConfigMapRefresh)
+	r.cleanups = newCleanupLedger()
+	config, opts := mgr.GetConfig(), client.Options{Scheme: mgr.GetScheme(), Mapper: mgr.GetRESTMapper()}
+	if r.TagDestructiveRequests {
+		config = audittrail.Config(config)
+		tagged, err := client.New(config, opts)
+		if err != nil {
+			return fmt.Errorf("failed to create client for tagged requests: %w", err)
+		}
+		r.tagged, r.taggedConfig = tagged, config
+	}
+	if r.ImpersonateInitiator {
+		r.impersonator = newImpersonator(config, opts)
+	}
+	if err := mgr.Add(manager.RunnableFunc(r.recovery.run)); err != nil {
+		return err
+	}
+
+	var reconciler reconcile.Reconciler = r
+	if r.ReconcileErrors != nil {
+		reconciler = r.ReconcileErrors.Wrap(r)
+	}
 	return ctrl.NewControllerManagedBy(mgr).
-		For(&chaosv1alpha1.ChaosExperiment{}).
+		For(&chaosv1alpha1.ChaosExperiment{}, builder.WithPredicates(r.Shard.predicate())).
+		Watches(&chaosv1alpha1.ChaosFreeze{}, handler.EnqueueRequestsFromMapFunc(r.allExperiments)).
+		Watches(&chaosv1alpha1.ChaosPolicy{}, handler.EnqueueRequestsFromMapFunc(r.allExperiments)).
 		Named("chaosexperiment").
-		Complete(r)
+		Complete(reconciler)
 }
 
 // handleNetworkPartition injects network partition into pods using iptables to drop traffic
@@ -3842,10 +4555,6 @@ func (r *ChaosExperimentReconciler) handleNetworkPartition(ctx context.Context,
 	log := ctrl.LoggerFrom(ctx)
 	startTime := time.Now()
 
-	// Track active experiments
-	chaosmetrics.ActiveExperiments.WithLabelValues("network-partition").Inc()
-	defer chaosmetrics.ActiveExperiments.WithLabelValues("network-partition").Dec()
-
 	// Validate required fields
 	if exp.Spec.Duration == "" {
 		return r.handleExperimentFailure(ctx, exp, &ChaosError{
@@ -3897,10 +4606,24 @@ func (r *ChaosExperimentReconciler) handleNetworkPartition(ctx context.Context,
 		return ctrl.Result{}, r.handleDryRun(ctx, exp, eligiblePods, fmt.Sprintf("network-partition (%s)", direction))
 	}
 
-	// Shuffle the list of pods
-	rand.Shuffle(len(eligiblePods), func(i, j int) {
-		eligiblePods[i], eligiblePods[j] = eligiblePods[j], eligiblePods[i]
-	})
+	// With peerSelector only traffic between the targets and the peer group is blocked
+	var peerIPs []string
+	if len(exp.Spec.PeerSelector) > 0 {
+		peerIPs, err = r.resolvePartitionPeers(ctx, exp)
+		if err != nil {
+			return r.handleExperimentFailure(ctx, exp, WrapK8sError(err, "list peer pods"))
+		}
+		if len(peerIPs) == 0 {
+			log.Info("No running peer pods found", "peerSelector", exp.Spec.PeerSelector)
+			exp.Status.Message = "No running pods with an IP address match peerSelector"
+			_ = r.Status().Update(ctx, exp)
+			return ctrl.Result{RequeueAfter: time.Minute}, nil
+		}
+	}
+	rules := partitionRulesFor(&exp.Spec, direction, peerIPs)
+
+	// Order eligible pods so the first Count entries are the targets
+	eligiblePods = r.orderTargetPods(ctx, exp, eligiblePods)
 
 	// Determine how many pods to affect
 	affectCount := exp.Spec.Count
@@ -3912,19 +4635,44 @@ func (r *ChaosExperimentReconciler) handleNetworkPartition(ctx context.Context,
 	}
 
 	// Inject ephemeral containers to apply network partition
-	affectedPods := []string{}
+	injected := []injectedContainer{}
+	// failureReason explains the first pod the container could not be injected into, when actionable
+	failureReason := ""
+	// familyErr is the first pod none of the targets can be reached from
+	var familyErr *ChaosError
 	for i := 0; i < affectCount; i++ {
 		pod := eligiblePods[i]
+		if !r.targetInjectable(ctx, exp, &pod) {
+			continue
+		}
+
+		podRules, err := rules.forPod(&pod)
+		if err != nil {
+			log.Error(err, "Partition targets do not match the pod's IP families", "pod", pod.Name, "namespace", pod.Namespace)
+			chaosErr := &ChaosError{Original: err, Type: ErrorTypeValidation, Operation: "match network-partition IP family"}
+			chaosmetrics.ExperimentErrors.WithLabelValues("network-partition", exp.Spec.Namespace, string(chaosErr.Type)).Inc()
+			if familyErr == nil {
+				familyErr = chaosErr
+			}
+			continue
+		}
+
 		log.Info("Injecting network partition into pod",
 			"pod", pod.Name,
 			"namespace", pod.Namespace,
 			"direction", direction,
+			"peers", len(peerIPs),
+			"families", len(podRules.Families),
 			"duration", timeoutSeconds)
 
-		containerName, err := r.injectNetworkPartitionContainer(ctx, &pod, direction, timeoutSeconds)
+		containerName, err := r.injectNetworkPartitionContainer(ctx, &pod, podRules, timeoutSeconds)
 		if err != nil {
 			log.Error(err, "Failed to inject network partition container", "pod", pod.Name)
-			chaosmetrics.ExperimentErrors.WithLabelValues("network-partition", exp.Spec.Namespace, "injection_error").Inc()
+			if failureReason == "" {
+				failureReason = injectionFailureReason(err)
+			}
+			chaosErr := WrapK8sError(err, "update pod/ephemeralcontainers")
+			chaosmetrics.ExperimentErrors.WithLabelValues("network-partition", exp.Spec.Namespace, string(chaosErr.Type)).Inc()
 			continue
 		}
 
@@ -3933,8 +4681,23 @@ func (r *ChaosExperimentReconciler) handleNetworkPartition(ctx context.Context,
 			"Injected network partition (%s) by chaos experiment %s", direction, exp.Name)
 
 		// Track the affected pod for cleanup later
-		r.trackAffectedPod(exp, pod.Namespace, pod.Name, containerName)
-		affectedPods = append(affectedPods, pod.Name)
+		r.trackAffectedPod(ctx, exp, pod.Namespace, pod.Name, containerName)
+		injected = append(injected, injectedContainer{
+			Pod: client.ObjectKeyFromObject(&pod), Container: containerName, Probe: partitionProbe(podRules),
+		})
+	}
+
+	// Wait for the injected containers to start; those that failed do not count as affected
+	affectedPods, startErr := r.verifyInjections(ctx, exp, injected)
+	if len(affectedPods) == 0 && startErr != nil {
+		return r.handleExperimentFailure(ctx, exp, startErr)
+	}
+
+	// Measure what the targets actually see while the fault lasts
+	r.startNetworkMeasurements(ctx, exp, affectedPods, timeoutSeconds)
+
+	if len(affectedPods) == 0 && familyErr != nil {
+		return r.handleExperimentFailure(ctx, exp, familyErr)
 	}
 
 	// Update status
@@ -3944,10 +4707,18 @@ func (r *ChaosExperimentReconciler) handleNetworkPartition(ctx context.Context,
 	if len(affectedPods) > 0 {
 		exp.Status.Message = fmt.Sprintf("Successfully injected network partition (%s) into %d pod(s) for %s",
 			direction, len(affectedPods), exp.Spec.Duration)
+		if len(peerIPs) > 0 {
+			exp.Status.Message = fmt.Sprintf("Successfully partitioned %d pod(s) from %d peer address(es) (%s) for %s",
+				len(affectedPods), len(peerIPs), direction, exp.Spec.Duration)
+		}
 	} else {
 		exp.Status.Message = "Failed to inject network partition into any pods"
+		if failureReason != "" {
+			exp.Status.Message += ": " + failureReason
+		}
 		status = statusFailure
 	}
+	countAffected(exp, len(affectedPods))
 	if err := r.Status().Update(ctx, exp); err != nil {
 		log.Error(err, "Failed to update ChaosExperiment status")
 		return ctrl.Result{}, err
@@ -3966,65 +4737,16 @@ func (r *ChaosExperimentReconciler) handleNetworkPartition(ctx context.Context,
 		// Don't fail the experiment if history recording fails
 	}
 
-	return ctrl.Result{RequeueAfter: time.Minute}, nil
+	return ctrl.Result{RequeueAfter: r.roundInterval(exp)}, nil
 }
 
 // injectNetworkPartitionContainer injects an ephemeral container that applies network partition using iptables
-func (r *ChaosExperimentReconciler) injectNetworkPartitionContainer(ctx context.Context, pod *corev1.Pod, direction string, timeoutSeconds int) (string, error) {
+func (r *ChaosExperimentReconciler) injectNetworkPartitionContainer(ctx context.Context, pod *corev1.Pod, rules partitionRules, timeoutSeconds int) (string, error) {
 	log := ctrl.LoggerFrom(ctx)
 
-	// Generate unique chain name using timestamp to avoid collisions
-	chainName := fmt.Sprintf("CHAOS_PARTITION_%d", time.Now().Unix())
-
-	// Build iptables command script using custom chains
-	// This approach is safer than direct rule injection:
-	// 1. Creates isolated chain for chaos rules
-	// 2. Prevents conflicts with CNI/mesh networking rules
-	// 3. Cleanup removes only chaos chain, not system rules
-	// 4. Easy debugging (inspect chain separately)
-	//
-	// Script flow:
-	// 1. Create custom chain
-	// 2. Insert jump rules to custom chain (high priority)
-	// 3. Add rules to custom chain: allow loopback, drop traffic
-	// 4. Sleep for duration
-	// 5. Cleanup: remove only custom chain (safe)
-
-	script := fmt.Sprintf(`
-# Create custom iptables chain for isolation
-iptables -N %s
-
-# Insert jump to custom chain at high priority (position 1)
-iptables -I INPUT 1 -j %s
-iptables -I OUTPUT 1 -j %s
-
-# Allow loopback traffic in custom chain to prevent process lockup
-iptables -A %s -i lo -j ACCEPT
-iptables -A %s -o lo -j ACCEPT
-
-# Block traffic based on direction in custom chain
-if [ "%s" = "both" ] || [ "%s" = "ingress" ]; then
-  iptables -A %s -j DROP
-fi
-if [ "%s" = "both" ] || [ "%s" = "egress" ]; then
-  iptables -A %s -j DROP
-fi
-
-# Wait for partition duration
-sleep %d
-
-# Safe cleanup: Remove only chaos chain, not system rules
-# Using '|| true' for idempotency on retries
-iptables -D INPUT -j %s || true
-iptables -D OUTPUT -j %s || true
-iptables -F %s || true
-iptables -X %s || true
-`, chainName, chainName, chainName,
-		chainName, chainName,
-		direction, direction, chainName,
-		direction, direction, chainName,
-		timeoutSeconds,
-		chainName, chainName, chainName, chainName)
+	// Unique chain names avoid collisions with partitions of other experiments
+	chainSuffix := strconv.FormatInt(time.Now().Unix(), 10)
+	script := rules.script(chainSuffix, timeoutSeconds)
 
 	// Generate unique container name
 	containerName := fmt.Sprintf("network-partition-%d", time.Now().Unix())
@@ -4032,9 +4754,10 @@ iptables -X %s || true
 	// Create ephemeral container with NET_ADMIN capability
 	ephemeralContainer := corev1.EphemeralContainer{
 		EphemeralContainerCommon: corev1.EphemeralContainerCommon{
-			Name:    containerName,
-			Image:   "nicolaka/netshoot", // Public image with iptables
-			Command: []string{"/bin/sh", "-c", script},
+			Name:                     containerName,
+			Image:                    "nicolaka/netshoot", // Public image with iptables
+			Command:                  []string{"/bin/sh", "-c", script},
+			TerminationMessagePolicy: corev1.TerminationMessageFallbackToLogsOnError,
 			SecurityContext: &corev1.SecurityContext{
 				Capabilities: &corev1.Capabilities{
 					Add: []corev1.Capability{"NET_ADMIN"},
@@ -4051,8 +4774,10 @@ iptables -X %s || true
 	log.Info("Successfully injected network partition ephemeral container",
 		"pod", pod.Name,
 		"container", containerName,
-		"chain", chainName,
-		"direction", direction,
+		"chainSuffix", chainSuffix,
+		"direction", rules.Direction,
+		"addresses", len(rules.Addresses),
+		"hosts", len(rules.Hosts),
 		"duration", timeoutSeconds)
 
 	return containerName, nil
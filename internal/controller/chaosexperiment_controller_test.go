@@ -498,6 +498,8 @@ var _ = Describe("ChaosExperiment Controller", func() {
 				Scheme:        k8sClient.Scheme(),
 				Recorder:      record.NewFakeRecorder(100),
 				HistoryConfig: DefaultHistoryConfig(),
+				// envtest has no kubelet, so the injected container never starts
+				EphemeralStartTimeout: time.Second,
 			}
 
 			_, err := reconciler.Reconcile(ctx, reconcile.Request{
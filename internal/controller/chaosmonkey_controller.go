@@ -0,0 +1,396 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"sort"
+	"strings"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	chaosv1alpha1 "github.com/neogan74/k8s-chaos/api/v1alpha1"
+	chaosduration "github.com/neogan74/k8s-chaos/internal/duration"
+	chaosmetrics "github.com/neogan74/k8s-chaos/internal/metrics"
+)
+
+const (
+	// defaultMonkeyInterval is the mean time between runs when spec.interval is not set
+	defaultMonkeyInterval = time.Hour
+	// defaultMonkeyMinReadyReplicas applies when spec.minReadyReplicas is not set
+	defaultMonkeyMinReadyReplicas = 2
+	// monkeyWaitInterval is how often a monkey held by a freeze or a running experiment checks again
+	monkeyWaitInterval = time.Minute
+)
+
+// ChaosMonkeyReconciler runs ChaosMonkeys: after a random gap it picks an eligible workload
+// and creates a low-severity ChaosExperiment against it, one experiment at a time
+type ChaosMonkeyReconciler struct {
+	client.Client
+	Scheme   *runtime.Scheme
+	Recorder record.EventRecorder
+
+	// Experiments is the experiment reconciler whose injection log the monkey consults to skip
+	// namespaces that have used up their ChaosPolicy rate limit; optional
+	Experiments *ChaosExperimentReconciler
+
+	// WatchNamespaces are the namespaces the manager's cache is restricted to; the monkey picks
+	// from no other. Empty means all.
+	WatchNamespaces []string
+	// Shard restricts the reconciler to the monkeys of one shard; nil reconciles every monkey
+	Shard *Shard
+	// ExperimentWriter creates the experiments, typically impersonating a ServiceAccount so the
+	// webhook records it as their creator; the controller's own client is used when nil
+	ExperimentWriter client.Client
+
+	// Rand draws gaps, targets and actions; the global source is used when nil
+	Rand *rand.Rand
+}
+
+// monkeyTarget is a workload a monkey may pick
+type monkeyTarget struct {
+	Namespace  string
+	Kind       string
+	Name       string
+	Selector   map[string]string
+	Production bool
+}
+
+func (t *monkeyTarget) String() string {
+	return t.Namespace + "/" + t.Kind + "/" + t.Name
+}
+
+// +kubebuilder:rbac:groups=chaos.gushchin.dev,resources=chaosmonkeys,verbs=get;list;watch
+// +kubebuilder:rbac:groups=chaos.gushchin.dev,resources=chaosmonkeys/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=chaos.gushchin.dev,resources=chaosmonkeys/finalizers,verbs=update
+
+// Reconcile starts the next run of a ChaosMonkey once it is due
+func (r *ChaosMonkeyReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := ctrl.LoggerFrom(ctx)
+
+	monkey := &chaosv1alpha1.ChaosMonkey{}
+	if err := r.Get(ctx, req.NamespacedName, monkey); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+	if monkey.DeletionTimestamp != nil {
+		return ctrl.Result{}, nil
+	}
+	now := time.Now()
+
+	if monkey.Spec.Paused {
+		return ctrl.Result{}, r.updateMonkeyStatus(ctx, monkey, "Paused", nil)
+	}
+
+	freeze, err := r.activeFreeze(ctx, now)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if freeze != nil {
+		message := fmt.Sprintf("Held by ChaosFreeze %q", freeze.Name)
+		return ctrl.Result{RequeueAfter: monkeyWaitInterval}, r.updateMonkeyStatus(ctx, monkey, message, nil)
+	}
+
+	running, err := r.runningExperiment(ctx, monkey)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if running != "" {
+		// Completion of the owned experiment triggers a reconcile; the requeue is a safety net
+		message := fmt.Sprintf("Waiting for experiment %s to finish", running)
+		return ctrl.Result{RequeueAfter: monkeyWaitInterval}, r.updateMonkeyStatus(ctx, monkey, message, nil)
+	}
+
+	// The first run is scheduled a random gap after creation rather than started at once
+	if monkey.Status.NextRunTime == nil {
+		next := metav1.NewTime(now.Add(r.nextGap(monkey)))
+		return ctrl.Result{RequeueAfter: next.Sub(now)},
+			r.updateMonkeyStatus(ctx, monkey, "Waiting for the next run", &next)
+	}
+	if now.Before(monkey.Status.NextRunTime.Time) {
+		return ctrl.Result{RequeueAfter: monkey.Status.NextRunTime.Sub(now)}, nil
+	}
+
+	if !chaosv1alpha1.IsWithinTimeWindows(monkey.Spec.TimeWindows, now) {
+		boundary, opens := chaosv1alpha1.NextTimeWindowBoundary(monkey.Spec.TimeWindows, now)
+		if !opens {
+			return ctrl.Result{}, r.updateMonkeyStatus(ctx, monkey, "No upcoming time window", nil)
+		}
+		next := metav1.NewTime(boundary)
+		return ctrl.Result{RequeueAfter: boundary.Sub(now)},
+			r.updateMonkeyStatus(ctx, monkey, "Outside time windows", &next)
+	}
+
+	next := metav1.NewTime(now.Add(r.nextGap(monkey)))
+	target, err := r.pickTarget(ctx, monkey, now)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if target == nil {
+		log.Info("No eligible workload for chaos monkey", "monkey", monkey.Name)
+		return ctrl.Result{RequeueAfter: next.Sub(now)},
+			r.updateMonkeyStatus(ctx, monkey, "No eligible workload", &next)
+	}
+
+	action := monkeyActions(monkey)[r.intn(len(monkeyActions(monkey)))]
+	exp := monkeyExperiment(monkey, target, action)
+	if err := controllerutil.SetControllerReference(monkey, exp, r.Scheme); err != nil {
+		return ctrl.Result{}, err
+	}
+	writer := r.ExperimentWriter
+	if writer == nil {
+		writer = r.Client
+	}
+	if err := writer.Create(ctx, exp); err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to create experiment: %w", err)
+	}
+	log.Info("Chaos monkey created experiment", "monkey", monkey.Name, "experiment", exp.Name,
+		"action", action, "target", target.String())
+	r.Recorder.Event(monkey, corev1.EventTypeNormal, "ExperimentCreated",
+		fmt.Sprintf("Created %s experiment %s against %s", action, exp.Name, target.String()))
+	chaosmetrics.ChaosMonkeyRuns.WithLabelValues(monkey.Namespace+"/"+monkey.Name, action, target.Namespace).Inc()
+
+	runAt := metav1.NewTime(now)
+	monkey.Status.Runs++
+	monkey.Status.LastRunTime = &runAt
+	monkey.Status.LastExperiment = exp.Name
+	monkey.Status.LastTarget = target.String()
+	message := fmt.Sprintf("Running %s against %s", action, target.String())
+	return ctrl.Result{RequeueAfter: next.Sub(now)}, r.updateMonkeyStatus(ctx, monkey, message, &next)
+}
+
+// updateMonkeyStatus records message and, when set, the next run time
+func (r *ChaosMonkeyReconciler) updateMonkeyStatus(ctx context.Context, monkey *chaosv1alpha1.ChaosMonkey, message string, next *metav1.Time) error {
+	if next != nil {
+		monkey.Status.NextRunTime = next
+	}
+	monkey.Status.Message = message
+	if err := r.Status().Update(ctx, monkey); err != nil {
+		return fmt.Errorf("failed to update chaos monkey status: %w", err)
+	}
+	return nil
+}
+
+// activeFreeze returns the first ChaosFreeze in effect, or nil
+func (r *ChaosMonkeyReconciler) activeFreeze(ctx context.Context, now time.Time) (*chaosv1alpha1.ChaosFreeze, error) {
+	freezes := &chaosv1alpha1.ChaosFreezeList{}
+	if err := r.List(ctx, freezes); err != nil {
+		return nil, fmt.Errorf("failed to list chaos freezes: %w", err)
+	}
+	for i := range freezes.Items {
+		if freezes.Items[i].IsActive(now) {
+			return &freezes.Items[i], nil
+		}
+	}
+	return nil, nil
+}
+
+// runningExperiment returns the name of an unfinished experiment created by the monkey, or ""
+func (r *ChaosMonkeyReconciler) runningExperiment(ctx context.Context, monkey *chaosv1alpha1.ChaosMonkey) (string, error) {
+	experiments := &chaosv1alpha1.ChaosExperimentList{}
+	if err := r.List(ctx, experiments, client.InNamespace(monkey.Namespace),
+		client.MatchingLabels{chaosv1alpha1.MonkeyLabel: monkey.Name}); err != nil {
+		return "", fmt.Errorf("failed to list chaos monkey experiments: %w", err)
+	}
+	for _, exp := range experiments.Items {
+		if exp.DeletionTimestamp == nil && exp.Status.Phase != phaseCompleted && exp.Status.Phase != phaseFailed {
+			return exp.Name, nil
+		}
+	}
+	return "", nil
+}
+
+// pickTarget chooses a random eligible workload, or returns nil when there is none
+func (r *ChaosMonkeyReconciler) pickTarget(ctx context.Context, monkey *chaosv1alpha1.ChaosMonkey, now time.Time) (*monkeyTarget, error) {
+	namespaces, err := r.candidateNamespaces(ctx, monkey, now)
+	if err != nil || len(namespaces) == 0 {
+		return nil, err
+	}
+
+	minReady := monkey.Spec.MinReadyReplicas
+	if minReady < defaultMonkeyMinReadyReplicas {
+		minReady = defaultMonkeyMinReadyReplicas
+	}
+
+	var targets []monkeyTarget
+	add := func(kind string, meta metav1.ObjectMeta, replicas *int32, ready int32, selector *metav1.LabelSelector, template *corev1.PodTemplateSpec) {
+		production, ok := namespaces[meta.Namespace]
+		if !ok || selector == nil || len(selector.MatchLabels) == 0 || len(selector.MatchExpressions) > 0 {
+			return
+		}
+		if template.Labels[chaosv1alpha1.ExclusionLabel] == "true" || chaosv1alpha1.IsExcludedUntil(template.Annotations, now) {
+			return
+		}
+		desired := int32(1)
+		if replicas != nil {
+			desired = *replicas
+		}
+		// Only fully ready workloads with enough replicas to absorb the loss of one pod
+		if desired < minReady || ready < desired {
+			return
+		}
+		targets = append(targets, monkeyTarget{
+			Namespace:  meta.Namespace,
+			Kind:       kind,
+			Name:       meta.Name,
+			Selector:   selector.MatchLabels,
+			Production: production,
+		})
+	}
+
+	deployments := &appsv1.DeploymentList{}
+	if err := r.List(ctx, deployments); err != nil {
+		return nil, fmt.Errorf("failed to list deployments: %w", err)
+	}
+	for i := range deployments.Items {
+		d := &deployments.Items[i]
+		add("Deployment", d.ObjectMeta, d.Spec.Replicas, d.Status.ReadyReplicas, d.Spec.Selector, &d.Spec.Template)
+	}
+	statefulSets := &appsv1.StatefulSetList{}
+	if err := r.List(ctx, statefulSets); err != nil {
+		return nil, fmt.Errorf("failed to list statefulsets: %w", err)
+	}
+	for i := range statefulSets.Items {
+		s := &statefulSets.Items[i]
+		add("StatefulSet", s.ObjectMeta, s.Spec.Replicas, s.Status.ReadyReplicas, s.Spec.Selector, &s.Spec.Template)
+	}
+
+	if len(targets) == 0 {
+		return nil, nil
+	}
+	// List order is not guaranteed; sort so that a seeded Rand picks reproducibly
+	sort.Slice(targets, func(i, j int) bool { return targets[i].String() < targets[j].String() })
+	return &targets[r.intn(len(targets))], nil
+}
+
+// candidateNamespaces returns the namespaces the monkey may pick from, mapped to whether they
+// are production namespaces. Excluded namespaces, production namespaces unless allowed and
+// namespaces whose ChaosPolicy rate limit is used up are left out.
+func (r *ChaosMonkeyReconciler) candidateNamespaces(ctx context.Context, monkey *chaosv1alpha1.ChaosMonkey, now time.Time) (map[string]bool, error) {
+	namespaces := &corev1.NamespaceList{}
+	if err := r.List(ctx, namespaces); err != nil {
+		return nil, fmt.Errorf("failed to list namespaces: %w", err)
+	}
+	allowed := map[string]bool{}
+	for _, name := range monkey.Spec.Namespaces {
+		allowed[name] = true
+	}
+
+	candidates := map[string]bool{}
+	for i := range namespaces.Items {
+		ns := &namespaces.Items[i]
+		if (len(allowed) > 0 && !allowed[ns.Name]) || !watchesNamespace(r.WatchNamespaces, ns.Name) {
+			continue
+		}
+		if len(allowed) == 0 && strings.HasPrefix(ns.Name, "kube-") {
+			continue
+		}
+		if ns.DeletionTimestamp != nil || ns.Annotations[chaosv1alpha1.ExclusionLabel] == "true" ||
+			chaosv1alpha1.IsExcludedUntil(ns.Annotations, now) {
+			continue
+		}
+		production := chaosv1alpha1.IsProductionNamespace(ns.Name, ns)
+		if production && !monkey.Spec.AllowProduction {
+			continue
+		}
+		if r.budgetExhausted(ctx, ns.Name, now) {
+			continue
+		}
+		candidates[ns.Name] = production
+	}
+	return candidates, nil
+}
+
+// budgetExhausted reports whether a ChaosPolicy would hold back an injection into namespace now
+func (r *ChaosMonkeyReconciler) budgetExhausted(ctx context.Context, namespace string, now time.Time) bool {
+	if r.Experiments == nil {
+		return false
+	}
+	probe := &chaosv1alpha1.ChaosExperiment{Spec: chaosv1alpha1.ChaosExperimentSpec{Namespace: namespace}}
+	wait, _, err := r.Experiments.rateLimitDelay(ctx, probe, now)
+	return err == nil && wait > 0
+}
+
+// nextGap draws the time until the next run, between half and one and a half times the interval
+func (r *ChaosMonkeyReconciler) nextGap(monkey *chaosv1alpha1.ChaosMonkey) time.Duration {
+	interval := defaultMonkeyInterval
+	if parsed, err := chaosduration.Parse(monkey.Spec.Interval); err == nil && parsed > 0 {
+		interval = parsed
+	}
+	return interval/2 + time.Duration(r.float64()*float64(interval))
+}
+
+func (r *ChaosMonkeyReconciler) intn(n int) int {
+	if r.Rand != nil {
+		return r.Rand.Intn(n)
+	}
+	return rand.Intn(n)
+}
+
+func (r *ChaosMonkeyReconciler) float64() float64 {
+	if r.Rand != nil {
+		return r.Rand.Float64()
+	}
+	return rand.Float64()
+}
+
+// monkeyActions returns the actions a monkey chooses from
+func monkeyActions(monkey *chaosv1alpha1.ChaosMonkey) []string {
+	if len(monkey.Spec.Actions) == 0 {
+		return []string{"pod-kill"}
+	}
+	return monkey.Spec.Actions
+}
+
+// monkeyExperiment builds the single-pod experiment of one monkey run
+func monkeyExperiment(monkey *chaosv1alpha1.ChaosMonkey, target *monkeyTarget, action string) *chaosv1alpha1.ChaosExperiment {
+	return &chaosv1alpha1.ChaosExperiment{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: monkey.Name + "-",
+			Namespace:    monkey.Namespace,
+			Labels:       map[string]string{chaosv1alpha1.MonkeyLabel: monkey.Name},
+		},
+		Spec: chaosv1alpha1.ChaosExperimentSpec{
+			Action:                  action,
+			Namespace:               target.Namespace,
+			Selector:                target.Selector,
+			Count:                   1,
+			Severity:                chaosv1alpha1.SeverityLow,
+			AllowProduction:         target.Production,
+			TTLSecondsAfterFinished: monkey.Spec.ExperimentTTLSecondsAfterFinished,
+		},
+	}
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *ChaosMonkeyReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&chaosv1alpha1.ChaosMonkey{}, builder.WithPredicates(r.Shard.predicate())).
+		Owns(&chaosv1alpha1.ChaosExperiment{}, builder.WithPredicates(r.Shard.ownerPredicate())).
+		Named("chaosmonkey").
+		Complete(r)
+}
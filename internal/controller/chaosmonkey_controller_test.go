@@ -0,0 +1,260 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	chaosv1alpha1 "github.com/neogan74/k8s-chaos/api/v1alpha1"
+)
+
+func newMonkeyReconciler(t *testing.T, objs ...client.Object) *ChaosMonkeyReconciler {
+	t.Helper()
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, chaosv1alpha1.AddToScheme(scheme))
+	require.NoError(t, corev1.AddToScheme(scheme))
+	require.NoError(t, appsv1.AddToScheme(scheme))
+
+	cl := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(objs...).
+		WithStatusSubresource(&chaosv1alpha1.ChaosMonkey{}, &chaosv1alpha1.ChaosExperiment{}).
+		Build()
+
+	return &ChaosMonkeyReconciler{
+		Client:   cl,
+		Scheme:   scheme,
+		Recorder: record.NewFakeRecorder(100),
+		Experiments: &ChaosExperimentReconciler{
+			Client:     cl,
+			injections: newInjectionLog(),
+		},
+		Rand: rand.New(rand.NewSource(1)),
+	}
+}
+
+// dueMonkey returns a monkey whose next run is already due
+func dueMonkey() *chaosv1alpha1.ChaosMonkey {
+	return &chaosv1alpha1.ChaosMonkey{
+		ObjectMeta: metav1.ObjectMeta{Name: "monkey", Namespace: "chaos"},
+		Spec: chaosv1alpha1.ChaosMonkeySpec{
+			Actions:                           []string{"pod-kill"},
+			Interval:                          "1h",
+			MinReadyReplicas:                  2,
+			ExperimentTTLSecondsAfterFinished: ptr.To[int32](600),
+		},
+		Status: chaosv1alpha1.ChaosMonkeyStatus{
+			NextRunTime: &metav1.Time{Time: time.Now().Add(-time.Minute)},
+		},
+	}
+}
+
+func monkeyDeployment(namespace, name string, replicas, ready int32) *appsv1.Deployment {
+	labels := map[string]string{"app": name}
+	return &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: ptr.To(replicas),
+			Selector: &metav1.LabelSelector{MatchLabels: labels},
+			Template: corev1.PodTemplateSpec{ObjectMeta: metav1.ObjectMeta{Labels: labels}},
+		},
+		Status: appsv1.DeploymentStatus{ReadyReplicas: ready},
+	}
+}
+
+func monkeyExperiments(t *testing.T, r *ChaosMonkeyReconciler) []chaosv1alpha1.ChaosExperiment {
+	t.Helper()
+	list := &chaosv1alpha1.ChaosExperimentList{}
+	require.NoError(t, r.List(context.Background(), list, client.MatchingLabels{chaosv1alpha1.MonkeyLabel: "monkey"}))
+	return list.Items
+}
+
+func TestChaosMonkeyReconcile_SchedulesFirstRun(t *testing.T) {
+	monkey := dueMonkey()
+	monkey.Status.NextRunTime = nil
+	r := newMonkeyReconciler(t, monkey,
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "shop"}},
+		monkeyDeployment("shop", "web", 3, 3))
+
+	result, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: client.ObjectKeyFromObject(monkey)})
+	require.NoError(t, err)
+	assert.GreaterOrEqual(t, result.RequeueAfter, 30*time.Minute)
+	assert.LessOrEqual(t, result.RequeueAfter, 90*time.Minute)
+	assert.Empty(t, monkeyExperiments(t, r), "the first run waits for a gap")
+
+	updated := &chaosv1alpha1.ChaosMonkey{}
+	require.NoError(t, r.Get(context.Background(), client.ObjectKeyFromObject(monkey), updated))
+	require.NotNil(t, updated.Status.NextRunTime)
+	assert.Zero(t, updated.Status.Runs)
+}
+
+func TestChaosMonkeyReconcile_CreatesExperimentAgainstEligibleWorkload(t *testing.T) {
+	ctx := context.Background()
+	monkey := dueMonkey()
+	r := newMonkeyReconciler(t, monkey,
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "shop"}},
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{
+			Name:        "excluded",
+			Annotations: map[string]string{chaosv1alpha1.ExclusionLabel: "true"},
+		}},
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "shop-prod"}},
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "kube-system"}},
+		monkeyDeployment("shop", "web", 3, 3),
+		monkeyDeployment("shop", "single", 1, 1),
+		monkeyDeployment("shop", "degraded", 3, 2),
+		monkeyDeployment("excluded", "web", 3, 3),
+		monkeyDeployment("shop-prod", "web", 3, 3),
+		monkeyDeployment("kube-system", "coredns", 2, 2))
+
+	result, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: client.ObjectKeyFromObject(monkey)})
+	require.NoError(t, err)
+	assert.Positive(t, result.RequeueAfter)
+
+	experiments := monkeyExperiments(t, r)
+	require.Len(t, experiments, 1)
+	exp := experiments[0]
+	assert.Equal(t, "chaos", exp.Namespace)
+	assert.Equal(t, "pod-kill", exp.Spec.Action)
+	assert.Equal(t, "shop", exp.Spec.Namespace)
+	assert.Equal(t, map[string]string{"app": "web"}, exp.Spec.Selector)
+	assert.Equal(t, 1, exp.Spec.Count)
+	assert.Equal(t, ptr.To[int32](600), exp.Spec.TTLSecondsAfterFinished)
+	require.Len(t, exp.OwnerReferences, 1)
+	assert.Equal(t, "monkey", exp.OwnerReferences[0].Name)
+
+	updated := &chaosv1alpha1.ChaosMonkey{}
+	require.NoError(t, r.Get(ctx, client.ObjectKeyFromObject(monkey), updated))
+	assert.Equal(t, int64(1), updated.Status.Runs)
+	assert.Equal(t, exp.Name, updated.Status.LastExperiment)
+	assert.Equal(t, "shop/Deployment/web", updated.Status.LastTarget)
+	assert.True(t, updated.Status.NextRunTime.After(time.Now()))
+
+	// The experiment is still running, so the next due run waits for it
+	updated.Status.NextRunTime = &metav1.Time{Time: time.Now().Add(-time.Minute)}
+	require.NoError(t, r.Status().Update(ctx, updated))
+	_, err = r.Reconcile(ctx, ctrl.Request{NamespacedName: client.ObjectKeyFromObject(monkey)})
+	require.NoError(t, err)
+	assert.Len(t, monkeyExperiments(t, r), 1)
+	require.NoError(t, r.Get(ctx, client.ObjectKeyFromObject(monkey), updated))
+	assert.Contains(t, updated.Status.Message, "Waiting for experiment")
+}
+
+func TestChaosMonkeyReconcile_HeldBack(t *testing.T) {
+	tests := []struct {
+		name    string
+		mutate  func(*chaosv1alpha1.ChaosMonkey)
+		objs    []client.Object
+		message string
+	}{
+		{
+			name:    "paused",
+			mutate:  func(m *chaosv1alpha1.ChaosMonkey) { m.Spec.Paused = true },
+			message: "Paused",
+		},
+		{
+			name: "freeze",
+			objs: []client.Object{&chaosv1alpha1.ChaosFreeze{
+				ObjectMeta: metav1.ObjectMeta{Name: "incident"},
+			}},
+			message: `Held by ChaosFreeze "incident"`,
+		},
+		{
+			name: "outside time windows",
+			mutate: func(m *chaosv1alpha1.ChaosMonkey) {
+				m.Spec.TimeWindows = []chaosv1alpha1.TimeWindow{{
+					Type:  chaosv1alpha1.TimeWindowAbsolute,
+					Start: time.Now().Add(24 * time.Hour).Format(time.RFC3339),
+					End:   time.Now().Add(25 * time.Hour).Format(time.RFC3339),
+				}}
+			},
+			message: "Outside time windows",
+		},
+		{
+			name: "budget exhausted",
+			objs: []client.Object{&chaosv1alpha1.ChaosPolicy{
+				ObjectMeta: metav1.ObjectMeta{Name: "budget"},
+				Spec: chaosv1alpha1.ChaosPolicySpec{
+					RateLimit: &chaosv1alpha1.ChaosRateLimit{MaxInjections: 1, Window: "1h"},
+				},
+			}},
+			message: "No eligible workload",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			monkey := dueMonkey()
+			if tt.mutate != nil {
+				tt.mutate(monkey)
+			}
+			objs := append([]client.Object{monkey,
+				&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "shop"}},
+				monkeyDeployment("shop", "web", 3, 3)}, tt.objs...)
+			r := newMonkeyReconciler(t, objs...)
+			r.Experiments.injections.record("shop", time.Now().Add(-time.Minute))
+
+			_, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: client.ObjectKeyFromObject(monkey)})
+			require.NoError(t, err)
+			assert.Empty(t, monkeyExperiments(t, r))
+
+			updated := &chaosv1alpha1.ChaosMonkey{}
+			require.NoError(t, r.Get(ctx, client.ObjectKeyFromObject(monkey), updated))
+			assert.Equal(t, tt.message, updated.Status.Message)
+			assert.Zero(t, updated.Status.Runs)
+		})
+	}
+}
+
+func TestChaosMonkeyReconcile_CreatesWithExperimentWriter(t *testing.T) {
+	monkey := dueMonkey()
+	r := newMonkeyReconciler(t, monkey,
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "shop"}},
+		monkeyDeployment("shop", "web", 3, 3))
+	var created []string
+	r.ExperimentWriter = interceptor.NewClient(r.Client.(client.WithWatch), interceptor.Funcs{
+		Create: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.CreateOption) error {
+			err := c.Create(ctx, obj, opts...)
+			created = append(created, obj.GetName())
+			return err
+		},
+	})
+
+	_, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: client.ObjectKeyFromObject(monkey)})
+	require.NoError(t, err)
+
+	experiments := monkeyExperiments(t, r)
+	require.Len(t, experiments, 1)
+	assert.Equal(t, []string{experiments[0].Name}, created)
+}
@@ -0,0 +1,335 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	chaosv1alpha1 "github.com/neogan74/k8s-chaos/api/v1alpha1"
+	chaosmetrics "github.com/neogan74/k8s-chaos/internal/metrics"
+)
+
+const (
+	// defaultCleanupTaskMaxAttempts applies when spec.maxAttempts is not set
+	defaultCleanupTaskMaxAttempts = 10
+	// cleanupTaskBaseBackoff is the wait after the first failed attempt; it doubles with every attempt
+	cleanupTaskBaseBackoff = 5 * time.Second
+	// cleanupTaskMaxBackoff caps the wait between attempts
+	cleanupTaskMaxBackoff = 5 * time.Minute
+	// defaultCleanupTaskRetention is how long succeeded tasks are kept when Retention is not set
+	defaultCleanupTaskRetention = time.Hour
+	// cleanupTaskWaitInterval is how often a task with afterExperiment checks whether its
+	// experiment stopped running
+	cleanupTaskWaitInterval = 30 * time.Second
+)
+
+// ChaosCleanupTaskReconciler runs ChaosCleanupTasks: it retries each revert with exponential
+// backoff until it succeeds or runs out of attempts, apart from the experiment reconciles
+type ChaosCleanupTaskReconciler struct {
+	client.Client
+	Scheme   *runtime.Scheme
+	Recorder record.EventRecorder
+
+	// Experiments runs the reverts with the experiment reconciler's clients and exec access
+	Experiments *ChaosExperimentReconciler
+
+	// Retention is how long succeeded tasks are kept before they are deleted; zero means 1h.
+	// Failed tasks are kept until someone deletes them.
+	Retention time.Duration
+
+	// Shard restricts the reconciler to the tasks of one shard; nil reconciles every task
+	Shard *Shard
+
+	// now returns the current time; tests replace it
+	now func() time.Time
+}
+
+// +kubebuilder:rbac:groups=chaos.gushchin.dev,resources=chaoscleanuptasks,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=chaos.gushchin.dev,resources=chaoscleanuptasks/status,verbs=get;update;patch
+
+// Reconcile attempts the revert of a pending task when its backoff has passed and deletes
+// succeeded tasks once their retention is over
+func (r *ChaosCleanupTaskReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := ctrl.LoggerFrom(ctx)
+
+	task := &chaosv1alpha1.ChaosCleanupTask{}
+	if err := r.Get(ctx, req.NamespacedName, task); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+	now := r.clock()
+
+	switch task.Status.Phase {
+	case chaosv1alpha1.CleanupTaskFailed:
+		return ctrl.Result{}, nil
+	case chaosv1alpha1.CleanupTaskSucceeded:
+		expires := task.Status.CompletionTime.Add(r.retention())
+		if now.Before(expires) {
+			return ctrl.Result{RequeueAfter: expires.Sub(now)}, nil
+		}
+		return ctrl.Result{}, client.IgnoreNotFound(r.Delete(ctx, task))
+	}
+	if next := task.Status.NextAttemptTime; next != nil && now.Before(next.Time) {
+		return ctrl.Result{RequeueAfter: next.Sub(now)}, nil
+	}
+	if task.Spec.AfterExperiment {
+		running, err := r.experimentRunning(ctx, task)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		if running {
+			return ctrl.Result{RequeueAfter: cleanupTaskWaitInterval}, nil
+		}
+	}
+
+	attemptTime := metav1.NewTime(now)
+	task.Status.Attempts++
+	task.Status.LastAttemptTime = &attemptTime
+	err := validateCleanupTask(&task.Spec)
+	if err == nil {
+		err = r.Experiments.runCleanup(ctx, pendingCleanupOf(&task.Spec), task.Namespace+"/"+task.Spec.Experiment,
+			task.Spec.TaintKey, task.Spec.TaintEffect)
+	}
+
+	if err == nil {
+		log.Info("Cleanup task reverted its injection", "task", task.Name, "operation", task.Spec.Operation)
+		chaosmetrics.CleanupTaskAttempts.WithLabelValues(task.Spec.Operation, statusSuccess).Inc()
+		r.Recorder.Event(task, corev1.EventTypeNormal, "CleanupSucceeded",
+			fmt.Sprintf("%s reverted after %d attempt(s)", describeCleanupTask(&task.Spec), task.Status.Attempts))
+		task.Status.Phase = chaosv1alpha1.CleanupTaskSucceeded
+		task.Status.LastError = ""
+		task.Status.NextAttemptTime = nil
+		task.Status.CompletionTime = &attemptTime
+		return ctrl.Result{RequeueAfter: r.retention()}, r.Status().Update(ctx, task)
+	}
+
+	chaosmetrics.CleanupTaskAttempts.WithLabelValues(task.Spec.Operation, statusFailure).Inc()
+	task.Status.LastError = err.Error()
+	maxAttempts := task.Spec.MaxAttempts
+	if maxAttempts <= 0 {
+		maxAttempts = defaultCleanupTaskMaxAttempts
+	}
+	if task.Status.Attempts >= maxAttempts {
+		log.Error(err, "Cleanup task ran out of attempts", "task", task.Name, "attempts", task.Status.Attempts)
+		chaosmetrics.CleanupTasksFailed.WithLabelValues(task.Spec.Operation).Inc()
+		r.Recorder.Event(task, corev1.EventTypeWarning, "CleanupFailed",
+			fmt.Sprintf("%s not reverted after %d attempt(s), revert it by hand: %v",
+				describeCleanupTask(&task.Spec), task.Status.Attempts, err))
+		task.Status.Phase = chaosv1alpha1.CleanupTaskFailed
+		task.Status.NextAttemptTime = nil
+		task.Status.CompletionTime = &attemptTime
+		return ctrl.Result{}, r.Status().Update(ctx, task)
+	}
+
+	backoff := cleanupTaskBackoff(task.Status.Attempts)
+	log.Info("Cleanup task attempt failed, retrying", "task", task.Name, "attempt", task.Status.Attempts,
+		"backoff", backoff, "error", err.Error())
+	next := metav1.NewTime(now.Add(backoff))
+	task.Status.Phase = chaosv1alpha1.CleanupTaskPending
+	task.Status.NextAttemptTime = &next
+	return ctrl.Result{RequeueAfter: backoff}, r.Status().Update(ctx, task)
+}
+
+// experimentRunning reports whether the experiment that created the task is still running; a
+// deleted or recreated experiment no longer is
+func (r *ChaosCleanupTaskReconciler) experimentRunning(ctx context.Context, task *chaosv1alpha1.ChaosCleanupTask) (bool, error) {
+	exp := &chaosv1alpha1.ChaosExperiment{}
+	if err := r.Get(ctx, types.NamespacedName{Namespace: task.Namespace, Name: task.Spec.Experiment}, exp); err != nil {
+		return false, client.IgnoreNotFound(err)
+	}
+	if uid := task.Labels[chaosv1alpha1.ExperimentUIDLabel]; uid != "" && uid != string(exp.UID) {
+		return false, nil
+	}
+	return exp.DeletionTimestamp.IsZero() && exp.Status.Phase == phaseRunning, nil
+}
+
+// cleanupTaskBackoff returns the wait after the given number of failed attempts
+func cleanupTaskBackoff(attempts int32) time.Duration {
+	backoff := cleanupTaskBaseBackoff
+	for i := int32(1); i < attempts && backoff < cleanupTaskMaxBackoff; i++ {
+		backoff *= 2
+	}
+	return min(backoff, cleanupTaskMaxBackoff)
+}
+
+// validateCleanupTask checks that the task names the target its operation needs
+func validateCleanupTask(spec *chaosv1alpha1.ChaosCleanupTaskSpec) error {
+	switch spec.Operation {
+	case chaosv1alpha1.CleanupUncordon:
+		if spec.Node == "" {
+			return fmt.Errorf("node is required for %s", spec.Operation)
+		}
+	case chaosv1alpha1.CleanupUntaint:
+		if spec.Node == "" || spec.TaintKey == "" || spec.TaintEffect == "" {
+			return fmt.Errorf("node, taintKey and taintEffect are required for %s", spec.Operation)
+		}
+	case chaosv1alpha1.CleanupStopContainer:
+		if namespace, name, ok := strings.Cut(spec.Pod, "/"); !ok || namespace == "" || name == "" || spec.Container == "" {
+			return fmt.Errorf("pod as namespace/name and container are required for %s", spec.Operation)
+		}
+	case chaosv1alpha1.CleanupRestoreQuota:
+		if namespace, name, ok := strings.Cut(spec.Quota, "/"); !ok || namespace == "" || name == "" {
+			return fmt.Errorf("quota as namespace/name is required for %s", spec.Operation)
+		}
+	case chaosv1alpha1.CleanupRemoveWebhook:
+		if !strings.HasPrefix(spec.Webhook, admissionWebhookPrefix) {
+			return fmt.Errorf("webhook named %s... is required for %s", admissionWebhookPrefix, spec.Operation)
+		}
+	default:
+		return fmt.Errorf("unknown operation %q", spec.Operation)
+	}
+	return nil
+}
+
+// pendingCleanupOf returns the revert a task describes
+func pendingCleanupOf(spec *chaosv1alpha1.ChaosCleanupTaskSpec) chaosv1alpha1.PendingCleanup {
+	return chaosv1alpha1.PendingCleanup{
+		Operation: spec.Operation,
+		Node:      spec.Node,
+		Pod:       spec.Pod,
+		Container: spec.Container,
+		Quota:     spec.Quota,
+		Webhook:   spec.Webhook,
+	}
+}
+
+// describeCleanupTask names the revert of a task in events
+func describeCleanupTask(spec *chaosv1alpha1.ChaosCleanupTaskSpec) string {
+	switch spec.Operation {
+	case chaosv1alpha1.CleanupStopContainer:
+		return fmt.Sprintf("%s of container %s in pod %s", spec.Operation, spec.Container, spec.Pod)
+	case chaosv1alpha1.CleanupRestoreQuota:
+		return fmt.Sprintf("%s of ResourceQuota %s", spec.Operation, spec.Quota)
+	case chaosv1alpha1.CleanupRemoveWebhook:
+		return fmt.Sprintf("%s of ValidatingWebhookConfiguration %s", spec.Operation, spec.Webhook)
+	}
+	return fmt.Sprintf("%s of node %s", spec.Operation, spec.Node)
+}
+
+func (r *ChaosCleanupTaskReconciler) clock() time.Time {
+	if r.now != nil {
+		return r.now()
+	}
+	return time.Now()
+}
+
+func (r *ChaosCleanupTaskReconciler) retention() time.Duration {
+	if r.Retention > 0 {
+		return r.Retention
+	}
+	return defaultCleanupTaskRetention
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *ChaosCleanupTaskReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&chaosv1alpha1.ChaosCleanupTask{}, builder.WithPredicates(r.Shard.predicate())).
+		Named("chaoscleanuptask").
+		Complete(r)
+}
+
+// createCleanupTask hands a revert the experiment reconciler could not finish to the cleanup
+// controller
+func (r *ChaosExperimentReconciler) createCleanupTask(ctx context.Context, exp *chaosv1alpha1.ChaosExperiment, cleanup chaosv1alpha1.PendingCleanup) error {
+	task, err := r.ensureCleanupTask(ctx, exp, cleanup, false)
+	if err != nil {
+		return err
+	}
+	r.Recorder.Event(exp, corev1.EventTypeWarning, "CleanupDelegated",
+		fmt.Sprintf("%s is retried by ChaosCleanupTask %s", describeCleanupTask(&task.Spec), task.Name))
+	return nil
+}
+
+// createDeferredCleanupTask hands a revert to a cleanup task that waits for the experiment to stop
+// running. There is no event per task, large selections would flood the experiment with them.
+func (r *ChaosExperimentReconciler) createDeferredCleanupTask(ctx context.Context, exp *chaosv1alpha1.ChaosExperiment, cleanup chaosv1alpha1.PendingCleanup) error {
+	_, err := r.ensureCleanupTask(ctx, exp, cleanup, true)
+	return err
+}
+
+// ensureCleanupTask creates the cleanup task of a revert. Tasks are named after the experiment and
+// target, so handing off the same revert twice creates one task. They have no owner reference:
+// the experiment's cleanup task finalizer holds its deletion until they finished instead.
+func (r *ChaosExperimentReconciler) ensureCleanupTask(ctx context.Context, exp *chaosv1alpha1.ChaosExperiment, cleanup chaosv1alpha1.PendingCleanup, afterExperiment bool) (*chaosv1alpha1.ChaosCleanupTask, error) {
+	task := &chaosv1alpha1.ChaosCleanupTask{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      cleanupTaskName(exp.Name, cleanup),
+			Namespace: exp.Namespace,
+			Labels:    experimentLabels(exp),
+		},
+		Spec: chaosv1alpha1.ChaosCleanupTaskSpec{
+			Experiment:      exp.Name,
+			Operation:       cleanup.Operation,
+			Node:            cleanup.Node,
+			Pod:             cleanup.Pod,
+			Container:       cleanup.Container,
+			Quota:           cleanup.Quota,
+			Webhook:         cleanup.Webhook,
+			AfterExperiment: afterExperiment,
+		},
+	}
+	if cleanup.Operation == chaosv1alpha1.CleanupUntaint {
+		task.Spec.TaintKey = exp.Spec.TaintKey
+		task.Spec.TaintEffect = exp.Spec.TaintEffect
+	}
+	if err := r.addCleanupTaskFinalizer(ctx, exp); err != nil {
+		return nil, err
+	}
+	if err := r.Create(ctx, task); err != nil && !apierrors.IsAlreadyExists(err) {
+		return nil, fmt.Errorf("failed to create cleanup task %s: %w", task.Name, err)
+	}
+	return task, nil
+}
+
+// delegateCleanup creates a cleanup task for a revert that just failed; the revert is only
+// logged as lost when the task cannot be created either
+func (r *ChaosExperimentReconciler) delegateCleanup(ctx context.Context, exp *chaosv1alpha1.ChaosExperiment, cleanup chaosv1alpha1.PendingCleanup) {
+	if err := r.createCleanupTask(ctx, exp, cleanup); err != nil {
+		ctrl.LoggerFrom(ctx).Error(err, "Failed to hand off revert to a cleanup task",
+			"operation", cleanup.Operation, "node", cleanup.Node, "pod", cleanup.Pod, "quota", cleanup.Quota, "webhook", cleanup.Webhook)
+	}
+}
+
+// cleanupTaskName derives a stable task name from the experiment and the reverted target
+func cleanupTaskName(experiment string, cleanup chaosv1alpha1.PendingCleanup) string {
+	h := fnv.New32a()
+	target := cleanup.Node + "/" + cleanup.Pod + "/" + cleanup.Container
+	if cleanup.Quota != "" {
+		target += "/" + cleanup.Quota
+	}
+	if cleanup.Webhook != "" {
+		target += "/" + cleanup.Webhook
+	}
+	_, _ = h.Write([]byte(target))
+	if len(experiment) > 200 {
+		experiment = experiment[:200]
+	}
+	return fmt.Sprintf("%s-%s-%08x", experiment, strings.ToLower(cleanup.Operation), h.Sum32())
+}
@@ -0,0 +1,196 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	chaosv1alpha1 "github.com/neogan74/k8s-chaos/api/v1alpha1"
+)
+
+func newCleanupTaskReconciler(t *testing.T, now time.Time, objs ...client.Object) *ChaosCleanupTaskReconciler {
+	t.Helper()
+	experiments := newReconcilerWithObjects(t, objs...)
+	return &ChaosCleanupTaskReconciler{
+		Client:      experiments.Client,
+		Scheme:      experiments.Scheme,
+		Recorder:    experiments.Recorder,
+		Experiments: experiments,
+		now:         func() time.Time { return now },
+	}
+}
+
+func reconcileCleanupTask(t *testing.T, r *ChaosCleanupTaskReconciler, task *chaosv1alpha1.ChaosCleanupTask) (ctrl.Result, *chaosv1alpha1.ChaosCleanupTask) {
+	t.Helper()
+	key := client.ObjectKeyFromObject(task)
+	result, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: key})
+	require.NoError(t, err)
+	got := &chaosv1alpha1.ChaosCleanupTask{}
+	err = r.Get(context.Background(), key, got)
+	if apierrors.IsNotFound(err) {
+		return result, nil
+	}
+	require.NoError(t, err)
+	return result, got
+}
+
+func TestCleanupTaskReconcile_Succeeds(t *testing.T) {
+	now := time.Date(2025, 6, 2, 10, 0, 0, 0, time.UTC)
+	node := &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: "worker-1"},
+		Spec:       corev1.NodeSpec{Unschedulable: true},
+	}
+	task := &chaosv1alpha1.ChaosCleanupTask{
+		ObjectMeta: metav1.ObjectMeta{Name: "drain-uncordon", Namespace: "chaos"},
+		Spec: chaosv1alpha1.ChaosCleanupTaskSpec{
+			Experiment: "drain", Operation: chaosv1alpha1.CleanupUncordon, Node: "worker-1",
+		},
+	}
+	r := newCleanupTaskReconciler(t, now, node, task)
+
+	result, got := reconcileCleanupTask(t, r, task)
+	assert.Equal(t, chaosv1alpha1.CleanupTaskSucceeded, got.Status.Phase)
+	assert.Equal(t, int32(1), got.Status.Attempts)
+	assert.Equal(t, defaultCleanupTaskRetention, result.RequeueAfter)
+
+	updated := &corev1.Node{}
+	require.NoError(t, r.Get(context.Background(), types.NamespacedName{Name: "worker-1"}, updated))
+	assert.False(t, updated.Spec.Unschedulable)
+
+	// Succeeded tasks are deleted once their retention is over
+	r.now = func() time.Time { return now.Add(defaultCleanupTaskRetention) }
+	_, got = reconcileCleanupTask(t, r, task)
+	assert.Nil(t, got)
+}
+
+func TestCleanupTaskReconcile_WaitsForExperiment(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2025, 6, 2, 10, 0, 0, 0, time.UTC)
+	exp := &chaosv1alpha1.ChaosExperiment{
+		ObjectMeta: metav1.ObjectMeta{Name: "cpu-stress", Namespace: "chaos", UID: "exp-uid"},
+		Spec:       chaosv1alpha1.ChaosExperimentSpec{Action: "pod-cpu-stress", Namespace: "apps"},
+		Status:     chaosv1alpha1.ChaosExperimentStatus{Phase: phaseRunning},
+	}
+	task := &chaosv1alpha1.ChaosCleanupTask{
+		ObjectMeta: metav1.ObjectMeta{Name: "cpu-stress-stopcontainer", Namespace: "chaos",
+			Labels: map[string]string{chaosv1alpha1.ExperimentUIDLabel: "exp-uid"}},
+		Spec: chaosv1alpha1.ChaosCleanupTaskSpec{
+			Experiment: "cpu-stress", Operation: chaosv1alpha1.CleanupStopContainer,
+			Pod: "apps/web-0", Container: "chaos-cpu-1", AfterExperiment: true,
+		},
+	}
+	r := newCleanupTaskReconciler(t, now, exp, task)
+
+	// The container keeps stressing while the experiment runs; waiting costs no attempt
+	result, got := reconcileCleanupTask(t, r, task)
+	assert.Equal(t, cleanupTaskWaitInterval, result.RequeueAfter)
+	assert.Empty(t, got.Status.Phase)
+	assert.Zero(t, got.Status.Attempts)
+
+	exp.Status.Phase = "Completed"
+	require.NoError(t, r.Status().Update(ctx, exp))
+	_, got = reconcileCleanupTask(t, r, task)
+	assert.Equal(t, chaosv1alpha1.CleanupTaskSucceeded, got.Status.Phase)
+	assert.Equal(t, int32(1), got.Status.Attempts)
+}
+
+func TestCleanupTaskReconcile_BacksOffAndGivesUp(t *testing.T) {
+	now := time.Date(2025, 6, 2, 10, 0, 0, 0, time.UTC)
+	// Without a clientset the running container cannot be signalled, so every attempt fails
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "web-0", Namespace: "apps"},
+		Status: corev1.PodStatus{EphemeralContainerStatuses: []corev1.ContainerStatus{{
+			Name:  "chaos-network-loss-1",
+			State: corev1.ContainerState{Running: &corev1.ContainerStateRunning{}},
+		}}},
+	}
+	task := &chaosv1alpha1.ChaosCleanupTask{
+		ObjectMeta: metav1.ObjectMeta{Name: "loss-stop", Namespace: "chaos"},
+		Spec: chaosv1alpha1.ChaosCleanupTaskSpec{
+			Experiment: "loss", Operation: chaosv1alpha1.CleanupStopContainer,
+			Pod: "apps/web-0", Container: "chaos-network-loss-1", MaxAttempts: 2,
+		},
+	}
+	r := newCleanupTaskReconciler(t, now, pod, task)
+
+	result, got := reconcileCleanupTask(t, r, task)
+	assert.Equal(t, chaosv1alpha1.CleanupTaskPending, got.Status.Phase)
+	assert.Equal(t, int32(1), got.Status.Attempts)
+	assert.Contains(t, got.Status.LastError, "no clientset")
+	assert.Equal(t, cleanupTaskBaseBackoff, result.RequeueAfter)
+
+	// Nothing is attempted before the backoff has passed
+	result, got = reconcileCleanupTask(t, r, task)
+	assert.Equal(t, int32(1), got.Status.Attempts)
+	assert.Equal(t, cleanupTaskBaseBackoff, result.RequeueAfter)
+
+	r.now = func() time.Time { return now.Add(cleanupTaskBaseBackoff) }
+	_, got = reconcileCleanupTask(t, r, task)
+	assert.Equal(t, chaosv1alpha1.CleanupTaskFailed, got.Status.Phase)
+	assert.Equal(t, int32(2), got.Status.Attempts)
+	assert.Nil(t, got.Status.NextAttemptTime)
+	require.NotNil(t, got.Status.CompletionTime)
+}
+
+func TestCleanupTaskReconcile_FailsInvalidTask(t *testing.T) {
+	task := &chaosv1alpha1.ChaosCleanupTask{
+		ObjectMeta: metav1.ObjectMeta{Name: "taint-untaint", Namespace: "chaos"},
+		Spec: chaosv1alpha1.ChaosCleanupTaskSpec{
+			Experiment: "taint", Operation: chaosv1alpha1.CleanupUntaint, Node: "worker-1", MaxAttempts: 1,
+		},
+	}
+	r := newCleanupTaskReconciler(t, time.Now(), task)
+
+	_, got := reconcileCleanupTask(t, r, task)
+	assert.Equal(t, chaosv1alpha1.CleanupTaskFailed, got.Status.Phase)
+	assert.Contains(t, got.Status.LastError, "taintKey")
+}
+
+func TestCleanupTaskBackoff(t *testing.T) {
+	assert.Equal(t, 5*time.Second, cleanupTaskBackoff(1))
+	assert.Equal(t, 10*time.Second, cleanupTaskBackoff(2))
+	assert.Equal(t, 40*time.Second, cleanupTaskBackoff(4))
+	assert.Equal(t, cleanupTaskMaxBackoff, cleanupTaskBackoff(20))
+}
+
+func TestCreateCleanupTask_Idempotent(t *testing.T) {
+	ctx := context.Background()
+	exp := handoffTestExperiment("node-taint")
+	r := newReconcilerWithObjects(t, exp)
+	cleanup := chaosv1alpha1.PendingCleanup{Operation: chaosv1alpha1.CleanupUntaint, Node: "worker-1"}
+
+	require.NoError(t, r.createCleanupTask(ctx, exp, cleanup))
+	require.NoError(t, r.createCleanupTask(ctx, exp, cleanup))
+
+	tasks := &chaosv1alpha1.ChaosCleanupTaskList{}
+	require.NoError(t, r.List(ctx, tasks))
+	require.Len(t, tasks.Items, 1)
+	assert.Equal(t, "chaos", tasks.Items[0].Spec.TaintKey)
+	assert.Equal(t, string(corev1.TaintEffectNoSchedule), tasks.Items[0].Spec.TaintEffect)
+	assert.Equal(t, exp.Name, tasks.Items[0].Labels[chaosv1alpha1.ExperimentLabel])
+}
@@ -0,0 +1,94 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+
+	chaosv1alpha1 "github.com/neogan74/k8s-chaos/api/v1alpha1"
+)
+
+// cpuStressPlan is how a pod is stressed: Workers processes, each busy Load percent of the time
+type cpuStressPlan struct {
+	Workers int
+	Load    int
+	// LimitMillis is the pod's CPU limit the plan was fitted to, 0 when the pod has none
+	LimitMillis int64
+}
+
+// podCPULimit returns the CPU limit of the pod's cgroup in millicores: the pod-level limit, or the
+// sum of the container limits when every container has one. The kubelet enforces it as cpu.max
+// on cgroup v2 nodes and as the CFS quota on cgroup v1, and ephemeral containers run inside that
+// cgroup, so it caps the stressor whatever the node size.
+func podCPULimit(pod *corev1.Pod) (int64, bool) {
+	if pod.Spec.Resources != nil {
+		if limit, ok := pod.Spec.Resources.Limits[corev1.ResourceCPU]; ok {
+			return limit.MilliValue(), true
+		}
+	}
+	var total int64
+	for _, c := range pod.Spec.Containers {
+		limit, ok := c.Resources.Limits[corev1.ResourceCPU]
+		if !ok {
+			return 0, false
+		}
+		total += limit.MilliValue()
+	}
+	return total, len(pod.Spec.Containers) > 0
+}
+
+// planCPUStress fits the stress of spec to the CPU limit of pod. With relativeLoad the stress is
+// that share of the limit. Otherwise cpuWorkers at cpuLoad would be throttled by a smaller limit,
+// so the same load is applied to the CPUs the limit allows instead. The total is then spread
+// over as few workers as possible, none asked for more than a full CPU.
+func planCPUStress(spec *chaosv1alpha1.ChaosExperimentSpec, pod *corev1.Pod) (cpuStressPlan, error) {
+	limit, hasLimit := podCPULimit(pod)
+
+	var total int64
+	if spec.RelativeLoad != "" {
+		share, err := chaosv1alpha1.ParseRelativeLoad(spec.RelativeLoad)
+		if err != nil {
+			return cpuStressPlan{}, err
+		}
+		if !hasLimit || limit == 0 {
+			return cpuStressPlan{}, fmt.Errorf("pod %s/%s has no CPU limit; relativeLoad needs one to be relative to",
+				pod.Namespace, pod.Name)
+		}
+		total = limit * int64(share) / 100
+	} else {
+		workers := max(spec.CPUWorkers, 1)
+		if !hasLimit || limit == 0 {
+			return cpuStressPlan{Workers: workers, Load: spec.CPULoad}, nil
+		}
+		total = min(int64(workers)*1000, limit) * int64(spec.CPULoad) / 100
+	}
+
+	total = max(total, 10)
+	workers := (total + 999) / 1000
+	load := (total + workers*5) / (workers * 10)
+	return cpuStressPlan{Workers: int(workers), Load: int(min(max(load, 1), 100)), LimitMillis: limit}, nil
+}
+
+// cpuLoadLabel describes the load of spec for messages, such as "80%" or "50% of the CPU limit"
+func cpuLoadLabel(spec *chaosv1alpha1.ChaosExperimentSpec) string {
+	if spec.RelativeLoad != "" {
+		return spec.RelativeLoad + " of the CPU limit"
+	}
+	return fmt.Sprintf("%d%%", spec.CPULoad)
+}
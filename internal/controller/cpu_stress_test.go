@@ -0,0 +1,116 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	chaosv1alpha1 "github.com/neogan74/k8s-chaos/api/v1alpha1"
+)
+
+// cpuLimitedPod returns a pod with one container per CPU limit; an empty limit leaves it unset
+func cpuLimitedPod(limits ...string) *corev1.Pod {
+	pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default"}}
+	for _, limit := range limits {
+		c := corev1.Container{Name: "c" + limit}
+		if limit != "" {
+			c.Resources.Limits = corev1.ResourceList{corev1.ResourceCPU: resource.MustParse(limit)}
+		}
+		pod.Spec.Containers = append(pod.Spec.Containers, c)
+	}
+	return pod
+}
+
+func TestPodCPULimit(t *testing.T) {
+	limit, ok := podCPULimit(cpuLimitedPod("500m", "1500m"))
+	assert.True(t, ok)
+	assert.Equal(t, int64(2000), limit)
+
+	// One unlimited container leaves the pod cgroup unlimited
+	_, ok = podCPULimit(cpuLimitedPod("500m", ""))
+	assert.False(t, ok)
+
+	pod := cpuLimitedPod("")
+	pod.Spec.Resources = &corev1.ResourceRequirements{Limits: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("3")}}
+	limit, ok = podCPULimit(pod)
+	assert.True(t, ok)
+	assert.Equal(t, int64(3000), limit)
+}
+
+func TestPlanCPUStress(t *testing.T) {
+	tests := []struct {
+		name    string
+		spec    chaosv1alpha1.ChaosExperimentSpec
+		pod     *corev1.Pod
+		want    cpuStressPlan
+		wantErr bool
+	}{
+		{
+			name: "workers above the limit are folded into it",
+			spec: chaosv1alpha1.ChaosExperimentSpec{CPULoad: 80, CPUWorkers: 2},
+			pod:  cpuLimitedPod("500m"),
+			want: cpuStressPlan{Workers: 1, Load: 40, LimitMillis: 500},
+		},
+		{
+			name: "stress within the limit is packed onto busy workers",
+			spec: chaosv1alpha1.ChaosExperimentSpec{CPULoad: 50, CPUWorkers: 2},
+			pod:  cpuLimitedPod("4"),
+			want: cpuStressPlan{Workers: 1, Load: 100, LimitMillis: 4000},
+		},
+		{
+			name: "no limit passes the spec through",
+			spec: chaosv1alpha1.ChaosExperimentSpec{CPULoad: 80, CPUWorkers: 4},
+			pod:  cpuLimitedPod(""),
+			want: cpuStressPlan{Workers: 4, Load: 80},
+		},
+		{
+			name: "relative load of a multi-core limit",
+			spec: chaosv1alpha1.ChaosExperimentSpec{RelativeLoad: "50%"},
+			pod:  cpuLimitedPod("3"),
+			want: cpuStressPlan{Workers: 2, Load: 75, LimitMillis: 3000},
+		},
+		{
+			name: "relative load of a fractional limit",
+			spec: chaosv1alpha1.ChaosExperimentSpec{RelativeLoad: "100%"},
+			pod:  cpuLimitedPod("250m"),
+			want: cpuStressPlan{Workers: 1, Load: 25, LimitMillis: 250},
+		},
+		{
+			name:    "relative load needs a limit",
+			spec:    chaosv1alpha1.ChaosExperimentSpec{RelativeLoad: "50%"},
+			pod:     cpuLimitedPod(""),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plan, err := planCPUStress(&tt.spec, tt.pod)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, plan)
+		})
+	}
+}
@@ -0,0 +1,276 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	chaosv1alpha1 "github.com/neogan74/k8s-chaos/api/v1alpha1"
+)
+
+// diskFillFinalizer keeps a pod-disk-fill experiment until its fillers are stopped, so deleting
+// the experiment also removes the fill files
+const diskFillFinalizer = "chaos.gushchin.dev/disk-fill-cleanup"
+
+// diskFillFile is the name of the fill file in the target directory
+const diskFillFile = "chaos-disk-fill.img"
+
+// diskFillReserve is the free space a filler leaves on the filesystem
+type diskFillReserve struct {
+	KB         int64
+	Percentage int
+}
+
+// diskFillReserveFor reads the reserve from the spec; reserveBytes was validated at admission
+func diskFillReserveFor(spec *chaosv1alpha1.ChaosExperimentSpec) (diskFillReserve, error) {
+	reserve := diskFillReserve{Percentage: spec.ReservePercentage}
+	if spec.ReserveBytes != "" {
+		q, err := resource.ParseQuantity(spec.ReserveBytes)
+		if err != nil {
+			return reserve, fmt.Errorf("invalid reserveBytes %q: %w", spec.ReserveBytes, err)
+		}
+		reserve.KB = (q.Value() + 1023) / 1024
+	}
+	return reserve, nil
+}
+
+// diskFillScript returns the shell script of the filler container. It grows the fill file in
+// chunks, re-reading the filesystem usage before each one, until fillPercentage or the reserve is
+// reached. While holding it keeps checking and shrinks the file when the reserve is crossed. The
+// file is removed on exit, including when the controller stops the filler with SIGTERM; a file
+// left by an interrupted run is removed before filling. The script fails early when the target is
+// not writable.
+func diskFillScript(targetPath string, fillPercentage int, reserve diskFillReserve, durationSeconds int) string {
+	return fmt.Sprintf(`TARGET=%q
+FILE="$TARGET/%s"
+PERCENT=%d
+RESERVE_KB=%d
+RESERVE_PERCENT=%d
+DURATION=%d
+
+trap 'rm -f "$FILE"' EXIT
+trap 'exit 0' TERM INT
+
+mkdir -p "$TARGET" || exit 1
+rm -f "$FILE"
+if ! : > "$FILE"; then
+  echo "$TARGET is not writable" >&2
+  exit 1
+fi
+
+# headroom prints how many KB the fill file may still grow; negative when it must shrink
+headroom() {
+  set -- $(df -Pk "$TARGET" | tail -1)
+  total=$2 used=$3 avail=$4
+  if [ -z "$avail" ]; then
+    echo "failed to read disk usage" >&2
+    exit 1
+  fi
+  room=$((total * PERCENT / 100 - used))
+  by_reserve=$((avail - RESERVE_KB))
+  [ "$by_reserve" -lt "$room" ] && room=$by_reserve
+  by_reserve=$((avail - total * RESERVE_PERCENT / 100))
+  [ "$by_reserve" -lt "$room" ] && room=$by_reserve
+  echo "$room"
+}
+
+file_kb() {
+  echo $(($(wc -c < "$FILE") / 1024))
+}
+
+end=$(($(date +%%s) + DURATION))
+while [ "$(date +%%s)" -lt "$end" ]; do
+  room=$(headroom) || exit 1
+  if [ "$room" -lt 0 ] && [ -f "$FILE" ]; then
+    size=$(($(file_kb) + room))
+    [ "$size" -lt 0 ] && size=0
+    truncate -s $((size * 1024)) "$FILE"
+  elif [ "$room" -ge 64 ]; then
+    blocks=$((room / 64))
+    [ "$blocks" -gt 1024 ] && blocks=1024
+    dd if=/dev/zero bs=64k count="$blocks" 2>/dev/null >> "$FILE" && continue
+  fi
+  sleep 5 &
+  wait $!
+done
+`, targetPath, diskFillFile, fillPercentage, reserve.KB, reserve.Percentage, durationSeconds)
+}
+
+// diskFillTarget is where a filler writes: a path in its own filesystem, or a pod volume mounted
+// into the filler at the path an application container uses
+type diskFillTarget struct {
+	Path  string
+	Mount *corev1.VolumeMount
+}
+
+// resolveDiskFillTarget finds where to fill in pod. With volumeName it looks for a mount of that
+// volume in any container and fails when the volume cannot be filled as a whole: when it is
+// read-only, or only mounted through subPath, which an ephemeral container cannot mount.
+func resolveDiskFillTarget(pod *corev1.Pod, volumeName, targetPath string) (diskFillTarget, error) {
+	if volumeName == "" {
+		if targetPath == "" {
+			return diskFillTarget{}, fmt.Errorf("target path is empty")
+		}
+		return diskFillTarget{Path: targetPath}, nil
+	}
+
+	var volume *corev1.Volume
+	for i := range pod.Spec.Volumes {
+		if pod.Spec.Volumes[i].Name == volumeName {
+			volume = &pod.Spec.Volumes[i]
+			break
+		}
+	}
+	if volume == nil {
+		return diskFillTarget{}, fmt.Errorf("volume %q not found in pod %s/%s", volumeName, pod.Namespace, pod.Name)
+	}
+	if readOnlyVolume(volume) {
+		return diskFillTarget{}, fmt.Errorf("volume %q in pod %s/%s is read-only", volumeName, pod.Namespace, pod.Name)
+	}
+
+	containers := make([]corev1.Container, 0, len(pod.Spec.Containers)+len(pod.Spec.InitContainers))
+	containers = append(containers, pod.Spec.Containers...)
+	containers = append(containers, pod.Spec.InitContainers...)
+	var readOnly, subPath []string
+	for _, container := range containers {
+		for _, mount := range container.VolumeMounts {
+			switch {
+			case mount.Name != volumeName:
+			case mount.SubPath != "" || mount.SubPathExpr != "":
+				subPath = append(subPath, container.Name)
+			case mount.ReadOnly:
+				readOnly = append(readOnly, container.Name)
+			default:
+				return diskFillTarget{
+					Path:  mount.MountPath,
+					Mount: &corev1.VolumeMount{Name: volumeName, MountPath: mount.MountPath},
+				}, nil
+			}
+		}
+	}
+
+	switch {
+	case len(subPath) > 0:
+		return diskFillTarget{}, fmt.Errorf("volume %q in pod %s/%s is only mounted with subPath (containers %s), "+
+			"which a filler cannot mount", volumeName, pod.Namespace, pod.Name, strings.Join(subPath, ", "))
+	case len(readOnly) > 0:
+		return diskFillTarget{}, fmt.Errorf("volume %q in pod %s/%s is mounted read-only (containers %s)",
+			volumeName, pod.Namespace, pod.Name, strings.Join(readOnly, ", "))
+	default:
+		return diskFillTarget{}, fmt.Errorf("volume %q is not mounted by any container of pod %s/%s",
+			volumeName, pod.Namespace, pod.Name)
+	}
+}
+
+// readOnlyVolume reports volume sources that can never be written to
+func readOnlyVolume(volume *corev1.Volume) bool {
+	source := volume.VolumeSource
+	switch {
+	case source.ConfigMap != nil, source.Secret != nil, source.DownwardAPI != nil, source.Projected != nil:
+		return true
+	case source.PersistentVolumeClaim != nil:
+		return source.PersistentVolumeClaim.ReadOnly
+	case source.CSI != nil:
+		return source.CSI.ReadOnly != nil && *source.CSI.ReadOnly
+	}
+	return false
+}
+
+// stopDiskFill signals a running filler container, whose shell removes the fill file and exits
+func (r *ChaosExperimentReconciler) stopDiskFill(ctx context.Context, pod *corev1.Pod, containerName string) error {
+	return r.signalEphemeralContainer(ctx, pod, containerName, "disk filler")
+}
+
+// stopEphemeralContainer signals an injected container to revert its fault and exit early; the
+// netem scripts drop their qdiscs and the stressors stop on SIGTERM
+func (r *ChaosExperimentReconciler) stopEphemeralContainer(ctx context.Context, pod *corev1.Pod, containerName string) error {
+	return r.signalEphemeralContainer(ctx, pod, containerName, "container "+containerName)
+}
+
+// signalEphemeralContainer sends SIGTERM to PID 1 of an ephemeral container; what names it in errors
+func (r *ChaosExperimentReconciler) signalEphemeralContainer(ctx context.Context, pod *corev1.Pod, containerName, what string) error {
+	if r.Clientset == nil {
+		return fmt.Errorf("no clientset to exec into pod %s/%s", pod.Namespace, pod.Name)
+	}
+	// The injected shell or stressor is PID 1 of the ephemeral container and handles SIGTERM
+	_, stderr, err := r.execInPod(ctx, pod.Namespace, pod.Name, containerName, []string{"kill", "-TERM", "1"})
+	if err != nil {
+		return fmt.Errorf("failed to stop %s: %w (stderr: %s)", what, err, r.podRedactor(pod).Scrub(stderr))
+	}
+	return nil
+}
+
+// stopDiskFillers stops the fillers still running in the affected pods of an experiment
+func (r *ChaosExperimentReconciler) stopDiskFillers(ctx context.Context, exp *chaosv1alpha1.ChaosExperiment) error {
+	for _, ref := range exp.Status.AffectedPods {
+		key, containerName, ok := parseAffectedPodRef(ref)
+		if !ok {
+			continue
+		}
+		pod := &corev1.Pod{}
+		if err := r.Get(ctx, key, pod); err != nil {
+			if client.IgnoreNotFound(err) != nil {
+				return err
+			}
+			continue
+		}
+		if !isEphemeralContainerRunning(pod, containerName) {
+			continue
+		}
+		if err := r.stopDiskFill(ctx, pod, containerName); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// addDiskFillFinalizer adds the finalizer before the first filler is injected
+func (r *ChaosExperimentReconciler) addDiskFillFinalizer(ctx context.Context, exp *chaosv1alpha1.ChaosExperiment) error {
+	if !controllerutil.AddFinalizer(exp, diskFillFinalizer) {
+		return nil
+	}
+	return r.Update(ctx, exp)
+}
+
+// reconcileDiskFillFinalizer stops the fillers of a deleted experiment and releases the finalizer
+// once no filler is left. It reports whether the reconcile is done.
+func (r *ChaosExperimentReconciler) reconcileDiskFillFinalizer(ctx context.Context, exp *chaosv1alpha1.ChaosExperiment) (bool, error) {
+	if !controllerutil.ContainsFinalizer(exp, diskFillFinalizer) {
+		return false, nil
+	}
+
+	if !exp.DeletionTimestamp.IsZero() {
+		ctrl.LoggerFrom(ctx).Info("Stopping disk fillers of deleted experiment", "affectedPods", len(exp.Status.AffectedPods))
+		// Keep the finalizer and retry until every filler removed its file
+		if err := r.stopDiskFillers(ctx, exp); err != nil {
+			return true, err
+		}
+	} else if exp.Status.Phase == phaseRunning || len(exp.Status.AffectedPods) > 0 {
+		return false, nil
+	}
+
+	controllerutil.RemoveFinalizer(exp, diskFillFinalizer)
+	return true, client.IgnoreNotFound(r.Update(ctx, exp))
+}
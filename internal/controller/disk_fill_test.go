@@ -0,0 +1,293 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	chaosv1alpha1 "github.com/neogan74/k8s-chaos/api/v1alpha1"
+)
+
+func TestDiskFillReserveFor(t *testing.T) {
+	reserve, err := diskFillReserveFor(&chaosv1alpha1.ChaosExperimentSpec{ReserveBytes: "512Mi", ReservePercentage: 10})
+	require.NoError(t, err)
+	assert.Equal(t, diskFillReserve{KB: 512 * 1024, Percentage: 10}, reserve)
+
+	// Partial kilobytes round up so the floor is never undercut
+	reserve, err = diskFillReserveFor(&chaosv1alpha1.ChaosExperimentSpec{ReserveBytes: "1500"})
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), reserve.KB)
+
+	_, err = diskFillReserveFor(&chaosv1alpha1.ChaosExperimentSpec{ReserveBytes: "lots"})
+	assert.Error(t, err)
+}
+
+// runDiskFillScript starts the filler script on a temporary directory with a reserve larger than
+// any disk, so it never writes
+func runDiskFillScript(t *testing.T, durationSeconds int) (*exec.Cmd, string) {
+	t.Helper()
+	if _, err := exec.LookPath("sh"); err != nil {
+		t.Skip("no shell available")
+	}
+	dir := t.TempDir()
+	leftover := filepath.Join(dir, diskFillFile)
+	require.NoError(t, os.WriteFile(leftover, []byte("left by an interrupted run"), 0o600))
+
+	cmd := exec.Command("sh", "-c", diskFillScript(dir, 80, diskFillReserve{KB: 1 << 50}, durationSeconds))
+	require.NoError(t, cmd.Start())
+	return cmd, leftover
+}
+
+func TestDiskFillScript_RemovesLeftoverFile(t *testing.T) {
+	cmd, leftover := runDiskFillScript(t, 0)
+
+	require.NoError(t, cmd.Wait())
+	assert.NoFileExists(t, leftover)
+}
+
+func TestDiskFillScript_StopsOnSIGTERM(t *testing.T) {
+	cmd, leftover := runDiskFillScript(t, 600)
+	// The leftover is replaced by the empty file of the writability check
+	require.Eventually(t, func() bool {
+		info, err := os.Stat(leftover)
+		return err == nil && info.Size() == 0
+	}, 5*time.Second, 20*time.Millisecond)
+
+	require.NoError(t, cmd.Process.Signal(syscall.SIGTERM))
+	done := make(chan error, 1)
+	go func() { done <- cmd.Wait() }()
+	select {
+	case err := <-done:
+		assert.NoError(t, err)
+	case <-time.After(3 * time.Second):
+		_ = cmd.Process.Kill()
+		t.Fatal("filler did not stop on SIGTERM")
+	}
+	assert.NoFileExists(t, leftover)
+}
+
+func TestDiskFillScript_FailsOnReadOnlyTarget(t *testing.T) {
+	if _, err := exec.LookPath("sh"); err != nil {
+		t.Skip("no shell available")
+	}
+	if os.Geteuid() == 0 {
+		t.Skip("root can write to read-only directories")
+	}
+	dir := t.TempDir()
+	require.NoError(t, os.Chmod(dir, 0o500))
+	t.Cleanup(func() { _ = os.Chmod(dir, 0o700) })
+
+	out, err := exec.Command("sh", "-c", diskFillScript(dir, 80, diskFillReserve{}, 60)).CombinedOutput()
+	assert.Error(t, err)
+	assert.Contains(t, string(out), "is not writable")
+}
+
+func diskFillExperiment(phase string, affected ...string) *chaosv1alpha1.ChaosExperiment {
+	return &chaosv1alpha1.ChaosExperiment{
+		ObjectMeta: metav1.ObjectMeta{Name: "fill", Namespace: "default", Finalizers: []string{diskFillFinalizer}},
+		Spec:       chaosv1alpha1.ChaosExperimentSpec{Action: "pod-disk-fill", Namespace: "default"},
+		Status:     chaosv1alpha1.ChaosExperimentStatus{Phase: phase, AffectedPods: affected},
+	}
+}
+
+func TestReconcileDiskFillFinalizer(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("kept while running", func(t *testing.T) {
+		exp := diskFillExperiment(phaseRunning, "default/web:disk-fill-1")
+		r := newReconcilerWithObjects(t, exp)
+
+		done, err := r.reconcileDiskFillFinalizer(ctx, exp)
+		require.NoError(t, err)
+		assert.False(t, done)
+		assert.Contains(t, fetchExperiment(t, r, "fill", "default").Finalizers, diskFillFinalizer)
+	})
+
+	t.Run("released after completion", func(t *testing.T) {
+		exp := diskFillExperiment(phaseCompleted)
+		r := newReconcilerWithObjects(t, exp)
+
+		done, err := r.reconcileDiskFillFinalizer(ctx, exp)
+		require.NoError(t, err)
+		assert.True(t, done)
+		assert.NotContains(t, fetchExperiment(t, r, "fill", "default").Finalizers, diskFillFinalizer)
+	})
+
+	t.Run("deleted with exited filler", func(t *testing.T) {
+		exp := diskFillExperiment(phaseRunning, "default/web:disk-fill-1", "default/gone:disk-fill-1")
+		exp.DeletionTimestamp = ptrNow()
+		pod := &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default"},
+			Status: corev1.PodStatus{EphemeralContainerStatuses: []corev1.ContainerStatus{{
+				Name:  "disk-fill-1",
+				State: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{}},
+			}}},
+		}
+		r := newReconcilerWithObjects(t, exp, pod)
+
+		done, err := r.reconcileDiskFillFinalizer(ctx, exp)
+		require.NoError(t, err)
+		assert.True(t, done)
+		err = r.Get(ctx, clientKey(exp), &chaosv1alpha1.ChaosExperiment{})
+		assert.True(t, apierrors.IsNotFound(err), "experiment should be gone, got %v", err)
+	})
+
+	t.Run("deleted with running filler that cannot be stopped", func(t *testing.T) {
+		exp := diskFillExperiment(phaseRunning, "default/web:disk-fill-1")
+		exp.DeletionTimestamp = ptrNow()
+		pod := &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default"},
+			Spec: corev1.PodSpec{EphemeralContainers: []corev1.EphemeralContainer{{
+				EphemeralContainerCommon: corev1.EphemeralContainerCommon{Name: "disk-fill-1"},
+			}}},
+			Status: corev1.PodStatus{EphemeralContainerStatuses: []corev1.ContainerStatus{{
+				Name:  "disk-fill-1",
+				State: corev1.ContainerState{Running: &corev1.ContainerStateRunning{}},
+			}}},
+		}
+		r := newReconcilerWithObjects(t, exp, pod)
+
+		done, err := r.reconcileDiskFillFinalizer(ctx, exp)
+		assert.True(t, done)
+		assert.Error(t, err)
+		assert.Contains(t, fetchExperiment(t, r, "fill", "default").Finalizers, diskFillFinalizer)
+	})
+}
+
+func ptrNow() *metav1.Time {
+	now := metav1.Now()
+	return &now
+}
+
+func diskFillVolumePod(source corev1.VolumeSource, mounts map[string]corev1.VolumeMount) *corev1.Pod {
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "db", Namespace: "fill-target", Labels: map[string]string{"app": "db"}},
+		Spec: corev1.PodSpec{
+			Volumes: []corev1.Volume{{Name: "data", VolumeSource: source}},
+			// The first container does not mount the volume
+			Containers: []corev1.Container{{Name: "proxy", Image: "envoy"}},
+		},
+	}
+	for container, mount := range mounts {
+		pod.Spec.Containers = append(pod.Spec.Containers, corev1.Container{
+			Name: container, Image: "postgres", VolumeMounts: []corev1.VolumeMount{mount},
+		})
+	}
+	return pod
+}
+
+func TestResolveDiskFillTarget(t *testing.T) {
+	pvc := corev1.VolumeSource{PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: "data-db-0"}}
+
+	t.Run("target path", func(t *testing.T) {
+		target, err := resolveDiskFillTarget(&corev1.Pod{}, "", "/var/log")
+		require.NoError(t, err)
+		assert.Equal(t, diskFillTarget{Path: "/var/log"}, target)
+	})
+
+	t.Run("volume mounted by a later container", func(t *testing.T) {
+		pod := diskFillVolumePod(pvc, map[string]corev1.VolumeMount{
+			"postgres": {Name: "data", MountPath: "/var/lib/postgresql"},
+		})
+		target, err := resolveDiskFillTarget(pod, "data", "/tmp")
+		require.NoError(t, err)
+		assert.Equal(t, "/var/lib/postgresql", target.Path)
+		assert.Equal(t, &corev1.VolumeMount{Name: "data", MountPath: "/var/lib/postgresql"}, target.Mount)
+	})
+
+	t.Run("writable mount preferred", func(t *testing.T) {
+		pod := diskFillVolumePod(pvc, map[string]corev1.VolumeMount{
+			"reader": {Name: "data", MountPath: "/ro", ReadOnly: true},
+		})
+		pod.Spec.InitContainers = []corev1.Container{{
+			Name: "restore", VolumeMounts: []corev1.VolumeMount{{Name: "data", MountPath: "/restore"}},
+		}}
+		target, err := resolveDiskFillTarget(pod, "data", "")
+		require.NoError(t, err)
+		assert.Equal(t, "/restore", target.Path)
+	})
+
+	errorCases := []struct {
+		name    string
+		source  corev1.VolumeSource
+		mounts  map[string]corev1.VolumeMount
+		volume  string
+		message string
+	}{
+		{"unknown volume", pvc, nil, "cache", `volume "cache" not found`},
+		{"not mounted", pvc, nil, "data", "not mounted by any container"},
+		{"read-only claim", corev1.VolumeSource{PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+			ClaimName: "data-db-0", ReadOnly: true,
+		}}, map[string]corev1.VolumeMount{"postgres": {Name: "data", MountPath: "/data"}}, "data", "is read-only"},
+		{"config map", corev1.VolumeSource{ConfigMap: &corev1.ConfigMapVolumeSource{}},
+			map[string]corev1.VolumeMount{"postgres": {Name: "data", MountPath: "/etc/db"}}, "data", "is read-only"},
+		{"read-only mount", pvc, map[string]corev1.VolumeMount{
+			"postgres": {Name: "data", MountPath: "/data", ReadOnly: true},
+		}, "data", "mounted read-only (containers postgres)"},
+		{"subPath mount", pvc, map[string]corev1.VolumeMount{
+			"postgres": {Name: "data", MountPath: "/data", SubPath: "pgdata"},
+		}, "data", "only mounted with subPath (containers postgres)"},
+	}
+	for _, tc := range errorCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := resolveDiskFillTarget(diskFillVolumePod(tc.source, tc.mounts), tc.volume, "/tmp")
+			assert.ErrorContains(t, err, tc.message)
+		})
+	}
+}
+
+func TestHandlePodDiskFill_ReadOnlyVolumeFails(t *testing.T) {
+	ctx := context.Background()
+	pod := diskFillVolumePod(
+		corev1.VolumeSource{PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: "data-db-0"}},
+		map[string]corev1.VolumeMount{"postgres": {Name: "data", MountPath: "/data", ReadOnly: true}},
+	)
+	exp := &chaosv1alpha1.ChaosExperiment{
+		ObjectMeta: metav1.ObjectMeta{Name: "fill-ro", Namespace: "default"},
+		Spec: chaosv1alpha1.ChaosExperimentSpec{
+			Action:         "pod-disk-fill",
+			Namespace:      "fill-target",
+			Selector:       map[string]string{"app": "db"},
+			Duration:       "1m",
+			FillPercentage: 80,
+			VolumeName:     "data",
+		},
+	}
+	ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "fill-target"}}
+	r := newReconcilerWithObjects(t, ns, pod, exp)
+
+	_, err := r.handlePodDiskFill(ctx, exp)
+	require.NoError(t, err)
+
+	refreshed := fetchExperiment(t, r, "fill-ro", "default")
+	assert.Equal(t, phasePending, refreshed.Status.Phase)
+	assert.Contains(t, refreshed.Status.LastError, "[validation]")
+	assert.Contains(t, refreshed.Status.LastError, `volume "data" in pod fill-target/db is mounted read-only`)
+	assert.Empty(t, refreshed.Status.AffectedPods)
+}
@@ -0,0 +1,132 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	chaosv1alpha1 "github.com/neogan74/k8s-chaos/api/v1alpha1"
+)
+
+// drainPollInterval is how often a bounded drain retries blocked evictions and checks the node
+const drainPollInterval = time.Second
+
+// drainEvictionGracePeriod is the grace period evicted pods get, the same drainNode deletes with
+const drainEvictionGracePeriod int64 = 30
+
+// drainNodeWithin evicts the node's pods through the Eviction API until none is left or the
+// timeout expires, retrying evictions a PodDisruptionBudget blocked. It returns the pods still on
+// the node when it gave up; none means the drain finished.
+func (r *ChaosExperimentReconciler) drainNodeWithin(ctx context.Context, node *corev1.Node, timeout time.Duration) ([]chaosv1alpha1.UnevictedPod, error) {
+	log := ctrl.LoggerFrom(ctx)
+
+	var remaining []chaosv1alpha1.UnevictedPod
+	err := wait.PollUntilContextTimeout(ctx, drainPollInterval, timeout, true, func(ctx context.Context) (bool, error) {
+		pods, err := r.listPodsOnNode(ctx, node.Name)
+		if err != nil {
+			return false, fmt.Errorf("failed to list pods on node: %w", err)
+		}
+
+		remaining = nil
+		evicted := 0
+		for i := range pods {
+			pod := &pods[i]
+			if pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed ||
+				isDaemonSetPod(pod) || isStaticPod(pod) {
+				continue
+			}
+			if pod.DeletionTimestamp != nil {
+				// Already evicted; a pod that stays here is held by its finalizers or a stuck kubelet
+				remaining = append(remaining, unevictedPod(node.Name, pod, "pod is stuck terminating"))
+				continue
+			}
+
+			if err := r.evictPod(ctx, pod); err != nil {
+				if apierrors.IsNotFound(err) {
+					continue
+				}
+				reason := err.Error()
+				if apierrors.IsTooManyRequests(err) {
+					reason = "eviction blocked by a PodDisruptionBudget"
+				}
+				remaining = append(remaining, unevictedPod(node.Name, pod, reason))
+				continue
+			}
+			log.Info("Evicted pod from node", "pod", pod.Name, "namespace", pod.Namespace, "node", node.Name)
+			evicted++
+		}
+		// Pods evicted in this pass may still be terminating; look again before declaring the node drained
+		return len(remaining) == 0 && evicted == 0, nil
+	})
+	if err != nil {
+		if wait.Interrupted(err) {
+			return remaining, nil
+		}
+		return nil, err
+	}
+	return nil, nil
+}
+
+// evictPod asks the API server to evict a pod, which fails while a PodDisruptionBudget forbids it
+func (r *ChaosExperimentReconciler) evictPod(ctx context.Context, pod *corev1.Pod) error {
+	gracePeriod := drainEvictionGracePeriod
+	eviction := &policyv1.Eviction{
+		ObjectMeta:    metav1.ObjectMeta{Name: pod.Name, Namespace: pod.Namespace},
+		DeleteOptions: &metav1.DeleteOptions{GracePeriodSeconds: &gracePeriod},
+	}
+	return r.writer(ctx).SubResource("eviction").Create(ctx, pod, eviction)
+}
+
+// unevictedPod builds the status entry for a pod a drain left behind
+func unevictedPod(node string, pod *corev1.Pod, reason string) chaosv1alpha1.UnevictedPod {
+	return chaosv1alpha1.UnevictedPod{
+		Node:   node,
+		Pod:    fmt.Sprintf("%s/%s", pod.Namespace, pod.Name),
+		Reason: reason,
+	}
+}
+
+// rollbackDrain stops using a node whose drain timed out: the node is uncordoned again when
+// the experiment cordoned it. Returns whether the node is schedulable again.
+func (r *ChaosExperimentReconciler) rollbackDrain(ctx context.Context, exp *chaosv1alpha1.ChaosExperiment, node *corev1.Node, wasAlreadyCordoned bool, unevicted []chaosv1alpha1.UnevictedPod) bool {
+	log := ctrl.LoggerFrom(ctx)
+	log.Info("Drain did not finish within drainTimeout, rolling back", "node", node.Name,
+		"drainTimeout", exp.Spec.DrainTimeout, "unevicted", len(unevicted))
+
+	r.Recorder.Eventf(exp, corev1.EventTypeWarning, "DrainRolledBack",
+		"Drain of node %s did not finish within %s: %d pod(s) could not be evicted", node.Name, exp.Spec.DrainTimeout, len(unevicted))
+
+	// A node that was cordoned before the experiment stays the way it was found
+	if wasAlreadyCordoned {
+		return false
+	}
+	if err := r.uncordonNode(ctx, node.Name); err != nil {
+		// The node stays in status.cordonedNodes and is uncordoned when the experiment ends
+		log.Error(err, "Failed to uncordon node after drain timeout", "node", node.Name)
+		return false
+	}
+	return true
+}
@@ -0,0 +1,136 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	chaosv1alpha1 "github.com/neogan74/k8s-chaos/api/v1alpha1"
+)
+
+// blockEvictions makes evictions of the named pods fail the way an exhausted PodDisruptionBudget does
+func blockEvictions(r *ChaosExperimentReconciler, names ...string) {
+	blocked := make(map[string]bool)
+	for _, name := range names {
+		blocked[name] = true
+	}
+	r.Client = interceptor.NewClient(r.Client.(client.WithWatch), interceptor.Funcs{
+		SubResourceCreate: func(ctx context.Context, c client.Client, sub string, obj client.Object, subResource client.Object, opts ...client.SubResourceCreateOption) error {
+			if sub == "eviction" && blocked[obj.GetName()] {
+				return apierrors.NewTooManyRequests("Cannot evict pod as it would violate the pod's disruption budget.", 10)
+			}
+			return c.SubResource(sub).Create(ctx, obj, subResource, opts...)
+		},
+	})
+}
+
+func TestDrainNodeWithin_EvictsPods(t *testing.T) {
+	ctx := context.Background()
+	r := newReconcilerWithObjects(t,
+		drainTestPod("team-a", "web", "worker-1"),
+		drainTestPod("team-b", "api", "worker-1"),
+		drainTestPod("team-a", "other-node", "worker-2"),
+	)
+
+	unevicted, err := r.drainNodeWithin(ctx, &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "worker-1"}}, 5*time.Second)
+	require.NoError(t, err)
+	assert.Empty(t, unevicted)
+
+	pods := &corev1.PodList{}
+	require.NoError(t, r.List(ctx, pods))
+	require.Len(t, pods.Items, 1)
+	assert.Equal(t, "other-node", pods.Items[0].Name)
+}
+
+func TestDrainNodeWithin_ReportsPodsLeftBehind(t *testing.T) {
+	ctx := context.Background()
+	stuck := drainTestPod("team-a", "stuck", "worker-1")
+	stuck.Finalizers = []string{"example.com/backup"}
+	stuck.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+	r := newReconcilerWithObjects(t,
+		drainTestPod("team-a", "web", "worker-1"),
+		drainTestPod("payments", "ledger-0", "worker-1"),
+		stuck,
+	)
+	blockEvictions(r, "ledger-0")
+
+	unevicted, err := r.drainNodeWithin(ctx, &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "worker-1"}}, time.Second)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []chaosv1alpha1.UnevictedPod{
+		{Node: "worker-1", Pod: "payments/ledger-0", Reason: "eviction blocked by a PodDisruptionBudget"},
+		{Node: "worker-1", Pod: "team-a/stuck", Reason: "pod is stuck terminating"},
+	}, unevicted)
+
+	// Pods that could be evicted are gone anyway
+	err = r.Get(ctx, types.NamespacedName{Namespace: "team-a", Name: "web"}, &corev1.Pod{})
+	assert.True(t, apierrors.IsNotFound(err))
+}
+
+func TestHandleNodeDrain_RollsBackOnDrainTimeout(t *testing.T) {
+	ctx := context.Background()
+	exp := &chaosv1alpha1.ChaosExperiment{
+		ObjectMeta: metav1.ObjectMeta{Name: "drain", Namespace: "chaos", UID: "uid-drain"},
+		Spec: chaosv1alpha1.ChaosExperimentSpec{
+			Action:       "node-drain",
+			Namespace:    "chaos",
+			Selector:     map[string]string{"pool": "spot"},
+			Count:        1,
+			DrainTimeout: "1s",
+		},
+	}
+	r := newReconcilerWithObjects(t, exp,
+		&corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "worker-1", Labels: map[string]string{"pool": "spot"}}},
+		drainTestPod("team-a", "web", "worker-1"),
+		drainTestPod("payments", "ledger-0", "worker-1"),
+	)
+	blockEvictions(r, "ledger-0")
+
+	_, err := r.handleNodeDrain(ctx, exp)
+	require.NoError(t, err)
+
+	node := &corev1.Node{}
+	require.NoError(t, r.Get(ctx, types.NamespacedName{Name: "worker-1"}, node))
+	assert.False(t, node.Spec.Unschedulable, "a drain that timed out must uncordon its node")
+
+	updated := fetchExperiment(t, r, "drain", "chaos")
+	assert.Empty(t, updated.Status.CordonedNodes)
+	assert.Equal(t, []chaosv1alpha1.UnevictedPod{
+		{Node: "worker-1", Pod: "payments/ledger-0", Reason: "eviction blocked by a PodDisruptionBudget"},
+	}, updated.Status.UnevictedPods)
+	assert.Contains(t, updated.Status.Message, "rolled back 1 node(s)")
+
+	histories := &chaosv1alpha1.ChaosExperimentHistoryList{}
+	require.NoError(t, r.List(ctx, histories))
+	require.Len(t, histories.Items, 1)
+	record := histories.Items[0].Spec
+	assert.Equal(t, statusPartial, record.Execution.Status)
+	assert.Contains(t, record.AffectedResources, chaosv1alpha1.ResourceReference{
+		Kind: "Pod", Name: "ledger-0", Namespace: "payments", Action: "not-evicted",
+		Details: "eviction blocked by a PodDisruptionBudget",
+	})
+}
@@ -18,18 +18,33 @@ package controller
 
 import (
 	"context"
+	"fmt"
 	"testing"
+	"time"
 
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	batchv1 "k8s.io/api/batch/v1"
+	coordinationv1 "k8s.io/api/coordination/v1"
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	schedulingv1 "k8s.io/api/scheduling/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	chaosv1alpha1 "github.com/neogan74/k8s-chaos/api/v1alpha1"
+	chaosmetrics "github.com/neogan74/k8s-chaos/internal/metrics"
 )
 
 // Build a reconciler with a fake client populated with the given objects.
@@ -39,11 +54,20 @@ func newReconcilerWithObjects(t *testing.T, objs ...client.Object) *ChaosExperim
 	scheme := runtime.NewScheme()
 	require.NoError(t, chaosv1alpha1.AddToScheme(scheme))
 	require.NoError(t, corev1.AddToScheme(scheme))
+	require.NoError(t, coordinationv1.AddToScheme(scheme))
+	require.NoError(t, appsv1.AddToScheme(scheme))
+	require.NoError(t, autoscalingv2.AddToScheme(scheme))
+	require.NoError(t, batchv1.AddToScheme(scheme))
+	require.NoError(t, policyv1.AddToScheme(scheme))
+	require.NoError(t, networkingv1.AddToScheme(scheme))
+	require.NoError(t, schedulingv1.AddToScheme(scheme))
+	require.NoError(t, admissionregistrationv1.AddToScheme(scheme))
 
 	cl := fake.NewClientBuilder().
 		WithScheme(scheme).
 		WithObjects(objs...).
-		WithStatusSubresource(&chaosv1alpha1.ChaosExperiment{}).
+		WithStatusSubresource(&chaosv1alpha1.ChaosExperiment{}, &chaosv1alpha1.ChaosCleanupTask{}).
+		WithIndex(&corev1.Pod{}, podNodeNameField, indexPodNodeName).
 		Build()
 
 	return &ChaosExperimentReconciler{
@@ -178,3 +202,624 @@ func TestGetEligiblePods_TerminatingPodExcluded(t *testing.T) {
 	assert.Len(t, eligible, 1, "should only include the running pod")
 	assert.Equal(t, "running-pod", eligible[0].Name, "should include only the running pod, not the terminating pod")
 }
+
+func TestGetEligiblePods_ExcludeUntil(t *testing.T) {
+	ctx := context.Background()
+	future := time.Now().Add(time.Hour).UTC().Format(time.RFC3339)
+	past := time.Now().Add(-time.Hour).UTC().Format(time.RFC3339)
+	pod := func(name, excludeUntil string) *corev1.Pod {
+		p := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "migrating",
+			Labels:    map[string]string{"app": "demo"},
+		}}
+		if excludeUntil != "" {
+			p.Annotations = map[string]string{chaosv1alpha1.ExcludeUntilAnnotation: excludeUntil}
+		}
+		return p
+	}
+	exp := &chaosv1alpha1.ChaosExperiment{
+		Spec: chaosv1alpha1.ChaosExperimentSpec{
+			Action:    "pod-kill",
+			Namespace: "migrating",
+			Selector:  map[string]string{"app": "demo"},
+		},
+	}
+
+	t.Run("pods", func(t *testing.T) {
+		r := newReconcilerWithObjects(t,
+			&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "migrating"}},
+			pod("plain", ""),
+			pod("migrating", future),
+			pod("migrated", past),
+			pod("typo", "tomorrow"),
+			pod("other", ""))
+
+		before := testutil.ToFloat64(chaosmetrics.SafetyExcludedResources.WithLabelValues("pod-kill", "migrating", exclusionPodTemporary))
+		eligible, err := r.getEligiblePods(ctx, exp)
+		require.NoError(t, err)
+		var names []string
+		for _, p := range eligible {
+			names = append(names, p.Name)
+		}
+		assert.ElementsMatch(t, []string{"plain", "migrated", "other"}, names,
+			"unexpired and unparsable exclude-until annotations exclude the pod")
+		assert.Equal(t, before+2, testutil.ToFloat64(
+			chaosmetrics.SafetyExcludedResources.WithLabelValues("pod-kill", "migrating", exclusionPodTemporary)))
+	})
+
+	t.Run("namespace", func(t *testing.T) {
+		ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{
+			Name:        "migrating",
+			Annotations: map[string]string{chaosv1alpha1.ExcludeUntilAnnotation: future},
+		}}
+		r := newReconcilerWithObjects(t, ns, pod("plain", ""))
+		eligible, err := r.getEligiblePods(ctx, exp)
+		require.NoError(t, err)
+		assert.Empty(t, eligible)
+
+		ns.Annotations[chaosv1alpha1.ExcludeUntilAnnotation] = past
+		r = newReconcilerWithObjects(t, ns, pod("plain", ""))
+		eligible, err = r.getEligiblePods(ctx, exp)
+		require.NoError(t, err)
+		assert.Len(t, eligible, 1, "an expired namespace exclusion no longer applies")
+	})
+}
+
+func TestGetEligiblePods_OwnerPatterns(t *testing.T) {
+	ctx := context.Background()
+	owned := func(name, kind, owner string, labels map[string]string) *corev1.Pod {
+		pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "data",
+			Labels:    map[string]string{"tier": "data"},
+		}}
+		for k, v := range labels {
+			pod.Labels[k] = v
+		}
+		if owner != "" {
+			pod.OwnerReferences = []metav1.OwnerReference{{
+				APIVersion: "apps/v1", Kind: kind, Name: owner, UID: types.UID(owner), Controller: ptr.To(true),
+			}}
+		}
+		return pod
+	}
+	objs := []client.Object{
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "data"}},
+		owned("kafka-broker-0", "StatefulSet", "kafka-broker", nil),
+		owned("kafka-broker-1", "StatefulSet", "kafka-broker", nil),
+		owned("postgres-0", "StatefulSet", "postgres", nil),
+		owned("postgres-replica-0", "StatefulSet", "postgres-replica", nil),
+		owned("api-7d9f8-abcde", "ReplicaSet", "api-7d9f8", map[string]string{appsv1.DefaultDeploymentUniqueLabelKey: "7d9f8"}),
+		owned("debug", "", "", nil),
+	}
+
+	tests := []struct {
+		name    string
+		exclude []string
+		include []string
+		want    []string
+	}{
+		{
+			name:    "exclude by pattern and exact name",
+			exclude: []string{"kafka-*", "postgres"},
+			want:    []string{"postgres-replica-0", "api-7d9f8-abcde", "debug"},
+		},
+		{
+			name:    "include matches Deployments by name and skips unowned pods",
+			include: []string{"api", "postgres*"},
+			want:    []string{"postgres-0", "postgres-replica-0", "api-7d9f8-abcde"},
+		},
+		{
+			name:    "exclude wins over include",
+			include: []string{"postgres*"},
+			exclude: []string{"postgres"},
+			want:    []string{"postgres-replica-0"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := newReconcilerWithObjects(t, objs...)
+			exp := &chaosv1alpha1.ChaosExperiment{
+				Spec: chaosv1alpha1.ChaosExperimentSpec{
+					Action:                   "pod-kill",
+					Namespace:                "data",
+					Selector:                 map[string]string{"tier": "data"},
+					ExcludeOwners:            tt.exclude,
+					IncludeOwners:            tt.include,
+					AllowSingletonDisruption: true,
+					IgnoreRollouts:           true,
+				},
+			}
+			eligible, err := r.getEligiblePods(ctx, exp)
+			require.NoError(t, err)
+			var names []string
+			for _, p := range eligible {
+				names = append(names, p.Name)
+			}
+			assert.ElementsMatch(t, tt.want, names)
+		})
+	}
+}
+
+func TestGetEligiblePods_JobPods(t *testing.T) {
+	ctx := context.Background()
+	pod := func(name, ownerKind, owner string) *corev1.Pod {
+		p := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "batch", Labels: map[string]string{"team": "data"}}}
+		if owner != "" {
+			p.OwnerReferences = []metav1.OwnerReference{{
+				APIVersion: "batch/v1", Kind: ownerKind, Name: owner, UID: types.UID(owner), Controller: ptr.To(true),
+			}}
+		}
+		return p
+	}
+	r := newReconcilerWithObjects(t,
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "batch"}},
+		pod("report-29312-abcde", "Job", "report-29312"),
+		pod("etl-xyz12", "Job", "etl"),
+		pod("scheduler-0", "StatefulSet", "scheduler"),
+	)
+	exp := &chaosv1alpha1.ChaosExperiment{
+		Spec: chaosv1alpha1.ChaosExperimentSpec{
+			Action:                   "pod-kill",
+			Namespace:                "batch",
+			Selector:                 map[string]string{"team": "data"},
+			AllowSingletonDisruption: true,
+			IgnoreRollouts:           true,
+		},
+	}
+
+	eligible, err := r.getEligiblePods(ctx, exp)
+	require.NoError(t, err)
+	require.Len(t, eligible, 1)
+	assert.Equal(t, "scheduler-0", eligible[0].Name)
+
+	exp.Spec.IncludeJobPods = true
+	eligible, err = r.getEligiblePods(ctx, exp)
+	require.NoError(t, err)
+	assert.Len(t, eligible, 3)
+}
+
+func TestGetEligiblePods_NodeConstraints(t *testing.T) {
+	ctx := context.Background()
+	node := func(name, capacityType, zone string) *corev1.Node {
+		return &corev1.Node{ObjectMeta: metav1.ObjectMeta{
+			Name:   name,
+			Labels: map[string]string{"karpenter.sh/capacity-type": capacityType, "topology.kubernetes.io/zone": zone},
+		}}
+	}
+	pod := func(name, nodeName string) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "shop", Labels: map[string]string{"app": "web"}},
+			Spec:       corev1.PodSpec{NodeName: nodeName},
+		}
+	}
+	objs := []client.Object{
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "shop"}},
+		node("spot-a", "spot", "eu-west-1a"),
+		node("spot-b", "spot", "eu-west-1b"),
+		node("ondemand-a", "on-demand", "eu-west-1a"),
+		pod("web-1", "spot-a"),
+		pod("web-2", "spot-b"),
+		pod("web-3", "ondemand-a"),
+		pod("web-pending", ""),
+	}
+
+	tests := []struct {
+		name     string
+		selector map[string]string
+		affinity *corev1.NodeSelector
+		want     []string
+	}{
+		{
+			name: "no constraints keeps unscheduled pods",
+			want: []string{"web-1", "web-2", "web-3", "web-pending"},
+		},
+		{
+			name:     "nodeSelector picks the spot pool",
+			selector: map[string]string{"karpenter.sh/capacity-type": "spot"},
+			want:     []string{"web-1", "web-2"},
+		},
+		{
+			name: "nodeAffinity terms are ORed",
+			affinity: &corev1.NodeSelector{NodeSelectorTerms: []corev1.NodeSelectorTerm{
+				{MatchExpressions: []corev1.NodeSelectorRequirement{
+					{Key: "karpenter.sh/capacity-type", Operator: corev1.NodeSelectorOpNotIn, Values: []string{"spot"}},
+				}},
+				{MatchFields: []corev1.NodeSelectorRequirement{
+					{Key: "metadata.name", Operator: corev1.NodeSelectorOpIn, Values: []string{"spot-b"}},
+				}},
+			}},
+			want: []string{"web-2", "web-3"},
+		},
+		{
+			name:     "nodeSelector and nodeAffinity must both match",
+			selector: map[string]string{"karpenter.sh/capacity-type": "spot"},
+			affinity: &corev1.NodeSelector{NodeSelectorTerms: []corev1.NodeSelectorTerm{
+				{MatchExpressions: []corev1.NodeSelectorRequirement{
+					{Key: "topology.kubernetes.io/zone", Operator: corev1.NodeSelectorOpIn, Values: []string{"eu-west-1a"}},
+				}},
+			}},
+			want: []string{"web-1"},
+		},
+		{
+			name:     "an empty term matches nothing",
+			affinity: &corev1.NodeSelector{NodeSelectorTerms: []corev1.NodeSelectorTerm{{}}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := newReconcilerWithObjects(t, objs...)
+			exp := &chaosv1alpha1.ChaosExperiment{
+				Spec: chaosv1alpha1.ChaosExperimentSpec{
+					Action:                   "pod-kill",
+					Namespace:                "shop",
+					Selector:                 map[string]string{"app": "web"},
+					NodeSelector:             tt.selector,
+					NodeAffinity:             tt.affinity,
+					AllowSingletonDisruption: true,
+					IgnoreRollouts:           true,
+				},
+			}
+			eligible, err := r.getEligiblePods(ctx, exp)
+			require.NoError(t, err)
+			var names []string
+			for _, p := range eligible {
+				names = append(names, p.Name)
+			}
+			assert.ElementsMatch(t, tt.want, names)
+		})
+	}
+}
+
+func TestGetEligiblePods_SingletonAndLeaderExcluded(t *testing.T) {
+	ctx := context.Background()
+	controller := true
+	readyPod := func(name string, owner types.UID) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "test-ns",
+				Labels:    map[string]string{"app": "demo"},
+				OwnerReferences: []metav1.OwnerReference{{
+					APIVersion: "apps/v1",
+					Kind:       "ReplicaSet",
+					Name:       string(owner),
+					UID:        owner,
+					Controller: &controller,
+				}},
+			},
+			Status: corev1.PodStatus{
+				Conditions: []corev1.PodCondition{{Type: corev1.PodReady, Status: corev1.ConditionTrue}},
+			},
+		}
+	}
+	holder := "replica-1_4f9c2a"
+	lease := &coordinationv1.Lease{
+		ObjectMeta: metav1.ObjectMeta{Name: "demo-leader", Namespace: "test-ns"},
+		Spec:       coordinationv1.LeaseSpec{HolderIdentity: &holder},
+	}
+	objs := []client.Object{
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "test-ns"}},
+		readyPod("singleton", "rs-single"),
+		readyPod("replica-1", "rs-multi"),
+		readyPod("replica-2", "rs-multi"),
+		lease,
+	}
+
+	t.Run("protected pods are skipped by default", func(t *testing.T) {
+		exp := &chaosv1alpha1.ChaosExperiment{
+			Spec: chaosv1alpha1.ChaosExperimentSpec{
+				Action:    "pod-kill",
+				Namespace: "test-ns",
+				Selector:  map[string]string{"app": "demo"},
+			},
+		}
+		r := newReconcilerWithObjects(t, objs...)
+
+		eligible, err := r.getEligiblePods(ctx, exp)
+		require.NoError(t, err)
+		require.Len(t, eligible, 1)
+		assert.Equal(t, "replica-2", eligible[0].Name)
+	})
+
+	t.Run("allowSingletonDisruption keeps them", func(t *testing.T) {
+		exp := &chaosv1alpha1.ChaosExperiment{
+			Spec: chaosv1alpha1.ChaosExperimentSpec{
+				Action:                   "pod-kill",
+				Namespace:                "test-ns",
+				Selector:                 map[string]string{"app": "demo"},
+				AllowSingletonDisruption: true,
+			},
+		}
+		r := newReconcilerWithObjects(t, objs...)
+
+		eligible, err := r.getEligiblePods(ctx, exp)
+		require.NoError(t, err)
+		assert.Len(t, eligible, 3)
+	})
+}
+
+func TestOrderTargetPods(t *testing.T) {
+	newPods := func(names ...string) []corev1.Pod {
+		pods := make([]corev1.Pod, 0, len(names))
+		for _, name := range names {
+			pods = append(pods, corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "test-ns"},
+			})
+		}
+		return pods
+	}
+	names := func(pods []corev1.Pod) []string {
+		out := make([]string, 0, len(pods))
+		for _, pod := range pods {
+			out = append(out, pod.Name)
+		}
+		return out
+	}
+	seed := int64(42)
+	ctx := context.Background()
+	r := newReconcilerWithObjects(t)
+
+	t.Run("seed gives the same order regardless of input order", func(t *testing.T) {
+		exp := &chaosv1alpha1.ChaosExperiment{
+			Spec: chaosv1alpha1.ChaosExperimentSpec{SelectionSeed: &seed},
+		}
+		first := newPods("a", "b", "c", "d", "e")
+		second := newPods("e", "d", "c", "b", "a")
+
+		first = r.orderTargetPods(ctx, exp, first)
+		second = r.orderTargetPods(ctx, exp, second)
+
+		assert.Equal(t, names(first), names(second))
+	})
+
+	t.Run("sticky targets are kept and recorded in status", func(t *testing.T) {
+		exp := &chaosv1alpha1.ChaosExperiment{
+			Spec: chaosv1alpha1.ChaosExperimentSpec{Count: 2, StickyTargets: true},
+			Status: chaosv1alpha1.ChaosExperimentStatus{
+				SelectedTargets: []string{"test-ns/c", "test-ns/gone"},
+			},
+		}
+		pods := r.orderTargetPods(ctx, exp, newPods("a", "b", "c", "d"))
+
+		assert.Equal(t, "c", pods[0].Name, "previously selected pod should come first")
+		assert.Len(t, exp.Status.SelectedTargets, 2)
+		assert.Equal(t, "test-ns/c", exp.Status.SelectedTargets[0])
+		assert.NotContains(t, exp.Status.SelectedTargets, "test-ns/gone")
+	})
+
+	t.Run("replay targets seed the first sticky run", func(t *testing.T) {
+		exp := &chaosv1alpha1.ChaosExperiment{
+			ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{
+				chaosv1alpha1.ReplayTargetsAnnotation: "test-ns/d, test-ns/b",
+			}},
+			Spec: chaosv1alpha1.ChaosExperimentSpec{Count: 2, StickyTargets: true},
+		}
+		r.orderTargetPods(ctx, exp, newPods("a", "b", "c", "d"))
+
+		assert.ElementsMatch(t, []string{"test-ns/b", "test-ns/d"}, exp.Status.SelectedTargets)
+
+		exp.Status.SelectedTargets = []string{"test-ns/a"}
+		pods := r.orderTargetPods(ctx, exp, newPods("a", "b", "c", "d"))
+		assert.Equal(t, "a", pods[0].Name, "recorded status should win over the annotation")
+	})
+
+	t.Run("non-sticky experiments do not record targets", func(t *testing.T) {
+		exp := &chaosv1alpha1.ChaosExperiment{
+			Spec: chaosv1alpha1.ChaosExperimentSpec{Count: 1},
+		}
+		pods := r.orderTargetPods(ctx, exp, newPods("a", "b"))
+
+		assert.Len(t, pods, 2)
+		assert.Empty(t, exp.Status.SelectedTargets)
+	})
+}
+
+func TestOrderTargetPods_Strategies(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	newPod := func(name, node string, age time.Duration) corev1.Pod {
+		return corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              name,
+				Namespace:         "test-ns",
+				CreationTimestamp: metav1.NewTime(now.Add(-age)),
+			},
+			Spec: corev1.PodSpec{NodeName: node},
+		}
+	}
+	zoneNode := func(name, zone string) *corev1.Node {
+		return &corev1.Node{ObjectMeta: metav1.ObjectMeta{
+			Name:   name,
+			Labels: map[string]string{corev1.LabelTopologyZone: zone},
+		}}
+	}
+
+	r := newReconcilerWithObjects(t,
+		zoneNode("node-a", "zone-1"), zoneNode("node-b", "zone-1"), zoneNode("node-c", "zone-2"))
+
+	tests := []struct {
+		name      string
+		strategy  string
+		wantFirst string
+		wantLen   int
+	}{
+		{name: "oldest", strategy: "oldest", wantFirst: "old", wantLen: 3},
+		{name: "newest", strategy: "newest", wantFirst: "new", wantLen: 3},
+		{name: "one per node", strategy: "one-per-node", wantLen: 2},
+		{name: "one per zone", strategy: "one-per-zone", wantLen: 2},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			exp := &chaosv1alpha1.ChaosExperiment{
+				Spec: chaosv1alpha1.ChaosExperimentSpec{SelectionStrategy: tc.strategy},
+			}
+			pods := []corev1.Pod{
+				newPod("mid", "node-a", time.Hour),
+				newPod("old", "node-a", 2*time.Hour),
+				newPod("new", "node-c", time.Minute),
+			}
+			if tc.strategy == "one-per-zone" {
+				pods[1].Spec.NodeName = "node-b"
+			}
+
+			got := r.orderTargetPods(ctx, exp, pods)
+
+			assert.Len(t, got, tc.wantLen)
+			if tc.wantFirst != "" {
+				assert.Equal(t, tc.wantFirst, got[0].Name)
+			}
+		})
+	}
+}
+
+func TestOrderTargetPods_SpreadBy(t *testing.T) {
+	ctx := context.Background()
+	zoneNode := func(name, zone string) *corev1.Node {
+		return &corev1.Node{ObjectMeta: metav1.ObjectMeta{
+			Name:   name,
+			Labels: map[string]string{corev1.LabelTopologyZone: zone},
+		}}
+	}
+	r := newReconcilerWithObjects(t,
+		zoneNode("node-a", "zone-1"), zoneNode("node-b", "zone-1"),
+		zoneNode("node-c", "zone-2"), zoneNode("node-d", "zone-3"))
+
+	newPod := func(name, node, owner string) corev1.Pod {
+		return corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "test-ns",
+				OwnerReferences: []metav1.OwnerReference{{
+					APIVersion: "apps/v1", Kind: "StatefulSet", Name: owner, UID: types.UID(owner), Controller: ptr.To(true),
+				}},
+			},
+			Spec: corev1.PodSpec{NodeName: node},
+		}
+	}
+	seed := int64(7)
+	domains := func(spreadBy string, pods []corev1.Pod) []string {
+		zones := map[string]string{"node-a": "zone-1", "node-b": "zone-1", "node-c": "zone-2", "node-d": "zone-3"}
+		var result []string
+		for _, pod := range pods {
+			switch spreadBy {
+			case "zone":
+				result = append(result, zones[pod.Spec.NodeName])
+			case "node":
+				result = append(result, pod.Spec.NodeName)
+			case "owner":
+				result = append(result, pod.OwnerReferences[0].Name)
+			}
+		}
+		return result
+	}
+
+	tests := []struct {
+		name     string
+		spreadBy string
+		mode     string
+		count    int
+		check    func(t *testing.T, targets []string, remaining int)
+	}{
+		{
+			name: "one replica per zone", spreadBy: "zone", count: 3,
+			check: func(t *testing.T, targets []string, remaining int) {
+				assert.ElementsMatch(t, []string{"zone-1", "zone-2", "zone-3"}, targets)
+				assert.Equal(t, 6, remaining)
+			},
+		},
+		{
+			name: "one replica per owner", spreadBy: "owner", count: 2,
+			check: func(t *testing.T, targets []string, remaining int) {
+				assert.ElementsMatch(t, []string{"cache", "db"}, targets)
+			},
+		},
+		{
+			name: "all replicas in a single zone", spreadBy: "zone", mode: "concentrate", count: 6,
+			check: func(t *testing.T, targets []string, remaining int) {
+				assert.NotEmpty(t, targets)
+				for _, zone := range targets {
+					assert.Equal(t, targets[0], zone)
+				}
+				assert.Equal(t, len(targets), remaining)
+			},
+		},
+		{
+			name: "a single node", spreadBy: "node", mode: "concentrate", count: 6,
+			check: func(t *testing.T, targets []string, remaining int) {
+				for _, node := range targets {
+					assert.Equal(t, targets[0], node)
+				}
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			exp := &chaosv1alpha1.ChaosExperiment{
+				Spec: chaosv1alpha1.ChaosExperimentSpec{
+					Count:         tc.count,
+					SelectionSeed: &seed,
+					SpreadBy:      tc.spreadBy,
+					SpreadMode:    tc.mode,
+				},
+			}
+			pods := []corev1.Pod{
+				newPod("db-0", "node-a", "db"),
+				newPod("db-1", "node-b", "db"),
+				newPod("db-2", "node-c", "db"),
+				newPod("cache-0", "node-a", "cache"),
+				newPod("cache-1", "node-c", "cache"),
+				newPod("cache-2", "node-d", "cache"),
+			}
+
+			got := r.orderTargetPods(ctx, exp, pods)
+			targets := got[:min(tc.count, len(got))]
+			tc.check(t, domains(tc.spreadBy, targets), len(got))
+		})
+	}
+}
+
+func TestGetEligiblePods_ListsFromAPIInPages(t *testing.T) {
+	ctx := context.Background()
+	var objs []client.Object
+	for i := range 450 {
+		podLabels := map[string]string{"app": "web"}
+		if i%3 == 0 {
+			podLabels["chaos"] = "enabled"
+		}
+		objs = append(objs, &corev1.Pod{ObjectMeta: metav1.ObjectMeta{
+			Name: fmt.Sprintf("web-%d", i), Namespace: "shop", Labels: podLabels,
+		}})
+	}
+	objs = append(objs, &corev1.Pod{ObjectMeta: metav1.ObjectMeta{
+		Name: "api-0", Namespace: "shop", Labels: map[string]string{"app": "api", "chaos": "enabled"},
+	}})
+	r := newReconcilerWithObjects(t, objs...)
+	reader := &pagedReader{Reader: r.Client}
+	r.APIReader = reader
+	r.ListPodsFromAPI = true
+	r.PodListPageSize = 100
+	exp := &chaosv1alpha1.ChaosExperiment{Spec: chaosv1alpha1.ChaosExperimentSpec{
+		Action:                   "pod-kill",
+		Namespace:                "shop",
+		Selector:                 map[string]string{"app": "web"},
+		AllowSingletonDisruption: true,
+		IgnoreRollouts:           true,
+	}}
+
+	eligible, err := r.getEligiblePods(ctx, exp)
+	require.NoError(t, err)
+	assert.Len(t, eligible, 450)
+	assert.Equal(t, 5, reader.pages)
+
+	// Pods outside the cache selector are not listed, since the cache could not read them back
+	r.PodCacheSelector = labels.SelectorFromSet(labels.Set{"chaos": "enabled"})
+	reader.pages = 0
+	eligible, err = r.getEligiblePods(ctx, exp)
+	require.NoError(t, err)
+	assert.Len(t, eligible, 150)
+	assert.Equal(t, 2, reader.pages)
+}
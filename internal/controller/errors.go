@@ -17,6 +17,8 @@ limitations under the License.
 package controller
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"regexp"
 	"strings"
@@ -36,6 +38,8 @@ const (
 	ErrorTypeValidation ErrorType = "validation"
 	// ErrorTypeTimeout indicates operation timeouts
 	ErrorTypeTimeout ErrorType = "timeout"
+	// ErrorTypeImagePull indicates an injected container whose image cannot be pulled
+	ErrorTypeImagePull ErrorType = "image-pull"
 	// ErrorTypeUnknown indicates uncategorized errors
 	ErrorTypeUnknown ErrorType = "unknown"
 )
@@ -73,17 +77,64 @@ func (ce *ChaosError) Unwrap() error {
 	return ce.Original
 }
 
+// StatusMessage renders the error for status.lastError: the error type, the message and,
+// when one applies, a remediation hint
+func (ce *ChaosError) StatusMessage() string {
+	msg := FormatErrorMessage(ce)
+	if hint := Remediation(ce); hint != "" {
+		msg = strings.TrimSuffix(msg, ".") + ". " + hint
+	}
+	return fmt.Sprintf("[%s] %s", ce.Type, msg)
+}
+
 // ClassifyError analyzes a K8s API error and returns structured error information
 func ClassifyError(err error) *ChaosError {
 	if err == nil {
 		return nil
 	}
 
+	// Already classified further down the call chain
+	var existing *ChaosError
+	if errors.As(err, &existing) {
+		ce := *existing
+		ce.Original = err
+		return &ce
+	}
+
 	ce := &ChaosError{
 		Original: err,
 		Type:     ErrorTypeUnknown,
 	}
 
+	var pullErr *imagePullError
+	if errors.As(err, &pullErr) {
+		ce.Type = ErrorTypeImagePull
+		return ce
+	}
+
+	// A memory stress larger than its target can hold is refused like an invalid spec
+	var overcommitErr *memoryOvercommitError
+	if errors.As(err, &overcommitErr) {
+		ce.Type = ErrorTypeValidation
+		return ce
+	}
+
+	// An injected container that never started or exited with an error
+	var startErr *targetStartError
+	if errors.As(err, &startErr) {
+		ce.Type = ErrorTypeExecution
+		if imagePullWaitingReasons[startErr.Result.Reason] {
+			ce.Type = ErrorTypeImagePull
+		}
+		return ce
+	}
+
+	// Pod Security admission also answers 403, but no RBAC change fixes it
+	if isPodSecurityRejection(err) {
+		ce.Type = ErrorTypeExecution
+		return ce
+	}
+
 	// Check for permission errors (403 Forbidden or 401 Unauthorized)
 	if apierrors.IsForbidden(err) || apierrors.IsUnauthorized(err) {
 		ce.Type = ErrorTypePermission
@@ -91,8 +142,8 @@ func ClassifyError(err error) *ChaosError {
 		return ce
 	}
 
-	// Check for timeout errors
-	if apierrors.IsTimeout(err) {
+	// Check for timeout errors, including the API server giving up on the request
+	if apierrors.IsTimeout(err) || apierrors.IsServerTimeout(err) || errors.Is(err, context.DeadlineExceeded) {
 		ce.Type = ErrorTypeTimeout
 		return ce
 	}
@@ -235,6 +286,48 @@ func FormatErrorMessage(ce *ChaosError) string {
 	return msg.String()
 }
 
+// Remediation returns a short hint on how to resolve a classified error. Permission errors
+// carry their remediation in FormatErrorMessage and get none here.
+func Remediation(ce *ChaosError) string {
+	if ce == nil {
+		return ""
+	}
+	switch ce.Type {
+	case ErrorTypePermission:
+		return ""
+	case ErrorTypeValidation:
+		return "Fix the experiment spec and re-apply it; see https://github.com/neogan74/k8s-chaos/blob/main/docs/API.md"
+	case ErrorTypeTimeout:
+		return "The API server did not answer in time; check control plane health. The experiment is retried with backoff"
+	case ErrorTypeImagePull:
+		return "The node cannot pull the chaos container image; check registry access and pull secrets, " +
+			"or mirror the image and point --stress-image and --stress-fallback-image at the mirror"
+	}
+
+	var netAdminErr *netAdminError
+	var startErr *targetStartError
+	switch {
+	case errors.As(ce.Original, &netAdminErr):
+		return "Add NET_ADMIN to the container's capabilities and tc to its image, or set netAdminFallback to apply the change from a helper container"
+	case errors.As(ce.Original, &startErr):
+		namespace, pod, _ := strings.Cut(startErr.Result.Pod, "/")
+		return fmt.Sprintf("Check the output of the injected container: kubectl logs -n %s %s -c %s",
+			namespace, pod, startErr.Result.Container)
+	case isPodSecurityRejection(ce.Original):
+		return "Network chaos containers add the NET_ADMIN capability; label the namespace with a Pod Security level that allows it (privileged) or target another namespace"
+	case apierrors.IsNotFound(ce.Original):
+		return "The target disappeared while the experiment ran; check that the selector still matches running objects"
+	case apierrors.IsConflict(ce.Original):
+		return "The target was modified concurrently; the experiment is retried"
+	case apierrors.IsAlreadyExists(ce.Original):
+		return "A resource from a previous run still exists; wait for its cleanup or delete it"
+	case apierrors.IsTooManyRequests(ce.Original):
+		return "The API server is throttling the controller; the experiment is retried with backoff"
+	}
+	return "Check the target's events and the controller logs: " +
+		"kubectl logs -n k8s-chaos-system deployment/k8s-chaos-controller-manager"
+}
+
 // WrapK8sError wraps a K8s API error with classification and operation context
 func WrapK8sError(err error, operation string) *ChaosError {
 	if err == nil {
@@ -0,0 +1,71 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+var podsResource = schema.GroupResource{Resource: "pods"}
+
+func TestClassifyError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want ErrorType
+	}{
+		{"forbidden", apierrors.NewForbidden(podsResource, "web", fmt.Errorf("denied")), ErrorTypePermission},
+		{"wrapped forbidden", fmt.Errorf("failed to list pods: %w", apierrors.NewForbidden(podsResource, "", fmt.Errorf("denied"))), ErrorTypePermission},
+		{"server timeout", apierrors.NewServerTimeout(podsResource, "list", 1), ErrorTypeTimeout},
+		{"deadline exceeded", fmt.Errorf("exec: %w", context.DeadlineExceeded), ErrorTypeTimeout},
+		{"invalid", apierrors.NewBadRequest("bad"), ErrorTypeValidation},
+		{"not found", apierrors.NewNotFound(podsResource, "web"), ErrorTypeExecution},
+		{"pod security rejection", podSecurityRejection(), ErrorTypeExecution},
+		{"image pull", fmt.Errorf("inject: %w", &imagePullError{Pod: "web", Container: "memory-stress-1", Image: "busybox", Reason: "ErrImagePull"}), ErrorTypeImagePull},
+		{"already classified", fmt.Errorf("outer: %w", &ChaosError{Original: fmt.Errorf("bad spec"), Type: ErrorTypeValidation}), ErrorTypeValidation},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, ClassifyError(tt.err).Type)
+		})
+	}
+}
+
+func TestChaosErrorStatusMessage(t *testing.T) {
+	notFound := WrapK8sError(fmt.Errorf("failed to delete pod: %w", apierrors.NewNotFound(podsResource, "web")), "delete pod")
+	assert.Equal(t, `[execution] failed to delete pod: pods "web" not found. `+
+		"The target disappeared while the experiment ran; check that the selector still matches running objects",
+		notFound.StatusMessage())
+
+	validation := &ChaosError{Original: fmt.Errorf("duration is required"), Type: ErrorTypeValidation}
+	assert.Contains(t, validation.StatusMessage(), "[validation] duration is required. Fix the experiment spec")
+
+	// Permission errors keep the RBAC remediation from FormatErrorMessage and add nothing
+	forbidden := WrapK8sError(fmt.Errorf(`pods is forbidden: User "x" cannot list resource "pods" in API group "" in namespace "default"`), "list pods")
+	forbidden.Type = ErrorTypePermission
+	forbidden.Resource, forbidden.Verb = "pods", "list"
+	msg := forbidden.StatusMessage()
+	assert.Contains(t, msg, "[permission] Permission denied: cannot list pods")
+	assert.Contains(t, msg, "kubectl auth can-i list pods")
+	assert.NotContains(t, msg, "controller logs")
+}
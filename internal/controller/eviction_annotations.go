@@ -0,0 +1,107 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"sort"
+	"strconv"
+
+	corev1 "k8s.io/api/core/v1"
+
+	chaosv1alpha1 "github.com/neogan74/k8s-chaos/api/v1alpha1"
+)
+
+// Annotations other controllers use to decide which pods may be disrupted
+const (
+	// podDeletionCostAnnotation ranks the pods of a ReplicaSet for scale-down; lower costs go first
+	podDeletionCostAnnotation = "controller.kubernetes.io/pod-deletion-cost"
+	// safeToEvictAnnotation set to "false" keeps the cluster-autoscaler from removing the pod's node
+	safeToEvictAnnotation = "cluster-autoscaler.kubernetes.io/safe-to-evict"
+)
+
+// exclusionNotSafeToEvict is the excluded resources metric reason for pods annotated safe-to-evict: "false"
+const exclusionNotSafeToEvict = "safe-to-evict"
+
+// honorsEvictionAnnotations reports whether the experiment picks its victims by the eviction annotations
+func honorsEvictionAnnotations(spec *chaosv1alpha1.ChaosExperimentSpec) bool {
+	if spec.IgnoreEvictionAnnotations {
+		return false
+	}
+	return spec.Action == "pod-kill" || spec.Action == "node-drain"
+}
+
+// isNotSafeToEvict checks whether a pod is annotated cluster-autoscaler.kubernetes.io/safe-to-evict: "false"
+func isNotSafeToEvict(pod *corev1.Pod) bool {
+	return pod.Annotations[safeToEvictAnnotation] == "false"
+}
+
+// podDeletionCost returns the pod's deletion cost; like the ReplicaSet controller, a missing or
+// malformed annotation counts as 0
+func podDeletionCost(pod *corev1.Pod) int64 {
+	cost, err := strconv.ParseInt(pod.Annotations[podDeletionCostAnnotation], 10, 32)
+	if err != nil {
+		return 0
+	}
+	return cost
+}
+
+// filterNotSafeToEvict drops the pods annotated safe-to-evict: "false" and returns their names
+func filterNotSafeToEvict(pods []corev1.Pod) ([]corev1.Pod, []string) {
+	kept := make([]corev1.Pod, 0, len(pods))
+	var skipped []string
+	for _, pod := range pods {
+		if isNotSafeToEvict(&pod) {
+			skipped = append(skipped, pod.Name)
+			continue
+		}
+		kept = append(kept, pod)
+	}
+	return kept, skipped
+}
+
+// sortByDeletionCost moves the cheapest pods to the front; pods of equal cost keep their order
+func sortByDeletionCost(pods []corev1.Pod) {
+	sort.SliceStable(pods, func(i, j int) bool {
+		return podDeletionCost(&pods[i]) < podDeletionCost(&pods[j])
+	})
+}
+
+// nodeEvictionBlocker returns a pod on the node that is annotated safe-to-evict: "false", or ""
+func nodeEvictionBlocker(pods []corev1.Pod) string {
+	for i := range pods {
+		if isEvictablePod(&pods[i]) && isNotSafeToEvict(&pods[i]) {
+			return pods[i].Namespace + "/" + pods[i].Name
+		}
+	}
+	return ""
+}
+
+// sortNodesByDeletionCost moves the nodes whose evictable pods cost the least to delete to the front;
+// nodes of equal cost keep their order
+func sortNodesByDeletionCost(nodes []corev1.Node, podsByNode map[string][]corev1.Pod) {
+	costs := make(map[string]int64, len(nodes))
+	for _, node := range nodes {
+		for i := range podsByNode[node.Name] {
+			if pod := &podsByNode[node.Name][i]; isEvictablePod(pod) {
+				costs[node.Name] += podDeletionCost(pod)
+			}
+		}
+	}
+	sort.SliceStable(nodes, func(i, j int) bool {
+		return costs[nodes[i].Name] < costs[nodes[j].Name]
+	})
+}
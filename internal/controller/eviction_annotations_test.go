@@ -0,0 +1,139 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	chaosv1alpha1 "github.com/neogan74/k8s-chaos/api/v1alpha1"
+)
+
+func annotatedPod(name string, annotations map[string]string) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        name,
+			Namespace:   "test-ns",
+			Labels:      map[string]string{"app": "demo"},
+			Annotations: annotations,
+		},
+		Status: corev1.PodStatus{Phase: corev1.PodRunning},
+	}
+}
+
+func TestPodDeletionCost(t *testing.T) {
+	for value, want := range map[string]int64{"": 0, "100": 100, "-5": -5, "cheap": 0, "9999999999": 0} {
+		pod := annotatedPod("web", map[string]string{podDeletionCostAnnotation: value})
+		assert.Equal(t, want, podDeletionCost(pod), "annotation %q", value)
+	}
+}
+
+func TestGetEligiblePods_NotSafeToEvict(t *testing.T) {
+	ctx := context.Background()
+	objs := []client.Object{
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "test-ns"}},
+		annotatedPod("pinned", map[string]string{safeToEvictAnnotation: "false"}),
+		annotatedPod("evictable", map[string]string{safeToEvictAnnotation: "true"}),
+		annotatedPod("plain", nil),
+	}
+	exp := &chaosv1alpha1.ChaosExperiment{
+		Spec: chaosv1alpha1.ChaosExperimentSpec{
+			Action:                   "pod-kill",
+			Namespace:                "test-ns",
+			Selector:                 map[string]string{"app": "demo"},
+			AllowSingletonDisruption: true,
+		},
+	}
+	names := func(pods []corev1.Pod) []string {
+		var out []string
+		for _, pod := range pods {
+			out = append(out, pod.Name)
+		}
+		return out
+	}
+
+	eligible, err := newReconcilerWithObjects(t, objs...).getEligiblePods(ctx, exp)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"evictable", "plain"}, names(eligible))
+
+	exp.Spec.IgnoreEvictionAnnotations = true
+	eligible, err = newReconcilerWithObjects(t, objs...).getEligiblePods(ctx, exp)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"pinned", "evictable", "plain"}, names(eligible))
+
+	// Other actions do not disrupt pods the way a kill does
+	exp.Spec.IgnoreEvictionAnnotations = false
+	exp.Spec.Action = "pod-cpu-stress"
+	eligible, err = newReconcilerWithObjects(t, objs...).getEligiblePods(ctx, exp)
+	require.NoError(t, err)
+	assert.Len(t, eligible, 3)
+}
+
+func TestOrderTargetPods_DeletionCost(t *testing.T) {
+	ctx := context.Background()
+	pods := []corev1.Pod{
+		*annotatedPod("expensive", map[string]string{podDeletionCostAnnotation: "1000"}),
+		*annotatedPod("default", nil),
+		*annotatedPod("cheap", map[string]string{podDeletionCostAnnotation: "-10"}),
+	}
+	exp := &chaosv1alpha1.ChaosExperiment{
+		Spec: chaosv1alpha1.ChaosExperimentSpec{Action: "pod-kill", Namespace: "test-ns", Count: 3},
+	}
+	r := newReconcilerWithObjects(t)
+
+	ordered := r.orderTargetPods(ctx, exp, pods)
+	require.Len(t, ordered, 3)
+	assert.Equal(t, []string{"cheap", "default", "expensive"},
+		[]string{ordered[0].Name, ordered[1].Name, ordered[2].Name})
+}
+
+func TestHandleNodeDrain_SkipsNodesWithPodsNotSafeToEvict(t *testing.T) {
+	ctx := context.Background()
+	pinned := drainTestPod("batch", "checkpointing", "worker-1")
+	pinned.Annotations = map[string]string{safeToEvictAnnotation: "false"}
+	expensive := drainTestPod("shop", "cache", "worker-2")
+	expensive.Annotations = map[string]string{podDeletionCostAnnotation: "500"}
+	exp := &chaosv1alpha1.ChaosExperiment{
+		ObjectMeta: metav1.ObjectMeta{Name: "drain", Namespace: "chaos"},
+		Spec: chaosv1alpha1.ChaosExperimentSpec{
+			Action:    "node-drain",
+			Namespace: "chaos",
+			Selector:  map[string]string{"pool": "spot"},
+			Count:     1,
+		},
+	}
+	spot := func(name string) *corev1.Node {
+		return &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: name, Labels: map[string]string{"pool": "spot"}}}
+	}
+	r := newReconcilerWithObjects(t, exp, spot("worker-1"), spot("worker-2"), spot("worker-3"),
+		pinned, expensive, drainTestPod("shop", "web", "worker-3"))
+
+	_, err := r.handleNodeDrain(ctx, exp)
+	require.NoError(t, err)
+
+	// worker-1 is off limits and worker-3's pods are cheaper to delete than worker-2's
+	updated := fetchExperiment(t, r, "drain", "chaos")
+	assert.Equal(t, []string{"worker-3"}, updated.Status.CordonedNodes)
+	require.NoError(t, r.Get(ctx, types.NamespacedName{Namespace: "batch", Name: "checkpointing"}, &corev1.Pod{}))
+}
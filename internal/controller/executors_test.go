@@ -0,0 +1,39 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/neogan74/k8s-chaos/internal/capabilities"
+	"github.com/neogan74/k8s-chaos/internal/preflight"
+)
+
+// Every executed action must be described for /api/v1/capabilities and checked by preflight
+func TestSupportedActions_Described(t *testing.T) {
+	actions := SupportedActions()
+
+	assert.Equal(t, capabilities.Known(), actions)
+	for _, action := range actions {
+		assert.Contains(t, preflight.Actions, action)
+	}
+	_, err := capabilities.Describe(actions)
+	require.NoError(t, err)
+}
@@ -22,12 +22,14 @@ import (
 	"testing"
 	"time"
 
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	chaosv1alpha1 "github.com/neogan74/k8s-chaos/api/v1alpha1"
+	chaosmetrics "github.com/neogan74/k8s-chaos/internal/metrics"
 )
 
 func TestHandleExperimentFailureRetries(t *testing.T) {
@@ -39,6 +41,7 @@ func TestHandleExperimentFailureRetries(t *testing.T) {
 		},
 		Spec: chaosv1alpha1.ChaosExperimentSpec{
 			Action:     "pod-kill",
+			Namespace:  "fail-retry-target",
 			MaxRetries: 2,
 		},
 	}
@@ -59,6 +62,9 @@ func TestHandleExperimentFailureRetries(t *testing.T) {
 	assert.Equal(t, 1, refreshed.Status.RetryCount)
 	assert.NotNil(t, refreshed.Status.NextRetryTime)
 	assert.Contains(t, refreshed.Status.Message, "Retry 1/2")
+	assert.Contains(t, refreshed.Status.LastError, "[execution] boom. Check the target's events")
+	assert.Equal(t, float64(1), testutil.ToFloat64(
+		chaosmetrics.ExperimentErrors.WithLabelValues("pod-kill", "fail-retry-target", string(ErrorTypeExecution))))
 }
 
 func TestHandleExperimentFailureExhaustsRetries(t *testing.T) {
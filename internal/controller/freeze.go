@@ -0,0 +1,134 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	chaosv1alpha1 "github.com/neogan74/k8s-chaos/api/v1alpha1"
+	chaosmetrics "github.com/neogan74/k8s-chaos/internal/metrics"
+)
+
+const (
+	// conditionFrozen is set on experiments held by a ChaosFreeze
+	conditionFrozen = "Frozen"
+)
+
+// getActiveFreeze returns the first ChaosFreeze that is currently in effect, or nil
+func (r *ChaosExperimentReconciler) getActiveFreeze(ctx context.Context) (*chaosv1alpha1.ChaosFreeze, error) {
+	freezes := &chaosv1alpha1.ChaosFreezeList{}
+	if err := r.List(ctx, freezes); err != nil {
+		return nil, fmt.Errorf("failed to list chaos freezes: %w", err)
+	}
+
+	now := time.Now()
+	for i := range freezes.Items {
+		if freezes.Items[i].IsActive(now) {
+			chaosmetrics.FreezeActive.Set(1)
+			return &freezes.Items[i], nil
+		}
+	}
+	chaosmetrics.FreezeActive.Set(0)
+	return nil, nil
+}
+
+// handleFreeze reverts the experiment's active injections and holds it in the Paused phase
+// until the freeze is lifted
+func (r *ChaosExperimentReconciler) handleFreeze(ctx context.Context, exp *chaosv1alpha1.ChaosExperiment, freeze *chaosv1alpha1.ChaosFreeze) (ctrl.Result, error) {
+	log := ctrl.LoggerFrom(ctx)
+
+	message := fmt.Sprintf("Frozen by ChaosFreeze %q", freeze.Name)
+	if freeze.Spec.Reason != "" {
+		message = fmt.Sprintf("%s: %s", message, freeze.Spec.Reason)
+	}
+
+	// Only revert and record once per freeze; later reconciles just keep waiting
+	if !meta.IsStatusConditionTrue(exp.Status.Conditions, conditionFrozen) {
+		log.Info("Chaos freeze in effect, pausing experiment", "freeze", freeze.Name)
+		r.revertActiveInjections(ctx, exp)
+		chaosmetrics.SafetyFreezeBlocks.WithLabelValues(exp.Spec.Action, exp.Spec.Namespace).Inc()
+		r.Recorder.Event(exp, corev1.EventTypeWarning, "ChaosFrozen", message)
+	}
+
+	meta.SetStatusCondition(&exp.Status.Conditions, metav1.Condition{
+		Type:               conditionFrozen,
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: exp.Generation,
+		Reason:             "ChaosFreezeActive",
+		Message:            message,
+	})
+	exp.Status.Phase = phasePaused
+	exp.Status.Message = message
+	if err := r.Status().Update(ctx, exp); err != nil {
+		log.Error(err, "Failed to update status for frozen experiment")
+		return ctrl.Result{}, err
+	}
+
+	// Re-check periodically so that expiring freezes are picked up on time
+	requeueAfter := time.Minute
+	if freeze.Spec.ExpiresAt != nil {
+		if untilExpiry := time.Until(freeze.Spec.ExpiresAt.Time); untilExpiry < requeueAfter {
+			requeueAfter = untilExpiry + time.Second
+		}
+	}
+	return ctrl.Result{RequeueAfter: requeueAfter}, nil
+}
+
+// clearFrozenCondition removes the Frozen condition once no freeze is in effect
+func (r *ChaosExperimentReconciler) clearFrozenCondition(ctx context.Context, exp *chaosv1alpha1.ChaosExperiment) {
+	if meta.FindStatusCondition(exp.Status.Conditions, conditionFrozen) == nil {
+		return
+	}
+
+	meta.RemoveStatusCondition(&exp.Status.Conditions, conditionFrozen)
+	r.Recorder.Event(exp, corev1.EventTypeNormal, "ChaosUnfrozen", "Chaos freeze lifted, resuming experiment")
+	if err := r.Status().Update(ctx, exp); err != nil {
+		log := ctrl.LoggerFrom(ctx)
+		log.Error(err, "Failed to clear Frozen condition")
+	}
+}
+
+// allExperiments enqueues every experiment when a ChaosFreeze or ChaosPolicy changes so that
+// freezes and limits take effect (and are lifted) immediately instead of on the next requeue
+func (r *ChaosExperimentReconciler) allExperiments(ctx context.Context, _ client.Object) []reconcile.Request {
+	experiments := &chaosv1alpha1.ChaosExperimentList{}
+	if err := r.List(ctx, experiments); err != nil {
+		ctrl.LoggerFrom(ctx).Error(err, "Failed to list experiments to enqueue")
+		return nil
+	}
+
+	requests := make([]reconcile.Request, 0, len(experiments.Items))
+	for _, exp := range experiments.Items {
+		if !r.Shard.Owns(exp.Namespace, exp.Name) {
+			continue
+		}
+		requests = append(requests, reconcile.Request{
+			NamespacedName: types.NamespacedName{Name: exp.Name, Namespace: exp.Namespace},
+		})
+	}
+	return requests
+}
@@ -0,0 +1,74 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	chaosv1alpha1 "github.com/neogan74/k8s-chaos/api/v1alpha1"
+)
+
+func TestReconcile_FreezePausesAndResumes(t *testing.T) {
+	ctx := context.Background()
+	exp := &chaosv1alpha1.ChaosExperiment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "frozen-exp",
+			Namespace: "default",
+		},
+		Spec: chaosv1alpha1.ChaosExperimentSpec{
+			Action:    "pod-kill",
+			Namespace: "default",
+			Selector:  map[string]string{"app": "demo"},
+		},
+		Status: chaosv1alpha1.ChaosExperimentStatus{
+			Phase: phaseRunning,
+		},
+	}
+	freeze := &chaosv1alpha1.ChaosFreeze{
+		ObjectMeta: metav1.ObjectMeta{Name: "incident-42"},
+		Spec:       chaosv1alpha1.ChaosFreezeSpec{Reason: "incident 42"},
+	}
+
+	r := newReconcilerWithObjects(t, exp, freeze)
+	req := ctrl.Request{NamespacedName: client.ObjectKeyFromObject(exp)}
+
+	result, err := r.Reconcile(ctx, req)
+	require.NoError(t, err)
+	assert.Positive(t, result.RequeueAfter)
+
+	updated := fetchExperiment(t, r, exp.Name, exp.Namespace)
+	assert.Equal(t, phasePaused, updated.Status.Phase)
+	assert.Contains(t, updated.Status.Message, "incident 42")
+	assert.True(t, meta.IsStatusConditionTrue(updated.Status.Conditions, conditionFrozen))
+
+	// Lifting the freeze clears the condition and resumes the experiment
+	require.NoError(t, r.Delete(ctx, freeze))
+	_, err = r.Reconcile(ctx, req)
+	require.NoError(t, err)
+
+	updated = fetchExperiment(t, r, exp.Name, exp.Namespace)
+	assert.NotEqual(t, phasePaused, updated.Status.Phase)
+	assert.Nil(t, meta.FindStatusCondition(updated.Status.Conditions, conditionFrozen))
+}
@@ -0,0 +1,190 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	chaosv1alpha1 "github.com/neogan74/k8s-chaos/api/v1alpha1"
+)
+
+// handoffTimeout bounds persisting the reverts of an interrupted reconcile after its context
+// was cancelled by the shutdown
+const handoffTimeout = 10 * time.Second
+
+// cleanupLedger remembers the reverts of the injections the reconciles in flight applied. A
+// reconcile that returns normally has recorded its injections in the status itself; one cut
+// short by a shutdown hands its entries off to the experiment's status.pendingCleanup.
+type cleanupLedger struct {
+	mu      sync.Mutex
+	pending map[types.NamespacedName][]chaosv1alpha1.PendingCleanup
+}
+
+func newCleanupLedger() *cleanupLedger {
+	return &cleanupLedger{pending: map[types.NamespacedName][]chaosv1alpha1.PendingCleanup{}}
+}
+
+// record adds the revert of an injection made for the experiment of ctx
+func (l *cleanupLedger) record(ctx context.Context, cleanup chaosv1alpha1.PendingCleanup) {
+	exp := artifactExperiment(ctx)
+	if l == nil || exp == nil {
+		return
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	key := client.ObjectKeyFromObject(exp)
+	if !slices.Contains(l.pending[key], cleanup) {
+		l.pending[key] = append(l.pending[key], cleanup)
+	}
+}
+
+// take returns and forgets the reverts recorded for an experiment
+func (l *cleanupLedger) take(key types.NamespacedName) []chaosv1alpha1.PendingCleanup {
+	if l == nil {
+		return nil
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	pending := l.pending[key]
+	delete(l.pending, key)
+	return pending
+}
+
+// handOffCleanup runs when a reconcile returns. If the shutdown cancelled it, the reverts of the
+// injections it made are persisted so the next controller finishes them; otherwise they are dropped.
+func (r *ChaosExperimentReconciler) handOffCleanup(ctx context.Context, key types.NamespacedName) {
+	pending := r.cleanups.take(key)
+	if len(pending) == 0 || ctx.Err() == nil {
+		return
+	}
+	log := ctrl.LoggerFrom(ctx)
+
+	// The reconcile context is gone; the manager still waits for this reconcile to return
+	writeCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), handoffTimeout)
+	defer cancel()
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		exp := &chaosv1alpha1.ChaosExperiment{}
+		if err := r.Get(writeCtx, key, exp); err != nil {
+			return err
+		}
+		for _, cleanup := range pending {
+			if !slices.Contains(exp.Status.PendingCleanup, cleanup) {
+				exp.Status.PendingCleanup = append(exp.Status.PendingCleanup, cleanup)
+			}
+		}
+		return r.Status().Update(writeCtx, exp)
+	})
+	if err != nil {
+		log.Error(err, "Failed to hand off cleanup of an interrupted injection", "pending", pending)
+		return
+	}
+	log.Info("Handed off cleanup of an interrupted injection to the next controller", "pending", pending)
+}
+
+// finishPendingCleanup runs the reverts a previous controller handed off. Reverts that fail are
+// passed on to ChaosCleanupTasks; those that cannot be stay in the status and are retried with
+// the returned error.
+func (r *ChaosExperimentReconciler) finishPendingCleanup(ctx context.Context, exp *chaosv1alpha1.ChaosExperiment) error {
+	log := ctrl.LoggerFrom(ctx)
+
+	var failed []chaosv1alpha1.PendingCleanup
+	var errs []string
+	finished := 0
+	for _, cleanup := range exp.Status.PendingCleanup {
+		if err := r.runCleanup(ctx, cleanup, exp.Namespace+"/"+exp.Name, exp.Spec.TaintKey, exp.Spec.TaintEffect); err != nil {
+			log.Error(err, "Failed to finish handed-off cleanup, creating a cleanup task", "operation", cleanup.Operation,
+				"node", cleanup.Node, "pod", cleanup.Pod, "container", cleanup.Container, "quota", cleanup.Quota, "webhook", cleanup.Webhook)
+			if err := r.createCleanupTask(ctx, exp, cleanup); err != nil {
+				failed = append(failed, cleanup)
+				errs = append(errs, err.Error())
+				continue
+			}
+		} else {
+			finished++
+		}
+		// The target is reverted, or up to its cleanup task, either way no longer the experiment's
+		switch cleanup.Operation {
+		case chaosv1alpha1.CleanupUncordon:
+			exp.Status.CordonedNodes = slices.DeleteFunc(exp.Status.CordonedNodes, func(node string) bool { return node == cleanup.Node })
+		case chaosv1alpha1.CleanupUntaint:
+			exp.Status.TaintedNodes = slices.DeleteFunc(exp.Status.TaintedNodes, func(node string) bool { return node == cleanup.Node })
+		case chaosv1alpha1.CleanupRestoreQuota:
+			exp.Status.SqueezedQuotas = slices.DeleteFunc(exp.Status.SqueezedQuotas, func(quota string) bool { return quota == cleanup.Quota })
+		case chaosv1alpha1.CleanupRemoveWebhook:
+			if exp.Status.AdmissionWebhook == cleanup.Webhook {
+				exp.Status.AdmissionWebhook = ""
+				exp.Status.AdmissionDelayEndsAt = nil
+			}
+		}
+	}
+
+	exp.Status.PendingCleanup = failed
+	if err := r.Status().Update(ctx, exp); err != nil {
+		return fmt.Errorf("failed to update pending cleanup: %w", err)
+	}
+	if finished > 0 {
+		r.Recorder.Eventf(exp, corev1.EventTypeNormal, "CleanupFinished",
+			"Reverted %d injection(s) left behind by a controller shutdown", finished)
+	}
+	if len(failed) > 0 {
+		return fmt.Errorf("%d handed-off cleanup(s) could not be passed on: %s", len(failed), strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+// runCleanup runs one revert for experiment ("namespace/name"); targets that are gone need no
+// revert. Untaint removes the taint with taintKey and taintEffect.
+func (r *ChaosExperimentReconciler) runCleanup(ctx context.Context, cleanup chaosv1alpha1.PendingCleanup, experiment, taintKey, taintEffect string) error {
+	switch cleanup.Operation {
+	case chaosv1alpha1.CleanupUncordon:
+		if err := r.uncordonNode(ctx, cleanup.Node); err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+		return nil
+	case chaosv1alpha1.CleanupUntaint:
+		return r.untaintNode(ctx, cleanup.Node, taintKey, taintEffect)
+	case chaosv1alpha1.CleanupStopContainer:
+		namespace, name, _ := strings.Cut(cleanup.Pod, "/")
+		pod := &corev1.Pod{}
+		if err := r.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, pod); err != nil {
+			return client.IgnoreNotFound(err)
+		}
+		if !isEphemeralContainerRunning(pod, cleanup.Container) {
+			return nil
+		}
+		return r.stopEphemeralContainer(ctx, pod, cleanup.Container)
+	case chaosv1alpha1.CleanupRestoreQuota:
+		return r.restoreQuota(ctx, cleanup.Quota, experiment)
+	case chaosv1alpha1.CleanupRemoveWebhook:
+		return r.removeAdmissionWebhook(ctx, cleanup.Webhook)
+	}
+	return fmt.Errorf("unknown cleanup operation %q", cleanup.Operation)
+}
@@ -0,0 +1,147 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	chaosv1alpha1 "github.com/neogan74/k8s-chaos/api/v1alpha1"
+)
+
+func handoffTestExperiment(action string) *chaosv1alpha1.ChaosExperiment {
+	return &chaosv1alpha1.ChaosExperiment{
+		ObjectMeta: metav1.ObjectMeta{Name: "drain-workers", Namespace: "chaos"},
+		Spec: chaosv1alpha1.ChaosExperimentSpec{
+			Action:      action,
+			Namespace:   "apps",
+			TaintKey:    "chaos",
+			TaintEffect: string(corev1.TaintEffectNoSchedule),
+		},
+	}
+}
+
+func TestHandOffCleanup_PersistsOnlyInterruptedReconciles(t *testing.T) {
+	exp := handoffTestExperiment("node-drain")
+	node := &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "worker-1"}}
+	r := newReconcilerWithObjects(t, exp, node)
+	r.cleanups = newCleanupLedger()
+	key := client.ObjectKeyFromObject(exp)
+
+	// A reconcile that returns normally recorded its injections in the status itself
+	ctx := withArtifactMarking(context.Background(), exp)
+	_, err := r.cordonNode(ctx, node)
+	require.NoError(t, err)
+	r.handOffCleanup(ctx, key)
+	assert.Empty(t, fetchExperiment(t, r, exp.Name, exp.Namespace).Status.PendingCleanup)
+	assert.Empty(t, r.cleanups.take(key))
+
+	// A reconcile cancelled by the shutdown hands its injections off
+	ctx, cancel := context.WithCancel(withArtifactMarking(context.Background(), exp))
+	r.cleanups.record(ctx, chaosv1alpha1.PendingCleanup{Operation: chaosv1alpha1.CleanupUncordon, Node: "worker-1"})
+	r.cleanups.record(ctx, chaosv1alpha1.PendingCleanup{Operation: chaosv1alpha1.CleanupUncordon, Node: "worker-1"})
+	r.cleanups.record(ctx, chaosv1alpha1.PendingCleanup{
+		Operation: chaosv1alpha1.CleanupStopContainer, Pod: "apps/web-0", Container: "chaos-network-loss-1",
+	})
+	cancel()
+	r.handOffCleanup(ctx, key)
+
+	assert.Equal(t, []chaosv1alpha1.PendingCleanup{
+		{Operation: chaosv1alpha1.CleanupUncordon, Node: "worker-1"},
+		{Operation: chaosv1alpha1.CleanupStopContainer, Pod: "apps/web-0", Container: "chaos-network-loss-1"},
+	}, fetchExperiment(t, r, exp.Name, exp.Namespace).Status.PendingCleanup)
+}
+
+func TestFinishPendingCleanup(t *testing.T) {
+	ctx := context.Background()
+	exp := handoffTestExperiment("node-taint")
+	exp.Status.TaintedNodes = []string{"worker-2", "worker-3"}
+	exp.Status.PendingCleanup = []chaosv1alpha1.PendingCleanup{
+		{Operation: chaosv1alpha1.CleanupUncordon, Node: "worker-1"},
+		{Operation: chaosv1alpha1.CleanupUntaint, Node: "worker-2"},
+		{Operation: chaosv1alpha1.CleanupUncordon, Node: "gone"},
+		{Operation: chaosv1alpha1.CleanupStopContainer, Pod: "apps/gone", Container: "chaos-cpu-stress-1"},
+	}
+	cordoned := &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "worker-1",
+			Annotations: map[string]string{chaosv1alpha1.CordonedByAnnotation: "chaos/drain-workers"},
+		},
+		Spec: corev1.NodeSpec{Unschedulable: true},
+	}
+	tainted := &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: "worker-2"},
+		Spec: corev1.NodeSpec{Taints: []corev1.Taint{
+			{Key: "chaos", Effect: corev1.TaintEffectNoSchedule},
+			{Key: "dedicated", Effect: corev1.TaintEffectNoSchedule},
+		}},
+	}
+	r := newReconcilerWithObjects(t, exp, cordoned, tainted)
+
+	current := fetchExperiment(t, r, exp.Name, exp.Namespace)
+	require.NoError(t, r.finishPendingCleanup(ctx, current))
+
+	node := &corev1.Node{}
+	require.NoError(t, r.Get(ctx, client.ObjectKey{Name: "worker-1"}, node))
+	assert.False(t, node.Spec.Unschedulable)
+	assert.NotContains(t, node.Annotations, chaosv1alpha1.CordonedByAnnotation)
+	require.NoError(t, r.Get(ctx, client.ObjectKey{Name: "worker-2"}, node))
+	assert.Equal(t, []corev1.Taint{{Key: "dedicated", Effect: corev1.TaintEffectNoSchedule}}, node.Spec.Taints)
+
+	updated := fetchExperiment(t, r, exp.Name, exp.Namespace)
+	assert.Empty(t, updated.Status.PendingCleanup)
+	assert.Equal(t, []string{"worker-3"}, updated.Status.TaintedNodes)
+	assert.Contains(t, <-r.Recorder.(*record.FakeRecorder).Events, "Reverted 4 injection(s)")
+}
+
+func TestFinishPendingCleanup_DelegatesFailedReverts(t *testing.T) {
+	ctx := context.Background()
+	exp := handoffTestExperiment("pod-network-loss")
+	exp.Status.PendingCleanup = []chaosv1alpha1.PendingCleanup{
+		{Operation: chaosv1alpha1.CleanupStopContainer, Pod: "apps/web-0", Container: "chaos-network-loss-1"},
+	}
+	// Without a clientset the running container cannot be signalled
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "web-0", Namespace: "apps"},
+		Status: corev1.PodStatus{EphemeralContainerStatuses: []corev1.ContainerStatus{{
+			Name:  "chaos-network-loss-1",
+			State: corev1.ContainerState{Running: &corev1.ContainerStateRunning{}},
+		}}},
+	}
+	r := newReconcilerWithObjects(t, exp, pod)
+
+	require.NoError(t, r.finishPendingCleanup(ctx, fetchExperiment(t, r, exp.Name, exp.Namespace)))
+	assert.Empty(t, fetchExperiment(t, r, exp.Name, exp.Namespace).Status.PendingCleanup)
+
+	tasks := &chaosv1alpha1.ChaosCleanupTaskList{}
+	require.NoError(t, r.List(ctx, tasks))
+	require.Len(t, tasks.Items, 1)
+	task := tasks.Items[0]
+	assert.Equal(t, exp.Namespace, task.Namespace)
+	assert.Equal(t, exp.Name, task.Spec.Experiment)
+	assert.Equal(t, chaosv1alpha1.CleanupStopContainer, task.Spec.Operation)
+	assert.Equal(t, "apps/web-0", task.Spec.Pod)
+	assert.Equal(t, "chaos-network-loss-1", task.Spec.Container)
+	assert.Empty(t, task.OwnerReferences, "the task must outlive the experiment")
+}
@@ -17,7 +17,6 @@ limitations under the License.
 package controller
 
 import (
-	"context"
 	"fmt"
 	"testing"
 	"time"
@@ -889,11 +888,17 @@ func TestGenerateShortUID(t *testing.T) {
 
 // Test getInitiator function
 func TestGetInitiator(t *testing.T) {
-	ctx := context.Background()
-	initiator := getInitiator(ctx)
-
-	// Should return the default controller service account
+	// Without the annotation, fall back to the default controller service account
+	initiator := getInitiator(&chaosv1alpha1.ChaosExperiment{})
 	assert.Equal(t, "system:serviceaccount:chaos-system:chaos-controller", initiator)
+
+	// With the annotation set by the mutating webhook, use the recorded user
+	exp := &chaosv1alpha1.ChaosExperiment{
+		ObjectMeta: metav1.ObjectMeta{
+			Annotations: map[string]string{chaosv1alpha1.InitiatedByAnnotation: "jane@example.com"},
+		},
+	}
+	assert.Equal(t, "jane@example.com", getInitiator(exp))
 }
 
 // Test DefaultHistoryConfig function
@@ -905,6 +910,7 @@ func TestDefaultHistoryConfig(t *testing.T) {
 	assert.Equal(t, 100, config.RetentionLimit)
 	assert.Equal(t, 30*24*time.Hour, config.RetentionTTL)
 	assert.Equal(t, 1, config.SamplingRate)
+	assert.Equal(t, 20, config.RegressionThreshold)
 }
 
 // TestIsPermissionDeniedError verifies that RBAC errors are correctly categorised.
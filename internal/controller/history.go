@@ -27,6 +27,7 @@ import (
 
 	chaosv1alpha1 "github.com/neogan74/k8s-chaos/api/v1alpha1"
 	chaosmetrics "github.com/neogan74/k8s-chaos/internal/metrics"
+	"github.com/neogan74/k8s-chaos/internal/signing"
 )
 
 // HistoryConfig holds configuration for history recording
@@ -36,16 +37,31 @@ type HistoryConfig struct {
 	RetentionLimit int
 	RetentionTTL   time.Duration
 	SamplingRate   int // Record every Nth execution (1 = all, 10 = every 10th)
+	// SigningKey signs every record spec when set, so tampering can be detected with `history verify`
+	SigningKey *signing.Key
+	// RegressionThreshold is the percentage by which recovery time may grow over the previous
+	// run before the run is flagged as a regression (0 disables regression detection)
+	RegressionThreshold int
+	// SnapshotMaxBytes bounds the events and logs captured into each record (0 disables capture)
+	SnapshotMaxBytes int
+	// SnapshotLogLines is how many trailing log lines are captured per container (0 skips logs)
+	SnapshotLogLines int
+	// SizeBudget bounds the total size in bytes of an experiment's history records. Older records
+	// are compacted, then deleted, to stay within it (0 disables the budget)
+	SizeBudget int
 }
 
 // DefaultHistoryConfig returns default history configuration
 func DefaultHistoryConfig() HistoryConfig {
 	return HistoryConfig{
-		Enabled:        true,
-		Namespace:      "chaos-system",
-		RetentionLimit: 100,
-		RetentionTTL:   30 * 24 * time.Hour, // 30 days
-		SamplingRate:   1,                   // Record all executions
+		Enabled:             true,
+		Namespace:           "chaos-system",
+		RetentionLimit:      100,
+		RetentionTTL:        30 * 24 * time.Hour, // 30 days
+		SamplingRate:        1,                   // Record all executions
+		RegressionThreshold: 20,
+		SnapshotMaxBytes:    16 * 1024,
+		SnapshotLogLines:    20,
 	}
 }
 
@@ -57,6 +73,20 @@ func (r *ChaosExperimentReconciler) createHistoryRecord(
 	affectedResources []chaosv1alpha1.ResourceReference,
 	startTime time.Time,
 	errorDetails *chaosv1alpha1.ErrorDetails,
+) error {
+	return r.recordExecution(ctx, exp, executionStatus, affectedResources, startTime, errorDetails, nil)
+}
+
+// recordExecution creates the history record of createHistoryRecord, describing the cut-short
+// injections of a cancelled execution when cancellation is set
+func (r *ChaosExperimentReconciler) recordExecution(
+	ctx context.Context,
+	exp *chaosv1alpha1.ChaosExperiment,
+	executionStatus string,
+	affectedResources []chaosv1alpha1.ResourceReference,
+	startTime time.Time,
+	errorDetails *chaosv1alpha1.ErrorDetails,
+	cancellation *chaosv1alpha1.Cancellation,
 ) error {
 	log := ctrl.LoggerFrom(ctx)
 
@@ -86,9 +116,11 @@ func (r *ChaosExperimentReconciler) createHistoryRecord(
 			Namespace: historyNamespace,
 			Labels: map[string]string{
 				"chaos.gushchin.dev/experiment":       exp.Name,
+				"chaos.gushchin.dev/experiment-uid":   string(exp.UID),
 				"chaos.gushchin.dev/action":           exp.Spec.Action,
 				"chaos.gushchin.dev/target-namespace": exp.Spec.Namespace,
 				"chaos.gushchin.dev/status":           executionStatus,
+				"chaos.gushchin.dev/severity":         chaosv1alpha1.SeverityOf(&exp.Spec),
 			},
 		},
 		Spec: chaosv1alpha1.ChaosExperimentHistorySpec{
@@ -99,25 +131,62 @@ func (r *ChaosExperimentReconciler) createHistoryRecord(
 			},
 			ExperimentSpec: exp.Spec,
 			Execution: chaosv1alpha1.ExecutionDetails{
-				StartTime: metav1.NewTime(startTime),
-				EndTime:   &endTime,
-				Duration:  duration.String(),
-				Status:    executionStatus,
-				Message:   exp.Status.Message,
-				Phase:     exp.Status.Phase,
+				StartTime:    metav1.NewTime(startTime),
+				EndTime:      &endTime,
+				Duration:     duration.String(),
+				Status:       executionStatus,
+				Message:      exp.Status.Message,
+				Phase:        exp.Status.Phase,
+				Cancellation: cancellation,
 			},
-			AffectedResources: affectedResources,
+			AffectedResources:   affectedResources,
+			Verdict:             exp.Status.Verdict,
+			BlastRadius:         exp.Status.BlastRadius,
+			Autoscalers:         exp.Status.Autoscalers,
+			NetworkMeasurements: exp.Status.NetworkMeasurements,
 			Audit: chaosv1alpha1.AuditMetadata{
-				InitiatedBy:        getInitiator(ctx),
-				ScheduledExecution: exp.Spec.Schedule != "",
+				InitiatedBy:        getInitiator(exp),
+				InitiatedVia:       exp.Annotations[chaosv1alpha1.UserAgentAnnotation],
+				ScheduledExecution: !chaosv1alpha1.ScheduleDefinition(&exp.Spec).IsZero(),
 				DryRun:             exp.Spec.DryRun,
 				RetryCount:         exp.Status.RetryCount,
+				ChangeTicket:       exp.Annotations[chaosv1alpha1.ChangeTicketAnnotation],
+				ChangeTicketState:  verifiedChangeTicketState(exp),
 				CreationTimestamp:  metav1.Now(),
 			},
 			Error: errorDetails,
 		},
 	}
 
+	// Records kept next to the experiment go away with it; those in a shared history
+	// namespace outlive it until retention removes them. The record of a deletion is left
+	// unowned, it would otherwise be collected right after being written.
+	if cancellation == nil || cancellation.Reason != chaosv1alpha1.CancellationDeleted {
+		setExperimentOwner(exp, history)
+	}
+
+	if exp.Status.Verdict != nil {
+		history.Labels["chaos.gushchin.dev/verdict"] = exp.Status.Verdict.Result
+	}
+
+	if r.HistoryConfig.RegressionThreshold > 0 {
+		r.detectRegressions(ctx, exp, history)
+	}
+
+	if exp.Spec.IncludeJobPods {
+		history.Spec.JobImpact = r.jobImpact(ctx, exp)
+	}
+	history.Spec.Snapshot = r.captureSnapshot(ctx, affectedResources, startTime)
+	history.Spec.Metrics = r.sampleRunMetrics(ctx, exp, startTime)
+
+	r.redactHistory(history)
+
+	if r.HistoryConfig.SigningKey != nil {
+		if err := signing.Sign(r.HistoryConfig.SigningKey, history); err != nil {
+			return fmt.Errorf("failed to sign history record: %w", err)
+		}
+	}
+
 	// Create the history record
 	if err := r.Create(ctx, history); err != nil {
 		log.Error(err, "Failed to create history record",
@@ -135,8 +204,12 @@ func (r *ChaosExperimentReconciler) createHistoryRecord(
 	// Record metrics for history creation
 	chaosmetrics.HistoryRecordsTotal.WithLabelValues(exp.Spec.Action, executionStatus).Inc()
 
+	// Hand the record to the result webhooks; delivery and retries happen in the background
+	r.ResultWebhooks.Enqueue(history)
+	r.AuditLog.Enqueue(history)
+
 	// Trigger retention cleanup asynchronously
-	go r.cleanupOldHistoryRecords(context.Background(), exp)
+	go r.cleanupOldHistoryRecords(context.Background(), exp, historyNamespace)
 
 	// Trigger TTL cleanup asynchronously
 	go r.cleanupExpiredHistory(context.Background())
@@ -144,10 +217,12 @@ func (r *ChaosExperimentReconciler) createHistoryRecord(
 	return nil
 }
 
-// cleanupOldHistoryRecords removes old history records based on retention policy
+// cleanupOldHistoryRecords removes old history records based on retention policy, then keeps the
+// remaining ones within the size budget
 func (r *ChaosExperimentReconciler) cleanupOldHistoryRecords(
 	ctx context.Context,
 	exp *chaosv1alpha1.ChaosExperiment,
+	historyNamespace string,
 ) {
 	log := ctrl.LoggerFrom(ctx)
 
@@ -160,7 +235,7 @@ func (r *ChaosExperimentReconciler) cleanupOldHistoryRecords(
 	// List all history records for this experiment
 	historyList := &chaosv1alpha1.ChaosExperimentHistoryList{}
 	err := r.List(ctx, historyList,
-		client.InNamespace(exp.Namespace),
+		client.InNamespace(historyNamespace),
 		client.MatchingLabels{
 			"chaos.gushchin.dev/experiment": exp.Name,
 		})
@@ -168,20 +243,21 @@ func (r *ChaosExperimentReconciler) cleanupOldHistoryRecords(
 		log.Error(err, "Failed to list history records for cleanup")
 		return
 	}
-
-	// If under limit, nothing to clean up
-	if len(historyList.Items) <= retentionLimit {
-		return
+	records := make([]chaosv1alpha1.ChaosExperimentHistory, 0, len(historyList.Items))
+	for _, record := range historyList.Items {
+		if record.Spec.ExperimentRef.Namespace == exp.Namespace {
+			records = append(records, record)
+		}
 	}
 
 	// Sort by creation timestamp (oldest first)
-	sortHistoryByAge(historyList.Items)
+	sortHistoryByAge(records)
 
 	// Delete oldest records exceeding the limit
-	recordsToDelete := len(historyList.Items) - retentionLimit
+	recordsToDelete := max(len(records)-retentionLimit, 0)
 	deletedCount := 0
-	for i := 0; i < recordsToDelete && i < len(historyList.Items); i++ {
-		record := &historyList.Items[i]
+	for i := 0; i < recordsToDelete; i++ {
+		record := &records[i]
 		log.Info("Deleting old history record due to retention policy",
 			"record", record.Name,
 			"age", time.Since(record.CreationTimestamp.Time))
@@ -201,6 +277,10 @@ func (r *ChaosExperimentReconciler) cleanupOldHistoryRecords(
 			"deletedCount", deletedCount,
 			"retentionLimit", retentionLimit)
 	}
+
+	if r.HistoryConfig.SizeBudget > 0 {
+		r.enforceHistorySizeBudget(ctx, records[recordsToDelete:], time.Now())
+	}
 }
 
 // cleanupExpiredHistory removes history records older than the configured TTL
@@ -286,13 +366,25 @@ func buildResourceReferences(action string, namespace string, resourceNames []st
 	return refs
 }
 
-// getInitiator extracts the user/service account that initiated the request
-func getInitiator(ctx context.Context) string {
-	// TODO: Extract from request context
-	// For now, return controller service account
+// getInitiator returns the user/service account recorded by the mutating webhook
+func getInitiator(exp *chaosv1alpha1.ChaosExperiment) string {
+	if initiator := exp.Annotations[chaosv1alpha1.InitiatedByAnnotation]; initiator != "" {
+		return initiator
+	}
+	// Without the mutating webhook the creator is unknown; attribute it to the controller
 	return "system:serviceaccount:chaos-system:chaos-controller"
 }
 
+// verifiedChangeTicketState returns the state the change-management system reported for the
+// experiment's change ticket, or "" when that ticket was never verified
+func verifiedChangeTicketState(exp *chaosv1alpha1.ChaosExperiment) string {
+	ticket := exp.Status.ChangeTicket
+	if ticket == nil || ticket.ID != exp.Annotations[chaosv1alpha1.ChangeTicketAnnotation] {
+		return ""
+	}
+	return ticket.State
+}
+
 // generateShortUID generates a short unique identifier for history records
 func generateShortUID() string {
 	// Simple implementation - use current nanosecond timestamp
@@ -0,0 +1,151 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	chaosv1alpha1 "github.com/neogan74/k8s-chaos/api/v1alpha1"
+	chaosmetrics "github.com/neogan74/k8s-chaos/internal/metrics"
+	"github.com/neogan74/k8s-chaos/internal/signing"
+)
+
+// enforceHistorySizeBudget keeps the history records of one experiment, sorted oldest first, within
+// HistoryConfig.SizeBudget. The oldest records are compacted first; only when compacting all of them
+// is not enough are they deleted. The newest record is always kept as written.
+func (r *ChaosExperimentReconciler) enforceHistorySizeBudget(ctx context.Context, records []chaosv1alpha1.ChaosExperimentHistory, now time.Time) {
+	log := ctrl.LoggerFrom(ctx)
+	budget := r.HistoryConfig.SizeBudget
+
+	sizes := make([]int, len(records))
+	total := 0
+	for i := range records {
+		sizes[i] = historyRecordSize(&records[i])
+		total += sizes[i]
+	}
+	if total <= budget {
+		return
+	}
+
+	compacted := 0
+	for i := 0; i < len(records)-1 && total > budget; i++ {
+		record := &records[i]
+		if !r.compactHistoryRecord(record, sizes[i], now) {
+			continue
+		}
+		if err := r.Update(ctx, record); err != nil {
+			log.Error(err, "Failed to compact history record", "record", record.Name)
+			continue
+		}
+		size := historyRecordSize(record)
+		total += size - sizes[i]
+		sizes[i] = size
+		compacted++
+		chaosmetrics.HistoryCompactionsTotal.WithLabelValues(record.Spec.ExperimentSpec.Action).Inc()
+	}
+
+	deleted := 0
+	for i := 0; i < len(records)-1 && total > budget; i++ {
+		record := &records[i]
+		log.Info("Deleting history record to stay within the size budget",
+			"record", record.Name,
+			"size", sizes[i])
+		if err := r.Delete(ctx, record); err != nil {
+			log.Error(err, "Failed to delete history record", "record", record.Name)
+			continue
+		}
+		total -= sizes[i]
+		deleted++
+		chaosmetrics.HistoryCleanupTotal.WithLabelValues("size_budget").Inc()
+	}
+
+	if compacted > 0 || deleted > 0 {
+		log.Info("Enforced history size budget",
+			"compactedCount", compacted,
+			"deletedCount", deleted,
+			"size", total,
+			"sizeBudget", budget)
+	}
+}
+
+// compactHistoryRecord strips the per-target details of a history record: the affected resources,
+// which are replaced by counts, the blast-radius topology, the snapshot, network measurements and
+// autoscaler activity. The execution summary, audit metadata, error, metrics and regressions stay.
+// A signed record is signed again; it is left alone when the controller cannot sign, so its
+// signature keeps verifying. It reports whether the record was changed.
+func (r *ChaosExperimentReconciler) compactHistoryRecord(record *chaosv1alpha1.ChaosExperimentHistory, size int, now time.Time) bool {
+	if record.Spec.Compaction != nil {
+		return false
+	}
+
+	compact := record.DeepCopy()
+	spec := &compact.Spec
+	compaction := &chaosv1alpha1.HistoryCompaction{
+		CompactedAt:  metav1.NewTime(now),
+		OriginalSize: size,
+	}
+	if len(spec.AffectedResources) > 0 {
+		compaction.AffectedResources = map[string]int{}
+		for _, ref := range spec.AffectedResources {
+			compaction.AffectedResources[ref.Kind+"/"+ref.Action]++
+		}
+		spec.AffectedResources = nil
+		compaction.Removed = append(compaction.Removed, "affectedResources")
+	}
+	if spec.BlastRadius != nil && spec.BlastRadius.Topology != nil {
+		spec.BlastRadius.Topology = nil
+		compaction.Removed = append(compaction.Removed, "blastRadius.topology")
+	}
+	if spec.Snapshot != nil {
+		spec.Snapshot = nil
+		compaction.Removed = append(compaction.Removed, "snapshot")
+	}
+	if spec.NetworkMeasurements != nil {
+		spec.NetworkMeasurements = nil
+		compaction.Removed = append(compaction.Removed, "networkMeasurements")
+	}
+	if spec.Autoscalers != nil {
+		spec.Autoscalers = nil
+		compaction.Removed = append(compaction.Removed, "autoscalers")
+	}
+	if len(compaction.Removed) == 0 {
+		return false
+	}
+	spec.Compaction = compaction
+
+	if _, signed := compact.Annotations[signing.SignatureAnnotation]; signed {
+		if r.HistoryConfig.SigningKey == nil || signing.Sign(r.HistoryConfig.SigningKey, compact) != nil {
+			return false
+		}
+	}
+	*record = *compact
+	return true
+}
+
+// historyRecordSize approximates the size a history record takes in etcd by its JSON encoding
+func historyRecordSize(record *chaosv1alpha1.ChaosExperimentHistory) int {
+	data, err := json.Marshal(record)
+	if err != nil {
+		return 0
+	}
+	return len(data)
+}
@@ -18,16 +18,21 @@ package controller
 
 import (
 	"context"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	chaosv1alpha1 "github.com/neogan74/k8s-chaos/api/v1alpha1"
+	"github.com/neogan74/k8s-chaos/internal/signing"
 )
 
 const testHistoryNamespace = "chaos-system"
@@ -116,3 +121,158 @@ func TestCleanupExpiredHistory_Disabled(t *testing.T) {
 	_ = k8sClient.List(context.Background(), &historyList)
 	assert.Equal(t, 1, len(historyList.Items), "Record should NOT be deleted when TTL is 0")
 }
+
+func TestDetectRegressions(t *testing.T) {
+	ctx := context.Background()
+	exp := &chaosv1alpha1.ChaosExperiment{
+		ObjectMeta: metav1.ObjectMeta{Name: "regress", Namespace: "default"},
+		Spec:       chaosv1alpha1.ChaosExperimentSpec{Action: "pod-kill", Namespace: "default"},
+	}
+	previous := &chaosv1alpha1.ChaosExperimentHistory{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "regress-previous",
+			Namespace: testHistoryNamespace,
+			Labels:    map[string]string{"chaos.gushchin.dev/experiment": "regress"},
+		},
+		Spec: chaosv1alpha1.ChaosExperimentHistorySpec{
+			ExperimentRef: chaosv1alpha1.ObjectReference{Name: "regress", Namespace: "default"},
+			Execution: chaosv1alpha1.ExecutionDetails{
+				StartTime:    metav1.NewTime(time.Now().Add(-time.Hour)),
+				Status:       statusSuccess,
+				RecoveryTime: "10s",
+			},
+		},
+	}
+	r := newReconcilerWithObjects(t, exp, previous)
+
+	current := &chaosv1alpha1.ChaosExperimentHistory{
+		ObjectMeta: metav1.ObjectMeta{Name: "regress-current", Namespace: testHistoryNamespace},
+		Spec: chaosv1alpha1.ChaosExperimentHistorySpec{
+			Execution: chaosv1alpha1.ExecutionDetails{
+				StartTime:    metav1.Now(),
+				Status:       statusSuccess,
+				RecoveryTime: "11s",
+			},
+		},
+	}
+	r.detectRegressions(ctx, exp, current)
+	assert.Empty(t, current.Spec.Regressions, "10% slower recovery is within the default threshold")
+
+	current.Spec.Execution.RecoveryTime = "30s"
+	r.detectRegressions(ctx, exp, current)
+	assert.Equal(t, []string{"recoveryTime: 10s -> 30s (+200%)"}, current.Spec.Regressions)
+
+	recorder := r.Recorder.(*record.FakeRecorder)
+	select {
+	case event := <-recorder.Events:
+		assert.Contains(t, event, "RegressionDetected")
+		assert.Contains(t, event, "regress-previous")
+	default:
+		t.Fatal("expected RegressionDetected event")
+	}
+}
+
+// sizedHistoryRecord returns a history record of experiment "budget" with one snapshot event of
+// padding bytes, so its size is dominated by per-target details
+func sizedHistoryRecord(name string, age time.Duration, padding int) *chaosv1alpha1.ChaosExperimentHistory {
+	return &chaosv1alpha1.ChaosExperimentHistory{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              name,
+			Namespace:         testHistoryNamespace,
+			CreationTimestamp: metav1.NewTime(time.Now().Add(-age)),
+			Labels:            map[string]string{"chaos.gushchin.dev/experiment": "budget"},
+		},
+		Spec: chaosv1alpha1.ChaosExperimentHistorySpec{
+			ExperimentRef:  chaosv1alpha1.ObjectReference{Name: "budget", Namespace: "default"},
+			ExperimentSpec: chaosv1alpha1.ChaosExperimentSpec{Action: "pod-kill", Namespace: "default"},
+			Execution:      chaosv1alpha1.ExecutionDetails{Status: "success", Message: "Killed 2 pod(s)"},
+			AffectedResources: []chaosv1alpha1.ResourceReference{
+				{Kind: "Pod", Name: "web-1", Namespace: "default", Action: "deleted"},
+				{Kind: "Pod", Name: "web-2", Namespace: "default", Action: "deleted"},
+			},
+			Snapshot: &chaosv1alpha1.ResourceSnapshot{
+				Events: []chaosv1alpha1.CapturedEvent{{Object: "Pod/web-1", Message: strings.Repeat("x", padding)}},
+			},
+		},
+	}
+}
+
+func TestCleanupOldHistoryRecords_SizeBudget(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = chaosv1alpha1.AddToScheme(scheme)
+	ctx := context.Background()
+
+	records := []client.Object{
+		sizedHistoryRecord("budget-1", 4*time.Hour, 4000),
+		sizedHistoryRecord("budget-2", 3*time.Hour, 4000),
+		sizedHistoryRecord("budget-3", 2*time.Hour, 4000),
+		sizedHistoryRecord("budget-4", time.Hour, 4000),
+	}
+	// Records of an experiment with the same name in another namespace are not counted
+	other := sizedHistoryRecord("other-budget", 5*time.Hour, 4000)
+	other.Spec.ExperimentRef.Namespace = "staging"
+	records = append(records, other)
+
+	k8sClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(records...).Build()
+	r := &ChaosExperimentReconciler{
+		Client: k8sClient,
+		HistoryConfig: HistoryConfig{
+			RetentionLimit: 100,
+			// Room for two full records and two compacted ones
+			SizeBudget: 12000,
+		},
+	}
+	exp := &chaosv1alpha1.ChaosExperiment{ObjectMeta: metav1.ObjectMeta{Name: "budget", Namespace: "default"}}
+	r.cleanupOldHistoryRecords(ctx, exp, testHistoryNamespace)
+
+	get := func(name string) *chaosv1alpha1.ChaosExperimentHistory {
+		record := &chaosv1alpha1.ChaosExperimentHistory{}
+		if err := k8sClient.Get(ctx, client.ObjectKey{Namespace: testHistoryNamespace, Name: name}, record); err != nil {
+			return nil
+		}
+		return record
+	}
+
+	newest := get("budget-4")
+	require.NotNil(t, newest)
+	assert.Nil(t, newest.Spec.Compaction, "the newest record stays as written")
+	assert.NotNil(t, newest.Spec.Snapshot)
+
+	compacted := get("budget-1")
+	require.NotNil(t, compacted, "old records are compacted before any is deleted")
+	assert.NotNil(t, get("budget-2").Spec.Compaction)
+	require.NotNil(t, compacted.Spec.Compaction)
+	assert.Equal(t, map[string]int{"Pod/deleted": 2}, compacted.Spec.Compaction.AffectedResources)
+	assert.Equal(t, []string{"affectedResources", "snapshot"}, compacted.Spec.Compaction.Removed)
+	assert.Greater(t, compacted.Spec.Compaction.OriginalSize, 4000)
+	assert.Nil(t, compacted.Spec.AffectedResources)
+	assert.Nil(t, compacted.Spec.Snapshot)
+	assert.Equal(t, "Killed 2 pod(s)", compacted.Spec.Execution.Message)
+	assert.Nil(t, get("budget-3").Spec.Compaction, "compaction stops once the budget is met")
+
+	assert.Nil(t, get("other-budget").Spec.Compaction)
+
+	// A budget smaller than the newest record deletes everything else
+	r.HistoryConfig.SizeBudget = 100
+	r.cleanupOldHistoryRecords(ctx, exp, testHistoryNamespace)
+	for _, name := range []string{"budget-1", "budget-2", "budget-3"} {
+		assert.Nil(t, get(name), "expected %s to be deleted", name)
+	}
+	assert.NotNil(t, get("budget-4"))
+}
+
+func TestCompactHistoryRecord_Signed(t *testing.T) {
+	key := &signing.Key{Algorithm: signing.AlgorithmHMAC, HMACSecret: []byte("secret")}
+	record := sizedHistoryRecord("signed", time.Hour, 100)
+	require.NoError(t, signing.Sign(key, record))
+
+	// A controller without the key leaves signed records alone
+	r := &ChaosExperimentReconciler{}
+	assert.False(t, r.compactHistoryRecord(record, 500, time.Now()))
+	assert.NotNil(t, record.Spec.Snapshot)
+
+	r.HistoryConfig.SigningKey = key
+	require.True(t, r.compactHistoryRecord(record, 500, time.Now()))
+	assert.NoError(t, signing.Verify(key, record), "compacted records are signed again")
+	assert.False(t, r.compactHistoryRecord(record, 500, time.Now()), "records are compacted once")
+}
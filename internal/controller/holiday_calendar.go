@@ -0,0 +1,127 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	chaosv1alpha1 "github.com/neogan74/k8s-chaos/api/v1alpha1"
+	"github.com/neogan74/k8s-chaos/internal/holidays"
+)
+
+const (
+	// holidayFeedRefresh is how long a holiday calendar fetched from a URL is used; public feeds
+	// rarely change and should not be downloaded on every reconcile
+	holidayFeedRefresh = 6 * time.Hour
+	// holidayConfigMapRefresh is how long a holiday calendar read from a ConfigMap is used, so
+	// added freeze days take effect quickly
+	holidayConfigMapRefresh = time.Minute
+	// holidayCalendarRetry is when an experiment blocked by an unreadable calendar looks again
+	holidayCalendarRetry = time.Minute
+)
+
+// holidayGate checks the holiday calendars of the ChaosPolicies covering the experiment's target
+// namespace. It returns why chaos is blocked, or "" when it is not, and when to look again.
+// A calendar that was never read successfully blocks chaos too, since its days are unknown.
+func (r *ChaosExperimentReconciler) holidayGate(ctx context.Context, exp *chaosv1alpha1.ChaosExperiment, now time.Time) (string, time.Time, error) {
+	log := ctrl.LoggerFrom(ctx)
+	policies := &chaosv1alpha1.ChaosPolicyList{}
+	if err := r.List(ctx, policies); err != nil {
+		return "", time.Time{}, fmt.Errorf("failed to list chaos policies: %w", err)
+	}
+
+	for i := range policies.Items {
+		policy := &policies.Items[i]
+		if policy.DeletionTimestamp != nil || !policy.AppliesTo(exp.Spec.Namespace) {
+			continue
+		}
+		for j := range policy.Spec.HolidayCalendars {
+			cal := &policy.Spec.HolidayCalendars[j]
+			calendar, err := r.holidayCalendar(ctx, cal, now)
+			if err != nil {
+				if calendar == nil {
+					return fmt.Sprintf("Blocked until holiday calendar %q of ChaosPolicy %q can be read: %v",
+						cal.Name, policy.Name, err), now.Add(holidayCalendarRetry), nil
+				}
+				log.Error(err, "Failed to refresh holiday calendar, using the copy read before",
+					"policy", policy.Name, "calendar", cal.Name)
+			}
+			holiday, ok := calendar.At(now)
+			if !ok {
+				continue
+			}
+			name := holiday.Name
+			if name == "" {
+				name = holiday.Start.Format(time.DateOnly)
+			}
+			return fmt.Sprintf("Blocked by %q of holiday calendar %q (ChaosPolicy %q) until %s",
+				name, cal.Name, policy.Name, holiday.End.Format(time.RFC3339)), holiday.End, nil
+		}
+	}
+	return "", time.Time{}, nil
+}
+
+// holidayCalendar returns the parsed calendar, read through the holiday caches
+func (r *ChaosExperimentReconciler) holidayCalendar(ctx context.Context, cal *chaosv1alpha1.HolidayCalendar, now time.Time) (holidays.Calendar, error) {
+	loc, err := time.LoadLocation(cal.Timezone)
+	if err != nil {
+		return nil, fmt.Errorf("invalid timezone %q: %w", cal.Timezone, err)
+	}
+
+	switch {
+	case (cal.URL == "") == (cal.ConfigMapRef == nil):
+		return nil, errors.New("exactly one of url and configMapRef must be set")
+	case cal.URL != "":
+		key := cal.URL + "|" + loc.String()
+		return r.holidayFeeds.Get(ctx, key, holidays.URLSource(nil, cal.URL), loc, now)
+	default:
+		ref := *cal.ConfigMapRef
+		if ref.Key == "" {
+			ref.Key = "calendar"
+		}
+		key := fmt.Sprintf("%s/%s/%s|%s", ref.Namespace, ref.Name, ref.Key, loc)
+		return r.holidayConfigMaps.Get(ctx, key, r.configMapCalendarSource(ref), loc, now)
+	}
+}
+
+// configMapCalendarSource reads a calendar from a ConfigMap key. ConfigMaps are read from the API
+// server when APIReader is set, so the controller does not cache every ConfigMap of the cluster.
+func (r *ChaosExperimentReconciler) configMapCalendarSource(ref chaosv1alpha1.CalendarConfigMapRef) holidays.Source {
+	return func(ctx context.Context) (string, error) {
+		var reader client.Reader = r.Client
+		if r.APIReader != nil {
+			reader = r.APIReader
+		}
+		cm := &corev1.ConfigMap{}
+		if err := reader.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, cm); err != nil {
+			return "", fmt.Errorf("failed to get ConfigMap %s/%s: %w", ref.Namespace, ref.Name, err)
+		}
+		data, ok := cm.Data[ref.Key]
+		if !ok {
+			return "", fmt.Errorf("ConfigMap %s/%s has no key %q", ref.Namespace, ref.Name, ref.Key)
+		}
+		return data, nil
+	}
+}
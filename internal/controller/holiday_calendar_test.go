@@ -0,0 +1,147 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	chaosv1alpha1 "github.com/neogan74/k8s-chaos/api/v1alpha1"
+)
+
+func TestReconcile_BlockedByHolidayCalendar(t *testing.T) {
+	ctx := context.Background()
+	exp := rateLimitTestExperiment("kill")
+	today := time.Now().UTC().Format(time.DateOnly)
+	calendar := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "freeze-days", Namespace: "chaos-system"},
+		Data:       map[string]string{"calendar": today + " Release freeze\n"},
+	}
+	policy := &chaosv1alpha1.ChaosPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "holidays"},
+		Spec: chaosv1alpha1.ChaosPolicySpec{
+			HolidayCalendars: []chaosv1alpha1.HolidayCalendar{{
+				Name:         "company",
+				ConfigMapRef: &chaosv1alpha1.CalendarConfigMapRef{Namespace: "chaos-system", Name: "freeze-days"},
+			}},
+		},
+	}
+	objs := []client.Object{exp, policy, calendar, &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "shop"}}}
+	for _, name := range []string{"web-1", "web-2"} {
+		objs = append(objs, &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "shop", Labels: map[string]string{"app": "web"}}})
+	}
+	r := newReconcilerWithObjects(t, objs...)
+
+	result, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: client.ObjectKeyFromObject(exp)})
+	require.NoError(t, err)
+	assert.Positive(t, result.RequeueAfter)
+	assert.LessOrEqual(t, result.RequeueAfter, 24*time.Hour, "the experiment looks again when the freeze day ends")
+	pods := &corev1.PodList{}
+	require.NoError(t, r.List(ctx, pods, client.InNamespace("shop")))
+	assert.Len(t, pods.Items, 2)
+
+	updated := fetchExperiment(t, r, exp.Name, exp.Namespace)
+	condition := meta.FindStatusCondition(updated.Status.Conditions, "BlockedByTimeWindow")
+	require.NotNil(t, condition)
+	assert.Contains(t, condition.Message, `Blocked by "Release freeze" of holiday calendar "company" (ChaosPolicy "holidays")`)
+
+	// Once the day is gone from the calendar, chaos runs and the condition is cleared
+	calendar.Data["calendar"] = "# no freeze days\n"
+	require.NoError(t, r.Update(ctx, calendar))
+	_, err = r.Reconcile(ctx, ctrl.Request{NamespacedName: client.ObjectKeyFromObject(exp)})
+	require.NoError(t, err)
+	require.NoError(t, r.List(ctx, pods, client.InNamespace("shop")))
+	assert.Len(t, pods.Items, 1)
+	updated = fetchExperiment(t, r, exp.Name, exp.Namespace)
+	assert.Nil(t, meta.FindStatusCondition(updated.Status.Conditions, "BlockedByTimeWindow"))
+}
+
+func TestHolidayGate(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 12, 25, 10, 0, 0, 0, time.UTC)
+	feed := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte("BEGIN:VCALENDAR\r\nBEGIN:VEVENT\r\nDTSTART;VALUE=DATE:20261225\r\n" +
+			"SUMMARY:Christmas Day\r\nEND:VEVENT\r\nEND:VCALENDAR\r\n"))
+	}))
+	defer feed.Close()
+
+	tests := []struct {
+		name     string
+		policy   chaosv1alpha1.ChaosPolicySpec
+		expected string
+		until    time.Time
+	}{
+		{
+			name: "public holiday feed",
+			policy: chaosv1alpha1.ChaosPolicySpec{HolidayCalendars: []chaosv1alpha1.HolidayCalendar{
+				{Name: "public", URL: feed.URL, Timezone: "Europe/Berlin"},
+			}},
+			expected: `Blocked by "Christmas Day" of holiday calendar "public"`,
+			until:    time.Date(2026, 12, 25, 23, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "policy for other namespaces",
+			policy: chaosv1alpha1.ChaosPolicySpec{
+				Namespaces:       []string{"prod"},
+				HolidayCalendars: []chaosv1alpha1.HolidayCalendar{{Name: "public", URL: feed.URL}},
+			},
+		},
+		{
+			name: "missing ConfigMap",
+			policy: chaosv1alpha1.ChaosPolicySpec{HolidayCalendars: []chaosv1alpha1.HolidayCalendar{
+				{Name: "company", ConfigMapRef: &chaosv1alpha1.CalendarConfigMapRef{Namespace: "chaos-system", Name: "missing"}},
+			}},
+			expected: `Blocked until holiday calendar "company" of ChaosPolicy "holidays" can be read`,
+			until:    now.Add(holidayCalendarRetry),
+		},
+		{
+			name: "both sources",
+			policy: chaosv1alpha1.ChaosPolicySpec{HolidayCalendars: []chaosv1alpha1.HolidayCalendar{
+				{Name: "both", URL: feed.URL, ConfigMapRef: &chaosv1alpha1.CalendarConfigMapRef{Namespace: "chaos-system", Name: "missing"}},
+			}},
+			expected: "exactly one of url and configMapRef must be set",
+			until:    now.Add(holidayCalendarRetry),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			policy := &chaosv1alpha1.ChaosPolicy{ObjectMeta: metav1.ObjectMeta{Name: "holidays"}, Spec: tt.policy}
+			r := newReconcilerWithObjects(t, policy)
+			exp := &chaosv1alpha1.ChaosExperiment{Spec: chaosv1alpha1.ChaosExperimentSpec{Namespace: "staging"}}
+
+			reason, until, err := r.holidayGate(ctx, exp, now)
+			require.NoError(t, err)
+			if tt.expected == "" {
+				assert.Empty(t, reason)
+				return
+			}
+			assert.Contains(t, reason, tt.expected)
+			assert.True(t, until.Equal(tt.until), "expected to look again at %s, got %s", tt.until, until)
+		})
+	}
+}
@@ -0,0 +1,135 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	chaosv1alpha1 "github.com/neogan74/k8s-chaos/api/v1alpha1"
+)
+
+// serviceAccountUserPrefix starts the username of every ServiceAccount
+const serviceAccountUserPrefix = "system:serviceaccount:"
+
+// actor is the identity the destructive operations of a reconcile run as
+type actor struct {
+	client.Client
+	// config is the impersonating rest config, used for pods/exec
+	config *rest.Config
+}
+
+// actorKey is the context key of the *actor of a reconcile
+type actorKey struct{}
+
+// impersonator builds and caches a client per impersonated ServiceAccount
+type impersonator struct {
+	config    *rest.Config
+	newClient func(*rest.Config) (client.Client, error)
+
+	mu     sync.Mutex
+	actors map[string]*actor
+}
+
+func newImpersonator(config *rest.Config, opts client.Options) *impersonator {
+	return &impersonator{
+		config:    config,
+		newClient: func(cfg *rest.Config) (client.Client, error) { return client.New(cfg, opts) },
+		actors:    map[string]*actor{},
+	}
+}
+
+// actorFor returns the actor impersonating username. The API server adds the ServiceAccount
+// groups itself when no groups are impersonated, so bindings to system:serviceaccounts:<ns> apply.
+func (i *impersonator) actorFor(username string) (*actor, error) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	if a, ok := i.actors[username]; ok {
+		return a, nil
+	}
+	cfg := rest.CopyConfig(i.config)
+	cfg.Impersonate = rest.ImpersonationConfig{UserName: username}
+	c, err := i.newClient(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create client impersonating %s: %w", username, err)
+	}
+	a := &actor{Client: c, config: cfg}
+	i.actors[username] = a
+	return a, nil
+}
+
+// actAsInitiator returns ctx with the experiment's initiator as the actor of its destructive
+// operations when ImpersonateInitiator is set. The initiator is the initiated-by annotation the
+// mutating webhook records at admission; it must be a ServiceAccount, since a user's groups are
+// not recorded and could not be impersonated faithfully. Experiments created by the controller's
+// own ServiceAccount, e.g. through the REST API, are refused: acting as it would bypass the check.
+func (r *ChaosExperimentReconciler) actAsInitiator(ctx context.Context, exp *chaosv1alpha1.ChaosExperiment) (context.Context, error) {
+	if !r.ImpersonateInitiator {
+		return ctx, nil
+	}
+	initiator := exp.Annotations[chaosv1alpha1.InitiatedByAnnotation]
+	if initiator == "" {
+		return ctx, fmt.Errorf("experiment has no %s annotation to impersonate; is the mutating webhook installed?",
+			chaosv1alpha1.InitiatedByAnnotation)
+	}
+	namespace, name, ok := strings.Cut(strings.TrimPrefix(initiator, serviceAccountUserPrefix), ":")
+	if !strings.HasPrefix(initiator, serviceAccountUserPrefix) || !ok || namespace == "" || name == "" {
+		return ctx, fmt.Errorf("experiment was created by %q, not a ServiceAccount, and cannot be run with impersonation enabled",
+			initiator)
+	}
+	if initiator == r.ControllerUsername {
+		return ctx, fmt.Errorf("experiment was created by the controller's own ServiceAccount %q; configure a ServiceAccount "+
+			"for the client that created it to run it with impersonation enabled", initiator)
+	}
+	if r.impersonator == nil {
+		return ctx, fmt.Errorf("impersonation is enabled but not set up")
+	}
+	a, err := r.impersonator.actorFor(initiator)
+	if err != nil {
+		return ctx, err
+	}
+	return context.WithValue(ctx, actorKey{}, a), nil
+}
+
+// writer returns the client destructive operations go through: the initiator's when impersonating,
+// otherwise the controller's own, tagged when TagDestructiveRequests is set. Status, history,
+// finalizers and reverting injections use r.Client directly so an experiment can always be undone.
+func (r *ChaosExperimentReconciler) writer(ctx context.Context) client.Client {
+	if a, ok := ctx.Value(actorKey{}).(*actor); ok {
+		return a
+	}
+	if r.tagged != nil {
+		return r.tagged
+	}
+	return r.Client
+}
+
+// execConfig returns the rest config commands are executed in pods with
+func (r *ChaosExperimentReconciler) execConfig(ctx context.Context) *rest.Config {
+	if a, ok := ctx.Value(actorKey{}).(*actor); ok {
+		return a.config
+	}
+	if r.taggedConfig != nil {
+		return r.taggedConfig
+	}
+	return r.Config
+}
@@ -0,0 +1,124 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	chaosv1alpha1 "github.com/neogan74/k8s-chaos/api/v1alpha1"
+)
+
+// impersonatingReconciler impersonates initiators with a client that is forbidden to delete pods
+// and records the usernames it was built for
+func impersonatingReconciler(t *testing.T, objs ...client.Object) (*ChaosExperimentReconciler, *[]string) {
+	r := newReconcilerWithObjects(t, objs...)
+	r.ImpersonateInitiator = true
+	var users []string
+	r.impersonator = &impersonator{
+		config: &rest.Config{Host: "https://example.invalid"},
+		newClient: func(cfg *rest.Config) (client.Client, error) {
+			users = append(users, cfg.Impersonate.UserName)
+			return interceptor.NewClient(r.Client.(client.WithWatch), interceptor.Funcs{
+				Delete: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.DeleteOption) error {
+					return apierrors.NewForbidden(schema.GroupResource{Resource: "pods"}, obj.GetName(), nil)
+				},
+			}), nil
+		},
+		actors: map[string]*actor{},
+	}
+	return r, &users
+}
+
+func TestActAsInitiator(t *testing.T) {
+	r, users := impersonatingReconciler(t)
+	exp := &chaosv1alpha1.ChaosExperiment{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{
+		chaosv1alpha1.InitiatedByAnnotation: "system:serviceaccount:team-a:chaos",
+	}}}
+
+	ctx, err := r.actAsInitiator(context.Background(), exp)
+	require.NoError(t, err)
+	assert.NotSame(t, r.Client, r.writer(ctx))
+	assert.Equal(t, "system:serviceaccount:team-a:chaos", r.execConfig(ctx).Impersonate.UserName)
+	assert.Same(t, r.Client, r.writer(context.Background()))
+
+	// The client is reused across reconciles
+	_, err = r.actAsInitiator(context.Background(), exp)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"system:serviceaccount:team-a:chaos"}, *users)
+}
+
+func TestActAsInitiator_Refused(t *testing.T) {
+	r, _ := impersonatingReconciler(t)
+	for _, initiator := range []string{"", "alice@example.com", "system:serviceaccount:team-a"} {
+		exp := &chaosv1alpha1.ChaosExperiment{}
+		if initiator != "" {
+			exp.Annotations = map[string]string{chaosv1alpha1.InitiatedByAnnotation: initiator}
+		}
+		_, err := r.actAsInitiator(context.Background(), exp)
+		assert.Error(t, err, initiator)
+	}
+
+	// Experiments the controller created on behalf of someone else, e.g. through the REST API
+	r.ControllerUsername = "system:serviceaccount:chaos-system:controller-manager"
+	exp := &chaosv1alpha1.ChaosExperiment{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{
+		chaosv1alpha1.InitiatedByAnnotation: r.ControllerUsername,
+	}}}
+	_, err := r.actAsInitiator(context.Background(), exp)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "controller's own ServiceAccount")
+
+	// Without the option the controller acts as itself
+	r.ImpersonateInitiator = false
+	ctx, err := r.actAsInitiator(context.Background(), &chaosv1alpha1.ChaosExperiment{})
+	require.NoError(t, err)
+	assert.Same(t, r.Client, r.writer(ctx))
+}
+
+func TestImpersonation_PodKillForbidden(t *testing.T) {
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "team-b", Labels: map[string]string{"app": "web"}},
+		Status:     corev1.PodStatus{Phase: corev1.PodRunning},
+	}
+	exp := &chaosv1alpha1.ChaosExperiment{
+		ObjectMeta: metav1.ObjectMeta{Name: "kill", Namespace: "team-a", Annotations: map[string]string{
+			chaosv1alpha1.InitiatedByAnnotation: "system:serviceaccount:team-a:chaos",
+		}},
+		Spec: chaosv1alpha1.ChaosExperimentSpec{
+			Action: "pod-kill", Namespace: "team-b", Selector: map[string]string{"app": "web"}, Count: 1,
+		},
+	}
+	r, _ := impersonatingReconciler(t, pod, exp)
+	ctx, err := r.actAsInitiator(context.Background(), exp)
+	require.NoError(t, err)
+
+	_, _ = r.handlePodKill(ctx, exp)
+	assert.Contains(t, exp.Status.Message, "failed to kill any pods")
+
+	// The pod outlives an experiment whose creator may not delete it
+	require.NoError(t, r.Get(context.Background(), client.ObjectKeyFromObject(pod), &corev1.Pod{}))
+}
@@ -0,0 +1,249 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	utilexec "k8s.io/client-go/util/exec"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	chaosv1alpha1 "github.com/neogan74/k8s-chaos/api/v1alpha1"
+	chaosmetrics "github.com/neogan74/k8s-chaos/internal/metrics"
+)
+
+const (
+	// probeAttempts is how many times a probe looks for the fault, a second apart, before the
+	// injection counts as unverified. Memory stress and disk fill need a few seconds to build up.
+	probeAttempts = 10
+	// maxConcurrentProbes bounds the execs a reconcile runs at once
+	maxConcurrentProbes = 10
+)
+
+// Values of TargetResult.Verification
+const (
+	injectionVerified   = "Verified"
+	injectionUnverified = "Unverified"
+)
+
+// probeScript wraps probe, a shell function printing why the fault is not in place and returning
+// non-zero, into a script that retries it for up to probeAttempts seconds. The script exits 0 once
+// the probe passes and 1 with the last reason otherwise.
+func probeScript(probe string) string {
+	return probe + fmt.Sprintf(`attempt=1
+while :; do
+  reason=$(probe) && exit 0
+  [ "$attempt" -ge %d ] && break
+  attempt=$((attempt + 1))
+  sleep 1
+done
+echo "$reason"
+exit 1
+`, probeAttempts)
+}
+
+// netemProbe checks that every default-route interface has a root netem qdisc applying effect,
+// such as "loss" or "corrupt"
+func netemProbe(effect string) string {
+	return probeScript(netemInterfaces + fmt.Sprintf(`probe() {
+  for dev in $devs; do
+    qdisc=$(tc qdisc show dev "$dev" root 2>&1)
+    case "$qdisc" in
+      *netem*%[1]s*) ;;
+      *) echo "no netem %[1]s qdisc on $dev: $qdisc"; return 1 ;;
+    esac
+  done
+}
+`, effect))
+}
+
+// partitionProbe checks that the partition chains are hooked into the filter table of every
+// family the rules cover
+func partitionProbe(rules partitionRules) string {
+	tools := make([]string, 0, len(rules.families()))
+	for _, family := range rules.families() {
+		tools = append(tools, family.Iptables)
+	}
+	return probeScript(fmt.Sprintf(`probe() {
+  for ipt in %s; do
+    if ! "$ipt" -S 2>/dev/null | grep -q -- "-j CHAOS_PART_"; then
+      echo "no partition chain in the $ipt filter table"
+      return 1
+    fi
+  done
+}
+`, strings.Join(tools, " ")))
+}
+
+// cpuStressProbe checks that the stressor's cgroup used at least half of the planned CPU over one
+// second. The injected container has its own cgroup, so the application's load does not count.
+func cpuStressProbe(plan cpuStressPlan) string {
+	expected := int64(plan.Load) * int64(plan.Workers) * 10
+	return probeScript(fmt.Sprintf(`cpu_usec() {
+  if [ -r /sys/fs/cgroup/cpu.stat ]; then
+    while read -r key value; do
+      [ "$key" = usage_usec ] && echo "$value" && return 0
+    done < /sys/fs/cgroup/cpu.stat
+  fi
+  for f in /sys/fs/cgroup/cpuacct/cpuacct.usage /sys/fs/cgroup/cpu,cpuacct/cpuacct.usage; do
+    [ -r "$f" ] && echo $(($(cat "$f") / 1000)) && return 0
+  done
+  return 1
+}
+probe() {
+  before=$(cpu_usec) || { echo "cannot read the CPU usage of the stressor's cgroup"; return 1; }
+  sleep 1
+  after=$(cpu_usec) || { echo "cannot read the CPU usage of the stressor's cgroup"; return 1; }
+  used=$(((after - before) / 1000))
+  [ "$used" -ge %[1]d ] && return 0
+  echo "stressor used ${used}m CPU, expected about %[2]dm"
+  return 1
+}
+`, expected/2, expected))
+}
+
+// memoryStressProbe checks that the stressor's cgroup holds at least half of the memory its
+// workers allocate. It returns no probe when size cannot be parsed.
+func memoryStressProbe(size string, workers int) string {
+	perWorker, err := memorySizeBytes(size)
+	if err != nil {
+		return ""
+	}
+	expectedBytes := perWorker * int64(workers)
+	return probeScript(fmt.Sprintf(`memory_bytes() {
+  for f in /sys/fs/cgroup/memory.current /sys/fs/cgroup/memory/memory.usage_in_bytes; do
+    [ -r "$f" ] && cat "$f" && return 0
+  done
+  return 1
+}
+probe() {
+  used=$(memory_bytes) || { echo "cannot read the memory usage of the stressor's cgroup"; return 1; }
+  [ "$used" -ge %[1]d ] && return 0
+  echo "stressor holds $((used / 1048576))Mi, expected about %[2]dMi"
+  return 1
+}
+`, expectedBytes/2, expectedBytes/(1<<20)))
+}
+
+// diskFillProbe checks that the fill file in targetPath has grown
+func diskFillProbe(targetPath string) string {
+	return probeScript(fmt.Sprintf(`probe() {
+  [ -s %[1]q/%[2]s ] && return 0
+  echo "%[2]s in %[1]s is empty; the filesystem may already be above fillPercentage"
+  return 1
+}
+`, targetPath, diskFillFile))
+}
+
+// runInjectionProbe runs the probe script in the injected container. It returns whether the probe
+// passed and, when it did not, its output; err is set when the probe could not be run at all.
+func (r *ChaosExperimentReconciler) runInjectionProbe(ctx context.Context, pod types.NamespacedName, container, script string) (bool, string, error) {
+	exec := r.probeExec
+	if exec == nil {
+		if r.Clientset == nil {
+			return false, "", fmt.Errorf("no clientset to exec into pod %s", pod)
+		}
+		exec = func(ctx context.Context, pod types.NamespacedName, container string, command []string) (string, string, error) {
+			return r.execInPod(ctx, pod.Namespace, pod.Name, container, command)
+		}
+	}
+	stdout, stderr, err := exec(ctx, pod, container, []string{"/bin/sh", "-c", script})
+	if err == nil {
+		return true, "", nil
+	}
+	var exitErr utilexec.ExitError
+	if !errors.As(err, &exitErr) {
+		return false, "", err
+	}
+	output := strings.TrimSpace(stdout)
+	if output == "" {
+		output = strings.TrimSpace(stderr)
+	}
+	if output == "" {
+		output = exitErr.Error()
+	}
+	return false, output, nil
+}
+
+// probeInjections runs the probes of the injected containers that are running, at most
+// maxConcurrentProbes at a time, and records the outcome in status.targetResults; indexes maps each
+// injected container to its result. A probe that cannot be run at all, for instance because exec
+// is forbidden, leaves the injection unverified too.
+func (r *ChaosExperimentReconciler) probeInjections(ctx context.Context, exp *chaosv1alpha1.ChaosExperiment, injected []injectedContainer, indexes []int) {
+	if !r.VerifyInjections {
+		return
+	}
+	log := ctrl.LoggerFrom(ctx)
+
+	var wg sync.WaitGroup
+	slots := make(chan struct{}, maxConcurrentProbes)
+	for n, target := range injected {
+		if target.Probe == "" || indexes[n] < 0 || exp.Status.TargetResults[indexes[n]].State != targetRunning {
+			continue
+		}
+		result := &exp.Status.TargetResults[indexes[n]]
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			slots <- struct{}{}
+			defer func() { <-slots }()
+
+			verified, output, err := r.runInjectionProbe(ctx, target.Pod, target.Container, target.Probe)
+			if err != nil {
+				log.Error(err, "Failed to probe injected container", "pod", target.Pod, "container", target.Container)
+				result.Verification = injectionUnverified
+				result.VerificationMessage = "probe could not run: " + err.Error()
+				return
+			}
+			if verified {
+				result.Verification = injectionVerified
+				result.VerificationMessage = ""
+				return
+			}
+			result.Verification = injectionUnverified
+			result.VerificationMessage = output
+		}()
+	}
+	wg.Wait()
+
+	for n, target := range injected {
+		if target.Probe == "" || indexes[n] < 0 {
+			continue
+		}
+		result := &exp.Status.TargetResults[indexes[n]]
+		if result.Verification == "" {
+			continue
+		}
+		chaosmetrics.InjectionVerifications.WithLabelValues(exp.Spec.Action, exp.Spec.Namespace, strings.ToLower(result.Verification)).Inc()
+		if result.Verification != injectionUnverified {
+			continue
+		}
+		pod := &corev1.Pod{}
+		pod.Name, pod.Namespace = target.Pod.Name, target.Pod.Namespace
+		result.VerificationMessage = r.podRedactor(pod).Scrub(result.VerificationMessage)
+		log.Info("Injected fault not found in target", "pod", target.Pod, "container", target.Container,
+			"reason", result.VerificationMessage)
+		r.Recorder.Eventf(pod, corev1.EventTypeWarning, "ChaosInjectionUnverified",
+			"Container %s runs, but its fault was not found: %s", target.Container, result.VerificationMessage)
+	}
+}
@@ -0,0 +1,129 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	utilexec "k8s.io/client-go/util/exec"
+
+	chaosv1alpha1 "github.com/neogan74/k8s-chaos/api/v1alpha1"
+)
+
+func TestProbeInjections(t *testing.T) {
+	running := corev1.ContainerState{Running: &corev1.ContainerStateRunning{}}
+	r := newReconcilerWithObjects(t,
+		injectedPod("verified", "network-loss-1", running),
+		injectedPod("unverified", "network-loss-2", running),
+		injectedPod("forbidden", "network-loss-3", running),
+		injectedPod("unprobed", "network-loss-4", running),
+	)
+	r.VerifyInjections = true
+	r.probeExec = func(_ context.Context, pod types.NamespacedName, container string, command []string) (string, string, error) {
+		require.Equal(t, []string{"/bin/sh", "-c", "probe"}, command)
+		switch pod.Name {
+		case "verified":
+			return "", "", nil
+		case "unverified":
+			return "no netem loss qdisc on eth0: qdisc noqueue 0: root refcnt 2\n", "",
+				utilexec.CodeExitError{Err: errors.New("command terminated with exit code 1"), Code: 1}
+		default:
+			return "", "", errors.New(`pods "forbidden" is forbidden: cannot create resource "pods/exec"`)
+		}
+	}
+	exp := &chaosv1alpha1.ChaosExperiment{Spec: chaosv1alpha1.ChaosExperimentSpec{Action: "pod-network-loss", Namespace: "default"}}
+
+	injected := []injectedContainer{
+		{Pod: types.NamespacedName{Namespace: "default", Name: "verified"}, Container: "network-loss-1", Probe: "probe"},
+		{Pod: types.NamespacedName{Namespace: "default", Name: "unverified"}, Container: "network-loss-2", Probe: "probe"},
+		{Pod: types.NamespacedName{Namespace: "default", Name: "forbidden"}, Container: "network-loss-3", Probe: "probe"},
+		{Pod: types.NamespacedName{Namespace: "default", Name: "unprobed"}, Container: "network-loss-4"},
+	}
+	affected, startErr := r.verifyInjections(context.Background(), exp, injected)
+	require.Nil(t, startErr)
+	assert.Len(t, affected, 4, "unverified injections still count as affected")
+
+	require.Len(t, exp.Status.TargetResults, 4)
+	assert.Equal(t, injectionVerified, exp.Status.TargetResults[0].Verification)
+	assert.Empty(t, exp.Status.TargetResults[0].VerificationMessage)
+	assert.Equal(t, injectionUnverified, exp.Status.TargetResults[1].Verification)
+	assert.Equal(t, "no netem loss qdisc on eth0: qdisc noqueue 0: root refcnt 2", exp.Status.TargetResults[1].VerificationMessage)
+	assert.Equal(t, injectionUnverified, exp.Status.TargetResults[2].Verification)
+	assert.Contains(t, exp.Status.TargetResults[2].VerificationMessage, "probe could not run")
+	assert.Empty(t, exp.Status.TargetResults[3].Verification)
+}
+
+func TestProbeInjections_Disabled(t *testing.T) {
+	r := newReconcilerWithObjects(t, injectedPod("web", "network-loss-1", corev1.ContainerState{Running: &corev1.ContainerStateRunning{}}))
+	r.probeExec = func(context.Context, types.NamespacedName, string, []string) (string, string, error) {
+		t.Fatal("probe must not run when verification is disabled")
+		return "", "", nil
+	}
+	exp := &chaosv1alpha1.ChaosExperiment{}
+
+	_, startErr := r.verifyInjections(context.Background(), exp, []injectedContainer{
+		{Pod: types.NamespacedName{Namespace: "default", Name: "web"}, Container: "network-loss-1", Probe: "probe"},
+	})
+	require.Nil(t, startErr)
+	assert.Empty(t, exp.Status.TargetResults[0].Verification)
+}
+
+func TestDiskFillProbe(t *testing.T) {
+	sh, err := exec.LookPath("sh")
+	if err != nil {
+		t.Skip("no sh")
+	}
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, diskFillFile), []byte("filled"), 0o600))
+
+	out, err := exec.Command(sh, "-c", diskFillProbe(dir)).CombinedOutput()
+	assert.NoError(t, err, string(out))
+}
+
+func TestProbeScripts(t *testing.T) {
+	rules := partitionRules{Families: []ipFamily{ipv4Family, ipv6Family}}
+	scripts := map[string]string{
+		"netem":     netemProbe("corrupt"),
+		"partition": partitionProbe(rules),
+		"cpu":       cpuStressProbe(cpuStressPlan{Load: 80, Workers: 2}),
+		"memory":    memoryStressProbe("256M", 2),
+		"disk fill": diskFillProbe("/data"),
+	}
+	assert.Contains(t, scripts["netem"], "*netem*corrupt*")
+	assert.Contains(t, scripts["partition"], "for ipt in iptables ip6tables; do")
+	assert.Contains(t, scripts["cpu"], `[ "$used" -ge 800 ]`)
+	assert.Contains(t, scripts["memory"], `[ "$used" -ge 268435456 ]`)
+	assert.Empty(t, memoryStressProbe("lots", 1))
+
+	sh, err := exec.LookPath("sh")
+	if err != nil {
+		return
+	}
+	for name, script := range scripts {
+		out, err := exec.Command(sh, "-n", "-c", script).CombinedOutput()
+		assert.NoError(t, err, "%s: %s", name, out)
+	}
+}
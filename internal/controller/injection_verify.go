@@ -0,0 +1,257 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	chaosv1alpha1 "github.com/neogan74/k8s-chaos/api/v1alpha1"
+)
+
+const (
+	// defaultEphemeralStartTimeout bounds how long a reconcile waits for injected containers to run
+	defaultEphemeralStartTimeout = 30 * time.Second
+	// ephemeralStartPollInterval is how often the targets are read while waiting
+	ephemeralStartPollInterval = time.Second
+	// maxInjectionRestarts is how many times a failed injection is retried on the same pod
+	maxInjectionRestarts = 3
+)
+
+// Values of TargetResult.State
+const (
+	targetPending   = "Pending"
+	targetRunning   = "Running"
+	targetSucceeded = "Succeeded"
+	targetFailed    = "Failed"
+)
+
+// injectedContainer is an ephemeral container injected by the current reconcile
+type injectedContainer struct {
+	Pod       types.NamespacedName
+	Container string
+	// Probe is the script checking in the container that its fault is in place; optional
+	Probe string
+}
+
+// targetStartError is an injected container that could not start or exited with an error
+type targetStartError struct {
+	Result chaosv1alpha1.TargetResult
+}
+
+func (e *targetStartError) Error() string {
+	msg := fmt.Sprintf("container %s in pod %s", e.Result.Container, e.Result.Pod)
+	if e.Result.ExitCode != nil {
+		msg += fmt.Sprintf(" exited with code %d", *e.Result.ExitCode)
+	} else {
+		msg += " did not start"
+	}
+	if e.Result.Reason != "" {
+		msg += " (" + e.Result.Reason + ")"
+	}
+	if e.Result.Message != "" {
+		msg += ": " + e.Result.Message
+	}
+	return msg
+}
+
+// ephemeralStartTimeout returns how long to wait for injected containers to run
+func (r *ChaosExperimentReconciler) ephemeralStartTimeout() time.Duration {
+	if r.EphemeralStartTimeout > 0 {
+		return r.EphemeralStartTimeout
+	}
+	return defaultEphemeralStartTimeout
+}
+
+// readTargetResult updates result from the status of its container in pod. Image pull errors fail
+// the target right away; any other waiting container is still Pending.
+func readTargetResult(pod *corev1.Pod, result *chaosv1alpha1.TargetResult) {
+	for _, status := range pod.Status.EphemeralContainerStatuses {
+		if status.Name != result.Container {
+			continue
+		}
+		switch state := status.State; {
+		case state.Running != nil:
+			result.State = targetRunning
+			if !state.Running.StartedAt.IsZero() {
+				result.StartedAt = state.Running.StartedAt.DeepCopy()
+			}
+		case state.Terminated != nil:
+			exitCode := state.Terminated.ExitCode
+			result.ExitCode = &exitCode
+			result.Reason = state.Terminated.Reason
+			result.Message = state.Terminated.Message
+			result.State = targetSucceeded
+			if exitCode != 0 {
+				result.State = targetFailed
+			}
+		case state.Waiting != nil && imagePullWaitingReasons[state.Waiting.Reason]:
+			result.State = targetFailed
+			result.Reason = state.Waiting.Reason
+			result.Message = state.Waiting.Message
+		}
+		return
+	}
+}
+
+// targetResultIndex returns the index of the result of pod in the experiment status, or -1
+func targetResultIndex(exp *chaosv1alpha1.ChaosExperiment, pod types.NamespacedName) int {
+	for i := range exp.Status.TargetResults {
+		if exp.Status.TargetResults[i].Pod == pod.String() {
+			return i
+		}
+	}
+	return -1
+}
+
+// targetInjectable refreshes the result of pod from its status and reports whether a container
+// may be injected into it. A container that is still starting is waited for instead of being
+// injected again, and a pod whose injection failed maxInjectionRestarts times is left alone.
+func (r *ChaosExperimentReconciler) targetInjectable(ctx context.Context, exp *chaosv1alpha1.ChaosExperiment, pod *corev1.Pod) bool {
+	i := targetResultIndex(exp, client.ObjectKeyFromObject(pod))
+	if i < 0 {
+		return true
+	}
+	result := &exp.Status.TargetResults[i]
+	previous := result.State
+	if previous == targetPending || previous == targetRunning {
+		readTargetResult(pod, result)
+		result.Message = r.podRedactor(pod).Scrub(result.Message)
+	}
+	if result.State == targetFailed && previous != targetFailed {
+		// The container exited with an error after the reconcile that injected it
+		r.Recorder.Eventf(pod, corev1.EventTypeWarning, "ChaosInjectionFailed", "%s", (&targetStartError{Result: *result}).Error())
+	}
+
+	switch {
+	case result.State == targetPending:
+		ctrl.LoggerFrom(ctx).Info("Injected container is still starting, not injecting again",
+			"pod", pod.Name, "container", result.Container)
+		return false
+	case result.State == targetFailed && result.Restarts >= maxInjectionRestarts:
+		ctrl.LoggerFrom(ctx).Info("Injection failed too many times, not retrying",
+			"pod", pod.Name, "container", result.Container, "restarts", result.Restarts)
+		return false
+	}
+	return true
+}
+
+// verifyInjections waits until the containers injected by this reconcile run, up to the start
+// timeout, and records them in status.targetResults. It returns the pods whose container did not
+// fail, and the first failure once every target failed. Containers still pending at the timeout count as affected and are
+// checked again by the next reconcile; failed ones are injected again up to maxInjectionRestarts.
+func (r *ChaosExperimentReconciler) verifyInjections(ctx context.Context, exp *chaosv1alpha1.ChaosExperiment, injected []injectedContainer) ([]string, *ChaosError) {
+	log := ctrl.LoggerFrom(ctx)
+
+	// Point each pod's result at the new container
+	indexes := make([]int, len(injected))
+	for n, target := range injected {
+		indexes[n] = -1
+		if target.Container == "" {
+			continue
+		}
+		result := chaosv1alpha1.TargetResult{Pod: target.Pod.String(), Container: target.Container, State: targetPending}
+		i := targetResultIndex(exp, target.Pod)
+		if i < 0 {
+			exp.Status.TargetResults = append(exp.Status.TargetResults, result)
+			i = len(exp.Status.TargetResults) - 1
+		} else {
+			previous := exp.Status.TargetResults[i]
+			result.Restarts = previous.Restarts
+			if previous.State == targetFailed {
+				result.Restarts++
+			}
+			exp.Status.TargetResults[i] = result
+		}
+		indexes[n] = i
+	}
+
+	// deleted marks targets whose pod went away while waiting
+	deleted := make([]bool, len(injected))
+	err := wait.PollUntilContextTimeout(ctx, ephemeralStartPollInterval, r.ephemeralStartTimeout(), true,
+		func(ctx context.Context) (bool, error) {
+			done := true
+			for n, target := range injected {
+				if indexes[n] < 0 || deleted[n] || exp.Status.TargetResults[indexes[n]].State != targetPending {
+					continue
+				}
+				pod := &corev1.Pod{}
+				if err := r.Get(ctx, target.Pod, pod); err != nil {
+					if apierrors.IsNotFound(err) {
+						deleted[n] = true
+						continue
+					}
+					log.Error(err, "Failed to read injected container status", "pod", target.Pod)
+					done = false
+					continue
+				}
+				result := &exp.Status.TargetResults[indexes[n]]
+				readTargetResult(pod, result)
+				// Termination messages are often the tail of the stressor's output
+				result.Message = r.podRedactor(pod).Scrub(result.Message)
+				if result.State == targetPending {
+					done = false
+				}
+			}
+			return done, nil
+		})
+	if err != nil {
+		log.Info("Injected containers did not start in time, checking them again on the next reconcile",
+			"timeout", r.ephemeralStartTimeout())
+	}
+	r.probeInjections(ctx, exp, injected, indexes)
+
+	affected := []string{}
+	var startErr *ChaosError
+	for n, target := range injected {
+		if indexes[n] < 0 {
+			affected = append(affected, target.Pod.Name)
+			continue
+		}
+		result := exp.Status.TargetResults[indexes[n]]
+		if deleted[n] || result.State != targetFailed {
+			affected = append(affected, target.Pod.Name)
+			continue
+		}
+		failure := &targetStartError{Result: result}
+		log.Info("Injected container failed", "pod", target.Pod, "container", target.Container,
+			"reason", result.Reason, "restarts", result.Restarts)
+		pod := &corev1.Pod{}
+		pod.Name, pod.Namespace = target.Pod.Name, target.Pod.Namespace
+		r.Recorder.Eventf(pod, corev1.EventTypeWarning, "ChaosInjectionFailed", "%s", failure.Error())
+		if startErr == nil {
+			startErr = ClassifyError(failure)
+			startErr.Operation = "start injected container"
+		}
+	}
+
+	// The experiment only fails when no target, from this or an earlier reconcile, is healthy
+	for _, result := range exp.Status.TargetResults {
+		if result.State != targetFailed {
+			return affected, nil
+		}
+	}
+	return affected, startErr
+}
@@ -0,0 +1,161 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+
+	chaosv1alpha1 "github.com/neogan74/k8s-chaos/api/v1alpha1"
+)
+
+// injectedPod returns a pod whose ephemeral container is in state
+func injectedPod(name, container string, state corev1.ContainerState) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+		Status: corev1.PodStatus{EphemeralContainerStatuses: []corev1.ContainerStatus{{
+			Name:  container,
+			State: state,
+		}}},
+	}
+}
+
+func exitedState(code int32, message string) corev1.ContainerState {
+	return corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{ExitCode: code, Reason: "Error", Message: message}}
+}
+
+func TestReadTargetResult(t *testing.T) {
+	tests := []struct {
+		name   string
+		state  corev1.ContainerState
+		want   string
+		reason string
+	}{
+		{"running", corev1.ContainerState{Running: &corev1.ContainerStateRunning{}}, targetRunning, ""},
+		{"exited", corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{Reason: "Completed"}}, targetSucceeded, "Completed"},
+		{"failed", exitedState(1, "container lacks NET_ADMIN"), targetFailed, "Error"},
+		{"image pull", corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "ImagePullBackOff"}}, targetFailed, "ImagePullBackOff"},
+		{"creating", corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "ContainerCreating"}}, targetPending, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := chaosv1alpha1.TargetResult{Pod: "default/web", Container: "chaos-netloss-1", State: targetPending}
+			readTargetResult(injectedPod("web", "chaos-netloss-1", tt.state), &result)
+			assert.Equal(t, tt.want, result.State)
+			assert.Equal(t, tt.reason, result.Reason)
+		})
+	}
+
+	// No status yet: the kubelet has not seen the container
+	result := chaosv1alpha1.TargetResult{Pod: "default/web", Container: "chaos-netloss-2", State: targetPending}
+	readTargetResult(injectedPod("web", "chaos-netloss-1", exitedState(1, "")), &result)
+	assert.Equal(t, targetPending, result.State)
+	assert.Nil(t, result.ExitCode)
+}
+
+func TestVerifyInjections_FailedTarget(t *testing.T) {
+	pod := injectedPod("web", "chaos-netloss-1", exitedState(1, "container lacks NET_ADMIN; missing: tc"))
+	r := newReconcilerWithObjects(t, pod)
+	exp := &chaosv1alpha1.ChaosExperiment{}
+
+	affected, startErr := r.verifyInjections(context.Background(), exp,
+		[]injectedContainer{{Pod: types.NamespacedName{Namespace: "default", Name: "web"}, Container: "chaos-netloss-1"}})
+
+	assert.Empty(t, affected)
+	require.NotNil(t, startErr)
+	assert.Equal(t, ErrorTypeExecution, startErr.Type)
+	assert.Equal(t, "container chaos-netloss-1 in pod default/web exited with code 1 (Error): container lacks NET_ADMIN; missing: tc",
+		startErr.Original.Error())
+	assert.Contains(t, startErr.StatusMessage(), "kubectl logs -n default web -c chaos-netloss-1")
+	require.Len(t, exp.Status.TargetResults, 1)
+	assert.Equal(t, chaosv1alpha1.TargetResult{
+		Pod: "default/web", Container: "chaos-netloss-1", State: targetFailed,
+		ExitCode: ptr.To[int32](1), Reason: "Error", Message: "container lacks NET_ADMIN; missing: tc",
+	}, exp.Status.TargetResults[0])
+}
+
+func TestVerifyInjections_PartialFailureAndTimeout(t *testing.T) {
+	failed := injectedPod("web-1", "chaos-cpu-stress-2", exitedState(137, ""))
+	// The kubelet never reports the second container
+	starting := injectedPod("web-2", "chaos-cpu-stress-1", corev1.ContainerState{})
+	r := newReconcilerWithObjects(t, failed, starting)
+	r.EphemeralStartTimeout = 10 * time.Millisecond
+	exp := &chaosv1alpha1.ChaosExperiment{Status: chaosv1alpha1.ChaosExperimentStatus{
+		TargetResults: []chaosv1alpha1.TargetResult{{Pod: "default/web-1", Container: "chaos-cpu-stress-1", State: targetFailed, Restarts: 1}},
+	}}
+
+	affected, startErr := r.verifyInjections(context.Background(), exp, []injectedContainer{
+		{Pod: types.NamespacedName{Namespace: "default", Name: "web-1"}, Container: "chaos-cpu-stress-2"},
+		{Pod: types.NamespacedName{Namespace: "default", Name: "web-2"}, Container: "chaos-cpu-stress-1"},
+	})
+
+	// A pending target still counts, so the experiment does not fail
+	assert.Equal(t, []string{"web-2"}, affected)
+	assert.Nil(t, startErr)
+	require.Len(t, exp.Status.TargetResults, 2)
+	assert.Equal(t, targetFailed, exp.Status.TargetResults[0].State)
+	assert.Equal(t, "chaos-cpu-stress-2", exp.Status.TargetResults[0].Container)
+	assert.Equal(t, int32(2), exp.Status.TargetResults[0].Restarts)
+	assert.Equal(t, targetPending, exp.Status.TargetResults[1].State)
+}
+
+func TestVerifyInjections_ImagePull(t *testing.T) {
+	pod := injectedPod("web", "memory-stress-1", corev1.ContainerState{
+		Waiting: &corev1.ContainerStateWaiting{Reason: "ErrImagePull", Message: "not found"},
+	})
+	r := newReconcilerWithObjects(t, pod)
+	exp := &chaosv1alpha1.ChaosExperiment{}
+
+	_, startErr := r.verifyInjections(context.Background(), exp,
+		[]injectedContainer{{Pod: types.NamespacedName{Namespace: "default", Name: "web"}, Container: "memory-stress-1"}})
+
+	require.NotNil(t, startErr)
+	assert.Equal(t, ErrorTypeImagePull, startErr.Type)
+	assert.Equal(t, "container memory-stress-1 in pod default/web did not start (ErrImagePull): not found", startErr.Original.Error())
+}
+
+func TestTargetInjectable(t *testing.T) {
+	r := newReconcilerWithObjects(t)
+	ctx := context.Background()
+	exp := &chaosv1alpha1.ChaosExperiment{Status: chaosv1alpha1.ChaosExperimentStatus{
+		TargetResults: []chaosv1alpha1.TargetResult{
+			{Pod: "default/starting", Container: "disk-fill-1", State: targetPending},
+			{Pod: "default/crashed", Container: "disk-fill-1", State: targetRunning},
+			{Pod: "default/exhausted", Container: "disk-fill-4", State: targetFailed, Restarts: maxInjectionRestarts},
+		},
+	}}
+
+	assert.True(t, r.targetInjectable(ctx, exp, injectedPod("new", "", corev1.ContainerState{})))
+	assert.False(t, r.targetInjectable(ctx, exp, injectedPod("starting", "disk-fill-1",
+		corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "ContainerCreating"}})))
+	assert.False(t, r.targetInjectable(ctx, exp, injectedPod("exhausted", "disk-fill-4", exitedState(1, ""))))
+
+	// A container that exits early with an error is recorded and injected again
+	assert.True(t, r.targetInjectable(ctx, exp, injectedPod("crashed", "disk-fill-1", exitedState(1, "read-only file system"))))
+	assert.Equal(t, targetFailed, exp.Status.TargetResults[1].State)
+	assert.Equal(t, "read-only file system", exp.Status.TargetResults[1].Message)
+	assert.Contains(t, <-r.Recorder.(*record.FakeRecorder).Events, "ChaosInjectionFailed")
+}
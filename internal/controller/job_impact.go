@@ -0,0 +1,95 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	chaosv1alpha1 "github.com/neogan74/k8s-chaos/api/v1alpha1"
+)
+
+// Outcomes of a Job whose pods an execution affected
+const (
+	jobRunning  = "Running"
+	jobComplete = "Complete"
+	jobFailed   = "Failed"
+	jobMissing  = "Missing"
+)
+
+// jobImpact reports the current state of the Jobs among the workloads of the run's blast radius.
+// Jobs are read from the API server when APIReader is set, so the controller does not watch
+// every Job of the cluster for a field only experiments with includeJobPods use.
+func (r *ChaosExperimentReconciler) jobImpact(ctx context.Context, exp *chaosv1alpha1.ChaosExperiment) []chaosv1alpha1.JobImpact {
+	if exp.Status.BlastRadius == nil {
+		return nil
+	}
+	var reader client.Reader = r.Client
+	if r.APIReader != nil {
+		reader = r.APIReader
+	}
+
+	var impact []chaosv1alpha1.JobImpact
+	for _, workload := range exp.Status.BlastRadius.Workloads {
+		if workload.Kind != "Job" {
+			continue
+		}
+		job := &batchv1.Job{}
+		err := reader.Get(ctx, client.ObjectKey{Namespace: exp.Spec.Namespace, Name: workload.Name}, job)
+		switch {
+		case apierrors.IsNotFound(err):
+			impact = append(impact, chaosv1alpha1.JobImpact{Name: workload.Name, Outcome: jobMissing})
+		case err != nil:
+			ctrl.LoggerFrom(ctx).Error(err, "Failed to read Job for history record", "job", workload.Name)
+		default:
+			impact = append(impact, jobImpactOf(job))
+		}
+	}
+	return impact
+}
+
+// jobImpactOf summarizes the state of a Job
+func jobImpactOf(job *batchv1.Job) chaosv1alpha1.JobImpact {
+	impact := chaosv1alpha1.JobImpact{
+		Name:         job.Name,
+		Outcome:      jobRunning,
+		Failed:       job.Status.Failed,
+		BackoffLimit: job.Spec.BackoffLimit,
+	}
+	if owner := metav1.GetControllerOf(job); owner != nil && owner.Kind == "CronJob" {
+		impact.CronJob = owner.Name
+	}
+	for _, cond := range job.Status.Conditions {
+		if cond.Status != corev1.ConditionTrue {
+			continue
+		}
+		switch cond.Type {
+		case batchv1.JobComplete:
+			impact.Outcome = jobComplete
+		case batchv1.JobFailed:
+			impact.Outcome = jobFailed
+			impact.Reason = cond.Reason
+		}
+	}
+	return impact
+}
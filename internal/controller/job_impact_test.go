@@ -0,0 +1,72 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	chaosv1alpha1 "github.com/neogan74/k8s-chaos/api/v1alpha1"
+)
+
+func TestJobImpact(t *testing.T) {
+	running := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "report-29312",
+			Namespace: "batch",
+			OwnerReferences: []metav1.OwnerReference{{
+				APIVersion: "batch/v1", Kind: "CronJob", Name: "report", UID: "report", Controller: ptr.To(true),
+			}},
+		},
+		Spec:   batchv1.JobSpec{BackoffLimit: ptr.To[int32](2)},
+		Status: batchv1.JobStatus{Failed: 1, Active: 1},
+	}
+	failed := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{Name: "etl", Namespace: "batch"},
+		Status: batchv1.JobStatus{Failed: 3, Conditions: []batchv1.JobCondition{
+			{Type: batchv1.JobFailed, Status: corev1.ConditionTrue, Reason: "BackoffLimitExceeded"},
+		}},
+	}
+	r := newReconcilerWithObjects(t, running, failed)
+	exp := &chaosv1alpha1.ChaosExperiment{
+		Spec: chaosv1alpha1.ChaosExperimentSpec{Action: "pod-kill", Namespace: "batch", IncludeJobPods: true},
+		Status: chaosv1alpha1.ChaosExperimentStatus{BlastRadius: &chaosv1alpha1.BlastRadius{
+			Workloads: []chaosv1alpha1.WorkloadImpact{
+				{Kind: "Job", Name: "report-29312"},
+				{Kind: "Job", Name: "etl"},
+				{Kind: "Job", Name: "cleaned-up"},
+				{Kind: "StatefulSet", Name: "scheduler"},
+			},
+		}},
+	}
+
+	impact := r.jobImpact(context.Background(), exp)
+	require.Len(t, impact, 3)
+	assert.Equal(t, chaosv1alpha1.JobImpact{
+		Name: "report-29312", CronJob: "report", Outcome: jobRunning, Failed: 1, BackoffLimit: ptr.To[int32](2),
+	}, impact[0])
+	assert.Equal(t, jobFailed, impact[1].Outcome)
+	assert.Equal(t, "BackoffLimitExceeded", impact[1].Reason)
+	assert.Equal(t, chaosv1alpha1.JobImpact{Name: "cleaned-up", Outcome: jobMissing}, impact[2])
+}
@@ -0,0 +1,260 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	coordinationv1 "k8s.io/api/coordination/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	chaosv1alpha1 "github.com/neogan74/k8s-chaos/api/v1alpha1"
+	chaosmetrics "github.com/neogan74/k8s-chaos/internal/metrics"
+)
+
+// Ways lease-steal takes a lease from its holder
+const (
+	leaseModeAcquire = "Acquire"
+	leaseModeDelete  = "Delete"
+)
+
+// handleLeaseSteal takes the leader-election lease spec.leaseName from its holder. In Acquire mode
+// the controller writes itself in as holder for spec.duration: the leader's next renewal fails and
+// it steps down, and the candidates matching spec.selector take over once the lease expires or is
+// released. In Delete mode the lease is deleted and the candidates race to create it again.
+func (r *ChaosExperimentReconciler) handleLeaseSteal(ctx context.Context, exp *chaosv1alpha1.ChaosExperiment) (ctrl.Result, error) {
+	log := ctrl.LoggerFrom(ctx)
+	startTime := time.Now()
+
+	if exp.Spec.LeaseName == "" {
+		return r.handleExperimentFailure(ctx, exp, &ChaosError{
+			Original:  fmt.Errorf("leaseName must be specified"),
+			Type:      ErrorTypeValidation,
+			Operation: "validate lease-steal config",
+		})
+	}
+	mode := exp.Spec.LeaseMode
+	if mode == "" {
+		mode = leaseModeAcquire
+	}
+	var hold time.Duration
+	if mode == leaseModeAcquire {
+		var err error
+		if hold, err = r.parseDuration(exp.Spec.Duration); err != nil || hold < time.Second {
+			return r.handleExperimentFailure(ctx, exp, &ChaosError{
+				Original:  fmt.Errorf("invalid duration %q: lease-steal holds the lease for at least a second", exp.Spec.Duration),
+				Type:      ErrorTypeValidation,
+				Operation: "validate lease-steal config",
+			})
+		}
+	}
+
+	key := types.NamespacedName{Namespace: exp.Spec.Namespace, Name: exp.Spec.LeaseName}
+	lease := &coordinationv1.Lease{}
+	if err := r.liveReader().Get(ctx, key, lease); err != nil {
+		if isPermissionDeniedError(err) {
+			return ctrl.Result{}, r.handlePermissionDenied(ctx, exp, "reading lease for lease-steal", err)
+		}
+		return r.handleExperimentFailure(ctx, exp, WrapK8sError(err, "get lease "+key.String()))
+	}
+	holder := r.describeLeaseHolder(ctx, exp, leaseHolder(lease))
+
+	if exp.Spec.DryRun {
+		now := metav1.Now()
+		exp.Status.LastRunTime = &now
+		if mode == leaseModeDelete {
+			exp.Status.Message = fmt.Sprintf("DRY RUN: Would delete lease %s held by %s", key, holder)
+		} else {
+			exp.Status.Message = fmt.Sprintf("DRY RUN: Would acquire lease %s held by %s for %s", key, holder, exp.Spec.Duration)
+		}
+		exp.Status.Phase = phaseCompleted
+
+		if err := r.Status().Update(ctx, exp); err != nil {
+			log.Error(err, "Failed to update ChaosExperiment status")
+			return ctrl.Result{}, err
+		}
+
+		log.Info("Dry run completed", "action", "lease-steal", "lease", key.String(), "holder", holder)
+		return ctrl.Result{}, nil
+	}
+
+	var previous string
+	var err error
+	if mode == leaseModeDelete {
+		previous, err = r.deleteLease(ctx, key)
+	} else {
+		previous, err = r.acquireLease(ctx, key, leaseStealIdentity(exp), hold)
+	}
+	if err != nil {
+		log.Error(err, "Failed to steal lease", "lease", key.String(), "mode", mode)
+		if isPermissionDeniedError(err) {
+			return ctrl.Result{}, r.handlePermissionDenied(ctx, exp, "stealing lease "+key.String(), err)
+		}
+		return r.handleExperimentFailure(ctx, exp, WrapK8sError(err, "steal lease "+key.String()))
+	}
+	holder = r.describeLeaseHolder(ctx, exp, previous)
+
+	// Remember acquired leases so they are released when the experiment ends
+	if mode == leaseModeAcquire && !slices.Contains(exp.Status.StolenLeases, key.String()) {
+		exp.Status.StolenLeases = append(exp.Status.StolenLeases, key.String())
+	}
+
+	now := metav1.Now()
+	exp.Status.LastRunTime = &now
+	var details string
+	if mode == leaseModeDelete {
+		exp.Status.Message = fmt.Sprintf("Deleted lease %s held by %s", key, holder)
+		details = "deleted while held by " + holder
+	} else {
+		exp.Status.Message = fmt.Sprintf("Acquired lease %s held by %s for %s", key, holder, exp.Spec.Duration)
+		details = fmt.Sprintf("acquired for %s while held by %s", exp.Spec.Duration, holder)
+	}
+	r.Recorder.Event(exp, corev1.EventTypeWarning, "ChaosLeaseSteal", exp.Status.Message)
+	countAffected(exp, 1)
+	if err := r.Status().Update(ctx, exp); err != nil {
+		log.Error(err, "Failed to update ChaosExperiment status")
+		return ctrl.Result{}, err
+	}
+
+	chaosmetrics.ExperimentsTotal.WithLabelValues("lease-steal", exp.Spec.Namespace, statusSuccess).Inc()
+	chaosmetrics.ExperimentDuration.WithLabelValues("lease-steal", exp.Spec.Namespace).Observe(time.Since(startTime).Seconds())
+	chaosmetrics.ResourcesAffected.WithLabelValues("lease-steal", exp.Spec.Namespace, exp.Name).Set(1)
+
+	action := "acquired"
+	if mode == leaseModeDelete {
+		action = "deleted"
+	}
+	affectedResources := buildResourceReferences(action, exp.Spec.Namespace, []string{exp.Spec.LeaseName}, "Lease")
+	affectedResources[0].Details = details
+	if err := r.createHistoryRecord(ctx, exp, statusSuccess, affectedResources, startTime, nil); err != nil {
+		log.Error(err, "Failed to create history record")
+		// Don't fail the experiment if history recording fails
+	}
+
+	return ctrl.Result{RequeueAfter: r.roundInterval(exp)}, nil
+}
+
+// leaseStealIdentity is the holder identity the controller writes into leases it acquires
+func leaseStealIdentity(exp *chaosv1alpha1.ChaosExperiment) string {
+	return fmt.Sprintf("k8s-chaos/%s/%s", exp.Namespace, exp.Name)
+}
+
+// leaseHolder returns the holder identity of a lease, "" when nobody holds it
+func leaseHolder(lease *coordinationv1.Lease) string {
+	return ptr.Deref(lease.Spec.HolderIdentity, "")
+}
+
+// describeLeaseHolder names the holder for status messages and history records, with the
+// candidate pod it belongs to when the identity is one of the pods matching spec.selector
+func (r *ChaosExperimentReconciler) describeLeaseHolder(ctx context.Context, exp *chaosv1alpha1.ChaosExperiment, holder string) string {
+	if holder == "" {
+		return "nobody"
+	}
+	pods, err := r.listPods(ctx, client.InNamespace(exp.Spec.Namespace),
+		client.MatchingLabelsSelector{Selector: labels.SelectorFromSet(exp.Spec.Selector)})
+	if err != nil {
+		return fmt.Sprintf("%q", holder)
+	}
+	for _, pod := range pods {
+		if isLeaseHolder(pod.Name, []string{holder}) {
+			return fmt.Sprintf("%q (pod %s)", holder, pod.Name)
+		}
+	}
+	return fmt.Sprintf("%q", holder)
+}
+
+// acquireLease makes holder the holder of the lease for hold, the way a leader-election client
+// acquires an expired lease, and returns the previous holder. The lease is read live: a renewal the
+// cache has not seen yet would make every write conflict.
+func (r *ChaosExperimentReconciler) acquireLease(ctx context.Context, key types.NamespacedName, holder string, hold time.Duration) (string, error) {
+	var previous string
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		lease := &coordinationv1.Lease{}
+		if err := r.liveReader().Get(ctx, key, lease); err != nil {
+			return err
+		}
+		previous = leaseHolder(lease)
+		now := metav1.NewMicroTime(time.Now())
+		if previous != holder {
+			lease.Spec.AcquireTime = &now
+			lease.Spec.LeaseTransitions = ptr.To(ptr.Deref(lease.Spec.LeaseTransitions, 0) + 1)
+		}
+		lease.Spec.HolderIdentity = ptr.To(holder)
+		lease.Spec.LeaseDurationSeconds = ptr.To(int32(hold / time.Second))
+		lease.Spec.RenewTime = &now
+		return r.Update(ctx, lease)
+	})
+	return previous, err
+}
+
+// deleteLease deletes the lease unless it changed since it was read, and returns its holder
+func (r *ChaosExperimentReconciler) deleteLease(ctx context.Context, key types.NamespacedName) (string, error) {
+	var previous string
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		lease := &coordinationv1.Lease{}
+		if err := r.liveReader().Get(ctx, key, lease); err != nil {
+			return err
+		}
+		previous = leaseHolder(lease)
+		return r.Delete(ctx, lease, client.Preconditions{UID: &lease.UID, ResourceVersion: &lease.ResourceVersion})
+	})
+	return previous, err
+}
+
+// releaseStolenLeases gives up the leases the experiment still holds, so candidates do not have
+// to wait for them to expire. A lease someone else took over in the meantime is left alone, and
+// one that cannot be released expires on its own after spec.duration.
+func (r *ChaosExperimentReconciler) releaseStolenLeases(ctx context.Context, exp *chaosv1alpha1.ChaosExperiment) {
+	log := ctrl.LoggerFrom(ctx)
+	identity := leaseStealIdentity(exp)
+
+	for _, name := range exp.Status.StolenLeases {
+		namespace, leaseName, _ := strings.Cut(name, "/")
+		key := types.NamespacedName{Namespace: namespace, Name: leaseName}
+		err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+			lease := &coordinationv1.Lease{}
+			if err := r.liveReader().Get(ctx, key, lease); err != nil {
+				return err
+			}
+			if leaseHolder(lease) != identity {
+				log.Info("Stolen lease already has a new holder", "lease", name, "holder", leaseHolder(lease))
+				return nil
+			}
+			// Released the way leader-election clients release on shutdown
+			lease.Spec.HolderIdentity = nil
+			lease.Spec.LeaseDurationSeconds = ptr.To(int32(1))
+			lease.Spec.RenewTime = ptr.To(metav1.NewMicroTime(time.Now()))
+			return r.Update(ctx, lease)
+		})
+		if err != nil && !apierrors.IsNotFound(err) {
+			log.Error(err, "Failed to release stolen lease, it expires on its own", "lease", name)
+		}
+	}
+	exp.Status.StolenLeases = nil
+}
@@ -0,0 +1,154 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	chaosv1alpha1 "github.com/neogan74/k8s-chaos/api/v1alpha1"
+)
+
+func leaseStealFixtures(mode string) (*chaosv1alpha1.ChaosExperiment, *coordinationv1.Lease, *corev1.Pod) {
+	exp := &chaosv1alpha1.ChaosExperiment{
+		ObjectMeta: metav1.ObjectMeta{Name: "operator-failover", Namespace: "chaos"},
+		Spec: chaosv1alpha1.ChaosExperimentSpec{
+			Action:    "lease-steal",
+			Namespace: "ops",
+			Selector:  map[string]string{"app": "billing-operator"},
+			LeaseName: "billing-operator-leader",
+			LeaseMode: mode,
+			Duration:  "30s",
+		},
+	}
+	renewed := metav1.NewMicroTime(time.Now())
+	lease := &coordinationv1.Lease{
+		ObjectMeta: metav1.ObjectMeta{Name: "billing-operator-leader", Namespace: "ops"},
+		Spec: coordinationv1.LeaseSpec{
+			HolderIdentity:       ptr.To("billing-operator-5c8d7_0f1e2d"),
+			LeaseDurationSeconds: ptr.To[int32](15),
+			RenewTime:            &renewed,
+			LeaseTransitions:     ptr.To[int32](2),
+		},
+	}
+	pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{
+		Name: "billing-operator-5c8d7", Namespace: "ops", Labels: map[string]string{"app": "billing-operator"},
+	}}
+	return exp, lease, pod
+}
+
+func fetchLease(t *testing.T, r *ChaosExperimentReconciler, lease *coordinationv1.Lease) *coordinationv1.Lease {
+	t.Helper()
+	got := &coordinationv1.Lease{}
+	require.NoError(t, r.Get(context.Background(), client.ObjectKeyFromObject(lease), got))
+	return got
+}
+
+func TestHandleLeaseSteal_Acquire(t *testing.T) {
+	ctx := context.Background()
+	exp, lease, pod := leaseStealFixtures("")
+	r := newReconcilerWithObjects(t, exp, lease, pod)
+
+	result, err := r.handleLeaseSteal(ctx, exp)
+	require.NoError(t, err)
+	assert.Equal(t, defaultInterval, result.RequeueAfter)
+
+	stolen := fetchLease(t, r, lease)
+	assert.Equal(t, "k8s-chaos/chaos/operator-failover", leaseHolder(stolen))
+	assert.Equal(t, int32(30), *stolen.Spec.LeaseDurationSeconds)
+	assert.Equal(t, int32(3), *stolen.Spec.LeaseTransitions)
+	assert.NotNil(t, stolen.Spec.AcquireTime)
+
+	updated := fetchExperiment(t, r, exp.Name, exp.Namespace)
+	assert.Equal(t, []string{"ops/billing-operator-leader"}, updated.Status.StolenLeases)
+	assert.Contains(t, updated.Status.Message, `"billing-operator-5c8d7_0f1e2d" (pod billing-operator-5c8d7)`)
+
+	// Ending the experiment hands the lease back right away
+	r.revertActiveInjections(ctx, updated)
+	released := fetchLease(t, r, lease)
+	assert.Nil(t, released.Spec.HolderIdentity)
+	assert.Equal(t, int32(1), *released.Spec.LeaseDurationSeconds)
+	assert.Empty(t, updated.Status.StolenLeases)
+}
+
+func TestReleaseStolenLeases_KeepsNewHolder(t *testing.T) {
+	ctx := context.Background()
+	exp, lease, pod := leaseStealFixtures("")
+	lease.Spec.HolderIdentity = ptr.To("billing-operator-9a7b6_3c4d5e")
+	exp.Status.StolenLeases = []string{"ops/billing-operator-leader"}
+	r := newReconcilerWithObjects(t, exp, lease, pod)
+
+	r.releaseStolenLeases(ctx, exp)
+
+	assert.Equal(t, "billing-operator-9a7b6_3c4d5e", leaseHolder(fetchLease(t, r, lease)))
+	assert.Empty(t, exp.Status.StolenLeases)
+}
+
+func TestHandleLeaseSteal_Delete(t *testing.T) {
+	ctx := context.Background()
+	exp, lease, pod := leaseStealFixtures("Delete")
+	exp.Spec.Duration = ""
+	r := newReconcilerWithObjects(t, exp, lease, pod)
+
+	_, err := r.handleLeaseSteal(ctx, exp)
+	require.NoError(t, err)
+
+	err = r.Get(ctx, client.ObjectKeyFromObject(lease), &coordinationv1.Lease{})
+	assert.True(t, apierrors.IsNotFound(err), "lease should be deleted, got %v", err)
+	updated := fetchExperiment(t, r, exp.Name, exp.Namespace)
+	assert.Empty(t, updated.Status.StolenLeases)
+	assert.Contains(t, updated.Status.Message, "Deleted lease ops/billing-operator-leader")
+}
+
+func TestHandleLeaseSteal_DryRun(t *testing.T) {
+	ctx := context.Background()
+	exp, lease, pod := leaseStealFixtures("")
+	exp.Spec.DryRun = true
+	r := newReconcilerWithObjects(t, exp, lease, pod)
+
+	_, err := r.handleLeaseSteal(ctx, exp)
+	require.NoError(t, err)
+
+	assert.Equal(t, "billing-operator-5c8d7_0f1e2d", leaseHolder(fetchLease(t, r, lease)))
+	updated := fetchExperiment(t, r, exp.Name, exp.Namespace)
+	assert.Equal(t, phaseCompleted, updated.Status.Phase)
+	assert.Contains(t, updated.Status.Message, "DRY RUN: Would acquire lease ops/billing-operator-leader")
+}
+
+func TestHandleLeaseSteal_MissingLease(t *testing.T) {
+	ctx := context.Background()
+	exp, _, pod := leaseStealFixtures("")
+	r := newReconcilerWithObjects(t, exp, pod)
+
+	_, err := r.handleLeaseSteal(ctx, exp)
+	require.NoError(t, err)
+
+	updated := fetchExperiment(t, r, exp.Name, exp.Namespace)
+	assert.Equal(t, phasePending, updated.Status.Phase)
+	assert.Contains(t, updated.Status.Message, "billing-operator-leader")
+	assert.Empty(t, updated.Status.StolenLeases)
+}
@@ -23,10 +23,13 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	chaosv1alpha1 "github.com/neogan74/k8s-chaos/api/v1alpha1"
+	cronschedule "github.com/neogan74/k8s-chaos/internal/schedule"
 )
 
 func TestCheckSchedule_NoScheduleRunsImmediately(t *testing.T) {
@@ -98,6 +101,102 @@ func TestCheckSchedule_CronUpdatesStatusAndRuns(t *testing.T) {
 	assert.NotNil(t, refreshed.Status.NextScheduledTime)
 }
 
+func TestCheckSchedule_JitterShiftsNextRun(t *testing.T) {
+	ctx := context.Background()
+	exp := &chaosv1alpha1.ChaosExperiment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              "jittered",
+			Namespace:         "default",
+			CreationTimestamp: metav1.Now(),
+		},
+		Spec: chaosv1alpha1.ChaosExperimentSpec{
+			Action:         "pod-kill",
+			Schedule:       "0 0 1 1 *", // yearly, far enough ahead to not fire during the test
+			ScheduleJitter: "30m",
+		},
+	}
+
+	r := newReconcilerWithObjects(t, exp)
+	r.schedules = cronschedule.NewCache()
+
+	shouldRun, _, err := r.checkSchedule(ctx, exp)
+	require.NoError(t, err)
+	assert.False(t, shouldRun)
+
+	offset := cronschedule.Offset("default/jittered", 30*time.Minute)
+	base, err := cronschedule.Parse("0 0 1 1 *")
+	require.NoError(t, err)
+	require.NotNil(t, exp.Status.NextScheduledTime)
+	assert.True(t, base.Next(time.Now()).Add(offset).Equal(exp.Status.NextScheduledTime.Time),
+		"expected next run to be shifted by %s, got %s", offset, exp.Status.NextScheduledTime)
+}
+
+func TestCheckSchedule_FurtherSchedulesAndExclusions(t *testing.T) {
+	ctx := context.Background()
+	createdAt := metav1.NewTime(time.Now().Add(-6 * time.Minute))
+	exp := &chaosv1alpha1.ChaosExperiment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              "multi-cron",
+			Namespace:         "default",
+			CreationTimestamp: createdAt,
+		},
+		Spec: chaosv1alpha1.ChaosExperimentSpec{
+			Action:    "pod-kill",
+			Schedule:  "0 0 1 1 *",             // yearly
+			Schedules: []string{"*/5 * * * *"}, // and every 5 minutes
+		},
+	}
+
+	r := newReconcilerWithObjects(t, exp)
+
+	// The further schedule fired within the last six minutes
+	shouldRun, _, err := r.checkSchedule(ctx, exp)
+	require.NoError(t, err)
+	assert.True(t, shouldRun)
+
+	// An exclusion window covering the last half hour skips that run
+	exp = fetchExperiment(t, r, exp.Name, exp.Namespace)
+	exp.Status.LastScheduledTime = &createdAt
+	exp.Spec.ExcludeSchedules = []chaosv1alpha1.ScheduleExclusion{{Schedule: "*/30 * * * *", Duration: "30m"}}
+	shouldRun, requeueAfter, err := r.checkSchedule(ctx, exp)
+	require.NoError(t, err)
+	assert.False(t, shouldRun)
+	assert.Equal(t, noScheduledRunRecheck, requeueAfter, "every run is excluded")
+	assert.Nil(t, exp.Status.NextScheduledTime)
+}
+
+func TestReconcile_PublishesNextScheduledTimeDuringFreeze(t *testing.T) {
+	ctx := context.Background()
+	exp := &chaosv1alpha1.ChaosExperiment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              "nightly",
+			Namespace:         "default",
+			CreationTimestamp: metav1.Now(),
+		},
+		Spec: chaosv1alpha1.ChaosExperimentSpec{
+			Action:    "pod-kill",
+			Namespace: "default",
+			Selector:  map[string]string{"app": "demo"},
+			Schedule:  "0 3 * * *",
+		},
+	}
+	freeze := &chaosv1alpha1.ChaosFreeze{
+		ObjectMeta: metav1.ObjectMeta{Name: "release"},
+		Spec:       chaosv1alpha1.ChaosFreezeSpec{Reason: "release week"},
+	}
+
+	r := newReconcilerWithObjects(t, exp, freeze)
+	_, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: client.ObjectKeyFromObject(exp)})
+	require.NoError(t, err)
+
+	base, err := cronschedule.Parse("0 3 * * *")
+	require.NoError(t, err)
+	updated := fetchExperiment(t, r, exp.Name, exp.Namespace)
+	require.NotNil(t, updated.Status.NextScheduledTime, "the next run should be published while frozen")
+	assert.True(t, base.Next(time.Now()).Equal(updated.Status.NextScheduledTime.Time),
+		"expected the next 03:00, got %s", updated.Status.NextScheduledTime)
+}
+
 func TestCheckExperimentLifecycle_StartsAndCompletes(t *testing.T) {
 	ctx := context.Background()
 	exp := &chaosv1alpha1.ChaosExperiment{
@@ -141,3 +240,27 @@ func TestCheckExperimentLifecycle_StartsAndCompletes(t *testing.T) {
 func clientKey(exp *chaosv1alpha1.ChaosExperiment) client.ObjectKey {
 	return client.ObjectKey{Name: exp.Name, Namespace: exp.Namespace}
 }
+
+func TestReconcile_IntervalBetweenRounds(t *testing.T) {
+	ctx := context.Background()
+	exp := &chaosv1alpha1.ChaosExperiment{
+		ObjectMeta: metav1.ObjectMeta{Name: "every-ten", Namespace: "default"},
+		Spec: chaosv1alpha1.ChaosExperimentSpec{
+			Action:    "pod-kill",
+			Namespace: "default",
+			Selector:  map[string]string{"app": "web"},
+			Interval:  "10m",
+		},
+		Status: chaosv1alpha1.ChaosExperimentStatus{Phase: phaseRunning},
+	}
+	pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "web-1", Namespace: "default", Labels: map[string]string{"app": "web"}}}
+	ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "default"}}
+	r := newReconcilerWithObjects(t, exp, pod, ns)
+
+	result, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: client.ObjectKeyFromObject(exp)})
+	require.NoError(t, err)
+	assert.Equal(t, 10*time.Minute, result.RequeueAfter)
+
+	exp.Spec.Interval = ""
+	assert.Equal(t, defaultInterval, r.roundInterval(exp))
+}
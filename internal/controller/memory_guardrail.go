@@ -0,0 +1,176 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	chaosv1alpha1 "github.com/neogan74/k8s-chaos/api/v1alpha1"
+)
+
+const (
+	// memoryOvercommitClamp shrinks a memory stress to fit; Refuse, the default, skips the pod
+	memoryOvercommitClamp = "Clamp"
+
+	// memoryStressOverhead is the address space a stressor process may use beyond its allocation,
+	// for its binary, libraries and stack
+	memoryStressOverhead = 64 << 20
+)
+
+// memoryOvercommitError is a memory stress that does not fit into its target
+type memoryOvercommitError struct {
+	Pod       string
+	Requested int64
+	Headroom  int64
+	// Bound names what limits the headroom, such as "the pod's memory limit"
+	Bound string
+}
+
+func (e *memoryOvercommitError) Error() string {
+	return fmt.Sprintf("memory stress of %dMi does not fit into pod %s: %s leaves %dMi; "+
+		"lower memorySize or memoryWorkers, or set memoryOvercommitPolicy to Clamp",
+		e.Requested>>20, e.Pod, e.Bound, e.Headroom>>20)
+}
+
+// podMemoryLimit returns the memory limit of the pod's cgroup: the pod-level limit, or the sum of
+// the container limits when every container has one
+func podMemoryLimit(pod *corev1.Pod) (int64, bool) {
+	if pod.Spec.Resources != nil {
+		if limit, ok := pod.Spec.Resources.Limits[corev1.ResourceMemory]; ok {
+			return limit.Value(), true
+		}
+	}
+	var total int64
+	for _, c := range pod.Spec.Containers {
+		limit, ok := c.Resources.Limits[corev1.ResourceMemory]
+		if !ok {
+			return 0, false
+		}
+		total += limit.Value()
+	}
+	return total, len(pod.Spec.Containers) > 0
+}
+
+// podMemoryRequest returns the memory requested by the containers of a pod
+func podMemoryRequest(pod *corev1.Pod) int64 {
+	var total int64
+	for _, c := range pod.Spec.Containers {
+		if request, ok := c.Resources.Requests[corev1.ResourceMemory]; ok {
+			total += request.Value()
+		}
+	}
+	return total
+}
+
+// nodeMemoryFree returns the allocatable memory of a node not requested by the pods running on
+// it; ok is false when the node is unknown
+func (r *ChaosExperimentReconciler) nodeMemoryFree(ctx context.Context, nodeName string) (int64, bool, error) {
+	node := &corev1.Node{}
+	if err := r.Get(ctx, client.ObjectKey{Name: nodeName}, node); err != nil {
+		if apierrors.IsNotFound(err) {
+			return 0, false, nil
+		}
+		return 0, false, fmt.Errorf("failed to get node %s: %w", nodeName, err)
+	}
+	allocatable, ok := node.Status.Allocatable[corev1.ResourceMemory]
+	if !ok {
+		return 0, false, nil
+	}
+
+	pods, err := r.listPodsOnNode(ctx, nodeName)
+	if err != nil {
+		return 0, false, fmt.Errorf("failed to list pods on node %s: %w", nodeName, err)
+	}
+	free := allocatable.Value()
+	for i := range pods {
+		if pods[i].Status.Phase == corev1.PodSucceeded || pods[i].Status.Phase == corev1.PodFailed {
+			continue
+		}
+		free -= podMemoryRequest(&pods[i])
+	}
+	return max(free, 0), true, nil
+}
+
+// memoryStressHeadroom returns how much memory a stress may take in pod, bounded by the pod's
+// memory limit and by the memory left allocatable on its node, and what bounds it. ok is false
+// when neither is known.
+func (r *ChaosExperimentReconciler) memoryStressHeadroom(ctx context.Context, pod *corev1.Pod) (int64, string, bool, error) {
+	headroom, bound, ok := int64(0), "", false
+	if limit, hasLimit := podMemoryLimit(pod); hasLimit {
+		headroom, bound, ok = limit, fmt.Sprintf("the pod's %s memory limit", resource.NewQuantity(limit, resource.BinarySI)), true
+	}
+	if pod.Spec.NodeName == "" {
+		return headroom, bound, ok, nil
+	}
+
+	free, known, err := r.nodeMemoryFree(ctx, pod.Spec.NodeName)
+	if err != nil {
+		return 0, "", false, err
+	}
+	if known && (!ok || free < headroom) {
+		headroom, bound, ok = free, fmt.Sprintf("the unrequested allocatable memory of node %s", pod.Spec.NodeName), true
+	}
+	return headroom, bound, ok, nil
+}
+
+// fitMemoryStress returns the per-worker memory size to stress pod with: spec.memorySize when
+// the total fits the pod's headroom, a smaller size under the Clamp policy, or an error. A stress
+// past the pod's limit gets the pod OOM-killed; past the node's free memory it can take the node
+// and its other pods down.
+func (r *ChaosExperimentReconciler) fitMemoryStress(ctx context.Context, exp *chaosv1alpha1.ChaosExperiment, pod *corev1.Pod, workers int) (string, bool, error) {
+	size, err := memorySizeBytes(exp.Spec.MemorySize)
+	if err != nil {
+		return "", false, err
+	}
+	headroom, bound, ok, err := r.memoryStressHeadroom(ctx, pod)
+	if err != nil || !ok {
+		return exp.Spec.MemorySize, false, err
+	}
+
+	requested := size * int64(workers)
+	if requested <= headroom {
+		return exp.Spec.MemorySize, false, nil
+	}
+	overcommit := &memoryOvercommitError{
+		Pod:       pod.Namespace + "/" + pod.Name,
+		Requested: requested,
+		Headroom:  headroom,
+		Bound:     bound,
+	}
+	perWorker := headroom / int64(workers) >> 20
+	if exp.Spec.MemoryOvercommitPolicy != memoryOvercommitClamp || perWorker < 1 {
+		return "", false, overcommit
+	}
+	return fmt.Sprintf("%dM", perWorker), true, nil
+}
+
+// memoryStressLimit caps the address space of every stressor process at its share of the stress.
+// Ephemeral containers cannot have resource limits, so this is what keeps a stressor from
+// growing past the size it was checked against.
+func memoryStressLimit(size string) (string, error) {
+	bytes, err := memorySizeBytes(size)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("ulimit -v %d\n", (bytes+memoryStressOverhead)>>10), nil
+}
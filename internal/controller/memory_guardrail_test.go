@@ -0,0 +1,146 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"os/exec"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	chaosv1alpha1 "github.com/neogan74/k8s-chaos/api/v1alpha1"
+)
+
+// memoryPod returns a pod on node with one container per request/limit pair; empty values are unset
+func memoryPod(name, node string, resources ...[2]string) *corev1.Pod {
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+		Spec:       corev1.PodSpec{NodeName: node},
+		Status:     corev1.PodStatus{Phase: corev1.PodRunning},
+	}
+	for _, r := range resources {
+		c := corev1.Container{Name: "app-" + r[0] + r[1]}
+		if r[0] != "" {
+			c.Resources.Requests = corev1.ResourceList{corev1.ResourceMemory: resource.MustParse(r[0])}
+		}
+		if r[1] != "" {
+			c.Resources.Limits = corev1.ResourceList{corev1.ResourceMemory: resource.MustParse(r[1])}
+		}
+		pod.Spec.Containers = append(pod.Spec.Containers, c)
+	}
+	return pod
+}
+
+func memoryNode(name, allocatable string) *corev1.Node {
+	return &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Status:     corev1.NodeStatus{Allocatable: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse(allocatable)}},
+	}
+}
+
+func memoryStressExperiment(size string, workers int, policy string) *chaosv1alpha1.ChaosExperiment {
+	return &chaosv1alpha1.ChaosExperiment{Spec: chaosv1alpha1.ChaosExperimentSpec{
+		Action: "pod-memory-stress", MemorySize: size, MemoryWorkers: workers, MemoryOvercommitPolicy: policy,
+	}}
+}
+
+func TestPodMemoryLimit(t *testing.T) {
+	limit, ok := podMemoryLimit(memoryPod("web", "", [2]string{"", "512Mi"}, [2]string{"", "256Mi"}))
+	assert.True(t, ok)
+	assert.Equal(t, int64(768<<20), limit)
+
+	// One container without a limit leaves the pod cgroup unbounded
+	_, ok = podMemoryLimit(memoryPod("web", "", [2]string{"", "512Mi"}, [2]string{"128Mi", ""}))
+	assert.False(t, ok)
+
+	pod := memoryPod("web", "", [2]string{"128Mi", ""})
+	pod.Spec.Resources = &corev1.ResourceRequirements{Limits: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("1Gi")}}
+	limit, ok = podMemoryLimit(pod)
+	assert.True(t, ok)
+	assert.Equal(t, int64(1<<30), limit)
+}
+
+func TestFitMemoryStress_NodeBound(t *testing.T) {
+	target := memoryPod("web", "node-a", [2]string{"1Gi", ""})
+	neighbour := memoryPod("db", "node-a", [2]string{"2Gi", ""})
+	finished := memoryPod("job", "node-a", [2]string{"8Gi", ""})
+	finished.Status.Phase = corev1.PodSucceeded
+	r := newReconcilerWithObjects(t, memoryNode("node-a", "4Gi"), target, neighbour, finished)
+	ctx := context.Background()
+
+	// 1Gi of the node is left: 4 x 512M does not fit
+	_, _, err := r.fitMemoryStress(ctx, memoryStressExperiment("512M", 4, ""), target, 4)
+	var overcommit *memoryOvercommitError
+	require.ErrorAs(t, err, &overcommit)
+	assert.Equal(t, "memory stress of 2048Mi does not fit into pod default/web: the unrequested allocatable memory "+
+		"of node node-a leaves 1024Mi; lower memorySize or memoryWorkers, or set memoryOvercommitPolicy to Clamp", err.Error())
+	assert.Equal(t, ErrorTypeValidation, ClassifyError(err).Type)
+
+	size, clamped, err := r.fitMemoryStress(ctx, memoryStressExperiment("512M", 4, memoryOvercommitClamp), target, 4)
+	require.NoError(t, err)
+	assert.True(t, clamped)
+	assert.Equal(t, "256M", size)
+
+	size, clamped, err = r.fitMemoryStress(ctx, memoryStressExperiment("1G", 1, ""), target, 1)
+	require.NoError(t, err)
+	assert.False(t, clamped)
+	assert.Equal(t, "1G", size)
+}
+
+func TestFitMemoryStress_PodLimitBound(t *testing.T) {
+	target := memoryPod("web", "node-a", [2]string{"256Mi", "768Mi"})
+	r := newReconcilerWithObjects(t, memoryNode("node-a", "16Gi"), target)
+	ctx := context.Background()
+
+	_, _, err := r.fitMemoryStress(ctx, memoryStressExperiment("512M", 2, ""), target, 2)
+	assert.ErrorContains(t, err, "the pod's 768Mi memory limit leaves 768Mi")
+
+	size, clamped, err := r.fitMemoryStress(ctx, memoryStressExperiment("512M", 2, memoryOvercommitClamp), target, 2)
+	require.NoError(t, err)
+	assert.True(t, clamped)
+	assert.Equal(t, "384M", size)
+}
+
+func TestFitMemoryStress_Unbounded(t *testing.T) {
+	// Unscheduled pod without limits: nothing to check against
+	target := memoryPod("web", "", [2]string{"256Mi", ""})
+	r := newReconcilerWithObjects(t, target)
+
+	size, clamped, err := r.fitMemoryStress(context.Background(), memoryStressExperiment("4G", 8, ""), target, 8)
+	require.NoError(t, err)
+	assert.False(t, clamped)
+	assert.Equal(t, "4G", size)
+}
+
+func TestMemoryStressLimit(t *testing.T) {
+	limit, err := memoryStressLimit("256M")
+	require.NoError(t, err)
+	assert.Equal(t, "ulimit -v 327680\n", limit)
+
+	// The built-in stressor runs under the limit
+	script, err := builtinMemoryStressScript(1, "1M", 1)
+	require.NoError(t, err)
+	limit, err = memoryStressLimit("1M")
+	require.NoError(t, err)
+	out, err := exec.Command("sh", "-c", limit+script).CombinedOutput()
+	require.NoError(t, err, string(out))
+}
@@ -0,0 +1,131 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	chaosv1alpha1 "github.com/neogan74/k8s-chaos/api/v1alpha1"
+)
+
+// metricsSettleDelay is how long after completion the "after" value of metricsQueries is sampled,
+// giving the targets time to recover before the comparison point is taken
+const metricsSettleDelay = 2 * time.Minute
+
+// sampleMetric evaluates a metrics query at the given time, returning the formatted value or the error
+func (r *ChaosExperimentReconciler) sampleMetric(ctx context.Context, query chaosv1alpha1.MetricsQuery, at time.Time) (string, error) {
+	if r.Prometheus == nil {
+		return "", fmt.Errorf("no Prometheus configured, set --prometheus-url on the controller")
+	}
+	value, err := r.Prometheus.Query(ctx, query.Query, at)
+	if err != nil {
+		return "", err
+	}
+	return strconv.FormatFloat(value, 'g', -1, 64), nil
+}
+
+// sampleRunMetrics samples every metrics query at the start of a run and now, for the run's history record
+func (r *ChaosExperimentReconciler) sampleRunMetrics(ctx context.Context, exp *chaosv1alpha1.ChaosExperiment, runStart time.Time) []chaosv1alpha1.MetricSample {
+	if len(exp.Spec.MetricsQueries) == 0 {
+		return nil
+	}
+	now := time.Now()
+	samples := make([]chaosv1alpha1.MetricSample, 0, len(exp.Spec.MetricsQueries))
+	for _, query := range exp.Spec.MetricsQueries {
+		sample := chaosv1alpha1.MetricSample{Name: query.Name}
+		r.fillSample(ctx, &sample.Before, &sample.Error, query, runStart)
+		r.fillSample(ctx, &sample.During, &sample.Error, query, now)
+		samples = append(samples, sample)
+	}
+	return samples
+}
+
+// fillSample stores a sampled value in target, or the error in errMsg
+func (r *ChaosExperimentReconciler) fillSample(ctx context.Context, target, errMsg *string, query chaosv1alpha1.MetricsQuery, at time.Time) {
+	value, err := r.sampleMetric(ctx, query, at)
+	if err != nil {
+		ctrl.LoggerFrom(ctx).V(1).Info("Failed to sample metrics query", "query", query.Name, "error", err.Error())
+		*errMsg = err.Error()
+		return
+	}
+	*target = value
+}
+
+// handleCompletedMetrics fills status.metrics for a completed experiment: "before" at the
+// experiment start and "during" at completion right away, then "after" once metricsSettleDelay
+// has passed. It requeues until the "after" values are recorded.
+func (r *ChaosExperimentReconciler) handleCompletedMetrics(ctx context.Context, exp *chaosv1alpha1.ChaosExperiment) (ctrl.Result, error) {
+	if exp.Status.Phase != phaseCompleted || exp.Status.CompletedAt == nil || len(exp.Spec.MetricsQueries) == 0 {
+		return ctrl.Result{}, nil
+	}
+	log := ctrl.LoggerFrom(ctx)
+	completedAt := exp.Status.CompletedAt.Time
+
+	changed := false
+	if len(exp.Status.Metrics) == 0 {
+		startedAt := completedAt
+		if exp.Status.StartTime != nil {
+			startedAt = exp.Status.StartTime.Time
+		}
+		for _, query := range exp.Spec.MetricsQueries {
+			sample := chaosv1alpha1.MetricSample{Name: query.Name}
+			r.fillSample(ctx, &sample.Before, &sample.Error, query, startedAt)
+			r.fillSample(ctx, &sample.During, &sample.Error, query, completedAt)
+			exp.Status.Metrics = append(exp.Status.Metrics, sample)
+		}
+		changed = true
+	}
+
+	result := ctrl.Result{}
+	if settleAt := completedAt.Add(metricsSettleDelay); time.Now().Before(settleAt) {
+		result.RequeueAfter = time.Until(settleAt)
+	} else {
+		for i := range exp.Status.Metrics {
+			sample := &exp.Status.Metrics[i]
+			if sample.After != "" {
+				continue
+			}
+			if query := findMetricsQuery(exp, sample.Name); query != nil {
+				r.fillSample(ctx, &sample.After, &sample.Error, *query, settleAt)
+				changed = true
+			}
+		}
+	}
+
+	if changed {
+		if err := r.Status().Update(ctx, exp); err != nil {
+			log.Error(err, "Failed to update experiment metrics")
+			return ctrl.Result{}, err
+		}
+	}
+	return result, nil
+}
+
+// findMetricsQuery returns the spec query with the given name, or nil if it was removed
+func findMetricsQuery(exp *chaosv1alpha1.ChaosExperiment, name string) *chaosv1alpha1.MetricsQuery {
+	for i := range exp.Spec.MetricsQueries {
+		if exp.Spec.MetricsQueries[i].Name == name {
+			return &exp.Spec.MetricsQueries[i]
+		}
+	}
+	return nil
+}
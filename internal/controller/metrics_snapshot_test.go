@@ -0,0 +1,123 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	chaosv1alpha1 "github.com/neogan74/k8s-chaos/api/v1alpha1"
+	"github.com/neogan74/k8s-chaos/internal/promquery"
+)
+
+// fakePrometheus answers every query with a value that encodes which point was sampled:
+// 1 before chaosStart, 2 between chaosStart and chaosEnd, 3 afterwards.
+func fakePrometheus(t *testing.T, chaosStart, chaosEnd time.Time) *promquery.Client {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		seconds, err := strconv.ParseFloat(r.URL.Query().Get("time"), 64)
+		require.NoError(t, err)
+		at := time.UnixMilli(int64(seconds * 1000))
+		value := 2
+		switch {
+		case at.Before(chaosStart):
+			value = 1
+		case at.After(chaosEnd):
+			value = 3
+		}
+		_, _ = fmt.Fprintf(w, `{"status":"success","data":{"resultType":"scalar","result":[%f,"%d"]}}`, seconds, value)
+	}))
+	t.Cleanup(server.Close)
+	return promquery.NewClient(server.URL)
+}
+
+func metricsExperiment(startedAt, completedAt time.Time) *chaosv1alpha1.ChaosExperiment {
+	return &chaosv1alpha1.ChaosExperiment{
+		ObjectMeta: metav1.ObjectMeta{Name: "latency", Namespace: "default"},
+		Spec: chaosv1alpha1.ChaosExperimentSpec{
+			Action:    "pod-kill",
+			Namespace: "default",
+			Selector:  map[string]string{"app": "web"},
+			MetricsQueries: []chaosv1alpha1.MetricsQuery{
+				{Name: "error-rate", Query: `sum(rate(http_requests_total{code=~"5.."}[1m]))`},
+			},
+		},
+		Status: chaosv1alpha1.ChaosExperimentStatus{
+			Phase:       phaseCompleted,
+			StartTime:   &metav1.Time{Time: startedAt},
+			CompletedAt: &metav1.Time{Time: completedAt},
+		},
+	}
+}
+
+func TestHandleCompletedMetrics(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("after value waits for the settle delay", func(t *testing.T) {
+		completedAt := time.Now().Add(-10 * time.Second)
+		startedAt := completedAt.Add(-10 * time.Minute)
+		exp := metricsExperiment(startedAt, completedAt)
+		r := newReconcilerWithObjects(t, exp)
+		r.Prometheus = fakePrometheus(t, startedAt.Add(time.Second), completedAt.Add(time.Second))
+
+		result, err := r.handleCompletedMetrics(ctx, exp)
+		require.NoError(t, err)
+		assert.Greater(t, result.RequeueAfter, time.Duration(0))
+
+		stored := fetchExperiment(t, r, "latency", "default")
+		require.Len(t, stored.Status.Metrics, 1)
+		assert.Equal(t, "1", stored.Status.Metrics[0].Before)
+		assert.Equal(t, "2", stored.Status.Metrics[0].During)
+		assert.Empty(t, stored.Status.Metrics[0].After)
+	})
+
+	t.Run("all points sampled once settled", func(t *testing.T) {
+		completedAt := time.Now().Add(-2 * metricsSettleDelay)
+		startedAt := completedAt.Add(-10 * time.Minute)
+		exp := metricsExperiment(startedAt, completedAt)
+		r := newReconcilerWithObjects(t, exp)
+		r.Prometheus = fakePrometheus(t, startedAt.Add(time.Second), completedAt.Add(time.Second))
+
+		result, err := r.handleCompletedMetrics(ctx, exp)
+		require.NoError(t, err)
+		assert.Zero(t, result.RequeueAfter)
+
+		stored := fetchExperiment(t, r, "latency", "default")
+		require.Len(t, stored.Status.Metrics, 1)
+		assert.Equal(t, chaosv1alpha1.MetricSample{Name: "error-rate", Before: "1", During: "2", After: "3"}, stored.Status.Metrics[0])
+	})
+}
+
+func TestSampleRunMetrics_NoPrometheus(t *testing.T) {
+	exp := metricsExperiment(time.Now(), time.Now())
+	r := newReconcilerWithObjects(t)
+
+	samples := r.sampleRunMetrics(context.Background(), exp, time.Now().Add(-time.Minute))
+
+	require.Len(t, samples, 1)
+	assert.Empty(t, samples[0].Before)
+	assert.Contains(t, samples[0].Error, "--prometheus-url")
+}
@@ -0,0 +1,143 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+// netAdminMissingFunc is a shell function printing what the container lacks to change network
+// settings, such as "NET_ADMIN / tc binary", or nothing. NET_ADMIN is bit 12 of CapEff; the tools
+// to look for follow the function name when it is called.
+const netAdminMissingFunc = `netadmin_missing() {
+  missing=""
+  cap=""
+  while read -r key value; do
+    [ "$key" = CapEff: ] && cap=$value
+  done < /proc/self/status
+  if [ -z "$cap" ] || [ $(( 0x$cap >> 12 & 1 )) -ne 1 ]; then
+    missing="NET_ADMIN"
+  fi
+  for tool in "$@"; do
+    command -v "$tool" >/dev/null 2>&1 || missing="${missing:+$missing / }$tool binary"
+  done
+  echo "$missing"
+}
+`
+
+// netAdminProbe returns a script that prints what the container lacks to run tools
+func netAdminProbe(tools ...string) string {
+	return netAdminMissingFunc + "netadmin_missing " + strings.Join(tools, " ") + "\n"
+}
+
+// netAdminGuard returns a script prefix that stops an injected container with a clear message
+// when it cannot run tools, instead of failing on the first tc or iptables call. With the
+// FallbackToLogsOnError policy the message becomes the container's termination message.
+func netAdminGuard(tools ...string) string {
+	return netAdminMissingFunc + fmt.Sprintf(`missing=$(netadmin_missing %s)
+if [ -n "$missing" ]; then
+  echo "container lacks $missing" >&2
+  exit 1
+fi
+`, strings.Join(tools, " "))
+}
+
+// netAdminError is a target container that cannot change network settings
+type netAdminError struct {
+	Pod       string
+	Container string
+	// Missing is what the container lacks, such as "NET_ADMIN / tc binary"
+	Missing string
+}
+
+func (e *netAdminError) Error() string {
+	return fmt.Sprintf("container %s in pod %s lacks %s", e.Container, e.Pod, e.Missing)
+}
+
+// isPodSecurityRejection reports whether Pod Security admission rejected an injected container,
+// which happens when the namespace level does not allow NET_ADMIN
+func isPodSecurityRejection(err error) bool {
+	return apierrors.IsForbidden(err) && strings.Contains(err.Error(), "violates PodSecurity")
+}
+
+// injectionFailureReason returns why a network chaos container could not be used, for the status
+// message, or "" when err says nothing actionable
+func injectionFailureReason(err error) string {
+	var netAdminErr *netAdminError
+	switch {
+	case err == nil:
+		return ""
+	case errors.As(err, &netAdminErr):
+		return netAdminErr.Error()
+	case isPodSecurityRejection(err):
+		return "Pod Security admission does not allow the NET_ADMIN capability in the target namespace"
+	}
+	return ""
+}
+
+// probeNetAdmin checks the target container for NET_ADMIN and the tc binary. A container
+// without a shell cannot be probed or run the delay either and is reported as such.
+func (r *ChaosExperimentReconciler) probeNetAdmin(ctx context.Context, pod *corev1.Pod, containerName string) error {
+	stdout, stderr, err := r.execInPod(ctx, pod.Namespace, pod.Name, containerName,
+		[]string{"/bin/sh", "-c", netAdminProbe("tc")})
+	if err != nil {
+		if strings.Contains(err.Error(), "executable file not found") || strings.Contains(err.Error(), "no such file or directory") {
+			return &netAdminError{Pod: pod.Name, Container: containerName, Missing: "a shell"}
+		}
+		return fmt.Errorf("failed to probe container %s: %w (stderr: %s)", containerName, err, r.podRedactor(pod).Scrub(stderr))
+	}
+	if missing := strings.TrimSpace(stdout); missing != "" {
+		return &netAdminError{Pod: pod.Name, Container: containerName, Missing: missing}
+	}
+	return nil
+}
+
+// injectNetworkDelayHelper applies the delay from an ephemeral container with NET_ADMIN and tc.
+// It shares the pod's network namespace, so the qdisc stays after the helper exits, as with exec.
+func (r *ChaosExperimentReconciler) injectNetworkDelayHelper(ctx context.Context, pod *corev1.Pod, delayMs int) (string, error) {
+	containerName := fmt.Sprintf("network-delay-%d", time.Now().Unix())
+	ephemeralContainer := corev1.EphemeralContainer{
+		EphemeralContainerCommon: corev1.EphemeralContainerCommon{
+			Name:                     containerName,
+			Image:                    "ghcr.io/neogan74/iproute2:latest",
+			Command:                  []string{"/bin/sh", "-c", netAdminGuard("tc") + netemReplaceScript(fmt.Sprintf("delay %dms", delayMs))},
+			TerminationMessagePolicy: corev1.TerminationMessageFallbackToLogsOnError,
+			SecurityContext: &corev1.SecurityContext{
+				Capabilities: &corev1.Capabilities{
+					Add: []corev1.Capability{"NET_ADMIN"},
+				},
+			},
+		},
+	}
+	if err := r.updatePodWithEphemeralContainer(ctx, pod, ephemeralContainer); err != nil {
+		return "", err
+	}
+
+	ctrl.LoggerFrom(ctx).Info("Injected network delay helper container",
+		"pod", pod.Name,
+		"container", containerName,
+		"delayMs", delayMs)
+	return containerName, nil
+}
@@ -0,0 +1,63 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+	"os/exec"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+// podSecurityRejection is the error of an ephemeral container update that Pod Security admission refused
+func podSecurityRejection() error {
+	return fmt.Errorf("failed to inject ephemeral container after 1 attempts: %w", apierrors.NewForbidden(podsResource, "web",
+		fmt.Errorf(`violates PodSecurity "baseline:latest": non-default capabilities (container "network-loss-1" must not include "NET_ADMIN" in securityContext.capabilities.add)`)))
+}
+
+func TestNetAdminProbe(t *testing.T) {
+	if _, err := exec.LookPath("sh"); err != nil {
+		t.Skip("sh not available")
+	}
+	out, err := exec.Command("sh", "-c", netAdminProbe("sh", "chaos-no-such-tool")).CombinedOutput()
+	require.NoError(t, err, string(out))
+	assert.Contains(t, string(out), "chaos-no-such-tool binary")
+	assert.NotContains(t, string(out), "sh binary")
+
+	// The guard stops the script with the same finding
+	out, err = exec.Command("sh", "-c", netAdminGuard("chaos-no-such-tool")+"echo applied\n").CombinedOutput()
+	require.Error(t, err)
+	assert.Contains(t, string(out), "container lacks ")
+	assert.Contains(t, string(out), "chaos-no-such-tool binary")
+	assert.NotContains(t, string(out), "applied")
+}
+
+func TestInjectionFailureReason(t *testing.T) {
+	assert.Equal(t, "container app in pod web lacks NET_ADMIN / tc binary",
+		injectionFailureReason(fmt.Errorf("exec: %w", &netAdminError{Pod: "web", Container: "app", Missing: "NET_ADMIN / tc binary"})))
+	assert.Contains(t, injectionFailureReason(podSecurityRejection()), "Pod Security admission")
+	assert.Empty(t, injectionFailureReason(apierrors.NewNotFound(podsResource, "web")))
+	assert.Empty(t, injectionFailureReason(nil))
+
+	// Pod Security rejections are not RBAC problems
+	assert.False(t, isPermissionDeniedError(podSecurityRejection()))
+	assert.True(t, strings.Contains(Remediation(WrapK8sError(podSecurityRejection(), "inject")), "Pod Security level"))
+}
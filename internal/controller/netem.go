@@ -0,0 +1,78 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+	"strings"
+)
+
+// netemInterfaces is a shell function printing the interfaces that carry a default route, IPv4 or
+// IPv6, one per line. Pods with extra interfaces (Multus) or an IPv6-only primary route are shaped
+// where their traffic leaves; eth0 is the fallback when routes cannot be read. It only needs sh and
+// ip, so it runs in the target container as well as in the iproute2 image.
+const netemInterfaces = `netem_interfaces() {
+  { ip -o -4 route show default; ip -o -6 route show default; } 2>/dev/null | while read -r line; do
+    set -- $line
+    while [ $# -gt 1 ]; do
+      if [ "$1" = dev ]; then
+        echo "$2"
+        break
+      fi
+      shift
+    done
+  done | sort -u
+}
+devs=$(netem_interfaces)
+[ -n "$devs" ] || devs=eth0
+`
+
+// netemScript returns the script of an ephemeral container that applies a netem qdisc with args
+// to every default-route interface for the given seconds. Only qdiscs it added are removed, when
+// the time is up or the container is stopped. It exits early when the container cannot run tc.
+func netemScript(args string, seconds int) string {
+	var b strings.Builder
+	b.WriteString(netAdminGuard("tc"))
+	b.WriteString(netemInterfaces)
+	fmt.Fprintf(&b, `added=""
+cleanup() {
+  for dev in $added; do
+    tc qdisc del dev "$dev" root 2>/dev/null
+  done
+  return 0
+}
+trap cleanup EXIT
+trap 'exit 0' TERM INT
+for dev in $devs; do
+  tc qdisc add dev "$dev" root netem %s || exit 1
+  added="$added $dev"
+done
+sleep %d &
+wait $!
+`, args, seconds)
+	return b.String()
+}
+
+// netemReplaceScript returns a script that replaces the root qdisc of every default-route
+// interface with netem args and leaves it in place
+func netemReplaceScript(args string) string {
+	return netemInterfaces + fmt.Sprintf(`for dev in $devs; do
+  tc qdisc del dev "$dev" root 2>/dev/null
+  tc qdisc add dev "$dev" root netem %s || exit 1
+done
+`, args)
+}
@@ -0,0 +1,62 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"os/exec"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNetemScript(t *testing.T) {
+	script := netemScript("loss 10% 25%", 30)
+
+	assert.Contains(t, script, `tc qdisc add dev "$dev" root netem loss 10% 25% || exit 1`)
+	assert.Contains(t, script, "sleep 30 &")
+	assert.Contains(t, script, "trap cleanup EXIT")
+	assert.NotContains(t, script, "eth0 root")
+	requireValidShell(t, script)
+	requireValidShell(t, netemReplaceScript("delay 100ms"))
+}
+
+func TestNetemInterfaces(t *testing.T) {
+	if _, err := exec.LookPath("sh"); err != nil {
+		t.Skip("sh not available")
+	}
+	run := func(routes4, routes6 string) string {
+		// A fake ip prints the given default routes per family
+		fake := `ip() {
+  if [ "$2" = -4 ]; then printf '%s' '` + routes4 + `'; else printf '%s' '` + routes6 + `'; fi
+}
+`
+		out, err := exec.Command("sh", "-c", fake+netemInterfaces+`echo $devs`).CombinedOutput()
+		require.NoError(t, err, string(out))
+		return strings.TrimSpace(string(out))
+	}
+
+	// Dual-stack on a single interface
+	assert.Equal(t, "eth0", run("default via 10.0.0.1 dev eth0\n", "default via fe80::1 dev eth0 metric 1024 pref medium\n"))
+	// IPv6-only pod with a differently named interface
+	assert.Equal(t, "net1", run("", "default via fe80::1 dev net1 proto ra metric 1024\n"))
+	// Multus: a second interface with its own default route
+	assert.Equal(t, "eth0 net1", run("default via 10.0.0.1 dev eth0\ndefault via 192.168.5.1 dev net1 metric 200\n", ""))
+	// No routes readable
+	assert.Equal(t, "eth0", run("", ""))
+}
@@ -0,0 +1,220 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	chaosv1alpha1 "github.com/neogan74/k8s-chaos/api/v1alpha1"
+)
+
+const (
+	// defaultMeasurementWindow is the number of pings per sample when spec.networkMeasurement
+	// does not set windowSeconds
+	defaultMeasurementWindow = 10
+	// maxNetworkMeasurements bounds status.networkMeasurements; the oldest entries are dropped first
+	maxNetworkMeasurements = 50
+	// measurementLogLimitBytes bounds the output read from one measurement container
+	measurementLogLimitBytes = 256 << 10
+)
+
+var (
+	// pingSentPattern matches the packet summary of BusyBox and iputils ping
+	pingSentPattern = regexp.MustCompile(`(\d+) packets transmitted, (\d+) (?:packets )?received`)
+	// pingRTTPattern matches the round-trip summary, whose third value is the maximum in both
+	// "min/avg/max" (BusyBox) and "min/avg/max/mdev" (iputils)
+	pingRTTPattern = regexp.MustCompile(`min/avg/max\S* = [\d.]+/([\d.]+)/([\d.]+)`)
+)
+
+// measurementScript returns the script of a measurement container: it pings target in windows of
+// window pings for the given seconds and prints one "sample" line with ping's summary per window
+func measurementScript(target string, window, seconds int) string {
+	return fmt.Sprintf(`trap 'exit 0' TERM INT
+end=$(($(date +%%s) + %[3]d))
+while [ "$(date +%%s)" -lt "$end" ]; do
+  out=$(ping -c %[2]d -W 1 %[1]q 2>&1 | grep -E 'transmitted|min/avg')
+  if [ -n "$out" ]; then
+    echo "sample $(echo "$out" | tr '\n' ' ')"
+  else
+    echo "ping %[1]s failed"
+    sleep %[2]d
+  fi
+done
+`, target, window, seconds)
+}
+
+// measurementWindow returns the pings per sample of a measurement
+func measurementWindow(m *chaosv1alpha1.NetworkMeasurement) int {
+	if m.WindowSeconds > 0 {
+		return int(m.WindowSeconds)
+	}
+	return defaultMeasurementWindow
+}
+
+// startNetworkMeasurements reads what the previous measurement containers saw, then injects a new
+// one into every affected pod for the given seconds. Pods that cannot be measured only get a log
+// entry: the measurement is an observer and never fails the experiment.
+func (r *ChaosExperimentReconciler) startNetworkMeasurements(ctx context.Context, exp *chaosv1alpha1.ChaosExperiment, affectedPods []string, seconds int) {
+	m := exp.Spec.NetworkMeasurement
+	if m == nil {
+		return
+	}
+	log := ctrl.LoggerFrom(ctx)
+	r.collectNetworkMeasurements(ctx, exp)
+
+	_, image := r.stressImages("")
+	script := measurementScript(m.Target, measurementWindow(m), seconds)
+	for _, name := range affectedPods {
+		pod := &corev1.Pod{}
+		if err := r.Get(ctx, types.NamespacedName{Namespace: exp.Spec.Namespace, Name: name}, pod); err != nil {
+			log.Error(err, "Failed to get pod for network measurement", "pod", name)
+			continue
+		}
+		containerName := fmt.Sprintf("netmeasure-%d", time.Now().Unix())
+		container := corev1.EphemeralContainer{
+			EphemeralContainerCommon: corev1.EphemeralContainerCommon{
+				Name:    containerName,
+				Image:   image,
+				Command: []string{"/bin/sh", "-c", script},
+				SecurityContext: &corev1.SecurityContext{
+					Capabilities: &corev1.Capabilities{Add: []corev1.Capability{"NET_RAW"}},
+				},
+			},
+		}
+		if err := r.updatePodWithEphemeralContainer(ctx, pod, container); err != nil {
+			log.Error(err, "Failed to inject network measurement container", "pod", name)
+			continue
+		}
+		exp.Status.NetworkMeasurements = append(exp.Status.NetworkMeasurements, chaosv1alpha1.NetworkMeasurementResult{
+			Pod:       pod.Namespace + "/" + pod.Name,
+			Container: containerName,
+			Target:    m.Target,
+		})
+	}
+	if excess := len(exp.Status.NetworkMeasurements) - maxNetworkMeasurements; excess > 0 {
+		exp.Status.NetworkMeasurements = exp.Status.NetworkMeasurements[excess:]
+	}
+}
+
+// collectNetworkMeasurements updates the entries of status.networkMeasurements that are not
+// complete from the output of their containers
+func (r *ChaosExperimentReconciler) collectNetworkMeasurements(ctx context.Context, exp *chaosv1alpha1.ChaosExperiment) {
+	log := ctrl.LoggerFrom(ctx)
+	for i := range exp.Status.NetworkMeasurements {
+		result := &exp.Status.NetworkMeasurements[i]
+		if result.Complete {
+			continue
+		}
+		namespace, name, _ := strings.Cut(result.Pod, "/")
+		key := types.NamespacedName{Namespace: namespace, Name: name}
+		pod := &corev1.Pod{}
+		if err := r.Get(ctx, key, pod); err != nil {
+			if apierrors.IsNotFound(err) {
+				// The output went with the pod; keep what was read so far
+				result.Complete = true
+			}
+			continue
+		}
+		output, err := r.readMeasurementLog(ctx, key, result.Container)
+		if err != nil {
+			log.V(1).Info("Failed to read network measurement", "pod", result.Pod,
+				"container", result.Container, "error", err.Error())
+			continue
+		}
+		applyMeasurementLog(result, output)
+		result.Complete = ephemeralContainerExited(pod, result.Container)
+	}
+}
+
+// readMeasurementLog returns the output of a measurement container
+func (r *ChaosExperimentReconciler) readMeasurementLog(ctx context.Context, pod types.NamespacedName, container string) (string, error) {
+	if r.measurementLogs != nil {
+		return r.measurementLogs(ctx, pod, container)
+	}
+	if r.Clientset == nil {
+		return "", fmt.Errorf("no clientset to read the logs of pod %s", pod)
+	}
+	limitBytes := int64(measurementLogLimitBytes)
+	raw, err := r.Clientset.CoreV1().Pods(pod.Namespace).GetLogs(pod.Name, &corev1.PodLogOptions{
+		Container:  container,
+		LimitBytes: &limitBytes,
+	}).DoRaw(ctx)
+	return string(raw), err
+}
+
+// ephemeralContainerExited reports whether the named ephemeral container terminated
+func ephemeralContainerExited(pod *corev1.Pod, name string) bool {
+	for _, status := range pod.Status.EphemeralContainerStatuses {
+		if status.Name == name {
+			return status.State.Terminated != nil
+		}
+	}
+	return false
+}
+
+// applyMeasurementLog sets the totals of result from the sample lines of a measurement container
+func applyMeasurementLog(result *chaosv1alpha1.NetworkMeasurementResult, output string) {
+	var samples, sent, received int64
+	var rttSum, rttMax float64
+	for _, line := range strings.Split(output, "\n") {
+		if !strings.HasPrefix(line, "sample ") {
+			continue
+		}
+		counts := pingSentPattern.FindStringSubmatch(line)
+		if counts == nil {
+			continue
+		}
+		windowSent, _ := strconv.ParseInt(counts[1], 10, 32)
+		windowReceived, _ := strconv.ParseInt(counts[2], 10, 32)
+		samples++
+		sent += windowSent
+		received += windowReceived
+		if rtt := pingRTTPattern.FindStringSubmatch(line); rtt != nil && windowReceived > 0 {
+			avg, _ := strconv.ParseFloat(rtt[1], 64)
+			maxRTT, _ := strconv.ParseFloat(rtt[2], 64)
+			rttSum += avg * float64(windowReceived)
+			rttMax = max(rttMax, maxRTT)
+		}
+	}
+
+	result.Samples = int32(samples)
+	result.PacketsSent = int32(sent)
+	result.PacketsReceived = int32(received)
+	result.LossPercentage, result.AvgRTT, result.MaxRTT = 0, "", ""
+	if sent > 0 {
+		result.LossPercentage = int32((sent - received) * 100 / sent)
+	}
+	if received > 0 {
+		result.AvgRTT = formatRTT(rttSum / float64(received))
+		result.MaxRTT = formatRTT(rttMax)
+	}
+}
+
+// formatRTT formats milliseconds as a duration rounded to 0.1ms
+func formatRTT(ms float64) string {
+	return time.Duration(ms * float64(time.Millisecond)).Round(100 * time.Microsecond).String()
+}
@@ -0,0 +1,122 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"os/exec"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	chaosv1alpha1 "github.com/neogan74/k8s-chaos/api/v1alpha1"
+)
+
+func TestApplyMeasurementLog(t *testing.T) {
+	output := `sample 10 packets transmitted, 8 packets received, 20% packet loss round-trip min/avg/max = 1.000/10.000/40.000 ms 
+ping db.data.svc failed
+sample 10 packets transmitted, 10 received, 0% packet loss, time 9012ms rtt min/avg/max/mdev = 2.000/20.000/25.000/1.500 ms 
+sample 10 packets transmitted, 0 packets received, 100% packet loss 
+`
+	result := chaosv1alpha1.NetworkMeasurementResult{Pod: "shop/web", Container: "netmeasure-1", Target: "db.data.svc"}
+	applyMeasurementLog(&result, output)
+
+	assert.Equal(t, int32(3), result.Samples)
+	assert.Equal(t, int32(30), result.PacketsSent)
+	assert.Equal(t, int32(18), result.PacketsReceived)
+	assert.Equal(t, int32(40), result.LossPercentage)
+	// (8 x 10ms + 10 x 20ms) / 18 replies
+	assert.Equal(t, "15.6ms", result.AvgRTT)
+	assert.Equal(t, "40ms", result.MaxRTT)
+
+	// Nothing measured yet
+	applyMeasurementLog(&result, "")
+	assert.Zero(t, result.Samples)
+	assert.Empty(t, result.AvgRTT)
+}
+
+func TestNetworkMeasurements_StartAndCollect(t *testing.T) {
+	ctx := context.Background()
+	pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "shop"}}
+	r := newReconcilerWithObjects(t, pod)
+	// The fake client has no ephemeralcontainers subresource; write the pod instead
+	r.Client = interceptor.NewClient(r.Client.(client.WithWatch), interceptor.Funcs{
+		SubResourceUpdate: func(ctx context.Context, c client.Client, subResource string, obj client.Object, opts ...client.SubResourceUpdateOption) error {
+			if subResource == "ephemeralcontainers" {
+				return c.Update(ctx, obj)
+			}
+			return c.SubResource(subResource).Update(ctx, obj, opts...)
+		},
+	})
+	exp := &chaosv1alpha1.ChaosExperiment{
+		ObjectMeta: metav1.ObjectMeta{Name: "lossy", Namespace: "chaos"},
+		Spec: chaosv1alpha1.ChaosExperimentSpec{
+			Action:             "pod-network-loss",
+			Namespace:          "shop",
+			NetworkMeasurement: &chaosv1alpha1.NetworkMeasurement{Target: "db.data.svc", WindowSeconds: 5},
+		},
+	}
+
+	r.startNetworkMeasurements(ctx, exp, []string{"web", "gone"}, 60)
+
+	require.Len(t, exp.Status.NetworkMeasurements, 1, "pods that went away are skipped")
+	measurement := exp.Status.NetworkMeasurements[0]
+	assert.Equal(t, "shop/web", measurement.Pod)
+	assert.Equal(t, "db.data.svc", measurement.Target)
+
+	injected := &corev1.Pod{}
+	require.NoError(t, r.Get(ctx, types.NamespacedName{Namespace: "shop", Name: "web"}, injected))
+	require.Len(t, injected.Spec.EphemeralContainers, 1)
+	container := injected.Spec.EphemeralContainers[0]
+	assert.Equal(t, measurement.Container, container.Name)
+	assert.Equal(t, defaultStressFallbackImage, container.Image)
+	assert.Contains(t, container.Command[2], `ping -c 5 -W 1 "db.data.svc"`)
+	assert.Equal(t, []corev1.Capability{"NET_RAW"}, container.SecurityContext.Capabilities.Add)
+
+	// While the container runs the totals are refreshed; once it exited they are final
+	r.measurementLogs = func(_ context.Context, _ types.NamespacedName, name string) (string, error) {
+		assert.Equal(t, measurement.Container, name)
+		return "sample 5 packets transmitted, 4 packets received, 20% packet loss round-trip min/avg/max = 1.0/3.0/5.0 ms\n", nil
+	}
+	r.collectNetworkMeasurements(ctx, exp)
+	assert.Equal(t, int32(20), exp.Status.NetworkMeasurements[0].LossPercentage)
+	assert.False(t, exp.Status.NetworkMeasurements[0].Complete)
+
+	injected.Status.EphemeralContainerStatuses = []corev1.ContainerStatus{{
+		Name:  measurement.Container,
+		State: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{}},
+	}}
+	require.NoError(t, r.Status().Update(ctx, injected))
+	r.collectNetworkMeasurements(ctx, exp)
+	assert.True(t, exp.Status.NetworkMeasurements[0].Complete)
+	assert.Equal(t, "3ms", exp.Status.NetworkMeasurements[0].AvgRTT)
+}
+
+func TestMeasurementScript(t *testing.T) {
+	sh, err := exec.LookPath("sh")
+	if err != nil {
+		t.Skip("no sh")
+	}
+	out, err := exec.Command(sh, "-n", "-c", measurementScript("10.0.0.1", 10, 60)).CombinedOutput()
+	assert.NoError(t, err, string(out))
+}
@@ -0,0 +1,360 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	chaosv1alpha1 "github.com/neogan74/k8s-chaos/api/v1alpha1"
+)
+
+// externalResolveInterval is how often a partition container re-resolves the DNS names of
+// externalTargets
+const externalResolveInterval = 30 * time.Second
+
+// ipFamily holds the per-family commands of a partition
+type ipFamily struct {
+	Name     string
+	Iptables string
+	// ICMP is the protocol name of ICMP for this family
+	ICMP string
+	// Record is the DNS record type holding addresses of this family
+	Record string
+	// Pattern matches an address of this family in dig output
+	Pattern string
+}
+
+var (
+	ipv4Family = ipFamily{Name: "IPv4", Iptables: "iptables", ICMP: "icmp", Record: "A", Pattern: `^[0-9]+(\.[0-9]+){3}$`}
+	ipv6Family = ipFamily{Name: "IPv6", Iptables: "ip6tables", ICMP: "ipv6-icmp", Record: "AAAA", Pattern: `^[0-9a-fA-F:]*:[0-9a-fA-F:.]*$`}
+)
+
+// matches reports whether an IP or CIDR belongs to the family
+func (f ipFamily) matches(address string) bool {
+	return strings.Contains(address, ":") == (f == ipv6Family)
+}
+
+// podIPFamilies returns the IP families of a pod's addresses. A pod that reports no IP is assumed
+// to be IPv4, as before dual-stack support.
+func podIPFamilies(pod *corev1.Pod) []ipFamily {
+	var hasIPv4, hasIPv6 bool
+	for _, podIP := range podIPs(pod) {
+		if ip := net.ParseIP(podIP); ip != nil {
+			if ip.To4() != nil {
+				hasIPv4 = true
+			} else {
+				hasIPv6 = true
+			}
+		}
+	}
+	var families []ipFamily
+	if hasIPv4 || !hasIPv6 {
+		families = append(families, ipv4Family)
+	}
+	if hasIPv6 {
+		families = append(families, ipv6Family)
+	}
+	return families
+}
+
+// partitionRules is what a network-partition container blocks
+type partitionRules struct {
+	Direction string
+	// Addresses are the IPs and CIDRs to block; with no Hosts either, every address is blocked
+	Addresses []string
+	// Hosts are DNS names the container resolves and re-resolves itself
+	Hosts     []string
+	Ports     []int32
+	Protocols []string
+	// Families are the IP families the pod has; empty means IPv4 only
+	Families []ipFamily
+}
+
+// partitionRulesFor combines the selective targeting fields of the spec with the resolved peer IPs.
+// External IPs and CIDRs are blocked like targetIPs; external DNS names are left to the container.
+func partitionRulesFor(spec *chaosv1alpha1.ChaosExperimentSpec, direction string, peerIPs []string) partitionRules {
+	addresses := make([]string, 0, len(spec.TargetIPs)+len(spec.TargetCIDRs)+len(peerIPs)+len(spec.ExternalTargets))
+	addresses = append(addresses, spec.TargetIPs...)
+	addresses = append(addresses, spec.TargetCIDRs...)
+	addresses = append(addresses, peerIPs...)
+	var hosts []string
+	for _, target := range spec.ExternalTargets {
+		if strings.Contains(target, "/") || net.ParseIP(target) != nil {
+			addresses = append(addresses, target)
+			continue
+		}
+		hosts = append(hosts, strings.TrimSuffix(target, "."))
+	}
+	return partitionRules{
+		Direction: direction,
+		Addresses: addresses,
+		Hosts:     hosts,
+		Ports:     spec.TargetPorts,
+		Protocols: spec.TargetProtocols,
+	}
+}
+
+// forPod returns the rules for the IP families of pod. Addresses of a family the pod does not have
+// cannot be reached from it and are left out; when that leaves nothing to block, an error says so.
+func (p partitionRules) forPod(pod *corev1.Pod) (partitionRules, error) {
+	p.Families = podIPFamilies(pod)
+	if len(p.Addresses) == 0 || len(p.Hosts) > 0 {
+		return p, nil
+	}
+	for _, family := range p.Families {
+		if len(p.familyAddresses(family)) > 0 {
+			return p, nil
+		}
+	}
+	names := make([]string, 0, len(p.Families))
+	for _, family := range p.Families {
+		names = append(names, family.Name)
+	}
+	return p, fmt.Errorf("pod %s/%s only has %s addresses and none of the partition targets are of that family",
+		pod.Namespace, pod.Name, strings.Join(names, "/"))
+}
+
+// families returns the IP families to write rules for
+func (p partitionRules) families() []ipFamily {
+	if len(p.Families) == 0 {
+		return []ipFamily{ipv4Family}
+	}
+	return p.Families
+}
+
+// familyAddresses returns the addresses of the given family
+func (p partitionRules) familyAddresses(family ipFamily) []string {
+	var addresses []string
+	for _, address := range p.Addresses {
+		if family.matches(address) {
+			addresses = append(addresses, address)
+		}
+	}
+	return addresses
+}
+
+// protocolMatches returns the iptables protocol and port match of each rule; a single empty match
+// covers all traffic. Ports default to TCP, and ICMP, which has no ports, is matched as a whole.
+func (p partitionRules) protocolMatches(family ipFamily) []string {
+	protocol := func(name string) string {
+		if name == "icmp" {
+			return family.ICMP
+		}
+		return name
+	}
+	protocols := p.Protocols
+	if len(p.Ports) == 0 {
+		if len(protocols) == 0 {
+			return []string{""}
+		}
+		matches := make([]string, 0, len(protocols))
+		for _, name := range protocols {
+			matches = append(matches, " -p "+protocol(name))
+		}
+		return matches
+	}
+
+	if len(protocols) == 0 {
+		protocols = []string{"tcp"}
+	}
+	var matches []string
+	for _, name := range protocols {
+		if name == "icmp" {
+			matches = append(matches, " -p "+protocol(name))
+			continue
+		}
+		for _, port := range p.Ports {
+			matches = append(matches, fmt.Sprintf(" -p %s --dport %d", name, port))
+		}
+	}
+	return matches
+}
+
+// chainRules returns the commands filling chain with DROP rules for addresses, or for all traffic
+// when addresses is empty. addressFlag is -s for ingress and -d for egress.
+func (p partitionRules) chainRules(family ipFamily, chain, loopbackFlag, addressFlag string, addresses []string) []string {
+	rules := []string{
+		fmt.Sprintf("%s -N %s", family.Iptables, chain),
+		// Keep loopback traffic so processes in the pod can still talk to each other
+		fmt.Sprintf("%s -A %s %s lo -j ACCEPT", family.Iptables, chain, loopbackFlag),
+	}
+	if len(addresses) == 0 {
+		addresses = []string{""}
+	}
+	for _, address := range addresses {
+		addressMatch := ""
+		if address != "" {
+			addressMatch = fmt.Sprintf(" %s %s", addressFlag, address)
+		}
+		for _, match := range p.protocolMatches(family) {
+			rules = append(rules, fmt.Sprintf("%s -A %s%s%s -j DROP", family.Iptables, chain, addressMatch, match))
+		}
+	}
+	return rules
+}
+
+// script returns the shell script of the partition container. Rules live in chains of their own,
+// one per direction and IP family, so they never touch CNI or mesh rules and cleanup removes
+// exactly what was added. The chains are removed when the duration ends or the container is
+// stopped.
+//
+// DNS names get a second pair of chains that is refilled every externalResolveInterval, so a
+// managed service that moves to new addresses stays unreachable. A failed lookup keeps the
+// previous addresses rather than lifting the block.
+func (p partitionRules) script(chainSuffix string, timeoutSeconds int) string {
+	type chainSet struct{ hook, static, dynamic, loopbackFlag, addressFlag string }
+	var chains []chainSet
+	if p.Direction == "both" || p.Direction == "ingress" {
+		chains = append(chains, chainSet{"INPUT", "CHAOS_PART_IN_" + chainSuffix, "CHAOS_PART_XIN_" + chainSuffix, "-i", "-s"})
+	}
+	if p.Direction == "both" || p.Direction == "egress" {
+		chains = append(chains, chainSet{"OUTPUT", "CHAOS_PART_OUT_" + chainSuffix, "CHAOS_PART_XOUT_" + chainSuffix, "-o", "-d"})
+	}
+	// Only DNS names to block: leave every other address, in-cluster traffic included, alone
+	isolate := len(p.Addresses) == 0 && len(p.Hosts) == 0
+	families := p.families()
+
+	tools := make([]string, 0, len(families)+1)
+	for _, family := range families {
+		tools = append(tools, family.Iptables)
+	}
+	if len(p.Hosts) > 0 {
+		tools = append(tools, "dig")
+	}
+
+	var b strings.Builder
+	b.WriteString(netAdminGuard(tools...))
+	b.WriteString("cleanup() {\n")
+	for _, family := range families {
+		for _, c := range chains {
+			for _, chain := range []string{c.static, c.dynamic} {
+				fmt.Fprintf(&b, "  %[1]s -D %[2]s -j %[3]s 2>/dev/null\n  %[1]s -F %[3]s 2>/dev/null\n  %[1]s -X %[3]s 2>/dev/null\n",
+					family.Iptables, c.hook, chain)
+			}
+		}
+	}
+	b.WriteString("  return 0\n}\ntrap cleanup EXIT\ntrap 'exit 0' TERM INT\nset -e\n")
+
+	for _, family := range families {
+		addresses := p.familyAddresses(family)
+		for _, c := range chains {
+			if isolate || len(addresses) > 0 {
+				for _, rule := range p.chainRules(family, c.static, c.loopbackFlag, c.addressFlag, addresses) {
+					b.WriteString(rule + "\n")
+				}
+				fmt.Fprintf(&b, "%s -I %s 1 -j %s\n", family.Iptables, c.hook, c.static)
+			}
+			if len(p.Hosts) > 0 {
+				fmt.Fprintf(&b, "%[1]s -N %[3]s\n%[1]s -I %[2]s 1 -j %[3]s\n", family.Iptables, c.hook, c.dynamic)
+			}
+		}
+	}
+	b.WriteString("set +e\n")
+
+	if len(p.Hosts) == 0 {
+		fmt.Fprintf(&b, "sleep %d &\nwait $!\n", timeoutSeconds)
+		return b.String()
+	}
+
+	b.WriteString("refresh_external() {\n")
+	for _, family := range families {
+		fmt.Fprintf(&b, "  ips=$(for h in")
+		for _, host := range p.Hosts {
+			fmt.Fprintf(&b, " '%s'", host)
+		}
+		fmt.Fprintf(&b, "; do dig +short %s \"$h\"; done | grep -E '%s' | sort -u)\n", family.Record, family.Pattern)
+		b.WriteString("  if [ -n \"$ips\" ]; then\n")
+		for _, c := range chains {
+			fmt.Fprintf(&b, "    %s -F %s\n", family.Iptables, c.dynamic)
+		}
+		b.WriteString("    for ip in $ips; do\n")
+		for _, c := range chains {
+			for _, match := range p.protocolMatches(family) {
+				fmt.Fprintf(&b, "      %s -A %s %s \"$ip\"%s -j DROP\n", family.Iptables, c.dynamic, c.addressFlag, match)
+			}
+		}
+		b.WriteString("    done\n  fi\n")
+	}
+	b.WriteString("  return 0\n}\n")
+	fmt.Fprintf(&b, `end=$(( $(date +%%s) + %d ))
+while :; do
+  refresh_external
+  left=$(( end - $(date +%%s) ))
+  [ "$left" -gt 0 ] || break
+  [ "$left" -le %[2]d ] || left=%[2]d
+  sleep "$left" &
+  wait $!
+done
+`, timeoutSeconds, int(externalResolveInterval.Seconds()))
+	return b.String()
+}
+
+// resolvePartitionPeers returns the sorted IPv4 and IPv6 addresses of the running pods matching
+// peerSelector in peerNamespaces, or the target namespace when none are listed
+func (r *ChaosExperimentReconciler) resolvePartitionPeers(ctx context.Context, exp *chaosv1alpha1.ChaosExperiment) ([]string, error) {
+	namespaces := exp.Spec.PeerNamespaces
+	if len(namespaces) == 0 {
+		namespaces = []string{exp.Spec.Namespace}
+	}
+
+	seen := map[string]bool{}
+	var ips []string
+	for _, namespace := range namespaces {
+		pods, err := r.listPods(ctx, client.InNamespace(namespace), client.MatchingLabels(exp.Spec.PeerSelector))
+		if err != nil {
+			return nil, fmt.Errorf("failed to list peer pods in namespace %s: %w", namespace, err)
+		}
+		for _, pod := range pods {
+			if pod.DeletionTimestamp != nil || pod.Status.Phase != corev1.PodRunning || pod.Spec.HostNetwork {
+				// Host-network pods share the node IP; blocking it would cut the node off
+				continue
+			}
+			for _, podIP := range podIPs(&pod) {
+				if net.ParseIP(podIP) == nil || seen[podIP] {
+					continue
+				}
+				seen[podIP] = true
+				ips = append(ips, podIP)
+			}
+		}
+	}
+	sort.Strings(ips)
+	return ips, nil
+}
+
+// podIPs returns all IPs of a pod, falling back to status.podIP
+func podIPs(pod *corev1.Pod) []string {
+	if len(pod.Status.PodIPs) == 0 {
+		if pod.Status.PodIP == "" {
+			return nil
+		}
+		return []string{pod.Status.PodIP}
+	}
+	ips := make([]string, 0, len(pod.Status.PodIPs))
+	for _, podIP := range pod.Status.PodIPs {
+		ips = append(ips, podIP.IP)
+	}
+	return ips
+}
@@ -0,0 +1,201 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"os/exec"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	chaosv1alpha1 "github.com/neogan74/k8s-chaos/api/v1alpha1"
+)
+
+// requireValidShell checks the script parses, when a shell is available
+func requireValidShell(t *testing.T, script string) {
+	t.Helper()
+	if _, err := exec.LookPath("sh"); err != nil {
+		return
+	}
+	out, err := exec.Command("sh", "-n", "-c", script).CombinedOutput()
+	require.NoError(t, err, string(out))
+}
+
+func TestPartitionScript_FullIsolation(t *testing.T) {
+	script := partitionRules{Direction: "both"}.script("42", 30)
+
+	assert.Contains(t, script, "iptables -A CHAOS_PART_IN_42 -i lo -j ACCEPT\niptables -A CHAOS_PART_IN_42 -j DROP\n")
+	assert.Contains(t, script, "iptables -A CHAOS_PART_OUT_42 -o lo -j ACCEPT\niptables -A CHAOS_PART_OUT_42 -j DROP\n")
+	assert.Contains(t, script, "iptables -I INPUT 1 -j CHAOS_PART_IN_42")
+	assert.Contains(t, script, "iptables -I OUTPUT 1 -j CHAOS_PART_OUT_42")
+	assert.Contains(t, script, "sleep 30 &")
+	requireValidShell(t, script)
+}
+
+func TestPartitionScript_PeersAndPorts(t *testing.T) {
+	spec := &chaosv1alpha1.ChaosExperimentSpec{
+		TargetCIDRs:     []string{"10.96.0.0/12"},
+		TargetPorts:     []int32{5432},
+		TargetProtocols: []string{"tcp", "icmp"},
+	}
+	rules := partitionRulesFor(spec, "egress", []string{"10.0.0.7"})
+	script := rules.script("42", 30)
+
+	assert.Contains(t, script, "iptables -A CHAOS_PART_OUT_42 -d 10.96.0.0/12 -p tcp --dport 5432 -j DROP")
+	assert.Contains(t, script, "iptables -A CHAOS_PART_OUT_42 -d 10.0.0.7 -p tcp --dport 5432 -j DROP")
+	assert.Contains(t, script, "iptables -A CHAOS_PART_OUT_42 -d 10.0.0.7 -p icmp -j DROP")
+	// Egress only: inbound traffic is untouched
+	assert.NotContains(t, script, "CHAOS_PART_IN_42 -")
+	assert.NotContains(t, script, "-j DROP\niptables -I INPUT")
+	requireValidShell(t, script)
+}
+
+func TestPartitionScript_ExternalTargets(t *testing.T) {
+	spec := &chaosv1alpha1.ChaosExperimentSpec{
+		ExternalTargets: []string{"db.example.com.", "203.0.113.0/24", "198.51.100.7"},
+		TargetPorts:     []int32{5432},
+	}
+	rules := partitionRulesFor(spec, "egress", nil)
+	assert.Equal(t, []string{"203.0.113.0/24", "198.51.100.7"}, rules.Addresses)
+	assert.Equal(t, []string{"db.example.com"}, rules.Hosts)
+
+	script := rules.script("42", 90)
+	assert.Contains(t, script, "iptables -A CHAOS_PART_OUT_42 -d 203.0.113.0/24 -p tcp --dport 5432 -j DROP")
+	assert.Contains(t, script, "iptables -N CHAOS_PART_XOUT_42\niptables -I OUTPUT 1 -j CHAOS_PART_XOUT_42\n")
+	assert.Contains(t, script, "for h in 'db.example.com'; do dig +short A \"$h\"")
+	assert.Contains(t, script, `iptables -A CHAOS_PART_XOUT_42 -d "$ip" -p tcp --dport 5432 -j DROP`)
+	assert.Contains(t, script, "end=$(( $(date +%s) + 90 ))")
+	assert.Contains(t, script, `[ "$left" -le 30 ] || left=30`)
+	assert.Contains(t, script, "iptables -X CHAOS_PART_XOUT_42")
+	assert.NotContains(t, script, "CHAOS_PART_XIN_42")
+	requireValidShell(t, script)
+}
+
+func TestPartitionScript_ExternalHostsOnlyKeepClusterTraffic(t *testing.T) {
+	spec := &chaosv1alpha1.ChaosExperimentSpec{ExternalTargets: []string{"api.example.com"}}
+	script := partitionRulesFor(spec, "both", nil).script("42", 30)
+
+	// No catch-all DROP: only the resolved addresses are blocked
+	assert.NotContains(t, script, "CHAOS_PART_OUT_42 -j DROP")
+	assert.NotContains(t, script, "iptables -N CHAOS_PART_IN_42")
+	assert.Contains(t, script, `iptables -A CHAOS_PART_XIN_42 -s "$ip" -j DROP`)
+	assert.Contains(t, script, `iptables -A CHAOS_PART_XOUT_42 -d "$ip" -j DROP`)
+	requireValidShell(t, script)
+}
+
+func TestPartitionRules_ProtocolMatches(t *testing.T) {
+	assert.Equal(t, []string{""}, partitionRules{}.protocolMatches(ipv4Family))
+	assert.Equal(t, []string{" -p udp"}, partitionRules{Protocols: []string{"udp"}}.protocolMatches(ipv4Family))
+	assert.Equal(t, []string{" -p tcp --dport 80", " -p tcp --dport 443"},
+		partitionRules{Ports: []int32{80, 443}}.protocolMatches(ipv4Family))
+	assert.Equal(t, []string{" -p ipv6-icmp"}, partitionRules{Protocols: []string{"icmp"}}.protocolMatches(ipv6Family))
+}
+
+func TestPartitionScript_DualStack(t *testing.T) {
+	pod := partitionPeerPod("api-0", "apps", corev1.PodRunning, "10.0.0.5", "fd00::5")
+	spec := &chaosv1alpha1.ChaosExperimentSpec{TargetIPs: []string{"10.0.0.7"}, TargetCIDRs: []string{"fd00:1::/64"}}
+	rules, err := partitionRulesFor(spec, "egress", []string{"fd00::9"}).forPod(pod)
+	require.NoError(t, err)
+	script := rules.script("42", 30)
+
+	assert.Contains(t, script, "iptables -A CHAOS_PART_OUT_42 -d 10.0.0.7 -j DROP")
+	assert.Contains(t, script, "ip6tables -A CHAOS_PART_OUT_42 -d fd00:1::/64 -j DROP")
+	assert.Contains(t, script, "ip6tables -A CHAOS_PART_OUT_42 -d fd00::9 -j DROP")
+	assert.Contains(t, script, "ip6tables -I OUTPUT 1 -j CHAOS_PART_OUT_42")
+	assert.Contains(t, script, "ip6tables -X CHAOS_PART_OUT_42")
+	assert.NotContains(t, script, "iptables -A CHAOS_PART_OUT_42 -d fd00")
+	requireValidShell(t, script)
+
+	// Full isolation covers both families
+	rules, err = partitionRules{Direction: "both"}.forPod(pod)
+	require.NoError(t, err)
+	script = rules.script("42", 30)
+	assert.Contains(t, script, "iptables -A CHAOS_PART_IN_42 -j DROP")
+	assert.Contains(t, script, "ip6tables -A CHAOS_PART_IN_42 -j DROP")
+}
+
+func TestPartitionScript_SingleFamilySkipsOtherAddresses(t *testing.T) {
+	// Only IPv6 targets on a dual-stack pod: IPv4 traffic is not touched at all
+	pod := partitionPeerPod("api-0", "apps", corev1.PodRunning, "10.0.0.5", "fd00::5")
+	rules, err := partitionRules{Direction: "egress", Addresses: []string{"fd00::9"}}.forPod(pod)
+	require.NoError(t, err)
+	script := rules.script("42", 30)
+	assert.NotContains(t, script, "\niptables -N")
+	assert.Contains(t, script, "ip6tables -N CHAOS_PART_OUT_42")
+
+	// An IPv4-only pod cannot reach an IPv6 target
+	pod = partitionPeerPod("api-1", "apps", corev1.PodRunning, "10.0.0.6")
+	_, err = partitionRules{Direction: "egress", Addresses: []string{"fd00::9"}}.forPod(pod)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "only has IPv4 addresses")
+}
+
+func TestPartitionScript_ExternalHostsDualStack(t *testing.T) {
+	pod := partitionPeerPod("api-0", "apps", corev1.PodRunning, "fd00::5")
+	spec := &chaosv1alpha1.ChaosExperimentSpec{ExternalTargets: []string{"db.example.com"}}
+	rules, err := partitionRulesFor(spec, "egress", nil).forPod(pod)
+	require.NoError(t, err)
+	script := rules.script("42", 60)
+
+	// IPv6-only pod: AAAA records only
+	assert.Contains(t, script, `dig +short AAAA "$h"`)
+	assert.NotContains(t, script, `dig +short A "$h"`)
+	assert.Contains(t, script, `ip6tables -A CHAOS_PART_XOUT_42 -d "$ip" -j DROP`)
+	requireValidShell(t, script)
+}
+
+func partitionPeerPod(name, namespace string, phase corev1.PodPhase, ips ...string) *corev1.Pod {
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace, Labels: map[string]string{"app": "db"}},
+		Status:     corev1.PodStatus{Phase: phase},
+	}
+	for _, ip := range ips {
+		pod.Status.PodIPs = append(pod.Status.PodIPs, corev1.PodIP{IP: ip})
+	}
+	return pod
+}
+
+func TestResolvePartitionPeers(t *testing.T) {
+	hostNetwork := partitionPeerPod("db-host", "data", corev1.PodRunning, "192.168.1.10")
+	hostNetwork.Spec.HostNetwork = true
+	r := newReconcilerWithObjects(t,
+		partitionPeerPod("db-1", "data", corev1.PodRunning, "10.0.0.9", "fd00::9"),
+		partitionPeerPod("db-0", "data", corev1.PodRunning, "10.0.0.8"),
+		partitionPeerPod("db-2", "data", corev1.PodPending),
+		partitionPeerPod("db-0", "other", corev1.PodRunning, "10.0.1.8"),
+		partitionPeerPod("db-0", "apps", corev1.PodRunning, "10.0.2.8"),
+		hostNetwork,
+	)
+	exp := &chaosv1alpha1.ChaosExperiment{Spec: chaosv1alpha1.ChaosExperimentSpec{
+		Action: "network-partition", Namespace: "apps",
+		PeerSelector: map[string]string{"app": "db"}, PeerNamespaces: []string{"data", "other"},
+	}}
+
+	ips, err := r.resolvePartitionPeers(context.Background(), exp)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"10.0.0.8", "10.0.0.9", "10.0.1.8", "fd00::9"}, ips)
+
+	// Without peerNamespaces the target namespace is searched
+	exp.Spec.PeerNamespaces = nil
+	ips, err = r.resolvePartitionPeers(context.Background(), exp)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"10.0.2.8"}, ips)
+}
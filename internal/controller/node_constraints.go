@@ -0,0 +1,130 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
+
+	chaosv1alpha1 "github.com/neogan74/k8s-chaos/api/v1alpha1"
+)
+
+// hasNodeConstraints reports whether a pod experiment is limited to pods on particular nodes
+func hasNodeConstraints(spec *chaosv1alpha1.ChaosExperimentSpec) bool {
+	return len(spec.NodeSelector) > 0 || spec.NodeAffinity != nil
+}
+
+// filterPodsByNode keeps the pods scheduled onto nodes that match the experiment's nodeSelector
+// and nodeAffinity, so chaos can be aimed at one node pool (e.g. spot) and not another. Pods
+// that are not scheduled yet have no node to match and are dropped.
+func (r *ChaosExperimentReconciler) filterPodsByNode(ctx context.Context, spec *chaosv1alpha1.ChaosExperimentSpec, pods []corev1.Pod) ([]corev1.Pod, error) {
+	nodes := &corev1.NodeList{}
+	if err := r.List(ctx, nodes); err != nil {
+		return nil, fmt.Errorf("failed to list nodes for node constraints: %w", err)
+	}
+	matching := map[string]bool{}
+	for i := range nodes.Items {
+		if nodeMatchesConstraints(&nodes.Items[i], spec) {
+			matching[nodes.Items[i].Name] = true
+		}
+	}
+
+	result := make([]corev1.Pod, 0, len(pods))
+	for _, pod := range pods {
+		if pod.Spec.NodeName != "" && matching[pod.Spec.NodeName] {
+			result = append(result, pod)
+		}
+	}
+	return result, nil
+}
+
+// nodeMatchesConstraints reports whether a node carries every nodeSelector label and matches at
+// least one nodeAffinity term
+func nodeMatchesConstraints(node *corev1.Node, spec *chaosv1alpha1.ChaosExperimentSpec) bool {
+	if !labels.SelectorFromSet(spec.NodeSelector).Matches(labels.Set(node.Labels)) {
+		return false
+	}
+	if spec.NodeAffinity == nil {
+		return true
+	}
+	for _, term := range spec.NodeAffinity.NodeSelectorTerms {
+		if nodeMatchesTerm(node, term) {
+			return true
+		}
+	}
+	return false
+}
+
+// nodeMatchesTerm evaluates a node selector term the way the scheduler does: all requirements
+// must hold, and an empty term matches nothing. The only supported field is metadata.name.
+func nodeMatchesTerm(node *corev1.Node, term corev1.NodeSelectorTerm) bool {
+	if len(term.MatchExpressions) == 0 && len(term.MatchFields) == 0 {
+		return false
+	}
+	for _, req := range term.MatchExpressions {
+		if !nodeRequirementMatches(req, labels.Set(node.Labels)) {
+			return false
+		}
+	}
+	for _, req := range term.MatchFields {
+		if req.Key != "metadata.name" || !nodeRequirementMatches(req, labels.Set{req.Key: node.Name}) {
+			return false
+		}
+	}
+	return true
+}
+
+// nodeRequirementMatches converts a node selector requirement to a label requirement and
+// evaluates it. Invalid requirements never match.
+func nodeRequirementMatches(req corev1.NodeSelectorRequirement, set labels.Set) bool {
+	op, ok := nodeSelectorOperators[req.Operator]
+	if !ok {
+		return false
+	}
+	requirement, err := labels.NewRequirement(req.Key, op, req.Values)
+	if err != nil {
+		return false
+	}
+	return requirement.Matches(set)
+}
+
+// nodeSelectorOperators maps node selector operators to their label selector equivalents
+var nodeSelectorOperators = map[corev1.NodeSelectorOperator]selection.Operator{
+	corev1.NodeSelectorOpIn:           selection.In,
+	corev1.NodeSelectorOpNotIn:        selection.NotIn,
+	corev1.NodeSelectorOpExists:       selection.Exists,
+	corev1.NodeSelectorOpDoesNotExist: selection.DoesNotExist,
+	corev1.NodeSelectorOpGt:           selection.GreaterThan,
+	corev1.NodeSelectorOpLt:           selection.LessThan,
+}
+
+// describeNodeConstraints summarizes the node constraints of a spec for events and simulations
+func describeNodeConstraints(spec *chaosv1alpha1.ChaosExperimentSpec) string {
+	var parts []string
+	if len(spec.NodeSelector) > 0 {
+		parts = append(parts, "nodeSelector "+labels.SelectorFromSet(spec.NodeSelector).String())
+	}
+	if spec.NodeAffinity != nil {
+		parts = append(parts, fmt.Sprintf("nodeAffinity with %d term(s)", len(spec.NodeAffinity.NodeSelectorTerms)))
+	}
+	return strings.Join(parts, " and ")
+}
@@ -18,11 +18,14 @@ package controller
 
 import (
 	"context"
+	"fmt"
+	"strconv"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -30,6 +33,88 @@ import (
 	chaosv1alpha1 "github.com/neogan74/k8s-chaos/api/v1alpha1"
 )
 
+// ---------------------------------------------------------------------------
+// drainNode
+// ---------------------------------------------------------------------------
+
+func drainTestPod(namespace, name, node string) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+		Spec:       corev1.PodSpec{NodeName: node, Containers: []corev1.Container{{Name: "app", Image: "nginx"}}},
+		Status:     corev1.PodStatus{Phase: corev1.PodRunning},
+	}
+}
+
+// pagedReader serves pod lists in pages of the requested limit, like the API server
+type pagedReader struct {
+	client.Reader
+	pages int
+}
+
+func (p *pagedReader) List(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error {
+	listOpts := (&client.ListOptions{}).ApplyOptions(opts)
+	passed := []client.ListOption{client.InNamespace(listOpts.Namespace)}
+	if listOpts.FieldSelector != nil {
+		passed = append(passed, client.MatchingFieldsSelector{Selector: listOpts.FieldSelector})
+	}
+	if listOpts.LabelSelector != nil {
+		passed = append(passed, client.MatchingLabelsSelector{Selector: listOpts.LabelSelector})
+	}
+	if err := p.Reader.List(ctx, list, passed...); err != nil {
+		return err
+	}
+	p.pages++
+	podList := list.(*corev1.PodList)
+	start, _ := strconv.Atoi(listOpts.Continue)
+	end := min(start+int(listOpts.Limit), len(podList.Items))
+	if end < len(podList.Items) {
+		podList.Continue = strconv.Itoa(end)
+	}
+	podList.Items = podList.Items[start:end]
+	return nil
+}
+
+func TestDrainNode_EvictsPodsOfAllNamespaces(t *testing.T) {
+	ctx := context.Background()
+	daemon := drainTestPod("kube-system", "agent", "worker-1")
+	daemon.OwnerReferences = []metav1.OwnerReference{{APIVersion: "apps/v1", Kind: "DaemonSet", Name: "agent", UID: "ds"}}
+	r := newReconcilerWithObjects(t,
+		drainTestPod("team-a", "web", "worker-1"),
+		drainTestPod("team-b", "api", "worker-1"),
+		drainTestPod("team-a", "other-node", "worker-2"),
+		daemon,
+	)
+	node := &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "worker-1"}}
+
+	require.NoError(t, r.drainNode(ctx, node))
+
+	pods := &corev1.PodList{}
+	require.NoError(t, r.List(ctx, pods))
+	var remaining []string
+	for _, pod := range pods.Items {
+		remaining = append(remaining, pod.Namespace+"/"+pod.Name)
+	}
+	assert.ElementsMatch(t, []string{"team-a/other-node", "kube-system/agent"}, remaining)
+}
+
+func TestDrainNode_PaginatesAPIReader(t *testing.T) {
+	ctx := context.Background()
+	var objs []client.Object
+	for i := range 2*defaultPodListPageSize + 10 {
+		objs = append(objs, drainTestPod(fmt.Sprintf("ns-%d", i%3), fmt.Sprintf("pod-%d", i), "worker-1"))
+	}
+	r := newReconcilerWithObjects(t, objs...)
+	reader := &pagedReader{Reader: r.Client}
+	r.APIReader = reader
+
+	require.NoError(t, r.drainNode(ctx, &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "worker-1"}}))
+
+	assert.Equal(t, 3, reader.pages)
+	pods := &corev1.PodList{}
+	require.NoError(t, r.List(ctx, pods))
+	assert.Empty(t, pods.Items)
+}
+
 // ---------------------------------------------------------------------------
 // taintNode
 // ---------------------------------------------------------------------------
@@ -485,3 +570,101 @@ func fetchExperiment(t *testing.T, r *ChaosExperimentReconciler, name, namespace
 	require.NoError(t, r.Get(context.Background(), client.ObjectKey{Name: name, Namespace: namespace}, exp))
 	return exp
 }
+
+// ---------------------------------------------------------------------------
+// node-drain safety checks
+// ---------------------------------------------------------------------------
+
+func TestIsControlPlaneNode(t *testing.T) {
+	tests := []struct {
+		name   string
+		labels map[string]string
+		want   bool
+	}{
+		{name: "control-plane role", labels: map[string]string{"node-role.kubernetes.io/control-plane": ""}, want: true},
+		{name: "legacy master role", labels: map[string]string{"node-role.kubernetes.io/master": ""}, want: true},
+		{name: "worker node", labels: map[string]string{"node-role.kubernetes.io/worker": ""}, want: false},
+		{name: "no labels", labels: nil, want: false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			node := &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "n", Labels: tc.labels}}
+			assert.Equal(t, tc.want, isControlPlaneNode(node))
+		})
+	}
+}
+
+func TestCheckDrainCapacity(t *testing.T) {
+	readyNode := func(name, cpu, memory string) corev1.Node {
+		return corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Status: corev1.NodeStatus{
+				Allocatable: corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse(cpu),
+					corev1.ResourceMemory: resource.MustParse(memory),
+				},
+				Conditions: []corev1.NodeCondition{{Type: corev1.NodeReady, Status: corev1.ConditionTrue}},
+			},
+		}
+	}
+	podWithRequests := func(name, cpu, memory string) corev1.Pod {
+		return corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+			Spec: corev1.PodSpec{Containers: []corev1.Container{{
+				Name: "app",
+				Resources: corev1.ResourceRequirements{Requests: corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse(cpu),
+					corev1.ResourceMemory: resource.MustParse(memory),
+				}},
+			}}},
+		}
+	}
+
+	tests := []struct {
+		name      string
+		otherNode corev1.Node
+		draining  map[string]bool
+		wantErr   string
+	}{
+		{
+			name:      "enough capacity on remaining node",
+			otherNode: readyNode("node-b", "4", "8Gi"),
+		},
+		{
+			name:      "not enough CPU",
+			otherNode: readyNode("node-b", "1", "8Gi"),
+			wantErr:   "insufficient CPU",
+		},
+		{
+			name:      "not enough memory",
+			otherNode: readyNode("node-b", "4", "1Gi"),
+			wantErr:   "insufficient memory",
+		},
+		{
+			name:      "node being drained does not count",
+			otherNode: readyNode("node-b", "4", "8Gi"),
+			draining:  map[string]bool{"node-b": true},
+			wantErr:   "insufficient CPU",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			target := readyNode("node-a", "4", "8Gi")
+			nodes := []corev1.Node{target, tc.otherNode}
+			podsByNode := map[string][]corev1.Pod{
+				"node-a": {podWithRequests("evicted", "2", "2Gi")},
+				"node-b": {podWithRequests("resident", "500m", "512Mi")},
+			}
+
+			err := checkDrainCapacity(&target, nodes, podsByNode, tc.draining)
+			if tc.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tc.wantErr)
+		})
+	}
+}
@@ -0,0 +1,236 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	chaosv1alpha1 "github.com/neogan74/k8s-chaos/api/v1alpha1"
+	chaosmetrics "github.com/neogan74/k8s-chaos/internal/metrics"
+)
+
+// Values of spec.nodeReplacement.autoscaler
+const (
+	autoscalerClusterAutoscaler = "cluster-autoscaler"
+	autoscalerKarpenter         = "karpenter"
+)
+
+const (
+	// clusterAutoscalerDeletionTaint marks nodes cluster-autoscaler is scaling down
+	clusterAutoscalerDeletionTaint = "ToBeDeletedByClusterAutoscaler"
+	// karpenterDisruptedTaint marks nodes Karpenter is disrupting
+	karpenterDisruptedTaint = "karpenter.sh/disrupted"
+
+	// defaultNodeReplacementTimeout is used when spec.nodeReplacement.timeout is not set
+	defaultNodeReplacementTimeout = 10 * time.Minute
+	// maxNodeReplacements bounds status.nodeReplacements
+	maxNodeReplacements = 50
+)
+
+// autoscalerTaint returns the taint the autoscaler marks the nodes it removes with
+func autoscalerTaint(autoscaler string, now time.Time) corev1.Taint {
+	if autoscaler == autoscalerKarpenter {
+		return corev1.Taint{Key: karpenterDisruptedTaint, Effect: corev1.TaintEffectNoSchedule}
+	}
+	// cluster-autoscaler keeps the time it tainted the node as the value
+	return corev1.Taint{
+		Key:    clusterAutoscalerDeletionTaint,
+		Value:  strconv.FormatInt(now.Unix(), 10),
+		Effect: corev1.TaintEffectNoSchedule,
+	}
+}
+
+// hasAutoscalerDeletionTaint checks whether an autoscaler marked the node for removal
+func hasAutoscalerDeletionTaint(node *corev1.Node) bool {
+	for _, taint := range node.Spec.Taints {
+		if taint.Key == clusterAutoscalerDeletionTaint || taint.Key == karpenterDisruptedTaint {
+			return true
+		}
+	}
+	return false
+}
+
+// cordonForDrain cordons a node before it is drained, with the autoscaler's taint when
+// spec.nodeReplacement.autoscaler asks for it
+// Returns (wasAlreadyCordoned bool, error)
+func (r *ChaosExperimentReconciler) cordonForDrain(ctx context.Context, exp *chaosv1alpha1.ChaosExperiment, node *corev1.Node) (bool, error) {
+	if exp.Spec.NodeReplacement == nil || exp.Spec.NodeReplacement.Autoscaler == "" {
+		return r.cordonNode(ctx, node)
+	}
+	return r.cordonNodeWithTaint(ctx, node, exp.Spec.NodeReplacement.Autoscaler)
+}
+
+// cordonNodeWithTaint keeps new pods off a node with the autoscaler's deletion taint rather than
+// spec.unschedulable, so the autoscaler treats the node as on its way out
+// Returns (wasAlreadyCordoned bool, error)
+func (r *ChaosExperimentReconciler) cordonNodeWithTaint(ctx context.Context, node *corev1.Node, autoscaler string) (bool, error) {
+	log := ctrl.LoggerFrom(ctx)
+
+	if node.Spec.Unschedulable || hasAutoscalerDeletionTaint(node) {
+		log.Info("Node is already cordoned", "node", node.Name)
+		return true, nil
+	}
+
+	taint := autoscalerTaint(autoscaler, time.Now())
+	node.Spec.Taints = append(node.Spec.Taints, taint)
+	markCordonedNode(ctx, node)
+	if node.Annotations == nil {
+		node.Annotations = map[string]string{}
+	}
+	node.Annotations[chaosv1alpha1.AutoscalerTaintAnnotation] = taint.Key
+	if err := r.writer(ctx).Update(ctx, node); err != nil {
+		return false, fmt.Errorf("failed to cordon node: %w", err)
+	}
+	r.cleanups.record(ctx, chaosv1alpha1.PendingCleanup{Operation: chaosv1alpha1.CleanupUncordon, Node: node.Name})
+
+	log.Info("Successfully cordoned node with autoscaler taint", "node", node.Name, "taint", taint.Key)
+	return false, nil
+}
+
+// removeAutoscalerTaint drops the taint cordonNodeWithTaint put on the node
+func removeAutoscalerTaint(node *corev1.Node) {
+	key := node.Annotations[chaosv1alpha1.AutoscalerTaintAnnotation]
+	if key == "" {
+		return
+	}
+	taints := make([]corev1.Taint, 0, len(node.Spec.Taints))
+	for _, taint := range node.Spec.Taints {
+		if taint.Key != key {
+			taints = append(taints, taint)
+		}
+	}
+	node.Spec.Taints = taints
+	delete(node.Annotations, chaosv1alpha1.AutoscalerTaintAnnotation)
+}
+
+// nodeReplacementTimeout returns spec.nodeReplacement.timeout
+func (r *ChaosExperimentReconciler) nodeReplacementTimeout(exp *chaosv1alpha1.ChaosExperiment) time.Duration {
+	if exp.Spec.NodeReplacement != nil && exp.Spec.NodeReplacement.Timeout != "" {
+		if timeout, err := r.parseDuration(exp.Spec.NodeReplacement.Timeout); err == nil && timeout > 0 {
+			return timeout
+		}
+	}
+	return defaultNodeReplacementTimeout
+}
+
+// recordDrainedNodes starts waiting for replacements of the nodes drained at now
+func recordDrainedNodes(exp *chaosv1alpha1.ChaosExperiment, drained []string, now metav1.Time) {
+	for _, node := range drained {
+		exp.Status.NodeReplacements = append(exp.Status.NodeReplacements, chaosv1alpha1.NodeReplacementResult{
+			Node:      node,
+			DrainedAt: now,
+		})
+	}
+	if excess := len(exp.Status.NodeReplacements) - maxNodeReplacements; excess > 0 {
+		exp.Status.NodeReplacements = exp.Status.NodeReplacements[excess:]
+	}
+}
+
+// replacementCandidate is a Ready node that may have replaced a drained one
+type replacementCandidate struct {
+	name      string
+	createdAt time.Time
+	readyAt   time.Time
+}
+
+// checkNodeReplacements matches the drained nodes still waiting for a replacement with nodes that
+// matched spec.selector, were created after the drain and became Ready, oldest drain first. The
+// time is taken from the nodes' timestamps, so it does not depend on when the check runs.
+func (r *ChaosExperimentReconciler) checkNodeReplacements(ctx context.Context, exp *chaosv1alpha1.ChaosExperiment, now time.Time) {
+	log := ctrl.LoggerFrom(ctx)
+
+	claimed := map[string]bool{}
+	pending := false
+	for _, result := range exp.Status.NodeReplacements {
+		claimed[result.Node] = true
+		if result.ReplacementNode != "" {
+			claimed[result.ReplacementNode] = true
+		} else if !result.TimedOut {
+			pending = true
+		}
+	}
+	if !pending {
+		return
+	}
+
+	nodes := &corev1.NodeList{}
+	selector := labels.SelectorFromSet(exp.Spec.Selector)
+	if err := r.List(ctx, nodes, client.MatchingLabelsSelector{Selector: selector}); err != nil {
+		log.Error(err, "Failed to list nodes for replacement check")
+		return
+	}
+	var candidates []replacementCandidate
+	for i := range nodes.Items {
+		node := &nodes.Items[i]
+		if claimed[node.Name] || isNodeUnavailable(node) || hasAutoscalerDeletionTaint(node) {
+			continue
+		}
+		candidate := replacementCandidate{name: node.Name, createdAt: node.CreationTimestamp.Time, readyAt: node.CreationTimestamp.Time}
+		for _, cond := range node.Status.Conditions {
+			if cond.Type == corev1.NodeReady && cond.LastTransitionTime.After(candidate.readyAt) {
+				candidate.readyAt = cond.LastTransitionTime.Time
+			}
+		}
+		candidates = append(candidates, candidate)
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		return candidates[i].readyAt.Before(candidates[j].readyAt)
+	})
+
+	timeout := r.nodeReplacementTimeout(exp)
+	used := make([]bool, len(candidates))
+	for i := range exp.Status.NodeReplacements {
+		result := &exp.Status.NodeReplacements[i]
+		if result.ReplacementNode != "" || result.TimedOut {
+			continue
+		}
+		drainedAt := result.DrainedAt.Time
+		for j, candidate := range candidates {
+			if used[j] || candidate.createdAt.Before(drainedAt) {
+				continue
+			}
+			latency := candidate.readyAt.Sub(drainedAt)
+			if latency > timeout {
+				// Candidates are ordered by readiness, so the rest came too late as well
+				break
+			}
+			used[j] = true
+			result.ReplacementNode = candidate.name
+			result.TimeToReplacement = latency.Round(time.Second).String()
+			chaosmetrics.NodeReplacementLatency.WithLabelValues(exp.Spec.Action, exp.Spec.Namespace).Observe(latency.Seconds())
+			r.Recorder.Eventf(exp, corev1.EventTypeNormal, "NodeReplaced",
+				"Node %s was replaced by %s after %s", result.Node, candidate.name, result.TimeToReplacement)
+			break
+		}
+		if result.ReplacementNode == "" && now.Sub(drainedAt) > timeout {
+			result.TimedOut = true
+			chaosmetrics.NodeReplacementTimeouts.WithLabelValues(exp.Spec.Action, exp.Spec.Namespace).Inc()
+			r.Recorder.Eventf(exp, corev1.EventTypeWarning, "NodeReplacementTimedOut",
+				"No replacement for node %s was Ready within %s", result.Node, timeout)
+		}
+	}
+}
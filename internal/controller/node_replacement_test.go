@@ -0,0 +1,151 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	chaosv1alpha1 "github.com/neogan74/k8s-chaos/api/v1alpha1"
+)
+
+func TestCordonNodeWithTaint_UncordonRemovesTaint(t *testing.T) {
+	ctx := context.Background()
+	other := corev1.Taint{Key: "dedicated", Value: "batch", Effect: corev1.TaintEffectNoSchedule}
+	node := &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: "worker-1"},
+		Spec:       corev1.NodeSpec{Taints: []corev1.Taint{other}},
+	}
+	r := newReconcilerWithObjects(t, node)
+	exp := &chaosv1alpha1.ChaosExperiment{Spec: chaosv1alpha1.ChaosExperimentSpec{
+		Action:          "node-drain",
+		NodeReplacement: &chaosv1alpha1.NodeReplacement{Autoscaler: autoscalerKarpenter},
+	}}
+
+	wasCordoned, err := r.cordonForDrain(ctx, exp, node)
+	require.NoError(t, err)
+	assert.False(t, wasCordoned)
+
+	tainted := &corev1.Node{}
+	require.NoError(t, r.Get(ctx, types.NamespacedName{Name: "worker-1"}, tainted))
+	assert.False(t, tainted.Spec.Unschedulable)
+	assert.Contains(t, tainted.Spec.Taints, corev1.Taint{Key: karpenterDisruptedTaint, Effect: corev1.TaintEffectNoSchedule})
+	assert.True(t, isNodeUnavailable(tainted))
+
+	// A second drain finds the node cordoned already
+	wasCordoned, err = r.cordonForDrain(ctx, exp, tainted)
+	require.NoError(t, err)
+	assert.True(t, wasCordoned)
+
+	require.NoError(t, r.uncordonNode(ctx, "worker-1"))
+	restored := &corev1.Node{}
+	require.NoError(t, r.Get(ctx, types.NamespacedName{Name: "worker-1"}, restored))
+	assert.Equal(t, []corev1.Taint{other}, restored.Spec.Taints)
+	assert.NotContains(t, restored.Annotations, chaosv1alpha1.AutoscalerTaintAnnotation)
+}
+
+func TestCheckNodeReplacements(t *testing.T) {
+	ctx := context.Background()
+	drainedAt := time.Date(2026, 3, 2, 10, 0, 0, 0, time.UTC)
+	spotNode := func(name string, created, ready time.Duration) *corev1.Node {
+		return &corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              name,
+				Labels:            map[string]string{"pool": "spot"},
+				CreationTimestamp: metav1.NewTime(drainedAt.Add(created)),
+			},
+			Status: corev1.NodeStatus{Conditions: []corev1.NodeCondition{{
+				Type:               corev1.NodeReady,
+				Status:             corev1.ConditionTrue,
+				LastTransitionTime: metav1.NewTime(drainedAt.Add(ready)),
+			}}},
+		}
+	}
+	r := newReconcilerWithObjects(t,
+		spotNode("old", -time.Hour, -time.Hour),
+		spotNode("fresh", time.Minute, 3*time.Minute),
+		spotNode("late", 2*time.Minute, 15*time.Minute),
+	)
+	exp := &chaosv1alpha1.ChaosExperiment{
+		Spec: chaosv1alpha1.ChaosExperimentSpec{
+			Action:          "node-drain",
+			Namespace:       "chaos",
+			Selector:        map[string]string{"pool": "spot"},
+			NodeReplacement: &chaosv1alpha1.NodeReplacement{Timeout: "10m"},
+		},
+		Status: chaosv1alpha1.ChaosExperimentStatus{NodeReplacements: []chaosv1alpha1.NodeReplacementResult{
+			{Node: "drained-1", DrainedAt: metav1.NewTime(drainedAt)},
+			{Node: "drained-2", DrainedAt: metav1.NewTime(drainedAt)},
+		}},
+	}
+
+	// Before the timeout, a drain without a replacement keeps waiting
+	r.checkNodeReplacements(ctx, exp, drainedAt.Add(5*time.Minute))
+	assert.Equal(t, chaosv1alpha1.NodeReplacementResult{
+		Node: "drained-1", DrainedAt: metav1.NewTime(drainedAt), ReplacementNode: "fresh", TimeToReplacement: "3m0s",
+	}, exp.Status.NodeReplacements[0])
+	assert.Empty(t, exp.Status.NodeReplacements[1].ReplacementNode)
+	assert.False(t, exp.Status.NodeReplacements[1].TimedOut)
+
+	// "late" became Ready after the timeout, so it does not count
+	r.checkNodeReplacements(ctx, exp, drainedAt.Add(20*time.Minute))
+	assert.Empty(t, exp.Status.NodeReplacements[1].ReplacementNode)
+	assert.True(t, exp.Status.NodeReplacements[1].TimedOut)
+	assert.Equal(t, "fresh", exp.Status.NodeReplacements[0].ReplacementNode)
+}
+
+func TestHandleNodeDrain_NodeReplacement(t *testing.T) {
+	ctx := context.Background()
+	exp := &chaosv1alpha1.ChaosExperiment{
+		ObjectMeta: metav1.ObjectMeta{Name: "drain", Namespace: "chaos"},
+		Spec: chaosv1alpha1.ChaosExperimentSpec{
+			Action:          "node-drain",
+			Namespace:       "chaos",
+			Selector:        map[string]string{"pool": "spot"},
+			Count:           1,
+			NodeReplacement: &chaosv1alpha1.NodeReplacement{Autoscaler: autoscalerClusterAutoscaler},
+		},
+	}
+	// No other node has room for the pod; the autoscaler is expected to add one
+	pod := drainTestPod("shop", "web", "worker-1")
+	pod.Spec.Containers[0].Resources.Requests = corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("2")}
+	r := newReconcilerWithObjects(t, exp, pod,
+		&corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "worker-1", Labels: map[string]string{"pool": "spot"}}})
+
+	_, err := r.handleNodeDrain(ctx, exp)
+	require.NoError(t, err)
+
+	node := &corev1.Node{}
+	require.NoError(t, r.Get(ctx, types.NamespacedName{Name: "worker-1"}, node))
+	assert.False(t, node.Spec.Unschedulable)
+	require.Len(t, node.Spec.Taints, 1)
+	assert.Equal(t, clusterAutoscalerDeletionTaint, node.Spec.Taints[0].Key)
+
+	updated := fetchExperiment(t, r, "drain", "chaos")
+	assert.Equal(t, []string{"worker-1"}, updated.Status.CordonedNodes)
+	require.Len(t, updated.Status.NodeReplacements, 1)
+	assert.Equal(t, "worker-1", updated.Status.NodeReplacements[0].Node)
+	assert.Empty(t, updated.Status.NodeReplacements[0].ReplacementNode)
+}
@@ -0,0 +1,122 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	chaosv1alpha1 "github.com/neogan74/k8s-chaos/api/v1alpha1"
+)
+
+// isControlPlaneNode checks if the node carries a control-plane (or legacy master) role label
+func isControlPlaneNode(node *corev1.Node) bool {
+	return chaosv1alpha1.IsControlPlaneNode(node)
+}
+
+// isNodeUnavailable checks if the node is cordoned, being removed by an autoscaler or not Ready
+func isNodeUnavailable(node *corev1.Node) bool {
+	if node.Spec.Unschedulable || hasAutoscalerDeletionTaint(node) {
+		return true
+	}
+	for _, cond := range node.Status.Conditions {
+		if cond.Type == corev1.NodeReady {
+			return cond.Status != corev1.ConditionTrue
+		}
+	}
+	return true
+}
+
+// podRequests returns the CPU and memory requested by a pod.
+// Init containers run before the app containers, so the larger of the two is used.
+func podRequests(pod *corev1.Pod) (cpu, memory resource.Quantity) {
+	for _, c := range pod.Spec.Containers {
+		cpu.Add(*c.Resources.Requests.Cpu())
+		memory.Add(*c.Resources.Requests.Memory())
+	}
+	for _, c := range pod.Spec.InitContainers {
+		if c.Resources.Requests.Cpu().Cmp(cpu) > 0 {
+			cpu = c.Resources.Requests.Cpu().DeepCopy()
+		}
+		if c.Resources.Requests.Memory().Cmp(memory) > 0 {
+			memory = c.Resources.Requests.Memory().DeepCopy()
+		}
+	}
+	return cpu, memory
+}
+
+// isEvictablePod checks if a pod would be evicted by drainNode
+func isEvictablePod(pod *corev1.Pod) bool {
+	if pod.DeletionTimestamp != nil {
+		return false
+	}
+	if pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed {
+		return false
+	}
+	return !isDaemonSetPod(pod) && !isStaticPod(pod)
+}
+
+// checkDrainCapacity verifies that the remaining schedulable nodes have enough free
+// allocatable CPU and memory to host the pods evicted from the target node.
+// podsByNode maps node names to the pods currently running on them.
+func checkDrainCapacity(target *corev1.Node, nodes []corev1.Node, podsByNode map[string][]corev1.Pod, draining map[string]bool) error {
+	var neededCPU, neededMemory resource.Quantity
+	for i := range podsByNode[target.Name] {
+		pod := &podsByNode[target.Name][i]
+		if !isEvictablePod(pod) {
+			continue
+		}
+		cpu, memory := podRequests(pod)
+		neededCPU.Add(cpu)
+		neededMemory.Add(memory)
+	}
+
+	var freeCPU, freeMemory resource.Quantity
+	for i := range nodes {
+		node := &nodes[i]
+		if node.Name == target.Name || draining[node.Name] || isNodeUnavailable(node) {
+			continue
+		}
+		cpu := node.Status.Allocatable.Cpu().DeepCopy()
+		memory := node.Status.Allocatable.Memory().DeepCopy()
+		for j := range podsByNode[node.Name] {
+			pod := &podsByNode[node.Name][j]
+			if pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed {
+				continue
+			}
+			usedCPU, usedMemory := podRequests(pod)
+			cpu.Sub(usedCPU)
+			memory.Sub(usedMemory)
+		}
+		if cpu.Sign() > 0 {
+			freeCPU.Add(cpu)
+		}
+		if memory.Sign() > 0 {
+			freeMemory.Add(memory)
+		}
+	}
+
+	if neededCPU.Cmp(freeCPU) > 0 {
+		return fmt.Errorf("insufficient CPU on remaining nodes: need %s, free %s", neededCPU.String(), freeCPU.String())
+	}
+	if neededMemory.Cmp(freeMemory) > 0 {
+		return fmt.Errorf("insufficient memory on remaining nodes: need %s, free %s", neededMemory.String(), freeMemory.String())
+	}
+	return nil
+}
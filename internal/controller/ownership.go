@@ -0,0 +1,125 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	chaosv1alpha1 "github.com/neogan74/k8s-chaos/api/v1alpha1"
+)
+
+// cleanupTaskFinalizer keeps a deleted experiment until the cleanup tasks it handed reverts to
+// have finished, so deleting it never races a revert that is still being retried
+const cleanupTaskFinalizer = "chaos.gushchin.dev/cleanup-tasks"
+
+// cleanupTaskFinalizerRequeue is how often a deleted experiment checks on its pending cleanup tasks
+const cleanupTaskFinalizerRequeue = 15 * time.Second
+
+// experimentControllerRef makes exp the controller of the helper pods it creates, so the garbage
+// collector removes them with the experiment
+func experimentControllerRef(exp *chaosv1alpha1.ChaosExperiment) metav1.OwnerReference {
+	return *metav1.NewControllerRef(exp, chaosv1alpha1.GroupVersion.WithKind("ChaosExperiment"))
+}
+
+// setExperimentOwner adds exp as a plain owner of obj when both live in the same namespace and
+// reports whether it did; owner references cannot cross namespaces. The reference does not block
+// the experiment's deletion, the dependent is collected afterwards.
+func setExperimentOwner(exp *chaosv1alpha1.ChaosExperiment, obj metav1.Object) bool {
+	if exp.UID == "" || obj.GetNamespace() != exp.Namespace {
+		return false
+	}
+	obj.SetOwnerReferences(append(obj.GetOwnerReferences(), metav1.OwnerReference{
+		APIVersion: chaosv1alpha1.GroupVersion.String(),
+		Kind:       "ChaosExperiment",
+		Name:       exp.Name,
+		UID:        exp.UID,
+	}))
+	return true
+}
+
+// addCleanupTaskFinalizer adds the finalizer before the first cleanup task of exp is created.
+// Only the finalizer is patched, so status changes the caller has not written yet are kept.
+// Experiments already being deleted cannot gain finalizers; their tasks simply outlive them.
+func (r *ChaosExperimentReconciler) addCleanupTaskFinalizer(ctx context.Context, exp *chaosv1alpha1.ChaosExperiment) error {
+	if !exp.DeletionTimestamp.IsZero() || controllerutil.ContainsFinalizer(exp, cleanupTaskFinalizer) {
+		return nil
+	}
+	patched := exp.DeepCopy()
+	controllerutil.AddFinalizer(patched, cleanupTaskFinalizer)
+	if err := r.Patch(ctx, patched, client.MergeFrom(exp)); err != nil {
+		return fmt.Errorf("failed to add cleanup task finalizer: %w", err)
+	}
+	exp.Finalizers = patched.Finalizers
+	exp.ResourceVersion = patched.ResourceVersion
+	return nil
+}
+
+// reconcileCleanupTaskFinalizer holds a deleted experiment until none of its cleanup tasks is
+// pending. Succeeded tasks are deleted with the experiment; failed ones are kept, they list the
+// reverts left to do by hand. It returns done=true when the returned result should be used as-is.
+func (r *ChaosExperimentReconciler) reconcileCleanupTaskFinalizer(ctx context.Context, exp *chaosv1alpha1.ChaosExperiment) (ctrl.Result, bool, error) {
+	if exp.DeletionTimestamp.IsZero() || !controllerutil.ContainsFinalizer(exp, cleanupTaskFinalizer) {
+		return ctrl.Result{}, false, nil
+	}
+
+	tasks := &chaosv1alpha1.ChaosCleanupTaskList{}
+	if err := r.List(ctx, tasks, client.InNamespace(exp.Namespace),
+		client.MatchingLabels{chaosv1alpha1.ExperimentUIDLabel: string(exp.UID)}); err != nil {
+		return ctrl.Result{}, true, fmt.Errorf("failed to list cleanup tasks: %w", err)
+	}
+	var pending []string
+	for i := range tasks.Items {
+		task := &tasks.Items[i]
+		switch task.Status.Phase {
+		case chaosv1alpha1.CleanupTaskSucceeded:
+			if err := r.Delete(ctx, task); client.IgnoreNotFound(err) != nil {
+				return ctrl.Result{}, true, fmt.Errorf("failed to delete cleanup task %s: %w", task.Name, err)
+			}
+		case chaosv1alpha1.CleanupTaskFailed:
+		default:
+			pending = append(pending, task.Name)
+		}
+	}
+	if len(pending) > 0 {
+		ctrl.LoggerFrom(ctx).Info("Waiting for cleanup tasks before releasing deleted experiment", "pending", pending)
+		changed := meta.SetStatusCondition(&exp.Status.Conditions, metav1.Condition{
+			Type:               chaosv1alpha1.ConditionCleanupPending,
+			Status:             metav1.ConditionTrue,
+			ObservedGeneration: exp.Generation,
+			Reason:             "WaitingForCleanupTasks",
+			Message:            fmt.Sprintf("Waiting for %d cleanup task(s): %s", len(pending), strings.Join(pending, ", ")),
+		})
+		if changed {
+			if err := r.Status().Update(ctx, exp); client.IgnoreNotFound(err) != nil {
+				return ctrl.Result{}, true, fmt.Errorf("failed to report pending cleanup tasks: %w", err)
+			}
+		}
+		return ctrl.Result{RequeueAfter: cleanupTaskFinalizerRequeue}, true, nil
+	}
+
+	controllerutil.RemoveFinalizer(exp, cleanupTaskFinalizer)
+	return ctrl.Result{}, true, client.IgnoreNotFound(r.Update(ctx, exp))
+}
@@ -0,0 +1,117 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	chaosv1alpha1 "github.com/neogan74/k8s-chaos/api/v1alpha1"
+)
+
+func TestSetExperimentOwner(t *testing.T) {
+	exp := handoffTestExperiment("pod-kill")
+	exp.UID = "exp-uid"
+
+	local := &chaosv1alpha1.ChaosExperimentHistory{ObjectMeta: metav1.ObjectMeta{Name: "local", Namespace: exp.Namespace}}
+	assert.True(t, setExperimentOwner(exp, local))
+	require.Len(t, local.OwnerReferences, 1)
+	assert.Equal(t, exp.UID, local.OwnerReferences[0].UID)
+	assert.Nil(t, local.OwnerReferences[0].Controller)
+
+	shared := &chaosv1alpha1.ChaosExperimentHistory{ObjectMeta: metav1.ObjectMeta{Name: "shared", Namespace: "chaos-system"}}
+	assert.False(t, setExperimentOwner(exp, shared))
+	assert.Empty(t, shared.OwnerReferences)
+}
+
+func TestCreateCleanupTask_AddsFinalizer(t *testing.T) {
+	ctx := context.Background()
+	exp := handoffTestExperiment("node-drain")
+	exp.UID = "exp-uid"
+	exp.Status.CordonedNodes = []string{"worker-1"}
+	r := newReconcilerWithObjects(t, exp)
+
+	// Status edits made before the hand-off must survive the finalizer patch
+	exp.Status.CordonedNodes = nil
+	require.NoError(t, r.createCleanupTask(ctx, exp, chaosv1alpha1.PendingCleanup{
+		Operation: chaosv1alpha1.CleanupUncordon, Node: "worker-1",
+	}))
+	assert.True(t, controllerutil.ContainsFinalizer(exp, cleanupTaskFinalizer))
+	assert.Empty(t, exp.Status.CordonedNodes)
+	require.NoError(t, r.Status().Update(ctx, exp))
+
+	stored := &chaosv1alpha1.ChaosExperiment{}
+	require.NoError(t, r.Get(ctx, client.ObjectKeyFromObject(exp), stored))
+	assert.True(t, controllerutil.ContainsFinalizer(stored, cleanupTaskFinalizer))
+	assert.Empty(t, stored.Status.CordonedNodes)
+}
+
+func TestReconcileCleanupTaskFinalizer(t *testing.T) {
+	ctx := context.Background()
+	exp := handoffTestExperiment("node-drain")
+	exp.UID = "exp-uid"
+	exp.Finalizers = []string{cleanupTaskFinalizer}
+	task := func(name, phase string) *chaosv1alpha1.ChaosCleanupTask {
+		return &chaosv1alpha1.ChaosCleanupTask{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: exp.Namespace, Labels: experimentLabels(exp)},
+			Spec:       chaosv1alpha1.ChaosCleanupTaskSpec{Experiment: exp.Name, Operation: chaosv1alpha1.CleanupUncordon, Node: name},
+			Status:     chaosv1alpha1.ChaosCleanupTaskStatus{Phase: phase},
+		}
+	}
+	pending := task("worker-1", chaosv1alpha1.CleanupTaskPending)
+	failed := task("worker-2", chaosv1alpha1.CleanupTaskFailed)
+	r := newReconcilerWithObjects(t, exp, pending, failed)
+
+	// Running experiments keep the finalizer without looking at their tasks
+	_, done, err := r.reconcileCleanupTaskFinalizer(ctx, exp)
+	require.NoError(t, err)
+	assert.False(t, done)
+
+	require.NoError(t, r.Delete(ctx, exp))
+	deleted := fetchExperiment(t, r, exp.Name, exp.Namespace)
+
+	result, done, err := r.reconcileCleanupTaskFinalizer(ctx, deleted)
+	require.NoError(t, err)
+	assert.True(t, done)
+	assert.Equal(t, cleanupTaskFinalizerRequeue, result.RequeueAfter)
+	waiting := fetchExperiment(t, r, exp.Name, exp.Namespace)
+	assert.True(t, controllerutil.ContainsFinalizer(waiting, cleanupTaskFinalizer))
+	cond := meta.FindStatusCondition(waiting.Status.Conditions, chaosv1alpha1.ConditionCleanupPending)
+	require.NotNil(t, cond)
+	assert.Equal(t, "Waiting for 1 cleanup task(s): worker-1", cond.Message)
+
+	pending.Status.Phase = chaosv1alpha1.CleanupTaskSucceeded
+	require.NoError(t, r.Status().Update(ctx, pending))
+	_, done, err = r.reconcileCleanupTaskFinalizer(ctx, deleted)
+	require.NoError(t, err)
+	assert.True(t, done)
+
+	err = r.Get(ctx, client.ObjectKeyFromObject(exp), &chaosv1alpha1.ChaosExperiment{})
+	assert.True(t, apierrors.IsNotFound(err), "experiment should be gone once its finalizer is released")
+	err = r.Get(ctx, client.ObjectKeyFromObject(pending), &chaosv1alpha1.ChaosCleanupTask{})
+	assert.True(t, apierrors.IsNotFound(err), "succeeded task should be deleted with the experiment")
+	assert.NoError(t, r.Get(ctx, client.ObjectKeyFromObject(failed), &chaosv1alpha1.ChaosCleanupTask{}),
+		"failed task should be kept for manual follow-up")
+}
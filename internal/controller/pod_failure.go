@@ -0,0 +1,127 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	chaosv1alpha1 "github.com/neogan74/k8s-chaos/api/v1alpha1"
+	chaosmetrics "github.com/neogan74/k8s-chaos/internal/metrics"
+)
+
+const (
+	// defaultFailureSignal crashes the container without giving it a chance to shut down
+	defaultFailureSignal = "KILL"
+	// defaultFailureInterval is how often a running target is failed again while duration lasts
+	defaultFailureInterval = 10 * time.Second
+)
+
+// failureSignal returns the signal pod-failure sends to PID 1
+func failureSignal(spec *chaosv1alpha1.ChaosExperimentSpec) string {
+	if spec.FailureSignal == "" {
+		return defaultFailureSignal
+	}
+	return spec.FailureSignal
+}
+
+// failureInterval returns how often pod-failure fails its targets again
+func (r *ChaosExperimentReconciler) failureInterval(spec *chaosv1alpha1.ChaosExperimentSpec) (time.Duration, error) {
+	if spec.FailureInterval == "" {
+		return defaultFailureInterval, nil
+	}
+	interval, err := r.parseDuration(spec.FailureInterval)
+	if err != nil {
+		return 0, fmt.Errorf("invalid failureInterval: %w", err)
+	}
+	return interval, nil
+}
+
+// isContainerRunning reports whether a regular container of pod is running; a container that is
+// restarting or waiting in CrashLoopBackOff is not
+func isContainerRunning(pod *corev1.Pod, containerName string) bool {
+	for _, status := range pod.Status.ContainerStatuses {
+		if status.Name == containerName {
+			return status.State.Running != nil
+		}
+	}
+	return false
+}
+
+// continuePodFailure fails the targets of the current run again until status.failureEndsAt. Only
+// running containers are signalled, so the kubelet's restart backoff is left to play out between
+// failures. Targets are the status.affectedPods of the run; pods that were replaced are skipped.
+func (r *ChaosExperimentReconciler) continuePodFailure(ctx context.Context, exp *chaosv1alpha1.ChaosExperiment) (ctrl.Result, error) {
+	log := ctrl.LoggerFrom(ctx)
+
+	remaining := time.Until(exp.Status.FailureEndsAt.Time)
+	if remaining <= 0 {
+		log.Info("Repeated container failure finished", "pods", len(exp.Status.AffectedPods))
+		exp.Status.Message = fmt.Sprintf("Stopped failing %d pod(s) after %s", len(exp.Status.AffectedPods), exp.Spec.Duration)
+		exp.Status.FailureEndsAt = nil
+		clearAffectedPods(exp)
+		if err := r.Status().Update(ctx, exp); err != nil {
+			log.Error(err, "Failed to update ChaosExperiment status")
+			return ctrl.Result{}, err
+		}
+		// The next round starts one interval after this one did
+		next := r.roundInterval(exp)
+		if exp.Status.LastRunTime != nil {
+			next = max(time.Until(exp.Status.LastRunTime.Add(next)), time.Second)
+		}
+		return ctrl.Result{RequeueAfter: next}, nil
+	}
+
+	interval, err := r.failureInterval(&exp.Spec)
+	if err != nil {
+		return r.handleExperimentFailure(ctx, exp, &ChaosError{Original: err, Type: ErrorTypeValidation})
+	}
+
+	signal := failureSignal(&exp.Spec)
+	failed := 0
+	for _, ref := range exp.Status.AffectedPods {
+		key, containerName, ok := parseAffectedPodRef(ref)
+		if !ok {
+			continue
+		}
+		pod := &corev1.Pod{}
+		if err := r.Get(ctx, key, pod); err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return ctrl.Result{}, err
+		}
+		if pod.DeletionTimestamp != nil || !isContainerRunning(pod, containerName) {
+			continue
+		}
+		if err := r.killContainerProcess(ctx, pod, containerName, signal); err != nil {
+			chaosErr := WrapK8sError(err, "exec pod")
+			chaosmetrics.ExperimentErrors.WithLabelValues("pod-failure", exp.Spec.Namespace, string(chaosErr.Type)).Inc()
+			continue
+		}
+		failed++
+	}
+	log.Info("Failed running containers again", "failed", failed, "targets", len(exp.Status.AffectedPods),
+		"signal", signal, "remaining", remaining)
+
+	return ctrl.Result{RequeueAfter: min(interval, remaining)}, nil
+}
@@ -0,0 +1,99 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	chaosv1alpha1 "github.com/neogan74/k8s-chaos/api/v1alpha1"
+)
+
+func TestFailureSignalAndInterval(t *testing.T) {
+	r := newReconcilerWithObjects(t)
+
+	spec := &chaosv1alpha1.ChaosExperimentSpec{}
+	assert.Equal(t, "KILL", failureSignal(spec))
+	interval, err := r.failureInterval(spec)
+	require.NoError(t, err)
+	assert.Equal(t, 10*time.Second, interval)
+
+	spec = &chaosv1alpha1.ChaosExperimentSpec{FailureSignal: "SEGV", FailureInterval: "1m30s"}
+	assert.Equal(t, "SEGV", failureSignal(spec))
+	interval, err = r.failureInterval(spec)
+	require.NoError(t, err)
+	assert.Equal(t, 90*time.Second, interval)
+}
+
+func repeatedFailureExperiment(endsAt time.Time, affected ...string) *chaosv1alpha1.ChaosExperiment {
+	ends := metav1.NewTime(endsAt)
+	return &chaosv1alpha1.ChaosExperiment{
+		ObjectMeta: metav1.ObjectMeta{Name: "crash", Namespace: "default"},
+		Spec: chaosv1alpha1.ChaosExperimentSpec{
+			Action: "pod-failure", Namespace: "apps", Duration: "5m", FailureInterval: "20s",
+		},
+		Status: chaosv1alpha1.ChaosExperimentStatus{Phase: phaseRunning, FailureEndsAt: &ends, AffectedPods: affected},
+	}
+}
+
+func TestContinuePodFailure_SkipsContainersInBackoff(t *testing.T) {
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "api-0", Namespace: "apps"},
+		Spec:       corev1.PodSpec{Containers: []corev1.Container{{Name: "api"}}},
+		Status: corev1.PodStatus{ContainerStatuses: []corev1.ContainerStatus{{
+			Name:  "api",
+			State: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "CrashLoopBackOff"}},
+		}}},
+	}
+	// A replaced pod and a pod in backoff are not signalled, so no exec is attempted
+	exp := repeatedFailureExperiment(time.Now().Add(time.Minute), "apps/api-0:api", "apps/gone:api")
+	r := newReconcilerWithObjects(t, exp, pod)
+
+	result, err := r.continuePodFailure(context.Background(), exp)
+	require.NoError(t, err)
+	assert.Equal(t, 20*time.Second, result.RequeueAfter)
+	assert.NotNil(t, exp.Status.FailureEndsAt)
+}
+
+func TestContinuePodFailure_RequeuesAtEnd(t *testing.T) {
+	exp := repeatedFailureExperiment(time.Now().Add(5*time.Second), "apps/gone:api")
+	r := newReconcilerWithObjects(t, exp)
+
+	result, err := r.continuePodFailure(context.Background(), exp)
+	require.NoError(t, err)
+	assert.LessOrEqual(t, result.RequeueAfter, 5*time.Second)
+}
+
+func TestContinuePodFailure_Finished(t *testing.T) {
+	exp := repeatedFailureExperiment(time.Now().Add(-time.Second), "apps/api-0:api", "apps/api-1:api")
+	r := newReconcilerWithObjects(t, exp)
+
+	result, err := r.continuePodFailure(context.Background(), exp)
+	require.NoError(t, err)
+	assert.Equal(t, time.Minute, result.RequeueAfter)
+
+	refreshed := fetchExperiment(t, r, "crash", "default")
+	assert.Nil(t, refreshed.Status.FailureEndsAt)
+	assert.Empty(t, refreshed.Status.AffectedPods)
+	assert.Equal(t, "Stopped failing 2 pod(s) after 5m", refreshed.Status.Message)
+}
@@ -0,0 +1,88 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// defaultPodListPageSize is the page size for listing pods from the API server
+const defaultPodListPageSize = 250
+
+// listPods returns the pods matching opts, from the cache or, with ListPodsFromAPI, from the API
+// server in pages
+func (r *ChaosExperimentReconciler) listPods(ctx context.Context, opts ...client.ListOption) ([]corev1.Pod, error) {
+	if r.ListPodsFromAPI && r.APIReader != nil {
+		return r.listPodPages(ctx, opts...)
+	}
+	podList := &corev1.PodList{}
+	if err := r.List(ctx, podList, opts...); err != nil {
+		return nil, err
+	}
+	return podList.Items, nil
+}
+
+// liveReader reads from the API server when APIReader is set, for objects the cache may hold stale
+// or, like pods outside PodCacheSelector, not at all
+func (r *ChaosExperimentReconciler) liveReader() client.Reader {
+	if r.APIReader != nil {
+		return r.APIReader
+	}
+	return r.Client
+}
+
+// listPodsOnNode returns the pods scheduled to a node. With an APIReader the API server filters
+// on spec.nodeName and the list is read in pages; the cache needs the podNodeNameField index.
+func (r *ChaosExperimentReconciler) listPodsOnNode(ctx context.Context, nodeName string) ([]corev1.Pod, error) {
+	if r.APIReader == nil {
+		return r.listPods(ctx, client.MatchingFields{podNodeNameField: nodeName})
+	}
+	return r.listPodPages(ctx, client.MatchingFields{podNodeNameField: nodeName})
+}
+
+// listPodPages lists pods through APIReader with limit and continue. The cache selector is
+// added to the label selector so the API server returns no pods the cache would not know.
+func (r *ChaosExperimentReconciler) listPodPages(ctx context.Context, opts ...client.ListOption) ([]corev1.Pod, error) {
+	listOpts := (&client.ListOptions{}).ApplyOptions(opts)
+	if r.PodCacheSelector != nil && !r.PodCacheSelector.Empty() {
+		if listOpts.LabelSelector == nil {
+			listOpts.LabelSelector = r.PodCacheSelector
+		} else if requirements, selectable := r.PodCacheSelector.Requirements(); selectable {
+			listOpts.LabelSelector = listOpts.LabelSelector.Add(requirements...)
+		}
+	}
+	listOpts.Limit = r.PodListPageSize
+	if listOpts.Limit <= 0 {
+		listOpts.Limit = defaultPodListPageSize
+	}
+
+	var pods []corev1.Pod
+	for {
+		podList := &corev1.PodList{}
+		if err := r.APIReader.List(ctx, podList, listOpts); err != nil {
+			return nil, err
+		}
+		pods = append(pods, podList.Items...)
+		if podList.Continue == "" {
+			return pods, nil
+		}
+		listOpts.Continue = podList.Continue
+	}
+}
@@ -0,0 +1,361 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	chaosv1alpha1 "github.com/neogan74/k8s-chaos/api/v1alpha1"
+	chaosmetrics "github.com/neogan74/k8s-chaos/internal/metrics"
+)
+
+const (
+	// restartReadyTimeout bounds the wait for a restarted pod to become Ready before the next restart
+	restartReadyTimeout = 5 * time.Minute
+	// restartObserveTimeout bounds the wait for a signalled container to restart
+	restartObserveTimeout = 45 * time.Second
+	// restartPollInterval is how often a rolling restart checks on the pod it restarted last
+	restartPollInterval = 2 * time.Second
+)
+
+// podRestartTiming is how long a restarted pod took to restart and to become Ready again
+type podRestartTiming struct {
+	Restarted time.Duration
+	Ready     time.Duration
+}
+
+// String is the history record detail of a restarted pod
+func (t podRestartTiming) String() string {
+	return fmt.Sprintf("restarted after %s, ready after %s",
+		t.Restarted.Round(time.Millisecond), t.Ready.Round(time.Millisecond))
+}
+
+// rollingRestartOrder orders the selected pods for a rolling restart: grouped by controller owner,
+// StatefulSet pods from the highest ordinal down like a StatefulSet rolling update, other pods by
+// name. The order does not depend on how the pods were selected, so reruns restart in the same order.
+func rollingRestartOrder(pods []corev1.Pod) []corev1.Pod {
+	ordered := append([]corev1.Pod(nil), pods...)
+	sort.SliceStable(ordered, func(i, j int) bool {
+		oi, oj := restartOwnerKey(&ordered[i]), restartOwnerKey(&ordered[j])
+		if oi != oj {
+			return oi < oj
+		}
+		ni, iok := statefulSetOrdinal(&ordered[i])
+		nj, jok := statefulSetOrdinal(&ordered[j])
+		if iok && jok && ni != nj {
+			return ni > nj
+		}
+		return podKey(&ordered[i]) < podKey(&ordered[j])
+	})
+	return ordered
+}
+
+// restartOwnerKey groups pods by namespace and controller owner; pods without one sort last
+func restartOwnerKey(pod *corev1.Pod) string {
+	owner := metav1.GetControllerOf(pod)
+	if owner == nil {
+		return pod.Namespace + "/~"
+	}
+	return pod.Namespace + "/" + owner.Kind + "/" + owner.Name
+}
+
+// statefulSetOrdinal returns the ordinal of a pod owned by a StatefulSet
+func statefulSetOrdinal(pod *corev1.Pod) (int, bool) {
+	owner := metav1.GetControllerOf(pod)
+	if owner == nil || owner.Kind != "StatefulSet" || !strings.HasPrefix(pod.Name, owner.Name+"-") {
+		return 0, false
+	}
+	ordinal, err := strconv.Atoi(strings.TrimPrefix(pod.Name, owner.Name+"-"))
+	if err != nil || ordinal < 0 {
+		return 0, false
+	}
+	return ordinal, true
+}
+
+// restartInterval returns the wait between two restarts of a pod-restart run
+func (r *ChaosExperimentReconciler) restartInterval(spec *chaosv1alpha1.ChaosExperimentSpec) (time.Duration, error) {
+	if spec.RestartInterval == "" {
+		return 0, nil
+	}
+	interval, err := r.parseDuration(spec.RestartInterval)
+	if err != nil {
+		return 0, fmt.Errorf("invalid restartInterval: %w", err)
+	}
+	return interval, nil
+}
+
+// continueRollingRestart takes the next step of the pod-restart run in status.rollingRestart: it
+// checks on the pod restarted last, restarts the next target once that one is Ready and the
+// restart interval passed, and finishes the run after the last target. Every step returns and
+// requeues, so a restart never holds a worker while the pod comes back.
+func (r *ChaosExperimentReconciler) continueRollingRestart(ctx context.Context, exp *chaosv1alpha1.ChaosExperiment) (ctrl.Result, error) {
+	run := exp.Status.RollingRestart
+	saved := run.DeepCopy()
+	now := time.Now()
+
+	if run.Pod != "" {
+		ready, halted, err := r.checkRestartedPod(ctx, exp, now)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		if halted {
+			return r.finishRollingRestart(ctx, exp, true)
+		}
+		if !ready {
+			return r.saveRollingRestart(ctx, exp, saved, restartPollInterval)
+		}
+	}
+
+	if len(run.Pending) == 0 {
+		return r.finishRollingRestart(ctx, exp, false)
+	}
+	if run.NextRestartAt != nil && now.Before(run.NextRestartAt.Time) {
+		return r.saveRollingRestart(ctx, exp, saved, run.NextRestartAt.Sub(now))
+	}
+
+	if err := r.restartNextPod(ctx, exp); err != nil {
+		return ctrl.Result{}, err
+	}
+	if run.Pod == "" {
+		// None of the targets left could be restarted
+		return r.finishRollingRestart(ctx, exp, false)
+	}
+	return r.saveRollingRestart(ctx, exp, nil, restartPollInterval)
+}
+
+// saveRollingRestart writes the progress of the run unless the step left it as saved, and checks
+// on it again after wait
+func (r *ChaosExperimentReconciler) saveRollingRestart(ctx context.Context, exp *chaosv1alpha1.ChaosExperiment, saved *chaosv1alpha1.RollingRestartStatus, wait time.Duration) (ctrl.Result, error) {
+	if saved == nil || !equality.Semantic.DeepEqual(saved, exp.Status.RollingRestart) {
+		if err := r.Status().Update(ctx, exp); err != nil {
+			ctrl.LoggerFrom(ctx).Error(err, "Failed to update rolling restart progress")
+			return ctrl.Result{}, err
+		}
+	}
+	return ctrl.Result{RequeueAfter: wait}, nil
+}
+
+// restartNextPod sends SIGTERM to the next pending target that can be restarted and records it
+// as the pod to check on. Targets that are gone or have no container are skipped.
+func (r *ChaosExperimentReconciler) restartNextPod(ctx context.Context, exp *chaosv1alpha1.ChaosExperiment) error {
+	log := ctrl.LoggerFrom(ctx)
+	run := exp.Status.RollingRestart
+
+	for len(run.Pending) > 0 {
+		key := run.Pending[0]
+		run.Pending = run.Pending[1:]
+		namespace, name, _ := strings.Cut(key, "/")
+		pod := &corev1.Pod{}
+		if err := r.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, pod); err != nil {
+			if !apierrors.IsNotFound(err) {
+				run.Pending = append([]string{key}, run.Pending...)
+				return err
+			}
+			log.Info("Skipping restart of deleted pod", "pod", key)
+			continue
+		}
+
+		log.Info("Gracefully restarting pod", "pod", pod.Name, "namespace", pod.Namespace)
+		containerName, initialRestartCount, err := getPrimaryContainerRestartCount(pod)
+		if err != nil {
+			log.Error(err, "Failed to restart pod", "pod", pod.Name)
+			chaosmetrics.ExperimentErrors.WithLabelValues("pod-restart", exp.Spec.Namespace, string(ErrorTypeExecution)).Inc()
+			continue
+		}
+
+		// Send SIGTERM to gracefully restart the container; whether it restarted is checked later
+		injectedAt := metav1.Now()
+		if err := r.gracefullyRestartContainer(ctx, pod); err != nil {
+			log.Error(err, "Exec returned an error while sending restart signal", "pod", pod.Name)
+		}
+		run.Pod = key
+		run.Container = containerName
+		run.RestartCount = initialRestartCount
+		run.InjectedAt = &injectedAt
+		run.Observed = false
+		run.NextRestartAt = nil
+		return nil
+	}
+	return nil
+}
+
+// checkRestartedPod checks on the pod restarted last. It reports ready once the pod is Ready
+// again, with its timing added to the run, and halted when it did not come back in time.
+// A container that was not seen restarting is skipped like a target that could not be restarted.
+func (r *ChaosExperimentReconciler) checkRestartedPod(ctx context.Context, exp *chaosv1alpha1.ChaosExperiment, now time.Time) (bool, bool, error) {
+	log := ctrl.LoggerFrom(ctx)
+	run := exp.Status.RollingRestart
+	namespace, name, _ := strings.Cut(run.Pod, "/")
+	injectedAt := run.InjectedAt.Time
+
+	pod := &corev1.Pod{}
+	err := r.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, pod)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return false, false, err
+	}
+	ref := chaosv1alpha1.ResourceReference{Kind: "Pod", Name: name, Namespace: namespace, Action: "container-restarted"}
+
+	if !run.Observed {
+		restarted := err == nil && containerRestarted(pod, run.Container, run.RestartCount)
+		if !restarted {
+			if err == nil && now.Sub(injectedAt) < restartObserveTimeout {
+				return false, false, nil
+			}
+			log.Error(nil, "Failed to observe container restart", "pod", name, "container", run.Container)
+			chaosmetrics.ExperimentErrors.WithLabelValues("pod-restart", exp.Spec.Namespace, string(ErrorTypeTimeout)).Inc()
+			return r.nextRestart(exp, now), false, nil
+		}
+		run.Observed = true
+		r.Recorder.Event(pod, corev1.EventTypeWarning, "ChaosPodRestart",
+			fmt.Sprintf("Restarted pod by chaos experiment %s", exp.Name))
+		r.trackContainerRestart(exp, "pod-restart", exp.Spec.Namespace, pod, run.Container, run.RestartCount, injectedAt)
+	}
+
+	if err == nil {
+		if timing, ok := restartedPodTiming(pod, run.Container, run.RestartCount, injectedAt, now); ok {
+			log.Info("Restarted pod is Ready", "pod", name, "container", run.Container, "timing", timing.String())
+			ref.Details = timing.String()
+			run.Restarted = append(run.Restarted, ref)
+			return r.nextRestart(exp, now), false, nil
+		}
+		if now.Sub(injectedAt) < restartReadyTimeout {
+			return false, false, nil
+		}
+	}
+
+	// Restarting more pods while one is not Ready would widen the outage; stop here
+	log.Error(nil, "Stopping rolling restart, restarted pod is not Ready", "pod", name, "timeout", restartReadyTimeout)
+	ref.Details = fmt.Sprintf("not Ready within %s", restartReadyTimeout)
+	run.Restarted = append(run.Restarted, ref)
+	return false, true, nil
+}
+
+// nextRestart forgets the pod restarted last and holds the next restart for the restart interval.
+// It always reports true, the run may go on.
+func (r *ChaosExperimentReconciler) nextRestart(exp *chaosv1alpha1.ChaosExperiment, now time.Time) bool {
+	run := exp.Status.RollingRestart
+	run.Pod, run.Container, run.RestartCount, run.InjectedAt, run.Observed = "", "", 0, nil, false
+	if interval, _ := r.restartInterval(&exp.Spec); interval > 0 && len(run.Pending) > 0 {
+		next := metav1.NewTime(now.Add(interval))
+		run.NextRestartAt = &next
+	}
+	return true
+}
+
+// finishRollingRestart records the run in status.rollingRestart and clears it. A halted run is
+// recorded as a failure and goes through the retry handling.
+func (r *ChaosExperimentReconciler) finishRollingRestart(ctx context.Context, exp *chaosv1alpha1.ChaosExperiment, halted bool) (ctrl.Result, error) {
+	log := ctrl.LoggerFrom(ctx)
+	run := exp.Status.RollingRestart
+	exp.Status.RollingRestart = nil
+
+	if len(run.Restarted) == 0 {
+		return r.handleExperimentFailure(ctx, exp, &ChaosError{
+			Original: fmt.Errorf("failed to restart any pods"),
+			Type:     ErrorTypeExecution,
+		})
+	}
+
+	now := metav1.Now()
+	exp.Status.LastRunTime = &now
+	exp.Status.Message = fmt.Sprintf("Successfully restarted %d pod(s)", len(run.Restarted))
+	status := statusSuccess
+	var errorDetails *chaosv1alpha1.ErrorDetails
+	if halted {
+		halt := run.Restarted[len(run.Restarted)-1]
+		exp.Status.Message = fmt.Sprintf("Restarted %d pod(s); stopped because pod %s was not Ready within %s",
+			len(run.Restarted), halt.Name, restartReadyTimeout)
+		status = statusFailure
+		errorDetails = &chaosv1alpha1.ErrorDetails{
+			Message:       exp.Status.Message,
+			FailureReason: "Timeout",
+		}
+	}
+
+	// Record metrics
+	chaosmetrics.ExperimentsTotal.WithLabelValues("pod-restart", exp.Spec.Namespace, status).Inc()
+	chaosmetrics.ExperimentDuration.WithLabelValues("pod-restart", exp.Spec.Namespace).Observe(now.Sub(run.StartedAt.Time).Seconds())
+	chaosmetrics.ResourcesAffected.WithLabelValues("pod-restart", exp.Spec.Namespace, exp.Name).Set(float64(len(run.Restarted)))
+	countAffected(exp, len(run.Restarted))
+
+	// Create history record with the restart timing of each pod
+	if err := r.createHistoryRecord(ctx, exp, status, run.Restarted, run.StartedAt.Time, errorDetails); err != nil {
+		log.Error(err, "Failed to create history record")
+		// Don't fail the experiment if history recording fails
+	}
+
+	if halted {
+		return r.handleExperimentFailure(ctx, exp, &ChaosError{
+			Original: errors.New(exp.Status.Message),
+			Type:     ErrorTypeTimeout,
+		})
+	}
+
+	// Reset retry counters on success
+	if err := r.handleExperimentSuccess(ctx, exp); err != nil {
+		log.Error(err, "Failed to update ChaosExperiment status")
+		return ctrl.Result{}, err
+	}
+	return ctrl.Result{RequeueAfter: r.roundInterval(exp)}, nil
+}
+
+// containerRestarted reports whether a container restarted past the given restart count
+func containerRestarted(pod *corev1.Pod, containerName string, initialRestartCount int32) bool {
+	for _, status := range pod.Status.ContainerStatuses {
+		if status.Name == containerName {
+			return status.RestartCount > initialRestartCount
+		}
+	}
+	return false
+}
+
+// restartedPodTiming returns the timing of a restart relative to injectedAt once the restarted
+// container and the pod are Ready again
+func restartedPodTiming(pod *corev1.Pod, containerName string, initialRestartCount int32, injectedAt, now time.Time) (podRestartTiming, bool) {
+	for _, status := range pod.Status.ContainerStatuses {
+		if status.Name != containerName || status.RestartCount <= initialRestartCount ||
+			!status.Ready || status.State.Running == nil {
+			continue
+		}
+		readyAt := podReadyTime(pod)
+		if readyAt.IsZero() {
+			continue
+		}
+		// Without a readiness probe the condition may never have turned false
+		if readyAt.Before(injectedAt) {
+			readyAt = now
+		}
+		return podRestartTiming{
+			Restarted: max(status.State.Running.StartedAt.Sub(injectedAt), 0),
+			Ready:     max(readyAt.Sub(injectedAt), 0),
+		}, true
+	}
+	return podRestartTiming{}, false
+}
@@ -0,0 +1,194 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	chaosv1alpha1 "github.com/neogan74/k8s-chaos/api/v1alpha1"
+)
+
+func restartTestPod(name, ownerKind, ownerName string) corev1.Pod {
+	pod := corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "restart"}}
+	if ownerKind != "" {
+		pod.OwnerReferences = []metav1.OwnerReference{{
+			APIVersion: "apps/v1", Kind: ownerKind, Name: ownerName, Controller: ptr.To(true),
+		}}
+	}
+	return pod
+}
+
+func TestRollingRestartOrder(t *testing.T) {
+	pods := []corev1.Pod{
+		restartTestPod("web-7d9f-b", "ReplicaSet", "web-7d9f"),
+		restartTestPod("db-2", "StatefulSet", "db"),
+		restartTestPod("standalone", "", ""),
+		restartTestPod("db-10", "StatefulSet", "db"),
+		restartTestPod("web-7d9f-a", "ReplicaSet", "web-7d9f"),
+		restartTestPod("db-0", "StatefulSet", "db"),
+	}
+
+	var names []string
+	for _, pod := range rollingRestartOrder(pods) {
+		names = append(names, pod.Name)
+	}
+
+	// StatefulSet pods go from the highest ordinal down, numerically rather than by name
+	assert.Equal(t, []string{"web-7d9f-a", "web-7d9f-b", "db-10", "db-2", "db-0", "standalone"}, names)
+	assert.Equal(t, "web-7d9f-b", pods[0].Name, "input must not be reordered")
+}
+
+func TestStatefulSetOrdinal(t *testing.T) {
+	ordinal, ok := statefulSetOrdinal(ptr.To(restartTestPod("kafka-broker-3", "StatefulSet", "kafka-broker")))
+	assert.True(t, ok)
+	assert.Equal(t, 3, ordinal)
+
+	_, ok = statefulSetOrdinal(ptr.To(restartTestPod("kafka-broker-x", "StatefulSet", "kafka-broker")))
+	assert.False(t, ok)
+	_, ok = statefulSetOrdinal(ptr.To(restartTestPod("web-1", "ReplicaSet", "web")))
+	assert.False(t, ok)
+}
+
+func TestRestartedPodTiming(t *testing.T) {
+	injectedAt := time.Now().Add(-time.Minute).Truncate(time.Second)
+	pod := restartTestPod("db-0", "StatefulSet", "db")
+	pod.Status.ContainerStatuses = []corev1.ContainerStatus{{
+		Name: "postgres", RestartCount: 2, Ready: true,
+		State: corev1.ContainerState{Running: &corev1.ContainerStateRunning{StartedAt: metav1.NewTime(injectedAt.Add(2 * time.Second))}},
+	}}
+	pod.Status.Conditions = []corev1.PodCondition{{
+		Type: corev1.PodReady, Status: corev1.ConditionTrue, LastTransitionTime: metav1.NewTime(injectedAt.Add(9 * time.Second)),
+	}}
+
+	timing, ok := restartedPodTiming(&pod, "postgres", 1, injectedAt, time.Now())
+	require.True(t, ok)
+	assert.Equal(t, podRestartTiming{Restarted: 2 * time.Second, Ready: 9 * time.Second}, timing)
+	assert.Equal(t, "restarted after 2s, ready after 9s", timing.String())
+
+	// The container has not restarted past the initial count yet
+	_, ok = restartedPodTiming(&pod, "postgres", 2, injectedAt, time.Now())
+	assert.False(t, ok)
+}
+
+// restartingPod is a pod whose postgres container restarted as often as restarts, Ready if ready
+func restartingPod(name string, restarts int32, ready bool, injectedAt time.Time) *corev1.Pod {
+	pod := restartTestPod(name, "StatefulSet", "db")
+	pod.Spec.Containers = []corev1.Container{{Name: "postgres", Image: "postgres"}}
+	pod.Status.ContainerStatuses = []corev1.ContainerStatus{{
+		Name: "postgres", RestartCount: restarts, Ready: ready,
+		State: corev1.ContainerState{Running: &corev1.ContainerStateRunning{StartedAt: metav1.NewTime(injectedAt.Add(time.Second))}},
+	}}
+	if ready {
+		pod.Status.Conditions = []corev1.PodCondition{{
+			Type: corev1.PodReady, Status: corev1.ConditionTrue, LastTransitionTime: metav1.NewTime(injectedAt.Add(5 * time.Second)),
+		}}
+	}
+	return &pod
+}
+
+func restartExperiment(run *chaosv1alpha1.RollingRestartStatus) *chaosv1alpha1.ChaosExperiment {
+	return &chaosv1alpha1.ChaosExperiment{
+		ObjectMeta: metav1.ObjectMeta{Name: "rolling", Namespace: "default"},
+		Spec: chaosv1alpha1.ChaosExperimentSpec{
+			Action: "pod-restart", Namespace: "restart", Count: 2, RestartInterval: "1m",
+		},
+		Status: chaosv1alpha1.ChaosExperimentStatus{Phase: phaseRunning, RetryCount: 1, RollingRestart: run},
+	}
+}
+
+func TestContinueRollingRestart_StepsAcrossReconciles(t *testing.T) {
+	ctx := context.Background()
+	exp := restartExperiment(&chaosv1alpha1.RollingRestartStatus{
+		StartedAt: metav1.Now(), Pending: []string{"restart/db-1", "restart/db-0"},
+	})
+	// Without a clientset the SIGTERM cannot be sent; the controller only logs that
+	r := newReconcilerWithObjects(t, exp, restartingPod("db-1", 0, true, time.Now()), restartingPod("db-0", 0, true, time.Now()))
+
+	// The first target is signalled and the reconcile returns instead of waiting for it
+	result, err := r.continueRollingRestart(ctx, exp)
+	require.NoError(t, err)
+	assert.Equal(t, restartPollInterval, result.RequeueAfter)
+	got := fetchExperiment(t, r, "rolling", "default")
+	require.NotNil(t, got.Status.RollingRestart)
+	assert.Equal(t, "restart/db-1", got.Status.RollingRestart.Pod)
+	assert.Equal(t, []string{"restart/db-0"}, got.Status.RollingRestart.Pending)
+
+	// Once it restarted and is Ready, its timing is recorded and the next restart waits for the interval
+	injectedAt := got.Status.RollingRestart.InjectedAt.Time
+	pod := restartingPod("db-1", 1, true, injectedAt)
+	current := &corev1.Pod{}
+	require.NoError(t, r.Get(ctx, client.ObjectKeyFromObject(pod), current))
+	current.Status = pod.Status
+	require.NoError(t, r.Status().Update(ctx, current))
+	result, err = r.continueRollingRestart(ctx, got)
+	require.NoError(t, err)
+	assert.InDelta(t, time.Minute.Seconds(), result.RequeueAfter.Seconds(), 1)
+	got = fetchExperiment(t, r, "rolling", "default")
+	run := got.Status.RollingRestart
+	require.NotNil(t, run)
+	assert.Empty(t, run.Pod)
+	require.Len(t, run.Restarted, 1)
+	assert.Equal(t, "restarted after 1s, ready after 5s", run.Restarted[0].Details)
+	require.NotNil(t, run.NextRestartAt)
+
+	// The second target never restarts; after the observe timeout it is skipped and the run ends
+	past := metav1.NewTime(time.Now().Add(-time.Second))
+	run.NextRestartAt = &past
+	_, err = r.continueRollingRestart(ctx, got)
+	require.NoError(t, err)
+	got = fetchExperiment(t, r, "rolling", "default")
+	assert.Equal(t, "restart/db-0", got.Status.RollingRestart.Pod)
+	signalled := metav1.NewTime(time.Now().Add(-restartObserveTimeout))
+	got.Status.RollingRestart.InjectedAt = &signalled
+	_, err = r.continueRollingRestart(ctx, got)
+	require.NoError(t, err)
+
+	got = fetchExperiment(t, r, "rolling", "default")
+	assert.Nil(t, got.Status.RollingRestart)
+	assert.Equal(t, phaseCompleted, got.Status.Phase)
+	assert.Equal(t, "Successfully restarted 1 pod(s)", got.Status.Message)
+	assert.Zero(t, got.Status.RetryCount)
+}
+
+func TestContinueRollingRestart_HaltedRunFails(t *testing.T) {
+	ctx := context.Background()
+	signalled := metav1.NewTime(time.Now().Add(-restartReadyTimeout))
+	exp := restartExperiment(&chaosv1alpha1.RollingRestartStatus{
+		StartedAt: signalled, Pending: []string{"restart/db-0"},
+		Pod: "restart/db-1", Container: "postgres", InjectedAt: &signalled, Observed: true,
+	})
+	r := newReconcilerWithObjects(t, exp, restartingPod("db-1", 1, false, signalled.Time))
+
+	_, err := r.continueRollingRestart(ctx, exp)
+	require.NoError(t, err)
+
+	// The pod that did not come back stops the run, which goes through the failure handling
+	got := fetchExperiment(t, r, "rolling", "default")
+	assert.Nil(t, got.Status.RollingRestart)
+	assert.Equal(t, phasePending, got.Status.Phase)
+	assert.Equal(t, 2, got.Status.RetryCount)
+	assert.Contains(t, got.Status.Message, "stopped because pod db-1 was not Ready within 5m0s")
+}
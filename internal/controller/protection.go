@@ -0,0 +1,110 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	coordinationv1 "k8s.io/api/coordination/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// filterProtectedPods removes pods that are the only ready replica of their owner and pods
+// that hold a leader-election lease. It returns the remaining pods and how many were skipped
+// for each reason.
+func (r *ChaosExperimentReconciler) filterProtectedPods(ctx context.Context, namespace string, pods []corev1.Pod) ([]corev1.Pod, int, int, error) {
+	log := ctrl.LoggerFrom(ctx)
+
+	// Count ready replicas per owner across the whole namespace, not just the selected pods
+	allPods, err := r.listPods(ctx, client.InNamespace(namespace))
+	if err != nil {
+		return nil, 0, 0, fmt.Errorf("failed to list pods for singleton check: %w", err)
+	}
+	readyByOwner := map[types.UID]int{}
+	for i := range allPods {
+		pod := &allPods[i]
+		owner := controllerOwnerUID(pod)
+		if owner != "" && pod.DeletionTimestamp == nil && isPodReady(pod) {
+			readyByOwner[owner]++
+		}
+	}
+
+	leases := &coordinationv1.LeaseList{}
+	if err := r.List(ctx, leases, client.InNamespace(namespace)); err != nil {
+		return nil, 0, 0, fmt.Errorf("failed to list leases for leader check: %w", err)
+	}
+	holders := []string{}
+	for _, lease := range leases.Items {
+		if lease.Spec.HolderIdentity != nil && *lease.Spec.HolderIdentity != "" {
+			holders = append(holders, *lease.Spec.HolderIdentity)
+		}
+	}
+
+	result := make([]corev1.Pod, 0, len(pods))
+	singletons, leaders := 0, 0
+	for _, pod := range pods {
+		if owner := controllerOwnerUID(&pod); owner != "" && isPodReady(&pod) && readyByOwner[owner] <= 1 {
+			log.Info("Skipping singleton pod", "pod", pod.Name, "namespace", pod.Namespace)
+			singletons++
+			continue
+		}
+		if isLeaseHolder(pod.Name, holders) {
+			log.Info("Skipping leader pod", "pod", pod.Name, "namespace", pod.Namespace)
+			leaders++
+			continue
+		}
+		result = append(result, pod)
+	}
+
+	return result, singletons, leaders, nil
+}
+
+// controllerOwnerUID returns the UID of the pod's controlling owner, or "" for bare pods
+func controllerOwnerUID(pod *corev1.Pod) types.UID {
+	for _, ref := range pod.OwnerReferences {
+		if ref.Controller != nil && *ref.Controller {
+			return ref.UID
+		}
+	}
+	return ""
+}
+
+// isPodReady reports whether the pod has the Ready condition set to true
+func isPodReady(pod *corev1.Pod) bool {
+	for _, cond := range pod.Status.Conditions {
+		if cond.Type == corev1.PodReady {
+			return cond.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
+
+// isLeaseHolder reports whether a lease holder identity refers to the pod.
+// Most leader-election libraries use the pod name, optionally followed by "_<uuid>".
+func isLeaseHolder(podName string, holders []string) bool {
+	for _, holder := range holders {
+		if holder == podName || strings.HasPrefix(holder, podName+"_") {
+			return true
+		}
+	}
+	return false
+}
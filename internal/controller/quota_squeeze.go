@@ -0,0 +1,423 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	chaosv1alpha1 "github.com/neogan74/k8s-chaos/api/v1alpha1"
+	chaosmetrics "github.com/neogan74/k8s-chaos/internal/metrics"
+)
+
+const (
+	// annotationQuotaSqueezedBy marks a ResourceQuota whose limits were lowered, with the owning
+	// experiment as "namespace/name"
+	annotationQuotaSqueezedBy = "chaos.gushchin.dev/quota-squeezed-by"
+	// annotationOriginalQuotaHard stores the quota's spec.hard as JSON so it can be restored
+	annotationOriginalQuotaHard = "chaos.gushchin.dev/original-quota-hard"
+)
+
+// handleQuotaSqueeze lowers the hard limits of the ResourceQuotas in spec.namespace towards their
+// current usage for spec.duration, so workloads that scale up or roll out in the meantime are
+// refused new pods. The original limits are saved on each quota and put back when the run ends;
+// the history record lists the squeezed limits and the workloads the quotas turned away.
+func (r *ChaosExperimentReconciler) handleQuotaSqueeze(ctx context.Context, exp *chaosv1alpha1.ChaosExperiment) (ctrl.Result, error) {
+	log := ctrl.LoggerFrom(ctx)
+	startTime := time.Now()
+
+	if exp.Status.QuotaSqueezeEndsAt != nil {
+		return r.finishQuotaSqueeze(ctx, exp)
+	}
+
+	duration, err := r.parseDuration(exp.Spec.Duration)
+	if err != nil {
+		return r.handleExperimentFailure(ctx, exp, &ChaosError{
+			Original:  fmt.Errorf("invalid duration format: %s", exp.Spec.Duration),
+			Type:      ErrorTypeValidation,
+			Operation: "validate quota-squeeze config",
+		})
+	}
+	var squeeze chaosv1alpha1.QuotaSqueeze
+	if exp.Spec.QuotaSqueeze != nil {
+		squeeze = *exp.Spec.QuotaSqueeze
+	}
+
+	quotas, err := r.quotasToSqueeze(ctx, exp.Spec.Namespace, squeeze.Name)
+	if err != nil {
+		if isPermissionDeniedError(err) {
+			return ctrl.Result{}, r.handlePermissionDenied(ctx, exp, "reading ResourceQuotas for quota-squeeze", err)
+		}
+		return r.handleExperimentFailure(ctx, exp, WrapK8sError(err, "get ResourceQuotas"))
+	}
+	var planned []string
+	for i := range quotas {
+		if hard := squeezedHard(&quotas[i], &squeeze); len(hard) > 0 {
+			planned = append(planned, fmt.Sprintf("%s (%s)", quotas[i].Name, describeQuotaChange(quotas[i].Spec.Hard, hard)))
+		}
+	}
+	if len(planned) == 0 {
+		return r.handleExperimentFailure(ctx, exp, &ChaosError{
+			Original:  fmt.Errorf("no ResourceQuota limit in namespace %s is above its usage", exp.Spec.Namespace),
+			Type:      ErrorTypeValidation,
+			Operation: "select ResourceQuotas",
+		})
+	}
+
+	if exp.Spec.DryRun {
+		now := metav1.Now()
+		exp.Status.LastRunTime = &now
+		exp.Status.Message = fmt.Sprintf("DRY RUN: Would squeeze %d ResourceQuota(s) in %s for %s: %s",
+			len(planned), exp.Spec.Namespace, exp.Spec.Duration, strings.Join(planned, "; "))
+		exp.Status.Phase = phaseCompleted
+
+		if err := r.Status().Update(ctx, exp); err != nil {
+			log.Error(err, "Failed to update ChaosExperiment status")
+			return ctrl.Result{}, err
+		}
+
+		log.Info("Dry run completed", "action", "quota-squeeze", "quotas", len(planned))
+		return ctrl.Result{}, nil
+	}
+
+	var squeezed []string
+	var squeezeErr error
+	for i := range quotas {
+		key := client.ObjectKeyFromObject(&quotas[i])
+		change, err := r.squeezeQuota(ctx, exp, key, &squeeze)
+		if err != nil {
+			log.Error(err, "Failed to squeeze ResourceQuota", "quota", key)
+			chaosmetrics.ExperimentErrors.WithLabelValues("quota-squeeze", exp.Spec.Namespace, string(WrapK8sError(err, "squeeze quota").Type)).Inc()
+			squeezeErr = err
+			continue
+		}
+		if change == "" {
+			continue
+		}
+		exp.Status.SqueezedQuotas = append(exp.Status.SqueezedQuotas, key.String())
+		squeezed = append(squeezed, fmt.Sprintf("%s (%s)", key.Name, change))
+	}
+	if len(squeezed) == 0 {
+		if squeezeErr == nil {
+			squeezeErr = fmt.Errorf("no ResourceQuota limit in namespace %s is above its usage", exp.Spec.Namespace)
+		}
+		if isPermissionDeniedError(squeezeErr) {
+			return ctrl.Result{}, r.handlePermissionDenied(ctx, exp, "updating ResourceQuotas", squeezeErr)
+		}
+		return r.handleExperimentFailure(ctx, exp, WrapK8sError(squeezeErr, "squeeze ResourceQuotas"))
+	}
+
+	now := metav1.Now()
+	endsAt := metav1.NewTime(now.Add(duration))
+	exp.Status.LastRunTime = &now
+	exp.Status.QuotaSqueezeEndsAt = &endsAt
+	exp.Status.Message = fmt.Sprintf("Squeezed %d ResourceQuota(s) in %s for %s: %s",
+		len(squeezed), exp.Spec.Namespace, exp.Spec.Duration, strings.Join(squeezed, "; "))
+	countAffected(exp, len(squeezed))
+	if err := r.Status().Update(ctx, exp); err != nil {
+		log.Error(err, "Failed to update ChaosExperiment status")
+		return ctrl.Result{}, err
+	}
+	r.Recorder.Event(exp, corev1.EventTypeWarning, "ChaosQuotaSqueeze", exp.Status.Message)
+
+	chaosmetrics.ExperimentsTotal.WithLabelValues("quota-squeeze", exp.Spec.Namespace, statusSuccess).Inc()
+	chaosmetrics.ExperimentDuration.WithLabelValues("quota-squeeze", exp.Spec.Namespace).Observe(time.Since(startTime).Seconds())
+
+	return ctrl.Result{RequeueAfter: duration}, nil
+}
+
+// finishQuotaSqueeze waits for the end of the current run, then records the squeezed limits and
+// the workloads the quotas refused, and restores the quotas
+func (r *ChaosExperimentReconciler) finishQuotaSqueeze(ctx context.Context, exp *chaosv1alpha1.ChaosExperiment) (ctrl.Result, error) {
+	log := ctrl.LoggerFrom(ctx)
+
+	if remaining := time.Until(exp.Status.QuotaSqueezeEndsAt.Time); remaining > 0 {
+		return ctrl.Result{RequeueAfter: remaining}, nil
+	}
+
+	startTime := exp.Status.QuotaSqueezeEndsAt.Time
+	if exp.Status.LastRunTime != nil {
+		startTime = exp.Status.LastRunTime.Time
+	}
+	quotas := r.observeSqueezedQuotas(ctx, exp)
+	names := make([]string, 0, len(quotas))
+	for _, quota := range quotas {
+		names = append(names, quota.Name)
+	}
+	rejected := r.quotaRejections(ctx, exp.Spec.Namespace, names, startTime)
+
+	r.restoreSqueezedQuotas(ctx, exp)
+	exp.Status.Message = fmt.Sprintf("Restored %d ResourceQuota(s) after %s: %d workload(s) were refused by them",
+		len(quotas), exp.Spec.Duration, len(rejected))
+	if err := r.Status().Update(ctx, exp); err != nil {
+		log.Error(err, "Failed to update ChaosExperiment status")
+		return ctrl.Result{}, err
+	}
+	log.Info("Quota squeeze run finished", "quotas", len(quotas), "rejected", len(rejected))
+
+	chaosmetrics.ResourcesAffected.WithLabelValues("quota-squeeze", exp.Spec.Namespace, exp.Name).Set(float64(len(rejected)))
+	if err := r.createHistoryRecord(ctx, exp, statusSuccess, append(quotas, rejected...), startTime, nil); err != nil {
+		log.Error(err, "Failed to create history record")
+		// Don't fail the experiment if history recording fails
+	}
+
+	// The next round starts one interval after this one did
+	next := r.roundInterval(exp)
+	if exp.Status.LastRunTime != nil {
+		next = max(time.Until(exp.Status.LastRunTime.Add(next)), time.Second)
+	}
+	return ctrl.Result{RequeueAfter: next}, nil
+}
+
+// quotasToSqueeze returns the named ResourceQuota, or every ResourceQuota in the namespace when
+// name is empty. Quotas are read live, since they are updated right after.
+func (r *ChaosExperimentReconciler) quotasToSqueeze(ctx context.Context, namespace, name string) ([]corev1.ResourceQuota, error) {
+	if name != "" {
+		quota := &corev1.ResourceQuota{}
+		if err := r.liveReader().Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, quota); err != nil {
+			return nil, err
+		}
+		return []corev1.ResourceQuota{*quota}, nil
+	}
+	quotas := &corev1.ResourceQuotaList{}
+	if err := r.liveReader().List(ctx, quotas, client.InNamespace(namespace)); err != nil {
+		return nil, err
+	}
+	return quotas.Items, nil
+}
+
+// squeezedHard returns the lowered limits of a quota, only those that change. Each selected limit
+// keeps headroomPercent of the room between its usage and the limit; all but CPU limits are
+// counts or bytes and stay whole.
+func squeezedHard(quota *corev1.ResourceQuota, squeeze *chaosv1alpha1.QuotaSqueeze) corev1.ResourceList {
+	lowered := corev1.ResourceList{}
+	for name, hard := range quota.Spec.Hard {
+		if len(squeeze.Resources) > 0 && !slices.Contains(squeeze.Resources, string(name)) {
+			continue
+		}
+		used := quota.Status.Used[name]
+		if used.Cmp(hard) >= 0 {
+			continue
+		}
+		limit := used.MilliValue() + (hard.MilliValue()-used.MilliValue())*int64(squeeze.HeadroomPercent)/100
+		if !strings.HasSuffix(string(name), string(corev1.ResourceCPU)) {
+			limit = max(limit-limit%1000, used.MilliValue())
+		}
+		if limit < hard.MilliValue() {
+			lowered[name] = *resource.NewMilliQuantity(limit, hard.Format)
+		}
+	}
+	return lowered
+}
+
+// describeQuotaChange lists the limits in lowered with their original values, sorted by name
+func describeQuotaChange(original, lowered corev1.ResourceList) string {
+	changes := make([]string, 0, len(lowered))
+	for name, limit := range lowered {
+		before := original[name]
+		changes = append(changes, fmt.Sprintf("%s %s -> %s", name, before.String(), limit.String()))
+	}
+	sort.Strings(changes)
+	return strings.Join(changes, ", ")
+}
+
+// loweredLimits returns the limits of current that differ from original
+func loweredLimits(original, current corev1.ResourceList) corev1.ResourceList {
+	lowered := corev1.ResourceList{}
+	for name, limit := range current {
+		if before, ok := original[name]; ok && limit.Cmp(before) != 0 {
+			lowered[name] = limit
+		}
+	}
+	return lowered
+}
+
+// squeezeQuota lowers the limits of a quota and saves the original ones on it, and returns the
+// change made; "" when no limit could be lowered. A quota squeezed by another experiment is an
+// error, one this experiment already squeezed is left as it is.
+func (r *ChaosExperimentReconciler) squeezeQuota(ctx context.Context, exp *chaosv1alpha1.ChaosExperiment, key types.NamespacedName, squeeze *chaosv1alpha1.QuotaSqueeze) (string, error) {
+	owner := exp.Namespace + "/" + exp.Name
+	var change string
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		quota := &corev1.ResourceQuota{}
+		if err := r.liveReader().Get(ctx, key, quota); err != nil {
+			return err
+		}
+		switch holder := quota.Annotations[annotationQuotaSqueezedBy]; holder {
+		case "":
+		case owner:
+			original, err := originalQuotaHard(quota)
+			if err != nil {
+				return err
+			}
+			change = describeQuotaChange(original, loweredLimits(original, quota.Spec.Hard))
+			return nil
+		default:
+			return fmt.Errorf("ResourceQuota %s is already squeezed by %s", key, holder)
+		}
+
+		lowered := squeezedHard(quota, squeeze)
+		if len(lowered) == 0 {
+			change = ""
+			return nil
+		}
+		saved, err := json.Marshal(quota.Spec.Hard)
+		if err != nil {
+			return fmt.Errorf("failed to save quota limits: %w", err)
+		}
+		change = describeQuotaChange(quota.Spec.Hard, lowered)
+		if quota.Annotations == nil {
+			quota.Annotations = map[string]string{}
+		}
+		quota.Annotations[annotationQuotaSqueezedBy] = owner
+		quota.Annotations[annotationOriginalQuotaHard] = string(saved)
+		for name, limit := range lowered {
+			quota.Spec.Hard[name] = limit
+		}
+		return r.writer(ctx).Update(ctx, quota)
+	})
+	if err != nil {
+		return "", err
+	}
+	if change != "" {
+		r.cleanups.record(ctx, chaosv1alpha1.PendingCleanup{Operation: chaosv1alpha1.CleanupRestoreQuota, Quota: key.String()})
+	}
+	return change, nil
+}
+
+// originalQuotaHard returns the limits squeezeQuota saved on a quota
+func originalQuotaHard(quota *corev1.ResourceQuota) (corev1.ResourceList, error) {
+	var original corev1.ResourceList
+	if err := json.Unmarshal([]byte(quota.Annotations[annotationOriginalQuotaHard]), &original); err != nil {
+		return nil, fmt.Errorf("failed to parse saved limits of ResourceQuota %s/%s: %w", quota.Namespace, quota.Name, err)
+	}
+	return original, nil
+}
+
+// restoreQuota puts back the limits saved on the quota ("namespace/name") by experiment
+// ("namespace/name"). Limits someone changed during the squeeze are overwritten with the saved
+// ones; a quota that is gone or no longer squeezed by experiment needs no restore.
+func (r *ChaosExperimentReconciler) restoreQuota(ctx context.Context, ref, experiment string) error {
+	namespace, name, _ := strings.Cut(ref, "/")
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		quota := &corev1.ResourceQuota{}
+		if err := r.liveReader().Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, quota); err != nil {
+			return client.IgnoreNotFound(err)
+		}
+		if quota.Annotations[annotationQuotaSqueezedBy] != experiment {
+			return nil
+		}
+		original, err := originalQuotaHard(quota)
+		if err != nil {
+			return err
+		}
+		quota.Spec.Hard = original
+		delete(quota.Annotations, annotationQuotaSqueezedBy)
+		delete(quota.Annotations, annotationOriginalQuotaHard)
+		return r.Update(ctx, quota)
+	})
+}
+
+// restoreSqueezedQuotas restores every quota the current run squeezed. A quota that cannot be
+// restored is handed to a cleanup task, so its limits do not stay lowered.
+func (r *ChaosExperimentReconciler) restoreSqueezedQuotas(ctx context.Context, exp *chaosv1alpha1.ChaosExperiment) {
+	log := ctrl.LoggerFrom(ctx)
+	for _, ref := range exp.Status.SqueezedQuotas {
+		if err := r.restoreQuota(ctx, ref, exp.Namespace+"/"+exp.Name); err != nil {
+			log.Error(err, "Failed to restore ResourceQuota", "quota", ref)
+			r.delegateCleanup(ctx, exp, chaosv1alpha1.PendingCleanup{
+				Operation: chaosv1alpha1.CleanupRestoreQuota, Quota: ref,
+			})
+		}
+	}
+	exp.Status.SqueezedQuotas = nil
+	exp.Status.QuotaSqueezeEndsAt = nil
+}
+
+// observeSqueezedQuotas describes the limits the current run lowered on each quota
+func (r *ChaosExperimentReconciler) observeSqueezedQuotas(ctx context.Context, exp *chaosv1alpha1.ChaosExperiment) []chaosv1alpha1.ResourceReference {
+	refs := make([]chaosv1alpha1.ResourceReference, 0, len(exp.Status.SqueezedQuotas))
+	for _, ref := range exp.Status.SqueezedQuotas {
+		namespace, name, _ := strings.Cut(ref, "/")
+		squeezed := chaosv1alpha1.ResourceReference{Kind: "ResourceQuota", Name: name, Namespace: namespace, Action: "squeezed"}
+
+		quota := &corev1.ResourceQuota{}
+		switch err := r.liveReader().Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, quota); {
+		case apierrors.IsNotFound(err):
+			squeezed.Details = "deleted before the run ended"
+		case err != nil:
+			squeezed.Details = "unknown: " + err.Error()
+		default:
+			if original, err := originalQuotaHard(quota); err == nil {
+				squeezed.Details = describeQuotaChange(original, loweredLimits(original, quota.Spec.Hard))
+			}
+		}
+		refs = append(refs, squeezed)
+	}
+	return refs
+}
+
+// quotaRejections finds the objects in namespace that were refused by one of the quotas since the
+// run started, from the events their controllers record, e.g. FailedCreate on a ReplicaSet
+func (r *ChaosExperimentReconciler) quotaRejections(ctx context.Context, namespace string, quotas []string, since time.Time) []chaosv1alpha1.ResourceReference {
+	events := &corev1.EventList{}
+	if err := r.List(ctx, events, client.InNamespace(namespace)); err != nil {
+		ctrl.LoggerFrom(ctx).Error(err, "Failed to list events for quota rejections", "namespace", namespace)
+		return nil
+	}
+	var refs []chaosv1alpha1.ResourceReference
+	seen := map[string]bool{}
+	for i := range events.Items {
+		event := &events.Items[i]
+		object := event.InvolvedObject.Kind + "/" + event.InvolvedObject.Name
+		if event.Type != corev1.EventTypeWarning || eventLastSeen(event).Before(since) || seen[object] {
+			continue
+		}
+		for _, quota := range quotas {
+			if exceedsQuota(event.Message, quota) {
+				seen[object] = true
+				refs = append(refs, chaosv1alpha1.ResourceReference{
+					Kind: event.InvolvedObject.Kind, Name: event.InvolvedObject.Name, Namespace: namespace,
+					Action: "quota-rejected", Details: event.Message,
+				})
+				break
+			}
+		}
+	}
+	return refs
+}
+
+// exceedsQuota reports whether an admission error message names quota as the one exceeded, as in
+// "exceeded quota: compute, requested: pods=1, used: pods=4, limited: pods=4"
+func exceedsQuota(message, quota string) bool {
+	_, rest, found := strings.Cut(message, "exceeded quota: "+quota)
+	return found && (rest == "" || rest[0] == ',')
+}
@@ -0,0 +1,215 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	chaosv1alpha1 "github.com/neogan74/k8s-chaos/api/v1alpha1"
+)
+
+func quotaSqueezeFixtures() (*chaosv1alpha1.ChaosExperiment, *corev1.ResourceQuota) {
+	exp := &chaosv1alpha1.ChaosExperiment{
+		ObjectMeta: metav1.ObjectMeta{Name: "checkout-quota", Namespace: "chaos"},
+		Spec: chaosv1alpha1.ChaosExperimentSpec{
+			Action:       "quota-squeeze",
+			Namespace:    "shop",
+			Selector:     map[string]string{"app": "checkout"},
+			Duration:     "10m",
+			QuotaSqueeze: &chaosv1alpha1.QuotaSqueeze{Name: "compute"},
+		},
+	}
+	quota := &corev1.ResourceQuota{
+		ObjectMeta: metav1.ObjectMeta{Name: "compute", Namespace: "shop"},
+		Spec: corev1.ResourceQuotaSpec{Hard: corev1.ResourceList{
+			corev1.ResourcePods:        resource.MustParse("10"),
+			corev1.ResourceRequestsCPU: resource.MustParse("4"),
+		}},
+		Status: corev1.ResourceQuotaStatus{Used: corev1.ResourceList{
+			corev1.ResourcePods:        resource.MustParse("4"),
+			corev1.ResourceRequestsCPU: resource.MustParse("1500m"),
+		}},
+	}
+	return exp, quota
+}
+
+func fetchQuota(t *testing.T, r *ChaosExperimentReconciler, quota *corev1.ResourceQuota) *corev1.ResourceQuota {
+	t.Helper()
+	got := &corev1.ResourceQuota{}
+	require.NoError(t, r.Get(context.Background(), client.ObjectKeyFromObject(quota), got))
+	return got
+}
+
+func TestSqueezedHard(t *testing.T) {
+	_, quota := quotaSqueezeFixtures()
+	quota.Spec.Hard[corev1.ResourceRequestsMemory] = resource.MustParse("8Gi")
+	quota.Status.Used[corev1.ResourceRequestsMemory] = resource.MustParse("8Gi")
+
+	tests := []struct {
+		name    string
+		squeeze chaosv1alpha1.QuotaSqueeze
+		want    string
+	}{
+		{name: "exhausted at usage", want: "pods 10 -> 4, requests.cpu 4 -> 1500m"},
+		{name: "half the headroom", squeeze: chaosv1alpha1.QuotaSqueeze{HeadroomPercent: 50}, want: "pods 10 -> 7, requests.cpu 4 -> 2750m"},
+		{name: "counts stay whole", squeeze: chaosv1alpha1.QuotaSqueeze{HeadroomPercent: 25}, want: "pods 10 -> 5, requests.cpu 4 -> 2125m"},
+		{name: "selected resources", squeeze: chaosv1alpha1.QuotaSqueeze{Resources: []string{"pods", "requests.memory"}}, want: "pods 10 -> 4"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, describeQuotaChange(quota.Spec.Hard, squeezedHard(quota, &tt.squeeze)))
+		})
+	}
+}
+
+func TestHandleQuotaSqueeze_SqueezesAndRestores(t *testing.T) {
+	ctx := context.Background()
+	exp, quota := quotaSqueezeFixtures()
+	r := newReconcilerWithObjects(t, exp, quota)
+
+	result, err := r.handleQuotaSqueeze(ctx, exp)
+	require.NoError(t, err)
+	assert.Equal(t, 10*time.Minute, result.RequeueAfter)
+
+	squeezed := fetchQuota(t, r, quota)
+	assert.Equal(t, "4", squeezed.Spec.Hard.Pods().String())
+	assert.Equal(t, "chaos/checkout-quota", squeezed.Annotations[annotationQuotaSqueezedBy])
+	exp = fetchExperiment(t, r, exp.Name, exp.Namespace)
+	assert.Equal(t, []string{"shop/compute"}, exp.Status.SqueezedQuotas)
+	require.NotNil(t, exp.Status.QuotaSqueezeEndsAt)
+	assert.Equal(t, "Squeezed 1 ResourceQuota(s) in shop for 10m: compute (pods 10 -> 4, requests.cpu 4 -> 1500m)", exp.Status.Message)
+
+	require.NoError(t, r.Create(ctx, &corev1.Event{
+		ObjectMeta:     metav1.ObjectMeta{Name: "checkout-5d8f.failedcreate", Namespace: "shop"},
+		InvolvedObject: corev1.ObjectReference{Kind: "ReplicaSet", Name: "checkout-5d8f", Namespace: "shop"},
+		Type:           corev1.EventTypeWarning,
+		Reason:         "FailedCreate",
+		Message: `Error creating: pods "checkout-5d8f-x2x9q" is forbidden: exceeded quota: compute, ` +
+			`requested: pods=1, used: pods=4, limited: pods=4`,
+		LastTimestamp: metav1.Now(),
+	}))
+
+	ended := metav1.NewTime(time.Now().Add(-time.Second))
+	exp.Status.QuotaSqueezeEndsAt = &ended
+	_, err = r.handleQuotaSqueeze(ctx, exp)
+	require.NoError(t, err)
+
+	restored := fetchQuota(t, r, quota)
+	assert.Equal(t, "10", restored.Spec.Hard.Pods().String())
+	assert.Equal(t, "4", restored.Spec.Hard.Name(corev1.ResourceRequestsCPU, resource.DecimalSI).String())
+	assert.NotContains(t, restored.Annotations, annotationQuotaSqueezedBy)
+	assert.NotContains(t, restored.Annotations, annotationOriginalQuotaHard)
+
+	updated := fetchExperiment(t, r, exp.Name, exp.Namespace)
+	assert.Nil(t, updated.Status.QuotaSqueezeEndsAt)
+	assert.Empty(t, updated.Status.SqueezedQuotas)
+	assert.Equal(t, "Restored 1 ResourceQuota(s) after 10m: 1 workload(s) were refused by them", updated.Status.Message)
+
+	history := &chaosv1alpha1.ChaosExperimentHistoryList{}
+	require.NoError(t, r.List(ctx, history))
+	require.Len(t, history.Items, 1)
+	resources := history.Items[0].Spec.AffectedResources
+	require.Len(t, resources, 2)
+	assert.Equal(t, "pods 10 -> 4, requests.cpu 4 -> 1500m", resources[0].Details)
+	assert.Equal(t, chaosv1alpha1.ResourceReference{
+		Kind: "ReplicaSet", Name: "checkout-5d8f", Namespace: "shop", Action: "quota-rejected", Details: resources[1].Details,
+	}, resources[1])
+}
+
+func TestHandleQuotaSqueeze_DryRun(t *testing.T) {
+	ctx := context.Background()
+	exp, quota := quotaSqueezeFixtures()
+	exp.Spec.DryRun = true
+	r := newReconcilerWithObjects(t, exp, quota)
+
+	_, err := r.handleQuotaSqueeze(ctx, exp)
+	require.NoError(t, err)
+
+	assert.Equal(t, "10", fetchQuota(t, r, quota).Spec.Hard.Pods().String())
+	updated := fetchExperiment(t, r, exp.Name, exp.Namespace)
+	assert.Equal(t, phaseCompleted, updated.Status.Phase)
+	assert.Equal(t, "DRY RUN: Would squeeze 1 ResourceQuota(s) in shop for 10m: compute (pods 10 -> 4, requests.cpu 4 -> 1500m)",
+		updated.Status.Message)
+}
+
+func TestHandleQuotaSqueeze_SkipsQuotaOfAnotherExperiment(t *testing.T) {
+	ctx := context.Background()
+	exp, quota := quotaSqueezeFixtures()
+	quota.Annotations = map[string]string{annotationQuotaSqueezedBy: "chaos/other"}
+	r := newReconcilerWithObjects(t, exp, quota)
+
+	_, err := r.handleQuotaSqueeze(ctx, exp)
+	require.NoError(t, err)
+
+	assert.Equal(t, "10", fetchQuota(t, r, quota).Spec.Hard.Pods().String())
+	updated := fetchExperiment(t, r, exp.Name, exp.Namespace)
+	assert.Empty(t, updated.Status.SqueezedQuotas)
+	assert.Contains(t, updated.Status.Message, "already squeezed by chaos/other")
+}
+
+func TestRestoreSqueezedQuotas_OnRevert(t *testing.T) {
+	ctx := context.Background()
+	exp, quota := quotaSqueezeFixtures()
+	r := newReconcilerWithObjects(t, exp, quota)
+
+	_, err := r.handleQuotaSqueeze(ctx, exp)
+	require.NoError(t, err)
+	updated := fetchExperiment(t, r, exp.Name, exp.Namespace)
+
+	r.revertActiveInjections(ctx, updated)
+
+	assert.Equal(t, "10", fetchQuota(t, r, quota).Spec.Hard.Pods().String())
+	assert.Empty(t, updated.Status.SqueezedQuotas)
+	assert.Nil(t, updated.Status.QuotaSqueezeEndsAt)
+}
+
+func TestCleanupTaskReconcile_RestoresQuota(t *testing.T) {
+	now := time.Date(2025, 6, 2, 10, 0, 0, 0, time.UTC)
+	_, quota := quotaSqueezeFixtures()
+	quota.Annotations = map[string]string{
+		annotationQuotaSqueezedBy:   "chaos/checkout-quota",
+		annotationOriginalQuotaHard: `{"pods":"10","requests.cpu":"4"}`,
+	}
+	quota.Spec.Hard[corev1.ResourcePods] = resource.MustParse("4")
+	task := &chaosv1alpha1.ChaosCleanupTask{
+		ObjectMeta: metav1.ObjectMeta{Name: "checkout-quota-restorequota", Namespace: "chaos"},
+		Spec: chaosv1alpha1.ChaosCleanupTaskSpec{
+			Experiment: "checkout-quota", Operation: chaosv1alpha1.CleanupRestoreQuota, Quota: "shop/compute",
+		},
+	}
+	r := newCleanupTaskReconciler(t, now, quota, task)
+
+	_, got := reconcileCleanupTask(t, r, task)
+	assert.Equal(t, chaosv1alpha1.CleanupTaskSucceeded, got.Status.Phase)
+	assert.Equal(t, "10", fetchQuota(t, r.Experiments, quota).Spec.Hard.Pods().String())
+}
+
+func TestExceedsQuota(t *testing.T) {
+	message := `pods "web-1" is forbidden: exceeded quota: compute-large, requested: pods=1, used: pods=4, limited: pods=4`
+	assert.True(t, exceedsQuota(message, "compute-large"))
+	assert.False(t, exceedsQuota(message, "compute"))
+	assert.True(t, exceedsQuota("exceeded quota: compute", "compute"))
+}
@@ -0,0 +1,200 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	chaosv1alpha1 "github.com/neogan74/k8s-chaos/api/v1alpha1"
+	chaosduration "github.com/neogan74/k8s-chaos/internal/duration"
+	chaosmetrics "github.com/neogan74/k8s-chaos/internal/metrics"
+)
+
+const (
+	// conditionRateLimited is set on experiments whose next injection round a ChaosPolicy holds back
+	conditionRateLimited = "RateLimited"
+
+	// defaultRateLimitWindow applies when a rate limit sets no window
+	defaultRateLimitWindow = time.Hour
+	// maxRateLimitWindow bounds rate limit windows and how long injection rounds are remembered
+	maxRateLimitWindow = 24 * time.Hour
+)
+
+// injectionLog remembers when injection rounds started in each target namespace. It is kept in
+// memory, so the namespace windows start empty when the controller restarts.
+type injectionLog struct {
+	mu     sync.Mutex
+	starts map[string][]time.Time
+}
+
+func newInjectionLog() *injectionLog {
+	return &injectionLog{starts: map[string][]time.Time{}}
+}
+
+// record adds a round started at at and forgets rounds no window can reach anymore
+func (l *injectionLog) record(namespace string, at time.Time) {
+	if l == nil {
+		return
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	cutoff := at.Add(-maxRateLimitWindow)
+	kept := l.starts[namespace][:0]
+	for _, start := range l.starts[namespace] {
+		if start.After(cutoff) {
+			kept = append(kept, start)
+		}
+	}
+	l.starts[namespace] = append(kept, at)
+}
+
+// since returns the rounds started in namespace after from, oldest first
+func (l *injectionLog) since(namespace string, from time.Time) []time.Time {
+	if l == nil {
+		return nil
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	var starts []time.Time
+	for _, start := range l.starts[namespace] {
+		if start.After(from) {
+			starts = append(starts, start)
+		}
+	}
+	return starts
+}
+
+// recordInjectionRound counts the round an executor just ran, which it reports by moving
+// status.lastRunTime past previous
+func (r *ChaosExperimentReconciler) recordInjectionRound(exp *chaosv1alpha1.ChaosExperiment, previous *metav1.Time) {
+	lastRun := exp.Status.LastRunTime
+	if exp.Spec.DryRun || lastRun == nil || (previous != nil && !lastRun.After(previous.Time)) {
+		return
+	}
+	r.injections.record(exp.Spec.Namespace, lastRun.Time)
+	chaosmetrics.InjectionRounds.WithLabelValues(exp.Spec.Action, exp.Spec.Namespace, chaosv1alpha1.SeverityOf(&exp.Spec)).Inc()
+}
+
+// rateLimitDelay returns how long the experiment's next injection round has to wait under the
+// ChaosPolicies that apply to its target namespace, and which limit holds it back
+func (r *ChaosExperimentReconciler) rateLimitDelay(ctx context.Context, exp *chaosv1alpha1.ChaosExperiment, now time.Time) (time.Duration, string, error) {
+	policies := &chaosv1alpha1.ChaosPolicyList{}
+	if err := r.List(ctx, policies); err != nil {
+		return 0, "", fmt.Errorf("failed to list chaos policies: %w", err)
+	}
+
+	var wait time.Duration
+	var reason string
+	for i := range policies.Items {
+		policy := &policies.Items[i]
+		if policy.Spec.RateLimit == nil || policy.DeletionTimestamp != nil || !policy.AppliesTo(exp.Spec.Namespace) {
+			continue
+		}
+		if d, why := r.policyDelay(policy, exp, now); d > wait {
+			wait, reason = d, why
+		}
+	}
+	return wait, reason, nil
+}
+
+// policyDelay applies one policy's rate limit to the experiment
+func (r *ChaosExperimentReconciler) policyDelay(policy *chaosv1alpha1.ChaosPolicy, exp *chaosv1alpha1.ChaosExperiment, now time.Time) (time.Duration, string) {
+	limit := policy.Spec.RateLimit
+	var wait time.Duration
+	var reason string
+
+	if limit.MinInterval != "" && exp.Status.LastRunTime != nil {
+		if interval, err := chaosduration.Parse(limit.MinInterval); err == nil {
+			if d := exp.Status.LastRunTime.Add(interval).Sub(now); d > 0 {
+				wait = d
+				reason = fmt.Sprintf("ChaosPolicy %q allows one injection round of the experiment every %s",
+					policy.Name, limit.MinInterval)
+			}
+		}
+	}
+
+	if limit.MaxInjections > 0 {
+		window := defaultRateLimitWindow
+		if limit.Window != "" {
+			if parsed, err := chaosduration.Parse(limit.Window); err == nil && parsed > 0 {
+				window = min(parsed, maxRateLimitWindow)
+			}
+		}
+		starts := r.injections.since(exp.Spec.Namespace, now.Add(-window))
+		if len(starts) >= limit.MaxInjections {
+			// The next round may start once enough of the oldest rounds left the window
+			if d := starts[len(starts)-limit.MaxInjections].Add(window).Sub(now); d > wait {
+				wait = d
+				reason = fmt.Sprintf("ChaosPolicy %q allows %d injection rounds per %s in namespace %s",
+					policy.Name, limit.MaxInjections, window, exp.Spec.Namespace)
+			}
+		}
+	}
+	return wait, reason
+}
+
+// handleRateLimited holds the experiment, keeping its phase, until the rate limit allows its
+// next injection round
+func (r *ChaosExperimentReconciler) handleRateLimited(ctx context.Context, exp *chaosv1alpha1.ChaosExperiment, wait time.Duration, reason string) (ctrl.Result, error) {
+	log := ctrl.LoggerFrom(ctx)
+	wait = wait.Round(time.Second) + time.Second
+	message := fmt.Sprintf("Rate limited: %s; next injection round in %s", reason, wait)
+
+	// Only record once per hold; later reconciles just keep waiting
+	if !meta.IsStatusConditionTrue(exp.Status.Conditions, conditionRateLimited) {
+		log.Info("Injection round held back by rate limit", "reason", reason, "wait", wait)
+		chaosmetrics.SafetyRateLimitBlocks.WithLabelValues(exp.Spec.Action, exp.Spec.Namespace).Inc()
+		r.Recorder.Event(exp, corev1.EventTypeWarning, "ChaosRateLimited", message)
+	}
+
+	meta.SetStatusCondition(&exp.Status.Conditions, metav1.Condition{
+		Type:               conditionRateLimited,
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: exp.Generation,
+		Reason:             "ChaosPolicyRateLimit",
+		Message:            reason,
+	})
+	exp.Status.Message = message
+	if err := r.Status().Update(ctx, exp); err != nil {
+		log.Error(err, "Failed to update status for rate limited experiment")
+		return ctrl.Result{}, err
+	}
+	return ctrl.Result{RequeueAfter: wait}, nil
+}
+
+// clearRateLimitedCondition removes the RateLimited condition once the experiment may run
+func (r *ChaosExperimentReconciler) clearRateLimitedCondition(ctx context.Context, exp *chaosv1alpha1.ChaosExperiment) {
+	if meta.FindStatusCondition(exp.Status.Conditions, conditionRateLimited) == nil {
+		return
+	}
+
+	meta.RemoveStatusCondition(&exp.Status.Conditions, conditionRateLimited)
+	if err := r.Status().Update(ctx, exp); err != nil {
+		log := ctrl.LoggerFrom(ctx)
+		log.Error(err, "Failed to clear RateLimited condition")
+	}
+}
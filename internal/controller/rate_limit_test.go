@@ -0,0 +1,164 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	chaosv1alpha1 "github.com/neogan74/k8s-chaos/api/v1alpha1"
+)
+
+func rateLimitTestExperiment(name string) *chaosv1alpha1.ChaosExperiment {
+	return &chaosv1alpha1.ChaosExperiment{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "shop"},
+		Spec: chaosv1alpha1.ChaosExperimentSpec{
+			Action:    "pod-kill",
+			Namespace: "shop",
+			Selector:  map[string]string{"app": "web"},
+		},
+		Status: chaosv1alpha1.ChaosExperimentStatus{Phase: phaseRunning},
+	}
+}
+
+func TestReconcile_RateLimitPerNamespace(t *testing.T) {
+	ctx := context.Background()
+	first := rateLimitTestExperiment("kill-a")
+	second := rateLimitTestExperiment("kill-b")
+	policy := &chaosv1alpha1.ChaosPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "gentle"},
+		Spec: chaosv1alpha1.ChaosPolicySpec{
+			Namespaces: []string{"shop"},
+			RateLimit:  &chaosv1alpha1.ChaosRateLimit{MaxInjections: 1, Window: "1h"},
+		},
+	}
+	objs := []client.Object{first, second, policy, &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "shop"}}}
+	for _, name := range []string{"web-1", "web-2", "web-3"} {
+		objs = append(objs, &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "shop", Labels: map[string]string{"app": "web"}}})
+	}
+	r := newReconcilerWithObjects(t, objs...)
+	r.injections = newInjectionLog()
+
+	_, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: client.ObjectKeyFromObject(first)})
+	require.NoError(t, err)
+	pods := &corev1.PodList{}
+	require.NoError(t, r.List(ctx, pods, client.InNamespace("shop")))
+	require.Len(t, pods.Items, 2)
+
+	// The namespace used up its injection for the hour, whichever experiment asks
+	result, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: client.ObjectKeyFromObject(second)})
+	require.NoError(t, err)
+	assert.Greater(t, result.RequeueAfter, 59*time.Minute)
+	require.NoError(t, r.List(ctx, pods, client.InNamespace("shop")))
+	assert.Len(t, pods.Items, 2)
+
+	updated := fetchExperiment(t, r, second.Name, second.Namespace)
+	assert.Equal(t, phaseRunning, updated.Status.Phase)
+	assert.Contains(t, updated.Status.Message, `ChaosPolicy "gentle" allows 1 injection rounds per 1h0m0s in namespace shop`)
+	assert.True(t, meta.IsStatusConditionTrue(updated.Status.Conditions, conditionRateLimited))
+
+	// Removing the policy lets the experiment run and clears the condition
+	require.NoError(t, r.Delete(ctx, policy))
+	_, err = r.Reconcile(ctx, ctrl.Request{NamespacedName: client.ObjectKeyFromObject(second)})
+	require.NoError(t, err)
+	updated = fetchExperiment(t, r, second.Name, second.Namespace)
+	assert.Nil(t, meta.FindStatusCondition(updated.Status.Conditions, conditionRateLimited))
+	require.NoError(t, r.List(ctx, pods, client.InNamespace("shop")))
+	assert.Len(t, pods.Items, 1)
+}
+
+func TestRateLimitDelay(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	exp := rateLimitTestExperiment("kill")
+	exp.Status.LastRunTime = &metav1.Time{Time: now.Add(-4 * time.Minute)}
+	policies := []client.Object{
+		&chaosv1alpha1.ChaosPolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: "interval"},
+			Spec: chaosv1alpha1.ChaosPolicySpec{
+				RateLimit: &chaosv1alpha1.ChaosRateLimit{MinInterval: "10m"},
+			},
+		},
+		&chaosv1alpha1.ChaosPolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: "burst"},
+			Spec: chaosv1alpha1.ChaosPolicySpec{
+				RateLimit: &chaosv1alpha1.ChaosRateLimit{MaxInjections: 2, Window: "30m"},
+			},
+		},
+		&chaosv1alpha1.ChaosPolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: "elsewhere"},
+			Spec: chaosv1alpha1.ChaosPolicySpec{
+				Namespaces: []string{"payments"},
+				RateLimit:  &chaosv1alpha1.ChaosRateLimit{MinInterval: "24h"},
+			},
+		},
+	}
+	r := newReconcilerWithObjects(t, policies...)
+	r.injections = newInjectionLog()
+
+	wait, reason, err := r.rateLimitDelay(ctx, exp, now)
+	require.NoError(t, err)
+	assert.Equal(t, 6*time.Minute, wait)
+	assert.Contains(t, reason, `ChaosPolicy "interval"`)
+
+	// Two rounds in the window: the next one waits for the older to leave it
+	r.injections.record("shop", now.Add(-20*time.Minute))
+	r.injections.record("shop", now.Add(-time.Minute))
+	r.injections.record("payments", now)
+	wait, reason, err = r.rateLimitDelay(ctx, exp, now)
+	require.NoError(t, err)
+	assert.Equal(t, 10*time.Minute, wait)
+	assert.Contains(t, reason, `ChaosPolicy "burst"`)
+
+	exp.Spec.Namespace = "staging"
+	exp.Status.LastRunTime = nil
+	wait, _, err = r.rateLimitDelay(ctx, exp, now)
+	require.NoError(t, err)
+	assert.Zero(t, wait)
+}
+
+func TestRecordInjectionRound(t *testing.T) {
+	r := &ChaosExperimentReconciler{injections: newInjectionLog()}
+	exp := rateLimitTestExperiment("kill")
+	previous := metav1.NewTime(time.Now().Add(-time.Hour))
+
+	// No new run: the executor only waited
+	exp.Status.LastRunTime = &previous
+	r.recordInjectionRound(exp, &previous)
+	assert.Empty(t, r.injections.since("shop", time.Time{}))
+
+	now := metav1.Now()
+	exp.Status.LastRunTime = &now
+	r.recordInjectionRound(exp, &previous)
+	assert.Len(t, r.injections.since("shop", time.Time{}), 1)
+
+	// Dry runs inject nothing
+	exp.Spec.DryRun = true
+	later := metav1.NewTime(now.Add(time.Minute))
+	exp.Status.LastRunTime = &later
+	r.recordInjectionRound(exp, &now)
+	assert.Len(t, r.injections.since("shop", time.Time{}), 1)
+}
@@ -0,0 +1,352 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	chaosv1alpha1 "github.com/neogan74/k8s-chaos/api/v1alpha1"
+	chaosmetrics "github.com/neogan74/k8s-chaos/internal/metrics"
+)
+
+const (
+	// recoveryPollInterval is how often pending targets are checked for recovery
+	recoveryPollInterval = 2 * time.Second
+	// recoveryTimeout gives up on a target that has not recovered
+	recoveryTimeout = 30 * time.Minute
+)
+
+// recoveryKind is what counts as recovered for a target
+type recoveryKind int
+
+const (
+	// recoveryReplacementReady: a new pod of the same controller owner is Ready (pod-kill)
+	recoveryReplacementReady recoveryKind = iota
+	// recoveryContainerReady: the container restarted and is Ready again (pod-failure, pod-restart)
+	recoveryContainerReady
+	// recoveryEphemeralExited: the ephemeral container that removes the qdisc has exited
+	// (pod-network-loss, pod-network-corruption)
+	recoveryEphemeralExited
+)
+
+// recoveryExperiment is the experiment whose status.recovery counts a target, zero when the
+// experiment has no successCriteria to judge recovery with
+type recoveryExperiment struct {
+	key types.NamespacedName
+	uid types.UID
+}
+
+// recoveryTarget is an injected pod waiting to recover
+type recoveryTarget struct {
+	experiment recoveryExperiment
+	kind       recoveryKind
+	action     string
+	namespace  string // spec.namespace of the experiment, the metric label
+	pod        types.NamespacedName
+	podUID     types.UID
+	ownerUID   types.UID
+	container  string
+	restarts   int32
+	injectedAt time.Time
+}
+
+// recoveryTracker observes chaosexperiment_recovery_seconds for injected pods. Targets are kept in
+// memory; those pending when the controller restarts are not measured. How the targets of an
+// experiment with successCriteria recovered is added up in its status.recovery.
+type recoveryTracker struct {
+	client client.Client
+
+	mu      sync.Mutex
+	pending []recoveryTarget
+	// claimed holds replacement pods already credited to a killed pod
+	claimed map[types.UID]bool
+}
+
+// recoveryOutcome is how the targets of one experiment measured in a check recovered
+type recoveryOutcome struct {
+	recovered, timedOut, gone int32
+	slowest                   time.Duration
+}
+
+func newRecoveryTracker(c client.Client) *recoveryTracker {
+	return &recoveryTracker{client: c, claimed: map[types.UID]bool{}}
+}
+
+func (t *recoveryTracker) track(target recoveryTarget) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.pending = append(t.pending, target)
+}
+
+// run checks pending targets until ctx is cancelled
+func (t *recoveryTracker) run(ctx context.Context) error {
+	ticker := time.NewTicker(recoveryPollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			t.check(ctx, time.Now())
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+// check observes the targets that recovered and drops those that timed out or disappeared
+func (t *recoveryTracker) check(ctx context.Context, now time.Time) {
+	t.persist(ctx, t.sweep(ctx, now))
+}
+
+// sweep measures the pending targets and returns how those of experiments with successCriteria
+// ended up
+func (t *recoveryTracker) sweep(ctx context.Context, now time.Time) map[recoveryExperiment]*recoveryOutcome {
+	log := ctrl.Log.WithName("recovery")
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	outcomes := map[recoveryExperiment]*recoveryOutcome{}
+	outcomeOf := func(target recoveryTarget) *recoveryOutcome {
+		if target.experiment.uid == "" {
+			return &recoveryOutcome{}
+		}
+		if outcomes[target.experiment] == nil {
+			outcomes[target.experiment] = &recoveryOutcome{}
+		}
+		return outcomes[target.experiment]
+	}
+
+	remaining := t.pending[:0]
+	for _, target := range t.pending {
+		recoveredAt, done, err := t.recovered(ctx, target)
+		switch {
+		case err != nil:
+			log.Error(err, "Failed to check target recovery", "pod", target.pod)
+			remaining = append(remaining, target)
+		case !recoveredAt.IsZero():
+			latency := recoveredAt.Sub(target.injectedAt)
+			if latency < 0 {
+				latency = 0
+			}
+			chaosmetrics.RecoveryLatency.WithLabelValues(target.action, target.namespace).Observe(latency.Seconds())
+			o := outcomeOf(target)
+			o.recovered++
+			o.slowest = max(o.slowest, latency)
+		case done:
+			// The target went away without recovering in a measurable way
+			outcomeOf(target).gone++
+		case now.Sub(target.injectedAt) > recoveryTimeout:
+			chaosmetrics.RecoveryTimeouts.WithLabelValues(target.action, target.namespace).Inc()
+			outcomeOf(target).timedOut++
+		default:
+			remaining = append(remaining, target)
+		}
+	}
+	t.pending = remaining
+	if len(t.pending) == 0 {
+		t.claimed = map[types.UID]bool{}
+	}
+	return outcomes
+}
+
+// persist adds outcomes to status.recovery of their experiments, so that successCriteria are
+// judged with them on whichever replica leads when the experiment completes
+func (t *recoveryTracker) persist(ctx context.Context, outcomes map[recoveryExperiment]*recoveryOutcome) {
+	log := ctrl.Log.WithName("recovery")
+	for experiment, outcome := range outcomes {
+		err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+			exp := &chaosv1alpha1.ChaosExperiment{}
+			if err := t.client.Get(ctx, experiment.key, exp); err != nil {
+				return err
+			}
+			if exp.UID != experiment.uid {
+				return nil
+			}
+			status := exp.Status.Recovery
+			if status == nil {
+				status = &chaosv1alpha1.RecoveryStatus{}
+				exp.Status.Recovery = status
+			}
+			status.Recovered += outcome.recovered
+			status.TimedOut += outcome.timedOut
+			status.Gone += outcome.gone
+			if slowest, _ := time.ParseDuration(status.Slowest); outcome.slowest > slowest {
+				status.Slowest = outcome.slowest.String()
+			}
+			return t.client.Status().Update(ctx, exp)
+		})
+		if client.IgnoreNotFound(err) != nil {
+			log.Error(err, "Failed to record recovery in experiment status", "experiment", experiment.key)
+		}
+	}
+}
+
+// recovered returns when target recovered, or done when it can no longer be measured
+func (t *recoveryTracker) recovered(ctx context.Context, target recoveryTarget) (time.Time, bool, error) {
+	if target.kind == recoveryReplacementReady {
+		return t.replacementReady(ctx, target)
+	}
+
+	pod := &corev1.Pod{}
+	if err := t.client.Get(ctx, target.pod, pod); err != nil {
+		if apierrors.IsNotFound(err) {
+			return time.Time{}, true, nil
+		}
+		return time.Time{}, false, err
+	}
+	if pod.UID != target.podUID {
+		return time.Time{}, true, nil
+	}
+
+	if target.kind == recoveryEphemeralExited {
+		for _, status := range pod.Status.EphemeralContainerStatuses {
+			if status.Name == target.container && status.State.Terminated != nil {
+				return status.State.Terminated.FinishedAt.Time, true, nil
+			}
+		}
+		return time.Time{}, false, nil
+	}
+
+	for _, status := range pod.Status.ContainerStatuses {
+		if status.Name != target.container || status.RestartCount <= target.restarts ||
+			!status.Ready || status.State.Running == nil {
+			continue
+		}
+		// Readiness has no per-container timestamp; the pod condition is the closest signal
+		at := status.State.Running.StartedAt.Time
+		if readyAt := podReadyTime(pod); readyAt.After(at) {
+			at = readyAt
+		}
+		return at, true, nil
+	}
+	return time.Time{}, false, nil
+}
+
+// replacementReady looks for a Ready pod of the same owner created after the injection
+func (t *recoveryTracker) replacementReady(ctx context.Context, target recoveryTarget) (time.Time, bool, error) {
+	pods := &corev1.PodList{}
+	if err := t.client.List(ctx, pods, client.InNamespace(target.pod.Namespace)); err != nil {
+		return time.Time{}, false, err
+	}
+	// Creation timestamps have second precision
+	createdAfter := target.injectedAt.Truncate(time.Second)
+
+	var best *corev1.Pod
+	var bestReady time.Time
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		owner := metav1.GetControllerOf(pod)
+		if owner == nil || owner.UID != target.ownerUID || pod.UID == target.podUID || t.claimed[pod.UID] ||
+			pod.DeletionTimestamp != nil || pod.CreationTimestamp.Time.Before(createdAfter) {
+			continue
+		}
+		readyAt := podReadyTime(pod)
+		if readyAt.IsZero() {
+			continue
+		}
+		if best == nil || readyAt.Before(bestReady) {
+			best, bestReady = pod, readyAt
+		}
+	}
+	if best == nil {
+		return time.Time{}, false, nil
+	}
+	t.claimed[best.UID] = true
+	return bestReady, true, nil
+}
+
+// podReadyTime returns when the pod became Ready, or zero when it is not Ready
+func podReadyTime(pod *corev1.Pod) time.Time {
+	for _, cond := range pod.Status.Conditions {
+		if cond.Type == corev1.PodReady && cond.Status == corev1.ConditionTrue {
+			return cond.LastTransitionTime.Time
+		}
+	}
+	return time.Time{}
+}
+
+// trackPodReplacement measures until a replacement of a killed pod is Ready; pods without a
+// controller are not replaced and are not tracked. exp is the injecting experiment, whose
+// status.recovery counts the target when it has successCriteria; it may be nil.
+func (r *ChaosExperimentReconciler) trackPodReplacement(exp *chaosv1alpha1.ChaosExperiment, action, namespace string, pod *corev1.Pod, injectedAt time.Time) {
+	owner := metav1.GetControllerOf(pod)
+	if r.recovery == nil || owner == nil {
+		return
+	}
+	r.recovery.track(recoveryTarget{
+		experiment: countRecovery(exp),
+		kind:       recoveryReplacementReady,
+		action:     action,
+		namespace:  namespace,
+		pod:        client.ObjectKeyFromObject(pod),
+		podUID:     pod.UID,
+		ownerUID:   owner.UID,
+		injectedAt: injectedAt,
+	})
+}
+
+// trackContainerRestart measures until the container restarted past restarts and is Ready
+func (r *ChaosExperimentReconciler) trackContainerRestart(exp *chaosv1alpha1.ChaosExperiment, action, namespace string, pod *corev1.Pod, container string, restarts int32, injectedAt time.Time) {
+	if r.recovery == nil {
+		return
+	}
+	r.recovery.track(recoveryTarget{
+		experiment: countRecovery(exp),
+		kind:       recoveryContainerReady,
+		action:     action,
+		namespace:  namespace,
+		pod:        client.ObjectKeyFromObject(pod),
+		podUID:     pod.UID,
+		container:  container,
+		restarts:   restarts,
+		injectedAt: injectedAt,
+	})
+}
+
+// trackEphemeralExit measures until the injecting ephemeral container exits
+func (r *ChaosExperimentReconciler) trackEphemeralExit(exp *chaosv1alpha1.ChaosExperiment, action, namespace string, pod *corev1.Pod, container string, injectedAt time.Time) {
+	if r.recovery == nil {
+		return
+	}
+	r.recovery.track(recoveryTarget{
+		experiment: countRecovery(exp),
+		kind:       recoveryEphemeralExited,
+		action:     action,
+		namespace:  namespace,
+		pod:        client.ObjectKeyFromObject(pod),
+		podUID:     pod.UID,
+		container:  container,
+		injectedAt: injectedAt,
+	})
+}
+
+// observeNodeRecovery records the recovery of a node the controller reverted itself
+func observeNodeRecovery(action, namespace string, injectedAt *metav1.Time) {
+	if injectedAt == nil {
+		return
+	}
+	chaosmetrics.RecoveryLatency.WithLabelValues(action, namespace).Observe(time.Since(injectedAt.Time).Seconds())
+}
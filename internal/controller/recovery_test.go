@@ -0,0 +1,163 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+
+	chaosmetrics "github.com/neogan74/k8s-chaos/internal/metrics"
+)
+
+// recoveryObservations returns the sample count and sum of chaosexperiment_recovery_seconds
+func recoveryObservations(t *testing.T, action, namespace string) (uint64, float64) {
+	t.Helper()
+	m := &dto.Metric{}
+	require.NoError(t, chaosmetrics.RecoveryLatency.WithLabelValues(action, namespace).(prometheus.Metric).Write(m))
+	return m.GetHistogram().GetSampleCount(), m.GetHistogram().GetSampleSum()
+}
+
+func recoveryTestPod(name string, uid types.UID, owner types.UID, created time.Time, readyAt *time.Time) *corev1.Pod {
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              name,
+			Namespace:         "recovery",
+			UID:               uid,
+			CreationTimestamp: metav1.NewTime(created),
+			OwnerReferences: []metav1.OwnerReference{{
+				APIVersion: "apps/v1", Kind: "ReplicaSet", Name: "web-abc", UID: owner, Controller: ptr.To(true),
+			}},
+		},
+		Spec: corev1.PodSpec{Containers: []corev1.Container{{Name: "app", Image: "nginx"}}},
+	}
+	if readyAt != nil {
+		pod.Status.Conditions = []corev1.PodCondition{{
+			Type: corev1.PodReady, Status: corev1.ConditionTrue, LastTransitionTime: metav1.NewTime(*readyAt),
+		}}
+	}
+	return pod
+}
+
+func TestRecoveryTracker_ReplacementReady(t *testing.T) {
+	injectedAt := time.Now().Add(-time.Minute).Truncate(time.Second)
+	readyAt := injectedAt.Add(12 * time.Second)
+	killed := recoveryTestPod("web-1", "uid-killed", "rs-uid", injectedAt.Add(-time.Hour), nil)
+	replacement := recoveryTestPod("web-2", "uid-new", "rs-uid", injectedAt.Add(time.Second), &readyAt)
+	// Pods of another owner or older than the injection are not replacements
+	other := recoveryTestPod("api-1", "uid-other", "other-rs", injectedAt.Add(time.Second), &injectedAt)
+	sibling := recoveryTestPod("web-3", "uid-sibling", "rs-uid", injectedAt.Add(-time.Hour), &injectedAt)
+
+	r := newReconcilerWithObjects(t, replacement, other, sibling)
+	r.recovery = newRecoveryTracker(r.Client)
+	r.trackPodReplacement(nil, "pod-kill", "recovery-replacement", killed, injectedAt)
+	// Two kills, one replacement: the second kill stays pending
+	r.trackPodReplacement(nil, "pod-kill", "recovery-replacement", recoveryTestPod("web-4", "uid-killed-2", "rs-uid", injectedAt.Add(-time.Hour), nil), injectedAt)
+
+	r.recovery.check(context.Background(), time.Now())
+
+	count, sum := recoveryObservations(t, "pod-kill", "recovery-replacement")
+	assert.Equal(t, uint64(1), count)
+	assert.InDelta(t, 12, sum, 0.001)
+	assert.Len(t, r.recovery.pending, 1)
+}
+
+func TestRecoveryTracker_BarePodNotTracked(t *testing.T) {
+	r := newReconcilerWithObjects(t)
+	r.recovery = newRecoveryTracker(r.Client)
+	pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "bare", Namespace: "recovery"}}
+
+	r.trackPodReplacement(nil, "pod-kill", "recovery", pod, time.Now())
+
+	assert.Empty(t, r.recovery.pending)
+}
+
+func TestRecoveryTracker_ContainerReady(t *testing.T) {
+	injectedAt := time.Now().Add(-time.Minute).Truncate(time.Second)
+	pod := recoveryTestPod("web-1", "uid-1", "rs-uid", injectedAt.Add(-time.Hour), nil)
+	pod.Status.ContainerStatuses = []corev1.ContainerStatus{{Name: "app", RestartCount: 3}}
+	r := newReconcilerWithObjects(t, pod)
+	r.recovery = newRecoveryTracker(r.Client)
+	r.trackContainerRestart(nil, "pod-failure", "recovery-container", pod, "app", 3, injectedAt)
+
+	// Not restarted yet
+	r.recovery.check(context.Background(), time.Now())
+	require.Len(t, r.recovery.pending, 1)
+
+	readyAt := injectedAt.Add(8 * time.Second)
+	pod.Status.ContainerStatuses = []corev1.ContainerStatus{{
+		Name: "app", RestartCount: 4, Ready: true,
+		State: corev1.ContainerState{Running: &corev1.ContainerStateRunning{StartedAt: metav1.NewTime(injectedAt.Add(3 * time.Second))}},
+	}}
+	pod.Status.Conditions = []corev1.PodCondition{{Type: corev1.PodReady, Status: corev1.ConditionTrue, LastTransitionTime: metav1.NewTime(readyAt)}}
+	require.NoError(t, r.Status().Update(context.Background(), pod))
+
+	r.recovery.check(context.Background(), time.Now())
+
+	count, sum := recoveryObservations(t, "pod-failure", "recovery-container")
+	assert.Equal(t, uint64(1), count)
+	assert.InDelta(t, 8, sum, 0.001)
+	assert.Empty(t, r.recovery.pending)
+}
+
+func TestRecoveryTracker_EphemeralExitedAndTimeout(t *testing.T) {
+	injectedAt := time.Now().Add(-time.Minute).Truncate(time.Second)
+	pod := recoveryTestPod("web-1", "uid-1", "rs-uid", injectedAt.Add(-time.Hour), nil)
+	pod.Status.EphemeralContainerStatuses = []corev1.ContainerStatus{{
+		Name: "chaos-netloss-1",
+		State: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{
+			FinishedAt: metav1.NewTime(injectedAt.Add(31 * time.Second)),
+		}},
+	}}
+	r := newReconcilerWithObjects(t, pod)
+	r.recovery = newRecoveryTracker(r.Client)
+	r.trackEphemeralExit(nil, "pod-network-loss", "recovery-ephemeral", pod, "chaos-netloss-1", injectedAt)
+	r.trackEphemeralExit(nil, "pod-network-loss", "recovery-ephemeral", pod, "chaos-netloss-2", injectedAt)
+
+	// The second container never exits and times out
+	r.recovery.check(context.Background(), injectedAt.Add(recoveryTimeout+time.Second))
+
+	count, sum := recoveryObservations(t, "pod-network-loss", "recovery-ephemeral")
+	assert.Equal(t, uint64(1), count)
+	assert.InDelta(t, 31, sum, 0.001)
+	m := &dto.Metric{}
+	require.NoError(t, chaosmetrics.RecoveryTimeouts.WithLabelValues("pod-network-loss", "recovery-ephemeral").Write(m))
+	assert.Equal(t, float64(1), m.GetCounter().GetValue())
+	assert.Empty(t, r.recovery.pending)
+}
+
+func TestRecoveryTracker_DeletedPodDropped(t *testing.T) {
+	pod := recoveryTestPod("web-1", "uid-1", "rs-uid", time.Now(), nil)
+	r := newReconcilerWithObjects(t)
+	r.recovery = newRecoveryTracker(r.Client)
+	r.trackContainerRestart(nil, "pod-restart", "recovery-deleted", pod, "app", 0, time.Now())
+
+	r.recovery.check(context.Background(), time.Now())
+
+	count, _ := recoveryObservations(t, "pod-restart", "recovery-deleted")
+	assert.Equal(t, uint64(0), count)
+	assert.Empty(t, r.recovery.pending)
+}
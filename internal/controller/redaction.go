@@ -0,0 +1,60 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	corev1 "k8s.io/api/core/v1"
+
+	chaosv1alpha1 "github.com/neogan74/k8s-chaos/api/v1alpha1"
+	"github.com/neogan74/k8s-chaos/internal/redact"
+)
+
+// podRedactor returns the filter for output read from pod: the configured patterns plus the
+// literal env values of its containers, which a failing command may echo back
+func (r *ChaosExperimentReconciler) podRedactor(pod *corev1.Pod) *redact.Filter {
+	var values []string
+	for _, containers := range [][]corev1.Container{pod.Spec.InitContainers, pod.Spec.Containers} {
+		for _, c := range containers {
+			for _, env := range c.Env {
+				if env.Value != "" {
+					values = append(values, env.Value)
+				}
+			}
+		}
+	}
+	return r.Redactor.WithValues(values...)
+}
+
+// redactHistory scrubs the free text of a history record before it is signed and stored. Log
+// tails are scrubbed per pod when they are captured.
+func (r *ChaosExperimentReconciler) redactHistory(history *chaosv1alpha1.ChaosExperimentHistory) {
+	spec := &history.Spec
+	spec.Execution.Message = r.Redactor.Scrub(spec.Execution.Message)
+	if spec.Error != nil {
+		message, scrubbed := r.Redactor.String(spec.Error.Message)
+		lastError, scrubbedLast := r.Redactor.String(spec.Error.LastError)
+		spec.Error.Message, spec.Error.LastError = message, lastError
+		spec.Error.Redacted = spec.Error.Redacted || scrubbed || scrubbedLast
+	}
+	if spec.Snapshot != nil {
+		for i := range spec.Snapshot.Events {
+			message, scrubbed := r.Redactor.String(spec.Snapshot.Events[i].Message)
+			spec.Snapshot.Events[i].Message = message
+			spec.Snapshot.Redacted = spec.Snapshot.Redacted || scrubbed
+		}
+	}
+}
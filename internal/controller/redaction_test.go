@@ -0,0 +1,79 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	chaosv1alpha1 "github.com/neogan74/k8s-chaos/api/v1alpha1"
+)
+
+func TestPodRedactor_EnvValues(t *testing.T) {
+	r := newReconcilerWithObjects(t)
+	pod := &corev1.Pod{Spec: corev1.PodSpec{Containers: []corev1.Container{{
+		Name: "app",
+		Env:  []corev1.EnvVar{{Name: "UPSTREAM", Value: "tenant-8f3a91"}, {Name: "DEBUG", Value: "1"}},
+	}}}}
+
+	assert.Equal(t, "connect [REDACTED] failed: exit 1",
+		r.podRedactor(pod).Scrub("connect tenant-8f3a91 failed: exit 1"))
+}
+
+func TestHandleExperimentFailure_RedactsStatus(t *testing.T) {
+	exp := &chaosv1alpha1.ChaosExperiment{
+		ObjectMeta: metav1.ObjectMeta{Name: "fill", Namespace: "default"},
+		Spec:       chaosv1alpha1.ChaosExperimentSpec{Action: "pod-disk-fill", Namespace: "default"},
+	}
+	r := newReconcilerWithObjects(t, exp)
+
+	_, _ = r.handleExperimentFailure(context.Background(), exp, &ChaosError{
+		Original: fmt.Errorf("failed to stop disk filler: exit 1 (stderr: AWS_SECRET_ACCESS_KEY=wJalrXUtnFEMI not set)"),
+		Type:     ErrorTypeExecution,
+	})
+
+	require.NotContains(t, exp.Status.LastError, "wJalrXUtnFEMI")
+	require.NotContains(t, exp.Status.Message, "wJalrXUtnFEMI")
+	assert.Contains(t, exp.Status.LastError, "AWS_SECRET_ACCESS_KEY=[REDACTED]")
+}
+
+func TestRedactHistory(t *testing.T) {
+	r := newReconcilerWithObjects(t)
+	history := &chaosv1alpha1.ChaosExperimentHistory{Spec: chaosv1alpha1.ChaosExperimentHistorySpec{
+		Execution: chaosv1alpha1.ExecutionDetails{Message: "Failed: token=abc123"},
+		Error:     &chaosv1alpha1.ErrorDetails{Message: "Failed: token=abc123", FailureReason: "ExecutionError"},
+		Snapshot: &chaosv1alpha1.ResourceSnapshot{Events: []chaosv1alpha1.CapturedEvent{
+			{Object: "Pod/web", Message: "Back-off pulling image"},
+			{Object: "Pod/web", Message: "probe failed: Authorization: Bearer abcdefghijkl"},
+		}},
+	}}
+
+	r.redactHistory(history)
+
+	assert.Equal(t, "Failed: token=[REDACTED]", history.Spec.Execution.Message)
+	assert.Equal(t, "Failed: token=[REDACTED]", history.Spec.Error.Message)
+	assert.True(t, history.Spec.Error.Redacted)
+	assert.Equal(t, "Back-off pulling image", history.Spec.Snapshot.Events[0].Message)
+	assert.Equal(t, "probe failed: Authorization: Bearer [REDACTED]", history.Spec.Snapshot.Events[1].Message)
+	assert.True(t, history.Spec.Snapshot.Redacted)
+}
@@ -0,0 +1,82 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	chaosv1alpha1 "github.com/neogan74/k8s-chaos/api/v1alpha1"
+	"github.com/neogan74/k8s-chaos/internal/historydiff"
+	chaosmetrics "github.com/neogan74/k8s-chaos/internal/metrics"
+)
+
+// detectRegressions compares a new history record with the previous run of the same experiment.
+// Regressions are stored on the record and reported through an event and a metric.
+func (r *ChaosExperimentReconciler) detectRegressions(ctx context.Context, exp *chaosv1alpha1.ChaosExperiment, history *chaosv1alpha1.ChaosExperimentHistory) {
+	log := ctrl.LoggerFrom(ctx)
+
+	previous, err := r.previousHistoryRecord(ctx, history.Namespace, exp)
+	if err != nil {
+		log.Error(err, "Failed to look up previous history record for regression check")
+		return
+	}
+	if previous == nil {
+		return
+	}
+
+	regressions := historydiff.Regressions(previous, history, r.HistoryConfig.RegressionThreshold)
+	if len(regressions) == 0 {
+		return
+	}
+
+	history.Spec.Regressions = regressions
+	log.Info("Regression detected compared to previous run",
+		"previous", previous.Name,
+		"regressions", regressions)
+	r.Recorder.Event(exp, corev1.EventTypeWarning, "RegressionDetected",
+		fmt.Sprintf("Run regressed compared to %s: %s", previous.Name, strings.Join(regressions, "; ")))
+	chaosmetrics.HistoryRegressions.WithLabelValues(exp.Spec.Action, exp.Spec.Namespace).Inc()
+}
+
+// previousHistoryRecord returns the most recent history record of the experiment, or nil if there is none
+func (r *ChaosExperimentReconciler) previousHistoryRecord(ctx context.Context, historyNamespace string, exp *chaosv1alpha1.ChaosExperiment) (*chaosv1alpha1.ChaosExperimentHistory, error) {
+	historyList := &chaosv1alpha1.ChaosExperimentHistoryList{}
+	if err := r.List(ctx, historyList,
+		client.InNamespace(historyNamespace),
+		client.MatchingLabels{"chaos.gushchin.dev/experiment": exp.Name},
+	); err != nil {
+		return nil, fmt.Errorf("failed to list history records: %w", err)
+	}
+
+	var latest *chaosv1alpha1.ChaosExperimentHistory
+	for i := range historyList.Items {
+		record := &historyList.Items[i]
+		if record.Spec.ExperimentRef.Namespace != exp.Namespace {
+			continue
+		}
+		if latest == nil || latest.Spec.Execution.StartTime.Before(&record.Spec.Execution.StartTime) {
+			latest = record
+		}
+	}
+	return latest, nil
+}
@@ -0,0 +1,136 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// filterRollingOutPods removes pods whose owning Deployment or StatefulSet is in the middle of a
+// rollout. Disrupting pods mid-deploy mixes chaos with the rollout's own churn and invalidates
+// the results, so those pods are skipped until the rollout settles. It returns the remaining pods
+// and the names of the workloads that are rolling out.
+func (r *ChaosExperimentReconciler) filterRollingOutPods(ctx context.Context, namespace string, pods []corev1.Pod) ([]corev1.Pod, []string, error) {
+	log := ctrl.LoggerFrom(ctx)
+
+	replicaSets := &appsv1.ReplicaSetList{}
+	if err := r.List(ctx, replicaSets, client.InNamespace(namespace)); err != nil {
+		return nil, nil, fmt.Errorf("failed to list replicasets for rollout check: %w", err)
+	}
+	deployments := &appsv1.DeploymentList{}
+	if err := r.List(ctx, deployments, client.InNamespace(namespace)); err != nil {
+		return nil, nil, fmt.Errorf("failed to list deployments for rollout check: %w", err)
+	}
+	statefulSets := &appsv1.StatefulSetList{}
+	if err := r.List(ctx, statefulSets, client.InNamespace(namespace)); err != nil {
+		return nil, nil, fmt.Errorf("failed to list statefulsets for rollout check: %w", err)
+	}
+
+	// Map ReplicaSet names to their owning Deployment
+	deploymentOfReplicaSet := map[string]string{}
+	for _, rs := range replicaSets.Items {
+		if owner := metav1.GetControllerOf(&rs); owner != nil && owner.Kind == "Deployment" {
+			deploymentOfReplicaSet[rs.Name] = owner.Name
+		}
+	}
+	rolling := map[string]bool{}
+	for i := range deployments.Items {
+		if isDeploymentRollingOut(&deployments.Items[i]) {
+			rolling["Deployment/"+deployments.Items[i].Name] = true
+		}
+	}
+	for i := range statefulSets.Items {
+		if isStatefulSetRollingOut(&statefulSets.Items[i]) {
+			rolling["StatefulSet/"+statefulSets.Items[i].Name] = true
+		}
+	}
+	if len(rolling) == 0 {
+		return pods, nil, nil
+	}
+
+	result := make([]corev1.Pod, 0, len(pods))
+	skipped := map[string]bool{}
+	var workloads []string
+	for _, pod := range pods {
+		workload := ""
+		if owner := metav1.GetControllerOf(&pod); owner != nil {
+			switch owner.Kind {
+			case "ReplicaSet":
+				if deployment, ok := deploymentOfReplicaSet[owner.Name]; ok {
+					workload = "Deployment/" + deployment
+				}
+			case "StatefulSet":
+				workload = "StatefulSet/" + owner.Name
+			}
+		}
+		if rolling[workload] {
+			log.Info("Skipping pod of workload that is rolling out", "pod", pod.Name, "workload", workload)
+			if !skipped[workload] {
+				skipped[workload] = true
+				workloads = append(workloads, workload)
+			}
+			continue
+		}
+		result = append(result, pod)
+	}
+
+	return result, workloads, nil
+}
+
+// isDeploymentRollingOut mirrors `kubectl rollout status`: the rollout is in progress until the
+// controller observed the latest spec and every replica is updated and available
+func isDeploymentRollingOut(deployment *appsv1.Deployment) bool {
+	if deployment.Generation > deployment.Status.ObservedGeneration {
+		return true
+	}
+	replicas := int32(1)
+	if deployment.Spec.Replicas != nil {
+		replicas = *deployment.Spec.Replicas
+	}
+	return deployment.Status.UpdatedReplicas < replicas ||
+		deployment.Status.Replicas > deployment.Status.UpdatedReplicas ||
+		deployment.Status.AvailableReplicas < deployment.Status.UpdatedReplicas
+}
+
+// isStatefulSetRollingOut mirrors `kubectl rollout status` for StatefulSets, honouring a
+// RollingUpdate partition: only ordinals at or above the partition are expected to update
+func isStatefulSetRollingOut(sts *appsv1.StatefulSet) bool {
+	if sts.Generation > sts.Status.ObservedGeneration {
+		return true
+	}
+	if sts.Spec.UpdateStrategy.Type == appsv1.OnDeleteStatefulSetStrategyType {
+		return false
+	}
+	replicas := int32(1)
+	if sts.Spec.Replicas != nil {
+		replicas = *sts.Spec.Replicas
+	}
+	if sts.Status.ReadyReplicas < replicas {
+		return true
+	}
+	if ru := sts.Spec.UpdateStrategy.RollingUpdate; ru != nil && ru.Partition != nil && *ru.Partition > 0 {
+		return sts.Status.UpdatedReplicas < replicas-*ru.Partition
+	}
+	return sts.Status.UpdateRevision != sts.Status.CurrentRevision
+}
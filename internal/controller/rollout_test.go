@@ -0,0 +1,166 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	chaosv1alpha1 "github.com/neogan74/k8s-chaos/api/v1alpha1"
+)
+
+func TestIsDeploymentRollingOut(t *testing.T) {
+	settled := appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Generation: 2},
+		Spec:       appsv1.DeploymentSpec{Replicas: ptr.To[int32](3)},
+		Status: appsv1.DeploymentStatus{
+			ObservedGeneration: 2,
+			Replicas:           3,
+			UpdatedReplicas:    3,
+			AvailableReplicas:  3,
+		},
+	}
+	assert.False(t, isDeploymentRollingOut(&settled))
+
+	notObserved := *settled.DeepCopy()
+	notObserved.Generation = 3
+	assert.True(t, isDeploymentRollingOut(&notObserved))
+
+	partiallyUpdated := *settled.DeepCopy()
+	partiallyUpdated.Status.UpdatedReplicas = 1
+	assert.True(t, isDeploymentRollingOut(&partiallyUpdated))
+
+	oldReplicasRemaining := *settled.DeepCopy()
+	oldReplicasRemaining.Status.Replicas = 4
+	assert.True(t, isDeploymentRollingOut(&oldReplicasRemaining))
+
+	notAvailable := *settled.DeepCopy()
+	notAvailable.Status.AvailableReplicas = 2
+	assert.True(t, isDeploymentRollingOut(&notAvailable))
+}
+
+func TestIsStatefulSetRollingOut(t *testing.T) {
+	settled := appsv1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{Generation: 1},
+		Spec:       appsv1.StatefulSetSpec{Replicas: ptr.To[int32](3)},
+		Status: appsv1.StatefulSetStatus{
+			ObservedGeneration: 1,
+			ReadyReplicas:      3,
+			UpdatedReplicas:    3,
+			CurrentRevision:    "db-1",
+			UpdateRevision:     "db-1",
+		},
+	}
+	assert.False(t, isStatefulSetRollingOut(&settled))
+
+	revisionChanging := *settled.DeepCopy()
+	revisionChanging.Status.UpdateRevision = "db-2"
+	revisionChanging.Status.UpdatedReplicas = 1
+	assert.True(t, isStatefulSetRollingOut(&revisionChanging))
+
+	// With partition 2 only ordinal 2 is expected to update, so the rollout is done
+	partitioned := *revisionChanging.DeepCopy()
+	partitioned.Spec.UpdateStrategy = appsv1.StatefulSetUpdateStrategy{
+		Type:          appsv1.RollingUpdateStatefulSetStrategyType,
+		RollingUpdate: &appsv1.RollingUpdateStatefulSetStrategy{Partition: ptr.To[int32](2)},
+	}
+	assert.False(t, isStatefulSetRollingOut(&partitioned))
+
+	partitioned.Status.UpdatedReplicas = 0
+	assert.True(t, isStatefulSetRollingOut(&partitioned))
+}
+
+func TestGetEligiblePods_RolloutInProgress(t *testing.T) {
+	ctx := context.Background()
+	podOf := func(name, ownerKind, ownerName string) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "test-ns",
+				Labels:    map[string]string{"app": "demo"},
+				OwnerReferences: []metav1.OwnerReference{{
+					APIVersion: "apps/v1",
+					Kind:       ownerKind,
+					Name:       ownerName,
+					UID:        k8stypes.UID("uid-" + ownerName),
+					Controller: ptr.To(true),
+				}},
+			},
+		}
+	}
+	rolling := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "test-ns", Generation: 5},
+		Spec:       appsv1.DeploymentSpec{Replicas: ptr.To[int32](2)},
+		Status:     appsv1.DeploymentStatus{ObservedGeneration: 5, Replicas: 3, UpdatedReplicas: 1, AvailableReplicas: 1},
+	}
+	rollingRS := &appsv1.ReplicaSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "web-abc",
+			Namespace: "test-ns",
+			OwnerReferences: []metav1.OwnerReference{{
+				APIVersion: "apps/v1", Kind: "Deployment", Name: "web", UID: "uid-web", Controller: ptr.To(true),
+			}},
+		},
+	}
+	objs := []client.Object{
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "test-ns"}},
+		rolling,
+		rollingRS,
+		podOf("web-abc-1", "ReplicaSet", "web-abc"),
+		podOf("web-abc-2", "ReplicaSet", "web-abc"),
+		podOf("worker-1", "ReplicaSet", "worker-xyz"),
+		podOf("worker-2", "ReplicaSet", "worker-xyz"),
+	}
+	newExp := func(ignore bool) *chaosv1alpha1.ChaosExperiment {
+		return &chaosv1alpha1.ChaosExperiment{
+			Spec: chaosv1alpha1.ChaosExperimentSpec{
+				Action:                   "pod-kill",
+				Namespace:                "test-ns",
+				Selector:                 map[string]string{"app": "demo"},
+				AllowSingletonDisruption: true,
+				IgnoreRollouts:           ignore,
+			},
+		}
+	}
+
+	t.Run("pods of rolling workloads are skipped", func(t *testing.T) {
+		r := newReconcilerWithObjects(t, objs...)
+		eligible, err := r.getEligiblePods(ctx, newExp(false))
+		require.NoError(t, err)
+		names := []string{}
+		for _, pod := range eligible {
+			names = append(names, pod.Name)
+		}
+		assert.ElementsMatch(t, []string{"worker-1", "worker-2"}, names)
+	})
+
+	t.Run("ignoreRollouts keeps them", func(t *testing.T) {
+		r := newReconcilerWithObjects(t, objs...)
+		eligible, err := r.getEligiblePods(ctx, newExp(true))
+		require.NoError(t, err)
+		assert.Len(t, eligible, 4)
+	})
+}
@@ -0,0 +1,357 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	schedulingv1 "k8s.io/api/scheduling/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	chaosv1alpha1 "github.com/neogan74/k8s-chaos/api/v1alpha1"
+	chaosmetrics "github.com/neogan74/k8s-chaos/internal/metrics"
+)
+
+const (
+	// balloonImage only sleeps; a balloon pod exists to hold the resources it requests
+	balloonImage = "registry.k8s.io/pause:3.10"
+
+	defaultBalloonCPU    = "500m"
+	defaultBalloonMemory = "256Mi"
+
+	// preemptedEventReason is the reason of the event the scheduler records on the pods it preempts
+	preemptedEventReason = "Preempted"
+)
+
+// handleSchedulerPressure creates balloon pods with spec.balloons.priorityClassName for
+// spec.duration. Balloons with a higher priority than the workloads make the scheduler preempt
+// them; lower-priority balloons stay Pending and churn the scheduling queue. When the run ends the
+// balloons are removed and the history record lists where each was scheduled and the pods the
+// scheduler preempted for them.
+func (r *ChaosExperimentReconciler) handleSchedulerPressure(ctx context.Context, exp *chaosv1alpha1.ChaosExperiment) (ctrl.Result, error) {
+	log := ctrl.LoggerFrom(ctx)
+	startTime := time.Now()
+
+	if exp.Status.BalloonsEndAt != nil {
+		return r.finishSchedulerPressure(ctx, exp)
+	}
+
+	balloons := exp.Spec.Balloons
+	if balloons == nil || balloons.PriorityClassName == "" {
+		return r.handleExperimentFailure(ctx, exp, &ChaosError{
+			Original:  fmt.Errorf("balloons.priorityClassName must be specified"),
+			Type:      ErrorTypeValidation,
+			Operation: "validate scheduler-pressure config",
+		})
+	}
+	duration, err := r.parseDuration(exp.Spec.Duration)
+	if err != nil {
+		return r.handleExperimentFailure(ctx, exp, &ChaosError{
+			Original:  fmt.Errorf("invalid duration format: %s", exp.Spec.Duration),
+			Type:      ErrorTypeValidation,
+			Operation: "validate scheduler-pressure config",
+		})
+	}
+	requests, err := balloonRequests(balloons)
+	if err != nil {
+		return r.handleExperimentFailure(ctx, exp, &ChaosError{
+			Original: err, Type: ErrorTypeValidation, Operation: "validate scheduler-pressure config",
+		})
+	}
+
+	class := &schedulingv1.PriorityClass{}
+	if err := r.Get(ctx, types.NamespacedName{Name: balloons.PriorityClassName}, class); err != nil {
+		if isPermissionDeniedError(err) {
+			return ctrl.Result{}, r.handlePermissionDenied(ctx, exp, "reading PriorityClass for scheduler-pressure", err)
+		}
+		return r.handleExperimentFailure(ctx, exp, WrapK8sError(err, "get PriorityClass "+balloons.PriorityClassName))
+	}
+
+	workloads, err := r.listPods(ctx, client.InNamespace(exp.Spec.Namespace),
+		client.MatchingLabelsSelector{Selector: labels.SelectorFromSet(exp.Spec.Selector)})
+	if err != nil {
+		if isPermissionDeniedError(err) {
+			return ctrl.Result{}, r.handlePermissionDenied(ctx, exp, "listing pods for scheduler-pressure", err)
+		}
+		return r.handleExperimentFailure(ctx, exp, WrapK8sError(fmt.Errorf("failed to list pods: %w", err), "list pods"))
+	}
+	preemptible := len(lowerPriorityPods(workloads, class.Value))
+	replicas := max(balloons.Replicas, 1)
+
+	if exp.Spec.DryRun {
+		now := metav1.Now()
+		exp.Status.LastRunTime = &now
+		exp.Status.Message = fmt.Sprintf(
+			"DRY RUN: Would create %d balloon pod(s) with PriorityClass %s (value %d); %d of %d pod(s) matching the selector have a lower priority",
+			replicas, class.Name, class.Value, preemptible, len(workloads))
+		exp.Status.Phase = phaseCompleted
+
+		if err := r.Status().Update(ctx, exp); err != nil {
+			log.Error(err, "Failed to update ChaosExperiment status")
+			return ctrl.Result{}, err
+		}
+
+		log.Info("Dry run completed", "action", "scheduler-pressure", "balloons", replicas, "preemptible", preemptible)
+		return ctrl.Result{}, nil
+	}
+
+	runID := time.Now().Unix()
+	var created []string
+	var createErr error
+	for i := 0; i < replicas; i++ {
+		pod := balloonPod(exp, class.Name, requests, fmt.Sprintf("chaos-balloon-%s-%d-%d", exp.Name, runID, i))
+		if err := r.writer(ctx).Create(ctx, pod); err != nil {
+			log.Error(err, "Failed to create balloon pod", "pod", pod.Name)
+			chaosmetrics.ExperimentErrors.WithLabelValues("scheduler-pressure", exp.Spec.Namespace, string(WrapK8sError(err, "create balloon pod").Type)).Inc()
+			createErr = err
+			continue
+		}
+		created = append(created, pod.Namespace+"/"+pod.Name)
+	}
+	if len(created) == 0 {
+		if isPermissionDeniedError(createErr) {
+			return ctrl.Result{}, r.handlePermissionDenied(ctx, exp, "creating balloon pods", createErr)
+		}
+		return r.handleExperimentFailure(ctx, exp, WrapK8sError(createErr, "create balloon pods"))
+	}
+
+	now := metav1.Now()
+	endsAt := metav1.NewTime(now.Add(duration))
+	exp.Status.LastRunTime = &now
+	exp.Status.BalloonPods = created
+	exp.Status.BalloonsEndAt = &endsAt
+	exp.Status.Message = fmt.Sprintf(
+		"Created %d balloon pod(s) with PriorityClass %s (value %d) for %s; %d of %d pod(s) matching the selector have a lower priority",
+		len(created), class.Name, class.Value, exp.Spec.Duration, preemptible, len(workloads))
+	countAffected(exp, preemptible)
+	if err := r.Status().Update(ctx, exp); err != nil {
+		log.Error(err, "Failed to update ChaosExperiment status")
+		return ctrl.Result{}, err
+	}
+	r.Recorder.Event(exp, corev1.EventTypeWarning, "ChaosSchedulerPressure", exp.Status.Message)
+
+	chaosmetrics.ExperimentsTotal.WithLabelValues("scheduler-pressure", exp.Spec.Namespace, statusSuccess).Inc()
+	chaosmetrics.ExperimentDuration.WithLabelValues("scheduler-pressure", exp.Spec.Namespace).Observe(time.Since(startTime).Seconds())
+
+	return ctrl.Result{RequeueAfter: duration}, nil
+}
+
+// finishSchedulerPressure waits for the end of the current run, then records where the balloons
+// were scheduled and which pods the scheduler preempted for them, and removes the balloons
+func (r *ChaosExperimentReconciler) finishSchedulerPressure(ctx context.Context, exp *chaosv1alpha1.ChaosExperiment) (ctrl.Result, error) {
+	log := ctrl.LoggerFrom(ctx)
+
+	if remaining := time.Until(exp.Status.BalloonsEndAt.Time); remaining > 0 {
+		return ctrl.Result{RequeueAfter: remaining}, nil
+	}
+
+	startTime := exp.Status.BalloonsEndAt.Time
+	if exp.Status.LastRunTime != nil {
+		startTime = exp.Status.LastRunTime.Time
+	}
+	balloons, identities := r.observeBalloons(ctx, exp)
+	preempted := r.preemptedPods(ctx, exp.Spec.Namespace, identities, startTime)
+	scheduled := 0
+	for _, ref := range balloons {
+		if strings.HasPrefix(ref.Details, "scheduled") {
+			scheduled++
+		}
+	}
+
+	r.deleteBalloons(ctx, exp)
+	exp.Status.BalloonsEndAt = nil
+	exp.Status.Message = fmt.Sprintf("Removed %d balloon pod(s) after %s: %d were scheduled, %d pod(s) were preempted for them",
+		len(balloons), exp.Spec.Duration, scheduled, len(preempted))
+	if err := r.Status().Update(ctx, exp); err != nil {
+		log.Error(err, "Failed to update ChaosExperiment status")
+		return ctrl.Result{}, err
+	}
+	log.Info("Scheduler pressure run finished", "balloons", len(balloons), "scheduled", scheduled, "preempted", len(preempted))
+
+	chaosmetrics.ResourcesAffected.WithLabelValues("scheduler-pressure", exp.Spec.Namespace, exp.Name).Set(float64(len(preempted)))
+	if err := r.createHistoryRecord(ctx, exp, statusSuccess, append(balloons, preempted...), startTime, nil); err != nil {
+		log.Error(err, "Failed to create history record")
+		// Don't fail the experiment if history recording fails
+	}
+
+	// The next round starts one interval after this one did
+	next := r.roundInterval(exp)
+	if exp.Status.LastRunTime != nil {
+		next = max(time.Until(exp.Status.LastRunTime.Add(next)), time.Second)
+	}
+	return ctrl.Result{RequeueAfter: next}, nil
+}
+
+// balloonRequests returns the resources each balloon pod requests
+func balloonRequests(balloons *chaosv1alpha1.Balloons) (corev1.ResourceList, error) {
+	cpu, memory := balloons.CPU, balloons.Memory
+	if cpu == "" {
+		cpu = defaultBalloonCPU
+	}
+	if memory == "" {
+		memory = defaultBalloonMemory
+	}
+	cpuQuantity, err := resource.ParseQuantity(cpu)
+	if err != nil {
+		return nil, fmt.Errorf("invalid balloons.cpu %q: %w", cpu, err)
+	}
+	memoryQuantity, err := resource.ParseQuantity(memory)
+	if err != nil {
+		return nil, fmt.Errorf("invalid balloons.memory %q: %w", memory, err)
+	}
+	return corev1.ResourceList{corev1.ResourceCPU: cpuQuantity, corev1.ResourceMemory: memoryQuantity}, nil
+}
+
+// balloonPod builds a balloon pod in spec.namespace. Requests equal limits so the balloon is
+// Guaranteed and holds exactly what it asks for, on the nodes spec.nodeSelector and
+// spec.nodeAffinity allow.
+func balloonPod(exp *chaosv1alpha1.ChaosExperiment, priorityClass string, requests corev1.ResourceList, name string) *corev1.Pod {
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: exp.Spec.Namespace,
+			Labels:    experimentLabels(exp),
+		},
+		Spec: corev1.PodSpec{
+			PriorityClassName:             priorityClass,
+			RestartPolicy:                 corev1.RestartPolicyNever,
+			TerminationGracePeriodSeconds: ptr.To[int64](0),
+			AutomountServiceAccountToken:  ptr.To(false),
+			NodeSelector:                  exp.Spec.NodeSelector,
+			Containers: []corev1.Container{{
+				Name:      "balloon",
+				Image:     balloonImage,
+				Resources: corev1.ResourceRequirements{Requests: requests, Limits: requests},
+				SecurityContext: &corev1.SecurityContext{
+					AllowPrivilegeEscalation: ptr.To(false),
+					RunAsNonRoot:             ptr.To(true),
+					RunAsUser:                ptr.To[int64](65535),
+					Capabilities:             &corev1.Capabilities{Drop: []corev1.Capability{"ALL"}},
+					SeccompProfile:           &corev1.SeccompProfile{Type: corev1.SeccompProfileTypeRuntimeDefault},
+				},
+			}},
+		},
+	}
+	if exp.Spec.NodeAffinity != nil {
+		pod.Spec.Affinity = &corev1.Affinity{NodeAffinity: &corev1.NodeAffinity{
+			RequiredDuringSchedulingIgnoredDuringExecution: exp.Spec.NodeAffinity.DeepCopy(),
+		}}
+	}
+	setExperimentOwner(exp, pod)
+	return pod
+}
+
+// lowerPriorityPods returns the pods the scheduler may preempt for a pod of the given priority
+func lowerPriorityPods(pods []corev1.Pod, priority int32) []corev1.Pod {
+	var lower []corev1.Pod
+	for _, pod := range pods {
+		if ptr.Deref(pod.Spec.Priority, 0) < priority {
+			lower = append(lower, pod)
+		}
+	}
+	return lower
+}
+
+// observeBalloons reports where each balloon of the current run was scheduled, and returns the
+// names and UIDs the scheduler may refer to them by in its preemption events
+func (r *ChaosExperimentReconciler) observeBalloons(ctx context.Context, exp *chaosv1alpha1.ChaosExperiment) ([]chaosv1alpha1.ResourceReference, []string) {
+	refs := make([]chaosv1alpha1.ResourceReference, 0, len(exp.Status.BalloonPods))
+	var identities []string
+	for _, ref := range exp.Status.BalloonPods {
+		namespace, name, _ := strings.Cut(ref, "/")
+		identities = append(identities, ref)
+		balloon := chaosv1alpha1.ResourceReference{Kind: "Pod", Name: name, Namespace: namespace, Action: "balloon"}
+
+		pod := &corev1.Pod{}
+		err := r.liveReader().Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, pod)
+		if err == nil && pod.UID != "" {
+			identities = append(identities, string(pod.UID))
+		}
+		switch {
+		case apierrors.IsNotFound(err):
+			balloon.Details = "removed before the run ended"
+		case err != nil:
+			balloon.Details = "unknown: " + err.Error()
+		case pod.Spec.NodeName != "":
+			balloon.Details = "scheduled on node " + pod.Spec.NodeName
+		default:
+			balloon.Details = "pending"
+			for _, cond := range pod.Status.Conditions {
+				if cond.Type == corev1.PodScheduled && cond.Message != "" {
+					balloon.Details = "pending: " + cond.Message
+				}
+			}
+		}
+		refs = append(refs, balloon)
+	}
+	return refs, identities
+}
+
+// preemptedPods finds the pods in namespace the scheduler preempted for one of the balloons since
+// the run started, from the events it records on its victims. Victims in other namespaces on the
+// same nodes are not listed.
+func (r *ChaosExperimentReconciler) preemptedPods(ctx context.Context, namespace string, balloons []string, since time.Time) []chaosv1alpha1.ResourceReference {
+	events := &corev1.EventList{}
+	if err := r.List(ctx, events, client.InNamespace(namespace)); err != nil {
+		ctrl.LoggerFrom(ctx).Error(err, "Failed to list events for preempted pods", "namespace", namespace)
+		return nil
+	}
+	var refs []chaosv1alpha1.ResourceReference
+	seen := map[string]bool{}
+	for i := range events.Items {
+		event := &events.Items[i]
+		if event.Reason != preemptedEventReason || event.InvolvedObject.Kind != "Pod" ||
+			eventLastSeen(event).Before(since) || seen[event.InvolvedObject.Name] {
+			continue
+		}
+		for _, balloon := range balloons {
+			if strings.Contains(event.Message, balloon) {
+				seen[event.InvolvedObject.Name] = true
+				refs = append(refs, chaosv1alpha1.ResourceReference{
+					Kind: "Pod", Name: event.InvolvedObject.Name, Namespace: namespace, Action: "preempted", Details: event.Message,
+				})
+				break
+			}
+		}
+	}
+	return refs
+}
+
+// deleteBalloons removes the balloon pods of the current run. Balloons that cannot be deleted are
+// left to the owner reference when they share the experiment's namespace.
+func (r *ChaosExperimentReconciler) deleteBalloons(ctx context.Context, exp *chaosv1alpha1.ChaosExperiment) {
+	log := ctrl.LoggerFrom(ctx)
+	for _, ref := range exp.Status.BalloonPods {
+		namespace, name, _ := strings.Cut(ref, "/")
+		pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name}}
+		if err := r.Delete(ctx, pod, client.GracePeriodSeconds(0)); err != nil && !apierrors.IsNotFound(err) {
+			log.Error(err, "Failed to delete balloon pod", "pod", ref)
+		}
+	}
+	exp.Status.BalloonPods = nil
+}
@@ -0,0 +1,172 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	schedulingv1 "k8s.io/api/scheduling/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	chaosv1alpha1 "github.com/neogan74/k8s-chaos/api/v1alpha1"
+)
+
+func schedulerPressureFixtures() (*chaosv1alpha1.ChaosExperiment, *schedulingv1.PriorityClass, *corev1.Pod) {
+	exp := &chaosv1alpha1.ChaosExperiment{
+		ObjectMeta: metav1.ObjectMeta{Name: "batch-preemption", Namespace: "batch", UID: "3f2a9c1e"},
+		Spec: chaosv1alpha1.ChaosExperimentSpec{
+			Action:    "scheduler-pressure",
+			Namespace: "batch",
+			Selector:  map[string]string{"app": "reports"},
+			Duration:  "5m",
+			Balloons: &chaosv1alpha1.Balloons{
+				PriorityClassName: "chaos-balloon",
+				Replicas:          2,
+				CPU:               "1",
+				Memory:            "512Mi",
+			},
+		},
+	}
+	class := &schedulingv1.PriorityClass{ObjectMeta: metav1.ObjectMeta{Name: "chaos-balloon"}, Value: 1000}
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "reports-7f9c", Namespace: "batch", Labels: map[string]string{"app": "reports"}},
+		Spec:       corev1.PodSpec{Priority: ptr.To[int32](0)},
+	}
+	return exp, class, pod
+}
+
+func listBalloons(t *testing.T, r *ChaosExperimentReconciler) []corev1.Pod {
+	t.Helper()
+	pods := &corev1.PodList{}
+	require.NoError(t, r.List(context.Background(), pods, client.InNamespace("batch"),
+		client.MatchingLabels{"chaos.gushchin.dev/experiment": "batch-preemption"}))
+	return pods.Items
+}
+
+func TestHandleSchedulerPressure_CreatesBalloons(t *testing.T) {
+	ctx := context.Background()
+	exp, class, pod := schedulerPressureFixtures()
+	r := newReconcilerWithObjects(t, exp, class, pod)
+
+	result, err := r.handleSchedulerPressure(ctx, exp)
+	require.NoError(t, err)
+	assert.Equal(t, 5*time.Minute, result.RequeueAfter)
+
+	balloons := listBalloons(t, r)
+	require.Len(t, balloons, 2)
+	for _, balloon := range balloons {
+		assert.Equal(t, "chaos-balloon", balloon.Spec.PriorityClassName)
+		assert.Equal(t, "1", balloon.Spec.Containers[0].Resources.Requests.Cpu().String())
+		assert.Equal(t, balloon.Spec.Containers[0].Resources.Requests, balloon.Spec.Containers[0].Resources.Limits)
+		assert.Len(t, balloon.OwnerReferences, 1)
+	}
+
+	updated := fetchExperiment(t, r, exp.Name, exp.Namespace)
+	assert.Len(t, updated.Status.BalloonPods, 2)
+	require.NotNil(t, updated.Status.BalloonsEndAt)
+	assert.Contains(t, updated.Status.Message, "Created 2 balloon pod(s) with PriorityClass chaos-balloon (value 1000) for 5m")
+	assert.Contains(t, updated.Status.Message, "1 of 1 pod(s) matching the selector have a lower priority")
+}
+
+func TestHandleSchedulerPressure_FinishRecordsPreemptions(t *testing.T) {
+	ctx := context.Background()
+	exp, class, pod := schedulerPressureFixtures()
+	exp.Spec.Balloons.Replicas = 1
+	r := newReconcilerWithObjects(t, exp, class, pod)
+
+	_, err := r.handleSchedulerPressure(ctx, exp)
+	require.NoError(t, err)
+	exp = fetchExperiment(t, r, exp.Name, exp.Namespace)
+	balloonRef := exp.Status.BalloonPods[0]
+
+	balloon := &listBalloons(t, r)[0]
+	balloon.Spec.NodeName = "worker-1"
+	require.NoError(t, r.Update(ctx, balloon))
+	require.NoError(t, r.Create(ctx, &corev1.Event{
+		ObjectMeta:     metav1.ObjectMeta{Name: "reports-7f9c.preempted", Namespace: "batch"},
+		InvolvedObject: corev1.ObjectReference{Kind: "Pod", Name: pod.Name, Namespace: "batch"},
+		Reason:         preemptedEventReason,
+		Message:        "Preempted by " + balloonRef + " on node worker-1",
+		LastTimestamp:  metav1.Now(),
+	}))
+
+	// Still running: nothing is removed yet
+	result, err := r.handleSchedulerPressure(ctx, exp)
+	require.NoError(t, err)
+	assert.Greater(t, result.RequeueAfter, time.Duration(0))
+	assert.Len(t, listBalloons(t, r), 1)
+
+	ended := metav1.NewTime(time.Now().Add(-time.Second))
+	exp.Status.BalloonsEndAt = &ended
+	_, err = r.handleSchedulerPressure(ctx, exp)
+	require.NoError(t, err)
+
+	assert.Empty(t, listBalloons(t, r))
+	updated := fetchExperiment(t, r, exp.Name, exp.Namespace)
+	assert.Nil(t, updated.Status.BalloonsEndAt)
+	assert.Empty(t, updated.Status.BalloonPods)
+	assert.Equal(t, "Removed 1 balloon pod(s) after 5m: 1 were scheduled, 1 pod(s) were preempted for them", updated.Status.Message)
+
+	history := &chaosv1alpha1.ChaosExperimentHistoryList{}
+	require.NoError(t, r.List(ctx, history))
+	require.Len(t, history.Items, 1)
+	resources := history.Items[0].Spec.AffectedResources
+	require.Len(t, resources, 2)
+	assert.Equal(t, chaosv1alpha1.ResourceReference{
+		Kind: "Pod", Name: balloonRef[len("batch/"):], Namespace: "batch", Action: "balloon", Details: "scheduled on node worker-1",
+	}, resources[0])
+	assert.Equal(t, "reports-7f9c", resources[1].Name)
+	assert.Equal(t, "preempted", resources[1].Action)
+}
+
+func TestHandleSchedulerPressure_DryRun(t *testing.T) {
+	ctx := context.Background()
+	exp, class, pod := schedulerPressureFixtures()
+	exp.Spec.DryRun = true
+	r := newReconcilerWithObjects(t, exp, class, pod)
+
+	_, err := r.handleSchedulerPressure(ctx, exp)
+	require.NoError(t, err)
+
+	assert.Empty(t, listBalloons(t, r))
+	updated := fetchExperiment(t, r, exp.Name, exp.Namespace)
+	assert.Equal(t, phaseCompleted, updated.Status.Phase)
+	assert.Contains(t, updated.Status.Message, "DRY RUN: Would create 2 balloon pod(s) with PriorityClass chaos-balloon (value 1000)")
+}
+
+func TestHandleSchedulerPressure_RevertRemovesBalloons(t *testing.T) {
+	ctx := context.Background()
+	exp, class, pod := schedulerPressureFixtures()
+	r := newReconcilerWithObjects(t, exp, class, pod)
+
+	_, err := r.handleSchedulerPressure(ctx, exp)
+	require.NoError(t, err)
+	updated := fetchExperiment(t, r, exp.Name, exp.Namespace)
+
+	r.revertActiveInjections(ctx, updated)
+
+	assert.Empty(t, listBalloons(t, r))
+	assert.Empty(t, updated.Status.BalloonPods)
+	assert.Nil(t, updated.Status.BalloonsEndAt)
+}
@@ -0,0 +1,128 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	chaosv1alpha1 "github.com/neogan74/k8s-chaos/api/v1alpha1"
+	chaosmetrics "github.com/neogan74/k8s-chaos/internal/metrics"
+)
+
+// conditionSeverityGated is set on experiments whose injections a ChaosPolicy severity rule holds back
+const conditionSeverityGated = "SeverityGated"
+
+// severityGate applies the severity rules of the ChaosPolicies covering the experiment's target
+// namespace. It returns why the next injection round may not start, or "" when it may, and how long
+// until a time window opens. Denials and missing approvals wait for a policy or experiment change.
+func (r *ChaosExperimentReconciler) severityGate(ctx context.Context, exp *chaosv1alpha1.ChaosExperiment, now time.Time) (string, time.Duration, error) {
+	policies := &chaosv1alpha1.ChaosPolicyList{}
+	if err := r.List(ctx, policies); err != nil {
+		return "", 0, fmt.Errorf("failed to list chaos policies: %w", err)
+	}
+
+	severity := chaosv1alpha1.SeverityOf(&exp.Spec)
+	var held, window string
+	var wait time.Duration
+	for i := range policies.Items {
+		policy := &policies.Items[i]
+		if policy.DeletionTimestamp != nil || !policy.AppliesTo(exp.Spec.Namespace) {
+			continue
+		}
+		for _, rule := range policy.SeverityRulesFor(severity) {
+			switch {
+			case rule.Deny:
+				return fmt.Sprintf("ChaosPolicy %q does not allow %s severity experiments in namespace %s",
+					policy.Name, severity, exp.Spec.Namespace), 0, nil
+			case rule.RequireApproval && !chaosv1alpha1.IsApproved(exp):
+				held = fmt.Sprintf("ChaosPolicy %q requires approval of %s severity experiments (%s annotation)",
+					policy.Name, severity, chaosv1alpha1.ApprovedByAnnotation)
+			}
+			if len(rule.TimeWindows) == 0 || chaosv1alpha1.IsWithinTimeWindows(rule.TimeWindows, now) {
+				continue
+			}
+			boundary, opens := chaosv1alpha1.NextTimeWindowBoundary(rule.TimeWindows, now)
+			if !opens {
+				held = fmt.Sprintf("ChaosPolicy %q has no upcoming time window for %s severity experiments",
+					policy.Name, severity)
+				continue
+			}
+			if d := boundary.Sub(now); d > wait {
+				wait = d
+				window = fmt.Sprintf("ChaosPolicy %q only allows %s severity experiments within its time windows",
+					policy.Name, severity)
+			}
+		}
+	}
+	if held != "" {
+		return held, 0, nil
+	}
+	return window, wait, nil
+}
+
+// handleSeverityGated holds the experiment, keeping its phase, until the severity rules allow its
+// next injection round
+func (r *ChaosExperimentReconciler) handleSeverityGated(ctx context.Context, exp *chaosv1alpha1.ChaosExperiment, reason string, wait time.Duration) (ctrl.Result, error) {
+	log := ctrl.LoggerFrom(ctx)
+	message := "Held by severity rule: " + reason
+	if wait > 0 {
+		wait = wait.Round(time.Second) + time.Second
+		message = fmt.Sprintf("%s; next window opens in %s", message, wait)
+	}
+
+	// Only record once per hold; later reconciles just keep waiting
+	if !meta.IsStatusConditionTrue(exp.Status.Conditions, conditionSeverityGated) {
+		severity := chaosv1alpha1.SeverityOf(&exp.Spec)
+		log.Info("Injection round held back by severity rule", "reason", reason, "severity", severity)
+		chaosmetrics.SafetySeverityBlocks.WithLabelValues(exp.Spec.Action, exp.Spec.Namespace, severity).Inc()
+		r.Recorder.Event(exp, corev1.EventTypeWarning, "ChaosSeverityGated", message)
+	}
+
+	meta.SetStatusCondition(&exp.Status.Conditions, metav1.Condition{
+		Type:               conditionSeverityGated,
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: exp.Generation,
+		Reason:             "ChaosPolicySeverityRule",
+		Message:            reason,
+	})
+	exp.Status.Message = message
+	if err := r.Status().Update(ctx, exp); err != nil {
+		log.Error(err, "Failed to update status for severity gated experiment")
+		return ctrl.Result{}, err
+	}
+	return ctrl.Result{RequeueAfter: wait}, nil
+}
+
+// clearSeverityGatedCondition removes the SeverityGated condition once the experiment may run
+func (r *ChaosExperimentReconciler) clearSeverityGatedCondition(ctx context.Context, exp *chaosv1alpha1.ChaosExperiment) {
+	if meta.FindStatusCondition(exp.Status.Conditions, conditionSeverityGated) == nil {
+		return
+	}
+
+	meta.RemoveStatusCondition(&exp.Status.Conditions, conditionSeverityGated)
+	if err := r.Status().Update(ctx, exp); err != nil {
+		log := ctrl.LoggerFrom(ctx)
+		log.Error(err, "Failed to clear SeverityGated condition")
+	}
+}
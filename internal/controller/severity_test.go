@@ -0,0 +1,137 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	chaosv1alpha1 "github.com/neogan74/k8s-chaos/api/v1alpha1"
+)
+
+func TestReconcile_SeverityApproval(t *testing.T) {
+	ctx := context.Background()
+	exp := rateLimitTestExperiment("kill")
+	exp.Spec.Severity = chaosv1alpha1.SeverityHigh
+	exp.Annotations = map[string]string{chaosv1alpha1.InitiatedByAnnotation: "jane"}
+	policy := &chaosv1alpha1.ChaosPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "approvals"},
+		Spec: chaosv1alpha1.ChaosPolicySpec{
+			SeverityRules: []chaosv1alpha1.SeverityRule{{Severity: chaosv1alpha1.SeverityMedium, RequireApproval: true}},
+		},
+	}
+	objs := []client.Object{exp, policy, &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "shop"}}}
+	for _, name := range []string{"web-1", "web-2"} {
+		objs = append(objs, &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "shop", Labels: map[string]string{"app": "web"}}})
+	}
+	r := newReconcilerWithObjects(t, objs...)
+
+	result, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: client.ObjectKeyFromObject(exp)})
+	require.NoError(t, err)
+	assert.Zero(t, result.RequeueAfter, "approvals are picked up from the experiment update")
+	pods := &corev1.PodList{}
+	require.NoError(t, r.List(ctx, pods, client.InNamespace("shop")))
+	assert.Len(t, pods.Items, 2)
+
+	updated := fetchExperiment(t, r, exp.Name, exp.Namespace)
+	assert.Equal(t, phaseRunning, updated.Status.Phase)
+	assert.Contains(t, updated.Status.Message, `ChaosPolicy "approvals" requires approval of high severity experiments`)
+	assert.True(t, meta.IsStatusConditionTrue(updated.Status.Conditions, conditionSeverityGated))
+
+	// Approving your own experiment does not count
+	updated.Annotations[chaosv1alpha1.ApprovedByAnnotation] = "jane"
+	require.NoError(t, r.Update(ctx, updated))
+	_, err = r.Reconcile(ctx, ctrl.Request{NamespacedName: client.ObjectKeyFromObject(exp)})
+	require.NoError(t, err)
+	require.NoError(t, r.List(ctx, pods, client.InNamespace("shop")))
+	assert.Len(t, pods.Items, 2)
+
+	updated = fetchExperiment(t, r, exp.Name, exp.Namespace)
+	updated.Annotations[chaosv1alpha1.ApprovedByAnnotation] = "lead"
+	require.NoError(t, r.Update(ctx, updated))
+	_, err = r.Reconcile(ctx, ctrl.Request{NamespacedName: client.ObjectKeyFromObject(exp)})
+	require.NoError(t, err)
+	require.NoError(t, r.List(ctx, pods, client.InNamespace("shop")))
+	assert.Len(t, pods.Items, 1)
+	updated = fetchExperiment(t, r, exp.Name, exp.Namespace)
+	assert.Nil(t, meta.FindStatusCondition(updated.Status.Conditions, conditionSeverityGated))
+}
+
+func TestSeverityGate(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC) // a Monday
+	policies := []client.Object{
+		&chaosv1alpha1.ChaosPolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: "staging-only"},
+			Spec: chaosv1alpha1.ChaosPolicySpec{
+				Namespaces:    []string{"prod"},
+				SeverityRules: []chaosv1alpha1.SeverityRule{{Severity: chaosv1alpha1.SeverityHigh, Deny: true}},
+			},
+		},
+		&chaosv1alpha1.ChaosPolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: "office-hours"},
+			Spec: chaosv1alpha1.ChaosPolicySpec{
+				SeverityRules: []chaosv1alpha1.SeverityRule{{
+					Severity: chaosv1alpha1.SeverityMedium,
+					TimeWindows: []chaosv1alpha1.TimeWindow{{
+						Type: chaosv1alpha1.TimeWindowRecurring, Start: "10:00", End: "16:00",
+					}},
+				}},
+			},
+		},
+	}
+	r := newReconcilerWithObjects(t, policies...)
+
+	tests := []struct {
+		name       string
+		namespace  string
+		severity   string
+		wantReason string
+		wantWait   time.Duration
+	}{
+		{name: "low severity runs anywhere", namespace: "prod", severity: chaosv1alpha1.SeverityLow},
+		{name: "high severity is denied in prod", namespace: "prod", severity: chaosv1alpha1.SeverityHigh,
+			wantReason: `ChaosPolicy "staging-only" does not allow high severity experiments in namespace prod`},
+		{name: "high severity waits for the window elsewhere", namespace: "staging", severity: chaosv1alpha1.SeverityHigh,
+			wantReason: `ChaosPolicy "office-hours" only allows high severity experiments within its time windows`,
+			wantWait:   time.Hour},
+		{name: "unset severity counts as medium", namespace: "staging",
+			wantReason: `ChaosPolicy "office-hours" only allows medium severity experiments within its time windows`,
+			wantWait:   time.Hour},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exp := rateLimitTestExperiment("exp")
+			exp.Spec.Namespace = tt.namespace
+			exp.Spec.Severity = tt.severity
+
+			reason, wait, err := r.severityGate(ctx, exp, now)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantReason, reason)
+			assert.Equal(t, tt.wantWait, wait)
+		})
+	}
+}
@@ -0,0 +1,103 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+	"hash/fnv"
+	"strconv"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+)
+
+// Shard is the slice of experiments and monkeys one controller replica reconciles when several
+// replicas split the work. Objects are assigned by a hash of namespace/name, so every replica
+// must run with the same Count. A nil Shard or a Count below two owns everything.
+type Shard struct {
+	// Index is the shard of this replica, from 0 to Count-1
+	Index int
+	// Count is the number of shards
+	Count int
+}
+
+// NewShard validates index against count
+func NewShard(index, count int) (*Shard, error) {
+	if count < 1 {
+		return nil, fmt.Errorf("shard count must be at least 1, got %d", count)
+	}
+	if index < 0 || index >= count {
+		return nil, fmt.Errorf("shard index %d is out of range for %d shards", index, count)
+	}
+	return &Shard{Index: index, Count: count}, nil
+}
+
+// ShardIndexFromHostname returns the ordinal of a StatefulSet pod name such as
+// k8s-chaos-controller-manager-2
+func ShardIndexFromHostname(hostname string) (int, error) {
+	i := strings.LastIndex(hostname, "-")
+	if i < 0 {
+		return 0, fmt.Errorf("hostname %q has no StatefulSet ordinal", hostname)
+	}
+	index, err := strconv.Atoi(hostname[i+1:])
+	if err != nil || index < 0 {
+		return 0, fmt.Errorf("hostname %q has no StatefulSet ordinal", hostname)
+	}
+	return index, nil
+}
+
+// Owns reports whether the object namespace/name belongs to this shard
+func (s *Shard) Owns(namespace, name string) bool {
+	if s == nil || s.Count < 2 {
+		return true
+	}
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(namespace + "/" + name))
+	return int(h.Sum32()%uint32(s.Count)) == s.Index
+}
+
+// Primary reports whether this replica runs the cluster-wide housekeeping that only one
+// replica should do, such as history TTL cleanup or the digest
+func (s *Shard) Primary() bool {
+	return s == nil || s.Index == 0
+}
+
+// predicate drops the events of objects other shards own
+func (s *Shard) predicate() predicate.Predicate {
+	return predicate.NewPredicateFuncs(func(obj client.Object) bool {
+		return s.Owns(obj.GetNamespace(), obj.GetName())
+	})
+}
+
+// ownerPredicate drops the events of objects whose controller, in the same namespace, another
+// shard owns
+func (s *Shard) ownerPredicate() predicate.Predicate {
+	return predicate.NewPredicateFuncs(func(obj client.Object) bool {
+		owner := metav1.GetControllerOf(obj)
+		return owner == nil || s.Owns(obj.GetNamespace(), owner.Name)
+	})
+}
+
+// String identifies the shard in logs
+func (s *Shard) String() string {
+	if s == nil {
+		return "0/1"
+	}
+	return fmt.Sprintf("%d/%d", s.Index, s.Count)
+}
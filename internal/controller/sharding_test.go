@@ -0,0 +1,131 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+
+	chaosv1alpha1 "github.com/neogan74/k8s-chaos/api/v1alpha1"
+)
+
+func TestNewShard(t *testing.T) {
+	_, err := NewShard(0, 0)
+	assert.Error(t, err)
+	_, err = NewShard(3, 3)
+	assert.Error(t, err)
+	_, err = NewShard(-1, 3)
+	assert.Error(t, err)
+
+	shard, err := NewShard(2, 3)
+	require.NoError(t, err)
+	assert.Equal(t, "2/3", shard.String())
+}
+
+func TestShardIndexFromHostname(t *testing.T) {
+	index, err := ShardIndexFromHostname("k8s-chaos-controller-manager-2")
+	require.NoError(t, err)
+	assert.Equal(t, 2, index)
+
+	for _, hostname := range []string{"manager", "manager-abc12", "manager-"} {
+		_, err := ShardIndexFromHostname(hostname)
+		assert.Error(t, err, hostname)
+	}
+}
+
+func TestShard_OwnsEachObjectOnce(t *testing.T) {
+	shards := []*Shard{{Index: 0, Count: 3}, {Index: 1, Count: 3}, {Index: 2, Count: 3}}
+	counts := make([]int, len(shards))
+	for i := range 300 {
+		name := fmt.Sprintf("exp-%d", i)
+		owners := 0
+		for j, shard := range shards {
+			if shard.Owns("default", name) {
+				owners++
+				counts[j]++
+			}
+		}
+		assert.Equal(t, 1, owners, name)
+	}
+	// The hash spreads the experiments over every shard
+	for j, count := range counts {
+		assert.Greater(t, count, 50, "shard %d", j)
+	}
+
+	var unsharded *Shard
+	assert.True(t, unsharded.Owns("default", "exp-0"))
+	assert.True(t, unsharded.Primary())
+	assert.True(t, (&Shard{Index: 0, Count: 1}).Owns("default", "exp-0"))
+	assert.False(t, shards[1].Primary())
+}
+
+func TestShard_AllExperimentsEnqueuesOwnedOnly(t *testing.T) {
+	shard := &Shard{Index: 1, Count: 2}
+	var objs []client.Object
+	owned := 0
+	for i := range 20 {
+		exp := &chaosv1alpha1.ChaosExperiment{
+			ObjectMeta: metav1.ObjectMeta{Name: fmt.Sprintf("exp-%d", i), Namespace: "default"},
+		}
+		objs = append(objs, exp)
+		if shard.Owns(exp.Namespace, exp.Name) {
+			owned++
+		}
+	}
+	r := newReconcilerWithObjects(t, objs...)
+	r.Shard = shard
+
+	requests := r.allExperiments(context.Background(), nil)
+	assert.Len(t, requests, owned)
+	for _, req := range requests {
+		assert.True(t, shard.Owns(req.Namespace, req.Name), req.String())
+	}
+}
+
+func TestShard_OwnerPredicate(t *testing.T) {
+	shard := &Shard{Index: 0, Count: 2}
+	var ownedMonkey, otherMonkey string
+	for i := 0; ownedMonkey == "" || otherMonkey == ""; i++ {
+		name := fmt.Sprintf("monkey-%d", i)
+		if shard.Owns("default", name) {
+			ownedMonkey = name
+		} else {
+			otherMonkey = name
+		}
+	}
+	experimentOf := func(monkey string) *chaosv1alpha1.ChaosExperiment {
+		return &chaosv1alpha1.ChaosExperiment{ObjectMeta: metav1.ObjectMeta{
+			Name: monkey + "-run", Namespace: "default",
+			OwnerReferences: []metav1.OwnerReference{{
+				APIVersion: chaosv1alpha1.GroupVersion.String(), Kind: "ChaosMonkey",
+				Name: monkey, Controller: ptr.To(true),
+			}},
+		}}
+	}
+
+	predicate := shard.ownerPredicate()
+	assert.True(t, predicate.Create(event.CreateEvent{Object: experimentOf(ownedMonkey)}))
+	assert.False(t, predicate.Create(event.CreateEvent{Object: experimentOf(otherMonkey)}))
+}
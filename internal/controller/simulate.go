@@ -0,0 +1,681 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	coordinationv1 "k8s.io/api/coordination/v1"
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	schedulingv1 "k8s.io/api/scheduling/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	chaosv1alpha1 "github.com/neogan74/k8s-chaos/api/v1alpha1"
+	cronschedule "github.com/neogan74/k8s-chaos/internal/schedule"
+)
+
+// Outcomes of a simulation step
+const (
+	// SimulationPass means the check lets the experiment through
+	SimulationPass = "pass"
+	// SimulationBlock means the controller would not inject at the simulated time
+	SimulationBlock = "block"
+	// SimulationWarn means the experiment would run but the check found a risk
+	SimulationWarn = "warn"
+	// SimulationSkip means the check does not apply or could not be evaluated
+	SimulationSkip = "skip"
+)
+
+// SimulationStep is one decision the controller makes before injecting
+type SimulationStep struct {
+	Check   string `json:"check"`
+	Outcome string `json:"outcome"`
+	Message string `json:"message"`
+	// Details lists the resources the decision is about, e.g. the pods a filter removed
+	Details []string `json:"details,omitempty"`
+}
+
+// Simulation is the decision tree of an experiment evaluated against the current cluster state
+type Simulation struct {
+	Experiment string    `json:"experiment"`
+	Action     string    `json:"action"`
+	At         time.Time `json:"at"`
+	// WouldInject is true when no step blocks and targets remain
+	WouldInject bool             `json:"wouldInject"`
+	Steps       []SimulationStep `json:"steps"`
+	// Targets are the pods (namespace/name), nodes or lease (namespace/name) that would be hit
+	Targets     []string                   `json:"targets,omitempty"`
+	BlastRadius *chaosv1alpha1.BlastRadius `json:"blastRadius,omitempty"`
+}
+
+func (s *Simulation) add(check, outcome, message string, details ...string) {
+	s.Steps = append(s.Steps, SimulationStep{Check: check, Outcome: outcome, Message: message, Details: details})
+}
+
+// Blocked reports whether any step blocks the experiment
+func (s *Simulation) Blocked() bool {
+	for _, step := range s.Steps {
+		if step.Outcome == SimulationBlock {
+			return true
+		}
+	}
+	return false
+}
+
+// nodeActions select nodes instead of pods
+var nodeActions = map[string]bool{
+	"node-drain": true, "node-taint": true, "node-cpu-stress": true, "node-disk-fill": true,
+}
+
+// Simulate evaluates the experiment as the controller would at the given time: spec validation,
+// freezes, production protection, schedule, time windows, dependencies, ChaosPolicy rate limits,
+// target selection with every exclusion, budgets and PodDisruptionBudgets. It only reads from the
+// cluster; nothing is created and no status is written. The experiment does not need to exist.
+func (r *ChaosExperimentReconciler) Simulate(ctx context.Context, exp *chaosv1alpha1.ChaosExperiment, at time.Time) (*Simulation, error) {
+	exp = exp.DeepCopy()
+	sim := &Simulation{Experiment: client.ObjectKeyFromObject(exp).String(), Action: exp.Spec.Action, At: at}
+
+	errs := chaosv1alpha1.ValidateSpecStructure(exp.Name, &exp.Spec)
+	if errs = append(errs, r.unwatchedNamespaceErrors(&exp.Spec)...); len(errs) > 0 {
+		details := make([]string, 0, len(errs))
+		for _, e := range errs {
+			details = append(details, e.Error())
+		}
+		sim.add("spec", SimulationBlock, "the spec is invalid", details...)
+		return sim, nil
+	}
+	if _, ok := executors[exp.Spec.Action]; !ok {
+		sim.add("spec", SimulationBlock, "unsupported action "+exp.Spec.Action)
+		return sim, nil
+	}
+	sim.add("spec", SimulationPass, "the spec is valid")
+
+	if exp.Spec.Paused {
+		sim.add("paused", SimulationBlock, "spec.paused is set")
+	}
+	if exp.Spec.DryRun {
+		sim.add("dry run", SimulationWarn, "spec.dryRun is set: the controller would only record the targets")
+	}
+
+	if err := r.simulateGates(ctx, exp, at, sim); err != nil {
+		return nil, err
+	}
+
+	var err error
+	switch {
+	case nodeActions[exp.Spec.Action]:
+		err = r.simulateNodeTargets(ctx, exp, sim)
+	case exp.Spec.Action == "lease-steal":
+		err = r.simulateLeaseTarget(ctx, exp, sim)
+	case exp.Spec.Action == "scheduler-pressure":
+		err = r.simulateBalloons(ctx, exp, sim)
+	case exp.Spec.Action == "quota-squeeze":
+		err = r.simulateQuotaSqueeze(ctx, exp, sim)
+	case exp.Spec.Action == "admission-delay":
+		err = r.simulateAdmissionDelay(ctx, exp, sim)
+	default:
+		err = r.simulatePodTargets(ctx, exp, sim)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	sim.WouldInject = !sim.Blocked() && len(sim.Targets) > 0
+	return sim, nil
+}
+
+// simulateGates evaluates the checks that hold back the whole experiment
+func (r *ChaosExperimentReconciler) simulateGates(ctx context.Context, exp *chaosv1alpha1.ChaosExperiment, at time.Time, sim *Simulation) error {
+	freezes := &chaosv1alpha1.ChaosFreezeList{}
+	if err := r.List(ctx, freezes); err != nil {
+		return fmt.Errorf("failed to list chaos freezes: %w", err)
+	}
+	frozen := false
+	for i := range freezes.Items {
+		if freeze := &freezes.Items[i]; freeze.IsActive(at) {
+			sim.add("freeze", SimulationBlock, fmt.Sprintf("ChaosFreeze %q is active: %s", freeze.Name, freeze.Spec.Reason))
+			frozen = true
+		}
+	}
+	if !frozen {
+		sim.add("freeze", SimulationPass, "no active ChaosFreeze")
+	}
+
+	ns := &corev1.Namespace{}
+	if err := r.Get(ctx, client.ObjectKey{Name: exp.Spec.Namespace}, ns); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return fmt.Errorf("failed to get namespace %s: %w", exp.Spec.Namespace, err)
+		}
+		sim.add("namespace", SimulationBlock, fmt.Sprintf("namespace %s does not exist", exp.Spec.Namespace))
+		ns = nil
+	}
+	switch {
+	case !chaosv1alpha1.IsProductionNamespace(exp.Spec.Namespace, ns):
+		sim.add("production", SimulationPass, fmt.Sprintf("namespace %s is not a production namespace", exp.Spec.Namespace))
+	case exp.Spec.AllowProduction:
+		sim.add("production", SimulationWarn, fmt.Sprintf("namespace %s is production; allowed by allowProduction", exp.Spec.Namespace))
+	default:
+		sim.add("production", SimulationBlock,
+			fmt.Sprintf("namespace %s is production; the webhook rejects the experiment without allowProduction", exp.Spec.Namespace))
+	}
+
+	if definition := chaosv1alpha1.ScheduleDefinition(&exp.Spec); definition.IsZero() {
+		sim.add("schedule", SimulationPass, "no schedule: the experiment runs when created")
+	} else {
+		_, offset := r.scheduleKeyAndOffset(exp)
+		var next time.Time
+		schedule, err := cronschedule.Build(definition, offset)
+		if err == nil {
+			next = schedule.Next(at.Add(-time.Second))
+		}
+		switch {
+		case err != nil:
+			sim.add("schedule", SimulationBlock, fmt.Sprintf("invalid schedule: %v", err))
+		case next.IsZero():
+			sim.add("schedule", SimulationBlock,
+				fmt.Sprintf("scheduled %s; no run is left outside the excluded schedules", definition))
+		default:
+			sim.add("schedule", SimulationPass, fmt.Sprintf("scheduled %s; the run due at %s is simulated",
+				definition, next.Format(time.RFC3339)))
+			// Windows and freezes apply at the time the run is due
+			at = next
+			sim.At = next
+		}
+	}
+
+	switch {
+	case len(exp.Spec.MaintenanceWindows) > 0 && chaosv1alpha1.IsWithinTimeWindows(exp.Spec.MaintenanceWindows, at):
+		end, _ := chaosv1alpha1.NextTimeWindowBoundary(exp.Spec.MaintenanceWindows, at)
+		sim.add("time windows", SimulationBlock, "inside a maintenance window until "+formatBoundary(end))
+	case !chaosv1alpha1.IsWithinTimeWindows(exp.Spec.TimeWindows, at):
+		next, _ := chaosv1alpha1.NextTimeWindowBoundary(exp.Spec.TimeWindows, at)
+		sim.add("time windows", SimulationBlock, "outside the allowed time windows; the next one opens at "+formatBoundary(next))
+	case len(exp.Spec.TimeWindows) > 0:
+		sim.add("time windows", SimulationPass, "inside an allowed time window")
+	default:
+		sim.add("time windows", SimulationPass, "no time windows configured")
+	}
+
+	if len(exp.Spec.DependsOn) > 0 {
+		met, err := r.checkDependencies(ctx, exp)
+		switch {
+		case err != nil:
+			return err
+		case met:
+			sim.add("dependencies", SimulationPass, "all dependencies completed", exp.Spec.DependsOn...)
+		default:
+			sim.add("dependencies", SimulationBlock, exp.Status.Message)
+		}
+	}
+
+	if !exp.Spec.DryRun {
+		reason, wait, err := r.severityGate(ctx, exp, at)
+		switch {
+		case err != nil:
+			return err
+		case reason != "" && wait > 0:
+			sim.add("severity", SimulationBlock, fmt.Sprintf("%s; next window opens at %s", reason,
+				formatBoundary(at.Add(wait))))
+		case reason != "":
+			sim.add("severity", SimulationBlock, reason)
+		default:
+			sim.add("severity", SimulationPass, fmt.Sprintf("no ChaosPolicy severity rule holds back %s severity",
+				chaosv1alpha1.SeverityOf(&exp.Spec)))
+		}
+	}
+
+	return r.simulateRateLimit(ctx, exp, at, sim)
+}
+
+// simulateRateLimit applies the ChaosPolicies to the experiment. The controller remembers recent
+// injection rounds in memory; here they are read back from the history records instead.
+func (r *ChaosExperimentReconciler) simulateRateLimit(ctx context.Context, exp *chaosv1alpha1.ChaosExperiment, at time.Time, sim *Simulation) error {
+	histories := &chaosv1alpha1.ChaosExperimentHistoryList{}
+	err := r.List(ctx, histories, client.MatchingLabels{"chaos.gushchin.dev/target-namespace": exp.Spec.Namespace})
+	if err != nil && !apierrors.IsForbidden(err) {
+		return fmt.Errorf("failed to list history records: %w", err)
+	}
+	rounds := newInjectionLog()
+	for _, history := range histories.Items {
+		if !history.Spec.Audit.DryRun {
+			rounds.record(exp.Spec.Namespace, history.Spec.Execution.StartTime.Time)
+		}
+	}
+
+	withHistory := &ChaosExperimentReconciler{Client: r.Client, injections: rounds}
+	wait, reason, err := withHistory.rateLimitDelay(ctx, exp, at)
+	if err != nil {
+		return err
+	}
+	if wait > 0 {
+		sim.add("rate limit", SimulationBlock, fmt.Sprintf("%s; next round in %s", reason, wait.Round(time.Second)))
+	} else {
+		sim.add("rate limit", SimulationPass, "no ChaosPolicy rate limit holds the experiment back")
+	}
+	return nil
+}
+
+func formatBoundary(t time.Time) string {
+	if t.IsZero() {
+		return "never"
+	}
+	return t.Format(time.RFC3339)
+}
+
+// simulatePodTargets runs the target selection of pod actions step by step, recording which pods
+// each filter removes
+func (r *ChaosExperimentReconciler) simulatePodTargets(ctx context.Context, exp *chaosv1alpha1.ChaosExperiment, sim *Simulation) error {
+	selected, err := r.listPods(ctx, client.InNamespace(exp.Spec.Namespace),
+		client.MatchingLabelsSelector{Selector: labels.SelectorFromSet(exp.Spec.Selector)})
+	if err != nil {
+		return fmt.Errorf("failed to list pods: %w", err)
+	}
+	if len(selected) == 0 {
+		sim.add("selector", SimulationBlock, fmt.Sprintf("no pods in %s match %v", exp.Spec.Namespace, exp.Spec.Selector))
+		return nil
+	}
+	sim.add("selector", SimulationPass, fmt.Sprintf("%d pod(s) match %v", len(selected), exp.Spec.Selector))
+
+	namespaceExclusion := r.namespaceExclusion(ctx, exp.Spec.Namespace, sim.At)
+	excluded := map[string][]string{}
+	pods := []corev1.Pod{}
+	for _, pod := range selected {
+		if reason := podExclusion(&pod, &exp.Spec, namespaceExclusion, sim.At); reason != "" {
+			excluded[reason] = append(excluded[reason], pod.Name)
+			continue
+		}
+		pods = append(pods, pod)
+	}
+	for _, exclusion := range []struct{ reason, message string }{
+		{exclusionNamespace, "namespace opts out with " + chaosv1alpha1.ExclusionLabel},
+		{exclusionNamespaceTemporary, "namespace opts out with " + chaosv1alpha1.ExcludeUntilAnnotation},
+		{exclusionLabel, "pods labeled " + chaosv1alpha1.ExclusionLabel},
+		{exclusionPodTemporary, "pods annotated with an unexpired " + chaosv1alpha1.ExcludeUntilAnnotation},
+		{exclusionOwner, "owners left out by excludeOwners or includeOwners"},
+		{exclusionTerminating, "pods are terminating"},
+	} {
+		if names := excluded[exclusion.reason]; len(names) > 0 {
+			sim.add("exclusions", SimulationPass, fmt.Sprintf("%d skipped: %s", len(names), exclusion.message), names...)
+		}
+	}
+
+	if hasNodeConstraints(&exp.Spec) && len(pods) > 0 {
+		remaining, err := r.filterPodsByNode(ctx, &exp.Spec, pods)
+		if err != nil {
+			return err
+		}
+		if removed := removedPods(pods, remaining); len(removed) > 0 {
+			sim.add("nodes", SimulationPass, fmt.Sprintf("%d pod(s) skipped: not on nodes matching %s",
+				len(removed), describeNodeConstraints(&exp.Spec)), removed...)
+		}
+		pods = remaining
+	}
+
+	if !exp.Spec.AllowSingletonDisruption && len(pods) > 0 {
+		remaining, singletons, leaders, err := r.filterProtectedPods(ctx, exp.Spec.Namespace, pods)
+		if err != nil {
+			return err
+		}
+		if removed := removedPods(pods, remaining); len(removed) > 0 {
+			sim.add("protection", SimulationPass, fmt.Sprintf(
+				"%d singleton replica(s) and %d lease holder(s) skipped (allowSingletonDisruption overrides)",
+				singletons, leaders), removed...)
+		}
+		pods = remaining
+	}
+
+	if !exp.Spec.IgnoreRollouts && len(pods) > 0 {
+		remaining, rollingOut, err := r.filterRollingOutPods(ctx, exp.Spec.Namespace, pods)
+		if err != nil {
+			return err
+		}
+		if len(rollingOut) > 0 {
+			sim.add("rollouts", SimulationPass, fmt.Sprintf("pods of %s skipped until the rollout settles",
+				strings.Join(rollingOut, ", ")), removedPods(pods, remaining)...)
+		}
+		pods = remaining
+	}
+
+	if honorsEvictionAnnotations(&exp.Spec) && len(pods) > 0 {
+		var protected []string
+		pods, protected = filterNotSafeToEvict(pods)
+		if len(protected) > 0 {
+			sim.add("eviction annotations", SimulationPass, fmt.Sprintf("%d pod(s) skipped: annotated %s: \"false\" (ignoreEvictionAnnotations overrides)",
+				len(protected), safeToEvictAnnotation), protected...)
+		}
+	}
+
+	if len(pods) == 0 {
+		sim.add("targets", SimulationBlock, "no eligible pods remain")
+		return nil
+	}
+
+	count := max(exp.Spec.Count, 1)
+	if err := chaosv1alpha1.CheckMaxPercentage(count, exp.Spec.MaxPercentage, len(pods)); err != nil {
+		sim.add("maxPercentage", SimulationBlock, err.Error())
+	} else if exp.Spec.MaxPercentage > 0 {
+		sim.add("maxPercentage", SimulationPass, fmt.Sprintf("%d of %d eligible pod(s) is within %d%%",
+			min(count, len(pods)), len(pods), exp.Spec.MaxPercentage))
+	}
+
+	pods = r.orderTargetPods(ctx, exp, pods)
+	targets := pods[:min(count, len(pods))]
+	message := fmt.Sprintf("%d of %d eligible pod(s) would be hit", len(targets), len(pods))
+	if exp.Spec.SelectionSeed == nil && (exp.Spec.SelectionStrategy == "" || exp.Spec.SelectionStrategy == strategyRandom) {
+		message += "; selection is random, so the controller may pick others"
+	}
+	for _, pod := range targets {
+		sim.Targets = append(sim.Targets, pod.Namespace+"/"+pod.Name)
+	}
+	sim.add("targets", SimulationPass, message, sim.Targets...)
+
+	sim.BlastRadius = r.estimateBlastRadius(ctx, exp, targets)
+	sim.add("blast radius", SimulationPass, formatBlastRadius(sim.BlastRadius))
+
+	return r.simulateDisruptionBudgets(ctx, exp.Spec.Namespace, targets, sim)
+}
+
+// removedPods returns the names of the pods in before that are not in after
+func removedPods(before, after []corev1.Pod) []string {
+	kept := make(map[string]bool, len(after))
+	for _, pod := range after {
+		kept[pod.Name] = true
+	}
+	var removed []string
+	for _, pod := range before {
+		if !kept[pod.Name] {
+			removed = append(removed, pod.Name)
+		}
+	}
+	return removed
+}
+
+// simulateDisruptionBudgets reports PodDisruptionBudgets in namespace ("" for all) that the
+// target pods would exceed. Pod actions delete or disturb pods directly, so a budget does not stop
+// them, and a drain retries blocked evictions; exceeding one is a warning either way.
+func (r *ChaosExperimentReconciler) simulateDisruptionBudgets(ctx context.Context, namespace string, targets []corev1.Pod, sim *Simulation) error {
+	budgets := &policyv1.PodDisruptionBudgetList{}
+	if err := r.List(ctx, budgets, client.InNamespace(namespace)); err != nil {
+		if apierrors.IsForbidden(err) {
+			sim.add("disruption budgets", SimulationSkip, "not allowed to list PodDisruptionBudgets")
+			return nil
+		}
+		return fmt.Errorf("failed to list PodDisruptionBudgets: %w", err)
+	}
+
+	var exceeded []string
+	covered := 0
+	for i := range budgets.Items {
+		budget := &budgets.Items[i]
+		selector, err := metav1.LabelSelectorAsSelector(budget.Spec.Selector)
+		if err != nil || selector.Empty() {
+			continue
+		}
+		hit := 0
+		for _, pod := range targets {
+			if pod.Namespace == budget.Namespace && selector.Matches(labels.Set(pod.Labels)) {
+				hit++
+			}
+		}
+		if hit == 0 {
+			continue
+		}
+		covered++
+		if int32(hit) > budget.Status.DisruptionsAllowed {
+			exceeded = append(exceeded, fmt.Sprintf("%s/%s allows %d disruption(s), %d target(s) covered",
+				budget.Namespace, budget.Name, budget.Status.DisruptionsAllowed, hit))
+		}
+	}
+
+	switch {
+	case len(exceeded) > 0:
+		sim.add("disruption budgets", SimulationWarn, "the targets exceed PodDisruptionBudgets", exceeded...)
+	case covered > 0:
+		sim.add("disruption budgets", SimulationPass, fmt.Sprintf("the targets fit %d PodDisruptionBudget(s)", covered))
+	default:
+		sim.add("disruption budgets", SimulationPass, "no PodDisruptionBudget covers the targets")
+	}
+	return nil
+}
+
+// simulateBalloons checks the PriorityClass of scheduler-pressure balloons against the pods the
+// selector matches; the targets are the pods with a lower priority, which the scheduler may preempt
+func (r *ChaosExperimentReconciler) simulateBalloons(ctx context.Context, exp *chaosv1alpha1.ChaosExperiment, sim *Simulation) error {
+	name := exp.Spec.Balloons.PriorityClassName
+	class := &schedulingv1.PriorityClass{}
+	if err := r.Get(ctx, types.NamespacedName{Name: name}, class); err != nil {
+		if apierrors.IsNotFound(err) {
+			sim.add("priority class", SimulationBlock, fmt.Sprintf("PriorityClass %s does not exist", name))
+			return nil
+		}
+		return fmt.Errorf("failed to get PriorityClass %s: %w", name, err)
+	}
+	sim.add("priority class", SimulationPass, fmt.Sprintf("balloons would run with PriorityClass %s (value %d)", name, class.Value))
+
+	pods, err := r.listPods(ctx, client.InNamespace(exp.Spec.Namespace),
+		client.MatchingLabelsSelector{Selector: labels.SelectorFromSet(exp.Spec.Selector)})
+	if err != nil {
+		return fmt.Errorf("failed to list pods: %w", err)
+	}
+	for _, pod := range lowerPriorityPods(pods, class.Value) {
+		sim.Targets = append(sim.Targets, pod.Namespace+"/"+pod.Name)
+	}
+	if len(sim.Targets) == 0 {
+		sim.add("targets", SimulationWarn, fmt.Sprintf("none of the %d pod(s) matching %v has a lower priority; the balloons would not preempt them",
+			len(pods), exp.Spec.Selector))
+		return nil
+	}
+	sim.add("targets", SimulationPass, fmt.Sprintf("%d of %d pod(s) have a lower priority and may be preempted", len(sim.Targets), len(pods)), sim.Targets...)
+	return nil
+}
+
+// simulateQuotaSqueeze lists the ResourceQuotas quota-squeeze would lower, with their new limits
+func (r *ChaosExperimentReconciler) simulateQuotaSqueeze(ctx context.Context, exp *chaosv1alpha1.ChaosExperiment, sim *Simulation) error {
+	var squeeze chaosv1alpha1.QuotaSqueeze
+	if exp.Spec.QuotaSqueeze != nil {
+		squeeze = *exp.Spec.QuotaSqueeze
+	}
+	quotas, err := r.quotasToSqueeze(ctx, exp.Spec.Namespace, squeeze.Name)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("failed to get ResourceQuotas: %w", err)
+	}
+	if len(quotas) == 0 {
+		sim.add("quotas", SimulationBlock, fmt.Sprintf("no ResourceQuota to squeeze in namespace %s", exp.Spec.Namespace))
+		return nil
+	}
+
+	var details []string
+	for i := range quotas {
+		quota := &quotas[i]
+		if holder := quota.Annotations[annotationQuotaSqueezedBy]; holder != "" && holder != exp.Namespace+"/"+exp.Name {
+			details = append(details, fmt.Sprintf("%s: already squeezed by %s", quota.Name, holder))
+			continue
+		}
+		if lowered := squeezedHard(quota, &squeeze); len(lowered) > 0 {
+			sim.Targets = append(sim.Targets, quota.Namespace+"/"+quota.Name)
+			details = append(details, fmt.Sprintf("%s: %s", quota.Name, describeQuotaChange(quota.Spec.Hard, lowered)))
+		}
+	}
+	if len(sim.Targets) == 0 {
+		sim.add("quotas", SimulationBlock, "no ResourceQuota limit is above its usage", details...)
+		return nil
+	}
+	sim.add("quotas", SimulationPass, fmt.Sprintf("%d ResourceQuota(s) would be squeezed", len(sim.Targets)), details...)
+	return nil
+}
+
+// simulateAdmissionDelay checks that the controller's webhook server is registered, as the slow
+// webhook calls it, and lists the resources whose requests would be delayed
+func (r *ChaosExperimentReconciler) simulateAdmissionDelay(ctx context.Context, exp *chaosv1alpha1.ChaosExperiment, sim *Simulation) error {
+	if _, err := r.controllerWebhookClientConfig(ctx); err != nil {
+		var chaosErr *ChaosError
+		if !errors.As(err, &chaosErr) {
+			return fmt.Errorf("failed to list ValidatingWebhookConfigurations: %w", err)
+		}
+		sim.add("webhook", SimulationBlock, chaosErr.Original.Error())
+		return nil
+	}
+	sim.add("webhook", SimulationPass, fmt.Sprintf("the controller's webhook %s is registered", controllerWebhookName))
+
+	for _, resource := range exp.Spec.AdmissionDelay.Resources {
+		sim.Targets = append(sim.Targets, exp.Spec.Namespace+"/"+resource)
+	}
+	sim.add("requests", SimulationPass, fmt.Sprintf("%s matching %v would be delayed by %s",
+		describeAdmissionDelay(exp.Spec.AdmissionDelay), exp.Spec.Selector, exp.Spec.AdmissionDelay.Delay), sim.Targets...)
+	return nil
+}
+
+// simulateLeaseTarget looks up the lease lease-steal would take and who holds it
+func (r *ChaosExperimentReconciler) simulateLeaseTarget(ctx context.Context, exp *chaosv1alpha1.ChaosExperiment, sim *Simulation) error {
+	key := types.NamespacedName{Namespace: exp.Spec.Namespace, Name: exp.Spec.LeaseName}
+	lease := &coordinationv1.Lease{}
+	if err := r.liveReader().Get(ctx, key, lease); err != nil {
+		if apierrors.IsNotFound(err) {
+			sim.add("lease", SimulationBlock, fmt.Sprintf("lease %s does not exist", key))
+			return nil
+		}
+		return fmt.Errorf("failed to get lease %s: %w", key, err)
+	}
+	sim.Targets = []string{key.String()}
+	sim.add("lease", SimulationPass, fmt.Sprintf("lease %s is held by %s", key, r.describeLeaseHolder(ctx, exp, leaseHolder(lease))), key.String())
+	return nil
+}
+
+// simulateNodeTargets runs the node selection of node actions, including the drain safety checks
+func (r *ChaosExperimentReconciler) simulateNodeTargets(ctx context.Context, exp *chaosv1alpha1.ChaosExperiment, sim *Simulation) error {
+	nodeList := &corev1.NodeList{}
+	if err := r.List(ctx, nodeList, client.MatchingLabelsSelector{Selector: labels.SelectorFromSet(exp.Spec.Selector)}); err != nil {
+		return fmt.Errorf("failed to list nodes: %w", err)
+	}
+	if len(nodeList.Items) == 0 {
+		sim.add("selector", SimulationBlock, fmt.Sprintf("no nodes match %v", exp.Spec.Selector))
+		return nil
+	}
+	sim.add("selector", SimulationPass, fmt.Sprintf("%d node(s) match %v", len(nodeList.Items), exp.Spec.Selector))
+
+	nodes := nodeList.Items
+	count := max(exp.Spec.Count, 1)
+	if exp.Spec.Action != "node-drain" {
+		for _, node := range nodes[:min(count, len(nodes))] {
+			sim.Targets = append(sim.Targets, node.Name)
+		}
+		sim.add("targets", SimulationPass, fmt.Sprintf("%d of %d node(s) would be hit", len(sim.Targets), len(nodes)), sim.Targets...)
+		return nil
+	}
+
+	if !exp.Spec.AllowControlPlane {
+		workers := []corev1.Node{}
+		var controlPlane []string
+		for _, node := range nodes {
+			if isControlPlaneNode(&node) {
+				controlPlane = append(controlPlane, node.Name)
+				continue
+			}
+			workers = append(workers, node)
+		}
+		if len(controlPlane) > 0 {
+			sim.add("control plane", SimulationPass, fmt.Sprintf("%d control-plane node(s) skipped (allowControlPlane overrides)",
+				len(controlPlane)), controlPlane...)
+		}
+		nodes = workers
+		if len(nodes) == 0 {
+			sim.add("targets", SimulationBlock, "no eligible nodes remain")
+			return nil
+		}
+	}
+
+	allNodes := &corev1.NodeList{}
+	if err := r.List(ctx, allNodes); err != nil {
+		return fmt.Errorf("failed to list nodes: %w", err)
+	}
+	allPods, err := r.listPods(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list pods: %w", err)
+	}
+	podsByNode := map[string][]corev1.Pod{}
+	for _, pod := range allPods {
+		if pod.Spec.NodeName != "" {
+			podsByNode[pod.Spec.NodeName] = append(podsByNode[pod.Spec.NodeName], pod)
+		}
+	}
+	unavailable := 0
+	for i := range allNodes.Items {
+		if isNodeUnavailable(&allNodes.Items[i]) {
+			unavailable++
+		}
+	}
+
+	if honorsEvictionAnnotations(&exp.Spec) {
+		sortNodesByDeletionCost(nodes, podsByNode)
+	}
+
+	// Walk the candidates like the drain loop does, in list order instead of shuffled
+	var skipped []string
+	var evicted []corev1.Pod
+	draining := map[string]bool{}
+	for i := 0; i < len(nodes) && len(sim.Targets) < count; i++ {
+		node := &nodes[i]
+		nodeUnavailable := isNodeUnavailable(node)
+		if exp.Spec.MaxUnavailableNodes > 0 && !nodeUnavailable && unavailable >= exp.Spec.MaxUnavailableNodes {
+			skipped = append(skipped, fmt.Sprintf("%s: maxUnavailableNodes (%d) reached", node.Name, exp.Spec.MaxUnavailableNodes))
+			continue
+		}
+		if honorsEvictionAnnotations(&exp.Spec) {
+			if blocker := nodeEvictionBlocker(podsByNode[node.Name]); blocker != "" {
+				skipped = append(skipped, fmt.Sprintf("%s: pod %s is annotated %s: \"false\"", node.Name, blocker, safeToEvictAnnotation))
+				continue
+			}
+		}
+		if exp.Spec.NodeReplacement == nil {
+			if err := checkDrainCapacity(node, allNodes.Items, podsByNode, draining); err != nil {
+				skipped = append(skipped, fmt.Sprintf("%s: %v", node.Name, err))
+				continue
+			}
+		}
+		draining[node.Name] = true
+		if !nodeUnavailable {
+			unavailable++
+		}
+		sim.Targets = append(sim.Targets, node.Name)
+		for _, pod := range podsByNode[node.Name] {
+			if isEvictablePod(&pod) {
+				evicted = append(evicted, pod)
+			}
+		}
+	}
+	if len(skipped) > 0 {
+		sim.add("drain safety", SimulationPass, fmt.Sprintf("%d node(s) skipped by safety checks", len(skipped)), skipped...)
+	}
+	if len(sim.Targets) == 0 {
+		sim.add("targets", SimulationBlock, "no node passes the drain safety checks")
+		return nil
+	}
+	sim.add("targets", SimulationPass, fmt.Sprintf("%d node(s) would be cordoned and drained, evicting %d pod(s); the controller picks candidates in random order",
+		len(sim.Targets), len(evicted)), sim.Targets...)
+
+	// Evictions honor PodDisruptionBudgets, so an exhausted budget stalls the drain
+	return r.simulateDisruptionBudgets(ctx, "", evicted, sim)
+}
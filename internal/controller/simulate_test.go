@@ -0,0 +1,186 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	chaosv1alpha1 "github.com/neogan74/k8s-chaos/api/v1alpha1"
+)
+
+func simulationStep(t *testing.T, sim *Simulation, check string) SimulationStep {
+	t.Helper()
+	for _, step := range sim.Steps {
+		if step.Check == check {
+			return step
+		}
+	}
+	t.Fatalf("no %q step in %+v", check, sim.Steps)
+	return SimulationStep{}
+}
+
+func simulatedPodKill() *chaosv1alpha1.ChaosExperiment {
+	return &chaosv1alpha1.ChaosExperiment{
+		ObjectMeta: metav1.ObjectMeta{Name: "web-kill", Namespace: "chaos-testing"},
+		Spec: chaosv1alpha1.ChaosExperimentSpec{
+			Action:        "pod-kill",
+			Namespace:     "default",
+			Selector:      map[string]string{"app": "web"},
+			Count:         1,
+			MaxPercentage: 50,
+		},
+	}
+}
+
+func TestSimulatePodKill(t *testing.T) {
+	ctx := context.Background()
+	excluded := ownedPod("web-3", "node-b", "ReplicaSet", "web-abc", "abc")
+	excluded.Labels[chaosv1alpha1.ExclusionLabel] = "true"
+	budget := &policyv1.PodDisruptionBudget{
+		ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default"},
+		Spec: policyv1.PodDisruptionBudgetSpec{
+			MaxUnavailable: ptrIntOrString(0),
+			Selector:       &metav1.LabelSelector{MatchLabels: map[string]string{"app": "web"}},
+		},
+		Status: policyv1.PodDisruptionBudgetStatus{DisruptionsAllowed: 0},
+	}
+	r := newReconcilerWithObjects(t,
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "default"}},
+		ownedPod("web-1", "node-a", "ReplicaSet", "web-abc", "abc"),
+		ownedPod("web-2", "node-b", "ReplicaSet", "web-abc", "abc"),
+		excluded, budget)
+
+	sim, err := r.Simulate(ctx, simulatedPodKill(), time.Now())
+	require.NoError(t, err)
+
+	assert.True(t, sim.WouldInject, "steps: %+v", sim.Steps)
+	assert.Equal(t, "chaos-testing/web-kill", sim.Experiment)
+	require.Len(t, sim.Targets, 1)
+	assert.Contains(t, []string{"default/web-1", "default/web-2"}, sim.Targets[0])
+	assert.Equal(t, []string{"web-3"}, simulationStep(t, sim, "exclusions").Details)
+	assert.Equal(t, SimulationPass, simulationStep(t, sim, "maxPercentage").Outcome)
+	assert.Equal(t, SimulationWarn, simulationStep(t, sim, "disruption budgets").Outcome)
+	require.NotNil(t, sim.BlastRadius)
+	assert.Equal(t, 1, sim.BlastRadius.AffectedPods)
+
+	// Nothing was written: the experiment does not exist and all pods are still there
+	pods := &corev1.PodList{}
+	require.NoError(t, r.List(ctx, pods))
+	assert.Len(t, pods.Items, 3)
+	experiments := &chaosv1alpha1.ChaosExperimentList{}
+	require.NoError(t, r.List(ctx, experiments))
+	assert.Empty(t, experiments.Items)
+}
+
+func ptrIntOrString(v int) *intstr.IntOrString {
+	value := intstr.FromInt(v)
+	return &value
+}
+
+func TestSimulateBlockingGates(t *testing.T) {
+	ctx := context.Background()
+	at := time.Date(2026, 3, 2, 3, 0, 0, 0, time.UTC) // a Monday, 03:00
+
+	exp := simulatedPodKill()
+	exp.Spec.TimeWindows = []chaosv1alpha1.TimeWindow{{
+		Type: chaosv1alpha1.TimeWindowRecurring, Start: "09:00", End: "17:00", Timezone: "UTC",
+	}}
+	r := newReconcilerWithObjects(t,
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "default"}},
+		&chaosv1alpha1.ChaosFreeze{
+			ObjectMeta: metav1.ObjectMeta{Name: "incident-42"},
+			Spec:       chaosv1alpha1.ChaosFreezeSpec{Reason: "INC-42"},
+		},
+		ownedPod("web-1", "node-a", "ReplicaSet", "web-abc", "abc"))
+
+	sim, err := r.Simulate(ctx, exp, at)
+	require.NoError(t, err)
+	assert.False(t, sim.WouldInject)
+	assert.Equal(t, SimulationBlock, simulationStep(t, sim, "freeze").Outcome)
+	assert.Contains(t, simulationStep(t, sim, "freeze").Message, "INC-42")
+	windows := simulationStep(t, sim, "time windows")
+	assert.Equal(t, SimulationBlock, windows.Outcome)
+	assert.Contains(t, windows.Message, "2026-03-02T09:00:00Z")
+
+	// Inside the window only the freeze blocks
+	sim, err = r.Simulate(ctx, exp, at.Add(7*time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, SimulationPass, simulationStep(t, sim, "time windows").Outcome)
+	assert.True(t, sim.Blocked())
+}
+
+func TestSimulateInvalidSpec(t *testing.T) {
+	exp := simulatedPodKill()
+	exp.Spec.Action = "pod-delay"
+	r := newReconcilerWithObjects(t)
+
+	sim, err := r.Simulate(context.Background(), exp, time.Now())
+	require.NoError(t, err)
+	assert.False(t, sim.WouldInject)
+	require.Len(t, sim.Steps, 1)
+	assert.Equal(t, SimulationBlock, sim.Steps[0].Outcome)
+	assert.NotEmpty(t, sim.Steps[0].Details)
+}
+
+func TestSimulateNodeDrain(t *testing.T) {
+	worker := func(name string) *corev1.Node {
+		return &corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Labels: map[string]string{"pool": "general"}},
+			Status: corev1.NodeStatus{
+				Conditions:  []corev1.NodeCondition{{Type: corev1.NodeReady, Status: corev1.ConditionTrue}},
+				Allocatable: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("4"), corev1.ResourceMemory: resource.MustParse("8Gi")},
+			},
+		}
+	}
+	controlPlane := worker("cp-1")
+	controlPlane.Labels["node-role.kubernetes.io/control-plane"] = ""
+	exp := &chaosv1alpha1.ChaosExperiment{
+		ObjectMeta: metav1.ObjectMeta{Name: "drain", Namespace: "chaos-testing"},
+		Spec: chaosv1alpha1.ChaosExperimentSpec{
+			Action:    "node-drain",
+			Namespace: "default",
+			Selector:  map[string]string{"pool": "general"},
+			Count:     1,
+		},
+	}
+	r := newReconcilerWithObjects(t,
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "default"}},
+		controlPlane, worker("worker-1"), worker("worker-2"),
+		drainTestPod("default", "app-1", "worker-1"))
+
+	sim, err := r.Simulate(context.Background(), exp, time.Now())
+	require.NoError(t, err)
+	assert.True(t, sim.WouldInject, "steps: %+v", sim.Steps)
+	assert.Equal(t, []string{"cp-1"}, simulationStep(t, sim, "control plane").Details)
+	require.Len(t, sim.Targets, 1)
+	assert.NotEqual(t, "cp-1", sim.Targets[0])
+
+	node := &corev1.Node{}
+	require.NoError(t, r.Get(context.Background(), client.ObjectKey{Name: sim.Targets[0]}, node))
+	assert.False(t, node.Spec.Unschedulable, "simulation must not cordon")
+}
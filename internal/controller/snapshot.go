@@ -0,0 +1,196 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	chaosv1alpha1 "github.com/neogan74/k8s-chaos/api/v1alpha1"
+)
+
+// snapshotBudget tracks how many bytes of events and logs may still be added to a snapshot
+type snapshotBudget struct {
+	remaining int
+	truncated bool
+	// redacted is set when credentials were scrubbed from a log tail
+	redacted bool
+}
+
+// take reserves n bytes, marking the snapshot truncated when they do not fit
+func (b *snapshotBudget) take(n int) bool {
+	if n > b.remaining {
+		b.truncated = true
+		return false
+	}
+	b.remaining -= n
+	return true
+}
+
+// captureSnapshot collects the events and container log tails of the affected resources, bounded
+// by HistoryConfig.SnapshotMaxBytes. Only events seen since the run started are kept. Capture is
+// best effort: anything that cannot be read is left out rather than failing the history record.
+func (r *ChaosExperimentReconciler) captureSnapshot(ctx context.Context, resources []chaosv1alpha1.ResourceReference, since time.Time) *chaosv1alpha1.ResourceSnapshot {
+	if r.HistoryConfig.SnapshotMaxBytes <= 0 || len(resources) == 0 {
+		return nil
+	}
+
+	budget := &snapshotBudget{remaining: r.HistoryConfig.SnapshotMaxBytes}
+	snapshot := &chaosv1alpha1.ResourceSnapshot{
+		Events: r.captureEvents(ctx, resources, since, budget),
+		Logs:   r.captureLogTails(ctx, resources, budget),
+	}
+	snapshot.Truncated = budget.truncated
+	snapshot.Redacted = budget.redacted
+
+	if len(snapshot.Events) == 0 && len(snapshot.Logs) == 0 && !snapshot.Truncated {
+		return nil
+	}
+	return snapshot
+}
+
+// captureEvents returns the events involving the affected resources, oldest first
+func (r *ChaosExperimentReconciler) captureEvents(ctx context.Context, resources []chaosv1alpha1.ResourceReference, since time.Time, budget *snapshotBudget) []chaosv1alpha1.CapturedEvent {
+	log := ctrl.LoggerFrom(ctx)
+
+	// Events of namespaced resources live in their namespace; node events are listed cluster-wide
+	affected := map[string]bool{}
+	namespaces := map[string]bool{}
+	for _, res := range resources {
+		affected[res.Kind+"/"+res.Namespace+"/"+res.Name] = true
+		namespaces[res.Namespace] = true
+	}
+	if namespaces[""] {
+		namespaces = map[string]bool{"": true}
+	}
+
+	var events []corev1.Event
+	for namespace := range namespaces {
+		list := &corev1.EventList{}
+		if err := r.List(ctx, list, client.InNamespace(namespace)); err != nil {
+			log.V(1).Info("Failed to list events for history snapshot", "namespace", namespace, "error", err.Error())
+			continue
+		}
+		for _, event := range list.Items {
+			obj := event.InvolvedObject
+			if affected[obj.Kind+"/"+obj.Namespace+"/"+obj.Name] && !eventLastSeen(&event).Before(since) {
+				events = append(events, event)
+			}
+		}
+	}
+	sort.SliceStable(events, func(i, j int) bool {
+		return eventLastSeen(&events[i]).Before(eventLastSeen(&events[j]))
+	})
+
+	captured := make([]chaosv1alpha1.CapturedEvent, 0, len(events))
+	for i := range events {
+		event := &events[i]
+		entry := chaosv1alpha1.CapturedEvent{
+			Object:  event.InvolvedObject.Kind + "/" + event.InvolvedObject.Name,
+			Type:    event.Type,
+			Reason:  event.Reason,
+			Message: event.Message,
+			Count:   event.Count,
+		}
+		entry.LastTimestamp.Time = eventLastSeen(event)
+		if !budget.take(len(entry.Object) + len(entry.Reason) + len(entry.Message)) {
+			break
+		}
+		captured = append(captured, entry)
+	}
+	return captured
+}
+
+// eventLastSeen returns the most precise "last seen" time an event carries
+func eventLastSeen(event *corev1.Event) time.Time {
+	switch {
+	case !event.LastTimestamp.IsZero():
+		return event.LastTimestamp.Time
+	case !event.EventTime.IsZero():
+		return event.EventTime.Time
+	default:
+		return event.CreationTimestamp.Time
+	}
+}
+
+// captureLogTails fetches the last HistoryConfig.SnapshotLogLines lines of every container of the
+// affected pods that still exist. Pods deleted by the experiment have no logs left to capture.
+func (r *ChaosExperimentReconciler) captureLogTails(ctx context.Context, resources []chaosv1alpha1.ResourceReference, budget *snapshotBudget) []chaosv1alpha1.ContainerLogTail {
+	if r.Clientset == nil || r.HistoryConfig.SnapshotLogLines <= 0 {
+		return nil
+	}
+	log := ctrl.LoggerFrom(ctx)
+	tailLines := int64(r.HistoryConfig.SnapshotLogLines)
+
+	var tails []chaosv1alpha1.ContainerLogTail
+	for _, res := range resources {
+		if res.Kind != "Pod" {
+			continue
+		}
+		pod := &corev1.Pod{}
+		if err := r.Get(ctx, client.ObjectKey{Namespace: res.Namespace, Name: res.Name}, pod); err != nil {
+			continue
+		}
+		for _, container := range pod.Spec.Containers {
+			if budget.remaining <= 0 {
+				budget.truncated = true
+				return tails
+			}
+			limitBytes := int64(budget.remaining)
+			raw, err := r.Clientset.CoreV1().Pods(pod.Namespace).GetLogs(pod.Name, &corev1.PodLogOptions{
+				Container:  container.Name,
+				TailLines:  &tailLines,
+				LimitBytes: &limitBytes,
+			}).DoRaw(ctx)
+			if err != nil {
+				log.V(1).Info("Failed to read container logs for history snapshot",
+					"pod", pod.Name, "container", container.Name, "error", err.Error())
+				continue
+			}
+			scrubbed, redacted := r.podRedactor(pod).String(string(raw))
+			lines := fitLogTail(scrubbed, budget.remaining)
+			if len(lines) < len(scrubbed) {
+				budget.truncated = true
+			}
+			budget.redacted = budget.redacted || redacted
+			budget.take(len(lines))
+			tails = append(tails, chaosv1alpha1.ContainerLogTail{Pod: pod.Name, Container: container.Name, Lines: lines})
+		}
+	}
+	return tails
+}
+
+// fitLogTail keeps the end of a log that fits in maxBytes, dropping any partial first line
+func fitLogTail(lines string, maxBytes int) string {
+	if len(lines) <= maxBytes {
+		return lines
+	}
+	if maxBytes <= 0 {
+		return ""
+	}
+	tail := lines[len(lines)-maxBytes:]
+	if i := strings.IndexByte(tail, '\n'); i >= 0 && i < len(tail)-1 {
+		tail = tail[i+1:]
+	}
+	return tail
+}
@@ -0,0 +1,106 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	chaosv1alpha1 "github.com/neogan74/k8s-chaos/api/v1alpha1"
+)
+
+func testEvent(name, namespace, kind, object, reason, message string, lastSeen time.Time) *corev1.Event {
+	return &corev1.Event{
+		ObjectMeta:     metav1.ObjectMeta{Name: name, Namespace: namespace},
+		InvolvedObject: corev1.ObjectReference{Kind: kind, Namespace: namespace, Name: object},
+		Type:           corev1.EventTypeWarning,
+		Reason:         reason,
+		Message:        message,
+		Count:          1,
+		LastTimestamp:  metav1.NewTime(lastSeen),
+	}
+}
+
+func TestCaptureSnapshot_Events(t *testing.T) {
+	ctx := context.Background()
+	start := time.Now().Add(-time.Minute)
+	r := newReconcilerWithObjects(t,
+		testEvent("e1", "default", "Pod", "web-1", "Killing", "Stopping container web", start.Add(20*time.Second)),
+		testEvent("e2", "default", "Pod", "web-1", "BackOff", "Back-off restarting failed container", start.Add(40*time.Second)),
+		testEvent("old", "default", "Pod", "web-1", "Pulled", "Image pulled", start.Add(-time.Hour)),
+		testEvent("other", "default", "Pod", "api-1", "Killing", "Stopping container api", start.Add(30*time.Second)),
+	)
+	resources := buildResourceReferences("deleted", "default", []string{"web-1"}, "Pod")
+
+	snapshot := r.captureSnapshot(ctx, resources, start)
+
+	require.NotNil(t, snapshot)
+	require.Len(t, snapshot.Events, 2)
+	assert.Equal(t, "Pod/web-1", snapshot.Events[0].Object)
+	assert.Equal(t, "Killing", snapshot.Events[0].Reason)
+	assert.Equal(t, "BackOff", snapshot.Events[1].Reason)
+	assert.False(t, snapshot.Truncated)
+	assert.Empty(t, snapshot.Logs, "no clientset, so no logs")
+}
+
+func TestCaptureSnapshot_SizeBound(t *testing.T) {
+	ctx := context.Background()
+	start := time.Now().Add(-time.Minute)
+	r := newReconcilerWithObjects(t,
+		testEvent("e1", "default", "Pod", "web-1", "Killing", "Stopping container web", start.Add(10*time.Second)),
+		testEvent("e2", "default", "Pod", "web-1", "BackOff", strings.Repeat("x", 200), start.Add(20*time.Second)),
+	)
+	r.HistoryConfig.SnapshotMaxBytes = 64
+	resources := buildResourceReferences("deleted", "default", []string{"web-1"}, "Pod")
+
+	snapshot := r.captureSnapshot(ctx, resources, start)
+
+	require.NotNil(t, snapshot)
+	assert.Len(t, snapshot.Events, 1)
+	assert.True(t, snapshot.Truncated)
+
+	r.HistoryConfig.SnapshotMaxBytes = 0
+	assert.Nil(t, r.captureSnapshot(ctx, resources, start), "capture is disabled with a zero size limit")
+}
+
+func TestCaptureSnapshot_NodeEvents(t *testing.T) {
+	ctx := context.Background()
+	start := time.Now().Add(-time.Minute)
+	nodeEvent := testEvent("n1", "default", "Node", "worker-1", "NodeNotSchedulable", "Node worker-1 status is now: NodeNotSchedulable", start.Add(5*time.Second))
+	nodeEvent.InvolvedObject.Namespace = ""
+	r := newReconcilerWithObjects(t, nodeEvent)
+
+	snapshot := r.captureSnapshot(ctx, []chaosv1alpha1.ResourceReference{{Kind: "Node", Name: "worker-1", Action: "drained"}}, start)
+
+	require.NotNil(t, snapshot)
+	require.Len(t, snapshot.Events, 1)
+	assert.Equal(t, "Node/worker-1", snapshot.Events[0].Object)
+}
+
+func TestFitLogTail(t *testing.T) {
+	logs := "line one\nline two\nline three\n"
+	assert.Equal(t, logs, fitLogTail(logs, 100))
+	assert.Equal(t, "line three\n", fitLogTail(logs, 15))
+	assert.Equal(t, "", fitLogTail(logs, 0))
+}
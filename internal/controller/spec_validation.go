@@ -0,0 +1,99 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	chaosv1alpha1 "github.com/neogan74/k8s-chaos/api/v1alpha1"
+	chaosmetrics "github.com/neogan74/k8s-chaos/internal/metrics"
+)
+
+const (
+	// conditionInvalid is set on experiments whose spec fails validation
+	conditionInvalid = "Invalid"
+)
+
+// handleInvalidSpec marks an experiment that failed validation as Failed and stops it.
+// This covers clusters where the validating webhook is not installed, so invalid specs
+// reach the controller instead of being rejected at admission.
+func (r *ChaosExperimentReconciler) handleInvalidSpec(ctx context.Context, exp *chaosv1alpha1.ChaosExperiment, errs []chaosv1alpha1.ValidationError) (ctrl.Result, error) {
+	log := ctrl.LoggerFrom(ctx)
+
+	details := make([]string, 0, len(errs))
+	for _, e := range errs {
+		details = append(details, fmt.Sprintf("%s: %s", e.Field, e.Message))
+	}
+	message := "Invalid experiment spec: " + strings.Join(details, "; ")
+
+	// Only revert and record once per generation; later reconciles are no-ops until the spec changes
+	condition := meta.FindStatusCondition(exp.Status.Conditions, conditionInvalid)
+	if condition != nil && condition.Status == metav1.ConditionTrue && condition.ObservedGeneration == exp.Generation {
+		return ctrl.Result{}, nil
+	}
+
+	log.Info("Experiment spec is invalid", "errors", details)
+	r.revertActiveInjections(ctx, exp)
+	chaosmetrics.ExperimentErrors.WithLabelValues(exp.Spec.Action, exp.Spec.Namespace, string(ErrorTypeValidation)).Inc()
+	r.Recorder.Event(exp, corev1.EventTypeWarning, "InvalidSpec", message)
+
+	meta.SetStatusCondition(&exp.Status.Conditions, metav1.Condition{
+		Type:               conditionInvalid,
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: exp.Generation,
+		Reason:             "ValidationFailed",
+		Message:            message,
+	})
+	exp.Status.Phase = phaseFailed
+	exp.Status.Message = message
+	exp.Status.LastError = (&ChaosError{Original: errors.New(message), Type: ErrorTypeValidation}).StatusMessage()
+	if err := r.Status().Update(ctx, exp); err != nil {
+		log.Error(err, "Failed to update status for invalid experiment")
+		return ctrl.Result{}, err
+	}
+
+	// No requeue: fixing the spec bumps the generation and triggers a new reconcile
+	return ctrl.Result{}, nil
+}
+
+// clearInvalidCondition removes the Invalid condition once the spec validates again and
+// resets the phase so the experiment can run
+func (r *ChaosExperimentReconciler) clearInvalidCondition(ctx context.Context, exp *chaosv1alpha1.ChaosExperiment) {
+	if meta.FindStatusCondition(exp.Status.Conditions, conditionInvalid) == nil {
+		return
+	}
+
+	meta.RemoveStatusCondition(&exp.Status.Conditions, conditionInvalid)
+	if exp.Status.Phase == phaseFailed {
+		exp.Status.Phase = phasePending
+		exp.Status.Message = "Experiment spec is valid"
+		exp.Status.LastError = ""
+	}
+	r.Recorder.Event(exp, corev1.EventTypeNormal, "SpecValid", "Experiment spec passed validation")
+	if err := r.Status().Update(ctx, exp); err != nil {
+		log := ctrl.LoggerFrom(ctx)
+		log.Error(err, "Failed to clear Invalid condition")
+	}
+}
@@ -0,0 +1,72 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	chaosv1alpha1 "github.com/neogan74/k8s-chaos/api/v1alpha1"
+)
+
+func TestReconcile_InvalidSpecFailsWithoutWebhook(t *testing.T) {
+	ctx := context.Background()
+	exp := &chaosv1alpha1.ChaosExperiment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "invalid-exp",
+			Namespace: "default",
+		},
+		Spec: chaosv1alpha1.ChaosExperimentSpec{
+			Action:          "pod-delay",
+			Namespace:       "default",
+			Selector:        map[string]string{"app": "demo"},
+			RestartInterval: "soon",
+		},
+	}
+
+	r := newReconcilerWithObjects(t, exp)
+	req := ctrl.Request{NamespacedName: client.ObjectKeyFromObject(exp)}
+
+	result, err := r.Reconcile(ctx, req)
+	require.NoError(t, err)
+	assert.Zero(t, result.RequeueAfter)
+
+	updated := fetchExperiment(t, r, exp.Name, exp.Namespace)
+	assert.Equal(t, phaseFailed, updated.Status.Phase)
+	assert.True(t, meta.IsStatusConditionTrue(updated.Status.Conditions, conditionInvalid))
+	// Every failing field is reported, not just the first
+	assert.Contains(t, updated.Status.Message, "spec.restartInterval: invalid restartInterval format")
+	assert.Contains(t, updated.Status.Message, "spec: duration is required for pod-delay action")
+
+	// Fixing the spec clears the condition and lets the experiment run again
+	updated.Spec.RestartInterval = ""
+	updated.Spec.Duration = "30s"
+	require.NoError(t, r.Update(ctx, updated))
+	_, err = r.Reconcile(ctx, req)
+	require.NoError(t, err)
+
+	updated = fetchExperiment(t, r, exp.Name, exp.Namespace)
+	assert.NotEqual(t, phaseFailed, updated.Status.Phase)
+	assert.Nil(t, meta.FindStatusCondition(updated.Status.Conditions, conditionInvalid))
+}
@@ -0,0 +1,176 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+const (
+	defaultCPUStressImage    = "alexeiled/stress-ng:latest-alpine"
+	defaultMemoryStressImage = "ghcr.io/neogan74/stress-ng:latest"
+	// defaultStressFallbackImage runs the built-in stressors; it is small and usually cached or mirrored
+	defaultStressFallbackImage = "busybox:1.36"
+)
+
+// imagePullWaitingReasons are the waiting reasons of a container whose image cannot be pulled
+var imagePullWaitingReasons = map[string]bool{
+	"ErrImagePull":      true,
+	"ImagePullBackOff":  true,
+	"InvalidImageName":  true,
+	"ErrImageNeverPull": true,
+}
+
+// imagePullError is an injected container stuck because its image cannot be pulled
+type imagePullError struct {
+	Pod       string
+	Container string
+	Image     string
+	// Reason and Message are the container's waiting state, such as ErrImagePull
+	Reason  string
+	Message string
+}
+
+func (e *imagePullError) Error() string {
+	msg := fmt.Sprintf("container %s in pod %s cannot pull image %s (%s)", e.Container, e.Pod, e.Image, e.Reason)
+	if e.Message != "" {
+		msg += ": " + e.Message
+	}
+	return msg
+}
+
+// stressImages returns the stress-ng image of an action and the image of the built-in fallback,
+// applying the controller's overrides
+func (r *ChaosExperimentReconciler) stressImages(defaultImage string) (string, string) {
+	image, fallback := defaultImage, defaultStressFallbackImage
+	if r.StressImage != "" {
+		image = r.StressImage
+	}
+	if r.StressFallbackImage != "" {
+		fallback = r.StressFallbackImage
+	}
+	return image, fallback
+}
+
+// ephemeralImagePullError returns the pull error of the named ephemeral container, or nil when
+// it is not waiting on its image
+func ephemeralImagePullError(pod *corev1.Pod, name string) *imagePullError {
+	for _, status := range pod.Status.EphemeralContainerStatuses {
+		if status.Name != name || status.State.Waiting == nil || !imagePullWaitingReasons[status.State.Waiting.Reason] {
+			continue
+		}
+		return &imagePullError{
+			Pod:       pod.Name,
+			Container: name,
+			Image:     status.Image,
+			Reason:    status.State.Waiting.Reason,
+			Message:   status.State.Waiting.Message,
+		}
+	}
+	return nil
+}
+
+// chooseStressImage picks the image of the next stress container in pod from the earlier ones
+// named with prefix. It is stressImage, or fallbackImage once a container could not pull
+// stressImage, in which case that pull error is returned as fellBack. When the fallback cannot be
+// pulled either, err is set so the experiment fails instead of waiting on the image forever.
+func chooseStressImage(pod *corev1.Pod, prefix, stressImage, fallbackImage string) (image string, fellBack, err *imagePullError) {
+	for _, ec := range pod.Spec.EphemeralContainers {
+		if !strings.HasPrefix(ec.Name, prefix) {
+			continue
+		}
+		pullErr := ephemeralImagePullError(pod, ec.Name)
+		if pullErr == nil {
+			continue
+		}
+		pullErr.Image = ec.Image
+		switch ec.Image {
+		case fallbackImage:
+			return "", nil, pullErr
+		case stressImage:
+			fellBack = pullErr
+		}
+	}
+	if fellBack != nil {
+		return fallbackImage, fellBack, nil
+	}
+	return stressImage, nil, nil
+}
+
+// builtinCPUStressScript keeps workers busy for load percent of every 100ms with BusyBox tools,
+// an approximation of stress-ng --cpu-load for when its image cannot be pulled
+func builtinCPUStressScript(load, workers, seconds int) string {
+	busy := load * 1000
+	idle := (100 - load) * 1000
+	return fmt.Sprintf(`end=$(( $(date +%%s) + %[3]d ))
+trap 'exit 0' TERM INT
+worker() {
+  while [ "$(date +%%s)" -lt "$end" ]; do
+    dd if=/dev/zero of=/dev/null bs=64k 2>/dev/null &
+    usleep %[1]d
+    kill $! 2>/dev/null
+    wait $! 2>/dev/null
+    [ %[2]d -eq 0 ] || usleep %[2]d
+  done
+}
+i=0
+while [ "$i" -lt %[4]d ]; do
+  worker &
+  i=$((i + 1))
+done
+wait
+`, busy, idle, seconds, workers)
+}
+
+// builtinMemoryStressScript holds size (as in spec.memorySize) per worker with BusyBox tools:
+// tail -c buffers all of the zeros it reads until its input ends after the duration
+func builtinMemoryStressScript(workers int, size string, seconds int) (string, error) {
+	bytes, err := memorySizeBytes(size)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf(`trap 'exit 0' TERM INT
+i=0
+while [ "$i" -lt %[3]d ]; do
+  { head -c %[1]d /dev/zero; sleep %[2]d; } | tail -c %[1]d > /dev/null &
+  i=$((i + 1))
+done
+wait
+`, bytes, seconds, workers), nil
+}
+
+// memorySizeBytes converts a spec.memorySize such as "256M" or "1G" to bytes
+func memorySizeBytes(size string) (int64, error) {
+	if len(size) < 2 {
+		return 0, fmt.Errorf("invalid memory size %q", size)
+	}
+	n, err := strconv.ParseInt(size[:len(size)-1], 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid memory size %q: %w", size, err)
+	}
+	switch size[len(size)-1] {
+	case 'M':
+		return n << 20, nil
+	case 'G':
+		return n << 30, nil
+	}
+	return 0, fmt.Errorf("invalid memory size %q: must end with M or G", size)
+}
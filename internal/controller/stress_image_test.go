@@ -0,0 +1,130 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"os/exec"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// stressPod returns a pod with earlier stress containers, each image mapped to its waiting reason
+func stressPod(images map[string]string) *corev1.Pod {
+	pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default"}}
+	for image, reason := range images {
+		name := "chaos-cpu-stress-" + image
+		pod.Spec.EphemeralContainers = append(pod.Spec.EphemeralContainers, corev1.EphemeralContainer{
+			EphemeralContainerCommon: corev1.EphemeralContainerCommon{Name: name, Image: image},
+		})
+		status := corev1.ContainerStatus{Name: name, Image: image}
+		if reason != "" {
+			status.State.Waiting = &corev1.ContainerStateWaiting{Reason: reason, Message: "not found"}
+		} else {
+			status.State.Running = &corev1.ContainerStateRunning{}
+		}
+		pod.Status.EphemeralContainerStatuses = append(pod.Status.EphemeralContainerStatuses, status)
+	}
+	return pod
+}
+
+func TestChooseStressImage(t *testing.T) {
+	const stress, fallback = "stress-ng", "busybox"
+
+	image, fellBack, err := chooseStressImage(stressPod(nil), "chaos-cpu-stress", stress, fallback)
+	assert.Equal(t, stress, image)
+	assert.Nil(t, fellBack)
+	assert.Nil(t, err)
+
+	// A stress container that started keeps the stress-ng image
+	image, fellBack, err = chooseStressImage(stressPod(map[string]string{stress: ""}), "chaos-cpu-stress", stress, fallback)
+	assert.Equal(t, stress, image)
+	assert.Nil(t, fellBack)
+	assert.Nil(t, err)
+
+	image, fellBack, err = chooseStressImage(stressPod(map[string]string{stress: "ImagePullBackOff"}), "chaos-cpu-stress", stress, fallback)
+	assert.Equal(t, fallback, image)
+	require.NotNil(t, fellBack)
+	assert.Equal(t, "ImagePullBackOff", fellBack.Reason)
+	assert.Equal(t, stress, fellBack.Image)
+	assert.Nil(t, err)
+
+	// Containers of another action are ignored
+	image, _, err = chooseStressImage(stressPod(map[string]string{stress: "ErrImagePull"}), "memory-stress", stress, fallback)
+	assert.Equal(t, stress, image)
+	assert.Nil(t, err)
+
+	_, _, err = chooseStressImage(stressPod(map[string]string{stress: "ErrImagePull", fallback: "ErrImagePull"}), "chaos-cpu-stress", stress, fallback)
+	require.NotNil(t, err)
+	assert.Equal(t, fallback, err.Image)
+	assert.Equal(t, "container chaos-cpu-stress-busybox in pod web cannot pull image busybox (ErrImagePull): not found", err.Error())
+}
+
+func TestStressImages_Overrides(t *testing.T) {
+	r := &ChaosExperimentReconciler{}
+	image, fallback := r.stressImages(defaultCPUStressImage)
+	assert.Equal(t, defaultCPUStressImage, image)
+	assert.Equal(t, defaultStressFallbackImage, fallback)
+
+	r.StressImage, r.StressFallbackImage = "mirror/stress-ng:1", "mirror/busybox:1"
+	image, fallback = r.stressImages(defaultMemoryStressImage)
+	assert.Equal(t, "mirror/stress-ng:1", image)
+	assert.Equal(t, "mirror/busybox:1", fallback)
+}
+
+func TestMemorySizeBytes(t *testing.T) {
+	n, err := memorySizeBytes("256M")
+	require.NoError(t, err)
+	assert.Equal(t, int64(256<<20), n)
+
+	n, err = memorySizeBytes("2G")
+	require.NoError(t, err)
+	assert.Equal(t, int64(2<<30), n)
+
+	for _, size := range []string{"", "M", "256", "256K", "xG"} {
+		_, err = memorySizeBytes(size)
+		assert.Error(t, err, size)
+	}
+}
+
+func TestBuiltinStressScripts_Valid(t *testing.T) {
+	requireValidShell(t, builtinCPUStressScript(80, 2, 30))
+	requireValidShell(t, builtinCPUStressScript(100, 1, 30))
+
+	script, err := builtinMemoryStressScript(2, "64M", 30)
+	require.NoError(t, err)
+	requireValidShell(t, script)
+
+	_, err = builtinMemoryStressScript(1, "64K", 30)
+	assert.Error(t, err)
+}
+
+func TestBuiltinMemoryStressScript_Exits(t *testing.T) {
+	script, err := builtinMemoryStressScript(2, "1M", 1)
+	require.NoError(t, err)
+
+	start := time.Now()
+	out, err := exec.Command("sh", "-c", script).CombinedOutput()
+	require.NoError(t, err, string(out))
+	// Workers hold their memory for the duration, then exit on their own
+	assert.GreaterOrEqual(t, time.Since(start), time.Second)
+	assert.Empty(t, string(out))
+}
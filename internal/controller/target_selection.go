@@ -0,0 +1,324 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	chaosv1alpha1 "github.com/neogan74/k8s-chaos/api/v1alpha1"
+)
+
+// Target selection strategies
+const (
+	strategyRandom        = "random"
+	strategyOldest        = "oldest"
+	strategyNewest        = "newest"
+	strategyHighestCPU    = "highest-cpu"
+	strategyHighestMemory = "highest-memory"
+	strategyOnePerNode    = "one-per-node"
+	strategyOnePerZone    = "one-per-zone"
+)
+
+// Failure domains and modes of spreadBy
+const (
+	spreadByZone          = "zone"
+	spreadByNode          = "node"
+	spreadByOwner         = "owner"
+	spreadModeConcentrate = "concentrate"
+)
+
+// podMetricsGVK is the metrics-server PodMetrics list kind, read as unstructured
+// so the controller does not need the k8s.io/metrics client.
+var podMetricsGVK = schema.GroupVersionKind{Group: "metrics.k8s.io", Version: "v1beta1", Kind: "PodMetricsList"}
+
+// orderTargetPods orders eligible pods so that the handler can take the first Count entries.
+// The base order is random, or a deterministic shuffle of the name-sorted pods when selectionSeed is set.
+// For pod-kill, pods with a lower controller.kubernetes.io/pod-deletion-cost are moved ahead of the others.
+// The selection strategy is then applied on top; ties keep the base order. spreadBy then arranges
+// the result across failure domains. With stickyTargets, pods
+// chosen by the previous run are moved to the front and the new selection is recorded in status.
+// The blast radius of the first Count pods is recorded in status as well.
+func (r *ChaosExperimentReconciler) orderTargetPods(ctx context.Context, exp *chaosv1alpha1.ChaosExperiment, pods []corev1.Pod) []corev1.Pod {
+	log := ctrl.LoggerFrom(ctx)
+
+	if exp.Spec.SelectionSeed != nil {
+		sort.Slice(pods, func(i, j int) bool {
+			return podKey(&pods[i]) < podKey(&pods[j])
+		})
+		rng := rand.New(rand.NewSource(*exp.Spec.SelectionSeed))
+		rng.Shuffle(len(pods), func(i, j int) {
+			pods[i], pods[j] = pods[j], pods[i]
+		})
+	} else {
+		rand.Shuffle(len(pods), func(i, j int) {
+			pods[i], pods[j] = pods[j], pods[i]
+		})
+	}
+
+	// Like a ReplicaSet scaling down, pod-kill takes the pods with the lowest deletion cost first
+	if honorsEvictionAnnotations(&exp.Spec) {
+		sortByDeletionCost(pods)
+	}
+
+	switch exp.Spec.SelectionStrategy {
+	case strategyOldest:
+		sort.SliceStable(pods, func(i, j int) bool {
+			return pods[i].CreationTimestamp.Before(&pods[j].CreationTimestamp)
+		})
+	case strategyNewest:
+		sort.SliceStable(pods, func(i, j int) bool {
+			return pods[j].CreationTimestamp.Before(&pods[i].CreationTimestamp)
+		})
+	case strategyHighestCPU, strategyHighestMemory:
+		usage, err := r.getPodUsage(ctx, exp.Spec.Namespace, exp.Spec.SelectionStrategy)
+		if err != nil {
+			// metrics-server may not be installed; fall back to the base order
+			log.Error(err, "Failed to read pod metrics, falling back to random selection",
+				"strategy", exp.Spec.SelectionStrategy)
+			break
+		}
+		sort.SliceStable(pods, func(i, j int) bool {
+			return usage[pods[i].Name] > usage[pods[j].Name]
+		})
+	case strategyOnePerNode:
+		pods = onePerGroup(pods, func(pod *corev1.Pod) string {
+			return pod.Spec.NodeName
+		})
+	case strategyOnePerZone:
+		zones, err := r.getNodeZones(ctx)
+		if err != nil {
+			log.Error(err, "Failed to read node zones, falling back to one pod per node")
+			zones = map[string]string{}
+		}
+		pods = onePerGroup(pods, func(pod *corev1.Pod) string {
+			if zone, ok := zones[pod.Spec.NodeName]; ok {
+				return zone
+			}
+			return pod.Spec.NodeName
+		})
+	}
+
+	if exp.Spec.SpreadBy != "" && len(pods) > 0 {
+		pods = r.spreadTargetPods(ctx, exp, pods)
+	}
+
+	count := exp.Spec.Count
+	if count <= 0 {
+		count = 1
+	}
+	if count > len(pods) {
+		count = len(pods)
+	}
+
+	if exp.Spec.StickyTargets {
+		recorded := exp.Status.SelectedTargets
+		if len(recorded) == 0 {
+			recorded = replayTargets(exp)
+		}
+		previous := make(map[string]bool, len(recorded))
+		for _, target := range recorded {
+			previous[target] = true
+		}
+		sort.SliceStable(pods, func(i, j int) bool {
+			return previous[podKey(&pods[i])] && !previous[podKey(&pods[j])]
+		})
+
+		selected := make([]string, 0, count)
+		for i := 0; i < count; i++ {
+			selected = append(selected, podKey(&pods[i]))
+		}
+		exp.Status.SelectedTargets = selected
+	}
+
+	exp.Status.BlastRadius = r.estimateBlastRadius(ctx, exp, pods[:count])
+
+	return pods
+}
+
+// replayTargets returns the pod keys from the replay-targets annotation set by `k8s-chaos rerun`
+func replayTargets(exp *chaosv1alpha1.ChaosExperiment) []string {
+	value := exp.Annotations[chaosv1alpha1.ReplayTargetsAnnotation]
+	if value == "" {
+		return nil
+	}
+	var targets []string
+	for _, target := range strings.Split(value, ",") {
+		if target = strings.TrimSpace(target); target != "" {
+			targets = append(targets, target)
+		}
+	}
+	return targets
+}
+
+// onePerGroup keeps the first pod of every group, preserving order
+func onePerGroup(pods []corev1.Pod, groupOf func(pod *corev1.Pod) string) []corev1.Pod {
+	seen := make(map[string]bool)
+	result := make([]corev1.Pod, 0, len(pods))
+	for i := range pods {
+		group := groupOf(&pods[i])
+		if seen[group] {
+			continue
+		}
+		seen[group] = true
+		result = append(result, pods[i])
+	}
+	return result
+}
+
+// spreadTargetPods reorders pods across the spreadBy failure domains, keeping the order within
+// each domain. spread interleaves the domains so the first targets all land in different ones;
+// concentrate keeps only the domain of the first pod, so every target shares it.
+func (r *ChaosExperimentReconciler) spreadTargetPods(ctx context.Context, exp *chaosv1alpha1.ChaosExperiment, pods []corev1.Pod) []corev1.Pod {
+	log := ctrl.LoggerFrom(ctx)
+
+	var domainOf func(pod *corev1.Pod) string
+	switch exp.Spec.SpreadBy {
+	case spreadByNode:
+		domainOf = func(pod *corev1.Pod) string {
+			return pod.Spec.NodeName
+		}
+	case spreadByZone:
+		zones, err := r.getNodeZones(ctx)
+		if err != nil {
+			log.Error(err, "Failed to read node zones, spreading by node instead")
+			zones = map[string]string{}
+		}
+		domainOf = func(pod *corev1.Pod) string {
+			if zone, ok := zones[pod.Spec.NodeName]; ok {
+				return zone
+			}
+			return pod.Spec.NodeName
+		}
+	case spreadByOwner:
+		domainOf = func(pod *corev1.Pod) string {
+			workload := workloadOf(pod)
+			return workload.kind + "/" + workload.name
+		}
+	default:
+		return pods
+	}
+
+	if exp.Spec.SpreadMode == spreadModeConcentrate {
+		domain := domainOf(&pods[0])
+		result := make([]corev1.Pod, 0, len(pods))
+		for i := range pods {
+			if domainOf(&pods[i]) == domain {
+				result = append(result, pods[i])
+			}
+		}
+		return result
+	}
+	return interleaveGroups(pods, domainOf)
+}
+
+// interleaveGroups orders pods round-robin across their groups: the first pod of every group,
+// then the second of every group, and so on. Groups take turns in order of first appearance.
+func interleaveGroups(pods []corev1.Pod, groupOf func(pod *corev1.Pod) string) []corev1.Pod {
+	var order []string
+	groups := make(map[string][]corev1.Pod)
+	for i := range pods {
+		group := groupOf(&pods[i])
+		if _, ok := groups[group]; !ok {
+			order = append(order, group)
+		}
+		groups[group] = append(groups[group], pods[i])
+	}
+
+	result := make([]corev1.Pod, 0, len(pods))
+	for round := 0; len(result) < len(pods); round++ {
+		for _, group := range order {
+			if round < len(groups[group]) {
+				result = append(result, groups[group][round])
+			}
+		}
+	}
+	return result
+}
+
+// getPodUsage returns the summed CPU (millicores) or memory (bytes) usage per pod name from metrics-server
+func (r *ChaosExperimentReconciler) getPodUsage(ctx context.Context, namespace, strategy string) (map[string]int64, error) {
+	metricsList := &unstructured.UnstructuredList{}
+	metricsList.SetGroupVersionKind(podMetricsGVK)
+	if err := r.List(ctx, metricsList, client.InNamespace(namespace)); err != nil {
+		return nil, fmt.Errorf("failed to list pod metrics: %w", err)
+	}
+
+	resourceName := "cpu"
+	if strategy == strategyHighestMemory {
+		resourceName = "memory"
+	}
+
+	usage := make(map[string]int64, len(metricsList.Items))
+	for _, item := range metricsList.Items {
+		containers, _, _ := unstructured.NestedSlice(item.Object, "containers")
+		var total int64
+		for _, c := range containers {
+			container, ok := c.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			value, found, _ := unstructured.NestedString(container, "usage", resourceName)
+			if !found {
+				continue
+			}
+			quantity, err := resource.ParseQuantity(value)
+			if err != nil {
+				continue
+			}
+			if resourceName == "cpu" {
+				total += quantity.MilliValue()
+			} else {
+				total += quantity.Value()
+			}
+		}
+		usage[item.GetName()] = total
+	}
+
+	return usage, nil
+}
+
+// getNodeZones maps node names to their topology zone label
+func (r *ChaosExperimentReconciler) getNodeZones(ctx context.Context) (map[string]string, error) {
+	nodeList := &corev1.NodeList{}
+	if err := r.List(ctx, nodeList); err != nil {
+		return nil, fmt.Errorf("failed to list nodes: %w", err)
+	}
+
+	zones := make(map[string]string, len(nodeList.Items))
+	for _, node := range nodeList.Items {
+		if zone, ok := node.Labels[corev1.LabelTopologyZone]; ok {
+			zones[node.Name] = zone
+		}
+	}
+	return zones, nil
+}
+
+// podKey returns the "namespace/name" key of a pod
+func podKey(pod *corev1.Pod) string {
+	return pod.Namespace + "/" + pod.Name
+}
@@ -0,0 +1,70 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	chaosv1alpha1 "github.com/neogan74/k8s-chaos/api/v1alpha1"
+)
+
+// isFinished reports whether the experiment reached a terminal phase
+func isFinished(exp *chaosv1alpha1.ChaosExperiment) bool {
+	return exp.Status.Phase == phaseCompleted || exp.Status.Phase == phaseFailed
+}
+
+// finishedAt returns when a finished experiment reached its terminal phase. Failed experiments
+// have no CompletedAt, so the last run time (or creation time) is used instead.
+func finishedAt(exp *chaosv1alpha1.ChaosExperiment) time.Time {
+	switch {
+	case exp.Status.CompletedAt != nil:
+		return exp.Status.CompletedAt.Time
+	case exp.Status.LastRunTime != nil:
+		return exp.Status.LastRunTime.Time
+	default:
+		return exp.CreationTimestamp.Time
+	}
+}
+
+// handleTTLAfterFinished deletes finished experiments whose ttlSecondsAfterFinished has elapsed.
+// It returns done=true when the experiment was deleted or is waiting for its TTL, in which case
+// the returned result should be used as-is.
+func (r *ChaosExperimentReconciler) handleTTLAfterFinished(ctx context.Context, exp *chaosv1alpha1.ChaosExperiment) (ctrl.Result, bool, error) {
+	if exp.Spec.TTLSecondsAfterFinished == nil || !isFinished(exp) {
+		return ctrl.Result{}, false, nil
+	}
+	log := ctrl.LoggerFrom(ctx)
+
+	ttl := time.Duration(*exp.Spec.TTLSecondsAfterFinished) * time.Second
+	expiresAt := finishedAt(exp).Add(ttl)
+	if remaining := time.Until(expiresAt); remaining > 0 {
+		return ctrl.Result{RequeueAfter: remaining}, true, nil
+	}
+
+	log.Info("Deleting finished experiment after TTL",
+		"phase", exp.Status.Phase,
+		"ttlSecondsAfterFinished", *exp.Spec.TTLSecondsAfterFinished)
+	if err := r.Delete(ctx, exp, client.PropagationPolicy("Background")); client.IgnoreNotFound(err) != nil {
+		log.Error(err, "Failed to delete finished experiment")
+		return ctrl.Result{}, true, err
+	}
+	return ctrl.Result{}, true, nil
+}
@@ -0,0 +1,76 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	chaosv1alpha1 "github.com/neogan74/k8s-chaos/api/v1alpha1"
+)
+
+func TestReconcile_TTLAfterFinished(t *testing.T) {
+	ctx := context.Background()
+
+	newFinished := func(name, phase string, finished time.Time, ttl *int32) *chaosv1alpha1.ChaosExperiment {
+		completedAt := metav1.NewTime(finished)
+		return &chaosv1alpha1.ChaosExperiment{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+			Spec: chaosv1alpha1.ChaosExperimentSpec{
+				Action:                  "pod-kill",
+				Namespace:               "default",
+				Selector:                map[string]string{"app": "demo"},
+				ExperimentDuration:      "1m",
+				TTLSecondsAfterFinished: ttl,
+			},
+			Status: chaosv1alpha1.ChaosExperimentStatus{
+				Phase:       phase,
+				CompletedAt: &completedAt,
+			},
+		}
+	}
+
+	expired := newFinished("expired", phaseCompleted, time.Now().Add(-2*time.Minute), ptr.To[int32](60))
+	pending := newFinished("pending", phaseFailed, time.Now(), ptr.To[int32](300))
+	noTTL := newFinished("no-ttl", phaseCompleted, time.Now().Add(-time.Hour), nil)
+
+	r := newReconcilerWithObjects(t, expired, pending, noTTL)
+
+	_, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: client.ObjectKeyFromObject(expired)})
+	require.NoError(t, err)
+	err = r.Get(ctx, client.ObjectKeyFromObject(expired), &chaosv1alpha1.ChaosExperiment{})
+	assert.True(t, apierrors.IsNotFound(err), "expired experiment should be deleted")
+
+	result, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: client.ObjectKeyFromObject(pending)})
+	require.NoError(t, err)
+	assert.Greater(t, result.RequeueAfter, 4*time.Minute)
+	assert.LessOrEqual(t, result.RequeueAfter, 5*time.Minute)
+	fetchExperiment(t, r, pending.Name, pending.Namespace)
+
+	_, err = r.Reconcile(ctx, ctrl.Request{NamespacedName: client.ObjectKeyFromObject(noTTL)})
+	require.NoError(t, err)
+	fetchExperiment(t, r, noTTL.Name, noTTL.Namespace)
+}
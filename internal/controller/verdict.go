@@ -0,0 +1,228 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	chaosv1alpha1 "github.com/neogan74/k8s-chaos/api/v1alpha1"
+	"github.com/neogan74/k8s-chaos/internal/signing"
+	"github.com/neogan74/k8s-chaos/internal/verdict"
+)
+
+// recoveryWaitInterval is how often a completed experiment checks whether the recovery of its
+// targets was measured before its successCriteria are judged
+const recoveryWaitInterval = 15 * time.Second
+
+// countRecovery counts a target injected by exp in status.recovery and returns the experiment
+// the tracker adds its outcome to. Nothing is counted when exp has no successCriteria.
+func countRecovery(exp *chaosv1alpha1.ChaosExperiment) recoveryExperiment {
+	if exp == nil || len(exp.Spec.SuccessCriteria) == 0 {
+		return recoveryExperiment{}
+	}
+	if exp.Status.Recovery == nil {
+		exp.Status.Recovery = &chaosv1alpha1.RecoveryStatus{}
+	}
+	exp.Status.Recovery.Tracked++
+	return recoveryExperiment{key: client.ObjectKeyFromObject(exp), uid: exp.UID}
+}
+
+// countAffected adds the targets an injection round of exp affected to status.recovery. Unlike
+// status.affectedPods it is not cleared when the injections are reverted, so the verdict still
+// sees them. Nothing is counted when exp has no successCriteria.
+func countAffected(exp *chaosv1alpha1.ChaosExperiment, affected int) {
+	if len(exp.Spec.SuccessCriteria) == 0 || affected <= 0 {
+		return
+	}
+	if exp.Status.Recovery == nil {
+		exp.Status.Recovery = &chaosv1alpha1.RecoveryStatus{}
+	}
+	exp.Status.Recovery.Affected += int32(affected)
+}
+
+// handleVerdict judges spec.successCriteria of a completed experiment once the "after" point of
+// metricsQueries was sampled and the recovery of its targets measured, stores status.verdict and
+// adds it to the execution's history record. Targets still pending recoveryTimeout after completion,
+// such as those a restarted controller no longer tracks, count as unrecovered. result is the
+// outcome of handleCompletedMetrics; its requeue is kept.
+func (r *ChaosExperimentReconciler) handleVerdict(ctx context.Context, exp *chaosv1alpha1.ChaosExperiment, result ctrl.Result) (ctrl.Result, error) {
+	if len(exp.Spec.SuccessCriteria) == 0 || exp.Status.Phase != phaseCompleted ||
+		exp.Status.CompletedAt == nil || exp.Status.Verdict != nil {
+		return result, nil
+	}
+	log := ctrl.LoggerFrom(ctx)
+
+	_, aborted := exp.Annotations[chaosv1alpha1.AbortAnnotation]
+	if !aborted {
+		wait := time.Until(exp.Status.CompletedAt.Add(metricsSettleDelay))
+		if wait <= 0 && exp.Status.Recovery.Pending() > 0 &&
+			time.Until(exp.Status.CompletedAt.Add(recoveryTimeout)) > 0 {
+			wait = recoveryWaitInterval
+		}
+		if wait > 0 {
+			if result.RequeueAfter == 0 || wait < result.RequeueAfter {
+				result.RequeueAfter = wait
+			}
+			return result, nil
+		}
+	}
+
+	exp.Status.Verdict = r.judge(exp, aborted)
+	if err := r.Status().Update(ctx, exp); err != nil {
+		log.Error(err, "Failed to update experiment verdict")
+		return ctrl.Result{}, err
+	}
+
+	eventType := corev1.EventTypeNormal
+	if exp.Status.Verdict.Result == chaosv1alpha1.VerdictFailed {
+		eventType = corev1.EventTypeWarning
+	}
+	r.Recorder.Event(exp, eventType, "Verdict"+exp.Status.Verdict.Result, exp.Status.Verdict.Message)
+	log.Info("Judged success criteria", "verdict", exp.Status.Verdict.Result, "message", exp.Status.Verdict.Message)
+
+	if err := r.recordVerdict(ctx, exp); err != nil {
+		log.Error(err, "Failed to record verdict in history")
+	}
+	return result, nil
+}
+
+// recordVerdict adds status.verdict of exp to the history record of its latest execution, which
+// was written when it completed. Nothing is recorded when that execution was not sampled.
+func (r *ChaosExperimentReconciler) recordVerdict(ctx context.Context, exp *chaosv1alpha1.ChaosExperiment) error {
+	if !r.HistoryConfig.Enabled {
+		return nil
+	}
+	historyNamespace := r.HistoryConfig.Namespace
+	if historyNamespace == "" {
+		historyNamespace = exp.Namespace
+	}
+
+	historyList := &chaosv1alpha1.ChaosExperimentHistoryList{}
+	if err := r.List(ctx, historyList,
+		client.InNamespace(historyNamespace),
+		client.MatchingLabels{"chaos.gushchin.dev/experiment-uid": string(exp.UID)},
+	); err != nil {
+		return fmt.Errorf("failed to list history records: %w", err)
+	}
+	var latest *chaosv1alpha1.ChaosExperimentHistory
+	for i := range historyList.Items {
+		record := &historyList.Items[i]
+		if latest == nil || latest.Spec.Execution.StartTime.Before(&record.Spec.Execution.StartTime) {
+			latest = record
+		}
+	}
+	if latest == nil {
+		ctrl.LoggerFrom(ctx).V(1).Info("No history record to add the verdict to")
+		return nil
+	}
+
+	latest.Spec.Verdict = exp.Status.Verdict
+	if latest.Labels == nil {
+		latest.Labels = map[string]string{}
+	}
+	latest.Labels["chaos.gushchin.dev/verdict"] = exp.Status.Verdict.Result
+	if r.HistoryConfig.SigningKey != nil {
+		if err := signing.Sign(r.HistoryConfig.SigningKey, latest); err != nil {
+			return fmt.Errorf("failed to sign history record: %w", err)
+		}
+	}
+	return r.Update(ctx, latest)
+}
+
+// judge evaluates every success criterion of exp; aborted experiments fail without evaluation
+func (r *ChaosExperimentReconciler) judge(exp *chaosv1alpha1.ChaosExperiment, aborted bool) *chaosv1alpha1.Verdict {
+	v := &chaosv1alpha1.Verdict{
+		Result:      chaosv1alpha1.VerdictFailed,
+		EvaluatedAt: metav1.Now(),
+	}
+	if aborted {
+		v.Message = "Experiment was aborted"
+		return v
+	}
+
+	in := r.verdictInputs(exp)
+	v.Observed = map[string]string{
+		"affected":          strconv.FormatInt(in.Affected, 10),
+		"recovered":         strconv.FormatInt(in.Recovered, 10),
+		"unrecovered":       strconv.FormatInt(in.Unrecovered, 10),
+		"recoverySeconds":   strconv.FormatFloat(in.RecoverySeconds, 'g', -1, 64),
+		"failedTargets":     strconv.FormatInt(in.FailedTargets, 10),
+		"unverifiedTargets": strconv.FormatInt(in.UnverifiedTargets, 10),
+	}
+
+	var failed []string
+	for _, criterion := range exp.Spec.SuccessCriteria {
+		res := verdict.Evaluate(criterion.Expression, in)
+		v.Criteria = append(v.Criteria, chaosv1alpha1.CriterionResult{
+			Name:    criterion.Name,
+			Passed:  res.Passed,
+			Message: res.Message,
+		})
+		if !res.Passed {
+			failed = append(failed, criterion.Name)
+		}
+	}
+	if len(failed) > 0 {
+		v.Message = fmt.Sprintf("%d of %d criteria failed: %s", len(failed), len(exp.Spec.SuccessCriteria), strings.Join(failed, ", "))
+		return v
+	}
+	v.Result = chaosv1alpha1.VerdictPassed
+	v.Message = fmt.Sprintf("All %d criteria passed", len(exp.Spec.SuccessCriteria))
+	return v
+}
+
+// verdictInputs gathers what successCriteria are evaluated with from the status of exp
+func (r *ChaosExperimentReconciler) verdictInputs(exp *chaosv1alpha1.ChaosExperiment) verdict.Inputs {
+	in := verdict.Inputs{Metrics: map[string]map[string]float64{}}
+	for _, sample := range exp.Status.Metrics {
+		points := map[string]float64{}
+		for point, value := range map[string]string{"before": sample.Before, "during": sample.During, "after": sample.After} {
+			if f, err := strconv.ParseFloat(value, 64); err == nil {
+				points[point] = f
+			}
+		}
+		in.Metrics[sample.Name] = points
+	}
+
+	if recovery := exp.Status.Recovery; recovery != nil {
+		in.Affected = int64(recovery.Affected)
+		in.Recovered = int64(recovery.Recovered)
+		in.Unrecovered = int64(recovery.TimedOut + recovery.Pending())
+		if slowest, err := time.ParseDuration(recovery.Slowest); err == nil {
+			in.RecoverySeconds = slowest.Seconds()
+		}
+	}
+
+	for _, target := range exp.Status.TargetResults {
+		if target.State == targetFailed {
+			in.FailedTargets++
+		}
+		if target.Verification == injectionUnverified {
+			in.UnverifiedTargets++
+		}
+	}
+	return in
+}
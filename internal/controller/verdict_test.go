@@ -0,0 +1,252 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	chaosv1alpha1 "github.com/neogan74/k8s-chaos/api/v1alpha1"
+	"github.com/neogan74/k8s-chaos/internal/signing"
+)
+
+func verdictExperiment(completedAt time.Time, criteria ...chaosv1alpha1.SuccessCriterion) *chaosv1alpha1.ChaosExperiment {
+	exp := metricsExperiment(completedAt.Add(-10*time.Minute), completedAt)
+	exp.UID = "verdict-uid"
+	exp.Spec.SuccessCriteria = criteria
+	exp.Status.Metrics = []chaosv1alpha1.MetricSample{{Name: "error-rate", Before: "0.01", During: "0.2", After: "0.01"}}
+	return exp
+}
+
+func TestHandleVerdict(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("waits for the settle delay", func(t *testing.T) {
+		exp := verdictExperiment(time.Now().Add(-10*time.Second),
+			chaosv1alpha1.SuccessCriterion{Name: "settled", Expression: `metrics["error-rate"]["after"] < 0.05`})
+		r := newReconcilerWithObjects(t, exp)
+
+		result, err := r.handleVerdict(ctx, exp, ctrl.Result{})
+		require.NoError(t, err)
+		assert.Greater(t, result.RequeueAfter, time.Duration(0))
+		assert.LessOrEqual(t, result.RequeueAfter, metricsSettleDelay)
+		assert.Nil(t, fetchExperiment(t, r, "latency", "default").Status.Verdict)
+	})
+
+	t.Run("passes when every criterion holds", func(t *testing.T) {
+		exp := verdictExperiment(time.Now().Add(-2*metricsSettleDelay),
+			chaosv1alpha1.SuccessCriterion{Name: "settled", Expression: `metrics["error-rate"]["after"] < 0.05`},
+			chaosv1alpha1.SuccessCriterion{Name: "all-recovered", Expression: `recovered == affected && recoverySeconds < 60.0`})
+		execution := &chaosv1alpha1.ChaosExperimentHistory{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "latency-execution",
+				Namespace: "chaos-system",
+				Labels:    map[string]string{"chaos.gushchin.dev/experiment-uid": string(exp.UID)},
+			},
+			Spec: chaosv1alpha1.ChaosExperimentHistorySpec{
+				Execution: chaosv1alpha1.ExecutionDetails{Status: statusSuccess, StartTime: *exp.Status.StartTime},
+			},
+		}
+		r := newReconcilerWithObjects(t, exp, execution)
+		r.HistoryConfig.SigningKey = &signing.Key{Algorithm: signing.AlgorithmHMAC, HMACSecret: []byte("secret")}
+		exp.Status.Recovery = &chaosv1alpha1.RecoveryStatus{Affected: 2, Tracked: 2, Recovered: 2, Slowest: "20s"}
+
+		_, err := r.handleVerdict(ctx, exp, ctrl.Result{})
+		require.NoError(t, err)
+
+		v := fetchExperiment(t, r, "latency", "default").Status.Verdict
+		require.NotNil(t, v)
+		assert.Equal(t, chaosv1alpha1.VerdictPassed, v.Result)
+		assert.Equal(t, "All 2 criteria passed", v.Message)
+		assert.Equal(t, "2", v.Observed["recovered"])
+		assert.Equal(t, "20", v.Observed["recoverySeconds"])
+
+		// The verdict is added to the execution's record rather than recorded as another run
+		var histories chaosv1alpha1.ChaosExperimentHistoryList
+		require.NoError(t, r.List(ctx, &histories))
+		require.Len(t, histories.Items, 1)
+		record := &histories.Items[0]
+		assert.Equal(t, "latency-execution", record.Name)
+		assert.Equal(t, chaosv1alpha1.VerdictPassed, record.Labels["chaos.gushchin.dev/verdict"])
+		require.NotNil(t, record.Spec.Verdict)
+		assert.Equal(t, chaosv1alpha1.VerdictPassed, record.Spec.Verdict.Result)
+		assert.NoError(t, signing.Verify(r.HistoryConfig.SigningKey, record))
+	})
+
+	t.Run("waits for pending recoveries up to the recovery timeout", func(t *testing.T) {
+		exp := verdictExperiment(time.Now().Add(-2*metricsSettleDelay),
+			chaosv1alpha1.SuccessCriterion{Name: "all-recovered", Expression: `unrecovered == 0`})
+		exp.Status.Recovery = &chaosv1alpha1.RecoveryStatus{Tracked: 2, Recovered: 1}
+		r := newReconcilerWithObjects(t, exp)
+
+		result, err := r.handleVerdict(ctx, exp, ctrl.Result{})
+		require.NoError(t, err)
+		assert.Equal(t, recoveryWaitInterval, result.RequeueAfter)
+		assert.Nil(t, fetchExperiment(t, r, "latency", "default").Status.Verdict)
+
+		// A target nobody measures any more, e.g. after a restart, is unrecovered once it timed out
+		exp = verdictExperiment(time.Now().Add(-recoveryTimeout-time.Minute),
+			chaosv1alpha1.SuccessCriterion{Name: "all-recovered", Expression: `unrecovered == 0`})
+		exp.Status.Recovery = &chaosv1alpha1.RecoveryStatus{Tracked: 2, Recovered: 1}
+		r = newReconcilerWithObjects(t, exp)
+
+		_, err = r.handleVerdict(ctx, exp, ctrl.Result{})
+		require.NoError(t, err)
+		v := fetchExperiment(t, r, "latency", "default").Status.Verdict
+		require.NotNil(t, v)
+		assert.Equal(t, chaosv1alpha1.VerdictFailed, v.Result)
+		assert.Equal(t, "1", v.Observed["unrecovered"])
+	})
+
+	t.Run("fails on a false or erroring criterion", func(t *testing.T) {
+		exp := verdictExperiment(time.Now().Add(-2*metricsSettleDelay),
+			chaosv1alpha1.SuccessCriterion{Name: "during", Expression: `metrics["error-rate"]["during"] < 0.05`},
+			chaosv1alpha1.SuccessCriterion{Name: "missing", Expression: `metrics["latency"]["after"] < 1.0`},
+			chaosv1alpha1.SuccessCriterion{Name: "unrecovered", Expression: `unrecovered == 0`})
+		r := newReconcilerWithObjects(t, exp)
+
+		_, err := r.handleVerdict(ctx, exp, ctrl.Result{})
+		require.NoError(t, err)
+
+		v := fetchExperiment(t, r, "latency", "default").Status.Verdict
+		require.NotNil(t, v)
+		assert.Equal(t, chaosv1alpha1.VerdictFailed, v.Result)
+		assert.Equal(t, "2 of 3 criteria failed: during, missing", v.Message)
+		require.Len(t, v.Criteria, 3)
+		assert.Equal(t, "expression is false", v.Criteria[0].Message)
+		assert.NotEmpty(t, v.Criteria[1].Message)
+		assert.True(t, v.Criteria[2].Passed)
+	})
+
+	t.Run("aborted experiments fail right away", func(t *testing.T) {
+		exp := verdictExperiment(time.Now(),
+			chaosv1alpha1.SuccessCriterion{Name: "settled", Expression: `true`})
+		exp.Annotations = map[string]string{chaosv1alpha1.AbortAnnotation: "alice"}
+		r := newReconcilerWithObjects(t, exp)
+
+		_, err := r.handleVerdict(ctx, exp, ctrl.Result{})
+		require.NoError(t, err)
+
+		v := fetchExperiment(t, r, "latency", "default").Status.Verdict
+		require.NotNil(t, v)
+		assert.Equal(t, chaosv1alpha1.VerdictFailed, v.Result)
+		assert.Equal(t, "Experiment was aborted", v.Message)
+		assert.Empty(t, v.Criteria)
+	})
+
+	t.Run("without criteria nothing is judged", func(t *testing.T) {
+		exp := verdictExperiment(time.Now().Add(-2 * metricsSettleDelay))
+		r := newReconcilerWithObjects(t, exp)
+
+		result, err := r.handleVerdict(ctx, exp, ctrl.Result{RequeueAfter: time.Minute})
+		require.NoError(t, err)
+		assert.Equal(t, time.Minute, result.RequeueAfter)
+		assert.Nil(t, fetchExperiment(t, r, "latency", "default").Status.Verdict)
+	})
+}
+
+func TestHandleVerdict_CountsRevertedTargets(t *testing.T) {
+	ctx := context.Background()
+	nodes := []client.Object{
+		&corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "worker-1", Labels: map[string]string{"pool": "chaos"}}},
+		&corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "worker-2", Labels: map[string]string{"pool": "chaos"}}},
+	}
+	exp := &chaosv1alpha1.ChaosExperiment{
+		ObjectMeta: metav1.ObjectMeta{Name: "taint", Namespace: "default", UID: "taint-uid"},
+		Spec: chaosv1alpha1.ChaosExperimentSpec{
+			Action:             "node-taint",
+			Namespace:          "default",
+			Selector:           map[string]string{"pool": "chaos"},
+			Count:              2,
+			TaintKey:           "chaos",
+			TaintEffect:        "NoSchedule",
+			ExperimentDuration: "10m",
+			SuccessCriteria: []chaosv1alpha1.SuccessCriterion{
+				{Name: "both-nodes", Expression: `affected == 2`},
+			},
+		},
+		Status: chaosv1alpha1.ChaosExperimentStatus{
+			Phase:     phaseRunning,
+			StartTime: &metav1.Time{Time: time.Now().Add(-11 * time.Minute)},
+		},
+	}
+	r := newReconcilerWithObjects(t, append(nodes, exp)...)
+
+	_, err := r.handleNodeTaint(ctx, exp)
+	require.NoError(t, err)
+	require.Len(t, exp.Status.TaintedNodes, 2)
+
+	// The duration has run out: the taints are removed and the experiment completes
+	running, err := r.checkExperimentLifecycle(ctx, exp)
+	require.NoError(t, err)
+	assert.False(t, running)
+	assert.Equal(t, phaseCompleted, exp.Status.Phase)
+	assert.Empty(t, exp.Status.TaintedNodes)
+
+	exp.Status.CompletedAt = &metav1.Time{Time: time.Now().Add(-2 * metricsSettleDelay)}
+	_, err = r.handleVerdict(ctx, exp, ctrl.Result{})
+	require.NoError(t, err)
+
+	v := fetchExperiment(t, r, "taint", "default").Status.Verdict
+	require.NotNil(t, v)
+	assert.Equal(t, chaosv1alpha1.VerdictPassed, v.Result)
+	assert.Equal(t, "2", v.Observed["affected"])
+}
+
+func TestRecoveryTrackerStatus(t *testing.T) {
+	ctx := context.Background()
+	injectedAt := time.Now().Add(-time.Minute).Truncate(time.Second)
+	exp := verdictExperiment(time.Now(), chaosv1alpha1.SuccessCriterion{Name: "all-recovered", Expression: `unrecovered == 0`})
+	exp.Status.Recovery = &chaosv1alpha1.RecoveryStatus{Tracked: 1, Recovered: 1, Slowest: "5s"}
+	ready := recoveryTestPod("web-1", "uid-1", "rs-uid", injectedAt.Add(-time.Hour), nil)
+	readyAt := injectedAt.Add(8 * time.Second)
+	ready.Status.ContainerStatuses = []corev1.ContainerStatus{{
+		Name: "app", RestartCount: 1, Ready: true,
+		State: corev1.ContainerState{Running: &corev1.ContainerStateRunning{StartedAt: metav1.NewTime(readyAt)}},
+	}}
+	stuck := recoveryTestPod("web-2", "uid-2", "rs-uid", injectedAt.Add(-time.Hour), nil)
+	r := newReconcilerWithObjects(t, exp, ready, stuck)
+	r.recovery = newRecoveryTracker(r.Client)
+
+	r.trackContainerRestart(exp, "pod-failure", "recovery-status", ready, "app", 0, injectedAt)
+	r.trackContainerRestart(exp, "pod-failure", "recovery-status", stuck, "app", 0, injectedAt)
+	r.trackContainerRestart(nil, "pod-failure", "recovery-status", stuck, "app", 0, injectedAt)
+	assert.Equal(t, int32(3), exp.Status.Recovery.Tracked, "the injecting experiment counts its targets")
+	require.NoError(t, r.Status().Update(ctx, exp))
+
+	r.recovery.check(ctx, time.Now())
+
+	recovery := fetchExperiment(t, r, "latency", "default").Status.Recovery
+	require.NotNil(t, recovery)
+	assert.Equal(t, chaosv1alpha1.RecoveryStatus{Tracked: 3, Recovered: 2, Slowest: "8s"}, *recovery)
+	assert.Equal(t, int32(1), recovery.Pending())
+
+	// The stuck target of the experiment times out, the one without an experiment is not counted
+	r.recovery.check(ctx, injectedAt.Add(recoveryTimeout+time.Second))
+
+	recovery = fetchExperiment(t, r, "latency", "default").Status.Recovery
+	assert.Equal(t, chaosv1alpha1.RecoveryStatus{Tracked: 3, Recovered: 2, TimedOut: 1, Slowest: "8s"}, *recovery)
+	assert.Zero(t, recovery.Pending())
+}
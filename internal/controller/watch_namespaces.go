@@ -0,0 +1,113 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	chaosv1alpha1 "github.com/neogan74/k8s-chaos/api/v1alpha1"
+)
+
+// WatchedNamespaces returns the namespaces to restrict the manager's cache to: allowlist plus,
+// with fromPolicies, the namespaces named by ChaosPolicies. Policies without namespaces apply
+// everywhere and select none. An empty result means every namespace is watched.
+func WatchedNamespaces(ctx context.Context, reader client.Reader, allowlist []string, fromPolicies bool) ([]string, error) {
+	namespaces := map[string]bool{}
+	for _, namespace := range allowlist {
+		if namespace = strings.TrimSpace(namespace); namespace != "" {
+			namespaces[namespace] = true
+		}
+	}
+	if fromPolicies {
+		policies := &chaosv1alpha1.ChaosPolicyList{}
+		if err := reader.List(ctx, policies); err != nil {
+			return nil, fmt.Errorf("failed to list ChaosPolicies: %w", err)
+		}
+		for _, policy := range policies.Items {
+			for _, namespace := range policy.Spec.Namespaces {
+				namespaces[namespace] = true
+			}
+		}
+	}
+
+	watched := make([]string, 0, len(namespaces))
+	for namespace := range namespaces {
+		watched = append(watched, namespace)
+	}
+	sort.Strings(watched)
+	return watched, nil
+}
+
+// RestrictCacheToNamespaces limits the namespaced objects opts caches to namespaces. The chaos
+// resources stay cached in every namespace, since experiments and their history usually live
+// apart from the namespaces they target. An empty namespaces leaves opts unchanged.
+func RestrictCacheToNamespaces(opts *cache.Options, namespaces []string) {
+	if len(namespaces) == 0 {
+		return
+	}
+	opts.DefaultNamespaces = map[string]cache.Config{}
+	for _, namespace := range namespaces {
+		opts.DefaultNamespaces[namespace] = cache.Config{}
+	}
+	if opts.ByObject == nil {
+		opts.ByObject = map[client.Object]cache.ByObject{}
+	}
+	everywhere := map[string]cache.Config{cache.AllNamespaces: {}}
+	for _, obj := range []client.Object{
+		&chaosv1alpha1.ChaosCleanupTask{},
+		&chaosv1alpha1.ChaosExperiment{},
+		&chaosv1alpha1.ChaosExperimentHistory{},
+		&chaosv1alpha1.ChaosMonkey{},
+	} {
+		opts.ByObject[obj] = cache.ByObject{Namespaces: everywhere}
+	}
+}
+
+// watchesNamespace reports whether the cache restricted to watched holds the objects of
+// namespace; an empty watched means every namespace
+func watchesNamespace(watched []string, namespace string) bool {
+	return len(watched) == 0 || slices.Contains(watched, namespace)
+}
+
+// unwatchedNamespaceErrors rejects experiments targeting namespaces outside WatchNamespaces:
+// the cache holds none of their pods, so every lookup would fail
+func (r *ChaosExperimentReconciler) unwatchedNamespaceErrors(spec *chaosv1alpha1.ChaosExperimentSpec) []chaosv1alpha1.ValidationError {
+	var errs []chaosv1alpha1.ValidationError
+	if !watchesNamespace(r.WatchNamespaces, spec.Namespace) {
+		errs = append(errs, chaosv1alpha1.ValidationError{
+			Field: "spec.namespace",
+			Message: fmt.Sprintf("namespace %s is not watched by the controller; add it to "+
+				"--watch-namespaces or a ChaosPolicy and restart the controller", spec.Namespace),
+		})
+	}
+	for _, namespace := range spec.PeerNamespaces {
+		if !watchesNamespace(r.WatchNamespaces, namespace) {
+			errs = append(errs, chaosv1alpha1.ValidationError{
+				Field:   "spec.peerNamespaces",
+				Message: fmt.Sprintf("namespace %s is not watched by the controller", namespace),
+			})
+		}
+	}
+	return errs
+}
@@ -0,0 +1,107 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	chaosv1alpha1 "github.com/neogan74/k8s-chaos/api/v1alpha1"
+)
+
+func TestWatchedNamespaces(t *testing.T) {
+	ctx := context.Background()
+	r := newReconcilerWithObjects(t,
+		&chaosv1alpha1.ChaosPolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: "payments"},
+			Spec:       chaosv1alpha1.ChaosPolicySpec{Namespaces: []string{"payments", "checkout"}},
+		},
+		// Applies everywhere without enabling any namespace
+		&chaosv1alpha1.ChaosPolicy{ObjectMeta: metav1.ObjectMeta{Name: "global"}},
+	)
+
+	watched, err := WatchedNamespaces(ctx, r.Client, []string{"staging", " checkout", ""}, false)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"checkout", "staging"}, watched)
+
+	watched, err = WatchedNamespaces(ctx, r.Client, []string{"staging"}, true)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"checkout", "payments", "staging"}, watched)
+
+	watched, err = WatchedNamespaces(ctx, r.Client, []string{""}, false)
+	require.NoError(t, err)
+	assert.Empty(t, watched)
+}
+
+func TestRestrictCacheToNamespaces(t *testing.T) {
+	opts := cache.Options{}
+	RestrictCacheToNamespaces(&opts, nil)
+	assert.Nil(t, opts.DefaultNamespaces)
+
+	pods := &corev1.Pod{}
+	opts.ByObject = map[client.Object]cache.ByObject{pods: {}}
+	RestrictCacheToNamespaces(&opts, []string{"apps", "payments"})
+	assert.Equal(t, map[string]cache.Config{"apps": {}, "payments": {}}, opts.DefaultNamespaces)
+	// The pod cache keeps its own options and inherits the default namespaces
+	assert.Contains(t, opts.ByObject, client.Object(pods))
+	assert.Nil(t, opts.ByObject[pods].Namespaces)
+	for obj, byObject := range opts.ByObject {
+		if obj == client.Object(pods) {
+			continue
+		}
+		assert.Contains(t, byObject.Namespaces, cache.AllNamespaces, "%T", obj)
+	}
+	assert.Len(t, opts.ByObject, 5)
+}
+
+func TestReconcile_UnwatchedNamespaceFails(t *testing.T) {
+	ctx := context.Background()
+	exp := &chaosv1alpha1.ChaosExperiment{
+		ObjectMeta: metav1.ObjectMeta{Name: "kill-web", Namespace: "chaos"},
+		Spec: chaosv1alpha1.ChaosExperimentSpec{
+			Action:    "pod-kill",
+			Namespace: "web",
+			Selector:  map[string]string{"app": "web"},
+		},
+	}
+
+	r := newReconcilerWithObjects(t, exp)
+	r.WatchNamespaces = []string{"apps"}
+	_, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: client.ObjectKeyFromObject(exp)})
+	require.NoError(t, err)
+
+	updated := fetchExperiment(t, r, exp.Name, exp.Namespace)
+	assert.Equal(t, phaseFailed, updated.Status.Phase)
+	assert.True(t, meta.IsStatusConditionTrue(updated.Status.Conditions, conditionInvalid))
+	assert.Contains(t, updated.Status.Message, "spec.namespace: namespace web is not watched by the controller")
+
+	r.WatchNamespaces = []string{"apps", "web"}
+	assert.Empty(t, r.unwatchedNamespaceErrors(&updated.Spec))
+	updated.Spec.PeerNamespaces = []string{"db"}
+	errs := r.unwatchedNamespaceErrors(&updated.Spec)
+	require.Len(t, errs, 1)
+	assert.Equal(t, "spec.peerNamespaces", errs[0].Field)
+}
@@ -0,0 +1,186 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package diagnostics serves pprof profiles and expvar runtime state of the controller for
+// support requests. The endpoint is disabled unless a bind address is configured.
+package diagnostics
+
+import (
+	"context"
+	"errors"
+	"expvar"
+	"net"
+	"net/http"
+	"net/http/pprof"
+	"runtime"
+	"runtime/debug"
+	"sync"
+	"time"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// Version is the controller version, set at build time with
+// -ldflags "-X github.com/neogan74/k8s-chaos/internal/diagnostics.Version=v1.2.3"
+var Version = "dev"
+
+// VarName is the expvar variable holding the controller state
+const VarName = "k8sChaos"
+
+// BuildInfo describes the running binary
+type BuildInfo struct {
+	Version   string `json:"version"`
+	Revision  string `json:"revision,omitempty"`
+	GoVersion string `json:"goVersion"`
+}
+
+// Build returns the version and VCS revision of the running binary
+func Build() BuildInfo {
+	info := BuildInfo{Version: Version, GoVersion: runtime.Version()}
+	if bi, ok := debug.ReadBuildInfo(); ok {
+		for _, s := range bi.Settings {
+			if s.Key == "vcs.revision" {
+				info.Revision = s.Value
+			}
+		}
+	}
+	return info
+}
+
+// ReconcileError is a failed reconcile of one experiment
+type ReconcileError struct {
+	Experiment string    `json:"experiment"`
+	Error      string    `json:"error"`
+	Time       time.Time `json:"time"`
+}
+
+// ErrorLog keeps the most recent reconcile errors
+type ErrorLog struct {
+	mu      sync.Mutex
+	size    int
+	entries []ReconcileError
+}
+
+// NewErrorLog creates an ErrorLog holding up to size entries
+func NewErrorLog(size int) *ErrorLog {
+	return &ErrorLog{size: size}
+}
+
+// Record adds an error, dropping the oldest one when the log is full
+func (l *ErrorLog) Record(experiment string, err error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.entries = append(l.entries, ReconcileError{Experiment: experiment, Error: err.Error(), Time: time.Now()})
+	if len(l.entries) > l.size {
+		l.entries = l.entries[len(l.entries)-l.size:]
+	}
+}
+
+// Entries returns the recorded errors, oldest first
+func (l *ErrorLog) Entries() []ReconcileError {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return append([]ReconcileError(nil), l.entries...)
+}
+
+// Wrap records the errors returned by r
+func (l *ErrorLog) Wrap(r reconcile.Reconciler) reconcile.Reconciler {
+	return reconcile.Func(func(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+		result, err := r.Reconcile(ctx, req)
+		if err != nil {
+			l.Record(req.String(), err)
+		}
+		return result, err
+	})
+}
+
+// State is published as the VarName expvar variable
+type State struct {
+	Build           BuildInfo         `json:"build"`
+	StartTime       time.Time         `json:"startTime"`
+	Config          map[string]string `json:"config"`
+	ReconcileErrors []ReconcileError  `json:"reconcileErrors"`
+}
+
+// Publish registers the controller state with expvar; call it once per process
+func Publish(config map[string]string, errorLog *ErrorLog) {
+	start := time.Now()
+	expvar.Publish(VarName, expvar.Func(func() any {
+		return State{
+			Build:           Build(),
+			StartTime:       start,
+			Config:          config,
+			ReconcileErrors: errorLog.Entries(),
+		}
+	}))
+}
+
+// Server serves /debug/pprof and /debug/vars
+type Server struct {
+	Addr string
+}
+
+// NeedLeaderElection lets every replica be profiled
+func (s *Server) NeedLeaderElection() bool {
+	return false
+}
+
+// Handler returns the diagnostics routes
+func (s *Server) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/debug/pprof/", pprof.Index)
+	mux.HandleFunc("/debug/pprof/cmdline", pprof.Cmdline)
+	mux.HandleFunc("/debug/pprof/profile", pprof.Profile)
+	mux.HandleFunc("/debug/pprof/symbol", pprof.Symbol)
+	mux.HandleFunc("/debug/pprof/trace", pprof.Trace)
+	mux.Handle("/debug/vars", expvar.Handler())
+	return mux
+}
+
+// Start serves diagnostics until ctx is cancelled
+func (s *Server) Start(ctx context.Context) error {
+	log := ctrl.Log.WithName("diagnostics")
+	if host, _, err := net.SplitHostPort(s.Addr); err == nil {
+		if ip := net.ParseIP(host); ip == nil || !ip.IsLoopback() {
+			log.Info("Warning: diagnostics endpoint is reachable from outside the pod; it exposes profiles and configuration without authentication",
+				"addr", s.Addr)
+		}
+	}
+	srv := &http.Server{
+		Addr:              s.Addr,
+		Handler:           s.Handler(),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	errCh := make(chan error, 1)
+	go func() {
+		log.Info("Serving diagnostics", "addr", s.Addr)
+		if err := srv.ListenAndServe(); !errors.Is(err, http.ErrServerClosed) {
+			errCh <- err
+		}
+		close(errCh)
+	}()
+
+	select {
+	case err := <-errCh:
+		return err
+	case <-ctx.Done():
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		return srv.Shutdown(shutdownCtx)
+	}
+}
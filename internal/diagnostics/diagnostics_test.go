@@ -0,0 +1,87 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package diagnostics
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+func TestErrorLog_KeepsMostRecent(t *testing.T) {
+	l := NewErrorLog(2)
+	l.Record("default/a", errors.New("first"))
+	l.Record("default/b", errors.New("second"))
+	l.Record("default/c", errors.New("third"))
+
+	entries := l.Entries()
+	require.Len(t, entries, 2)
+	assert.Equal(t, "second", entries[0].Error)
+	assert.Equal(t, "default/c", entries[1].Experiment)
+}
+
+func TestErrorLog_Wrap(t *testing.T) {
+	l := NewErrorLog(10)
+	failing := reconcile.Func(func(_ context.Context, req reconcile.Request) (reconcile.Result, error) {
+		if req.Name == "broken" {
+			return reconcile.Result{}, errors.New("boom")
+		}
+		return reconcile.Result{}, nil
+	})
+	r := l.Wrap(failing)
+
+	_, err := r.Reconcile(context.Background(), reconcile.Request{NamespacedName: types.NamespacedName{Namespace: "default", Name: "ok"}})
+	require.NoError(t, err)
+	_, err = r.Reconcile(context.Background(), reconcile.Request{NamespacedName: types.NamespacedName{Namespace: "default", Name: "broken"}})
+	require.Error(t, err)
+
+	entries := l.Entries()
+	require.Len(t, entries, 1)
+	assert.Equal(t, "default/broken", entries[0].Experiment)
+	assert.Equal(t, "boom", entries[0].Error)
+}
+
+func TestServer_Handler(t *testing.T) {
+	l := NewErrorLog(10)
+	l.Record("default/broken", errors.New("boom"))
+	Publish(map[string]string{"leader-elect": "true"}, l)
+	handler := (&Server{}).Handler()
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/debug/vars", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	var vars struct {
+		State State `json:"k8sChaos"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &vars))
+	assert.Equal(t, Version, vars.State.Build.Version)
+	assert.Equal(t, "true", vars.State.Config["leader-elect"])
+	require.Len(t, vars.State.ReconcileErrors, 1)
+
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/debug/pprof/", nil))
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), "goroutine")
+}
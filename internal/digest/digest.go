@@ -0,0 +1,427 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package digest mails a periodic summary of chaos activity to people who would rather not open
+// another dashboard: the executions recorded in the history, their successCriteria verdicts, the
+// workloads that failed most and the scheduled runs coming up.
+package digest
+
+import (
+	"bytes"
+	"cmp"
+	"context"
+	"crypto/tls"
+	"fmt"
+	"mime"
+	"net"
+	"net/smtp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	chaosv1alpha1 "github.com/neogan74/k8s-chaos/api/v1alpha1"
+	chaosmetrics "github.com/neogan74/k8s-chaos/internal/metrics"
+	cronschedule "github.com/neogan74/k8s-chaos/internal/schedule"
+)
+
+const (
+	// PeriodDaily sends a digest every day at midnight, covering the day before
+	PeriodDaily = "daily"
+	// PeriodWeekly sends a digest every Monday at midnight, covering the week before
+	PeriodWeekly = "weekly"
+
+	// Secret data keys configuring the SMTP server
+	SecretKeyHost     = "host"
+	SecretKeyPort     = "port"
+	SecretKeyUsername = "username"
+	SecretKeyPassword = "password"
+	SecretKeyFrom     = "from"
+	SecretKeyTo       = "to"
+
+	// defaultPort is the SMTP submission port
+	defaultPort = "587"
+	// maxFailingWorkloads and maxUpcoming bound the lists of a digest
+	maxFailingWorkloads = 5
+	maxUpcoming         = 20
+
+	sendTimeout = 30 * time.Second
+	timeLayout  = "Mon 2006-01-02 15:04 MST"
+)
+
+// periods maps each period to when digests are sent and how much history they cover
+var periods = map[string]struct {
+	schedule string
+	window   time.Duration
+}{
+	PeriodDaily:  {schedule: "0 0 * * *", window: 24 * time.Hour},
+	PeriodWeekly: {schedule: "0 0 * * 1", window: 7 * 24 * time.Hour},
+}
+
+// ValidatePeriod rejects periods other than daily and weekly
+func ValidatePeriod(period string) error {
+	if _, ok := periods[period]; !ok {
+		return fmt.Errorf("unsupported digest period %q (expected %s or %s)", period, PeriodDaily, PeriodWeekly)
+	}
+	return nil
+}
+
+// Mailer sends a plain-text message to the digest recipients
+type Mailer interface {
+	Send(ctx context.Context, subject, body string) error
+}
+
+// SMTP sends mail through an SMTP server, upgrading the connection with STARTTLS when offered
+type SMTP struct {
+	// Addr is the server address (host:port)
+	Addr string
+	// Username and Password authenticate with PLAIN auth, which requires TLS unless the server is
+	// on localhost; no authentication when Username is empty
+	Username, Password string
+	// From is the sender address
+	From string
+	// To are the recipient addresses
+	To []string
+	// TLSConfig is used for STARTTLS; the server name is verified against system roots when nil
+	TLSConfig *tls.Config
+}
+
+// SMTPFromSecret builds the mailer described by a Secret with "host", "from" and "to"
+// (comma-separated), and optionally "port" (587 by default), "username" and "password"
+func SMTPFromSecret(secret *corev1.Secret) (*SMTP, error) {
+	get := func(key string) string { return strings.TrimSpace(string(secret.Data[key])) }
+	for _, key := range []string{SecretKeyHost, SecretKeyFrom, SecretKeyTo} {
+		if get(key) == "" {
+			return nil, fmt.Errorf("secret %s/%s: %q is required", secret.Namespace, secret.Name, key)
+		}
+	}
+	port := cmp.Or(get(SecretKeyPort), defaultPort)
+	if _, err := strconv.ParseUint(port, 10, 16); err != nil {
+		return nil, fmt.Errorf("secret %s/%s: invalid %q %q", secret.Namespace, secret.Name, SecretKeyPort, port)
+	}
+	var to []string
+	for _, address := range strings.Split(get(SecretKeyTo), ",") {
+		if address = strings.TrimSpace(address); address != "" {
+			to = append(to, address)
+		}
+	}
+	return &SMTP{
+		Addr:     net.JoinHostPort(get(SecretKeyHost), port),
+		Username: get(SecretKeyUsername),
+		Password: get(SecretKeyPassword),
+		From:     get(SecretKeyFrom),
+		To:       to,
+	}, nil
+}
+
+// Send delivers the message to every recipient in one transaction
+func (s *SMTP) Send(ctx context.Context, subject, body string) error {
+	ctx, cancel := context.WithTimeout(ctx, sendTimeout)
+	defer cancel()
+	conn, err := (&net.Dialer{}).DialContext(ctx, "tcp", s.Addr)
+	if err != nil {
+		return fmt.Errorf("failed to connect to %s: %w", s.Addr, err)
+	}
+	deadline, _ := ctx.Deadline()
+	_ = conn.SetDeadline(deadline)
+
+	host, _, _ := net.SplitHostPort(s.Addr)
+	c, err := smtp.NewClient(conn, host)
+	if err != nil {
+		_ = conn.Close()
+		return fmt.Errorf("failed to greet %s: %w", s.Addr, err)
+	}
+	defer func() { _ = c.Close() }()
+
+	if ok, _ := c.Extension("STARTTLS"); ok {
+		config := s.TLSConfig
+		if config == nil {
+			config = &tls.Config{ServerName: host, MinVersion: tls.VersionTLS12}
+		}
+		if err := c.StartTLS(config); err != nil {
+			return fmt.Errorf("STARTTLS failed: %w", err)
+		}
+	}
+	if s.Username != "" {
+		if err := c.Auth(smtp.PlainAuth("", s.Username, s.Password, host)); err != nil {
+			return fmt.Errorf("authentication failed: %w", err)
+		}
+	}
+	if err := c.Mail(s.From); err != nil {
+		return err
+	}
+	for _, to := range s.To {
+		if err := c.Rcpt(to); err != nil {
+			return fmt.Errorf("recipient %s rejected: %w", to, err)
+		}
+	}
+	w, err := c.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(s.message(subject, body, time.Now())); err != nil {
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+	return c.Quit()
+}
+
+// message formats subject and body as a plain-text mail with CRLF line endings
+func (s *SMTP) message(subject, body string, date time.Time) []byte {
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "From: %s\r\n", s.From)
+	fmt.Fprintf(&b, "To: %s\r\n", strings.Join(s.To, ", "))
+	fmt.Fprintf(&b, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", subject))
+	fmt.Fprintf(&b, "Date: %s\r\n", date.Format(time.RFC1123Z))
+	b.WriteString("MIME-Version: 1.0\r\n")
+	b.WriteString("Content-Type: text/plain; charset=utf-8\r\n\r\n")
+	// Lines starting with a dot are escaped by the DATA writer
+	for _, line := range strings.Split(strings.TrimRight(body, "\n"), "\n") {
+		b.WriteString(line + "\r\n")
+	}
+	return b.Bytes()
+}
+
+// WorkloadFailures counts the failed executions that affected a workload
+type WorkloadFailures struct {
+	// Workload is "namespace/Kind/name"
+	Workload string
+	Failures int
+}
+
+// ScheduledRun is an upcoming run of a scheduled experiment
+type ScheduledRun struct {
+	// Experiment is "namespace/name"
+	Experiment string
+	Action     string
+	At         time.Time
+}
+
+// Summary is what a digest reports about one period
+type Summary struct {
+	From, To time.Time
+
+	// Executions counts the history records of the period by execution status
+	Executions map[string]int
+	// Passed and Failed count the experiments judged by their successCriteria
+	Passed, Failed int
+	// FailingWorkloads are the workloads affected by the most failed executions or verdicts
+	FailingWorkloads []WorkloadFailures
+	// Upcoming are the scheduled runs due in the next period, soonest first
+	Upcoming []ScheduledRun
+}
+
+// Summarize builds the summary of the history records created in [from, to) and the runs of
+// experiments scheduled in the period after to
+func Summarize(histories []chaosv1alpha1.ChaosExperimentHistory, experiments []chaosv1alpha1.ChaosExperiment, from, to time.Time) Summary {
+	s := Summary{From: from, To: to, Executions: map[string]int{}}
+	judged := map[string]bool{}
+	failures := map[string]int{}
+	for i := range histories {
+		h := &histories[i]
+		created := h.CreationTimestamp.Time
+		if created.Before(from) || !created.Before(to) {
+			continue
+		}
+
+		s.Executions[h.Spec.Execution.Status]++
+		failed := h.Spec.Execution.Status == "failure"
+		// Later records of a judged experiment, such as its cancellation, copy its verdict
+		if v := h.Spec.Verdict; v != nil && !judged[h.Spec.ExperimentRef.UID] {
+			judged[h.Spec.ExperimentRef.UID] = true
+			if v.Result == chaosv1alpha1.VerdictFailed {
+				s.Failed++
+				failed = true
+			} else {
+				s.Passed++
+			}
+		}
+		if !failed {
+			continue
+		}
+		for _, workload := range affectedWorkloads(h) {
+			failures[workload]++
+		}
+	}
+
+	for workload, count := range failures {
+		s.FailingWorkloads = append(s.FailingWorkloads, WorkloadFailures{Workload: workload, Failures: count})
+	}
+	slices.SortFunc(s.FailingWorkloads, func(a, b WorkloadFailures) int {
+		return cmp.Or(cmp.Compare(b.Failures, a.Failures), cmp.Compare(a.Workload, b.Workload))
+	})
+	if len(s.FailingWorkloads) > maxFailingWorkloads {
+		s.FailingWorkloads = s.FailingWorkloads[:maxFailingWorkloads]
+	}
+
+	horizon := to.Add(to.Sub(from))
+	for i := range experiments {
+		exp := &experiments[i]
+		next := exp.Status.NextScheduledTime
+		if exp.Spec.Paused || next == nil || next.Time.Before(to) || !next.Time.Before(horizon) {
+			continue
+		}
+		s.Upcoming = append(s.Upcoming, ScheduledRun{
+			Experiment: exp.Namespace + "/" + exp.Name,
+			Action:     exp.Spec.Action,
+			At:         next.Time,
+		})
+	}
+	slices.SortFunc(s.Upcoming, func(a, b ScheduledRun) int {
+		return cmp.Or(a.At.Compare(b.At), cmp.Compare(a.Experiment, b.Experiment))
+	})
+	if len(s.Upcoming) > maxUpcoming {
+		s.Upcoming = s.Upcoming[:maxUpcoming]
+	}
+	return s
+}
+
+// affectedWorkloads returns the workloads of an execution's blast radius; executions without
+// one, such as node actions, count against their experiment
+func affectedWorkloads(h *chaosv1alpha1.ChaosExperimentHistory) []string {
+	if h.Spec.BlastRadius == nil || len(h.Spec.BlastRadius.Workloads) == 0 {
+		return []string{h.Spec.ExperimentRef.Namespace + "/ChaosExperiment/" + h.Spec.ExperimentRef.Name}
+	}
+	workloads := make([]string, 0, len(h.Spec.BlastRadius.Workloads))
+	for _, workload := range h.Spec.BlastRadius.Workloads {
+		workloads = append(workloads, h.Spec.ExperimentSpec.Namespace+"/"+workload.Kind+"/"+workload.Name)
+	}
+	return workloads
+}
+
+// Subject is the mail subject of the summary
+func (s Summary) Subject() string {
+	total := 0
+	for _, count := range s.Executions {
+		total += count
+	}
+	subject := fmt.Sprintf("Chaos digest %s: %d executions", s.From.Format(time.DateOnly), total)
+	if s.Passed+s.Failed > 0 {
+		subject += fmt.Sprintf(", %d of %d verdicts failed", s.Failed, s.Passed+s.Failed)
+	}
+	return subject
+}
+
+// Render formats the summary as the plain-text body of a digest
+func (s Summary) Render() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Chaos activity from %s to %s\n\n", s.From.Format(timeLayout), s.To.Format(timeLayout))
+
+	b.WriteString("Executions\n")
+	if len(s.Executions) == 0 {
+		b.WriteString("  none\n")
+	}
+	for _, status := range []string{"success", "partial", "failure", "cancelled"} {
+		if count := s.Executions[status]; count > 0 {
+			fmt.Fprintf(&b, "  %-10s %d\n", status, count)
+		}
+	}
+
+	b.WriteString("\nVerdicts\n")
+	if s.Passed+s.Failed == 0 {
+		b.WriteString("  no experiments with successCriteria completed\n")
+	} else {
+		fmt.Fprintf(&b, "  %-10s %d\n  %-10s %d\n", chaosv1alpha1.VerdictPassed, s.Passed, chaosv1alpha1.VerdictFailed, s.Failed)
+	}
+
+	b.WriteString("\nTop failing workloads\n")
+	if len(s.FailingWorkloads) == 0 {
+		b.WriteString("  none\n")
+	}
+	for _, workload := range s.FailingWorkloads {
+		fmt.Fprintf(&b, "  %-4d %s\n", workload.Failures, workload.Workload)
+	}
+
+	b.WriteString("\nUpcoming scheduled chaos\n")
+	if len(s.Upcoming) == 0 {
+		b.WriteString("  none\n")
+	}
+	for _, run := range s.Upcoming {
+		fmt.Fprintf(&b, "  %s  %s (%s)\n", run.At.Format(timeLayout), run.Experiment, run.Action)
+	}
+	return b.String()
+}
+
+// Digest mails a summary at the end of every period from the leader
+type Digest struct {
+	// Reader lists history records and experiments; an uncached reader avoids keeping every
+	// history record in memory for a daily read
+	Reader client.Reader
+	Mailer Mailer
+	// Period is PeriodDaily or PeriodWeekly
+	Period string
+	// HistoryNamespace holds the history records; every namespace is read when empty
+	HistoryNamespace string
+}
+
+// NeedLeaderElection sends from the leader only, so every digest is mailed once
+func (d *Digest) NeedLeaderElection() bool {
+	return true
+}
+
+// Start sends a digest at the end of every period until ctx is cancelled. A digest missed while
+// no replica was leading is not sent afterwards.
+func (d *Digest) Start(ctx context.Context) error {
+	log := ctrl.Log.WithName("digest")
+	period, ok := periods[d.Period]
+	if !ok {
+		return ValidatePeriod(d.Period)
+	}
+	schedule, err := cronschedule.Parse(period.schedule)
+	if err != nil {
+		return err
+	}
+	log.Info("Sending chaos digests", "period", d.Period)
+
+	for {
+		at := schedule.Next(time.Now())
+		timer := time.NewTimer(time.Until(at))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil
+		case <-timer.C:
+		}
+		if err := d.Send(ctx, at.Add(-period.window), at); err != nil {
+			if ctx.Err() == nil {
+				log.Error(err, "Failed to send chaos digest", "from", at.Add(-period.window), "to", at)
+				chaosmetrics.DigestEmails.WithLabelValues("failed").Inc()
+			}
+			continue
+		}
+		chaosmetrics.DigestEmails.WithLabelValues("success").Inc()
+	}
+}
+
+// Send mails the digest of [from, to)
+func (d *Digest) Send(ctx context.Context, from, to time.Time) error {
+	var histories chaosv1alpha1.ChaosExperimentHistoryList
+	if err := d.Reader.List(ctx, &histories, client.InNamespace(d.HistoryNamespace)); err != nil {
+		return fmt.Errorf("failed to list history records: %w", err)
+	}
+	var experiments chaosv1alpha1.ChaosExperimentList
+	if err := d.Reader.List(ctx, &experiments); err != nil {
+		return fmt.Errorf("failed to list experiments: %w", err)
+	}
+	summary := Summarize(histories.Items, experiments.Items, from, to)
+	return d.Mailer.Send(ctx, summary.Subject(), summary.Render())
+}
@@ -0,0 +1,282 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package digest
+
+import (
+	"context"
+	"net"
+	"net/textproto"
+	"strings"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	chaosv1alpha1 "github.com/neogan74/k8s-chaos/api/v1alpha1"
+)
+
+var (
+	periodStart = time.Date(2026, 10, 14, 0, 0, 0, 0, time.UTC)
+	periodEnd   = periodStart.Add(24 * time.Hour)
+)
+
+func record(name, uid, status string, created time.Time, workloads ...string) chaosv1alpha1.ChaosExperimentHistory {
+	h := chaosv1alpha1.ChaosExperimentHistory{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "chaos-system", CreationTimestamp: metav1.NewTime(created)},
+		Spec: chaosv1alpha1.ChaosExperimentHistorySpec{
+			ExperimentRef:  chaosv1alpha1.ObjectReference{Name: "web-kill", Namespace: "default", UID: uid},
+			ExperimentSpec: chaosv1alpha1.ChaosExperimentSpec{Action: "pod-kill", Namespace: "shop"},
+			Execution:      chaosv1alpha1.ExecutionDetails{Status: status},
+		},
+	}
+	if len(workloads) > 0 {
+		h.Spec.BlastRadius = &chaosv1alpha1.BlastRadius{}
+		for _, workload := range workloads {
+			h.Spec.BlastRadius.Workloads = append(h.Spec.BlastRadius.Workloads, chaosv1alpha1.WorkloadImpact{Kind: "Deployment", Name: workload})
+		}
+	}
+	return h
+}
+
+func judged(h chaosv1alpha1.ChaosExperimentHistory, result string) chaosv1alpha1.ChaosExperimentHistory {
+	h.Spec.Verdict = &chaosv1alpha1.Verdict{Result: result}
+	return h
+}
+
+func scheduled(name string, next time.Time, paused bool) chaosv1alpha1.ChaosExperiment {
+	return chaosv1alpha1.ChaosExperiment{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "shop"},
+		Spec:       chaosv1alpha1.ChaosExperimentSpec{Action: "pod-kill", Paused: paused},
+		Status:     chaosv1alpha1.ChaosExperimentStatus{NextScheduledTime: &metav1.Time{Time: next}},
+	}
+}
+
+func TestSummarize(t *testing.T) {
+	during := periodStart.Add(time.Hour)
+	histories := []chaosv1alpha1.ChaosExperimentHistory{
+		record("ok-1", "a", "success", during, "web"),
+		judged(record("ok-2", "a", "success", during, "web"), chaosv1alpha1.VerdictFailed),
+		judged(record("failed-1", "b", "failure", during, "cart", "web"), chaosv1alpha1.VerdictPassed),
+		record("failed-2", "c", "failure", during),
+		// The cancellation of an already judged experiment copies its verdict
+		judged(record("cancelled-b", "b", "cancelled", during, "cart"), chaosv1alpha1.VerdictPassed),
+		record("before", "d", "failure", periodStart.Add(-time.Second), "old"),
+		record("after", "d", "failure", periodEnd, "new"),
+	}
+	experiments := []chaosv1alpha1.ChaosExperiment{
+		scheduled("late", periodEnd.Add(20*time.Hour), false),
+		scheduled("early", periodEnd.Add(2*time.Hour), false),
+		scheduled("paused", periodEnd.Add(3*time.Hour), true),
+		scheduled("next-week", periodEnd.Add(48*time.Hour), false),
+		scheduled("overdue", periodEnd.Add(-time.Minute), false),
+	}
+
+	s := Summarize(histories, experiments, periodStart, periodEnd)
+
+	if s.Executions["success"] != 2 || s.Executions["failure"] != 2 || s.Executions["cancelled"] != 1 || len(s.Executions) != 3 {
+		t.Errorf("Executions = %v, want 2 success, 2 failure and 1 cancelled", s.Executions)
+	}
+	if s.Passed != 1 || s.Failed != 1 {
+		t.Errorf("Passed, Failed = %d, %d, want 1, 1", s.Passed, s.Failed)
+	}
+	wantFailing := []WorkloadFailures{
+		{Workload: "shop/Deployment/web", Failures: 2},
+		{Workload: "default/ChaosExperiment/web-kill", Failures: 1},
+		{Workload: "shop/Deployment/cart", Failures: 1},
+	}
+	if len(s.FailingWorkloads) != len(wantFailing) {
+		t.Fatalf("FailingWorkloads = %v, want %v", s.FailingWorkloads, wantFailing)
+	}
+	for i := range wantFailing {
+		if s.FailingWorkloads[i] != wantFailing[i] {
+			t.Errorf("FailingWorkloads[%d] = %v, want %v", i, s.FailingWorkloads[i], wantFailing[i])
+		}
+	}
+	if len(s.Upcoming) != 2 || s.Upcoming[0].Experiment != "shop/early" || s.Upcoming[1].Experiment != "shop/late" {
+		t.Errorf("Upcoming = %v, want shop/early then shop/late", s.Upcoming)
+	}
+}
+
+func TestRender(t *testing.T) {
+	s := Summary{
+		From:             periodStart,
+		To:               periodEnd,
+		Executions:       map[string]int{"success": 3, "failure": 1},
+		Passed:           1,
+		Failed:           1,
+		FailingWorkloads: []WorkloadFailures{{Workload: "shop/Deployment/web", Failures: 1}},
+		Upcoming:         []ScheduledRun{{Experiment: "shop/web-kill", Action: "pod-kill", At: periodEnd.Add(time.Hour)}},
+	}
+
+	if got, want := s.Subject(), "Chaos digest 2026-10-14: 4 executions, 1 of 2 verdicts failed"; got != want {
+		t.Errorf("Subject() = %q, want %q", got, want)
+	}
+	body := s.Render()
+	for _, want := range []string{
+		"Chaos activity from Wed 2026-10-14 00:00 UTC to Thu 2026-10-15 00:00 UTC",
+		"  success    3\n  failure    1\n",
+		"  Passed     1\n  Failed     1\n",
+		"  1    shop/Deployment/web\n",
+		"  Thu 2026-10-15 01:00 UTC  shop/web-kill (pod-kill)\n",
+	} {
+		if !strings.Contains(body, want) {
+			t.Errorf("Render() is missing %q:\n%s", want, body)
+		}
+	}
+
+	empty := Summary{From: periodStart, To: periodEnd, Executions: map[string]int{}}
+	if body := empty.Render(); strings.Count(body, "  none\n") != 3 || !strings.Contains(body, "no experiments with successCriteria") {
+		t.Errorf("Render() of an empty period:\n%s", body)
+	}
+}
+
+func TestSMTPFromSecret(t *testing.T) {
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "digest", Namespace: "chaos-system"},
+		Data: map[string][]byte{
+			SecretKeyHost: []byte("smtp.example.com"),
+			SecretKeyFrom: []byte("chaos@example.com"),
+			SecretKeyTo:   []byte("sre@example.com, ,leads@example.com"),
+		},
+	}
+	mailer, err := SMTPFromSecret(secret)
+	if err != nil {
+		t.Fatalf("SMTPFromSecret() error = %v", err)
+	}
+	if mailer.Addr != "smtp.example.com:587" || len(mailer.To) != 2 || mailer.To[1] != "leads@example.com" {
+		t.Errorf("SMTPFromSecret() = %+v", mailer)
+	}
+
+	secret.Data[SecretKeyPort] = []byte("smtp")
+	if _, err := SMTPFromSecret(secret); err == nil {
+		t.Error("expected an invalid port to be rejected")
+	}
+	delete(secret.Data, SecretKeyPort)
+	delete(secret.Data, SecretKeyTo)
+	if _, err := SMTPFromSecret(secret); err == nil || !strings.Contains(err.Error(), `"to" is required`) {
+		t.Errorf("expected missing recipients to be rejected, got %v", err)
+	}
+}
+
+func TestValidatePeriod(t *testing.T) {
+	for _, period := range []string{PeriodDaily, PeriodWeekly} {
+		if err := ValidatePeriod(period); err != nil {
+			t.Errorf("ValidatePeriod(%q) error = %v", period, err)
+		}
+	}
+	if err := ValidatePeriod("hourly"); err == nil {
+		t.Error("expected hourly to be rejected")
+	}
+}
+
+// fakeSMTP accepts one message on a local port and returns the address and the received
+// DATA, without advertising STARTTLS or AUTH
+func fakeSMTP(t *testing.T) (string, <-chan string) {
+	t.Helper()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = listener.Close() })
+	received := make(chan string, 1)
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer func() { _ = conn.Close() }()
+		tp := textproto.NewConn(conn)
+		_ = tp.PrintfLine("220 fake ESMTP")
+		for {
+			line, err := tp.ReadLine()
+			if err != nil {
+				return
+			}
+			switch verb, _, _ := strings.Cut(line, " "); strings.ToUpper(verb) {
+			case "EHLO":
+				_ = tp.PrintfLine("250 fake")
+			case "DATA":
+				_ = tp.PrintfLine("354 go ahead")
+				data, err := tp.ReadDotBytes()
+				if err != nil {
+					return
+				}
+				received <- string(data)
+				_ = tp.PrintfLine("250 queued")
+			case "QUIT":
+				_ = tp.PrintfLine("221 bye")
+				return
+			default:
+				_ = tp.PrintfLine("250 ok")
+			}
+		}
+	}()
+	return listener.Addr().String(), received
+}
+
+func TestSMTPSend(t *testing.T) {
+	addr, received := fakeSMTP(t)
+	mailer := &SMTP{Addr: addr, From: "chaos@example.com", To: []string{"sre@example.com", "leads@example.com"}}
+
+	if err := mailer.Send(context.Background(), "Chaos digest", "Executions\n.hidden\n"); err != nil {
+		t.Fatalf("Send() error = %v", err)
+	}
+	message := <-received
+	for _, want := range []string{
+		"To: sre@example.com, leads@example.com\n",
+		"Subject: Chaos digest\n",
+		"Content-Type: text/plain; charset=utf-8\n\nExecutions\n.hidden\n",
+	} {
+		if !strings.Contains(message, want) {
+			t.Errorf("message is missing %q:\n%s", want, message)
+		}
+	}
+}
+
+type recordingMailer struct {
+	subject, body string
+}
+
+func (m *recordingMailer) Send(_ context.Context, subject, body string) error {
+	m.subject, m.body = subject, body
+	return nil
+}
+
+func TestDigestSend(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := chaosv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	h := record("ok", "a", "success", periodStart.Add(time.Hour))
+	exp := scheduled("web-kill", periodEnd.Add(time.Hour), false)
+	reader := fake.NewClientBuilder().WithScheme(scheme).WithObjects(&h, &exp).Build()
+	mailer := &recordingMailer{}
+	d := &Digest{Reader: reader, Mailer: mailer, Period: PeriodDaily, HistoryNamespace: "chaos-system"}
+
+	if err := d.Send(context.Background(), periodStart, periodEnd); err != nil {
+		t.Fatalf("Send() error = %v", err)
+	}
+	if mailer.subject != "Chaos digest 2026-10-14: 1 executions" {
+		t.Errorf("subject = %q", mailer.subject)
+	}
+	if !strings.Contains(mailer.body, "shop/web-kill (pod-kill)") {
+		t.Errorf("body is missing the upcoming run:\n%s", mailer.body)
+	}
+}
@@ -0,0 +1,162 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package duration parses the durations of chaos specs, such as "30s", "1h30m", "250ms" or "2d":
+// a sequence of whole numbers, each followed by a unit, without signs, fractions or spaces.
+// Parsing is strict: the whole string must be made of such terms, and errors name the offending
+// term and its position.
+package duration
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+	"time"
+)
+
+// Units of a duration term
+const (
+	Millisecond = "ms"
+	Second      = "s"
+	Minute      = "m"
+	Hour        = "h"
+	Day         = "d"
+)
+
+var (
+	// Standard are the units of most duration fields
+	Standard = []string{Second, Minute, Hour}
+	// WithMilliseconds adds milliseconds for latencies, such as the delay of pod-delay
+	WithMilliseconds = []string{Millisecond, Second, Minute, Hour}
+	// WithDays adds days for long-running windows, such as experimentDuration
+	WithDays = []string{Second, Minute, Hour, Day}
+	// All are every unit Parse knows
+	All = []string{Millisecond, Second, Minute, Hour, Day}
+)
+
+// unitLengths maps every unit to its length; a day is always 24 hours
+var unitLengths = map[string]time.Duration{
+	Millisecond: time.Millisecond,
+	Second:      time.Second,
+	Minute:      time.Minute,
+	Hour:        time.Hour,
+	Day:         24 * time.Hour,
+}
+
+// Error is a duration that could not be parsed
+type Error struct {
+	// Input is the whole duration string
+	Input string
+	// Offset is the byte offset of the offending term in Input
+	Offset int
+	// Reason describes what is wrong at Offset
+	Reason string
+}
+
+func (e *Error) Error() string {
+	if e.Input == "" {
+		return "invalid duration \"\": " + e.Reason
+	}
+	return fmt.Sprintf("invalid duration %q: %s at offset %d", e.Input, e.Reason, e.Offset)
+}
+
+// Parse parses s, accepting the given units; without units every unit is accepted
+func Parse(s string, units ...string) (time.Duration, error) {
+	if len(units) == 0 {
+		units = All
+	}
+	if s == "" {
+		return 0, &Error{Input: s, Reason: "empty, use a number followed by " + describeUnits(units)}
+	}
+
+	var total time.Duration
+	for i := 0; i < len(s); {
+		start := i
+		for i < len(s) && isDigit(s[i]) {
+			i++
+		}
+		number := s[start:i]
+		if number == "" {
+			return 0, &Error{Input: s, Offset: start, Reason: fmt.Sprintf("expected a number, found %q", term(s, start))}
+		}
+
+		unitStart := i
+		for i < len(s) && isLetter(s[i]) {
+			i++
+		}
+		unit := s[unitStart:i]
+		switch {
+		case unit == "" && i == len(s):
+			return 0, &Error{Input: s, Offset: start, Reason: fmt.Sprintf("%q lacks a unit (%s)", number, describeUnits(units))}
+		case unit == "":
+			return 0, &Error{Input: s, Offset: i, Reason: fmt.Sprintf("expected a unit (%s), found %q", describeUnits(units), term(s, i))}
+		case !slices.Contains(units, unit):
+			return 0, &Error{Input: s, Offset: unitStart, Reason: fmt.Sprintf("unit %q is not one of %s", unit, describeUnits(units))}
+		}
+
+		length := unitLengths[unit]
+		value, ok := parseNumber(number)
+		if !ok || value > uint64(math.MaxInt64/length) || time.Duration(value)*length > math.MaxInt64-total {
+			return 0, &Error{Input: s, Offset: start, Reason: fmt.Sprintf("%q is too large", s[start:i])}
+		}
+		total += time.Duration(value) * length
+	}
+	return total, nil
+}
+
+// Validate reports whether s parses with the given units
+func Validate(s string, units ...string) error {
+	_, err := Parse(s, units...)
+	return err
+}
+
+// describeUnits lists units as "s, m or h"
+func describeUnits(units []string) string {
+	if len(units) == 1 {
+		return units[0]
+	}
+	return strings.Join(units[:len(units)-1], ", ") + " or " + units[len(units)-1]
+}
+
+// term returns the run of characters of s starting at i up to the next digit, for error messages
+func term(s string, i int) string {
+	end := i + 1
+	for end < len(s) && !isDigit(s[end]) {
+		end++
+	}
+	return s[i:end]
+}
+
+// parseNumber parses a decimal number, reporting false on overflow
+func parseNumber(s string) (uint64, bool) {
+	var n uint64
+	for i := 0; i < len(s); i++ {
+		if n > (math.MaxUint64-9)/10 {
+			return 0, false
+		}
+		n = n*10 + uint64(s[i]-'0')
+	}
+	return n, true
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func isLetter(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z'
+}
@@ -0,0 +1,95 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package duration
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		input string
+		units []string
+		want  time.Duration
+	}{
+		{input: "30s", units: Standard, want: 30 * time.Second},
+		{input: "1h30m45s", units: Standard, want: time.Hour + 30*time.Minute + 45*time.Second},
+		{input: "90s", units: Standard, want: 90 * time.Second},
+		{input: "250ms", units: WithMilliseconds, want: 250 * time.Millisecond},
+		{input: "1s500ms", units: WithMilliseconds, want: 1500 * time.Millisecond},
+		{input: "2d12h", units: WithDays, want: 60 * time.Hour},
+		{input: "0s", units: Standard, want: 0},
+		{input: "7d", want: 7 * 24 * time.Hour},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := Parse(tt.input, tt.units...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("Parse(%q) = %s, want %s", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		input      string
+		units      []string
+		wantOffset int
+		wantError  string
+	}{
+		{input: "5x30s", units: Standard, wantOffset: 1,
+			wantError: `invalid duration "5x30s": unit "x" is not one of s, m or h at offset 1`},
+		{input: "30", units: Standard, wantOffset: 0,
+			wantError: `invalid duration "30": "30" lacks a unit (s, m or h) at offset 0`},
+		{input: "30 s", units: Standard, wantOffset: 2,
+			wantError: `invalid duration "30 s": expected a unit (s, m or h), found " s" at offset 2`},
+		{input: "1.5h", units: Standard, wantOffset: 1,
+			wantError: `invalid duration "1.5h": expected a unit (s, m or h), found "." at offset 1`},
+		{input: "-5m", units: Standard, wantOffset: 0,
+			wantError: `invalid duration "-5m": expected a number, found "-" at offset 0`},
+		{input: "30minutes", units: Standard, wantOffset: 2,
+			wantError: `invalid duration "30minutes": unit "minutes" is not one of s, m or h at offset 2`},
+		{input: "1m500ms", units: Standard, wantOffset: 5,
+			wantError: `invalid duration "1m500ms": unit "ms" is not one of s, m or h at offset 5`},
+		{input: "2d", units: WithMilliseconds, wantOffset: 1,
+			wantError: `invalid duration "2d": unit "d" is not one of ms, s, m or h at offset 1`},
+		{input: "1h30", units: Standard, wantOffset: 2,
+			wantError: `invalid duration "1h30": "30" lacks a unit (s, m or h) at offset 2`},
+		{input: "999999999999h", units: Standard, wantOffset: 0,
+			wantError: `invalid duration "999999999999h": "999999999999h" is too large at offset 0`},
+		{input: "", units: Standard,
+			wantError: `invalid duration "": empty, use a number followed by s, m or h`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			_, err := Parse(tt.input, tt.units...)
+			var parseErr *Error
+			if !errors.As(err, &parseErr) {
+				t.Fatalf("Parse(%q) error = %v, want *Error", tt.input, err)
+			}
+			if parseErr.Offset != tt.wantOffset || err.Error() != tt.wantError {
+				t.Errorf("Parse(%q) error = %q at %d, want %q at %d", tt.input, err, parseErr.Offset, tt.wantError, tt.wantOffset)
+			}
+		})
+	}
+}
@@ -0,0 +1,301 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package eventbus publishes experiment lifecycle events to Kafka or NATS, so that downstream
+// automation (ticketing for failed runs, resilience dashboards) can react without watching the
+// cluster. Events have the same types and JSON shape as the REST API event stream.
+package eventbus
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	toolscache "k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+
+	chaosv1alpha1 "github.com/neogan74/k8s-chaos/api/v1alpha1"
+	"github.com/neogan74/k8s-chaos/internal/apiserver"
+	chaosmetrics "github.com/neogan74/k8s-chaos/internal/metrics"
+)
+
+const (
+	// BackendNATS selects the NATS backend in the Secret's "type" key
+	BackendNATS = "nats"
+	// BackendKafka selects the Kafka REST Proxy backend in the Secret's "type" key
+	BackendKafka = "kafka"
+
+	// Secret data keys configuring the bus
+	SecretKeyType     = "type"
+	SecretKeyURL      = "url"
+	SecretKeyTopic    = "topic"
+	SecretKeyUsername = "username"
+	SecretKeyPassword = "password"
+	SecretKeyToken    = "token"
+
+	// DefaultNATSSubject prefixes NATS subjects when the Secret has no topic
+	DefaultNATSSubject = "chaos.events"
+	// DefaultKafkaTopic is used when the Secret has no topic
+	DefaultKafkaTopic = "chaos-events"
+
+	queueSize      = 256
+	publishRetries = 3
+	initialBackoff = time.Second
+)
+
+// Backend delivers one encoded event. key identifies the experiment ("<namespace>/<name>").
+type Backend interface {
+	Publish(ctx context.Context, eventType, key string, payload []byte) error
+	Close() error
+}
+
+// BackendFromSecret builds the backend described by a Secret with "type" ("nats" or "kafka"),
+// "url", and optionally "topic", "username", "password" and, for NATS, "token"
+func BackendFromSecret(secret *corev1.Secret) (Backend, error) {
+	get := func(key string) string { return string(secret.Data[key]) }
+	if get(SecretKeyURL) == "" {
+		return nil, fmt.Errorf("secret %s/%s: %q is required", secret.Namespace, secret.Name, SecretKeyURL)
+	}
+
+	switch get(SecretKeyType) {
+	case BackendNATS:
+		subject := get(SecretKeyTopic)
+		if subject == "" {
+			subject = DefaultNATSSubject
+		}
+		return &NATS{
+			URL:      get(SecretKeyURL),
+			Subject:  subject,
+			Username: get(SecretKeyUsername),
+			Password: get(SecretKeyPassword),
+			Token:    get(SecretKeyToken),
+		}, nil
+	case BackendKafka:
+		topic := get(SecretKeyTopic)
+		if topic == "" {
+			topic = DefaultKafkaTopic
+		}
+		return &Kafka{
+			URL:      get(SecretKeyURL),
+			Topic:    topic,
+			Username: get(SecretKeyUsername),
+			Password: get(SecretKeyPassword),
+		}, nil
+	default:
+		return nil, fmt.Errorf("secret %s/%s: %q must be %q or %q, got %q",
+			secret.Namespace, secret.Name, SecretKeyType, BackendNATS, BackendKafka, get(SecretKeyType))
+	}
+}
+
+// Owner tells whether this replica publishes the events of an experiment; *controller.Shard
+// implements it
+type Owner interface {
+	Owns(namespace, name string) bool
+}
+
+// Bus queues experiment events and publishes them to a backend from the leader
+type Bus struct {
+	Backend Backend
+	// Informers provides the ChaosExperiment informer lifecycle events are derived from;
+	// only events passed to Publish and the wrapped recorder are sent when nil
+	Informers cache.Informers
+	// Shard limits the lifecycle events derived from the informer to the experiments it owns.
+	// Every shard has its own leader watching all experiments, so without it each event would
+	// be published once per shard. Every experiment is published when nil.
+	Shard Owner
+
+	queue   chan apiserver.ExperimentEvent
+	backoff time.Duration
+}
+
+// New returns a bus publishing to backend the events of the experiments shard owns
+func New(backend Backend, informers cache.Informers, shard Owner) *Bus {
+	return &Bus{
+		Backend:   backend,
+		Informers: informers,
+		Shard:     shard,
+		queue:     make(chan apiserver.ExperimentEvent, queueSize),
+		backoff:   initialBackoff,
+	}
+}
+
+// Publish queues an event without blocking; events are dropped while the queue is full.
+// A nil bus ignores the event.
+func (b *Bus) Publish(event apiserver.ExperimentEvent) {
+	if b == nil {
+		return
+	}
+	select {
+	case b.queue <- event:
+	default:
+		chaosmetrics.EventBusMessages.WithLabelValues("dropped").Inc()
+	}
+}
+
+// NeedLeaderElection publishes from the leader of each shard only, so each transition is sent once
+func (b *Bus) NeedLeaderElection() bool {
+	return true
+}
+
+// Start watches experiments and publishes queued events until ctx is cancelled
+func (b *Bus) Start(ctx context.Context) error {
+	log := ctrl.Log.WithName("eventbus")
+	defer func() { _ = b.Backend.Close() }()
+
+	if b.Informers != nil {
+		informer, err := b.Informers.GetInformer(ctx, &chaosv1alpha1.ChaosExperiment{})
+		if err != nil {
+			return fmt.Errorf("failed to get experiment informer: %w", err)
+		}
+		registration, err := informer.AddEventHandler(b.handler())
+		if err != nil {
+			return fmt.Errorf("failed to watch experiments: %w", err)
+		}
+		defer func() { _ = informer.RemoveEventHandler(registration) }()
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event := <-b.queue:
+			if err := b.send(ctx, event); err != nil && ctx.Err() == nil {
+				log.Error(err, "Failed to publish experiment event",
+					"type", event.Type, "experiment", event.Namespace+"/"+event.Experiment)
+			}
+		}
+	}
+}
+
+// send publishes one event, retrying with exponential backoff
+func (b *Bus) send(ctx context.Context, event apiserver.ExperimentEvent) error {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	key := event.Namespace + "/" + event.Experiment
+
+	backoff := b.backoff
+	for attempt := 1; ; attempt++ {
+		if err = b.Backend.Publish(ctx, event.Type, key, payload); err == nil {
+			chaosmetrics.EventBusMessages.WithLabelValues("success").Inc()
+			return nil
+		}
+		if attempt > publishRetries {
+			chaosmetrics.EventBusMessages.WithLabelValues("failed").Inc()
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+	}
+}
+
+// handler derives lifecycle events from experiment changes. Experiments listed when the handler
+// is registered are not reported as created, so a new leader does not replay them.
+func (b *Bus) handler() toolscache.ResourceEventHandler {
+	publish := func(events []apiserver.ExperimentEvent) {
+		for _, event := range events {
+			b.Publish(event)
+		}
+	}
+	owned := func(exp *chaosv1alpha1.ChaosExperiment) bool {
+		return b.Shard == nil || b.Shard.Owns(exp.Namespace, exp.Name)
+	}
+	return toolscache.ResourceEventHandlerDetailedFuncs{
+		AddFunc: func(obj interface{}, isInInitialList bool) {
+			if exp, ok := obj.(*chaosv1alpha1.ChaosExperiment); ok && !isInInitialList && owned(exp) {
+				publish(apiserver.LifecycleEvents(nil, exp, time.Now()))
+			}
+		},
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			before, ok1 := oldObj.(*chaosv1alpha1.ChaosExperiment)
+			after, ok2 := newObj.(*chaosv1alpha1.ChaosExperiment)
+			if ok1 && ok2 && owned(after) {
+				publish(apiserver.LifecycleEvents(before, after, time.Now()))
+			}
+		},
+		DeleteFunc: func(obj interface{}) {
+			if tombstone, ok := obj.(toolscache.DeletedFinalStateUnknown); ok {
+				obj = tombstone.Obj
+			}
+			if exp, ok := obj.(*chaosv1alpha1.ChaosExperiment); ok && owned(exp) {
+				b.Publish(apiserver.ExperimentEvent{
+					Type:       apiserver.EventDeleted,
+					Namespace:  exp.Namespace,
+					Experiment: exp.Name,
+					Phase:      exp.Status.Phase,
+					Time:       time.Now(),
+				})
+			}
+		},
+	}
+}
+
+// Recorder wraps an event recorder so that the Kubernetes events it records on experiments are
+// also published, with their reason as type. A nil bus returns recorder unchanged.
+func (b *Bus) Recorder(recorder record.EventRecorder) record.EventRecorder {
+	if b == nil {
+		return recorder
+	}
+	return &busRecorder{EventRecorder: recorder, bus: b}
+}
+
+type busRecorder struct {
+	record.EventRecorder
+	bus *Bus
+}
+
+func (r *busRecorder) Event(object runtime.Object, eventtype, reason, message string) {
+	r.EventRecorder.Event(object, eventtype, reason, message)
+	r.publish(object, eventtype, reason, message)
+}
+
+func (r *busRecorder) Eventf(object runtime.Object, eventtype, reason, messageFmt string, args ...interface{}) {
+	r.EventRecorder.Eventf(object, eventtype, reason, messageFmt, args...)
+	r.publish(object, eventtype, reason, fmt.Sprintf(messageFmt, args...))
+}
+
+func (r *busRecorder) AnnotatedEventf(object runtime.Object, annotations map[string]string,
+	eventtype, reason, messageFmt string, args ...interface{}) {
+	r.EventRecorder.AnnotatedEventf(object, annotations, eventtype, reason, messageFmt, args...)
+	r.publish(object, eventtype, reason, fmt.Sprintf(messageFmt, args...))
+}
+
+func (r *busRecorder) publish(object runtime.Object, eventtype, reason, message string) {
+	exp, ok := object.(*chaosv1alpha1.ChaosExperiment)
+	if !ok {
+		return
+	}
+	r.bus.Publish(apiserver.ExperimentEvent{
+		Type:       reason,
+		Namespace:  exp.Namespace,
+		Experiment: exp.Name,
+		Phase:      exp.Status.Phase,
+		Severity:   chaosv1alpha1.SeverityOf(&exp.Spec),
+		Message:    message,
+		Warning:    eventtype == corev1.EventTypeWarning,
+		Time:       time.Now(),
+	})
+}
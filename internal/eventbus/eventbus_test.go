@@ -0,0 +1,210 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eventbus
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	toolscache "k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+
+	chaosv1alpha1 "github.com/neogan74/k8s-chaos/api/v1alpha1"
+	"github.com/neogan74/k8s-chaos/internal/apiserver"
+)
+
+// fakeBackend records published event types and fails the first failures calls
+type fakeBackend struct {
+	mu       sync.Mutex
+	failures int
+	types    []string
+	keys     []string
+}
+
+func (f *fakeBackend) Publish(_ context.Context, eventType, key string, _ []byte) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.failures > 0 {
+		f.failures--
+		return errors.New("unavailable")
+	}
+	f.types = append(f.types, eventType)
+	f.keys = append(f.keys, key)
+	return nil
+}
+
+func (f *fakeBackend) Close() error { return nil }
+
+func testBus(backend Backend) *Bus {
+	b := New(backend, nil, nil)
+	b.backoff = time.Millisecond
+	return b
+}
+
+func drain(b *Bus) []apiserver.ExperimentEvent {
+	var events []apiserver.ExperimentEvent
+	for {
+		select {
+		case ev := <-b.queue:
+			events = append(events, ev)
+		default:
+			return events
+		}
+	}
+}
+
+func TestBackendFromSecret(t *testing.T) {
+	secret := func(data map[string]string) *corev1.Secret {
+		s := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "bus", Namespace: "chaos-system"}, Data: map[string][]byte{}}
+		for k, v := range data {
+			s.Data[k] = []byte(v)
+		}
+		return s
+	}
+
+	backend, err := BackendFromSecret(secret(map[string]string{"type": "nats", "url": "nats://nats:4222"}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n, ok := backend.(*NATS); !ok || n.Subject != DefaultNATSSubject {
+		t.Errorf("unexpected backend %#v", backend)
+	}
+
+	backend, err = BackendFromSecret(secret(map[string]string{"type": "kafka", "url": "http://rest:8082", "topic": "chaos"}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if k, ok := backend.(*Kafka); !ok || k.Topic != "chaos" {
+		t.Errorf("unexpected backend %#v", backend)
+	}
+
+	if _, err := BackendFromSecret(secret(map[string]string{"type": "nats"})); err == nil {
+		t.Error("expected a missing url to be rejected")
+	}
+	if _, err := BackendFromSecret(secret(map[string]string{"type": "amqp", "url": "amqp://mq"})); err == nil {
+		t.Error("expected an unknown type to be rejected")
+	}
+}
+
+func TestSendRetries(t *testing.T) {
+	backend := &fakeBackend{failures: 2}
+	b := testBus(backend)
+	event := apiserver.ExperimentEvent{Type: apiserver.EventFailed, Namespace: "default", Experiment: "web-kill"}
+
+	if err := b.send(context.Background(), event); err != nil {
+		t.Fatalf("send() error = %v", err)
+	}
+	if len(backend.types) != 1 || backend.keys[0] != "default/web-kill" {
+		t.Errorf("unexpected deliveries %v %v", backend.types, backend.keys)
+	}
+
+	backend.failures = publishRetries + 1
+	if err := b.send(context.Background(), event); err == nil {
+		t.Error("expected send to give up after the retries")
+	}
+}
+
+func TestHandlerDerivesLifecycleEvents(t *testing.T) {
+	b := testBus(&fakeBackend{})
+	handler := b.handler().(toolscache.ResourceEventHandlerDetailedFuncs)
+	exp := &chaosv1alpha1.ChaosExperiment{
+		ObjectMeta: metav1.ObjectMeta{Name: "web-kill", Namespace: "default"},
+		Spec:       chaosv1alpha1.ChaosExperimentSpec{Action: "pod-kill"},
+	}
+
+	handler.AddFunc(exp, true)
+	if events := drain(b); len(events) != 0 {
+		t.Errorf("expected existing experiments not to be replayed, got %v", events)
+	}
+	handler.AddFunc(exp, false)
+	if events := drain(b); len(events) != 1 || events[0].Type != apiserver.EventCreated {
+		t.Errorf("expected Created, got %v", events)
+	}
+
+	failed := exp.DeepCopy()
+	failed.Status.Phase = "Failed"
+	failed.Status.Message = "no eligible pods"
+	handler.UpdateFunc(exp, failed)
+	if events := drain(b); len(events) != 1 || events[0].Type != apiserver.EventFailed || events[0].Message != "no eligible pods" {
+		t.Errorf("expected Failed, got %v", events)
+	}
+
+	handler.DeleteFunc(toolscache.DeletedFinalStateUnknown{Key: "default/web-kill", Obj: failed})
+	if events := drain(b); len(events) != 1 || events[0].Type != apiserver.EventDeleted {
+		t.Errorf("expected Deleted, got %v", events)
+	}
+}
+
+// oddShard owns the experiments whose name has an odd length
+type oddShard struct{}
+
+func (oddShard) Owns(_, name string) bool { return len(name)%2 == 1 }
+
+func TestHandlerSkipsExperimentsOfOtherShards(t *testing.T) {
+	b := testBus(&fakeBackend{})
+	b.Shard = oddShard{}
+	handler := b.handler().(toolscache.ResourceEventHandlerDetailedFuncs)
+	for _, name := range []string{"owned", "others"} {
+		exp := &chaosv1alpha1.ChaosExperiment{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"}}
+		handler.AddFunc(exp, false)
+		failed := exp.DeepCopy()
+		failed.Status.Phase = "Failed"
+		handler.UpdateFunc(exp, failed)
+		handler.DeleteFunc(failed)
+	}
+
+	events := drain(b)
+	if len(events) != 3 {
+		t.Fatalf("expected Created, Failed and Deleted of the owned experiment, got %v", events)
+	}
+	for _, event := range events {
+		if event.Experiment != "owned" {
+			t.Errorf("published an event of another shard's experiment: %v", event)
+		}
+	}
+}
+
+func TestRecorderPublishesExperimentEvents(t *testing.T) {
+	b := testBus(&fakeBackend{})
+	fake := record.NewFakeRecorder(10)
+	recorder := b.Recorder(fake)
+	exp := &chaosv1alpha1.ChaosExperiment{ObjectMeta: metav1.ObjectMeta{Name: "web-kill", Namespace: "default"}}
+
+	recorder.Eventf(exp, corev1.EventTypeWarning, "ChaosInjectionFailed", "failed on %d pods", 2)
+	recorder.Event(&corev1.Pod{}, corev1.EventTypeNormal, "Other", "not an experiment")
+
+	if len(fake.Events) != 2 {
+		t.Errorf("expected events to reach the wrapped recorder, got %d", len(fake.Events))
+	}
+	events := drain(b)
+	if len(events) != 1 {
+		t.Fatalf("expected one published event, got %v", events)
+	}
+	if ev := events[0]; ev.Type != "ChaosInjectionFailed" || !ev.Warning || ev.Message != "failed on 2 pods" {
+		t.Errorf("unexpected event %+v", ev)
+	}
+
+	var nilBus *Bus
+	if nilBus.Recorder(fake) != fake {
+		t.Error("expected a nil bus to return the recorder unchanged")
+	}
+}
@@ -0,0 +1,109 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eventbus
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// Kafka publishes events through a Kafka REST Proxy (v2 API), so no Kafka client and broker
+// credentials are needed in the controller. Events are keyed by "<namespace>/<experiment>" so
+// that all events of an experiment land on the same partition, in order.
+type Kafka struct {
+	// URL is the base URL of the REST Proxy, e.g. "http://kafka-rest.kafka:8082"
+	URL string
+	// Topic receives every event
+	Topic string
+	// Username and Password authenticate with HTTP basic auth when set
+	Username string
+	Password string
+	// HTTPClient is used for requests; a client with a 10s timeout is used when nil
+	HTTPClient *http.Client
+}
+
+type kafkaRecords struct {
+	Records []kafkaRecord `json:"records"`
+}
+
+type kafkaRecord struct {
+	Key   string          `json:"key"`
+	Value json.RawMessage `json:"value"`
+}
+
+type kafkaResponse struct {
+	Offsets []struct {
+		ErrorCode *int   `json:"error_code"`
+		Error     string `json:"error"`
+	} `json:"offsets"`
+	Message string `json:"message"`
+}
+
+// Publish produces payload to the topic with the given key
+func (k *Kafka) Publish(ctx context.Context, _, key string, payload []byte) error {
+	body, err := json.Marshal(kafkaRecords{Records: []kafkaRecord{{Key: key, Value: payload}}})
+	if err != nil {
+		return err
+	}
+
+	endpoint := strings.TrimSuffix(k.URL, "/") + "/topics/" + url.PathEscape(k.Topic)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to build request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/vnd.kafka.json.v2+json")
+	req.Header.Set("Accept", "application/vnd.kafka.v2+json")
+	if k.Username != "" {
+		req.SetBasicAuth(k.Username, k.Password)
+	}
+
+	httpClient := k.HTTPClient
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: 10 * time.Second}
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("kafka REST proxy request failed: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	var result kafkaResponse
+	_ = json.NewDecoder(resp.Body).Decode(&result)
+	if resp.StatusCode != http.StatusOK {
+		if result.Message != "" {
+			return fmt.Errorf("kafka REST proxy responded with %s: %s", resp.Status, result.Message)
+		}
+		return fmt.Errorf("kafka REST proxy responded with %s", resp.Status)
+	}
+	for _, offset := range result.Offsets {
+		if offset.ErrorCode != nil {
+			return fmt.Errorf("failed to produce to %s: %s", k.Topic, offset.Error)
+		}
+	}
+	return nil
+}
+
+// Close is a no-op; requests do not keep state
+func (k *Kafka) Close() error {
+	return nil
+}
@@ -0,0 +1,82 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eventbus
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestKafkaPublish(t *testing.T) {
+	var received kafkaRecords
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/topics/chaos-events" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		if r.Header.Get("Content-Type") != "application/vnd.kafka.json.v2+json" {
+			t.Errorf("unexpected content type %s", r.Header.Get("Content-Type"))
+		}
+		if user, pass, ok := r.BasicAuth(); !ok || user != "chaos" || pass != "pw" {
+			t.Errorf("missing basic auth")
+		}
+		if err := json.NewDecoder(r.Body).Decode(&received); err != nil {
+			t.Errorf("invalid body: %v", err)
+		}
+		_, _ = w.Write([]byte(`{"offsets":[{"partition":0,"offset":42}]}`))
+	}))
+	defer srv.Close()
+
+	k := &Kafka{URL: srv.URL + "/", Topic: "chaos-events", Username: "chaos", Password: "pw"}
+	if err := k.Publish(context.Background(), "Failed", "default/web-kill", []byte(`{"type":"Failed"}`)); err != nil {
+		t.Fatalf("Publish() error = %v", err)
+	}
+	if len(received.Records) != 1 || received.Records[0].Key != "default/web-kill" ||
+		string(received.Records[0].Value) != `{"type":"Failed"}` {
+		t.Errorf("unexpected records %+v", received.Records)
+	}
+}
+
+func TestKafkaPublishErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		status   int
+		response string
+		want     string
+	}{
+		{"unknown topic", http.StatusNotFound, `{"error_code":40401,"message":"Topic not found."}`, "Topic not found."},
+		{"record rejected", http.StatusOK, `{"offsets":[{"error_code":1,"error":"record too large"}]}`, "record too large"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tt.status)
+				_, _ = w.Write([]byte(tt.response))
+			}))
+			defer srv.Close()
+
+			k := &Kafka{URL: srv.URL, Topic: "chaos-events"}
+			err := k.Publish(context.Background(), "Failed", "default/web-kill", []byte(`{}`))
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("expected error containing %q, got %v", tt.want, err)
+			}
+		})
+	}
+}
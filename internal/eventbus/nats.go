@@ -0,0 +1,186 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eventbus
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// natsTimeout bounds connecting and every publish round trip
+const natsTimeout = 10 * time.Second
+
+// NATS publishes events with the NATS text protocol, one subject per event type:
+// "<subject>.<type>", e.g. "chaos.events.Failed". Each publish is confirmed with a PING so that
+// permission errors surface instead of being dropped by the server.
+type NATS struct {
+	// URL is the server address, "nats://host:4222" or "tls://host:4222"
+	URL string
+	// Subject prefixes the subjects events are published to
+	Subject string
+	// Username and Password, or Token, authenticate when the server requires it
+	Username string
+	Password string
+	Token    string
+
+	mu     sync.Mutex
+	conn   net.Conn
+	reader *bufio.Reader
+}
+
+type natsInfo struct {
+	TLSRequired bool `json:"tls_required"`
+}
+
+type natsConnect struct {
+	Verbose  bool   `json:"verbose"`
+	Pedantic bool   `json:"pedantic"`
+	Name     string `json:"name"`
+	Lang     string `json:"lang"`
+	Version  string `json:"version"`
+	Protocol int    `json:"protocol"`
+	User     string `json:"user,omitempty"`
+	Pass     string `json:"pass,omitempty"`
+	Token    string `json:"auth_token,omitempty"`
+}
+
+// Publish sends payload to the subject of eventType, connecting first if needed
+func (n *NATS) Publish(ctx context.Context, eventType, _ string, payload []byte) error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	if n.conn == nil {
+		if err := n.connect(ctx); err != nil {
+			return err
+		}
+	}
+
+	subject := n.Subject + "." + eventType
+	err := n.roundTrip(fmt.Sprintf("PUB %s %d\r\n%s\r\nPING\r\n", subject, len(payload), payload))
+	if err != nil {
+		n.closeLocked()
+		return fmt.Errorf("failed to publish to %s: %w", subject, err)
+	}
+	return nil
+}
+
+// Close closes the connection; the next Publish reconnects
+func (n *NATS) Close() error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	return n.closeLocked()
+}
+
+func (n *NATS) closeLocked() error {
+	if n.conn == nil {
+		return nil
+	}
+	err := n.conn.Close()
+	n.conn, n.reader = nil, nil
+	return err
+}
+
+func (n *NATS) connect(ctx context.Context) error {
+	u, err := url.Parse(n.URL)
+	if err != nil {
+		return fmt.Errorf("invalid NATS url %q: %w", n.URL, err)
+	}
+	host := u.Host
+	if u.Port() == "" {
+		host = net.JoinHostPort(u.Hostname(), "4222")
+	}
+
+	dialer := &net.Dialer{Timeout: natsTimeout}
+	conn, err := dialer.DialContext(ctx, "tcp", host)
+	if err != nil {
+		return fmt.Errorf("failed to connect to NATS at %s: %w", host, err)
+	}
+	n.conn, n.reader = conn, bufio.NewReader(conn)
+
+	// The server greets with INFO before anything else, which also tells whether it expects TLS
+	_ = conn.SetDeadline(time.Now().Add(natsTimeout))
+	line, err := n.reader.ReadString('\n')
+	if err != nil || !strings.HasPrefix(line, "INFO ") {
+		_ = n.closeLocked()
+		return fmt.Errorf("unexpected NATS greeting %q: %v", strings.TrimSpace(line), err)
+	}
+	var info natsInfo
+	if err := json.Unmarshal([]byte(strings.TrimPrefix(line, "INFO ")), &info); err != nil {
+		_ = n.closeLocked()
+		return fmt.Errorf("invalid NATS INFO: %w", err)
+	}
+	if u.Scheme == "tls" || info.TLSRequired {
+		tlsConn := tls.Client(conn, &tls.Config{ServerName: u.Hostname(), MinVersion: tls.VersionTLS12})
+		if err := tlsConn.HandshakeContext(ctx); err != nil {
+			_ = n.closeLocked()
+			return fmt.Errorf("NATS TLS handshake failed: %w", err)
+		}
+		n.conn, n.reader = tlsConn, bufio.NewReader(tlsConn)
+	}
+
+	connect, err := json.Marshal(natsConnect{
+		Name:    "k8s-chaos",
+		Lang:    "go",
+		Version: "1.0.0",
+		User:    n.Username,
+		Pass:    n.Password,
+		Token:   n.Token,
+	})
+	if err != nil {
+		_ = n.closeLocked()
+		return err
+	}
+	if err := n.roundTrip("CONNECT " + string(connect) + "\r\nPING\r\n"); err != nil {
+		_ = n.closeLocked()
+		return fmt.Errorf("NATS connect failed: %w", err)
+	}
+	return nil
+}
+
+// roundTrip writes commands ending with PING and waits for the PONG, failing on -ERR
+func (n *NATS) roundTrip(commands string) error {
+	_ = n.conn.SetDeadline(time.Now().Add(natsTimeout))
+	if _, err := n.conn.Write([]byte(commands)); err != nil {
+		return err
+	}
+	for {
+		line, err := n.reader.ReadString('\n')
+		if err != nil {
+			return err
+		}
+		line = strings.TrimSpace(line)
+		switch {
+		case line == "PONG":
+			return nil
+		case line == "PING":
+			if _, err := n.conn.Write([]byte("PONG\r\n")); err != nil {
+				return err
+			}
+		case strings.HasPrefix(line, "-ERR"):
+			return fmt.Errorf("server error: %s", strings.TrimSpace(strings.TrimPrefix(line, "-ERR")))
+		}
+		// +OK and INFO updates need no answer
+	}
+}
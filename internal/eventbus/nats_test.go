@@ -0,0 +1,140 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eventbus
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// fakeNATS accepts connections and records published messages; subjects containing "denied"
+// are rejected like a permissions violation
+type fakeNATS struct {
+	listener  net.Listener
+	connects  chan string
+	published chan string
+}
+
+func newFakeNATS(t *testing.T) *fakeNATS {
+	t.Helper()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	f := &fakeNATS{listener: listener, connects: make(chan string, 10), published: make(chan string, 10)}
+	t.Cleanup(func() { _ = listener.Close() })
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go f.serve(conn)
+		}
+	}()
+	return f
+}
+
+func (f *fakeNATS) url() string {
+	return "nats://" + f.listener.Addr().String()
+}
+
+func (f *fakeNATS) serve(conn net.Conn) {
+	defer func() { _ = conn.Close() }()
+	reader := bufio.NewReader(conn)
+	_, _ = fmt.Fprint(conn, "INFO {\"server_id\":\"fake\",\"max_payload\":1048576}\r\n")
+	for {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			return
+		}
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+		switch fields[0] {
+		case "CONNECT":
+			f.connects <- strings.TrimSpace(strings.TrimPrefix(line, "CONNECT "))
+		case "PING":
+			_, _ = fmt.Fprint(conn, "PONG\r\n")
+		case "PUB":
+			size, _ := strconv.Atoi(fields[2])
+			payload := make([]byte, size+2)
+			if _, err := io.ReadFull(reader, payload); err != nil {
+				return
+			}
+			if strings.Contains(fields[1], "denied") {
+				_, _ = fmt.Fprintf(conn, "-ERR 'Permissions Violation for Publish to %s'\r\n", fields[1])
+				return
+			}
+			f.published <- fields[1] + " " + string(payload[:size])
+		}
+	}
+}
+
+func TestNATSPublish(t *testing.T) {
+	server := newFakeNATS(t)
+	n := &NATS{URL: server.url(), Subject: "chaos.events", Token: "t0ken"}
+	defer func() { _ = n.Close() }()
+
+	ctx := context.Background()
+	if err := n.Publish(ctx, "Failed", "default/web-kill", []byte(`{"type":"Failed"}`)); err != nil {
+		t.Fatalf("Publish() error = %v", err)
+	}
+	if connect := <-server.connects; !strings.Contains(connect, `"auth_token":"t0ken"`) {
+		t.Errorf("CONNECT without token: %s", connect)
+	}
+	if got := <-server.published; got != `chaos.events.Failed {"type":"Failed"}` {
+		t.Errorf("published %q", got)
+	}
+
+	// The connection is reused
+	if err := n.Publish(ctx, "Started", "default/web-kill", []byte(`{}`)); err != nil {
+		t.Fatalf("Publish() error = %v", err)
+	}
+	if got := <-server.published; got != "chaos.events.Started {}" {
+		t.Errorf("published %q", got)
+	}
+	if len(server.connects) != 0 {
+		t.Error("expected a single connection")
+	}
+}
+
+func TestNATSPublishError(t *testing.T) {
+	server := newFakeNATS(t)
+	n := &NATS{URL: server.url(), Subject: "denied"}
+	defer func() { _ = n.Close() }()
+
+	err := n.Publish(context.Background(), "Failed", "default/web-kill", []byte(`{}`))
+	if err == nil || !strings.Contains(err.Error(), "Permissions Violation") {
+		t.Fatalf("expected the server error, got %v", err)
+	}
+	if n.conn != nil {
+		t.Error("expected the connection to be dropped after an error")
+	}
+
+	n.URL = "nats://127.0.0.1:1"
+	if err := n.Publish(context.Background(), "Failed", "", nil); err == nil {
+		t.Error("expected an unreachable server to fail")
+	}
+}
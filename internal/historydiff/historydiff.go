@@ -0,0 +1,142 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package historydiff compares ChaosExperimentHistory records. It is shared by the controller,
+// which flags regressions between consecutive runs, and the CLI `history diff` command.
+package historydiff
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"time"
+
+	chaosv1alpha1 "github.com/neogan74/k8s-chaos/api/v1alpha1"
+)
+
+const statusSuccess = "success"
+
+// Change is a single spec field that differs between two records
+type Change struct {
+	Field string
+	A     string
+	B     string
+}
+
+// Regressions compares a run against the previous run of the same experiment and returns a
+// description of every metric that got worse. Recovery time regresses when it grows by more than
+// thresholdPercent; the outcome regresses when a previously successful run no longer succeeds.
+func Regressions(previous, current *chaosv1alpha1.ChaosExperimentHistory, thresholdPercent int) []string {
+	var regressions []string
+
+	prevRecovery, prevErr := time.ParseDuration(previous.Spec.Execution.RecoveryTime)
+	curRecovery, curErr := time.ParseDuration(current.Spec.Execution.RecoveryTime)
+	if prevErr == nil && curErr == nil && prevRecovery > 0 {
+		increase := int((curRecovery - prevRecovery) * 100 / prevRecovery)
+		if increase > thresholdPercent {
+			regressions = append(regressions, fmt.Sprintf("recoveryTime: %s -> %s (+%d%%)",
+				prevRecovery, curRecovery, increase))
+		}
+	}
+
+	if previous.Spec.Execution.Status == statusSuccess && current.Spec.Execution.Status != statusSuccess {
+		regressions = append(regressions, fmt.Sprintf("status: %s -> %s",
+			previous.Spec.Execution.Status, current.Spec.Execution.Status))
+	}
+
+	return regressions
+}
+
+// SpecChanges returns the experiment spec fields that differ between two records, keyed by their
+// JSON path (e.g. "selector.app", "duration")
+func SpecChanges(a, b *chaosv1alpha1.ChaosExperimentHistory) ([]Change, error) {
+	flatA, err := flatten(a.Spec.ExperimentSpec)
+	if err != nil {
+		return nil, err
+	}
+	flatB, err := flatten(b.Spec.ExperimentSpec)
+	if err != nil {
+		return nil, err
+	}
+
+	fields := map[string]bool{}
+	for field := range flatA {
+		fields[field] = true
+	}
+	for field := range flatB {
+		fields[field] = true
+	}
+
+	var changes []Change
+	for field := range fields {
+		valueA, inA := flatA[field]
+		valueB, inB := flatB[field]
+		if inA && inB && reflect.DeepEqual(valueA, valueB) {
+			continue
+		}
+		changes = append(changes, Change{Field: field, A: formatValue(valueA, inA), B: formatValue(valueB, inB)})
+	}
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].Field < changes[j].Field
+	})
+	return changes, nil
+}
+
+// flatten encodes a value as JSON and flattens nested objects into dot-separated keys.
+// Arrays are kept as leaf values.
+func flatten(value interface{}) (map[string]interface{}, error) {
+	raw, err := json.Marshal(value)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode experiment spec: %w", err)
+	}
+	var decoded map[string]interface{}
+	if err := json.Unmarshal(raw, &decoded); err != nil {
+		return nil, fmt.Errorf("failed to decode experiment spec: %w", err)
+	}
+
+	result := map[string]interface{}{}
+	var walk func(prefix string, obj map[string]interface{})
+	walk = func(prefix string, obj map[string]interface{}) {
+		for key, v := range obj {
+			path := key
+			if prefix != "" {
+				path = prefix + "." + key
+			}
+			if nested, ok := v.(map[string]interface{}); ok {
+				walk(path, nested)
+				continue
+			}
+			result[path] = v
+		}
+	}
+	walk("", decoded)
+	return result, nil
+}
+
+func formatValue(value interface{}, present bool) string {
+	if !present {
+		return "<unset>"
+	}
+	if s, ok := value.(string); ok {
+		return s
+	}
+	raw, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+	return string(raw)
+}
@@ -0,0 +1,125 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package historydiff
+
+import (
+	"reflect"
+	"testing"
+
+	chaosv1alpha1 "github.com/neogan74/k8s-chaos/api/v1alpha1"
+)
+
+func record(status, recovery string) *chaosv1alpha1.ChaosExperimentHistory {
+	return &chaosv1alpha1.ChaosExperimentHistory{
+		Spec: chaosv1alpha1.ChaosExperimentHistorySpec{
+			ExperimentSpec: chaosv1alpha1.ChaosExperimentSpec{
+				Action:    "pod-kill",
+				Namespace: "default",
+				Selector:  map[string]string{"app": "nginx"},
+				Count:     1,
+			},
+			Execution: chaosv1alpha1.ExecutionDetails{Status: status, RecoveryTime: recovery},
+		},
+	}
+}
+
+func TestRegressions(t *testing.T) {
+	tests := []struct {
+		name      string
+		previous  *chaosv1alpha1.ChaosExperimentHistory
+		current   *chaosv1alpha1.ChaosExperimentHistory
+		threshold int
+		want      []string
+	}{
+		{
+			name:      "no change",
+			previous:  record("success", "10s"),
+			current:   record("success", "10s"),
+			threshold: 20,
+		},
+		{
+			name:      "recovery within threshold",
+			previous:  record("success", "10s"),
+			current:   record("success", "12s"),
+			threshold: 20,
+		},
+		{
+			name:      "recovery beyond threshold",
+			previous:  record("success", "10s"),
+			current:   record("success", "15s"),
+			threshold: 20,
+			want:      []string{"recoveryTime: 10s -> 15s (+50%)"},
+		},
+		{
+			name:      "recovery not measured",
+			previous:  record("success", ""),
+			current:   record("success", "15s"),
+			threshold: 20,
+		},
+		{
+			name:      "success to failure",
+			previous:  record("success", ""),
+			current:   record("failure", ""),
+			threshold: 20,
+			want:      []string{"status: success -> failure"},
+		},
+		{
+			name:      "failure to success is not a regression",
+			previous:  record("failure", ""),
+			current:   record("success", ""),
+			threshold: 20,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Regressions(tt.previous, tt.current, tt.threshold)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Regressions() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSpecChanges(t *testing.T) {
+	a := record("success", "")
+	b := record("success", "")
+	b.Spec.ExperimentSpec.Count = 3
+	b.Spec.ExperimentSpec.Selector["tier"] = "web"
+	b.Spec.ExperimentSpec.Duration = "30s"
+
+	changes, err := SpecChanges(a, b)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := []Change{
+		{Field: "count", A: "1", B: "3"},
+		{Field: "duration", A: "<unset>", B: "30s"},
+		{Field: "selector.tier", A: "<unset>", B: "web"},
+	}
+	if !reflect.DeepEqual(changes, want) {
+		t.Errorf("SpecChanges() = %+v, want %+v", changes, want)
+	}
+
+	changes, err = SpecChanges(a, a)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(changes) != 0 {
+		t.Errorf("expected no changes comparing a record with itself, got %+v", changes)
+	}
+}
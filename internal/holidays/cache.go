@@ -0,0 +1,123 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package holidays
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// maxCalendarSize bounds how much of a calendar is read; public holiday feeds are a few KiB
+const maxCalendarSize = 4 << 20
+
+// Source returns the raw content of a calendar
+type Source func(ctx context.Context) (string, error)
+
+// URLSource fetches a calendar over HTTP; a client with a 10s timeout is used when httpClient is nil
+func URLSource(httpClient *http.Client, url string) Source {
+	return func(ctx context.Context) (string, error) {
+		if httpClient == nil {
+			httpClient = &http.Client{Timeout: 10 * time.Second}
+		}
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+		if err != nil {
+			return "", fmt.Errorf("failed to build calendar request: %w", err)
+		}
+		resp, err := httpClient.Do(req)
+		if err != nil {
+			return "", fmt.Errorf("failed to fetch calendar: %w", err)
+		}
+		defer func() { _ = resp.Body.Close() }()
+		if resp.StatusCode != http.StatusOK {
+			return "", fmt.Errorf("failed to fetch calendar: HTTP %d", resp.StatusCode)
+		}
+		body, err := io.ReadAll(io.LimitReader(resp.Body, maxCalendarSize))
+		if err != nil {
+			return "", fmt.Errorf("failed to read calendar: %w", err)
+		}
+		return string(body), nil
+	}
+}
+
+// entry is a cached calendar
+type entry struct {
+	calendar Calendar
+	// err is the error of the last fetch, kept until the next one
+	err       error
+	nextFetch time.Time
+}
+
+// Cache keeps fetched calendars, so experiments do not download the same feed on every reconcile.
+// A failed refresh keeps serving the calendar fetched before and is retried sooner than a
+// successful one. A nil Cache fetches on every call.
+type Cache struct {
+	// Refresh is how long a fetched calendar is used before it is fetched again
+	Refresh time.Duration
+	// Retry is how long a failed fetch is remembered before it is tried again
+	Retry time.Duration
+
+	mu      sync.Mutex
+	entries map[string]*entry
+}
+
+// NewCache returns an empty Cache refreshing calendars after refresh
+func NewCache(refresh time.Duration) *Cache {
+	return &Cache{Refresh: refresh, Retry: time.Minute, entries: map[string]*entry{}}
+}
+
+// Get returns the calendar cached under key, fetching it from source and parsing it in loc when
+// it is missing or due for a refresh. Keys should identify the source and location, so a changed
+// reference is fetched right away. When the last fetch failed, its error is returned along with
+// the calendar fetched before, which is nil if there was none.
+func (c *Cache) Get(ctx context.Context, key string, source Source, loc *time.Location, now time.Time) (Calendar, error) {
+	if c == nil {
+		return fetch(ctx, source, loc)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e, ok := c.entries[key]
+	if !ok {
+		e = &entry{}
+		c.entries[key] = e
+	}
+	if now.Before(e.nextFetch) {
+		return e.calendar, e.err
+	}
+
+	calendar, err := fetch(ctx, source, loc)
+	e.err = err
+	if err != nil {
+		e.nextFetch = now.Add(c.Retry)
+		return e.calendar, err
+	}
+	e.calendar, e.nextFetch = calendar, now.Add(c.Refresh)
+	return calendar, nil
+}
+
+// fetch reads and parses a calendar
+func fetch(ctx context.Context, source Source, loc *time.Location) (Calendar, error) {
+	data, err := source(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return Parse(data, loc)
+}
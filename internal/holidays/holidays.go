@@ -0,0 +1,221 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package holidays reads holiday calendars, iCalendar (ICS) feeds or plain lists of dates, and
+// tells whether a point in time falls on one of their days. ChaosPolicies use them to block chaos
+// on public holidays and company freeze days.
+package holidays
+
+import (
+	"bufio"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Holiday is a period during which chaos is blocked
+type Holiday struct {
+	Name string
+	// Start and End bound the period; End is exclusive
+	Start, End time.Time
+	// Yearly repeats the period every year from Start on (RRULE:FREQ=YEARLY)
+	Yearly bool
+}
+
+// Calendar is the list of holidays of one calendar
+type Calendar []Holiday
+
+// At returns the holiday covering t. Occurrences of yearly holidays are returned with the Start
+// and End of the year they fall in.
+func (c Calendar) At(t time.Time) (Holiday, bool) {
+	for _, h := range c {
+		if !h.Yearly {
+			if !t.Before(h.Start) && t.Before(h.End) {
+				return h, true
+			}
+			continue
+		}
+		// An occurrence covering t started this year or, spanning New Year, the year before
+		for year := t.Year() - 1; year <= t.Year(); year++ {
+			if year < h.Start.Year() {
+				continue
+			}
+			shift := year - h.Start.Year()
+			occurrence := Holiday{Name: h.Name, Start: h.Start.AddDate(shift, 0, 0), End: h.End.AddDate(shift, 0, 0)}
+			if !t.Before(occurrence.Start) && t.Before(occurrence.End) {
+				return occurrence, true
+			}
+		}
+	}
+	return Holiday{}, false
+}
+
+// Parse reads an ICS calendar, or a list of dates when data is not one. Dates and events without
+// a time zone are taken to be in loc.
+func Parse(data string, loc *time.Location) (Calendar, error) {
+	trimmed := strings.TrimSpace(strings.TrimPrefix(data, "\ufeff"))
+	if strings.HasPrefix(strings.ToUpper(trimmed), "BEGIN:VCALENDAR") {
+		return parseICS(trimmed, loc), nil
+	}
+	return parseDateList(trimmed, loc)
+}
+
+// parseDateList reads one date ("2026-12-25") or inclusive range of dates ("2026-12-20/2026-12-31")
+// per line, optionally followed by a name. Blank lines and lines starting with # are skipped.
+func parseDateList(data string, loc *time.Location) (Calendar, error) {
+	var calendar Calendar
+	scanner := bufio.NewScanner(strings.NewReader(data))
+	for n := 1; scanner.Scan(); n++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		dates, name, _ := strings.Cut(line, " ")
+		first, last, isRange := strings.Cut(dates, "/")
+		start, err := time.ParseInLocation(time.DateOnly, first, loc)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: invalid date %q", n, first)
+		}
+		end := start
+		if isRange {
+			if end, err = time.ParseInLocation(time.DateOnly, last, loc); err != nil {
+				return nil, fmt.Errorf("line %d: invalid date %q", n, last)
+			}
+			if end.Before(start) {
+				return nil, fmt.Errorf("line %d: range ends before it starts", n)
+			}
+		}
+		calendar = append(calendar, Holiday{
+			Name:  strings.TrimSpace(name),
+			Start: start,
+			End:   end.AddDate(0, 0, 1),
+		})
+	}
+	return calendar, scanner.Err()
+}
+
+// parseICS reads the VEVENTs of an ICS calendar. Only yearly recurrence rules are expanded;
+// events with other rules count once, and events without a readable DTSTART or that were
+// cancelled are skipped, so one odd entry of a public feed does not make the whole feed unusable.
+func parseICS(data string, loc *time.Location) Calendar {
+	// Unfold continuation lines (RFC 5545 section 3.1)
+	data = strings.ReplaceAll(data, "\r\n", "\n")
+	data = strings.ReplaceAll(data, "\n ", "")
+	data = strings.ReplaceAll(data, "\n\t", "")
+
+	var calendar Calendar
+	var event map[string]icsProperty
+	for _, line := range strings.Split(data, "\n") {
+		name, prop, ok := parseICSLine(line)
+		if !ok {
+			continue
+		}
+		switch {
+		case name == "BEGIN" && strings.EqualFold(prop.value, "VEVENT"):
+			event = map[string]icsProperty{}
+		case name == "END" && strings.EqualFold(prop.value, "VEVENT"):
+			if h, ok := icsHoliday(event, loc); ok {
+				calendar = append(calendar, h)
+			}
+			event = nil
+		case event != nil:
+			event[name] = prop
+		}
+	}
+	return calendar
+}
+
+// icsProperty is the value and parameters of a content line
+type icsProperty struct {
+	value  string
+	params map[string]string
+}
+
+// parseICSLine splits a content line such as "DTSTART;VALUE=DATE:20261225"
+func parseICSLine(line string) (string, icsProperty, bool) {
+	head, value, ok := strings.Cut(strings.TrimRight(line, "\r"), ":")
+	if !ok {
+		return "", icsProperty{}, false
+	}
+	parts := strings.Split(head, ";")
+	prop := icsProperty{value: value, params: map[string]string{}}
+	for _, param := range parts[1:] {
+		if k, v, ok := strings.Cut(param, "="); ok {
+			prop.params[strings.ToUpper(k)] = strings.Trim(v, `"`)
+		}
+	}
+	return strings.ToUpper(parts[0]), prop, true
+}
+
+// icsHoliday turns the properties of a VEVENT into a Holiday
+func icsHoliday(event map[string]icsProperty, loc *time.Location) (Holiday, bool) {
+	if strings.EqualFold(event["STATUS"].value, "CANCELLED") {
+		return Holiday{}, false
+	}
+	dtstart, ok := event["DTSTART"]
+	if !ok {
+		return Holiday{}, false
+	}
+	start, allDay, err := parseICSTime(dtstart, loc)
+	if err != nil {
+		return Holiday{}, false
+	}
+
+	var end time.Time
+	if dtend, ok := event["DTEND"]; ok {
+		if end, _, err = parseICSTime(dtend, loc); err != nil {
+			return Holiday{}, false
+		}
+	} else if allDay {
+		end = start.AddDate(0, 0, 1)
+	}
+	if !end.After(start) {
+		return Holiday{}, false
+	}
+
+	return Holiday{
+		Name:   unescapeICSText(event["SUMMARY"].value),
+		Start:  start,
+		End:    end,
+		Yearly: strings.Contains(strings.ToUpper(event["RRULE"].value), "FREQ=YEARLY"),
+	}, true
+}
+
+// parseICSTime reads a DATE or DATE-TIME value and reports whether it is a DATE
+func parseICSTime(prop icsProperty, loc *time.Location) (time.Time, bool, error) {
+	if tzid := prop.params["TZID"]; tzid != "" {
+		if l, err := time.LoadLocation(tzid); err == nil {
+			loc = l
+		}
+	}
+	value := strings.TrimSpace(prop.value)
+	switch {
+	case prop.params["VALUE"] == "DATE" || len(value) == len("20060102"):
+		t, err := time.ParseInLocation("20060102", value, loc)
+		return t, true, err
+	case strings.HasSuffix(value, "Z"):
+		t, err := time.Parse("20060102T150405Z", value)
+		return t, false, err
+	default:
+		t, err := time.ParseInLocation("20060102T150405", value, loc)
+		return t, false, err
+	}
+}
+
+// unescapeICSText undoes the escaping of TEXT values
+func unescapeICSText(s string) string {
+	return strings.NewReplacer(`\n`, " ", `\N`, " ", `\,`, ",", `\;`, ";", `\\`, `\`).Replace(s)
+}
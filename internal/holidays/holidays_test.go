@@ -0,0 +1,207 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package holidays
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+const testICS = "BEGIN:VCALENDAR\r\n" +
+	"VERSION:2.0\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"DTSTART;VALUE=DATE:20261225\r\n" +
+	"DTEND;VALUE=DATE:20261226\r\n" +
+	"SUMMARY:Christmas\\, Day\r\n" +
+	"END:VEVENT\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"DTSTART;VALUE=DATE:20200101\r\n" +
+	"RRULE:FREQ=YEARLY\r\n" +
+	"SUMMARY:New Year\r\n" +
+	" 's Day\r\n" +
+	"END:VEVENT\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"DTSTART:20261001T220000Z\r\n" +
+	"DTEND:20261002T020000Z\r\n" +
+	"SUMMARY:Release freeze\r\n" +
+	"END:VEVENT\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"DTSTART;TZID=America/New_York:20261126T000000\r\n" +
+	"DTEND;TZID=America/New_York:20261127T000000\r\n" +
+	"SUMMARY:Thanksgiving\r\n" +
+	"END:VEVENT\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"DTSTART;VALUE=DATE:20260704\r\n" +
+	"STATUS:CANCELLED\r\n" +
+	"SUMMARY:Cancelled\r\n" +
+	"END:VEVENT\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"DTSTART:not-a-date\r\n" +
+	"SUMMARY:Broken\r\n" +
+	"END:VEVENT\r\n" +
+	"END:VCALENDAR\r\n"
+
+func TestParseICS(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Fatal(err)
+	}
+	calendar, err := Parse(testICS, berlin)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(calendar) != 4 {
+		t.Fatalf("expected 4 holidays without the cancelled and broken events, got %d", len(calendar))
+	}
+
+	tests := []struct {
+		at   time.Time
+		name string
+	}{
+		{time.Date(2026, 12, 25, 0, 0, 0, 0, berlin), "Christmas, Day"},
+		{time.Date(2026, 12, 25, 23, 59, 0, 0, berlin), "Christmas, Day"},
+		{time.Date(2026, 12, 26, 0, 0, 0, 0, berlin), ""},
+		// All-day events are in the calendar's time zone: 23:30 UTC is already the 25th in Berlin
+		{time.Date(2026, 12, 24, 23, 30, 0, 0, time.UTC), "Christmas, Day"},
+		{time.Date(2031, 1, 1, 12, 0, 0, 0, berlin), "New Year's Day"},
+		{time.Date(2019, 1, 1, 12, 0, 0, 0, berlin), ""},
+		{time.Date(2026, 10, 2, 1, 0, 0, 0, time.UTC), "Release freeze"},
+		{time.Date(2026, 10, 2, 2, 0, 0, 0, time.UTC), ""},
+		{time.Date(2026, 11, 26, 4, 0, 0, 0, time.UTC), ""},
+		{time.Date(2026, 11, 26, 6, 0, 0, 0, time.UTC), "Thanksgiving"},
+		{time.Date(2026, 7, 4, 12, 0, 0, 0, berlin), ""},
+	}
+	for _, tt := range tests {
+		h, ok := calendar.At(tt.at)
+		if ok != (tt.name != "") || h.Name != tt.name {
+			t.Errorf("At(%s) = %q, %v; expected %q", tt.at, h.Name, ok, tt.name)
+		}
+	}
+
+	h, _ := calendar.At(time.Date(2031, 1, 1, 12, 0, 0, 0, berlin))
+	if !h.End.Equal(time.Date(2031, 1, 2, 0, 0, 0, 0, berlin)) {
+		t.Errorf("expected the occurrence of a yearly holiday to end on Jan 2 2031, got %s", h.End)
+	}
+}
+
+func TestParseDateList(t *testing.T) {
+	calendar, err := Parse(`
+# Company freeze days
+2026-05-01 Labour Day
+2026-12-20/2026-12-31 Year-end freeze
+2026-08-14
+`, time.UTC)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		at   time.Time
+		name string
+		ok   bool
+	}{
+		{time.Date(2026, 5, 1, 9, 0, 0, 0, time.UTC), "Labour Day", true},
+		{time.Date(2026, 5, 2, 0, 0, 0, 0, time.UTC), "", false},
+		{time.Date(2026, 12, 20, 0, 0, 0, 0, time.UTC), "Year-end freeze", true},
+		{time.Date(2026, 12, 31, 23, 0, 0, 0, time.UTC), "Year-end freeze", true},
+		{time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC), "", false},
+		{time.Date(2026, 8, 14, 12, 0, 0, 0, time.UTC), "", true},
+	}
+	for _, tt := range tests {
+		h, ok := calendar.At(tt.at)
+		if ok != tt.ok || h.Name != tt.name {
+			t.Errorf("At(%s) = %q, %v; expected %q, %v", tt.at, h.Name, ok, tt.name, tt.ok)
+		}
+	}
+
+	for _, invalid := range []string{"2026-13-01", "christmas", "2026-12-31/2026-12-20 backwards"} {
+		if _, err := Parse(invalid, time.UTC); err == nil {
+			t.Errorf("expected %q to be rejected", invalid)
+		}
+	}
+}
+
+func TestCache(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 12, 25, 12, 0, 0, 0, time.UTC)
+	fetches := 0
+	fail := false
+	source := func(context.Context) (string, error) {
+		fetches++
+		if fail {
+			return "", errors.New("unavailable")
+		}
+		return "2026-12-25 Christmas", nil
+	}
+
+	cache := NewCache(time.Hour)
+	if _, err := cache.Get(ctx, "key", source, time.UTC, now); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := cache.Get(ctx, "key", source, time.UTC, now.Add(30*time.Minute)); err != nil || fetches != 1 {
+		t.Fatalf("expected the cached calendar within the refresh interval, got %d fetches, err %v", fetches, err)
+	}
+
+	// A failed refresh keeps serving the previous calendar and is retried after Retry
+	fail = true
+	calendar, err := cache.Get(ctx, "key", source, time.UTC, now.Add(2*time.Hour))
+	if err == nil || len(calendar) != 1 {
+		t.Fatalf("expected the previous calendar with the error, got %v, %v", calendar, err)
+	}
+	if _, err := cache.Get(ctx, "key", source, time.UTC, now.Add(2*time.Hour+30*time.Second)); err == nil || fetches != 2 {
+		t.Fatalf("expected the failure to be remembered, got %d fetches, err %v", fetches, err)
+	}
+	fail = false
+	if _, err := cache.Get(ctx, "key", source, time.UTC, now.Add(2*time.Hour+2*time.Minute)); err != nil || fetches != 3 {
+		t.Fatalf("expected a retry after the retry interval, got %d fetches, err %v", fetches, err)
+	}
+
+	// Without a previous calendar there is nothing to fall back to
+	fail = true
+	if calendar, err := cache.Get(ctx, "other", source, time.UTC, now); err == nil || calendar != nil {
+		t.Fatalf("expected no calendar and an error, got %v, %v", calendar, err)
+	}
+
+	var nilCache *Cache
+	fail = false
+	if _, err := nilCache.Get(ctx, "key", source, time.UTC, now); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestURLSource(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/holidays.ics" {
+			http.NotFound(w, r)
+			return
+		}
+		_, _ = w.Write([]byte(testICS))
+	}))
+	defer server.Close()
+
+	data, err := URLSource(nil, server.URL+"/holidays.ics")(context.Background())
+	if err != nil || data != testICS {
+		t.Fatalf("expected the calendar, got %q, %v", data, err)
+	}
+	if _, err := URLSource(server.Client(), server.URL+"/missing.ics")(context.Background()); err == nil {
+		t.Error("expected an error for HTTP 404")
+	}
+}
@@ -59,13 +59,59 @@ var (
 		[]string{"action", "namespace", "error_type"},
 	)
 
-	// ActiveExperiments tracks the number of currently active experiments
-	ActiveExperiments = prometheus.NewGaugeVec(
-		prometheus.GaugeOpts{
-			Name: "chaosexperiment_active",
-			Help: "Number of currently active chaos experiments",
+	// ActiveExperimentsDesc describes the number of Running experiments per action. It is computed
+	// from cluster state by a collector in the controller, not updated by reconciles.
+	ActiveExperimentsDesc = prometheus.NewDesc(
+		"chaosexperiment_active",
+		"Number of currently active chaos experiments",
+		[]string{"action"}, nil,
+	)
+
+	// ActiveExperimentDesc describes one series per Running experiment, always 1
+	ActiveExperimentDesc = prometheus.NewDesc(
+		"chaosexperiment_active_experiment",
+		"Chaos experiments currently in the Running phase (always 1)",
+		[]string{"namespace", "experiment", "action"}, nil,
+	)
+
+	// RecoveryLatency tracks the time from chaos injection until the target recovered: a replacement
+	// pod is Ready, a restarted container is Ready, the netem qdisc is removed or a node is reverted
+	RecoveryLatency = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "chaosexperiment_recovery_seconds",
+			Help:    "Time from chaos injection to target recovery in seconds",
+			Buckets: []float64{1, 2, 5, 10, 20, 30, 60, 120, 300, 600, 1200, 1800},
 		},
-		[]string{"action"},
+		[]string{"action", "namespace"},
+	)
+
+	// RecoveryTimeouts counts targets that did not recover within the tracking window
+	RecoveryTimeouts = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "chaosexperiment_recovery_timeouts_total",
+			Help: "Total number of injected targets that did not recover within 30 minutes",
+		},
+		[]string{"action", "namespace"},
+	)
+
+	// NodeReplacementLatency tracks the time from draining a node until a new node took its place
+	// (spec.nodeReplacement)
+	NodeReplacementLatency = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "chaosexperiment_node_replacement_seconds",
+			Help:    "Time from a node drain until a replacement node was Ready in seconds",
+			Buckets: []float64{30, 60, 90, 120, 180, 240, 300, 450, 600, 900, 1200, 1800},
+		},
+		[]string{"action", "namespace"},
+	)
+
+	// NodeReplacementTimeouts counts drained nodes no replacement was found for in time
+	NodeReplacementTimeouts = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "chaosexperiment_node_replacement_timeouts_total",
+			Help: "Total number of drained nodes not replaced within spec.nodeReplacement.timeout",
+		},
+		[]string{"action", "namespace"},
 	)
 
 	// HistoryRecordsTotal counts the total number of history records created
@@ -86,6 +132,15 @@ var (
 		[]string{"reason"},
 	)
 
+	// HistoryCompactionsTotal counts history records compacted to stay within the size budget
+	HistoryCompactionsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "chaosexperiment_history_compactions_total",
+			Help: "Total number of history records compacted to keep experiments within the history size budget",
+		},
+		[]string{"action"},
+	)
+
 	// HistoryRecordsCount tracks the current number of history records per experiment
 	HistoryRecordsCount = prometheus.NewGaugeVec(
 		prometheus.GaugeOpts{
@@ -95,6 +150,51 @@ var (
 		[]string{"experiment", "namespace"},
 	)
 
+	// HistoryRegressions counts runs that regressed compared to the previous run of the same experiment
+	HistoryRegressions = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "chaosexperiment_history_regressions_total",
+			Help: "Total number of experiment runs that regressed compared to the previous run",
+		},
+		[]string{"action", "namespace"},
+	)
+
+	// ResultWebhookDeliveries counts history records delivered to result webhooks, per endpoint
+	ResultWebhookDeliveries = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "chaosexperiment_result_webhook_deliveries_total",
+			Help: "Total number of history record deliveries to result webhooks by outcome (success, failed, dropped)",
+		},
+		[]string{"result"},
+	)
+
+	// EventBusMessages counts lifecycle events published to Kafka or NATS
+	EventBusMessages = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "chaosexperiment_event_bus_messages_total",
+			Help: "Total number of experiment events published to the event bus by outcome (success, failed, dropped)",
+		},
+		[]string{"result"},
+	)
+
+	// AuditLogMessages counts history records forwarded to the SIEM syslog collector
+	AuditLogMessages = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "chaosexperiment_audit_log_messages_total",
+			Help: "Total number of history records forwarded to the syslog collector by outcome (success, failed, dropped)",
+		},
+		[]string{"result"},
+	)
+
+	// DigestEmails counts the activity digests mailed to the configured recipients
+	DigestEmails = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "chaosexperiment_digest_emails_total",
+			Help: "Total number of chaos activity digests mailed by outcome (success, failed)",
+		},
+		[]string{"result"},
+	)
+
 	// SafetyDryRunExecutions counts experiments executed in dry-run mode
 	SafetyDryRunExecutions = prometheus.NewCounterVec(
 		prometheus.CounterOpts{
@@ -130,6 +230,106 @@ var (
 		},
 		[]string{"action", "namespace", "resource_type"},
 	)
+
+	// SafetyFreezeBlocks counts experiments held or rejected because of an active chaos freeze
+	SafetyFreezeBlocks = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "chaosexperiment_safety_freeze_blocks_total",
+			Help: "Total number of experiments paused or rejected due to an active chaos freeze",
+		},
+		[]string{"action", "namespace"},
+	)
+
+	// SafetyRateLimitBlocks counts injection rounds held back by a ChaosPolicy rate limit
+	SafetyRateLimitBlocks = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "chaosexperiment_safety_rate_limit_blocks_total",
+			Help: "Total number of injection rounds held back by a ChaosPolicy rate limit",
+		},
+		[]string{"action", "namespace"},
+	)
+
+	// SafetySeverityBlocks counts experiments rejected or injection rounds held back by ChaosPolicy severity rules
+	SafetySeverityBlocks = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "chaosexperiment_safety_severity_blocks_total",
+			Help: "Total number of experiments rejected or injection rounds held back by ChaosPolicy severity rules",
+		},
+		[]string{"action", "namespace", "severity"},
+	)
+
+	// InjectionRounds counts the injection rounds started, by severity
+	InjectionRounds = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "chaosexperiment_injection_rounds_total",
+			Help: "Total number of injection rounds started, by experiment severity",
+		},
+		[]string{"action", "namespace", "severity"},
+	)
+
+	// InjectionVerifications counts the probes of injected faults by outcome
+	InjectionVerifications = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "chaosexperiment_injection_verifications_total",
+			Help: "Total number of injected faults probed in their target, by whether the effect was found",
+		},
+		[]string{"action", "namespace", "result"},
+	)
+
+	// ChaosMonkeyRuns counts the experiments created by ChaosMonkeys
+	ChaosMonkeyRuns = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "chaosmonkey_runs_total",
+			Help: "Total number of experiments created by ChaosMonkeys",
+		},
+		[]string{"monkey", "action", "namespace"},
+	)
+
+	// CleanupTaskAttempts counts the revert attempts of ChaosCleanupTasks by outcome
+	CleanupTaskAttempts = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "chaoscleanuptask_attempts_total",
+			Help: "Total number of revert attempts made for ChaosCleanupTasks",
+		},
+		[]string{"operation", "result"},
+	)
+
+	// CleanupTasksFailed counts ChaosCleanupTasks that ran out of attempts
+	CleanupTasksFailed = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "chaoscleanuptask_failed_total",
+			Help: "Total number of ChaosCleanupTasks that ran out of attempts without reverting their injection",
+		},
+		[]string{"operation"},
+	)
+
+	// WebhookDecisions counts validating webhook decisions: every admitted and denied request, and
+	// each distinct reason an admitted or denied request was warned about
+	WebhookDecisions = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "chaosexperiment_webhook_decisions_total",
+			Help: "Total number of validating webhook decisions by operation, decision (admitted, denied, warned) and reason",
+		},
+		[]string{"operation", "decision", "reason"},
+	)
+
+	// WebhookDuration measures how long the validating webhook takes to decide on a request
+	WebhookDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "chaosexperiment_webhook_duration_seconds",
+			Help:    "Time the validating webhook took to admit or deny a request",
+			Buckets: []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5},
+		},
+		[]string{"operation", "decision"},
+	)
+
+	// FreezeActive reports whether a cluster-wide chaos freeze is currently in effect
+	FreezeActive = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "chaosexperiment_freeze_active",
+			Help: "Whether a cluster-wide chaos freeze is currently in effect (1 = frozen)",
+		},
+	)
 )
 
 func init() {
@@ -139,13 +339,33 @@ func init() {
 		ExperimentDuration,
 		ResourcesAffected,
 		ExperimentErrors,
-		ActiveExperiments,
+		RecoveryLatency,
+		RecoveryTimeouts,
+		NodeReplacementLatency,
+		NodeReplacementTimeouts,
 		HistoryRecordsTotal,
 		HistoryCleanupTotal,
+		HistoryCompactionsTotal,
 		HistoryRecordsCount,
+		HistoryRegressions,
+		ResultWebhookDeliveries,
+		EventBusMessages,
+		AuditLogMessages,
+		DigestEmails,
 		SafetyDryRunExecutions,
 		SafetyProductionBlocks,
 		SafetyPercentageViolations,
 		SafetyExcludedResources,
+		SafetyFreezeBlocks,
+		SafetyRateLimitBlocks,
+		SafetySeverityBlocks,
+		InjectionRounds,
+		InjectionVerifications,
+		ChaosMonkeyRuns,
+		CleanupTaskAttempts,
+		CleanupTasksFailed,
+		FreezeActive,
+		WebhookDecisions,
+		WebhookDuration,
 	)
 }
@@ -0,0 +1,321 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package preflight checks that the controller has the RBAC permissions its chaos actions need,
+// so a missing rule shows up at startup instead of as a 403 in the middle of an experiment.
+package preflight
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+
+	authorizationv1 "k8s.io/api/authorization/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	authorizationclient "k8s.io/client-go/kubernetes/typed/authorization/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+// Permission is a verb on a resource, checked cluster-wide
+type Permission struct {
+	Group       string
+	Resource    string
+	Subresource string
+	Verb        string
+}
+
+// String formats the permission like the rules of a ClusterRole, e.g. "create pods/exec"
+func (p Permission) String() string {
+	resource := p.Resource
+	if p.Subresource != "" {
+		resource += "/" + p.Subresource
+	}
+	if p.Group != "" {
+		resource += "." + p.Group
+	}
+	return p.Verb + " " + resource
+}
+
+const chaosGroup = "chaos.gushchin.dev"
+
+// Core lists the permissions the controller needs whatever actions are used; without them no
+// experiment can run and the readiness check fails
+var Core = []Permission{
+	{Group: chaosGroup, Resource: "chaosexperiments", Verb: "list"},
+	{Group: chaosGroup, Resource: "chaosexperiments", Verb: "watch"},
+	{Group: chaosGroup, Resource: "chaosexperiments", Verb: "update"},
+	{Group: chaosGroup, Resource: "chaosexperiments", Subresource: "status", Verb: "update"},
+	{Group: chaosGroup, Resource: "chaosexperimenthistories", Verb: "create"},
+	{Group: chaosGroup, Resource: "chaoscleanuptasks", Verb: "create"},
+	{Group: chaosGroup, Resource: "chaosfreezes", Verb: "list"},
+	{Group: chaosGroup, Resource: "chaospolicies", Verb: "list"},
+	{Resource: "namespaces", Verb: "get"},
+	{Resource: "events", Verb: "create"},
+}
+
+var (
+	listPods        = Permission{Resource: "pods", Verb: "list"}
+	deletePods      = Permission{Resource: "pods", Verb: "delete"}
+	createPods      = Permission{Resource: "pods", Verb: "create"}
+	execPods        = Permission{Resource: "pods", Subresource: "exec", Verb: "create"}
+	updateEphemeral = Permission{Resource: "pods", Subresource: "ephemeralcontainers", Verb: "update"}
+	listNodes       = Permission{Resource: "nodes", Verb: "list"}
+	updateNodes     = Permission{Resource: "nodes", Verb: "update"}
+	getLeases       = Permission{Group: "coordination.k8s.io", Resource: "leases", Verb: "get"}
+	updateLeases    = Permission{Group: "coordination.k8s.io", Resource: "leases", Verb: "update"}
+	deleteLeases    = Permission{Group: "coordination.k8s.io", Resource: "leases", Verb: "delete"}
+	getPriority     = Permission{Group: "scheduling.k8s.io", Resource: "priorityclasses", Verb: "get"}
+	listEvents      = Permission{Resource: "events", Verb: "list"}
+	getQuotas       = Permission{Resource: "resourcequotas", Verb: "get"}
+	listQuotas      = Permission{Resource: "resourcequotas", Verb: "list"}
+	updateQuotas    = Permission{Resource: "resourcequotas", Verb: "update"}
+	listWebhooks    = Permission{Group: "admissionregistration.k8s.io", Resource: "validatingwebhookconfigurations", Verb: "list"}
+	createWebhooks  = Permission{Group: "admissionregistration.k8s.io", Resource: "validatingwebhookconfigurations", Verb: "create"}
+	deleteWebhooks  = Permission{Group: "admissionregistration.k8s.io", Resource: "validatingwebhookconfigurations", Verb: "delete"}
+	injectEphemeral = []Permission{listPods, updateEphemeral}
+)
+
+// Actions lists the permissions each chaos action needs on top of Core
+var Actions = map[string][]Permission{
+	"pod-kill":               {listPods, deletePods},
+	"pod-delay":              {listPods, execPods},
+	"pod-failure":            {listPods, execPods},
+	"pod-restart":            {listPods, execPods},
+	"pod-cpu-stress":         injectEphemeral,
+	"pod-memory-stress":      injectEphemeral,
+	"pod-disk-fill":          {listPods, updateEphemeral, execPods}, // exec stops fillers so they remove their file
+	"pod-network-loss":       injectEphemeral,
+	"pod-network-corruption": injectEphemeral,
+	"network-partition":      injectEphemeral,
+	"node-drain":             {listNodes, updateNodes, listPods, deletePods},
+	"node-taint":             {listNodes, updateNodes},
+	"node-cpu-stress":        {listNodes, createPods, deletePods},
+	"node-disk-fill":         {listNodes, createPods, deletePods},
+	"lease-steal":            {listPods, getLeases, updateLeases, deleteLeases},
+	"scheduler-pressure":     {listPods, createPods, deletePods, getPriority, listEvents},
+	"quota-squeeze":          {getQuotas, listQuotas, updateQuotas, listEvents},
+	"admission-delay":        {listWebhooks, createWebhooks, deleteWebhooks},
+}
+
+// Reviewer answers whether the subject being checked holds a permission
+type Reviewer interface {
+	Allowed(ctx context.Context, p Permission) (bool, error)
+}
+
+// SelfReviewer checks the permissions of the caller with SelfSubjectAccessReviews, which every
+// authenticated user may create
+func SelfReviewer(c authorizationclient.SelfSubjectAccessReviewsGetter) Reviewer {
+	return selfReviewer{client: c}
+}
+
+type selfReviewer struct {
+	client authorizationclient.SelfSubjectAccessReviewsGetter
+}
+
+func (r selfReviewer) Allowed(ctx context.Context, p Permission) (bool, error) {
+	review, err := r.client.SelfSubjectAccessReviews().Create(ctx, &authorizationv1.SelfSubjectAccessReview{
+		Spec: authorizationv1.SelfSubjectAccessReviewSpec{ResourceAttributes: resourceAttributes(p)},
+	}, metav1.CreateOptions{})
+	if err != nil {
+		return false, err
+	}
+	return review.Status.Allowed, nil
+}
+
+// ServiceAccountReviewer checks the permissions of a service account with SubjectAccessReviews;
+// the caller needs permission to create subjectaccessreviews
+func ServiceAccountReviewer(c authorizationclient.SubjectAccessReviewsGetter, namespace, name string) Reviewer {
+	return subjectReviewer{
+		client: c,
+		user:   fmt.Sprintf("system:serviceaccount:%s:%s", namespace, name),
+		groups: []string{"system:serviceaccounts", "system:serviceaccounts:" + namespace, "system:authenticated"},
+	}
+}
+
+type subjectReviewer struct {
+	client authorizationclient.SubjectAccessReviewsGetter
+	user   string
+	groups []string
+}
+
+func (r subjectReviewer) Allowed(ctx context.Context, p Permission) (bool, error) {
+	review, err := r.client.SubjectAccessReviews().Create(ctx, &authorizationv1.SubjectAccessReview{
+		Spec: authorizationv1.SubjectAccessReviewSpec{
+			ResourceAttributes: resourceAttributes(p),
+			User:               r.user,
+			Groups:             r.groups,
+		},
+	}, metav1.CreateOptions{})
+	if err != nil {
+		return false, err
+	}
+	return review.Status.Allowed, nil
+}
+
+func resourceAttributes(p Permission) *authorizationv1.ResourceAttributes {
+	return &authorizationv1.ResourceAttributes{
+		Group:       p.Group,
+		Resource:    p.Resource,
+		Subresource: p.Subresource,
+		Verb:        p.Verb,
+	}
+}
+
+// Result is the outcome of a preflight check
+type Result struct {
+	// MissingCore are the Core permissions that are not granted
+	MissingCore []Permission
+	// MissingByAction maps each action that cannot run to its missing permissions
+	MissingByAction map[string][]Permission
+}
+
+// OK reports whether every checked permission is granted
+func (r *Result) OK() bool {
+	return len(r.MissingCore) == 0 && len(r.MissingByAction) == 0
+}
+
+// BlockedActions returns the actions with missing permissions, sorted
+func (r *Result) BlockedActions() []string {
+	actions := make([]string, 0, len(r.MissingByAction))
+	for action := range r.MissingByAction {
+		actions = append(actions, action)
+	}
+	sort.Strings(actions)
+	return actions
+}
+
+// Run checks Core and the permissions of the given actions, or of every action when none are
+// given. Each distinct permission is reviewed once.
+func Run(ctx context.Context, reviewer Reviewer, actions ...string) (*Result, error) {
+	if len(actions) == 0 {
+		for action := range Actions {
+			actions = append(actions, action)
+		}
+	}
+
+	allowed := map[Permission]bool{}
+	check := func(perms []Permission) ([]Permission, error) {
+		var missing []Permission
+		for _, p := range perms {
+			ok, seen := allowed[p]
+			if !seen {
+				var err error
+				if ok, err = reviewer.Allowed(ctx, p); err != nil {
+					return nil, fmt.Errorf("failed to review %q: %w", p, err)
+				}
+				allowed[p] = ok
+			}
+			if !ok {
+				missing = append(missing, p)
+			}
+		}
+		return missing, nil
+	}
+
+	result := &Result{MissingByAction: map[string][]Permission{}}
+	var err error
+	if result.MissingCore, err = check(Core); err != nil {
+		return nil, err
+	}
+	for _, action := range actions {
+		perms, known := Actions[action]
+		if !known {
+			return nil, fmt.Errorf("unknown action %q", action)
+		}
+		missing, err := check(perms)
+		if err != nil {
+			return nil, err
+		}
+		if len(missing) > 0 {
+			result.MissingByAction[action] = missing
+		}
+	}
+	return result, nil
+}
+
+// Checker runs the preflight check once when the manager starts, logs what is missing and
+// serves the outcome as a readiness check. Every replica checks its own permissions.
+type Checker struct {
+	Reviewer Reviewer
+
+	mu     sync.Mutex
+	result *Result
+	done   bool
+}
+
+// NeedLeaderElection lets every replica run the check, so readiness reflects each pod
+func (c *Checker) NeedLeaderElection() bool {
+	return false
+}
+
+// Start implements manager.Runnable
+func (c *Checker) Start(ctx context.Context) error {
+	log := ctrl.Log.WithName("preflight")
+	result, err := Run(ctx, c.Reviewer)
+	c.mu.Lock()
+	c.result, c.done = result, true
+	c.mu.Unlock()
+
+	// A failed review is not a missing permission; do not keep the controller unready over it
+	if err != nil {
+		log.Error(err, "RBAC preflight check failed; permissions were not verified")
+		return nil
+	}
+	if len(result.MissingCore) > 0 {
+		log.Error(nil, "Warning: the controller is missing RBAC permissions it needs to run any experiment",
+			"missing", permissionStrings(result.MissingCore),
+			"fix", "make manifests && kubectl apply -f config/rbac/")
+	}
+	for _, action := range result.BlockedActions() {
+		log.Info("Warning: missing RBAC permissions; experiments with this action will fail",
+			"action", action, "missing", permissionStrings(result.MissingByAction[action]))
+	}
+	if result.OK() {
+		log.Info("RBAC preflight check passed", "actions", len(Actions))
+	}
+	return nil
+}
+
+// Result returns the outcome of the check, or nil until it ran or when a review failed
+func (c *Checker) Result() *Result {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.result
+}
+
+// ReadyzCheck is a healthz.Checker that fails until the check ran and while Core permissions are missing
+func (c *Checker) ReadyzCheck(_ *http.Request) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if !c.done {
+		return fmt.Errorf("RBAC preflight check has not run yet")
+	}
+	if c.result != nil && len(c.result.MissingCore) > 0 {
+		return fmt.Errorf("missing RBAC permissions: %s", strings.Join(permissionStrings(c.result.MissingCore), ", "))
+	}
+	return nil
+}
+
+func permissionStrings(perms []Permission) []string {
+	out := make([]string, len(perms))
+	for i, p := range perms {
+		out[i] = p.String()
+	}
+	return out
+}
@@ -0,0 +1,116 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package preflight
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+// denyingClientset answers access reviews, denying the given permissions
+func denyingClientset(denied ...Permission) (*fake.Clientset, *int) {
+	reviews := 0
+	deny := map[Permission]bool{}
+	for _, p := range denied {
+		deny[p] = true
+	}
+	allowed := func(attrs *authorizationv1.ResourceAttributes) bool {
+		return !deny[Permission{Group: attrs.Group, Resource: attrs.Resource, Subresource: attrs.Subresource, Verb: attrs.Verb}]
+	}
+
+	cs := fake.NewClientset()
+	cs.PrependReactor("create", "selfsubjectaccessreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		reviews++
+		review := action.(k8stesting.CreateAction).GetObject().(*authorizationv1.SelfSubjectAccessReview)
+		review.Status.Allowed = allowed(review.Spec.ResourceAttributes)
+		return true, review, nil
+	})
+	cs.PrependReactor("create", "subjectaccessreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		reviews++
+		review := action.(k8stesting.CreateAction).GetObject().(*authorizationv1.SubjectAccessReview)
+		if review.Spec.User != "system:serviceaccount:chaos:controller" {
+			return true, nil, fmt.Errorf("unexpected user %q", review.Spec.User)
+		}
+		review.Status.Allowed = allowed(review.Spec.ResourceAttributes)
+		return true, review, nil
+	})
+	return cs, &reviews
+}
+
+func TestRun_AllGranted(t *testing.T) {
+	cs, reviews := denyingClientset()
+
+	result, err := Run(context.Background(), SelfReviewer(cs.AuthorizationV1()))
+
+	require.NoError(t, err)
+	assert.True(t, result.OK())
+	// Permissions shared between actions are reviewed once
+	assert.Less(t, *reviews, len(Core)+2*len(Actions))
+}
+
+func TestRun_MissingActionPermissions(t *testing.T) {
+	cs, _ := denyingClientset(execPods, createPods)
+
+	result, err := Run(context.Background(), SelfReviewer(cs.AuthorizationV1()))
+
+	require.NoError(t, err)
+	assert.False(t, result.OK())
+	assert.Empty(t, result.MissingCore)
+	assert.Equal(t, []string{"node-cpu-stress", "node-disk-fill", "pod-delay", "pod-disk-fill", "pod-failure", "pod-restart", "scheduler-pressure"},
+		result.BlockedActions())
+	assert.Equal(t, []Permission{execPods}, result.MissingByAction["pod-restart"])
+}
+
+func TestRun_SelectedActionsForServiceAccount(t *testing.T) {
+	cs, _ := denyingClientset(updateNodes)
+	reviewer := ServiceAccountReviewer(cs.AuthorizationV1(), "chaos", "controller")
+
+	result, err := Run(context.Background(), reviewer, "pod-kill", "node-taint")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"node-taint"}, result.BlockedActions())
+
+	_, err = Run(context.Background(), reviewer, "pod-explode")
+	assert.ErrorContains(t, err, `unknown action "pod-explode"`)
+}
+
+func TestChecker_Readyz(t *testing.T) {
+	cs, _ := denyingClientset(Core[0])
+	c := &Checker{Reviewer: SelfReviewer(cs.AuthorizationV1())}
+
+	assert.ErrorContains(t, c.ReadyzCheck(nil), "has not run yet")
+	require.NoError(t, c.Start(context.Background()))
+	assert.EqualError(t, c.ReadyzCheck(nil), "missing RBAC permissions: list chaosexperiments.chaos.gushchin.dev")
+
+	cs, _ = denyingClientset(execPods)
+	c = &Checker{Reviewer: SelfReviewer(cs.AuthorizationV1())}
+	require.NoError(t, c.Start(context.Background()))
+	// Missing action permissions are warnings only
+	assert.NoError(t, c.ReadyzCheck(nil))
+}
+
+func TestPermissionString(t *testing.T) {
+	assert.Equal(t, "create pods/exec", execPods.String())
+	assert.Equal(t, "update chaosexperiments/status.chaos.gushchin.dev", Core[3].String())
+}
@@ -0,0 +1,125 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package promquery runs instant PromQL queries against the Prometheus HTTP API. The controller
+// uses it to sample experiment metricsQueries before, during and after an experiment.
+package promquery
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrNoData is returned when a query evaluates to an empty result
+var ErrNoData = errors.New("query returned no data")
+
+// Client queries a single Prometheus-compatible endpoint
+type Client struct {
+	// URL is the base URL of the Prometheus server (e.g., "http://prometheus.monitoring:9090")
+	URL string
+	// HTTPClient is used for requests; a client with a 10s timeout is used when nil
+	HTTPClient *http.Client
+}
+
+// NewClient returns a client for the Prometheus server at baseURL
+func NewClient(baseURL string) *Client {
+	return &Client{
+		URL:        strings.TrimSuffix(baseURL, "/"),
+		HTTPClient: &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+type queryResponse struct {
+	Status    string `json:"status"`
+	ErrorType string `json:"errorType"`
+	Error     string `json:"error"`
+	Data      struct {
+		ResultType string          `json:"resultType"`
+		Result     json.RawMessage `json:"result"`
+	} `json:"data"`
+}
+
+// Query evaluates query at the given time and returns its value. Vector results must contain
+// exactly one series, so queries should aggregate (e.g., with sum or histogram_quantile).
+func (c *Client) Query(ctx context.Context, query string, at time.Time) (float64, error) {
+	params := url.Values{}
+	params.Set("query", query)
+	params.Set("time", strconv.FormatFloat(float64(at.UnixMilli())/1000, 'f', 3, 64))
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.URL+"/api/v1/query?"+params.Encode(), nil)
+	if err != nil {
+		return 0, fmt.Errorf("failed to build query request: %w", err)
+	}
+	httpClient := c.HTTPClient
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: 10 * time.Second}
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("failed to query prometheus: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	var body queryResponse
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return 0, fmt.Errorf("failed to decode prometheus response (HTTP %d): %w", resp.StatusCode, err)
+	}
+	if body.Status != "success" {
+		return 0, fmt.Errorf("prometheus query failed: %s: %s", body.ErrorType, body.Error)
+	}
+
+	switch body.Data.ResultType {
+	case "scalar":
+		var sample [2]any
+		if err := json.Unmarshal(body.Data.Result, &sample); err != nil {
+			return 0, fmt.Errorf("failed to decode scalar result: %w", err)
+		}
+		return parseSampleValue(sample)
+	case "vector":
+		var series []struct {
+			Value [2]any `json:"value"`
+		}
+		if err := json.Unmarshal(body.Data.Result, &series); err != nil {
+			return 0, fmt.Errorf("failed to decode vector result: %w", err)
+		}
+		switch len(series) {
+		case 0:
+			return 0, ErrNoData
+		case 1:
+			return parseSampleValue(series[0].Value)
+		default:
+			return 0, fmt.Errorf("query returned %d series, aggregate it to a single value", len(series))
+		}
+	default:
+		return 0, fmt.Errorf("unsupported result type %q", body.Data.ResultType)
+	}
+}
+
+// parseSampleValue extracts the value of a [timestamp, "value"] pair
+func parseSampleValue(sample [2]any) (float64, error) {
+	raw, ok := sample[1].(string)
+	if !ok {
+		return 0, fmt.Errorf("unexpected sample value %v", sample[1])
+	}
+	return strconv.ParseFloat(raw, 64)
+}
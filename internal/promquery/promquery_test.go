@@ -0,0 +1,70 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package promquery
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestQuery(t *testing.T) {
+	responses := map[string]string{
+		"vector":   `{"status":"success","data":{"resultType":"vector","result":[{"metric":{},"value":[1700000000,"0.25"]}]}}`,
+		"scalar":   `{"status":"success","data":{"resultType":"scalar","result":[1700000000,"42"]}}`,
+		"empty":    `{"status":"success","data":{"resultType":"vector","result":[]}}`,
+		"multiple": `{"status":"success","data":{"resultType":"vector","result":[{"value":[1,"1"]},{"value":[1,"2"]}]}}`,
+		"error":    `{"status":"error","errorType":"bad_data","error":"parse error"}`,
+	}
+	var gotTime string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/query" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		gotTime = r.URL.Query().Get("time")
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(responses[r.URL.Query().Get("query")]))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL + "/")
+	ctx := context.Background()
+	at := time.UnixMilli(1700000000500)
+
+	value, err := client.Query(ctx, "vector", at)
+	if err != nil || value != 0.25 {
+		t.Errorf("vector query = %v, %v; want 0.25", value, err)
+	}
+	if gotTime != "1700000000.500" {
+		t.Errorf("time parameter = %q", gotTime)
+	}
+	if value, err := client.Query(ctx, "scalar", at); err != nil || value != 42 {
+		t.Errorf("scalar query = %v, %v; want 42", value, err)
+	}
+	if _, err := client.Query(ctx, "empty", at); !errors.Is(err, ErrNoData) {
+		t.Errorf("empty query error = %v, want ErrNoData", err)
+	}
+	if _, err := client.Query(ctx, "multiple", at); err == nil {
+		t.Error("expected an error for a multi-series result")
+	}
+	if _, err := client.Query(ctx, "error", at); err == nil {
+		t.Error("expected an error for a failed query")
+	}
+}
@@ -0,0 +1,154 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package redact scrubs credentials from text the controller copies into experiment status and
+// history records, such as stderr of commands run in target pods and container log tails.
+package redact
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// Placeholder replaces every redacted value
+const Placeholder = "[REDACTED]"
+
+// minValueLength is the shortest literal value WithValues scrubs; shorter ones such as "1" or
+// "true" would blank out unrelated text
+const minValueLength = 6
+
+// DefaultPatterns match common credential formats. A pattern with a group named "value" only has
+// that group replaced, keeping the surrounding key for context; otherwise the whole match is.
+var DefaultPatterns = []string{
+	// KEY=value where the key names a credential, as in env output and command lines
+	`(?i)\b[A-Z0-9_.-]*(?:PASSWORD|PASSWD|SECRET|TOKEN|API_?KEY|ACCESS_?KEY|PRIVATE_?KEY|CREDENTIALS?)[A-Z0-9_.-]*\s*=\s*(?P<value>"[^"]*"|'[^']*'|[^\s,;"']+)`,
+	// The same in JSON; a bare "token: ..." is left alone as it is mostly prose in error messages
+	`(?i)"[A-Z0-9_.-]*(?:PASSWORD|PASSWD|SECRET|TOKEN|API_?KEY|ACCESS_?KEY|PRIVATE_?KEY|CREDENTIALS?)[A-Z0-9_.-]*"\s*:\s*(?P<value>"[^"]*")`,
+	// HTTP authorization headers
+	`(?i)\b(?:bearer|basic)\s+(?P<value>[A-Za-z0-9\-._~+/]{8,}=*)`,
+	// Passwords in URLs
+	`://[^/\s:@]+:(?P<value>[^/\s@]+)@`,
+	// JSON web tokens
+	`\beyJ[A-Za-z0-9_-]+\.[A-Za-z0-9_-]+\.[A-Za-z0-9_-]+`,
+	// AWS access key IDs
+	`\b(?:AKIA|ASIA)[0-9A-Z]{16}\b`,
+	// PEM private keys
+	`-----BEGIN [A-Z ]*PRIVATE KEY-----[\s\S]*?-----END [A-Z ]*PRIVATE KEY-----`,
+}
+
+// Filter replaces the matches of its patterns and its literal values with Placeholder. The zero
+// value redacts nothing; a nil *Filter behaves like Default().
+type Filter struct {
+	patterns []*regexp.Regexp
+	values   []string
+}
+
+var defaultFilter = mustNew(DefaultPatterns)
+
+// Default returns the filter of DefaultPatterns
+func Default() *Filter {
+	return defaultFilter
+}
+
+// New returns a filter of DefaultPatterns and the extra patterns
+func New(extra []string) (*Filter, error) {
+	return compile(append(append([]string{}, DefaultPatterns...), extra...))
+}
+
+func compile(patterns []string) (*Filter, error) {
+	f := &Filter{}
+	for _, pattern := range patterns {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid redaction pattern %q: %w", pattern, err)
+		}
+		f.patterns = append(f.patterns, re)
+	}
+	return f, nil
+}
+
+func mustNew(patterns []string) *Filter {
+	f, err := compile(patterns)
+	if err != nil {
+		panic(err)
+	}
+	return f
+}
+
+// WithValues returns a copy of the filter that also scrubs the literal values, such as the
+// environment variables of a target pod, which commands in that pod may print
+func (f *Filter) WithValues(values ...string) *Filter {
+	if f == nil {
+		f = Default()
+	}
+	out := &Filter{patterns: f.patterns, values: append([]string{}, f.values...)}
+	for _, v := range values {
+		if len(v) >= minValueLength {
+			out.values = append(out.values, v)
+		}
+	}
+	// Longest first, so a value containing another is replaced whole
+	sort.Slice(out.values, func(i, j int) bool { return len(out.values[i]) > len(out.values[j]) })
+	return out
+}
+
+// String returns s with everything the filter matches replaced, and whether anything was
+func (f *Filter) String(s string) (string, bool) {
+	if f == nil {
+		f = Default()
+	}
+	if s == "" {
+		return s, false
+	}
+	out := s
+	for _, v := range f.values {
+		out = strings.ReplaceAll(out, v, Placeholder)
+	}
+	for _, re := range f.patterns {
+		out = replace(re, out)
+	}
+	return out, out != s
+}
+
+// Scrub returns s with everything the filter matches replaced
+func (f *Filter) Scrub(s string) string {
+	out, _ := f.String(s)
+	return out
+}
+
+// replace substitutes the "value" group of each match of re, or the whole match without one
+func replace(re *regexp.Regexp, s string) string {
+	group := re.SubexpIndex("value")
+	matches := re.FindAllStringSubmatchIndex(s, -1)
+	if len(matches) == 0 {
+		return s
+	}
+	var b strings.Builder
+	last := 0
+	for _, m := range matches {
+		start, end := m[0], m[1]
+		if group > 0 && m[2*group] >= 0 {
+			start, end = m[2*group], m[2*group+1]
+		}
+		b.WriteString(s[last:start])
+		b.WriteString(Placeholder)
+		last = end
+	}
+	b.WriteString(s[last:])
+	return b.String()
+}
@@ -0,0 +1,198 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package resulthook delivers experiment history records to external HTTP endpoints, so that
+// analytics pipelines can ingest chaos outcomes without watching the cluster. Every request body
+// is signed with HMAC-SHA256 and failed deliveries are retried with exponential backoff.
+package resulthook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	chaosv1alpha1 "github.com/neogan74/k8s-chaos/api/v1alpha1"
+	chaosmetrics "github.com/neogan74/k8s-chaos/internal/metrics"
+)
+
+const (
+	// SignatureHeader carries "sha256=<hex HMAC of the body>" when a secret is configured
+	SignatureHeader = "X-Chaos-Signature"
+	// DeliveryHeader identifies the record as "<namespace>/<name>"; it is the same on every retry
+	DeliveryHeader = "X-Chaos-Delivery"
+	// AttemptHeader is the 1-based delivery attempt
+	AttemptHeader = "X-Chaos-Attempt"
+
+	// DefaultMaxRetries is how often a failed delivery is retried before it is dropped
+	DefaultMaxRetries = 5
+	// DefaultQueueSize bounds the records waiting for delivery
+	DefaultQueueSize = 100
+
+	initialBackoff = time.Second
+	maxBackoff     = time.Minute
+)
+
+// Sender posts history records to a list of endpoints
+type Sender struct {
+	// URLs receive every record
+	URLs []string
+	// Secret signs request bodies; requests are unsigned when empty
+	Secret []byte
+	// MaxRetries is how often a failed delivery is retried per endpoint
+	MaxRetries int
+	// HTTPClient is used for requests; a client with a 10s timeout is used when nil
+	HTTPClient *http.Client
+
+	queue   chan *chaosv1alpha1.ChaosExperimentHistory
+	backoff time.Duration
+}
+
+// NewSender returns a sender delivering to urls, retrying failed deliveries maxRetries times
+func NewSender(urls []string, secret []byte, maxRetries int) *Sender {
+	return &Sender{
+		URLs:       urls,
+		Secret:     secret,
+		MaxRetries: maxRetries,
+		HTTPClient: &http.Client{Timeout: 10 * time.Second},
+		queue:      make(chan *chaosv1alpha1.ChaosExperimentHistory, DefaultQueueSize),
+		backoff:    initialBackoff,
+	}
+}
+
+// Enqueue schedules a record for delivery without blocking. Records are dropped when the
+// queue is full, e.g. because every endpoint has been unreachable for a while.
+// A nil sender ignores the record.
+func (s *Sender) Enqueue(history *chaosv1alpha1.ChaosExperimentHistory) {
+	if s == nil {
+		return
+	}
+	select {
+	case s.queue <- history.DeepCopy():
+	default:
+		ctrl.Log.WithName("resulthook").Info("Result webhook queue full, dropping record",
+			"history", history.Namespace+"/"+history.Name)
+		for range s.URLs {
+			chaosmetrics.ResultWebhookDeliveries.WithLabelValues("dropped").Inc()
+		}
+	}
+}
+
+// NeedLeaderElection only delivers from the leader, which is the replica creating the records
+func (s *Sender) NeedLeaderElection() bool {
+	return true
+}
+
+// Start delivers queued records until ctx is cancelled
+func (s *Sender) Start(ctx context.Context) error {
+	log := ctrl.Log.WithName("resulthook")
+	log.Info("Delivering experiment results", "endpoints", len(s.URLs))
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case history := <-s.queue:
+			for _, url := range s.URLs {
+				if err := s.Deliver(ctx, url, history); err != nil {
+					log.Error(err, "Failed to deliver experiment result",
+						"url", url, "history", history.Namespace+"/"+history.Name)
+				}
+			}
+		}
+	}
+}
+
+// Deliver posts one record to url, retrying transport errors, 429 and 5xx responses
+func (s *Sender) Deliver(ctx context.Context, url string, history *chaosv1alpha1.ChaosExperimentHistory) error {
+	record := history.DeepCopy()
+	record.APIVersion = chaosv1alpha1.GroupVersion.String()
+	record.Kind = "ChaosExperimentHistory"
+	body, err := json.Marshal(record)
+	if err != nil {
+		return fmt.Errorf("failed to encode history record: %w", err)
+	}
+
+	backoff := s.backoff
+	var lastErr error
+	for attempt := 1; attempt <= s.MaxRetries+1; attempt++ {
+		retry, err := s.post(ctx, url, body, record, attempt)
+		if err == nil {
+			chaosmetrics.ResultWebhookDeliveries.WithLabelValues("success").Inc()
+			return nil
+		}
+		lastErr = err
+		if !retry || attempt > s.MaxRetries {
+			break
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(backoff):
+		}
+		backoff = min(backoff*2, maxBackoff)
+	}
+	chaosmetrics.ResultWebhookDeliveries.WithLabelValues("failed").Inc()
+	return lastErr
+}
+
+// post sends a single request and reports whether a failure is worth retrying
+func (s *Sender) post(ctx context.Context, url string, body []byte,
+	record *chaosv1alpha1.ChaosExperimentHistory, attempt int) (bool, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return false, fmt.Errorf("failed to build request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(DeliveryHeader, record.Namespace+"/"+record.Name)
+	req.Header.Set(AttemptHeader, strconv.Itoa(attempt))
+	if len(s.Secret) > 0 {
+		req.Header.Set(SignatureHeader, Sign(s.Secret, body))
+	}
+
+	httpClient := s.HTTPClient
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: 10 * time.Second}
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return true, fmt.Errorf("request failed: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64*1024))
+
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return false, nil
+	}
+	retry := resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500
+	return retry, fmt.Errorf("endpoint responded with %s", resp.Status)
+}
+
+// Sign returns the signature header value of body, for receivers to verify with the shared secret
+func Sign(secret, body []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,150 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resulthook
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	chaosv1alpha1 "github.com/neogan74/k8s-chaos/api/v1alpha1"
+)
+
+func testRecord() *chaosv1alpha1.ChaosExperimentHistory {
+	return &chaosv1alpha1.ChaosExperimentHistory{
+		ObjectMeta: metav1.ObjectMeta{Name: "web-kill-20260101-100000-abc12", Namespace: "chaos-system"},
+		Spec: chaosv1alpha1.ChaosExperimentHistorySpec{
+			ExperimentRef: chaosv1alpha1.ObjectReference{Name: "web-kill", Namespace: "default"},
+			Execution:     chaosv1alpha1.ExecutionDetails{Status: "success"},
+		},
+	}
+}
+
+func testSender(url string, maxRetries int) *Sender {
+	s := NewSender([]string{url}, []byte("s3cret"), maxRetries)
+	s.backoff = time.Millisecond
+	return s
+}
+
+func TestDeliverSignsRecord(t *testing.T) {
+	var received chaosv1alpha1.ChaosExperimentHistory
+	var signature, delivery string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if err := json.Unmarshal(body, &received); err != nil {
+			t.Errorf("invalid body: %v", err)
+		}
+		if got, want := r.Header.Get(SignatureHeader), Sign([]byte("s3cret"), body); got != want {
+			t.Errorf("signature = %q, want %q", got, want)
+		}
+		signature = r.Header.Get(SignatureHeader)
+		delivery = r.Header.Get(DeliveryHeader)
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer srv.Close()
+
+	if err := testSender(srv.URL, 0).Deliver(context.Background(), srv.URL, testRecord()); err != nil {
+		t.Fatalf("Deliver() error = %v", err)
+	}
+	if received.Kind != "ChaosExperimentHistory" || received.Spec.ExperimentRef.Name != "web-kill" {
+		t.Errorf("unexpected record %+v", received)
+	}
+	if signature == "" || delivery != "chaos-system/web-kill-20260101-100000-abc12" {
+		t.Errorf("unexpected headers: signature=%q delivery=%q", signature, delivery)
+	}
+}
+
+func TestDeliverRetries(t *testing.T) {
+	tests := []struct {
+		name       string
+		statuses   []int
+		maxRetries int
+		wantErr    bool
+		wantCalls  int32
+	}{
+		{name: "server error then success", statuses: []int{500, 503, 200}, maxRetries: 5, wantCalls: 3},
+		{name: "throttled then success", statuses: []int{429, 204}, maxRetries: 5, wantCalls: 2},
+		{name: "retries exhausted", statuses: []int{500, 500, 500}, maxRetries: 2, wantErr: true, wantCalls: 3},
+		{name: "client error not retried", statuses: []int{400, 200}, maxRetries: 5, wantErr: true, wantCalls: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var calls atomic.Int32
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				n := calls.Add(1)
+				if got := r.Header.Get(AttemptHeader); got != strconv.Itoa(int(n)) {
+					t.Errorf("attempt header = %q, want %d", got, n)
+				}
+				w.WriteHeader(tt.statuses[n-1])
+			}))
+			defer srv.Close()
+
+			err := testSender(srv.URL, tt.maxRetries).Deliver(context.Background(), srv.URL, testRecord())
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Deliver() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if calls.Load() != tt.wantCalls {
+				t.Errorf("calls = %d, want %d", calls.Load(), tt.wantCalls)
+			}
+		})
+	}
+}
+
+func TestStartDeliversQueuedRecords(t *testing.T) {
+	delivered := make(chan string, 1)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		delivered <- r.Header.Get(DeliveryHeader)
+	}))
+	defer srv.Close()
+
+	s := testSender(srv.URL, 0)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() { _ = s.Start(ctx) }()
+
+	s.Enqueue(testRecord())
+	select {
+	case got := <-delivered:
+		if got != "chaos-system/web-kill-20260101-100000-abc12" {
+			t.Errorf("delivered %q", got)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("record was not delivered")
+	}
+}
+
+func TestEnqueueDropsWhenFull(t *testing.T) {
+	s := testSender("http://127.0.0.1:0", 0)
+	for i := 0; i < DefaultQueueSize+10; i++ {
+		s.Enqueue(testRecord())
+	}
+	if len(s.queue) != DefaultQueueSize {
+		t.Errorf("queue length = %d, want %d", len(s.queue), DefaultQueueSize)
+	}
+
+	var nilSender *Sender
+	nilSender.Enqueue(testRecord())
+}
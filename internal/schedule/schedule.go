@@ -0,0 +1,314 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package schedule parses the cron schedules of experiments and computes when they run next.
+package schedule
+
+import (
+	"fmt"
+	"hash/fnv"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/robfig/cron/v3"
+)
+
+// parser accepts standard five-field cron expressions and descriptors such as @hourly
+var parser = cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow | cron.Descriptor)
+
+// Parse parses a cron expression
+func Parse(expr string) (cron.Schedule, error) {
+	schedule, err := parser.Parse(expr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cron schedule %q: %w", expr, err)
+	}
+	return schedule, nil
+}
+
+// Offset returns the fixed delay of the experiment identified by key within a jitter window.
+// Offsets are spread evenly over the window and stay the same across controller restarts.
+func Offset(key string, window time.Duration) time.Duration {
+	if window < time.Second {
+		return 0
+	}
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(key))
+	return time.Duration(h.Sum64() % uint64(window/time.Second) * uint64(time.Second))
+}
+
+// shifted fires offset after every activation of the underlying schedule
+type shifted struct {
+	schedule cron.Schedule
+	offset   time.Duration
+}
+
+func (s shifted) Next(t time.Time) time.Time {
+	return s.schedule.Next(t.Add(-s.offset)).Add(s.offset)
+}
+
+// Shift delays every activation of schedule by offset
+func Shift(schedule cron.Schedule, offset time.Duration) cron.Schedule {
+	if offset <= 0 {
+		return schedule
+	}
+	return shifted{schedule: schedule, offset: offset}
+}
+
+// Exclusion skips the activations within Duration after each activation of Schedule, such as a
+// deploy window. Without a Duration only activations at the same time are skipped.
+type Exclusion struct {
+	Schedule string
+	Duration time.Duration
+}
+
+// Definition is everything that decides when an experiment runs: it runs whenever any of
+// Schedules fires, except within Exclusions
+type Definition struct {
+	Schedules  []string
+	Exclusions []Exclusion
+}
+
+// IsZero reports whether d has no schedule, so the experiment runs when created
+func (d Definition) IsZero() bool {
+	return len(d.Schedules) == 0
+}
+
+// String describes d for logs and messages, e.g. `"0 * * * *" except "0 14 * * 3" for 2h0m0s`
+func (d Definition) String() string {
+	quoted := make([]string, len(d.Schedules))
+	for i, expr := range d.Schedules {
+		quoted[i] = fmt.Sprintf("%q", expr)
+	}
+	s := strings.Join(quoted, " or ")
+	for i, e := range d.Exclusions {
+		sep := " or "
+		if i == 0 {
+			sep = " except "
+		}
+		s += fmt.Sprintf("%s%q", sep, e.Schedule)
+		if e.Duration > 0 {
+			s += " for " + e.Duration.String()
+		}
+	}
+	return s
+}
+
+// equal reports whether d and other define the same runs in the same way
+func (d Definition) equal(other Definition) bool {
+	return slices.Equal(d.Schedules, other.Schedules) && slices.Equal(d.Exclusions, other.Exclusions)
+}
+
+// maxExcludedRuns bounds how many excluded activations in a row Next skips, so that exclusions
+// covering every activation cannot keep it searching forever
+const maxExcludedRuns = 10000
+
+// union fires whenever any of its schedules fires
+type union []cron.Schedule
+
+func (u union) Next(t time.Time) time.Time {
+	var next time.Time
+	for _, schedule := range u {
+		if n := schedule.Next(t); !n.IsZero() && (next.IsZero() || n.Before(next)) {
+			next = n
+		}
+	}
+	return next
+}
+
+// excluding skips the activations of schedule within the windows of exclusions
+type excluding struct {
+	schedule   cron.Schedule
+	exclusions []parsedExclusion
+}
+
+type parsedExclusion struct {
+	schedule cron.Schedule
+	duration time.Duration
+}
+
+func (e excluding) Next(t time.Time) time.Time {
+	for range maxExcludedRuns {
+		t = e.schedule.Next(t)
+		if t.IsZero() || !e.excluded(t) {
+			return t
+		}
+	}
+	return time.Time{}
+}
+
+// excluded reports whether t falls into a window [start, start+duration) of an exclusion
+func (e excluding) excluded(t time.Time) bool {
+	for _, exclusion := range e.exclusions {
+		// Windows last at least a second, so that an activation at t itself is excluded
+		window := max(exclusion.duration, time.Second)
+		start := exclusion.schedule.Next(t.Add(-window))
+		if !start.IsZero() && !start.After(t) {
+			return true
+		}
+	}
+	return false
+}
+
+// Build parses every expression of d and combines them into one schedule. Runs are delayed by
+// offset before exclusions apply, so that no jittered run lands in an excluded window.
+func Build(d Definition, offset time.Duration) (cron.Schedule, error) {
+	if d.IsZero() {
+		return nil, fmt.Errorf("no cron schedule")
+	}
+	schedules := make(union, 0, len(d.Schedules))
+	for _, expr := range d.Schedules {
+		schedule, err := Parse(expr)
+		if err != nil {
+			return nil, err
+		}
+		schedules = append(schedules, schedule)
+	}
+	var schedule cron.Schedule = schedules
+	if len(schedules) == 1 {
+		schedule = schedules[0]
+	}
+	schedule = Shift(schedule, offset)
+	if len(d.Exclusions) == 0 {
+		return schedule, nil
+	}
+	exclusions := make([]parsedExclusion, 0, len(d.Exclusions))
+	for _, exclusion := range d.Exclusions {
+		parsed, err := Parse(exclusion.Schedule)
+		if err != nil {
+			return nil, err
+		}
+		exclusions = append(exclusions, parsedExclusion{schedule: parsed, duration: exclusion.Duration})
+	}
+	return excluding{schedule: schedule, exclusions: exclusions}, nil
+}
+
+// NextRuns returns the first n activations of schedule after t
+func NextRuns(schedule cron.Schedule, t time.Time, n int) []time.Time {
+	runs := make([]time.Time, 0, n)
+	for len(runs) < n {
+		t = schedule.Next(t)
+		if t.IsZero() {
+			// The schedule never fires again, e.g. "0 0 30 2 *"
+			break
+		}
+		runs = append(runs, t)
+	}
+	return runs
+}
+
+// previewLayout is how Preview prints activations, with the weekday to make mixed-up
+// day-of-month and day-of-week fields easy to spot
+const previewLayout = "Mon 2006-01-02 15:04 MST"
+
+// Preview describes the first n activations of schedule after t for people, e.g.
+// "Tue 2026-10-20 03:00 UTC, Wed 2026-10-21 03:00 UTC"
+func Preview(schedule cron.Schedule, t time.Time, n int) string {
+	runs := NextRuns(schedule, t, n)
+	if len(runs) == 0 {
+		return "never"
+	}
+	formatted := make([]string, len(runs))
+	for i, run := range runs {
+		formatted[i] = run.Format(previewLayout)
+	}
+	return strings.Join(formatted, ", ")
+}
+
+// entry is the cached schedule of one experiment
+type entry struct {
+	definition Definition
+	offset     time.Duration
+	schedule   cron.Schedule
+	// next is the first activation after from; it stays valid for any time in [from, next)
+	from, next time.Time
+}
+
+// Cache keeps the parsed schedule and next activation of each experiment, so reconciles between
+// two activations neither parse the expression nor search for the next activation again.
+// A nil Cache parses on every call.
+type Cache struct {
+	mu      sync.Mutex
+	entries map[string]*entry
+}
+
+// NewCache returns an empty Cache
+func NewCache() *Cache {
+	return &Cache{entries: map[string]*entry{}}
+}
+
+// Schedule returns the schedule built from d with offset, cached under key
+func (c *Cache) Schedule(key string, d Definition, offset time.Duration) (cron.Schedule, error) {
+	if c == nil {
+		return Build(d, offset)
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e, err := c.entry(key, d, offset)
+	if err != nil {
+		return nil, err
+	}
+	return e.schedule, nil
+}
+
+// Next returns the first activation after t of the schedule built from d with offset, cached
+// under key
+func (c *Cache) Next(key string, d Definition, offset time.Duration, t time.Time) (time.Time, error) {
+	if c == nil {
+		schedule, err := c.Schedule(key, d, offset)
+		if err != nil {
+			return time.Time{}, err
+		}
+		return schedule.Next(t), nil
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e, err := c.entry(key, d, offset)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if e.next.IsZero() || t.Before(e.from) || !t.Before(e.next) {
+		e.from, e.next = t, e.schedule.Next(t)
+	}
+	return e.next, nil
+}
+
+// Forget drops the entry of a deleted experiment
+func (c *Cache) Forget(key string) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.entries, key)
+}
+
+// entry returns the entry of key, replacing it when the definition or offset changed
+func (c *Cache) entry(key string, d Definition, offset time.Duration) (*entry, error) {
+	if e, ok := c.entries[key]; ok && e.definition.equal(d) && e.offset == offset {
+		return e, nil
+	}
+	schedule, err := Build(d, offset)
+	if err != nil {
+		delete(c.entries, key)
+		return nil, err
+	}
+	d = Definition{Schedules: slices.Clone(d.Schedules), Exclusions: slices.Clone(d.Exclusions)}
+	e := &entry{definition: d, offset: offset, schedule: schedule}
+	c.entries[key] = e
+	return e, nil
+}
@@ -0,0 +1,197 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schedule
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestOffset(t *testing.T) {
+	window := 10 * time.Minute
+	if Offset("default/a", window) != Offset("default/a", window) {
+		t.Fatal("expected the same offset for the same experiment")
+	}
+
+	distinct := map[time.Duration]bool{}
+	for i := 0; i < 50; i++ {
+		offset := Offset(fmt.Sprintf("default/exp-%d", i), window)
+		if offset < 0 || offset >= window || offset%time.Second != 0 {
+			t.Fatalf("offset %s outside [0, %s) or not whole seconds", offset, window)
+		}
+		distinct[offset] = true
+	}
+	if len(distinct) < 40 {
+		t.Errorf("expected offsets to be spread over the window, got %d distinct of 50", len(distinct))
+	}
+
+	if Offset("default/a", 0) != 0 || Offset("default/a", time.Millisecond) != 0 {
+		t.Error("expected no offset without a window")
+	}
+}
+
+func TestShift(t *testing.T) {
+	schedule, err := Parse("0 * * * *")
+	if err != nil {
+		t.Fatal(err)
+	}
+	base := time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC)
+	shiftedSchedule := Shift(schedule, 7*time.Minute)
+
+	// 10:05 is before this hour's shifted activation at 10:07
+	if got := shiftedSchedule.Next(base.Add(5 * time.Minute)); !got.Equal(base.Add(7 * time.Minute)) {
+		t.Errorf("expected 10:07, got %s", got)
+	}
+	if got := shiftedSchedule.Next(base.Add(7 * time.Minute)); !got.Equal(base.Add(67 * time.Minute)) {
+		t.Errorf("expected 11:07, got %s", got)
+	}
+}
+
+func TestPreview(t *testing.T) {
+	schedule, err := Parse("0 3 * * 1-5")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Friday 2026-10-16 at noon: the next weekday runs are Monday to Wednesday
+	from := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+	want := "Mon 2026-10-19 03:00 UTC, Tue 2026-10-20 03:00 UTC, Wed 2026-10-21 03:00 UTC"
+	if got := Preview(schedule, from, 3); got != want {
+		t.Errorf("expected %q, got %q", want, got)
+	}
+
+	never, err := Parse("0 0 30 2 *")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if runs := NextRuns(never, from, 3); len(runs) != 0 {
+		t.Errorf("expected no runs on February 30, got %v", runs)
+	}
+	if got := Preview(never, from, 3); got != "never" {
+		t.Errorf("expected never, got %q", got)
+	}
+}
+
+// cronDef is the definition of a single cron schedule
+func cronDef(expr string) Definition {
+	return Definition{Schedules: []string{expr}}
+}
+
+func TestBuild(t *testing.T) {
+	// Thursday 2026-01-01 at 10:00
+	base := time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	// Any schedule fires the experiment
+	schedule, err := Build(Definition{Schedules: []string{"0 12 * * *", "30 10 * * *"}}, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := NextRuns(schedule, base, 3); !slices.Equal(got, []time.Time{
+		base.Add(30 * time.Minute), base.Add(2 * time.Hour), base.Add(24*time.Hour + 30*time.Minute),
+	}) {
+		t.Errorf("expected 10:30, 12:00 and 10:30 the next day, got %v", got)
+	}
+
+	// Hourly, except during a two-hour window from 11:00 and at the 14:00 run itself
+	schedule, err = Build(Definition{
+		Schedules: []string{"@hourly"},
+		Exclusions: []Exclusion{
+			{Schedule: "0 11 * * *", Duration: 2 * time.Hour},
+			{Schedule: "0 14 * * *"},
+		},
+	}, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := NextRuns(schedule, base, 3); !slices.Equal(got, []time.Time{
+		base.Add(3 * time.Hour), base.Add(5 * time.Hour), base.Add(6 * time.Hour),
+	}) {
+		t.Errorf("expected 13:00, 15:00 and 16:00, got %v", got)
+	}
+
+	// Jittered runs are excluded where they land, not where the cron fires
+	schedule, err = Build(Definition{
+		Schedules:  []string{"0 10 * * *"},
+		Exclusions: []Exclusion{{Schedule: "20 10 * * *", Duration: 10 * time.Minute}},
+	}, 25*time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := schedule.Next(base.Add(-time.Minute)); !got.IsZero() {
+		t.Errorf("expected every run to be excluded, got %s", got)
+	}
+
+	if _, err := Build(Definition{Schedules: []string{"@hourly"}, Exclusions: []Exclusion{{Schedule: "sometimes"}}}, 0); err == nil ||
+		!strings.Contains(err.Error(), `"sometimes"`) {
+		t.Errorf("expected the invalid exclusion to be named, got %v", err)
+	}
+	if _, err := Build(Definition{}, 0); err == nil {
+		t.Error("expected a definition without schedules to fail")
+	}
+
+	want := `"@hourly" or "0 9 * * 6" except "0 14 * * 3" for 2h0m0s or "0 0 25 12 *"`
+	if got := (Definition{
+		Schedules:  []string{"@hourly", "0 9 * * 6"},
+		Exclusions: []Exclusion{{Schedule: "0 14 * * 3", Duration: 2 * time.Hour}, {Schedule: "0 0 25 12 *"}},
+	}).String(); got != want {
+		t.Errorf("expected %s, got %s", want, got)
+	}
+}
+
+func TestCache(t *testing.T) {
+	c := NewCache()
+	base := time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	next, err := c.Next("default/a", cronDef("*/30 * * * *"), 0, base.Add(time.Minute))
+	if err != nil || !next.Equal(base.Add(30*time.Minute)) {
+		t.Fatalf("expected 10:30, got %s, %v", next, err)
+	}
+	cached := c.entries["default/a"]
+
+	// Within the same period the entry is reused
+	if next, _ = c.Next("default/a", cronDef("*/30 * * * *"), 0, base.Add(20*time.Minute)); !next.Equal(base.Add(30 * time.Minute)) {
+		t.Errorf("expected 10:30, got %s", next)
+	}
+	if c.entries["default/a"] != cached || !cached.from.Equal(base.Add(time.Minute)) {
+		t.Error("expected the cached next run to be reused")
+	}
+
+	// Past the cached activation it moves on
+	if next, _ = c.Next("default/a", cronDef("*/30 * * * *"), 0, base.Add(30*time.Minute)); !next.Equal(base.Add(time.Hour)) {
+		t.Errorf("expected 11:00, got %s", next)
+	}
+
+	// A changed expression or offset replaces the entry
+	if next, _ = c.Next("default/a", cronDef("0 * * * *"), 5*time.Minute, base.Add(30*time.Minute)); !next.Equal(base.Add(65 * time.Minute)) {
+		t.Errorf("expected 11:05, got %s", next)
+	}
+
+	if _, err := c.Next("default/a", cronDef("not-a-cron"), 0, base); err == nil {
+		t.Error("expected an invalid expression to fail")
+	}
+	if _, ok := c.entries["default/a"]; ok {
+		t.Error("expected the invalid entry to be dropped")
+	}
+
+	c.Forget("default/b")
+	var nilCache *Cache
+	if next, err = nilCache.Next("default/a", cronDef("@hourly"), 0, base); err != nil || !next.Equal(base.Add(time.Hour)) {
+		t.Errorf("expected a nil cache to compute 11:00, got %s, %v", next, err)
+	}
+}
@@ -0,0 +1,348 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package siem forwards experiment history records to a SIEM as RFC 5424 syslog messages, either
+// in ArcSight Common Event Format (CEF) or as the JSON record, so that destructive actions are part
+// of the security audit trail and not only stored in etcd.
+package siem
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	chaosv1alpha1 "github.com/neogan74/k8s-chaos/api/v1alpha1"
+	chaosmetrics "github.com/neogan74/k8s-chaos/internal/metrics"
+)
+
+const (
+	// FormatCEF sends CEF messages
+	FormatCEF = "cef"
+	// FormatJSON sends the history record as JSON
+	FormatJSON = "json"
+
+	// Transport protocols; tcp and tls use octet-counting framing (RFC 6587, RFC 5425)
+	ProtocolUDP = "udp"
+	ProtocolTCP = "tcp"
+	ProtocolTLS = "tls"
+
+	// facilityAudit is the "log audit" syslog facility
+	facilityAudit = 13
+	appName       = "k8s-chaos"
+	msgID         = "history"
+
+	queueSize      = 100
+	forwardRetries = 3
+	dialTimeout    = 10 * time.Second
+	initialBackoff = time.Second
+
+	// maxResourceList bounds the affected resource list in a CEF message
+	maxResourceList = 1023
+)
+
+// Forwarder sends history records to a syslog collector
+type Forwarder struct {
+	// Protocol is "udp", "tcp" or "tls"
+	Protocol string
+	// Addr is the collector address (host:port)
+	Addr string
+	// Format is "cef" or "json"
+	Format string
+	// Hostname is the HOSTNAME field of every message; the pod name by default
+	Hostname string
+	// TLSConfig is used with the tls protocol; system roots are trusted when nil
+	TLSConfig *tls.Config
+
+	mu      sync.Mutex
+	conn    net.Conn
+	queue   chan *chaosv1alpha1.ChaosExperimentHistory
+	backoff time.Duration
+}
+
+// NewForwarder returns a forwarder for the collector at addr
+func NewForwarder(protocol, addr, format string) (*Forwarder, error) {
+	switch protocol {
+	case ProtocolUDP, ProtocolTCP, ProtocolTLS:
+	default:
+		return nil, fmt.Errorf("unsupported syslog protocol %q (expected udp, tcp or tls)", protocol)
+	}
+	switch format {
+	case FormatCEF, FormatJSON:
+	default:
+		return nil, fmt.Errorf("unsupported audit log format %q (expected cef or json)", format)
+	}
+	if _, _, err := net.SplitHostPort(addr); err != nil {
+		return nil, fmt.Errorf("invalid syslog address %q: %w", addr, err)
+	}
+
+	hostname, err := os.Hostname()
+	if err != nil || hostname == "" {
+		hostname = "-"
+	}
+	return &Forwarder{
+		Protocol: protocol,
+		Addr:     addr,
+		Format:   format,
+		Hostname: hostname,
+		queue:    make(chan *chaosv1alpha1.ChaosExperimentHistory, queueSize),
+		backoff:  initialBackoff,
+	}, nil
+}
+
+// Enqueue schedules a record for forwarding without blocking; records are dropped while the
+// queue is full. A nil forwarder ignores the record.
+func (f *Forwarder) Enqueue(history *chaosv1alpha1.ChaosExperimentHistory) {
+	if f == nil {
+		return
+	}
+	select {
+	case f.queue <- history.DeepCopy():
+	default:
+		ctrl.Log.WithName("siem").Info("Audit log queue full, dropping record",
+			"history", history.Namespace+"/"+history.Name)
+		chaosmetrics.AuditLogMessages.WithLabelValues("dropped").Inc()
+	}
+}
+
+// NeedLeaderElection forwards from the leader only, the replica creating the records
+func (f *Forwarder) NeedLeaderElection() bool {
+	return true
+}
+
+// Start forwards queued records until ctx is cancelled
+func (f *Forwarder) Start(ctx context.Context) error {
+	log := ctrl.Log.WithName("siem")
+	log.Info("Forwarding history records to syslog", "addr", f.Addr, "protocol", f.Protocol, "format", f.Format)
+	defer f.closeConn()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case history := <-f.queue:
+			if err := f.Forward(ctx, history); err != nil && ctx.Err() == nil {
+				log.Error(err, "Failed to forward history record", "history", history.Namespace+"/"+history.Name)
+			}
+		}
+	}
+}
+
+// Forward sends one record, reconnecting and retrying with exponential backoff on failure
+func (f *Forwarder) Forward(ctx context.Context, history *chaosv1alpha1.ChaosExperimentHistory) error {
+	msg, err := f.Message(history)
+	if err != nil {
+		return err
+	}
+
+	backoff := f.backoff
+	for attempt := 1; ; attempt++ {
+		if err = f.write(ctx, msg); err == nil {
+			chaosmetrics.AuditLogMessages.WithLabelValues("success").Inc()
+			return nil
+		}
+		f.closeConn()
+		if attempt > forwardRetries {
+			chaosmetrics.AuditLogMessages.WithLabelValues("failed").Inc()
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+	}
+}
+
+func (f *Forwarder) write(ctx context.Context, msg []byte) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.conn == nil {
+		var err error
+		dialer := &net.Dialer{Timeout: dialTimeout}
+		switch f.Protocol {
+		case ProtocolTLS:
+			tlsDialer := &tls.Dialer{NetDialer: dialer, Config: f.TLSConfig}
+			f.conn, err = tlsDialer.DialContext(ctx, "tcp", f.Addr)
+		default:
+			f.conn, err = dialer.DialContext(ctx, f.Protocol, f.Addr)
+		}
+		if err != nil {
+			f.conn = nil
+			return fmt.Errorf("failed to connect to syslog collector %s: %w", f.Addr, err)
+		}
+	}
+
+	// Datagrams carry one message each; streams need the message length in front of it
+	frame := msg
+	if f.Protocol != ProtocolUDP {
+		frame = append([]byte(strconv.Itoa(len(msg))+" "), msg...)
+	}
+	_ = f.conn.SetWriteDeadline(time.Now().Add(dialTimeout))
+	if _, err := f.conn.Write(frame); err != nil {
+		return fmt.Errorf("failed to write to syslog collector %s: %w", f.Addr, err)
+	}
+	return nil
+}
+
+func (f *Forwarder) closeConn() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.conn != nil {
+		_ = f.conn.Close()
+		f.conn = nil
+	}
+}
+
+// Message renders a record as an RFC 5424 syslog message in the configured format
+func (f *Forwarder) Message(history *chaosv1alpha1.ChaosExperimentHistory) ([]byte, error) {
+	var body string
+	switch f.Format {
+	case FormatJSON:
+		record := history.DeepCopy()
+		record.APIVersion = chaosv1alpha1.GroupVersion.String()
+		record.Kind = "ChaosExperimentHistory"
+		encoded, err := json.Marshal(record)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode history record: %w", err)
+		}
+		body = string(encoded)
+	default:
+		body = CEF(history)
+	}
+
+	timestamp := history.Spec.Audit.CreationTimestamp.Time
+	if timestamp.IsZero() {
+		timestamp = time.Now()
+	}
+	pri := facilityAudit*8 + syslogSeverity(history)
+	return []byte(fmt.Sprintf("<%d>1 %s %s %s - %s - %s",
+		pri, timestamp.UTC().Format(time.RFC3339Nano), f.Hostname, appName, msgID, body)), nil
+}
+
+// syslogSeverity is notice for successful runs, warning for failed ones and informational for dry runs
+func syslogSeverity(history *chaosv1alpha1.ChaosExperimentHistory) int {
+	switch {
+	case history.Spec.Audit.DryRun:
+		return 6
+	case history.Spec.Execution.Status == "failure" || history.Spec.Execution.Status == "partial":
+		return 4
+	default:
+		return 5
+	}
+}
+
+// cefSeverity ranks destructive runs above dry runs and failed runs above successful ones
+func cefSeverity(history *chaosv1alpha1.ChaosExperimentHistory) int {
+	if history.Spec.Audit.DryRun {
+		return 1
+	}
+	switch history.Spec.Execution.Status {
+	case "failure":
+		return 8
+	case "partial":
+		return 7
+	case "cancelled":
+		return 3
+	default:
+		return 5
+	}
+}
+
+// CEF renders a record as a CEF:0 event. The device event class is the chaos action.
+func CEF(history *chaosv1alpha1.ChaosExperimentHistory) string {
+	spec := history.Spec
+	header := []string{
+		"CEF:0",
+		"k8s-chaos",
+		"chaos-controller",
+		chaosv1alpha1.GroupVersion.Version,
+		cefHeader(spec.ExperimentSpec.Action),
+		cefHeader("Chaos experiment " + spec.Execution.Status),
+		strconv.Itoa(cefSeverity(history)),
+	}
+
+	resources := make([]string, 0, len(spec.AffectedResources))
+	for _, res := range spec.AffectedResources {
+		ref := res.Kind + "/" + res.Name
+		if res.Namespace != "" {
+			ref = res.Kind + "/" + res.Namespace + "/" + res.Name
+		}
+		resources = append(resources, ref)
+	}
+	resourceList := strings.Join(resources, ",")
+	if len(resourceList) > maxResourceList {
+		resourceList = resourceList[:maxResourceList]
+	}
+
+	var ext []string
+	add := func(key, value string) {
+		if value != "" {
+			ext = append(ext, key+"="+cefExtension(value))
+		}
+	}
+	add("start", millis(spec.Execution.StartTime.Time))
+	if spec.Execution.EndTime != nil {
+		add("end", millis(spec.Execution.EndTime.Time))
+	}
+	add("act", spec.ExperimentSpec.Action)
+	add("outcome", spec.Execution.Status)
+	add("suser", spec.Audit.InitiatedBy)
+	add("requestClientApplication", spec.Audit.InitiatedVia)
+	add("externalId", history.Namespace+"/"+history.Name)
+	add("cs1Label", "experiment")
+	add("cs1", spec.ExperimentRef.Namespace+"/"+spec.ExperimentRef.Name)
+	add("cs2Label", "targetNamespace")
+	add("cs2", spec.ExperimentSpec.Namespace)
+	add("cs3Label", "affectedResources")
+	add("cs3", resourceList)
+	add("cn1Label", "affectedCount")
+	add("cn1", strconv.Itoa(len(spec.AffectedResources)))
+	add("cs4Label", "dryRun")
+	add("cs4", strconv.FormatBool(spec.Audit.DryRun))
+	add("msg", spec.Execution.Message)
+
+	return strings.Join(header, "|") + "|" + strings.Join(ext, " ")
+}
+
+func millis(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return strconv.FormatInt(t.UnixMilli(), 10)
+}
+
+var (
+	cefHeaderEscaper    = strings.NewReplacer(`\`, `\\`, `|`, `\|`, "\r", " ", "\n", " ")
+	cefExtensionEscaper = strings.NewReplacer(`\`, `\\`, `=`, `\=`, "\r\n", `\n`, "\n", `\n`, "\r", `\r`)
+)
+
+func cefHeader(value string) string {
+	return cefHeaderEscaper.Replace(value)
+}
+
+func cefExtension(value string) string {
+	return cefExtensionEscaper.Replace(value)
+}
@@ -0,0 +1,212 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package siem
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	chaosv1alpha1 "github.com/neogan74/k8s-chaos/api/v1alpha1"
+)
+
+func testRecord() *chaosv1alpha1.ChaosExperimentHistory {
+	start := time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC)
+	end := metav1.NewTime(start.Add(3 * time.Second))
+	return &chaosv1alpha1.ChaosExperimentHistory{
+		ObjectMeta: metav1.ObjectMeta{Name: "web-kill-20260101-100003-abc12", Namespace: "chaos-system"},
+		Spec: chaosv1alpha1.ChaosExperimentHistorySpec{
+			ExperimentRef:  chaosv1alpha1.ObjectReference{Name: "web-kill", Namespace: "default"},
+			ExperimentSpec: chaosv1alpha1.ChaosExperimentSpec{Action: "pod-kill", Namespace: "shop"},
+			Execution: chaosv1alpha1.ExecutionDetails{
+				StartTime: metav1.NewTime(start),
+				EndTime:   &end,
+				Status:    "failure",
+				Message:   "deleted 1/2 pods\nretry=1",
+			},
+			AffectedResources: []chaosv1alpha1.ResourceReference{{Kind: "Pod", Name: "web-0", Namespace: "shop"}},
+			Audit: chaosv1alpha1.AuditMetadata{
+				InitiatedBy:       "system:serviceaccount:ci:deployer",
+				CreationTimestamp: end,
+			},
+		},
+	}
+}
+
+func TestCEF(t *testing.T) {
+	got := CEF(testRecord())
+	want := `CEF:0|k8s-chaos|chaos-controller|v1alpha1|pod-kill|Chaos experiment failure|8|` +
+		`start=1767261600000 end=1767261603000 act=pod-kill outcome=failure ` +
+		`suser=system:serviceaccount:ci:deployer externalId=chaos-system/web-kill-20260101-100003-abc12 ` +
+		`cs1Label=experiment cs1=default/web-kill cs2Label=targetNamespace cs2=shop ` +
+		`cs3Label=affectedResources cs3=Pod/shop/web-0 cn1Label=affectedCount cn1=1 ` +
+		`cs4Label=dryRun cs4=false msg=deleted 1/2 pods\nretry\=1`
+	if got != want {
+		t.Errorf("CEF() =\n%s\nwant\n%s", got, want)
+	}
+
+	record := testRecord()
+	record.Spec.ExperimentSpec.Action = `a|b\c`
+	record.Spec.Audit.DryRun = true
+	if got := CEF(record); !strings.HasPrefix(got, `CEF:0|k8s-chaos|chaos-controller|v1alpha1|a\|b\\c|Chaos experiment failure|1|`) {
+		t.Errorf("expected escaped header and dry-run severity, got %s", got)
+	}
+}
+
+func TestMessage(t *testing.T) {
+	f, err := NewForwarder(ProtocolUDP, "127.0.0.1:514", FormatCEF)
+	if err != nil {
+		t.Fatal(err)
+	}
+	f.Hostname = "chaos-controller-0"
+
+	msg, err := f.Message(testRecord())
+	if err != nil {
+		t.Fatal(err)
+	}
+	// facility 13 (log audit) * 8 + warning (4)
+	prefix := "<108>1 2026-01-01T10:00:03Z chaos-controller-0 k8s-chaos - history - CEF:0|"
+	if !strings.HasPrefix(string(msg), prefix) {
+		t.Errorf("unexpected message %s", msg)
+	}
+
+	f.Format = FormatJSON
+	msg, err = f.Message(testRecord())
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, body, _ := strings.Cut(string(msg), " history - ")
+	var record chaosv1alpha1.ChaosExperimentHistory
+	if err := json.Unmarshal([]byte(body), &record); err != nil || record.Kind != "ChaosExperimentHistory" {
+		t.Errorf("expected the JSON record, got %s (%v)", body, err)
+	}
+}
+
+func TestNewForwarderValidates(t *testing.T) {
+	tests := []struct {
+		protocol, addr, format string
+	}{
+		{"http", "siem:514", FormatCEF},
+		{ProtocolTCP, "siem", FormatCEF},
+		{ProtocolTCP, "siem:514", "leef"},
+	}
+	for _, tt := range tests {
+		if _, err := NewForwarder(tt.protocol, tt.addr, tt.format); err == nil {
+			t.Errorf("expected %v to be rejected", tt)
+		}
+	}
+}
+
+func TestForwardTCPFraming(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = listener.Close() }()
+
+	received := make(chan string, 2)
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer func() { _ = conn.Close() }()
+		reader := bufio.NewReader(conn)
+		for {
+			length, err := reader.ReadString(' ')
+			if err != nil {
+				return
+			}
+			size, _ := strconv.Atoi(strings.TrimSpace(length))
+			msg := make([]byte, size)
+			if _, err := io.ReadFull(reader, msg); err != nil {
+				return
+			}
+			received <- string(msg)
+		}
+	}()
+
+	f, err := NewForwarder(ProtocolTCP, listener.Addr().String(), FormatCEF)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.closeConn()
+	for i := 0; i < 2; i++ {
+		if err := f.Forward(context.Background(), testRecord()); err != nil {
+			t.Fatalf("Forward() error = %v", err)
+		}
+	}
+	for i := 0; i < 2; i++ {
+		select {
+		case msg := <-received:
+			if !strings.Contains(msg, "CEF:0|k8s-chaos|") || strings.Contains(msg, "\n") {
+				t.Errorf("unexpected message %q", msg)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatal("message not received")
+		}
+	}
+}
+
+func TestForwardUDP(t *testing.T) {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = conn.Close() }()
+
+	f, err := NewForwarder(ProtocolUDP, conn.LocalAddr().String(), FormatCEF)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.closeConn()
+	if err := f.Forward(context.Background(), testRecord()); err != nil {
+		t.Fatalf("Forward() error = %v", err)
+	}
+
+	buf := make([]byte, 64*1024)
+	_ = conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+	n, _, err := conn.ReadFrom(buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(string(buf[:n]), "<108>1 ") {
+		t.Errorf("expected an unframed datagram, got %q", buf[:n])
+	}
+}
+
+func TestForwardGivesUp(t *testing.T) {
+	f, err := NewForwarder(ProtocolTCP, "127.0.0.1:1", FormatCEF)
+	if err != nil {
+		t.Fatal(err)
+	}
+	f.backoff = time.Millisecond
+	if err := f.Forward(context.Background(), testRecord()); err == nil {
+		t.Error("expected an unreachable collector to fail")
+	}
+
+	var nilForwarder *Forwarder
+	nilForwarder.Enqueue(testRecord())
+}
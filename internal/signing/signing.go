@@ -0,0 +1,183 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package signing signs and verifies ChaosExperimentHistory records so that
+// auditors can detect modifications made after a record was created.
+package signing
+
+import (
+	"crypto/ed25519"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+
+	chaosv1alpha1 "github.com/neogan74/k8s-chaos/api/v1alpha1"
+)
+
+const (
+	// SignatureAnnotation holds the signature of the history record spec, formatted as "<algorithm>:<base64>"
+	SignatureAnnotation = "chaos.gushchin.dev/signature"
+
+	// AlgorithmHMAC signs with HMAC-SHA256 using a shared secret
+	AlgorithmHMAC = "hmac-sha256"
+	// AlgorithmEd25519 signs with an ed25519 private key; verification only needs the public key
+	AlgorithmEd25519 = "ed25519"
+
+	// SecretKeyHMAC is the Secret data key holding the HMAC shared secret
+	SecretKeyHMAC = "hmac-key"
+	// SecretKeyEd25519Private is the Secret data key holding the ed25519 private key or seed
+	SecretKeyEd25519Private = "ed25519-private-key"
+	// SecretKeyEd25519Public is the Secret data key holding the ed25519 public key
+	SecretKeyEd25519Public = "ed25519-public-key"
+)
+
+var (
+	// ErrNotSigned is returned by Verify for records without a signature annotation
+	ErrNotSigned = errors.New("record is not signed")
+	// ErrMismatch is returned by Verify when the record was modified or signed with another key
+	ErrMismatch = errors.New("signature does not match record")
+)
+
+// Key holds the material used to sign or verify history records
+type Key struct {
+	Algorithm  string
+	HMACSecret []byte
+	PrivateKey ed25519.PrivateKey
+	PublicKey  ed25519.PublicKey
+}
+
+// KeyFromSecret loads a signing key from a Secret.
+// The Secret must contain either "hmac-key", or "ed25519-private-key" (64-byte seed+key or 32-byte seed)
+// and/or "ed25519-public-key". A public key alone is enough to verify but not to sign.
+func KeyFromSecret(secret *corev1.Secret) (*Key, error) {
+	if hmacKey, ok := secret.Data[SecretKeyHMAC]; ok {
+		if len(hmacKey) == 0 {
+			return nil, fmt.Errorf("secret %s/%s: %q is empty", secret.Namespace, secret.Name, SecretKeyHMAC)
+		}
+		return &Key{Algorithm: AlgorithmHMAC, HMACSecret: hmacKey}, nil
+	}
+
+	key := &Key{Algorithm: AlgorithmEd25519}
+	if priv, ok := secret.Data[SecretKeyEd25519Private]; ok {
+		switch len(priv) {
+		case ed25519.SeedSize:
+			key.PrivateKey = ed25519.NewKeyFromSeed(priv)
+		case ed25519.PrivateKeySize:
+			key.PrivateKey = ed25519.PrivateKey(priv)
+		default:
+			return nil, fmt.Errorf("secret %s/%s: invalid ed25519 private key length %d",
+				secret.Namespace, secret.Name, len(priv))
+		}
+		key.PublicKey = key.PrivateKey.Public().(ed25519.PublicKey)
+	}
+	if pub, ok := secret.Data[SecretKeyEd25519Public]; ok {
+		if len(pub) != ed25519.PublicKeySize {
+			return nil, fmt.Errorf("secret %s/%s: invalid ed25519 public key length %d",
+				secret.Namespace, secret.Name, len(pub))
+		}
+		key.PublicKey = ed25519.PublicKey(pub)
+	}
+	if key.PublicKey == nil {
+		return nil, fmt.Errorf("secret %s/%s contains neither %q nor an ed25519 key",
+			secret.Namespace, secret.Name, SecretKeyHMAC)
+	}
+	return key, nil
+}
+
+// Payload returns the canonical bytes that are signed for a history record.
+// Only the spec is covered; metadata and status may legitimately change (e.g. archiving).
+func Payload(history *chaosv1alpha1.ChaosExperimentHistory) ([]byte, error) {
+	payload, err := json.Marshal(history.Spec)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode history spec: %w", err)
+	}
+	return payload, nil
+}
+
+// Sign computes the signature of the history record and stores it in the signature annotation
+func Sign(key *Key, history *chaosv1alpha1.ChaosExperimentHistory) error {
+	payload, err := Payload(history)
+	if err != nil {
+		return err
+	}
+
+	var sig []byte
+	switch key.Algorithm {
+	case AlgorithmHMAC:
+		mac := hmac.New(sha256.New, key.HMACSecret)
+		mac.Write(payload)
+		sig = mac.Sum(nil)
+	case AlgorithmEd25519:
+		if key.PrivateKey == nil {
+			return fmt.Errorf("ed25519 private key is required for signing")
+		}
+		sig = ed25519.Sign(key.PrivateKey, payload)
+	default:
+		return fmt.Errorf("unsupported signature algorithm %q", key.Algorithm)
+	}
+
+	if history.Annotations == nil {
+		history.Annotations = map[string]string{}
+	}
+	history.Annotations[SignatureAnnotation] = key.Algorithm + ":" + base64.StdEncoding.EncodeToString(sig)
+	return nil
+}
+
+// Verify checks the signature annotation of the history record against its spec
+func Verify(key *Key, history *chaosv1alpha1.ChaosExperimentHistory) error {
+	value, ok := history.Annotations[SignatureAnnotation]
+	if !ok || value == "" {
+		return ErrNotSigned
+	}
+	algorithm, encoded, found := strings.Cut(value, ":")
+	if !found {
+		return fmt.Errorf("malformed signature annotation")
+	}
+	if algorithm != key.Algorithm {
+		return fmt.Errorf("record signed with %s but key is %s", algorithm, key.Algorithm)
+	}
+	sig, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return fmt.Errorf("malformed signature: %w", err)
+	}
+
+	payload, err := Payload(history)
+	if err != nil {
+		return err
+	}
+
+	switch algorithm {
+	case AlgorithmHMAC:
+		mac := hmac.New(sha256.New, key.HMACSecret)
+		mac.Write(payload)
+		if !hmac.Equal(sig, mac.Sum(nil)) {
+			return ErrMismatch
+		}
+	case AlgorithmEd25519:
+		if !ed25519.Verify(key.PublicKey, payload, sig) {
+			return ErrMismatch
+		}
+	default:
+		return fmt.Errorf("unsupported signature algorithm %q", algorithm)
+	}
+	return nil
+}
@@ -0,0 +1,136 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package signing
+
+import (
+	"crypto/ed25519"
+	"errors"
+	"strings"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	chaosv1alpha1 "github.com/neogan74/k8s-chaos/api/v1alpha1"
+)
+
+func newHistory() *chaosv1alpha1.ChaosExperimentHistory {
+	return &chaosv1alpha1.ChaosExperimentHistory{
+		ObjectMeta: metav1.ObjectMeta{Name: "exp-20250101-120000", Namespace: "chaos-system"},
+		Spec: chaosv1alpha1.ChaosExperimentHistorySpec{
+			ExperimentRef: chaosv1alpha1.ObjectReference{Name: "exp", Namespace: "default"},
+			ExperimentSpec: chaosv1alpha1.ChaosExperimentSpec{
+				Action:    "pod-kill",
+				Namespace: "default",
+				Selector:  map[string]string{"app": "nginx"},
+				Count:     1,
+			},
+			Execution: chaosv1alpha1.ExecutionDetails{Status: "success"},
+		},
+	}
+}
+
+func secretWith(data map[string][]byte) *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "signing-key", Namespace: "chaos-system"},
+		Data:       data,
+	}
+}
+
+func TestSignVerify_HMAC(t *testing.T) {
+	key, err := KeyFromSecret(secretWith(map[string][]byte{SecretKeyHMAC: []byte("s3cret")}))
+	if err != nil {
+		t.Fatalf("unexpected error loading key: %v", err)
+	}
+
+	history := newHistory()
+	if err := Sign(key, history); err != nil {
+		t.Fatalf("unexpected error signing: %v", err)
+	}
+	if !strings.HasPrefix(history.Annotations[SignatureAnnotation], AlgorithmHMAC+":") {
+		t.Fatalf("expected hmac signature annotation, got %q", history.Annotations[SignatureAnnotation])
+	}
+	if err := Verify(key, history); err != nil {
+		t.Fatalf("expected valid signature, got %v", err)
+	}
+
+	history.Spec.AffectedResources = append(history.Spec.AffectedResources,
+		chaosv1alpha1.ResourceReference{Kind: "Pod", Name: "injected"})
+	if err := Verify(key, history); !errors.Is(err, ErrMismatch) {
+		t.Fatalf("expected ErrMismatch after tampering, got %v", err)
+	}
+
+	otherKey, _ := KeyFromSecret(secretWith(map[string][]byte{SecretKeyHMAC: []byte("other")}))
+	history = newHistory()
+	_ = Sign(key, history)
+	if err := Verify(otherKey, history); !errors.Is(err, ErrMismatch) {
+		t.Fatalf("expected ErrMismatch with a different key, got %v", err)
+	}
+}
+
+func TestSignVerify_Ed25519(t *testing.T) {
+	pub, priv, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+
+	signKey, err := KeyFromSecret(secretWith(map[string][]byte{SecretKeyEd25519Private: priv.Seed()}))
+	if err != nil {
+		t.Fatalf("unexpected error loading private key: %v", err)
+	}
+	verifyKey, err := KeyFromSecret(secretWith(map[string][]byte{SecretKeyEd25519Public: pub}))
+	if err != nil {
+		t.Fatalf("unexpected error loading public key: %v", err)
+	}
+
+	history := newHistory()
+	if err := Sign(signKey, history); err != nil {
+		t.Fatalf("unexpected error signing: %v", err)
+	}
+	if err := Verify(verifyKey, history); err != nil {
+		t.Fatalf("expected valid signature with public key, got %v", err)
+	}
+	if err := Sign(verifyKey, newHistory()); err == nil {
+		t.Fatal("expected signing with a public key only to fail")
+	}
+
+	history.Spec.Execution.Status = "failure"
+	if err := Verify(verifyKey, history); !errors.Is(err, ErrMismatch) {
+		t.Fatalf("expected ErrMismatch after tampering, got %v", err)
+	}
+}
+
+func TestVerify_Unsigned(t *testing.T) {
+	key, _ := KeyFromSecret(secretWith(map[string][]byte{SecretKeyHMAC: []byte("s3cret")}))
+	if err := Verify(key, newHistory()); !errors.Is(err, ErrNotSigned) {
+		t.Fatalf("expected ErrNotSigned, got %v", err)
+	}
+}
+
+func TestKeyFromSecret_Invalid(t *testing.T) {
+	cases := map[string]map[string][]byte{
+		"empty secret":       {},
+		"empty hmac key":     {SecretKeyHMAC: {}},
+		"short private key":  {SecretKeyEd25519Private: []byte("short")},
+		"invalid public key": {SecretKeyEd25519Public: []byte("short")},
+	}
+	for name, data := range cases {
+		if _, err := KeyFromSecret(secretWith(data)); err == nil {
+			t.Errorf("%s: expected error", name)
+		}
+	}
+}
@@ -0,0 +1,121 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package verdict judges the successCriteria of a completed experiment: CEL expressions over what
+// the experiment observed, such as metric samples and how many of its targets recovered.
+package verdict
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/common/types"
+)
+
+// costLimit bounds the work of evaluating one expression
+const costLimit = 100000
+
+// Inputs are the variables expressions are evaluated with
+type Inputs struct {
+	// Metrics maps the names of metricsQueries to their sampled points: "before", "during" and
+	// "after". Points that could not be sampled are missing.
+	Metrics map[string]map[string]float64
+	// Affected counts the targets the experiment's injection rounds affected
+	Affected int64
+	// Recovered counts the affected targets seen recovering, Unrecovered those that timed out or
+	// had not recovered yet
+	Recovered, Unrecovered int64
+	// RecoverySeconds is the slowest recovery, 0 when none was measured
+	RecoverySeconds float64
+	// FailedTargets and UnverifiedTargets count injected containers that failed, or whose fault
+	// was not found in place
+	FailedTargets, UnverifiedTargets int64
+}
+
+func (in Inputs) activation() map[string]any {
+	metrics := in.Metrics
+	if metrics == nil {
+		metrics = map[string]map[string]float64{}
+	}
+	return map[string]any{
+		"metrics":           metrics,
+		"affected":          in.Affected,
+		"recovered":         in.Recovered,
+		"unrecovered":       in.Unrecovered,
+		"recoverySeconds":   in.RecoverySeconds,
+		"failedTargets":     in.FailedTargets,
+		"unverifiedTargets": in.UnverifiedTargets,
+	}
+}
+
+// Result is the outcome of one criterion
+type Result struct {
+	Passed bool
+	// Message explains a failed criterion
+	Message string
+}
+
+var env = sync.OnceValues(func() (*cel.Env, error) {
+	return cel.NewEnv(
+		cel.Variable("metrics", cel.MapType(cel.StringType, cel.MapType(cel.StringType, cel.DoubleType))),
+		cel.Variable("affected", cel.IntType),
+		cel.Variable("recovered", cel.IntType),
+		cel.Variable("unrecovered", cel.IntType),
+		cel.Variable("recoverySeconds", cel.DoubleType),
+		cel.Variable("failedTargets", cel.IntType),
+		cel.Variable("unverifiedTargets", cel.IntType),
+	)
+})
+
+// program compiles expression into a program that must evaluate to a bool
+func program(expression string) (cel.Program, error) {
+	e, err := env()
+	if err != nil {
+		return nil, err
+	}
+	ast, issues := e.Compile(expression)
+	if issues.Err() != nil {
+		return nil, issues.Err()
+	}
+	if ast.OutputType() != cel.BoolType {
+		return nil, fmt.Errorf("expression must evaluate to a bool, not %s", ast.OutputType())
+	}
+	return e.Program(ast, cel.CostLimit(costLimit))
+}
+
+// Compile checks that expression is a valid criterion
+func Compile(expression string) error {
+	_, err := program(expression)
+	return err
+}
+
+// Evaluate judges expression with in. An expression that cannot be evaluated, for example
+// because it reads a metric that was not sampled, fails.
+func Evaluate(expression string, in Inputs) Result {
+	prg, err := program(expression)
+	if err != nil {
+		return Result{Message: err.Error()}
+	}
+	out, _, err := prg.Eval(in.activation())
+	if err != nil {
+		return Result{Message: err.Error()}
+	}
+	if out != types.True {
+		return Result{Message: "expression is false"}
+	}
+	return Result{Passed: true}
+}
@@ -0,0 +1,80 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package verdict
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestCompile(t *testing.T) {
+	for _, expression := range []string{
+		"recovered == affected",
+		"recoverySeconds < 60.0 && failedTargets == 0",
+		`metrics["error-rate"].after <= metrics["error-rate"].before * 1.1`,
+		`!("p99" in metrics) || metrics.p99.after < 0.5`,
+	} {
+		if err := Compile(expression); err != nil {
+			t.Errorf("Compile(%q) = %v", expression, err)
+		}
+	}
+
+	for expression, want := range map[string]string{
+		"recovered":            "must evaluate to a bool",
+		"recovered == 1.0":     "no matching overload",
+		"unknownVariable == 1": "undeclared reference",
+		"recovered ==":         "Syntax error",
+	} {
+		if err := Compile(expression); err == nil || !strings.Contains(err.Error(), want) {
+			t.Errorf("Compile(%q) = %v, want an error containing %q", expression, err, want)
+		}
+	}
+}
+
+func TestEvaluate(t *testing.T) {
+	in := Inputs{
+		Metrics: map[string]map[string]float64{
+			"error-rate": {"before": 0.01, "after": 0.012},
+		},
+		Affected:        3,
+		Recovered:       2,
+		Unrecovered:     1,
+		RecoverySeconds: 41.5,
+	}
+
+	tests := []struct {
+		expression string
+		passed     bool
+		message    string
+	}{
+		{"affected == 3 && recoverySeconds < 60.0", true, ""},
+		{"recovered == affected", false, "expression is false"},
+		{`metrics["error-rate"].after <= metrics["error-rate"].before * 1.5`, true, ""},
+		{`metrics["error-rate"].during < 1.0`, false, "no such key: during"},
+		{`metrics["latency"].after < 1.0`, false, "no such key: latency"},
+	}
+	for _, tt := range tests {
+		result := Evaluate(tt.expression, in)
+		if result.Passed != tt.passed || !strings.Contains(result.Message, tt.message) {
+			t.Errorf("Evaluate(%q) = %+v, want passed=%v with message %q", tt.expression, result, tt.passed, tt.message)
+		}
+	}
+
+	if result := Evaluate("metrics.size() == 0", Inputs{}); !result.Passed {
+		t.Errorf("experiments without metrics should see an empty map, got %+v", result)
+	}
+}
@@ -0,0 +1,169 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package chaostest embeds chaos experiments in end-to-end suites. It creates experiments through
+// the typed client, waits for their faults to be injected and aborts and deletes them when the
+// spec ends, so application teams can run chaos scenarios against shared clusters without leaving
+// experiments behind:
+//
+//	var _ = Describe("checkout", func() {
+//		It("keeps serving while a pod is killed", func(ctx SpecContext) {
+//			chaos := chaostest.New(cs, "chaos-testing")
+//			_, err := chaos.InjectAndWait(ctx, chaosv1alpha1.ChaosExperimentSpec{
+//				Action:    "pod-kill",
+//				Namespace: "shop",
+//				Selector:  map[string]string{"app": "checkout"},
+//			})
+//			Expect(err).NotTo(HaveOccurred())
+//			result := chaostest.Sample(ctx, chaostest.HTTPGet(checkoutURL, http.StatusOK), time.Second, time.Minute)
+//			Expect(result.AtLeast(0.95)).To(Succeed())
+//		})
+//	})
+package chaostest
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/onsi/ginkgo/v2"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	chaosv1alpha1 "github.com/neogan74/k8s-chaos/api/v1alpha1"
+	"github.com/neogan74/k8s-chaos/pkg/client"
+)
+
+const (
+	// CreatedByLabel is set on every experiment created by this package, so leftovers of an
+	// interrupted suite can be removed with kubectl delete chaosexperiments -l
+	CreatedByLabel = "app.kubernetes.io/created-by"
+	// CreatedByValue is the value of CreatedByLabel
+	CreatedByValue = "chaostest"
+)
+
+// Chaos creates experiments for the current test and removes them when it ends
+type Chaos struct {
+	Client *client.Clientset
+	// Namespace the experiments are created in
+	Namespace string
+	// Cleanup registers a function to run when the current test ends. It defaults to Ginkgo's
+	// DeferCleanup; set it to t.Cleanup in plain go tests.
+	Cleanup func(func())
+	// CleanupTimeout bounds the abort and deletion of each experiment, two minutes by default
+	CleanupTimeout time.Duration
+}
+
+// New returns a Chaos creating experiments in namespace, cleaned up with Ginkgo's DeferCleanup
+func New(cs *client.Clientset, namespace string) *Chaos {
+	return &Chaos{
+		Client:         cs,
+		Namespace:      namespace,
+		Cleanup:        func(f func()) { ginkgo.DeferCleanup(f) },
+		CleanupTimeout: 2 * time.Minute,
+	}
+}
+
+// Inject creates an experiment running spec, named after its action, and registers its cleanup.
+// It does not wait for the controller; see InjectAndWait.
+func (c *Chaos) Inject(ctx context.Context, spec chaosv1alpha1.ChaosExperimentSpec) (*chaosv1alpha1.ChaosExperiment, error) {
+	exp := &chaosv1alpha1.ChaosExperiment{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: "chaostest-" + strings.ToLower(spec.Action) + "-",
+			Namespace:    c.Namespace,
+			Labels:       map[string]string{CreatedByLabel: CreatedByValue},
+		},
+		Spec: spec,
+	}
+	created, err := c.Client.ChaosExperiments(c.Namespace).Create(ctx, exp, metav1.CreateOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create %s experiment: %w", spec.Action, err)
+	}
+
+	name := created.Name
+	c.Cleanup(func() {
+		ctx, cancel := context.WithTimeout(context.Background(), c.CleanupTimeout)
+		defer cancel()
+		if err := c.Stop(ctx, name); err != nil {
+			ginkgo.GinkgoWriter.Printf("chaostest: cleanup of %s/%s failed: %v\n", c.Namespace, name, err)
+		}
+	})
+	return created, nil
+}
+
+// InjectAndWait creates an experiment like Inject and waits until it has run, i.e. its faults
+// are in place. An experiment ending in the Failed phase returns client.ErrExperimentFailed.
+func (c *Chaos) InjectAndWait(ctx context.Context, spec chaosv1alpha1.ChaosExperimentSpec) (*chaosv1alpha1.ChaosExperiment, error) {
+	exp, err := c.Inject(ctx, spec)
+	if err != nil {
+		return nil, err
+	}
+	exp, err = c.Client.WaitFor(ctx, c.Namespace, exp.Name, func(exp *chaosv1alpha1.ChaosExperiment) bool {
+		return exp.Status.LastRunTime != nil || isTerminal(exp)
+	})
+	if err != nil {
+		return exp, err
+	}
+	if exp.Status.Phase == client.PhaseFailed {
+		return exp, fmt.Errorf("%w: %s", client.ErrExperimentFailed, exp.Status.Message)
+	}
+	return exp, nil
+}
+
+// Stop aborts the experiment so the controller reverts its injections, waits for that, then
+// deletes it and waits until it is gone. A missing experiment is not an error.
+func (c *Chaos) Stop(ctx context.Context, name string) error {
+	experiments := c.Client.ChaosExperiments(c.Namespace)
+	exp, err := experiments.Get(ctx, name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if !isTerminal(exp) {
+		patch := fmt.Sprintf(`{"metadata":{"annotations":{%q:%q}}}`, chaosv1alpha1.AbortAnnotation, CreatedByValue)
+		if _, err := experiments.Patch(ctx, name, types.MergePatchType, []byte(patch), metav1.PatchOptions{}); err != nil {
+			return fmt.Errorf("failed to abort %s/%s: %w", c.Namespace, name, err)
+		}
+		if _, err := c.Client.WaitFor(ctx, c.Namespace, name, isTerminal); err != nil {
+			return err
+		}
+	}
+
+	if err := experiments.Delete(ctx, name, metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("failed to delete %s/%s: %w", c.Namespace, name, err)
+	}
+	err = wait.PollUntilContextCancel(ctx, client.PollInterval, true, func(ctx context.Context) (bool, error) {
+		_, err := experiments.Get(ctx, name, metav1.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			return true, nil
+		}
+		return false, err
+	})
+	if err != nil {
+		return fmt.Errorf("waiting for %s/%s to be deleted: %w", c.Namespace, name, err)
+	}
+	return nil
+}
+
+func isTerminal(exp *chaosv1alpha1.ChaosExperiment) bool {
+	return exp.Status.Phase == client.PhaseCompleted || exp.Status.Phase == client.PhaseFailed
+}
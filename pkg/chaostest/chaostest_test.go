@@ -0,0 +1,249 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package chaostest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/rest"
+
+	chaosv1alpha1 "github.com/neogan74/k8s-chaos/api/v1alpha1"
+	"github.com/neogan74/k8s-chaos/pkg/client"
+)
+
+const experimentsPath = "/apis/chaos.gushchin.dev/v1alpha1/namespaces/chaos-testing/chaosexperiments"
+
+// fakeAPI stores experiments in memory and plays the controller: an experiment has run on its
+// second read and completes once the abort annotation is set
+type fakeAPI struct {
+	t           *testing.T
+	mu          sync.Mutex
+	experiments map[string]*chaosv1alpha1.ChaosExperiment
+	reads       map[string]int
+	aborted     []string
+	deleted     []string
+	failRun     bool
+}
+
+func (f *fakeAPI) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	name := strings.TrimPrefix(strings.TrimPrefix(r.URL.Path, experimentsPath), "/")
+	exp := f.experiments[name]
+	if r.Method != http.MethodPost && exp == nil {
+		f.write(w, http.StatusNotFound, apierrors.NewNotFound(
+			chaosv1alpha1.GroupVersion.WithResource("chaosexperiments").GroupResource(), name).Status())
+		return
+	}
+
+	switch r.Method {
+	case http.MethodPost:
+		exp = &chaosv1alpha1.ChaosExperiment{}
+		if err := json.NewDecoder(r.Body).Decode(exp); err != nil {
+			f.t.Error(err)
+		}
+		exp.Name = exp.GenerateName + "abcde"
+		exp.Status.Phase = client.PhasePending
+		f.experiments[exp.Name] = exp
+		f.write(w, http.StatusCreated, exp)
+	case http.MethodGet:
+		f.reads[name]++
+		if f.reads[name] == 2 && exp.Status.Phase == client.PhasePending {
+			if f.failRun {
+				exp.Status.Phase, exp.Status.Message = client.PhaseFailed, "no eligible pods"
+			} else {
+				now := metav1.Now()
+				exp.Status.Phase, exp.Status.LastRunTime = client.PhaseRunning, &now
+			}
+		}
+		f.write(w, http.StatusOK, exp)
+	case http.MethodPatch:
+		var patch chaosv1alpha1.ChaosExperiment
+		if err := json.NewDecoder(r.Body).Decode(&patch); err != nil {
+			f.t.Error(err)
+		}
+		if value := patch.Annotations[chaosv1alpha1.AbortAnnotation]; value != "" {
+			f.aborted = append(f.aborted, name)
+			exp.Status.Phase = client.PhaseCompleted
+		}
+		f.write(w, http.StatusOK, exp)
+	case http.MethodDelete:
+		delete(f.experiments, name)
+		f.deleted = append(f.deleted, name)
+		f.write(w, http.StatusOK, &metav1.Status{Status: metav1.StatusSuccess})
+	}
+}
+
+func (f *fakeAPI) write(w http.ResponseWriter, status int, obj any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(obj); err != nil {
+		f.t.Error(err)
+	}
+}
+
+func newTestChaos(t *testing.T, api *fakeAPI) *Chaos {
+	t.Helper()
+	client.PollInterval = 10 * time.Millisecond
+	t.Cleanup(func() { client.PollInterval = 2 * time.Second })
+
+	api.t = t
+	api.experiments = map[string]*chaosv1alpha1.ChaosExperiment{}
+	api.reads = map[string]int{}
+	srv := httptest.NewServer(api)
+	t.Cleanup(srv.Close)
+	cs, err := client.NewForConfig(&rest.Config{Host: srv.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+	chaos := New(cs, "chaos-testing")
+	chaos.Cleanup = t.Cleanup
+	chaos.CleanupTimeout = 5 * time.Second
+	return chaos
+}
+
+var podKill = chaosv1alpha1.ChaosExperimentSpec{
+	Action:    "pod-kill",
+	Namespace: "shop",
+	Selector:  map[string]string{"app": "checkout"},
+}
+
+func TestInjectAndWaitCleansUp(t *testing.T) {
+	api := &fakeAPI{}
+	t.Run("inject", func(t *testing.T) {
+		chaos := newTestChaos(t, api)
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		exp, err := chaos.InjectAndWait(ctx, podKill)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if exp.Name != "chaostest-pod-kill-abcde" || exp.Status.LastRunTime == nil {
+			t.Errorf("unexpected experiment %s with status %+v", exp.Name, exp.Status)
+		}
+		if exp.Labels[CreatedByLabel] != CreatedByValue {
+			t.Errorf("missing %s label: %v", CreatedByLabel, exp.Labels)
+		}
+	})
+
+	if len(api.aborted) != 1 || len(api.deleted) != 1 || len(api.experiments) != 0 {
+		t.Errorf("cleanup should abort and delete the experiment: aborted=%v deleted=%v left=%d",
+			api.aborted, api.deleted, len(api.experiments))
+	}
+}
+
+func TestInjectAndWaitFailed(t *testing.T) {
+	api := &fakeAPI{failRun: true}
+	t.Run("inject", func(t *testing.T) {
+		chaos := newTestChaos(t, api)
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		if _, err := chaos.InjectAndWait(ctx, podKill); !errors.Is(err, client.ErrExperimentFailed) {
+			t.Errorf("expected ErrExperimentFailed, got %v", err)
+		}
+	})
+
+	// A failed experiment has nothing to revert, so it is only deleted
+	if len(api.aborted) != 0 || len(api.deleted) != 1 {
+		t.Errorf("unexpected cleanup aborted=%v deleted=%v", api.aborted, api.deleted)
+	}
+}
+
+func TestStopMissingExperiment(t *testing.T) {
+	chaos := newTestChaos(t, &fakeAPI{})
+	if err := chaos.Stop(context.Background(), "gone"); err != nil {
+		t.Errorf("stopping a missing experiment: %v", err)
+	}
+}
+
+func TestSample(t *testing.T) {
+	calls := 0
+	flaky := func(context.Context) error {
+		calls++
+		if calls%4 == 0 {
+			return errors.New("connection refused")
+		}
+		return nil
+	}
+
+	result := Sample(context.Background(), flaky, 5*time.Millisecond, 200*time.Millisecond)
+	if result.Attempts < 8 || result.Failures != result.Attempts/4 {
+		t.Fatalf("unexpected result %+v", result)
+	}
+	if err := result.AtLeast(0.7); err != nil {
+		t.Errorf("AtLeast(0.7): %v", err)
+	}
+	if err := result.AtLeast(0.9); err == nil || !strings.Contains(err.Error(), "connection refused") {
+		t.Errorf("AtLeast(0.9) should fail with the last error, got %v", err)
+	}
+	if err := (ProbeResult{}).AtLeast(0); !errors.Is(err, errNoAttempts) {
+		t.Errorf("empty result: got %v", err)
+	}
+}
+
+func TestHTTPGet(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/down" {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	defer srv.Close()
+
+	ctx := context.Background()
+	if err := HTTPGet(srv.URL+"/up", http.StatusOK)(ctx); err != nil {
+		t.Errorf("healthy endpoint: %v", err)
+	}
+	if err := HTTPGet(srv.URL+"/down", http.StatusOK)(ctx); err == nil {
+		t.Error("expected an error for status 503")
+	}
+}
+
+func TestPodsReady(t *testing.T) {
+	pod := func(name string, ready corev1.ConditionStatus) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "shop", Labels: map[string]string{"app": "checkout"}},
+			Status: corev1.PodStatus{Conditions: []corev1.PodCondition{
+				{Type: corev1.PodReady, Status: ready},
+			}},
+		}
+	}
+	cs := fake.NewClientset(pod("a", corev1.ConditionTrue), pod("b", corev1.ConditionFalse))
+
+	ctx := context.Background()
+	selector := map[string]string{"app": "checkout"}
+	if err := PodsReady(cs, "shop", selector, 1)(ctx); err != nil {
+		t.Errorf("one ready pod: %v", err)
+	}
+	if err := PodsReady(cs, "shop", selector, 2)(ctx); err == nil {
+		t.Error("expected an error with one of two pods ready")
+	}
+}
@@ -0,0 +1,147 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package chaostest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/kubernetes"
+)
+
+// Probe checks the steady state of the system under test, returning nil while it holds. A Probe
+// can be passed to Gomega's Eventually directly: Eventually(probe).WithContext(ctx).Should(Succeed()).
+type Probe func(ctx context.Context) error
+
+// HTTPGet probes url, expecting the given status code
+func HTTPGet(url string, status int) Probe {
+	return func(ctx context.Context) error {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+		if err != nil {
+			return err
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			return err
+		}
+		_ = resp.Body.Close()
+		if resp.StatusCode != status {
+			return fmt.Errorf("GET %s: got status %d, want %d", url, resp.StatusCode, status)
+		}
+		return nil
+	}
+}
+
+// PodsReady probes that at least minReady pods matching selector in namespace are Ready
+func PodsReady(cs kubernetes.Interface, namespace string, selector map[string]string, minReady int) Probe {
+	return func(ctx context.Context) error {
+		pods, err := cs.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{
+			LabelSelector: labels.SelectorFromSet(selector).String(),
+		})
+		if err != nil {
+			return err
+		}
+		ready := 0
+		for _, pod := range pods.Items {
+			if pod.DeletionTimestamp == nil && isReady(&pod) {
+				ready++
+			}
+		}
+		if ready < minReady {
+			return fmt.Errorf("%d pods matching %v in %s are ready, want at least %d",
+				ready, selector, namespace, minReady)
+		}
+		return nil
+	}
+}
+
+func isReady(pod *corev1.Pod) bool {
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type == corev1.PodReady {
+			return condition.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
+
+// errNoAttempts is returned by ProbeResult.AtLeast when the probe never ran
+var errNoAttempts = errors.New("probe never ran")
+
+// ProbeResult counts the outcomes of a sampled probe
+type ProbeResult struct {
+	Attempts int
+	Failures int
+	// LastError is the error of the most recent failed attempt
+	LastError error
+}
+
+// SuccessRate is the fraction of attempts that succeeded, 0 when the probe never ran
+func (r ProbeResult) SuccessRate() float64 {
+	if r.Attempts == 0 {
+		return 0
+	}
+	return float64(r.Attempts-r.Failures) / float64(r.Attempts)
+}
+
+// AtLeast returns an error unless at least rate of the attempts succeeded
+func (r ProbeResult) AtLeast(rate float64) error {
+	if r.Attempts == 0 {
+		return errNoAttempts
+	}
+	if r.SuccessRate() < rate {
+		return fmt.Errorf("probe succeeded %d of %d times (%.1f%%, want %.1f%%), last error: %w",
+			r.Attempts-r.Failures, r.Attempts, 100*r.SuccessRate(), 100*rate, r.LastError)
+	}
+	return nil
+}
+
+// Sample runs probe every interval for duration, or until ctx ends, and counts the outcomes.
+// Each attempt is bounded by interval so a hanging probe counts as a failure.
+func Sample(ctx context.Context, probe Probe, interval, duration time.Duration) ProbeResult {
+	ctx, cancel := context.WithTimeout(ctx, duration)
+	defer cancel()
+
+	var result ProbeResult
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		attemptCtx, cancelAttempt := context.WithTimeout(ctx, interval)
+		err := probe(attemptCtx)
+		cancelAttempt()
+		if ctx.Err() != nil {
+			// The window ended during the attempt, so its outcome says nothing about the system
+			return result
+		}
+		result.Attempts++
+		if err != nil {
+			result.Failures++
+			result.LastError = err
+		}
+
+		select {
+		case <-ctx.Done():
+			return result
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,157 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/neogan74/k8s-chaos/internal/capabilities"
+	"github.com/neogan74/k8s-chaos/internal/preflight"
+)
+
+var actionsCmd = &cobra.Command{
+	Use:   "actions",
+	Short: "List the supported chaos actions, what they need, and whether the cluster provides it",
+	Long: `List every chaos action with the spec fields it requires, the RBAC permissions the
+controller needs for it, and the capabilities of the containers it injects (NET_ADMIN, privileged).
+
+The RBAC permissions of the controller service account are checked like "k8s-chaos doctor" does.
+With --namespace, the Pod Security level of that namespace is checked too: NET_ADMIN and
+privileged pods need the "privileged" level. Pass the target namespace for pod actions and the
+experiment's namespace for node actions, where their stress pods run.
+
+Examples:
+  # What can run against the shop namespace?
+  k8s-chaos actions -n shop
+
+  # Only list the requirements, without a cluster
+  k8s-chaos actions --no-check
+
+  # As JSON, the same report as GET /api/v1/capabilities
+  k8s-chaos actions -n shop -o json`,
+	RunE: runActions,
+}
+
+var (
+	actionsControllerNamespace string
+	actionsServiceAccount      string
+	actionsOutput              string
+	actionsNoCheck             bool
+)
+
+func init() {
+	actionsCmd.Flags().StringVar(&actionsControllerNamespace, "controller-namespace", "k8s-chaos-system",
+		"namespace of the controller service account")
+	actionsCmd.Flags().StringVar(&actionsServiceAccount, "service-account", "k8s-chaos-controller-manager",
+		"controller service account whose permissions are checked")
+	actionsCmd.Flags().StringVarP(&actionsOutput, "output", "o", "", "output format: json, or a table when empty")
+	actionsCmd.Flags().BoolVar(&actionsNoCheck, "no-check", false, "list the requirements without checking the cluster")
+	rootCmd.AddCommand(actionsCmd)
+}
+
+func runActions(cmd *cobra.Command, args []string) error {
+	if actionsOutput != "" && actionsOutput != "json" {
+		return fmt.Errorf("unsupported output format %q", actionsOutput)
+	}
+	actions, err := capabilities.Describe(capabilities.Known())
+	if err != nil {
+		return err
+	}
+	report := &capabilities.Report{Actions: actions}
+
+	if !actionsNoCheck {
+		clientset, err := getClientset()
+		if err != nil {
+			return fmt.Errorf("failed to get Kubernetes client: %w", err)
+		}
+		ctx := context.Background()
+		if namespace != "" {
+			ns, err := clientset.CoreV1().Namespaces().Get(ctx, namespace, metav1.GetOptions{})
+			if err != nil {
+				return fmt.Errorf("failed to get namespace %s: %w", namespace, err)
+			}
+			report.Namespace, report.PodSecurityLevel = namespace, capabilities.PodSecurityLevel(ns)
+		}
+		reviewer := preflight.ServiceAccountReviewer(clientset.AuthorizationV1(), actionsControllerNamespace, actionsServiceAccount)
+		rbac, err := preflight.Run(ctx, reviewer)
+		if err != nil {
+			return err
+		}
+		report.RBACChecked = true
+		capabilities.Evaluate(report.Actions, rbac, report.Namespace, report.PodSecurityLevel)
+	}
+
+	if actionsOutput == "json" {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(report)
+	}
+	printActions(os.Stdout, report)
+	return nil
+}
+
+// printActions writes one line per action; the READY and MISSING columns only when checked
+func printActions(out io.Writer, report *capabilities.Report) {
+	checked := report.RBACChecked || report.PodSecurityLevel != ""
+	if report.Namespace != "" {
+		_, _ = fmt.Fprintf(out, "Namespace %s enforces Pod Security %q\n\n", report.Namespace, report.PodSecurityLevel)
+	}
+
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	header := "ACTION\tTARGET\tREQUIRES\tCAPABILITIES"
+	if checked {
+		header += "\tREADY\tMISSING"
+	}
+	_, _ = fmt.Fprintln(w, header)
+	for _, a := range report.Actions {
+		requires := strings.Join(a.RequiredFields, ", ")
+		if requires == "" {
+			requires = "-"
+		}
+		caps := append([]string(nil), a.Capabilities...)
+		if a.Privileged {
+			caps = append(caps, "privileged")
+		}
+		capsText := strings.Join(caps, ", ")
+		if capsText == "" {
+			capsText = "-"
+		}
+		line := fmt.Sprintf("%s\t%s\t%s\t%s", a.Name, a.Target, requires, capsText)
+		if checked {
+			ready := "yes"
+			if a.Satisfied != nil && !*a.Satisfied {
+				ready = "no"
+			}
+			missing := strings.Join(a.Missing, "; ")
+			if missing == "" {
+				missing = "-"
+			}
+			line += fmt.Sprintf("\t%s\t%s", ready, missing)
+		}
+		_, _ = fmt.Fprintln(w, line)
+	}
+	_ = w.Flush()
+}
@@ -0,0 +1,60 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/neogan74/k8s-chaos/internal/capabilities"
+	"github.com/neogan74/k8s-chaos/internal/preflight"
+)
+
+func TestPrintActions_Unchecked(t *testing.T) {
+	actions, err := capabilities.Describe([]string{"pod-kill", "node-cpu-stress"})
+	require.NoError(t, err)
+	var out bytes.Buffer
+
+	printActions(&out, &capabilities.Report{Actions: actions})
+
+	expected := `ACTION           TARGET  REQUIRES           CAPABILITIES
+node-cpu-stress  node    duration, cpuLoad  privileged
+pod-kill         pod     -                  -
+`
+	assert.Equal(t, expected, out.String())
+}
+
+func TestPrintActions_Checked(t *testing.T) {
+	actions, err := capabilities.Describe([]string{"pod-kill", "pod-network-loss"})
+	require.NoError(t, err)
+	report := &capabilities.Report{Namespace: "shop", PodSecurityLevel: "baseline", RBACChecked: true, Actions: actions}
+	capabilities.Evaluate(report.Actions, &preflight.Result{}, report.Namespace, report.PodSecurityLevel)
+	var out bytes.Buffer
+
+	printActions(&out, report)
+
+	expected := `Namespace shop enforces Pod Security "baseline"
+
+ACTION            TARGET  REQUIRES                  CAPABILITIES  READY  MISSING
+pod-kill          pod     -                         -             yes    -
+pod-network-loss  pod     duration, lossPercentage  NET_ADMIN     no     NET_ADMIN: namespace shop enforces Pod Security "baseline"
+`
+	assert.Equal(t, expected, out.String())
+}
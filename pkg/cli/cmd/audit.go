@@ -0,0 +1,274 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	chaosv1alpha1 "github.com/neogan74/k8s-chaos/api/v1alpha1"
+	"github.com/neogan74/k8s-chaos/internal/audittrail"
+)
+
+var auditCmd = &cobra.Command{
+	Use:   "audit [EXPERIMENT]",
+	Short: "Correlate API server audit log entries with experiments",
+	Long: `Extract the requests experiments made from a Kubernetes audit log. The controller must run with
+--tag-destructive-requests: it then sends each experiment's deletes, evictions, injections and other
+destructive operations with the user-agent k8s-chaos-injector/<experiment UID>.
+
+Without an experiment, every tagged request is summarized per experiment UID; UIDs are named
+after the history records in --history-namespace when the cluster is reachable. With an experiment
+(or --uid), its requests are listed. An experiment that no longer exists is looked up by its
+history records, covering every run of every experiment that had its name.
+
+The log holds one audit event or event list per line, as written by the log and webhook backends;
+use - to read it from stdin.
+
+Examples:
+  # Which experiments touched the cluster, and how often
+  k8s-chaos audit --log /var/log/kubernetes/audit.log
+
+  # Every request of one experiment
+  k8s-chaos audit web-kill -n shop --log audit.log
+
+  # Without cluster access
+  zcat audit.log.gz | k8s-chaos audit --uid 3f2b9c1e-5d7a-4c1e-9a3b-0e4f6d8c2b1a --log -`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runAudit,
+}
+
+var (
+	auditLog              string
+	auditUID              string
+	auditHistoryNamespace string
+)
+
+func init() {
+	auditCmd.Flags().StringVar(&auditLog, "log", "", "audit log to read, - for stdin")
+	auditCmd.Flags().StringVar(&auditUID, "uid", "", "list the requests of the experiment with this UID")
+	auditCmd.Flags().StringVar(&auditHistoryNamespace, "history-namespace", "chaos-system",
+		"namespace where the controller stores history records")
+	_ = auditCmd.MarkFlagRequired("log")
+	rootCmd.AddCommand(auditCmd)
+}
+
+func runAudit(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+
+	if len(args) > 0 && auditUID != "" {
+		return fmt.Errorf("specify either an experiment or --uid, not both")
+	}
+	if len(args) > 0 && namespace == "" {
+		return fmt.Errorf("namespace is required, use -n flag to specify")
+	}
+
+	events, err := readAuditLog(auditLog)
+	if err != nil {
+		return err
+	}
+
+	if auditUID != "" {
+		return printAuditEvents(os.Stdout, filterAuditEvents(events, map[string]bool{auditUID: true}))
+	}
+
+	k8sClient, err := getKubeClient()
+	if len(args) == 0 {
+		names := map[string]string{}
+		if err == nil {
+			names, err = historyExperimentNames(ctx, k8sClient, auditHistoryNamespace)
+		}
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: experiments not named, history records unavailable: %v\n", err)
+		}
+		return printAuditSummary(os.Stdout, events, names)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get Kubernetes client: %w", err)
+	}
+	uids, err := experimentUIDs(ctx, k8sClient, args[0], namespace, auditHistoryNamespace)
+	if err != nil {
+		return err
+	}
+	return printAuditEvents(os.Stdout, filterAuditEvents(events, uids))
+}
+
+// readAuditLog reads the tagged requests of the audit log at path, or stdin for -
+func readAuditLog(path string) ([]audittrail.Event, error) {
+	if path == "-" {
+		return audittrail.ReadEvents(os.Stdin)
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open audit log: %w", err)
+	}
+	defer func() { _ = f.Close() }()
+	events, err := audittrail.ReadEvents(f)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return events, nil
+}
+
+// experimentUIDs returns the UIDs the experiment name in ns ran under: its own if it exists, and
+// those recorded in its history records
+func experimentUIDs(ctx context.Context, c client.Client, name, ns, historyNamespace string) (map[string]bool, error) {
+	uids := map[string]bool{}
+	exp := &chaosv1alpha1.ChaosExperiment{}
+	err := c.Get(ctx, client.ObjectKey{Namespace: ns, Name: name}, exp)
+	switch {
+	case err == nil:
+		uids[string(exp.UID)] = true
+	case !apierrors.IsNotFound(err):
+		return nil, fmt.Errorf("failed to get experiment %s: %w", name, err)
+	}
+
+	namespaces := []string{historyNamespace}
+	if ns != historyNamespace {
+		namespaces = append(namespaces, ns)
+	}
+	for _, historyNs := range namespaces {
+		records := &chaosv1alpha1.ChaosExperimentHistoryList{}
+		if err := c.List(ctx, records, client.InNamespace(historyNs),
+			client.MatchingLabels{"chaos.gushchin.dev/experiment": name}); err != nil {
+			return nil, fmt.Errorf("failed to list history records in %s: %w", historyNs, err)
+		}
+		for _, record := range records.Items {
+			if record.Spec.ExperimentRef.Namespace == ns {
+				uids[record.Spec.ExperimentRef.UID] = true
+			}
+		}
+	}
+	if len(uids) == 0 {
+		return nil, fmt.Errorf("experiment %s not found in namespace %s, and it has no history records", name, ns)
+	}
+	return uids, nil
+}
+
+// historyExperimentNames maps the experiment UIDs of the history records in historyNamespace to
+// the namespace/name of their experiment
+func historyExperimentNames(ctx context.Context, c client.Client, historyNamespace string) (map[string]string, error) {
+	records := &chaosv1alpha1.ChaosExperimentHistoryList{}
+	if err := c.List(ctx, records, client.InNamespace(historyNamespace)); err != nil {
+		return nil, fmt.Errorf("failed to list history records: %w", err)
+	}
+	names := map[string]string{}
+	for _, record := range records.Items {
+		ref := record.Spec.ExperimentRef
+		names[ref.UID] = ref.Namespace + "/" + ref.Name
+	}
+	return names, nil
+}
+
+// filterAuditEvents keeps the events tagged with one of uids
+func filterAuditEvents(events []audittrail.Event, uids map[string]bool) []audittrail.Event {
+	var kept []audittrail.Event
+	for _, event := range events {
+		if uids[event.Experiment] {
+			kept = append(kept, event)
+		}
+	}
+	return kept
+}
+
+// printAuditEvents lists requests, with the experiment UID when they span several runs
+func printAuditEvents(out io.Writer, events []audittrail.Event) error {
+	if len(events) == 0 {
+		_, err := fmt.Fprintln(out, "No tagged requests found")
+		return err
+	}
+	several := false
+	for _, event := range events {
+		several = several || event.Experiment != events[0].Experiment
+	}
+
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	header := "TIME\tVERB\tRESOURCE\tOBJECT\tCODE\tUSER"
+	if several {
+		header += "\tEXPERIMENT UID"
+	}
+	_, _ = fmt.Fprintln(w, header)
+	for _, event := range events {
+		line := fmt.Sprintf("%s\t%s\t%s\t%s\t%s\t%s",
+			event.RequestReceivedTimestamp.Format(time.RFC3339), event.Verb, event.Resource(),
+			event.Object(), auditCode(event), event.Actor())
+		if several {
+			line += "\t" + event.Experiment
+		}
+		_, _ = fmt.Fprintln(w, line)
+	}
+	return w.Flush()
+}
+
+// printAuditSummary counts the requests of each experiment UID, most recent first
+func printAuditSummary(out io.Writer, events []audittrail.Event, names map[string]string) error {
+	type summary struct {
+		uid           string
+		requests      int
+		failed        int
+		first, latest time.Time
+	}
+	byUID := map[string]*summary{}
+	var summaries []*summary
+	for _, event := range events {
+		s, ok := byUID[event.Experiment]
+		if !ok {
+			s = &summary{uid: event.Experiment, first: event.RequestReceivedTimestamp}
+			byUID[event.Experiment] = s
+			summaries = append(summaries, s)
+		}
+		s.requests++
+		if event.Code() >= 400 {
+			s.failed++
+		}
+		s.latest = event.RequestReceivedTimestamp
+	}
+	if len(summaries) == 0 {
+		_, err := fmt.Fprintln(out, "No tagged requests found")
+		return err
+	}
+	sort.SliceStable(summaries, func(i, j int) bool { return summaries[i].latest.After(summaries[j].latest) })
+
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "EXPERIMENT UID\tEXPERIMENT\tREQUESTS\tFAILED\tFIRST\tLAST")
+	for _, s := range summaries {
+		name := names[s.uid]
+		if name == "" {
+			name = "-"
+		}
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%d\t%d\t%s\t%s\n", s.uid, name, s.requests, s.failed,
+			s.first.Format(time.RFC3339), s.latest.Format(time.RFC3339))
+	}
+	return w.Flush()
+}
+
+// auditCode is the response code of an event, or - when it was logged before the response
+func auditCode(event audittrail.Event) string {
+	if event.Code() == 0 {
+		return "-"
+	}
+	return fmt.Sprint(event.Code())
+}
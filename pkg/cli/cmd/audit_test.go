@@ -0,0 +1,118 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	chaosv1alpha1 "github.com/neogan74/k8s-chaos/api/v1alpha1"
+	"github.com/neogan74/k8s-chaos/internal/audittrail"
+)
+
+func auditEvent(uid, verb string, at time.Time, code int32) audittrail.Event {
+	return audittrail.Event{
+		Verb:                     verb,
+		Experiment:               uid,
+		ResponseStatus:           &audittrail.ResponseStatus{Code: code},
+		RequestReceivedTimestamp: at,
+	}
+}
+
+func TestExperimentUIDs_DeletedExperiment(t *testing.T) {
+	record := func(name, ns, uid string) *chaosv1alpha1.ChaosExperimentHistory {
+		return &chaosv1alpha1.ChaosExperimentHistory{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "chaos-system",
+				Labels:    map[string]string{"chaos.gushchin.dev/experiment": "web-kill"},
+			},
+			Spec: chaosv1alpha1.ChaosExperimentHistorySpec{
+				ExperimentRef: chaosv1alpha1.ObjectReference{Name: "web-kill", Namespace: ns, UID: uid},
+			},
+		}
+	}
+	c := newDiagnoseClient(t, interceptor.Funcs{},
+		record("web-kill-1", "shop", "uid-1"),
+		record("web-kill-2", "shop", "uid-2"),
+		record("web-kill-3", "other-team", "uid-3"))
+
+	uids, err := experimentUIDs(context.Background(), c, "web-kill", "shop", "chaos-system")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(uids) != 2 || !uids["uid-1"] || !uids["uid-2"] {
+		t.Errorf("expected both runs in shop, got %v", uids)
+	}
+
+	if _, err := experimentUIDs(context.Background(), c, "unknown", "shop", "chaos-system"); err == nil {
+		t.Error("expected an error for an experiment without runs")
+	}
+}
+
+func TestPrintAuditSummary(t *testing.T) {
+	start := time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC)
+	events := []audittrail.Event{
+		auditEvent("uid-1", "delete", start, 200),
+		auditEvent("uid-2", "create", start.Add(time.Minute), 403),
+		auditEvent("uid-1", "delete", start.Add(2*time.Minute), 200),
+	}
+
+	var out bytes.Buffer
+	if err := printAuditSummary(&out, events, map[string]string{"uid-1": "shop/web-kill"}); err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("expected a header and two experiments, got:\n%s", out.String())
+	}
+	if fields := strings.Fields(lines[1]); fields[0] != "uid-1" || fields[1] != "shop/web-kill" || fields[2] != "2" || fields[3] != "0" {
+		t.Errorf("unexpected summary of the latest experiment: %q", lines[1])
+	}
+	if fields := strings.Fields(lines[2]); fields[0] != "uid-2" || fields[1] != "-" || fields[3] != "1" {
+		t.Errorf("unexpected summary of the unnamed experiment: %q", lines[2])
+	}
+}
+
+func TestPrintAuditEvents(t *testing.T) {
+	start := time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	var out bytes.Buffer
+	if err := printAuditEvents(&out, []audittrail.Event{auditEvent("uid-1", "delete", start, 200)}); err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(out.String(), "EXPERIMENT UID") || !strings.Contains(out.String(), "2026-01-01T10:00:00Z  delete") {
+		t.Errorf("unexpected listing of a single run:\n%s", out.String())
+	}
+
+	out.Reset()
+	if err := printAuditEvents(&out, []audittrail.Event{
+		auditEvent("uid-1", "delete", start, 200),
+		auditEvent("uid-2", "delete", start, 200),
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(out.String(), "EXPERIMENT UID") {
+		t.Errorf("several runs should be told apart:\n%s", out.String())
+	}
+}
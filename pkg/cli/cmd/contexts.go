@@ -0,0 +1,134 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"sync"
+
+	"github.com/spf13/cobra"
+	"k8s.io/client-go/tools/clientcmd"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// allContexts makes commands that support it query every context of the kubeconfig
+var allContexts bool
+
+// cluster is a kubeconfig context and a client for it
+type cluster struct {
+	Name   string
+	Client client.Client
+}
+
+// clusterResult is the outcome of running a query against one cluster
+type clusterResult[T any] struct {
+	Cluster string
+	Value   T
+	Err     error
+}
+
+// addAllContextsFlag registers --all-contexts on a command that can merge results across clusters
+func addAllContextsFlag(cmd *cobra.Command) {
+	cmd.Flags().BoolVar(&allContexts, "all-contexts", false,
+		"query every context in the kubeconfig concurrently and merge the results")
+}
+
+// kubeContexts returns the names of all contexts in the kubeconfig, sorted
+func kubeContexts() ([]string, error) {
+	rawConfig, err := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(
+		&clientcmd.ClientConfigLoadingRules{ExplicitPath: getKubeconfigPath()},
+		&clientcmd.ConfigOverrides{},
+	).RawConfig()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load kubeconfig: %w", err)
+	}
+	names := make([]string, 0, len(rawConfig.Contexts))
+	for name := range rawConfig.Contexts {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+// getClusters returns a client for every kubeconfig context with --all-contexts, otherwise a
+// client for the selected context only
+func getClusters() ([]cluster, error) {
+	if !allContexts {
+		k8sClient, err := getKubeClient()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get Kubernetes client: %w", err)
+		}
+		return []cluster{{Name: kubeContext, Client: k8sClient}}, nil
+	}
+
+	names, err := kubeContexts()
+	if err != nil {
+		return nil, err
+	}
+	if len(names) == 0 {
+		return nil, fmt.Errorf("no contexts found in kubeconfig %s", getKubeconfigPath())
+	}
+	clusters := make([]cluster, 0, len(names))
+	for _, name := range names {
+		k8sClient, err := newKubeClient(name)
+		if err != nil {
+			return nil, fmt.Errorf("context %s: %w", name, err)
+		}
+		clusters = append(clusters, cluster{Name: name, Client: k8sClient})
+	}
+	return clusters, nil
+}
+
+// queryClusters runs query against all clusters concurrently; results keep the order of clusters
+func queryClusters[T any](ctx context.Context, clusters []cluster,
+	query func(context.Context, client.Client) (T, error)) []clusterResult[T] {
+	results := make([]clusterResult[T], len(clusters))
+	var wg sync.WaitGroup
+	for i, c := range clusters {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			value, err := query(ctx, c.Client)
+			results[i] = clusterResult[T]{Cluster: c.Name, Value: value, Err: err}
+		}()
+	}
+	wg.Wait()
+	return results
+}
+
+// successfulResults drops the clusters that failed, reporting them on stderr. It returns an error
+// when no cluster answered; for a single cluster that is the cluster's own error.
+func successfulResults[T any](results []clusterResult[T]) ([]clusterResult[T], error) {
+	if len(results) == 1 && results[0].Err != nil {
+		return nil, results[0].Err
+	}
+	var ok []clusterResult[T]
+	for _, r := range results {
+		if r.Err != nil {
+			fmt.Fprintf(os.Stderr, "warning: context %s: %v\n", r.Cluster, r.Err)
+			continue
+		}
+		ok = append(ok, r)
+	}
+	if len(ok) == 0 && len(results) > 0 {
+		return nil, fmt.Errorf("all %d contexts failed", len(results))
+	}
+	return ok, nil
+}
@@ -0,0 +1,153 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	chaosv1alpha1 "github.com/neogan74/k8s-chaos/api/v1alpha1"
+)
+
+const testKubeconfig = `apiVersion: v1
+kind: Config
+clusters:
+- name: prod
+  cluster:
+    server: https://prod.example.com
+- name: staging
+  cluster:
+    server: https://staging.example.com
+contexts:
+- name: staging
+  context:
+    cluster: staging
+    user: dev
+- name: prod
+  context:
+    cluster: prod
+    user: dev
+current-context: staging
+users:
+- name: dev
+  user:
+    token: abc
+`
+
+func TestKubeContexts(t *testing.T) {
+	orig := kubeconfig
+	t.Cleanup(func() { kubeconfig = orig })
+	kubeconfig = filepath.Join(t.TempDir(), "config")
+	if err := os.WriteFile(kubeconfig, []byte(testKubeconfig), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	names, err := kubeContexts()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if strings.Join(names, ",") != "prod,staging" {
+		t.Fatalf("expected sorted contexts prod,staging, got %v", names)
+	}
+
+	if _, err := newKubeClient("prod"); err != nil {
+		t.Fatalf("unexpected error creating client for context prod: %v", err)
+	}
+	if _, err := newKubeClient("missing"); err == nil {
+		t.Fatal("expected an error for an unknown context")
+	}
+}
+
+func fakeCluster(t *testing.T, name string, experiments ...string) cluster {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	if err := chaosv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	builder := fake.NewClientBuilder().WithScheme(scheme)
+	for _, exp := range experiments {
+		builder = builder.WithObjects(&chaosv1alpha1.ChaosExperiment{
+			ObjectMeta: metav1.ObjectMeta{Name: exp, Namespace: "chaos-testing"},
+			Spec:       chaosv1alpha1.ChaosExperimentSpec{Action: "pod-kill", Namespace: "chaos-testing"},
+			Status:     chaosv1alpha1.ChaosExperimentStatus{Phase: "Running"},
+		})
+	}
+	return cluster{Name: name, Client: builder.Build()}
+}
+
+func TestQueryClusters_MergesWithClusterColumn(t *testing.T) {
+	clusters := []cluster{
+		fakeCluster(t, "prod", "checkout-kill"),
+		fakeCluster(t, "staging", "web-kill", "db-kill"),
+	}
+
+	results, err := successfulResults(queryClusters(context.Background(), clusters,
+		func(ctx context.Context, c client.Client) ([]chaosv1alpha1.ChaosExperiment, error) {
+			list := &chaosv1alpha1.ChaosExperimentList{}
+			err := c.List(ctx, list)
+			return list.Items, err
+		}))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(results) != 2 || results[0].Cluster != "prod" || len(results[1].Value) != 2 {
+		t.Fatalf("unexpected results %+v", results)
+	}
+
+	var out bytes.Buffer
+	printExperimentList(&out, results, false, true)
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	if len(lines) != 4 || !strings.HasPrefix(lines[0], "CLUSTER") {
+		t.Fatalf("expected header and 3 rows with a CLUSTER column, got:\n%s", out.String())
+	}
+	if !strings.HasPrefix(lines[1], "prod ") || !strings.HasPrefix(lines[3], "staging ") {
+		t.Errorf("rows not prefixed with their cluster:\n%s", out.String())
+	}
+}
+
+func TestSuccessfulResults(t *testing.T) {
+	boom := errors.New("connection refused")
+
+	if _, err := successfulResults([]clusterResult[int]{{Cluster: "prod", Err: boom}}); !errors.Is(err, boom) {
+		t.Fatalf("expected the single cluster's error, got %v", err)
+	}
+
+	ok, err := successfulResults([]clusterResult[int]{
+		{Cluster: "prod", Err: boom},
+		{Cluster: "staging", Value: 3},
+	})
+	if err != nil || len(ok) != 1 || ok[0].Cluster != "staging" {
+		t.Fatalf("expected only staging to remain, got %+v, %v", ok, err)
+	}
+
+	if _, err := successfulResults([]clusterResult[int]{
+		{Cluster: "prod", Err: boom},
+		{Cluster: "staging", Err: boom},
+	}); err == nil {
+		t.Fatal("expected an error when every cluster failed")
+	}
+}
@@ -19,17 +19,33 @@ package cmd
 import (
 	"context"
 	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	chaosv1alpha1 "github.com/neogan74/k8s-chaos/api/v1alpha1"
 )
 
 var (
-	force bool
+	force                  bool
+	deleteWait             bool
+	deleteTimeout          time.Duration
+	keepHistory            bool
+	purgeHistory           bool
+	deleteHistoryNamespace string
 )
 
+// deletePollInterval is how often --wait checks whether the experiment is gone
+var deletePollInterval = 2 * time.Second
+
 var deleteCmd = &cobra.Command{
 	Use:   "delete EXPERIMENT_NAME",
 	Short: "Delete a chaos experiment",
@@ -40,13 +56,34 @@ Examples:
   k8s-chaos delete nginx-chaos-demo -n chaos-testing
 
   # Delete without confirmation
-  k8s-chaos delete nginx-chaos-demo -n chaos-testing --force`,
+  k8s-chaos delete nginx-chaos-demo -n chaos-testing --force
+
+  # Wait until the controller has reverted everything the experiment injected
+  k8s-chaos delete drain-workers -n chaos-testing --force --wait --timeout 10m
+
+  # Delete the experiment together with its history records
+  k8s-chaos delete nginx-chaos-demo -n chaos-testing --force --purge-history
+
+History records stored in the experiment's own namespace are owned by it and
+removed with it; records in the history namespace are kept until retention
+removes them. --keep-history detaches owned records so they survive, and
+--purge-history deletes the records in both namespaces.`,
 	Args: cobra.ExactArgs(1),
 	RunE: runDelete,
 }
 
 func init() {
 	deleteCmd.Flags().BoolVarP(&force, "force", "f", false, "skip confirmation prompt")
+	deleteCmd.Flags().BoolVar(&deleteWait, "wait", false,
+		"wait until the experiment is gone, i.e. its reverts and cleanup tasks have finished")
+	deleteCmd.Flags().DurationVar(&deleteTimeout, "timeout", 5*time.Minute, "how long --wait waits")
+	deleteCmd.Flags().BoolVar(&keepHistory, "keep-history", false,
+		"keep history records that would be garbage-collected with the experiment")
+	deleteCmd.Flags().BoolVar(&purgeHistory, "purge-history", false,
+		"delete the experiment's history records as well")
+	deleteCmd.Flags().StringVar(&deleteHistoryNamespace, "history-namespace", "chaos-system",
+		"namespace where the controller stores history records")
+	deleteCmd.MarkFlagsMutuallyExclusive("keep-history", "purge-history")
 	rootCmd.AddCommand(deleteCmd)
 }
 
@@ -87,11 +124,167 @@ func runDelete(cmd *cobra.Command, args []string) error {
 		}
 	}
 
+	out := cmd.OutOrStdout()
+	if keepHistory {
+		released, err := releaseHistory(ctx, k8sClient, exp, deleteHistoryNamespace)
+		if err != nil {
+			return err
+		}
+		if released > 0 {
+			fmt.Fprintf(out, "Kept %d history record(s) that were owned by the experiment\n", released)
+		}
+	}
+
 	// Delete the experiment
 	if err := k8sClient.Delete(ctx, exp); err != nil {
 		return fmt.Errorf("failed to delete experiment: %w", err)
 	}
 
-	fmt.Printf("Experiment '%s' deleted successfully\n", experimentName)
+	if purgeHistory {
+		purged, err := purgeExperimentHistory(ctx, k8sClient, exp, deleteHistoryNamespace)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(out, "Deleted %d history record(s)\n", purged)
+	}
+
+	if !deleteWait {
+		fmt.Fprintf(out, "Experiment '%s' deleted successfully\n", experimentName)
+		return nil
+	}
+
+	fmt.Fprintf(out, "Waiting for experiment '%s' to finish its cleanup...\n", experimentName)
+	waitCtx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+	if err := waitForDeletion(waitCtx, k8sClient, client.ObjectKeyFromObject(exp), out); err != nil {
+		return err
+	}
+	fmt.Fprintf(out, "Experiment '%s' deleted successfully\n", experimentName)
+	return reportFailedCleanupTasks(ctx, k8sClient, exp, out)
+}
+
+// experimentHistory returns the history records of exp in the history namespace and, when it
+// differs, the experiment's own namespace
+func experimentHistory(ctx context.Context, c client.Client, exp *chaosv1alpha1.ChaosExperiment, historyNamespace string) ([]chaosv1alpha1.ChaosExperimentHistory, error) {
+	namespaces := []string{historyNamespace}
+	if exp.Namespace != historyNamespace {
+		namespaces = append(namespaces, exp.Namespace)
+	}
+
+	var records []chaosv1alpha1.ChaosExperimentHistory
+	for _, ns := range namespaces {
+		list := &chaosv1alpha1.ChaosExperimentHistoryList{}
+		if err := c.List(ctx, list, client.InNamespace(ns),
+			client.MatchingLabels{chaosv1alpha1.ExperimentUIDLabel: string(exp.UID)}); err != nil {
+			return nil, fmt.Errorf("failed to list history records in %s: %w", ns, err)
+		}
+		records = append(records, list.Items...)
+	}
+	return records, nil
+}
+
+// releaseHistory removes the experiment from the owner references of its history records so
+// the garbage collector keeps them, and returns how many records it released
+func releaseHistory(ctx context.Context, c client.Client, exp *chaosv1alpha1.ChaosExperiment, historyNamespace string) (int, error) {
+	records, err := experimentHistory(ctx, c, exp, historyNamespace)
+	if err != nil {
+		return 0, err
+	}
+
+	released := 0
+	for i := range records {
+		record := &records[i]
+		refs := slices.DeleteFunc(slices.Clone(record.OwnerReferences), func(ref metav1.OwnerReference) bool {
+			return ref.UID == exp.UID
+		})
+		if len(refs) == len(record.OwnerReferences) {
+			continue
+		}
+		record.OwnerReferences = refs
+		if err := c.Update(ctx, record); err != nil {
+			return released, fmt.Errorf("failed to keep history record %s: %w", record.Name, err)
+		}
+		released++
+	}
+	return released, nil
+}
+
+// purgeExperimentHistory deletes the history records of exp and returns how many it deleted
+func purgeExperimentHistory(ctx context.Context, c client.Client, exp *chaosv1alpha1.ChaosExperiment, historyNamespace string) (int, error) {
+	records, err := experimentHistory(ctx, c, exp, historyNamespace)
+	if err != nil {
+		return 0, err
+	}
+
+	purged := 0
+	for i := range records {
+		if err := c.Delete(ctx, &records[i]); client.IgnoreNotFound(err) != nil {
+			return purged, fmt.Errorf("failed to delete history record %s: %w", records[i].Name, err)
+		}
+		purged++
+	}
+	return purged, nil
+}
+
+// waitForDeletion polls until the experiment is gone and prints what is still holding it
+// whenever that changes. The CleanupPending condition names the cleanup tasks being waited on;
+// otherwise the remaining finalizers are listed.
+func waitForDeletion(ctx context.Context, c client.Client, key types.NamespacedName, out io.Writer) error {
+	var last string
+	for {
+		exp := &chaosv1alpha1.ChaosExperiment{}
+		if err := c.Get(ctx, key, exp); apierrors.IsNotFound(err) {
+			return nil
+		} else if err != nil && ctx.Err() == nil {
+			return fmt.Errorf("failed to get experiment: %w", err)
+		}
+
+		if progress := deletionProgress(exp); progress != "" && progress != last {
+			fmt.Fprintf(out, "  %s\n", progress)
+			last = progress
+		}
+
+		select {
+		case <-ctx.Done():
+			if last == "" {
+				return fmt.Errorf("timed out waiting for experiment %s to be deleted", key.Name)
+			}
+			return fmt.Errorf("timed out waiting for experiment %s to be deleted: %s", key.Name, last)
+		case <-time.After(deletePollInterval):
+		}
+	}
+}
+
+// deletionProgress describes what keeps a deleted experiment around
+func deletionProgress(exp *chaosv1alpha1.ChaosExperiment) string {
+	if cond := meta.FindStatusCondition(exp.Status.Conditions, chaosv1alpha1.ConditionCleanupPending); cond != nil &&
+		cond.Status == metav1.ConditionTrue {
+		return cond.Message
+	}
+	if len(exp.Finalizers) > 0 {
+		return fmt.Sprintf("Waiting for finalizers: %s", strings.Join(exp.Finalizers, ", "))
+	}
+	return ""
+}
+
+// reportFailedCleanupTasks lists the reverts of exp that ran out of attempts; they outlive the
+// experiment and have to be finished by hand
+func reportFailedCleanupTasks(ctx context.Context, c client.Client, exp *chaosv1alpha1.ChaosExperiment, out io.Writer) error {
+	tasks := &chaosv1alpha1.ChaosCleanupTaskList{}
+	if err := c.List(ctx, tasks, client.InNamespace(exp.Namespace),
+		client.MatchingLabels{chaosv1alpha1.ExperimentUIDLabel: string(exp.UID)}); err != nil {
+		return fmt.Errorf("failed to list cleanup tasks: %w", err)
+	}
+
+	var failed []string
+	for _, task := range tasks.Items {
+		if task.Status.Phase == chaosv1alpha1.CleanupTaskFailed {
+			failed = append(failed, task.Name)
+		}
+	}
+	if len(failed) > 0 {
+		fmt.Fprintf(out, "Warning: %d revert(s) failed and need to be finished by hand, see ChaosCleanupTask %s\n",
+			len(failed), strings.Join(failed, ", "))
+	}
 	return nil
 }
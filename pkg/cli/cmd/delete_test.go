@@ -0,0 +1,154 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	chaosv1alpha1 "github.com/neogan74/k8s-chaos/api/v1alpha1"
+)
+
+func newDeleteExperiment(finalizers ...string) *chaosv1alpha1.ChaosExperiment {
+	return &chaosv1alpha1.ChaosExperiment{
+		ObjectMeta: metav1.ObjectMeta{Name: "drain-workers", Namespace: "chaos-testing", UID: "exp-uid", Finalizers: finalizers},
+		Spec:       chaosv1alpha1.ChaosExperimentSpec{Action: "node-drain", Namespace: "apps"},
+	}
+}
+
+func newDeleteHistory(name, namespace string, owned bool) *chaosv1alpha1.ChaosExperimentHistory {
+	record := &chaosv1alpha1.ChaosExperimentHistory{ObjectMeta: metav1.ObjectMeta{
+		Name:      name,
+		Namespace: namespace,
+		Labels:    map[string]string{chaosv1alpha1.ExperimentUIDLabel: "exp-uid"},
+	}}
+	if owned {
+		record.OwnerReferences = []metav1.OwnerReference{{
+			APIVersion: chaosv1alpha1.GroupVersion.String(), Kind: "ChaosExperiment", Name: "drain-workers", UID: "exp-uid",
+		}}
+	}
+	return record
+}
+
+func TestReleaseHistory(t *testing.T) {
+	ctx := context.Background()
+	exp := newDeleteExperiment()
+	c := newDiagnoseClient(t, interceptor.Funcs{}, exp,
+		newDeleteHistory("owned", "chaos-testing", true),
+		newDeleteHistory("shared", "chaos-system", false))
+
+	released, err := releaseHistory(ctx, c, exp, "chaos-system")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if released != 1 {
+		t.Errorf("released = %d, want 1", released)
+	}
+	record := &chaosv1alpha1.ChaosExperimentHistory{}
+	if err := c.Get(ctx, types.NamespacedName{Name: "owned", Namespace: "chaos-testing"}, record); err != nil {
+		t.Fatal(err)
+	}
+	if len(record.OwnerReferences) != 0 {
+		t.Errorf("owner references not removed: %v", record.OwnerReferences)
+	}
+}
+
+func TestPurgeExperimentHistory(t *testing.T) {
+	ctx := context.Background()
+	exp := newDeleteExperiment()
+	other := newDeleteHistory("other-run", "chaos-system", false)
+	other.Labels[chaosv1alpha1.ExperimentUIDLabel] = "other-uid"
+	c := newDiagnoseClient(t, interceptor.Funcs{}, exp, other,
+		newDeleteHistory("owned", "chaos-testing", true),
+		newDeleteHistory("shared", "chaos-system", false))
+
+	purged, err := purgeExperimentHistory(ctx, c, exp, "chaos-system")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if purged != 2 {
+		t.Errorf("purged = %d, want 2", purged)
+	}
+	remaining := &chaosv1alpha1.ChaosExperimentHistoryList{}
+	if err := c.List(ctx, remaining); err != nil {
+		t.Fatal(err)
+	}
+	if len(remaining.Items) != 1 || remaining.Items[0].Name != "other-run" {
+		t.Errorf("only the other experiment's record should remain, got %v", remaining.Items)
+	}
+}
+
+func TestWaitForDeletion(t *testing.T) {
+	defer func(interval time.Duration) { deletePollInterval = interval }(deletePollInterval)
+	deletePollInterval = 10 * time.Millisecond
+
+	exp := newDeleteExperiment("chaos.gushchin.dev/cleanup-tasks")
+	exp.Status.Conditions = []metav1.Condition{{
+		Type:    chaosv1alpha1.ConditionCleanupPending,
+		Status:  metav1.ConditionTrue,
+		Reason:  "WaitingForCleanupTasks",
+		Message: "Waiting for 1 cleanup task(s): drain-workers-uncordon-3f2a9c1e",
+	}}
+	c := newDiagnoseClient(t, interceptor.Funcs{}, exp)
+	if err := c.Delete(context.Background(), exp); err != nil {
+		t.Fatal(err)
+	}
+
+	// The experiment stays while its cleanup task is pending
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	var out bytes.Buffer
+	err := waitForDeletion(ctx, c, client.ObjectKeyFromObject(exp), &out)
+	if err == nil || !strings.Contains(err.Error(), "drain-workers-uncordon-3f2a9c1e") {
+		t.Errorf("expected a timeout naming the pending task, got %v", err)
+	}
+	if strings.Count(out.String(), "Waiting for 1 cleanup task(s)") != 1 {
+		t.Errorf("progress should be printed once, got %q", out.String())
+	}
+
+	// Releasing the finalizer lets the wait finish
+	stored := &chaosv1alpha1.ChaosExperiment{}
+	if err := c.Get(context.Background(), client.ObjectKeyFromObject(exp), stored); err != nil {
+		t.Fatal(err)
+	}
+	stored.Finalizers = nil
+	if err := c.Update(context.Background(), stored); err != nil {
+		t.Fatal(err)
+	}
+	if err := waitForDeletion(context.Background(), c, client.ObjectKeyFromObject(exp), &out); err != nil {
+		t.Errorf("waitForDeletion() = %v after the finalizer was released", err)
+	}
+}
+
+func TestDeletionProgress(t *testing.T) {
+	exp := newDeleteExperiment("chaos.gushchin.dev/disk-fill-cleanup")
+	if got := deletionProgress(exp); got != "Waiting for finalizers: chaos.gushchin.dev/disk-fill-cleanup" {
+		t.Errorf("deletionProgress() = %q", got)
+	}
+	exp.Finalizers = nil
+	if got := deletionProgress(exp); got != "" {
+		t.Errorf("deletionProgress() = %q, want empty", got)
+	}
+}
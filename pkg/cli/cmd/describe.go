@@ -19,6 +19,10 @@ package cmd
 import (
 	"context"
 	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"text/tabwriter"
 
 	"github.com/spf13/cobra"
 	"k8s.io/apimachinery/pkg/types"
@@ -37,12 +41,24 @@ Examples:
   k8s-chaos describe nginx-chaos-demo
 
   # Describe an experiment in a specific namespace
-  k8s-chaos describe nginx-chaos-demo -n chaos-testing`,
+  k8s-chaos describe nginx-chaos-demo -n chaos-testing
+
+  # Show the last 20 executions instead of 5
+  k8s-chaos describe nginx-chaos-demo -n chaos-testing --history 20`,
 	Args: cobra.ExactArgs(1),
 	RunE: runDescribe,
 }
 
+var (
+	describeHistoryLimit     int
+	describeHistoryNamespace string
+)
+
 func init() {
+	describeCmd.Flags().IntVar(&describeHistoryLimit, "history", 5,
+		"number of recent executions to list from the history records (0 to skip)")
+	describeCmd.Flags().StringVar(&describeHistoryNamespace, "history-namespace", "chaos-system",
+		"namespace where the controller stores history records")
 	rootCmd.AddCommand(describeCmd)
 }
 
@@ -68,9 +84,102 @@ func runDescribe(cmd *cobra.Command, args []string) error {
 	}
 
 	printExperimentDetails(exp)
+	printActiveTargets(cmd.OutOrStdout(), exp)
+
+	if describeHistoryLimit > 0 {
+		// The experiment is still worth showing when its history cannot be read
+		records, err := experimentHistory(ctx, k8sClient, exp, describeHistoryNamespace)
+		if err != nil {
+			fmt.Fprintf(cmd.OutOrStdout(), "\nRecent Executions: unavailable (%v)\n", err)
+			return nil
+		}
+		printRecentExecutions(cmd.OutOrStdout(), records, describeHistoryLimit)
+	}
 	return nil
 }
 
+// printActiveTargets lists what the experiment currently holds according to its status: cordoned
+// and tainted nodes, pods with injected chaos and reverts still waiting to run
+func printActiveTargets(out io.Writer, exp *chaosv1alpha1.ChaosExperiment) {
+	st := exp.Status
+	if len(st.CordonedNodes) == 0 && len(st.TaintedNodes) == 0 && len(st.AffectedPods) == 0 && len(st.PendingCleanup) == 0 {
+		return
+	}
+
+	fmt.Fprintln(out)
+	fmt.Fprintln(out, "Active Targets:")
+	if len(st.CordonedNodes) > 0 {
+		fmt.Fprintf(out, "  Cordoned Nodes:      %s\n", strings.Join(st.CordonedNodes, ", "))
+	}
+	if len(st.TaintedNodes) > 0 {
+		fmt.Fprintf(out, "  Tainted Nodes:       %s\n", strings.Join(st.TaintedNodes, ", "))
+	}
+	if len(st.AffectedPods) > 0 {
+		fmt.Fprintf(out, "  Affected Pods:       %s\n", strings.Join(st.AffectedPods, ", "))
+	}
+	for _, cleanup := range st.PendingCleanup {
+		target := cleanup.Node
+		if cleanup.Pod != "" {
+			target = cleanup.Pod
+		}
+		fmt.Fprintf(out, "  Pending Cleanup:     %s %s\n", cleanup.Operation, target)
+	}
+}
+
+// printRecentExecutions lists the newest history records, at most limit of them
+func printRecentExecutions(out io.Writer, records []chaosv1alpha1.ChaosExperimentHistory, limit int) {
+	fmt.Fprintln(out)
+	if len(records) == 0 {
+		fmt.Fprintln(out, "Recent Executions: none recorded")
+		return
+	}
+
+	records = slices.Clone(records)
+	slices.SortFunc(records, func(a, b chaosv1alpha1.ChaosExperimentHistory) int {
+		return b.Spec.Execution.StartTime.Compare(a.Spec.Execution.StartTime.Time)
+	})
+	shown := min(limit, len(records))
+	fmt.Fprintf(out, "Recent Executions (%d of %d):\n", shown, len(records))
+
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "  STARTED\tSTATUS\tDURATION\tAFFECTED\tERROR\tRECORD")
+	for _, record := range records[:shown] {
+		execution := record.Spec.Execution
+		fmt.Fprintf(w, "  %s\t%s\t%s\t%d\t%s\t%s\n",
+			execution.StartTime.Format("2006-01-02 15:04:05"),
+			valueOrDash(execution.Status),
+			valueOrDash(execution.Duration),
+			affectedCount(&record),
+			failureReason(record.Spec.Error),
+			record.Name)
+	}
+	_ = w.Flush()
+}
+
+// affectedCount is the number of resources a run affected; compacted records only keep counts
+func affectedCount(record *chaosv1alpha1.ChaosExperimentHistory) int {
+	if c := record.Spec.Compaction; c != nil && len(record.Spec.AffectedResources) == 0 {
+		total := 0
+		for _, n := range c.AffectedResources {
+			total += n
+		}
+		return total
+	}
+	return len(record.Spec.AffectedResources)
+}
+
+// failureReason is the category of a failed run, falling back to its error code
+func failureReason(details *chaosv1alpha1.ErrorDetails) string {
+	switch {
+	case details == nil:
+		return "-"
+	case details.FailureReason != "":
+		return details.FailureReason
+	default:
+		return valueOrDash(details.Code)
+	}
+}
+
 func printExperimentDetails(exp *chaosv1alpha1.ChaosExperiment) {
 	fmt.Printf("Name:         %s\n", exp.Name)
 	fmt.Printf("Namespace:    %s\n", exp.Namespace)
@@ -83,6 +192,10 @@ func printExperimentDetails(exp *chaosv1alpha1.ChaosExperiment) {
 	fmt.Printf("  Target Namespace:    %s\n", exp.Spec.Namespace)
 	fmt.Printf("  Selector:            %s\n", formatSelectorMultiline(exp.Spec.Selector))
 	fmt.Printf("  Count:               %d\n", exp.Spec.Count)
+	fmt.Printf("  Severity:            %s\n", chaosv1alpha1.SeverityOf(&exp.Spec))
+	if approver := exp.Annotations[chaosv1alpha1.ApprovedByAnnotation]; approver != "" {
+		fmt.Printf("  Approved By:         %s\n", approver)
+	}
 
 	if len(exp.Spec.DependsOn) > 0 {
 		fmt.Printf("  Depends On:          %v\n", exp.Spec.DependsOn)
@@ -98,8 +211,8 @@ func printExperimentDetails(exp *chaosv1alpha1.ChaosExperiment) {
 		fmt.Printf("  Experiment Duration: ∞ (runs indefinitely)\n")
 	}
 
-	if exp.Spec.Schedule != "" {
-		fmt.Printf("  Schedule:            %s\n", exp.Spec.Schedule)
+	if definition := chaosv1alpha1.ScheduleDefinition(&exp.Spec); !definition.IsZero() {
+		fmt.Printf("  Schedule:            %s\n", definition)
 	}
 
 	if len(exp.Spec.TimeWindows) > 0 {
@@ -156,6 +269,46 @@ func printExperimentDetails(exp *chaosv1alpha1.ChaosExperiment) {
 			fmt.Printf("  Next Retry Time:     %s\n", exp.Status.NextRetryTime.Format("2006-01-02 15:04:05"))
 		}
 	}
+
+	if br := exp.Status.BlastRadius; br != nil {
+		fmt.Println()
+		fmt.Println("Blast Radius:")
+		fmt.Printf("  Affected Pods:       %d\n", br.AffectedPods)
+		fmt.Printf("  Nodes Touched:       %d\n", br.NodesTouched)
+		if br.CPUSharePercent != nil {
+			fmt.Printf("  Namespace CPU:       %d%%\n", *br.CPUSharePercent)
+		}
+		if br.MemorySharePercent != nil {
+			fmt.Printf("  Namespace Memory:    %d%%\n", *br.MemorySharePercent)
+		}
+		for _, w := range br.Workloads {
+			fmt.Printf("  %-20s %d/%d pods (%d%%)\n", w.Kind+"/"+w.Name+":", w.Affected, w.Total, w.Percentage)
+		}
+	}
+
+	if summary := exp.Status.AffectedSummary; summary != nil {
+		fmt.Println()
+		fmt.Println("Injected Pods:")
+		fmt.Printf("  Pods:                %d\n", summary.Pods)
+		fmt.Printf("  Injections:          %d\n", summary.Injections)
+		for _, pod := range summary.Items {
+			fmt.Printf("  %-20s %d\n", pod.Namespace+"/"+pod.Name+":", pod.Injections)
+		}
+		if summary.Truncated {
+			fmt.Println("  (truncated; see the history records for every target)")
+		}
+	}
+
+	if len(exp.Status.Metrics) > 0 {
+		fmt.Println()
+		fmt.Println("Metrics (before / during / after):")
+		for _, m := range exp.Status.Metrics {
+			fmt.Printf("  %-20s %s / %s / %s\n", m.Name+":", valueOrDash(m.Before), valueOrDash(m.During), valueOrDash(m.After))
+			if m.Error != "" {
+				fmt.Printf("  %-20s error: %s\n", "", m.Error)
+			}
+		}
+	}
 }
 
 func formatSelectorMultiline(selector map[string]string) string {
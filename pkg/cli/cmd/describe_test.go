@@ -16,7 +16,16 @@ limitations under the License.
 
 package cmd
 
-import "testing"
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	chaosv1alpha1 "github.com/neogan74/k8s-chaos/api/v1alpha1"
+)
 
 const selectorNone = "<none>"
 
@@ -65,3 +74,75 @@ func TestFormatSelectorMultiline_MultiplePairs(t *testing.T) {
 		t.Fatalf("expected both pairs in output, got %s", got)
 	}
 }
+
+func describeHistoryRecord(name string, start time.Time, status string, affected int) chaosv1alpha1.ChaosExperimentHistory {
+	record := chaosv1alpha1.ChaosExperimentHistory{ObjectMeta: metav1.ObjectMeta{Name: name}}
+	record.Spec.Execution = chaosv1alpha1.ExecutionDetails{StartTime: metav1.NewTime(start), Status: status, Duration: "30s"}
+	for range affected {
+		record.Spec.AffectedResources = append(record.Spec.AffectedResources, chaosv1alpha1.ResourceReference{Kind: "Pod"})
+	}
+	return record
+}
+
+func TestPrintRecentExecutions(t *testing.T) {
+	base := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	failed := describeHistoryRecord("run-2", base.Add(time.Hour), "failure", 0)
+	failed.Spec.Error = &chaosv1alpha1.ErrorDetails{Message: "pods forbidden", FailureReason: "PermissionDenied"}
+	compacted := describeHistoryRecord("run-1", base, "success", 0)
+	compacted.Spec.Compaction = &chaosv1alpha1.HistoryCompaction{AffectedResources: map[string]int{"Pod/deleted": 3}}
+	records := []chaosv1alpha1.ChaosExperimentHistory{
+		compacted,
+		describeHistoryRecord("run-3", base.Add(2*time.Hour), "success", 2),
+		failed,
+	}
+
+	var out bytes.Buffer
+	printRecentExecutions(&out, records, 2)
+	got := out.String()
+
+	if !strings.Contains(got, "Recent Executions (2 of 3):") {
+		t.Errorf("missing header in %q", got)
+	}
+	lines := strings.Split(strings.TrimSpace(got), "\n")
+	if len(lines) != 4 {
+		t.Fatalf("expected header, column row and 2 runs, got %q", got)
+	}
+	if !strings.Contains(lines[2], "run-3") || !strings.Contains(lines[3], "run-2") {
+		t.Errorf("runs should be listed newest first, got %q", got)
+	}
+	if !strings.Contains(lines[3], "PermissionDenied") {
+		t.Errorf("failure reason missing from %q", lines[3])
+	}
+	if strings.Contains(got, "run-1") {
+		t.Errorf("the oldest run should be cut off by the limit, got %q", got)
+	}
+	if n := affectedCount(&compacted); n != 3 {
+		t.Errorf("affectedCount() of compacted record = %d, want 3", n)
+	}
+
+	out.Reset()
+	printRecentExecutions(&out, nil, 5)
+	if !strings.Contains(out.String(), "none recorded") {
+		t.Errorf("expected empty history note, got %q", out.String())
+	}
+}
+
+func TestPrintActiveTargets(t *testing.T) {
+	exp := &chaosv1alpha1.ChaosExperiment{}
+	var out bytes.Buffer
+	printActiveTargets(&out, exp)
+	if out.Len() != 0 {
+		t.Errorf("nothing should be printed without active targets, got %q", out.String())
+	}
+
+	exp.Status.CordonedNodes = []string{"worker-1", "worker-2"}
+	exp.Status.PendingCleanup = []chaosv1alpha1.PendingCleanup{{Operation: chaosv1alpha1.CleanupUncordon, Node: "worker-3"}}
+	printActiveTargets(&out, exp)
+	got := out.String()
+	if !strings.Contains(got, "Cordoned Nodes:      worker-1, worker-2") {
+		t.Errorf("cordoned nodes missing from %q", got)
+	}
+	if !strings.Contains(got, "Pending Cleanup:     Uncordon worker-3") {
+		t.Errorf("pending cleanup missing from %q", got)
+	}
+}
@@ -0,0 +1,480 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"archive/tar"
+	"bufio"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	chaosv1alpha1 "github.com/neogan74/k8s-chaos/api/v1alpha1"
+)
+
+var diagnoseCmd = &cobra.Command{
+	Use:   "diagnose",
+	Short: "Collect a diagnostics bundle for support requests",
+	Long: `Collect controller version, configuration, recent reconcile errors and webhook health
+into a tar.gz bundle to attach to an issue.
+
+The bundle contains:
+  summary.txt          human-readable overview, also printed to stdout
+  diagnostics.json     controller pods, webhook checks, failed experiments and warning events
+  logs/<pod>.log       recent controller logs
+  vars/<pod>.json      runtime state from the diagnostics endpoint (with --diagnostics-port)
+
+Secrets are never read. Review the bundle before sharing it: logs and experiment messages may
+contain workload names.
+
+Examples:
+  # Write k8s-chaos-diagnostics-<timestamp>.tar.gz to the current directory
+  k8s-chaos diagnose
+
+  # Controller installed with Helm into chaos-system
+  k8s-chaos diagnose --controller-namespace chaos-system -o bundle.tar.gz
+
+  # Include pprof/expvar state from a controller started with --diagnostics-bind-address=:6060
+  k8s-chaos diagnose --diagnostics-port 6060`,
+	RunE: runDiagnose,
+}
+
+var (
+	diagnoseControllerNamespace string
+	diagnoseOutput              string
+	diagnoseLogLines            int64
+	diagnosePort                int
+)
+
+func init() {
+	diagnoseCmd.Flags().StringVar(&diagnoseControllerNamespace, "controller-namespace", "k8s-chaos-system",
+		"namespace the controller is installed in")
+	diagnoseCmd.Flags().StringVarP(&diagnoseOutput, "output", "o", "",
+		"bundle file to write (default: k8s-chaos-diagnostics-<timestamp>.tar.gz)")
+	diagnoseCmd.Flags().Int64Var(&diagnoseLogLines, "log-lines", 500, "controller log lines to collect per pod")
+	diagnoseCmd.Flags().IntVar(&diagnosePort, "diagnostics-port", 0,
+		"port of the controller diagnostics endpoint to read /debug/vars from; 0 skips it")
+	rootCmd.AddCommand(diagnoseCmd)
+}
+
+// controllerPodLabels selects the controller pods of the kustomize and Helm installs
+var controllerPodLabels = client.MatchingLabels{"control-plane": "controller-manager"}
+
+// maxBundleEvents caps the warning events included in a bundle
+const maxBundleEvents = 50
+
+// controllerPod is the state of one controller replica
+type controllerPod struct {
+	Name      string     `json:"name"`
+	Phase     string     `json:"phase"`
+	Ready     bool       `json:"ready"`
+	Node      string     `json:"node,omitempty"`
+	Restarts  int32      `json:"restarts"`
+	Images    []string   `json:"images"`
+	Args      []string   `json:"args,omitempty"`
+	StartTime *time.Time `json:"startTime,omitempty"`
+}
+
+// webhookCheck is the configuration of one admission webhook served by the controller
+type webhookCheck struct {
+	Configuration  string `json:"configuration"`
+	Webhook        string `json:"webhook"`
+	Service        string `json:"service"`
+	FailurePolicy  string `json:"failurePolicy,omitempty"`
+	CABundle       bool   `json:"caBundle"`
+	ReadyEndpoints int    `json:"readyEndpoints"`
+}
+
+// diagnosticsBundle is everything diagnose collects
+type diagnosticsBundle struct {
+	CollectedAt         time.Time                  `json:"collectedAt"`
+	CLIVersion          string                     `json:"cliVersion"`
+	ControllerNamespace string                     `json:"controllerNamespace"`
+	Pods                []controllerPod            `json:"pods"`
+	Webhooks            []webhookCheck             `json:"webhooks"`
+	WebhookDryRun       string                     `json:"webhookDryRun"`
+	ReconcileErrors     []string                   `json:"reconcileErrors"`
+	FailedExperiments   []string                   `json:"failedExperiments"`
+	WarningEvents       []string                   `json:"warningEvents"`
+	Problems            []string                   `json:"problems,omitempty"`
+	Logs                map[string]string          `json:"-"`
+	Vars                map[string]json.RawMessage `json:"-"`
+}
+
+// Version returns the controller version taken from the image tag of the first pod
+func (b *diagnosticsBundle) Version() string {
+	for _, pod := range b.Pods {
+		for _, image := range pod.Images {
+			if i := strings.LastIndex(image, ":"); i > strings.LastIndex(image, "/") {
+				return image[i+1:]
+			}
+			return image
+		}
+	}
+	return "unknown"
+}
+
+func runDiagnose(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+	k8sClient, err := getKubeClient()
+	if err != nil {
+		return fmt.Errorf("failed to get Kubernetes client: %w", err)
+	}
+	clientset, err := getClientset()
+	if err != nil {
+		return fmt.Errorf("failed to get Kubernetes client: %w", err)
+	}
+
+	bundle := collectDiagnostics(ctx, k8sClient, clientset, diagnoseControllerNamespace, namespace, diagnoseLogLines, diagnosePort)
+
+	path := diagnoseOutput
+	if path == "" {
+		path = "k8s-chaos-diagnostics-" + bundle.CollectedAt.Format("20060102-150405") + ".tar.gz"
+	}
+	f, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("failed to create bundle: %w", err)
+	}
+	if err := writeDiagnosticsBundle(f, bundle); err != nil {
+		_ = f.Close()
+		return fmt.Errorf("failed to write bundle: %w", err)
+	}
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("failed to write bundle: %w", err)
+	}
+
+	printDiagnosticsSummary(os.Stdout, bundle)
+	fmt.Printf("\nDiagnostics bundle written to %s\n", path)
+	return nil
+}
+
+// collectDiagnostics gathers the bundle; a failing step is recorded as a problem instead of
+// aborting, so a partly broken install still produces a bundle
+func collectDiagnostics(ctx context.Context, c client.Client, clientset kubernetes.Interface,
+	controllerNamespace, experimentNamespace string, logLines int64, varsPort int) *diagnosticsBundle {
+	b := &diagnosticsBundle{
+		CollectedAt:         time.Now().UTC(),
+		CLIVersion:          rootCmd.Version,
+		ControllerNamespace: controllerNamespace,
+		Logs:                map[string]string{},
+		Vars:                map[string]json.RawMessage{},
+	}
+	problem := func(format string, args ...any) {
+		b.Problems = append(b.Problems, fmt.Sprintf(format, args...))
+	}
+
+	pods := &corev1.PodList{}
+	if err := c.List(ctx, pods, client.InNamespace(controllerNamespace), controllerPodLabels); err != nil {
+		problem("failed to list controller pods: %v", err)
+	} else if len(pods.Items) == 0 {
+		problem("no controller pods found in namespace %s", controllerNamespace)
+	}
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		b.Pods = append(b.Pods, describeControllerPod(pod))
+
+		logs, err := clientset.CoreV1().Pods(controllerNamespace).
+			GetLogs(pod.Name, &corev1.PodLogOptions{TailLines: &logLines}).DoRaw(ctx)
+		if err != nil {
+			problem("failed to read logs of %s: %v", pod.Name, err)
+		} else {
+			b.Logs[pod.Name] = string(logs)
+			b.ReconcileErrors = append(b.ReconcileErrors, errorLogLines(string(logs))...)
+		}
+
+		if varsPort > 0 && pod.Status.Phase == corev1.PodRunning {
+			vars, err := clientset.CoreV1().Pods(controllerNamespace).
+				ProxyGet("http", pod.Name, strconv.Itoa(varsPort), "/debug/vars", nil).DoRaw(ctx)
+			if err != nil {
+				problem("failed to read /debug/vars of %s: %v", pod.Name, err)
+			} else {
+				b.Vars[pod.Name] = vars
+			}
+		}
+	}
+
+	b.Webhooks = checkWebhooks(ctx, c, controllerNamespace, problem)
+	b.WebhookDryRun = webhookDryRun(ctx, c)
+	b.FailedExperiments, b.WarningEvents = experimentProblems(ctx, c, experimentNamespace, problem)
+	return b
+}
+
+func describeControllerPod(pod *corev1.Pod) controllerPod {
+	p := controllerPod{Name: pod.Name, Phase: string(pod.Status.Phase), Node: pod.Spec.NodeName}
+	if pod.Status.StartTime != nil {
+		start := pod.Status.StartTime.UTC()
+		p.StartTime = &start
+	}
+	for _, cond := range pod.Status.Conditions {
+		if cond.Type == corev1.PodReady {
+			p.Ready = cond.Status == corev1.ConditionTrue
+		}
+	}
+	for _, cs := range pod.Status.ContainerStatuses {
+		p.Restarts += cs.RestartCount
+	}
+	for _, container := range pod.Spec.Containers {
+		p.Images = append(p.Images, container.Image)
+		p.Args = append(p.Args, container.Args...)
+	}
+	return p
+}
+
+// errorLogLines returns the error-level lines of console or JSON encoded controller logs
+func errorLogLines(logs string) []string {
+	var lines []string
+	scanner := bufio.NewScanner(strings.NewReader(logs))
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if strings.Contains(line, "\tERROR\t") || strings.Contains(line, `"level":"error"`) {
+			lines = append(lines, line)
+		}
+	}
+	return lines
+}
+
+// checkWebhooks lists the admission webhooks backed by a service in the controller namespace
+func checkWebhooks(ctx context.Context, c client.Client, controllerNamespace string, problem func(string, ...any)) []webhookCheck {
+	var checks []webhookCheck
+	add := func(configuration string, webhook string, cfg admissionregistrationv1.WebhookClientConfig, policy *admissionregistrationv1.FailurePolicyType) {
+		if cfg.Service == nil || cfg.Service.Namespace != controllerNamespace {
+			return
+		}
+		check := webhookCheck{
+			Configuration: configuration,
+			Webhook:       webhook,
+			Service:       cfg.Service.Namespace + "/" + cfg.Service.Name,
+			CABundle:      len(cfg.CABundle) > 0,
+		}
+		if policy != nil {
+			check.FailurePolicy = string(*policy)
+		}
+		slices := &discoveryv1.EndpointSliceList{}
+		if err := c.List(ctx, slices, client.InNamespace(cfg.Service.Namespace),
+			client.MatchingLabels{discoveryv1.LabelServiceName: cfg.Service.Name}); err != nil {
+			problem("failed to list endpoints of %s: %v", check.Service, err)
+		}
+		for _, slice := range slices.Items {
+			for _, ep := range slice.Endpoints {
+				if ep.Conditions.Ready == nil || *ep.Conditions.Ready {
+					check.ReadyEndpoints++
+				}
+			}
+		}
+		if !check.CABundle {
+			problem("webhook %s has no caBundle", webhook)
+		}
+		if check.ReadyEndpoints == 0 {
+			problem("webhook service %s has no ready endpoints", check.Service)
+		}
+		checks = append(checks, check)
+	}
+
+	validating := &admissionregistrationv1.ValidatingWebhookConfigurationList{}
+	if err := c.List(ctx, validating); err != nil {
+		problem("failed to list validating webhook configurations: %v", err)
+	}
+	for _, cfg := range validating.Items {
+		for _, wh := range cfg.Webhooks {
+			add(cfg.Name, wh.Name, wh.ClientConfig, wh.FailurePolicy)
+		}
+	}
+	mutating := &admissionregistrationv1.MutatingWebhookConfigurationList{}
+	if err := c.List(ctx, mutating); err != nil {
+		problem("failed to list mutating webhook configurations: %v", err)
+	}
+	for _, cfg := range mutating.Items {
+		for _, wh := range cfg.Webhooks {
+			add(cfg.Name, wh.Name, wh.ClientConfig, wh.FailurePolicy)
+		}
+	}
+	return checks
+}
+
+// webhookDryRun submits a server-side dry-run experiment so admission runs end to end. A denial
+// still proves the webhook answers; only failures to call it are reported.
+func webhookDryRun(ctx context.Context, c client.Client) string {
+	exp := &chaosv1alpha1.ChaosExperiment{
+		ObjectMeta: metav1.ObjectMeta{GenerateName: "k8s-chaos-diagnose-", Namespace: "default"},
+		Spec: chaosv1alpha1.ChaosExperimentSpec{
+			Action:    "pod-kill",
+			Namespace: "default",
+			Selector:  map[string]string{"app.kubernetes.io/name": "k8s-chaos-diagnose"},
+			Count:     1,
+			DryRun:    true,
+		},
+	}
+	err := c.Create(ctx, exp, client.DryRunAll)
+	switch {
+	case err == nil:
+		return "ok"
+	case strings.Contains(err.Error(), "denied the request"):
+		return "ok (denied: " + err.Error() + ")"
+	default:
+		return "failed: " + err.Error()
+	}
+}
+
+// experimentProblems lists failed experiments and the most recent warning events on experiments
+func experimentProblems(ctx context.Context, c client.Client, ns string, problem func(string, ...any)) ([]string, []string) {
+	var failed []string
+	experiments := &chaosv1alpha1.ChaosExperimentList{}
+	if err := c.List(ctx, experiments, client.InNamespace(ns)); err != nil {
+		problem("failed to list experiments: %v", err)
+	}
+	for _, exp := range experiments.Items {
+		if exp.Status.Phase == phaseFailed {
+			failed = append(failed, fmt.Sprintf("%s/%s: %s", exp.Namespace, exp.Name, exp.Status.Message))
+		}
+	}
+	sort.Strings(failed)
+
+	events := &corev1.EventList{}
+	if err := c.List(ctx, events, client.InNamespace(ns)); err != nil {
+		problem("failed to list events: %v", err)
+	}
+	var warnings []corev1.Event
+	for _, ev := range events.Items {
+		if ev.Type == corev1.EventTypeWarning && ev.InvolvedObject.Kind == "ChaosExperiment" {
+			warnings = append(warnings, ev)
+		}
+	}
+	sort.Slice(warnings, func(i, j int) bool {
+		return warnings[i].LastTimestamp.After(warnings[j].LastTimestamp.Time)
+	})
+	if len(warnings) > maxBundleEvents {
+		warnings = warnings[:maxBundleEvents]
+	}
+	lines := make([]string, 0, len(warnings))
+	for _, ev := range warnings {
+		lines = append(lines, fmt.Sprintf("%s %s/%s %s: %s", ev.LastTimestamp.UTC().Format(time.RFC3339),
+			ev.InvolvedObject.Namespace, ev.InvolvedObject.Name, ev.Reason, ev.Message))
+	}
+	return failed, lines
+}
+
+// printDiagnosticsSummary writes the human-readable overview
+func printDiagnosticsSummary(out io.Writer, b *diagnosticsBundle) {
+	_, _ = fmt.Fprintf(out, "k8s-chaos diagnostics (%s)\n\n", b.CollectedAt.Format(time.RFC3339))
+	_, _ = fmt.Fprintf(out, "Controller version:  %s\n", b.Version())
+	_, _ = fmt.Fprintf(out, "CLI version:         %s\n", b.CLIVersion)
+	_, _ = fmt.Fprintf(out, "Namespace:           %s\n", b.ControllerNamespace)
+	_, _ = fmt.Fprintf(out, "Webhook dry run:     %s\n\n", b.WebhookDryRun)
+
+	if len(b.Pods) > 0 {
+		w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+		_, _ = fmt.Fprintln(w, "POD\tPHASE\tREADY\tRESTARTS\tNODE")
+		for _, p := range b.Pods {
+			_, _ = fmt.Fprintf(w, "%s\t%s\t%t\t%d\t%s\n", p.Name, p.Phase, p.Ready, p.Restarts, p.Node)
+		}
+		_ = w.Flush()
+		_, _ = fmt.Fprintln(out)
+	}
+
+	if len(b.Webhooks) > 0 {
+		w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+		_, _ = fmt.Fprintln(w, "WEBHOOK\tSERVICE\tCA BUNDLE\tREADY ENDPOINTS\tFAILURE POLICY")
+		for _, wh := range b.Webhooks {
+			_, _ = fmt.Fprintf(w, "%s\t%s\t%t\t%d\t%s\n", wh.Webhook, wh.Service, wh.CABundle, wh.ReadyEndpoints, wh.FailurePolicy)
+		}
+		_ = w.Flush()
+		_, _ = fmt.Fprintln(out)
+	}
+
+	_, _ = fmt.Fprintf(out, "Reconcile errors in logs:  %d\n", len(b.ReconcileErrors))
+	_, _ = fmt.Fprintf(out, "Failed experiments:        %d\n", len(b.FailedExperiments))
+	_, _ = fmt.Fprintf(out, "Warning events:            %d\n", len(b.WarningEvents))
+
+	if len(b.Problems) > 0 {
+		_, _ = fmt.Fprintln(out, "\nProblems:")
+		for _, p := range b.Problems {
+			_, _ = fmt.Fprintf(out, "  - %s\n", p)
+		}
+	}
+}
+
+// writeDiagnosticsBundle writes the bundle as a gzipped tarball
+func writeDiagnosticsBundle(out io.Writer, b *diagnosticsBundle) error {
+	gz := gzip.NewWriter(out)
+	tw := tar.NewWriter(gz)
+	add := func(name string, content []byte) error {
+		if err := tw.WriteHeader(&tar.Header{
+			Name:    name,
+			Mode:    0o644,
+			Size:    int64(len(content)),
+			ModTime: b.CollectedAt,
+		}); err != nil {
+			return err
+		}
+		_, err := tw.Write(content)
+		return err
+	}
+
+	var summary strings.Builder
+	printDiagnosticsSummary(&summary, b)
+	if err := add("summary.txt", []byte(summary.String())); err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(b, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := add("diagnostics.json", data); err != nil {
+		return err
+	}
+	for _, pod := range sortedKeys(b.Logs) {
+		if err := add("logs/"+pod+".log", []byte(b.Logs[pod])); err != nil {
+			return err
+		}
+	}
+	for _, pod := range sortedKeys(b.Vars) {
+		if err := add("vars/"+pod+".json", b.Vars[pod]); err != nil {
+			return err
+		}
+	}
+
+	if err := tw.Close(); err != nil {
+		return err
+	}
+	return gz.Close()
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,204 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	chaosv1alpha1 "github.com/neogan74/k8s-chaos/api/v1alpha1"
+)
+
+func newDiagnoseClient(t *testing.T, funcs interceptor.Funcs, objs ...client.Object) client.Client {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	if err := clientgoscheme.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	if err := chaosv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	return fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).WithInterceptorFuncs(funcs).Build()
+}
+
+func TestErrorLogLines(t *testing.T) {
+	logs := strings.Join([]string{
+		"2025-01-01T00:00:00Z\tINFO\tstarting manager",
+		"2025-01-01T00:00:01Z\tERROR\tReconciler error\t{\"controller\": \"chaosexperiment\"}",
+		`{"level":"info","msg":"ok"}`,
+		`{"level":"error","msg":"Reconciler error"}`,
+	}, "\n")
+
+	lines := errorLogLines(logs)
+	if len(lines) != 2 {
+		t.Fatalf("expected 2 error lines, got %d: %v", len(lines), lines)
+	}
+}
+
+func TestCollectDiagnostics(t *testing.T) {
+	objs := []client.Object{
+		&corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "controller-abc", Namespace: "k8s-chaos-system",
+				Labels: map[string]string{"control-plane": "controller-manager"}},
+			Spec: corev1.PodSpec{Containers: []corev1.Container{{Name: "manager",
+				Image: "registry.example.com:5000/k8s-chaos:v0.4.0", Args: []string{"--leader-elect"}}}},
+			Status: corev1.PodStatus{
+				Phase:             corev1.PodRunning,
+				Conditions:        []corev1.PodCondition{{Type: corev1.PodReady, Status: corev1.ConditionTrue}},
+				ContainerStatuses: []corev1.ContainerStatus{{Name: "manager", RestartCount: 2}},
+			},
+		},
+		&admissionregistrationv1.ValidatingWebhookConfiguration{
+			ObjectMeta: metav1.ObjectMeta{Name: "k8s-chaos-validating-webhook-configuration"},
+			Webhooks: []admissionregistrationv1.ValidatingWebhook{{
+				Name: "vchaosexperiment.kb.io",
+				ClientConfig: admissionregistrationv1.WebhookClientConfig{
+					Service: &admissionregistrationv1.ServiceReference{Namespace: "k8s-chaos-system", Name: "k8s-chaos-webhook-service"},
+				},
+				SideEffects:             ptr.To(admissionregistrationv1.SideEffectClassNone),
+				AdmissionReviewVersions: []string{"v1"},
+			}},
+		},
+		&discoveryv1.EndpointSlice{
+			ObjectMeta: metav1.ObjectMeta{Name: "k8s-chaos-webhook-service-x", Namespace: "k8s-chaos-system",
+				Labels: map[string]string{discoveryv1.LabelServiceName: "k8s-chaos-webhook-service"}},
+			AddressType: discoveryv1.AddressTypeIPv4,
+			Endpoints:   []discoveryv1.Endpoint{{Addresses: []string{"10.0.0.1"}, Conditions: discoveryv1.EndpointConditions{Ready: ptr.To(true)}}},
+		},
+		&chaosv1alpha1.ChaosExperiment{
+			ObjectMeta: metav1.ObjectMeta{Name: "broken", Namespace: "default"},
+			Status:     chaosv1alpha1.ChaosExperimentStatus{Phase: phaseFailed, Message: "no eligible pods"},
+		},
+		&corev1.Event{
+			ObjectMeta:     metav1.ObjectMeta{Name: "broken.1", Namespace: "default"},
+			InvolvedObject: corev1.ObjectReference{Kind: "ChaosExperiment", Namespace: "default", Name: "broken"},
+			Type:           corev1.EventTypeWarning,
+			Reason:         "ExperimentFailed",
+			Message:        "no eligible pods",
+		},
+	}
+	c := newDiagnoseClient(t, interceptor.Funcs{
+		Create: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.CreateOption) error {
+			return errors.New(`admission webhook "vchaosexperiment.kb.io" denied the request: no pods match`)
+		},
+	}, objs...)
+
+	b := collectDiagnostics(context.Background(), c, k8sfake.NewClientset(), "k8s-chaos-system", "", 100, 0)
+
+	if b.Version() != "v0.4.0" {
+		t.Errorf("expected version v0.4.0, got %s", b.Version())
+	}
+	if len(b.Pods) != 1 || b.Pods[0].Restarts != 2 || !b.Pods[0].Ready {
+		t.Errorf("unexpected pods: %+v", b.Pods)
+	}
+	if b.Logs["controller-abc"] == "" {
+		t.Error("expected controller logs to be collected")
+	}
+	if len(b.Webhooks) != 1 || b.Webhooks[0].ReadyEndpoints != 1 || b.Webhooks[0].CABundle {
+		t.Errorf("unexpected webhooks: %+v", b.Webhooks)
+	}
+	if !strings.HasPrefix(b.WebhookDryRun, "ok (denied") {
+		t.Errorf("expected a denial to count as a reachable webhook, got %s", b.WebhookDryRun)
+	}
+	if len(b.FailedExperiments) != 1 || len(b.WarningEvents) != 1 {
+		t.Errorf("unexpected experiment problems: %v %v", b.FailedExperiments, b.WarningEvents)
+	}
+	if len(b.Problems) != 1 || !strings.Contains(b.Problems[0], "no caBundle") {
+		t.Errorf("expected only the missing caBundle problem, got %v", b.Problems)
+	}
+}
+
+func TestCollectDiagnostics_NoController(t *testing.T) {
+	c := newDiagnoseClient(t, interceptor.Funcs{
+		Create: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.CreateOption) error {
+			return errors.New(`failed calling webhook "vchaosexperiment.kb.io": connection refused`)
+		},
+	})
+
+	b := collectDiagnostics(context.Background(), c, k8sfake.NewClientset(), "k8s-chaos-system", "", 100, 0)
+
+	if !strings.HasPrefix(b.WebhookDryRun, "failed") {
+		t.Errorf("expected dry run to fail, got %s", b.WebhookDryRun)
+	}
+	if len(b.Problems) == 0 || !strings.Contains(b.Problems[0], "no controller pods") {
+		t.Errorf("expected missing controller problem, got %v", b.Problems)
+	}
+	if b.Version() != "unknown" {
+		t.Errorf("expected unknown version, got %s", b.Version())
+	}
+}
+
+func TestWriteDiagnosticsBundle(t *testing.T) {
+	b := &diagnosticsBundle{
+		ControllerNamespace: "k8s-chaos-system",
+		WebhookDryRun:       "ok",
+		Logs:                map[string]string{"controller-abc": "log line\n"},
+	}
+
+	var buf bytes.Buffer
+	if err := writeDiagnosticsBundle(&buf, b); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	gz, err := gzip.NewReader(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tr := tar.NewReader(gz)
+	files := map[string]string{}
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		content, _ := io.ReadAll(tr)
+		files[hdr.Name] = string(content)
+	}
+
+	for _, name := range []string{"summary.txt", "diagnostics.json", "logs/controller-abc.log"} {
+		if _, ok := files[name]; !ok {
+			t.Errorf("expected %s in bundle, got %v", name, files)
+		}
+	}
+	if !strings.Contains(files["summary.txt"], "Webhook dry run:     ok") {
+		t.Errorf("unexpected summary:\n%s", files["summary.txt"])
+	}
+	if strings.Contains(files["diagnostics.json"], "log line") {
+		t.Error("expected logs to be kept out of diagnostics.json")
+	}
+}
@@ -0,0 +1,128 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	"github.com/neogan74/k8s-chaos/internal/preflight"
+)
+
+var doctorCmd = &cobra.Command{
+	Use:   "doctor",
+	Short: "Check that the controller has the RBAC permissions every action needs",
+	Long: `Check with access reviews that the controller service account holds every RBAC
+permission the chaos actions need, and list what is missing per action.
+
+The controller runs the same check at startup: missing permissions are logged as warnings,
+and the "rbac" readiness check fails while permissions it needs for every experiment are missing.
+
+Checking a service account requires permission to create subjectaccessreviews. Use --self to
+check your own permissions instead.
+
+Exits with an error when a permission is missing.
+
+Examples:
+  # Check the controller of the default install
+  k8s-chaos doctor
+
+  # Controller installed with Helm into chaos-system
+  k8s-chaos doctor --controller-namespace chaos-system --service-account k8s-chaos
+
+  # Only the actions you use
+  k8s-chaos doctor --action pod-kill,pod-delay`,
+	RunE: runDoctor,
+}
+
+var (
+	doctorControllerNamespace string
+	doctorServiceAccount      string
+	doctorActions             []string
+	doctorSelf                bool
+)
+
+func init() {
+	doctorCmd.Flags().StringVar(&doctorControllerNamespace, "controller-namespace", "k8s-chaos-system",
+		"namespace of the controller service account")
+	doctorCmd.Flags().StringVar(&doctorServiceAccount, "service-account", "k8s-chaos-controller-manager",
+		"controller service account to check")
+	doctorCmd.Flags().StringSliceVar(&doctorActions, "action", nil, "actions to check (default: all)")
+	doctorCmd.Flags().BoolVar(&doctorSelf, "self", false, "check the permissions of the current kubeconfig user")
+	rootCmd.AddCommand(doctorCmd)
+}
+
+func runDoctor(cmd *cobra.Command, args []string) error {
+	clientset, err := getClientset()
+	if err != nil {
+		return fmt.Errorf("failed to get Kubernetes client: %w", err)
+	}
+
+	reviewer := preflight.ServiceAccountReviewer(clientset.AuthorizationV1(), doctorControllerNamespace, doctorServiceAccount)
+	subject := fmt.Sprintf("system:serviceaccount:%s:%s", doctorControllerNamespace, doctorServiceAccount)
+	if doctorSelf {
+		reviewer = preflight.SelfReviewer(clientset.AuthorizationV1())
+		subject = "current user"
+	}
+
+	result, err := preflight.Run(context.Background(), reviewer, doctorActions...)
+	if err != nil {
+		return err
+	}
+	printDoctorResult(os.Stdout, subject, result, doctorActions)
+	if !result.OK() {
+		return fmt.Errorf("missing RBAC permissions for %s; fix: make manifests && kubectl apply -f config/rbac/", subject)
+	}
+	return nil
+}
+
+// printDoctorResult writes one line for the core permissions and one per checked action
+func printDoctorResult(out io.Writer, subject string, result *preflight.Result, actions []string) {
+	if len(actions) == 0 {
+		actions = sortedKeys(preflight.Actions)
+	} else {
+		actions = append([]string(nil), actions...)
+		sort.Strings(actions)
+	}
+
+	_, _ = fmt.Fprintf(out, "RBAC check for %s\n\n", subject)
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "ACTION\tSTATUS\tMISSING")
+	line := func(name string, missing []preflight.Permission) {
+		if len(missing) == 0 {
+			_, _ = fmt.Fprintf(w, "%s\tOK\t\n", name)
+			return
+		}
+		perms := make([]string, len(missing))
+		for i, p := range missing {
+			perms[i] = p.String()
+		}
+		_, _ = fmt.Fprintf(w, "%s\tMISSING\t%s\n", name, strings.Join(perms, ", "))
+	}
+	line("(controller)", result.MissingCore)
+	for _, action := range actions {
+		line(action, result.MissingByAction[action])
+	}
+	_ = w.Flush()
+}
@@ -0,0 +1,56 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/neogan74/k8s-chaos/internal/preflight"
+)
+
+func TestPrintDoctorResult(t *testing.T) {
+	result := &preflight.Result{
+		MissingByAction: map[string][]preflight.Permission{
+			"pod-failure": {{Resource: "pods", Subresource: "exec", Verb: "create"}},
+		},
+	}
+	var out bytes.Buffer
+
+	printDoctorResult(&out, "current user", result, []string{"pod-kill", "pod-failure"})
+
+	expected := `RBAC check for current user
+
+ACTION        STATUS   MISSING
+(controller)  OK       
+pod-failure   MISSING  create pods/exec
+pod-kill      OK       
+`
+	assert.Equal(t, expected, out.String())
+}
+
+func TestPrintDoctorResult_AllActions(t *testing.T) {
+	var out bytes.Buffer
+
+	printDoctorResult(&out, "sa", &preflight.Result{}, nil)
+
+	for action := range preflight.Actions {
+		assert.Contains(t, out.String(), action+" ")
+	}
+}
@@ -0,0 +1,389 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	"go/format"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"text/template"
+
+	"github.com/spf13/cobra"
+)
+
+//go:embed templates/action/*.tmpl
+var actionTemplates embed.FS
+
+var generateCmd = &cobra.Command{
+	Use:   "generate",
+	Short: "Scaffold code for k8s-chaos development",
+	Long:  `Generators for contributors working on the k8s-chaos source tree.`,
+}
+
+var generateActionCmd = &cobra.Command{
+	Use:   "action NAME",
+	Short: "Scaffold a new pod-targeting chaos action",
+	Long: `Scaffold a new chaos action in a k8s-chaos source checkout.
+
+Creates a handler skeleton with metrics, events and history wired in, its unit
+tests, webhook validation with test cases and an e2e test, and registers the
+action in the Action enum, the controller dispatch and the webhook. Spec fields
+given with --field are added to ChaosExperimentSpec and required by validation
+(bool fields are optional).
+
+Only the injection itself is left to implement; run "make manifests generate"
+afterwards to update the CRDs.
+
+Examples:
+  # Scaffold pod-dns-failure in the current checkout
+  k8s-chaos generate action pod-dns-failure
+
+  # With spec fields
+  k8s-chaos generate action pod-dns-failure --field dnsDomain:string --field failureRate:int`,
+	Args: cobra.ExactArgs(1),
+	RunE: runGenerateAction,
+}
+
+var (
+	generateDir    string
+	generateFields []string
+)
+
+func init() {
+	generateActionCmd.Flags().StringVar(&generateDir, "dir", ".", "root of the k8s-chaos source tree")
+	generateActionCmd.Flags().StringArrayVar(&generateFields, "field", nil,
+		"spec field to add as name:type (string, int, int32 or bool); repeatable")
+	generateCmd.AddCommand(generateActionCmd)
+	rootCmd.AddCommand(generateCmd)
+}
+
+// Files the generator edits, relative to the source tree root
+const (
+	typesFile        = "api/v1alpha1/chaosexperiment_types.go"
+	webhookFile      = "api/v1alpha1/chaosexperiment_webhook.go"
+	controllerFile   = "internal/controller/chaosexperiment_controller.go"
+	preflightFile    = "internal/preflight/preflight.go"
+	capabilitiesFile = "internal/capabilities/capabilities.go"
+)
+
+var (
+	actionNamePattern = regexp.MustCompile(`^[a-z][a-z0-9]*(-[a-z0-9]+)*$`)
+	fieldNamePattern  = regexp.MustCompile(`^[a-z][a-zA-Z0-9]*$`)
+	actionEnumPattern = regexp.MustCompile(`(?m)^(\s*// \+kubebuilder:validation:Enum=)(pod-kill;[a-z0-9;-]+)$`)
+)
+
+// scaffoldField is a spec field added for the new action
+type scaffoldField struct {
+	JSONName string
+	GoName   string
+	Type     string
+}
+
+// Required reports whether validation demands a value; a false bool cannot be told from unset
+func (f scaffoldField) Required() bool { return f.Type != "bool" }
+
+// Example is a valid Go literal for the field
+func (f scaffoldField) Example() string {
+	if f.Type == "string" {
+		return `"example"`
+	}
+	return "1"
+}
+
+// YAMLExample is a valid YAML value for the field
+func (f scaffoldField) YAMLExample() string { return f.Example() }
+
+// Zero is the Go literal validation rejects
+func (f scaffoldField) Zero() string {
+	if f.Type == "string" {
+		return `""`
+	}
+	return "0"
+}
+
+// Missing is the comparison that detects an unset field
+func (f scaffoldField) Missing() string {
+	if f.Type == "string" {
+		return `== ""`
+	}
+	return "<= 0"
+}
+
+// MissingSuffix completes the validation error message
+func (f scaffoldField) MissingSuffix() string {
+	if f.Type == "string" {
+		return ""
+	}
+	return " and greater than 0"
+}
+
+// actionScaffold holds the names derived from an action name, e.g. pod-dns-failure
+type actionScaffold struct {
+	Name       string // pod-dns-failure
+	Camel      string // PodDnsFailure
+	LowerCamel string // podDnsFailure
+	Snake      string // pod_dns_failure
+	Title      string // Pod Dns Failure
+	Fields     []scaffoldField
+}
+
+// EventReason is the reason of the event emitted on affected pods
+func (s actionScaffold) EventReason() string { return "Chaos" + s.Camel }
+
+// HasRequired reports whether any field is validated
+func (s actionScaffold) HasRequired() bool {
+	for _, f := range s.Fields {
+		if f.Required() {
+			return true
+		}
+	}
+	return false
+}
+
+func newActionScaffold(name string, fields []string) (*actionScaffold, error) {
+	if !actionNamePattern.MatchString(name) || len(name) > 63 {
+		return nil, fmt.Errorf("invalid action name %q: use lowercase words separated by dashes, e.g. pod-dns-failure", name)
+	}
+	s := &actionScaffold{Name: name, Snake: strings.ReplaceAll(name, "-", "_")}
+	titles := []string{}
+	for _, part := range strings.Split(name, "-") {
+		titles = append(titles, strings.ToUpper(part[:1])+part[1:])
+	}
+	s.Camel = strings.Join(titles, "")
+	s.LowerCamel = strings.ToLower(s.Camel[:1]) + s.Camel[1:]
+	s.Title = strings.Join(titles, " ")
+
+	seen := map[string]bool{}
+	for _, spec := range fields {
+		fieldName, fieldType, found := strings.Cut(spec, ":")
+		if !found || !fieldNamePattern.MatchString(fieldName) {
+			return nil, fmt.Errorf("invalid --field %q: use name:type with a lowerCamelCase name", spec)
+		}
+		switch fieldType {
+		case "string", "int", "int32", "bool":
+		default:
+			return nil, fmt.Errorf("invalid --field %q: type must be string, int, int32 or bool", spec)
+		}
+		if seen[fieldName] {
+			return nil, fmt.Errorf("field %q given twice", fieldName)
+		}
+		seen[fieldName] = true
+		s.Fields = append(s.Fields, scaffoldField{
+			JSONName: fieldName,
+			GoName:   strings.ToUpper(fieldName[:1]) + fieldName[1:],
+			Type:     fieldType,
+		})
+	}
+	return s, nil
+}
+
+// generatedFiles maps template names to the files they produce
+func (s *actionScaffold) generatedFiles() map[string]string {
+	return map[string]string{
+		"handler.go.tmpl":         filepath.Join("internal", "controller", s.Snake+".go"),
+		"handler_test.go.tmpl":    filepath.Join("internal", "controller", s.Snake+"_test.go"),
+		"validation.go.tmpl":      filepath.Join("api", "v1alpha1", s.Snake+"_validation.go"),
+		"validation_test.go.tmpl": filepath.Join("api", "v1alpha1", s.Snake+"_validation_test.go"),
+		"e2e_test.go.tmpl":        filepath.Join("test", "e2e", s.Snake+"_test.go"),
+	}
+}
+
+func runGenerateAction(cmd *cobra.Command, args []string) error {
+	s, err := newActionScaffold(args[0], generateFields)
+	if err != nil {
+		return err
+	}
+	return generateAction(os.Stdout, generateDir, s)
+}
+
+// generateAction writes the scaffold into the source tree at dir. Nothing is written unless every
+// file can be created and every registration point is found.
+func generateAction(out io.Writer, dir string, s *actionScaffold) error {
+	writes := map[string][]byte{}
+
+	for tmplName, path := range s.generatedFiles() {
+		if _, err := os.Stat(filepath.Join(dir, path)); err == nil {
+			return fmt.Errorf("%s already exists", path)
+		}
+		content, err := renderActionTemplate(tmplName, s)
+		if err != nil {
+			return fmt.Errorf("failed to render %s: %w", path, err)
+		}
+		writes[path] = content
+	}
+
+	patches := []struct {
+		path  string
+		apply func(string, *actionScaffold) (string, error)
+	}{
+		{typesFile, registerActionType},
+		{controllerFile, registerActionHandler},
+		{webhookFile, registerActionValidation},
+		{preflightFile, registerActionPermissions},
+		{capabilitiesFile, registerActionCapabilities},
+	}
+	for _, p := range patches {
+		original, err := os.ReadFile(filepath.Join(dir, p.path))
+		if err != nil {
+			return fmt.Errorf("not a k8s-chaos source tree (use --dir): %w", err)
+		}
+		patched, err := p.apply(string(original), s)
+		if err != nil {
+			return fmt.Errorf("%s: %w", p.path, err)
+		}
+		formatted, err := format.Source([]byte(patched))
+		if err != nil {
+			return fmt.Errorf("%s: patched source does not parse: %w", p.path, err)
+		}
+		writes[p.path] = formatted
+	}
+
+	for path, content := range writes {
+		if err := os.WriteFile(filepath.Join(dir, path), content, 0o644); err != nil {
+			return err
+		}
+	}
+
+	_, _ = fmt.Fprintf(out, "Scaffolded action %s:\n", s.Name)
+	for _, tmplName := range []string{"handler.go.tmpl", "handler_test.go.tmpl", "validation.go.tmpl",
+		"validation_test.go.tmpl", "e2e_test.go.tmpl"} {
+		_, _ = fmt.Fprintf(out, "  created  %s\n", s.generatedFiles()[tmplName])
+	}
+	for _, p := range patches {
+		_, _ = fmt.Fprintf(out, "  updated  %s\n", p.path)
+	}
+	_, _ = fmt.Fprintf(out, `
+Next steps:
+  1. Implement inject%s in internal/controller/%s.go; undo lasting changes in revertActiveInjections
+  2. Describe the new spec fields in %s
+  3. Add RBAC markers to the controller and the permissions to %s if the action needs new ones
+  4. Describe what the action needs in %s
+  5. Run "make manifests generate" to update the CRDs, deepcopy code and ClusterRole
+  6. Document the action in docs/API.md
+`, s.Camel, s.Snake, typesFile, preflightFile, capabilitiesFile)
+	return nil
+}
+
+func renderActionTemplate(name string, s *actionScaffold) ([]byte, error) {
+	tmpl, err := template.ParseFS(actionTemplates, "templates/action/"+name)
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, s); err != nil {
+		return nil, err
+	}
+	return format.Source(buf.Bytes())
+}
+
+// registerActionType adds the action to the Action enum and the fields to ChaosExperimentSpec
+func registerActionType(source string, s *actionScaffold) (string, error) {
+	match := actionEnumPattern.FindStringSubmatchIndex(source)
+	if match == nil {
+		return "", fmt.Errorf("action enum marker not found")
+	}
+	actions := source[match[4]:match[5]]
+	for _, existing := range strings.Split(actions, ";") {
+		if existing == s.Name {
+			return "", fmt.Errorf("action %s already exists", s.Name)
+		}
+	}
+	source = source[:match[5]] + ";" + s.Name + source[match[5]:]
+
+	if len(s.Fields) == 0 {
+		return source, nil
+	}
+	start := strings.Index(source, "type ChaosExperimentSpec struct {")
+	if start < 0 {
+		return "", fmt.Errorf("ChaosExperimentSpec not found")
+	}
+	end := strings.Index(source[start:], "\n}\n")
+	if end < 0 {
+		return "", fmt.Errorf("end of ChaosExperimentSpec not found")
+	}
+	end += start
+
+	var fields strings.Builder
+	for _, f := range s.Fields {
+		if strings.Contains(source[start:end], `json:"`+f.JSONName+`"`) ||
+			strings.Contains(source[start:end], `json:"`+f.JSONName+`,`) {
+			return "", fmt.Errorf("spec field %s already exists", f.JSONName)
+		}
+		_, _ = fmt.Fprintf(&fields, "\n\n\t// %s configures the %s action\n\t// TODO: describe the field\n", f.GoName, s.Name)
+		_, _ = fmt.Fprintf(&fields, "\t// +optional\n\t%s %s `json:\"%s,omitempty\"`", f.GoName, f.Type, f.JSONName)
+	}
+	return source[:end] + fields.String() + source[end:], nil
+}
+
+// registerActionHandler adds the action to the controller's executors
+func registerActionHandler(source string, s *actionScaffold) (string, error) {
+	return insertMapEntry(source, "var executors = map[string]actionExecutor{",
+		fmt.Sprintf("\t%q: (*ChaosExperimentReconciler).handle%s,\n", s.Name, s.Camel))
+}
+
+// registerActionPermissions gives the action the permissions of an ephemeral container injection
+func registerActionPermissions(source string, s *actionScaffold) (string, error) {
+	return insertMapEntry(source, "var Actions = map[string][]Permission{",
+		fmt.Sprintf("\t%q: injectEphemeral, // TODO: the permissions the injection needs\n", s.Name))
+}
+
+// registerActionCapabilities adds a placeholder description of the action and its required fields
+func registerActionCapabilities(source string, s *actionScaffold) (string, error) {
+	var required []string
+	for _, f := range s.Fields {
+		if f.Required() {
+			required = append(required, fmt.Sprintf("%q", f.JSONName))
+		}
+	}
+	fields := "nil"
+	if len(required) > 0 {
+		fields = "[]string{" + strings.Join(required, ", ") + "}"
+	}
+	return insertMapEntry(source, "var details = map[string]detail{",
+		fmt.Sprintf("\t%q: {\"TODO: describe %s\", TargetPod, %s, nil, false},\n", s.Name, s.Name, fields))
+}
+
+// insertMapEntry appends entry to the map literal starting with decl
+func insertMapEntry(source, decl, entry string) (string, error) {
+	start := strings.Index(source, decl)
+	if start < 0 {
+		return "", fmt.Errorf("%s not found", strings.TrimSuffix(decl, "{"))
+	}
+	end := strings.Index(source[start:], "\n}\n")
+	if end < 0 {
+		return "", fmt.Errorf("end of %s not found", strings.TrimSuffix(decl, "{"))
+	}
+	end += start + 1
+	return source[:end] + entry + source[end:], nil
+}
+
+// registerActionValidation adds the action to validateActionRequirements
+func registerActionValidation(source string, s *actionScaffold) (string, error) {
+	anchor := "\t}\n\treturn nil\n}\n\nfunc requireDuration("
+	idx := strings.Index(source, anchor)
+	if idx < 0 {
+		return "", fmt.Errorf("validateActionRequirements switch not found")
+	}
+	validation := fmt.Sprintf("\tcase %q:\n\t\treturn validate%sRequirements(spec)\n", s.Name, s.Camel)
+	return source[:idx] + validation + source[idx:], nil
+}
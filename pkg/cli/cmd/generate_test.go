@@ -0,0 +1,183 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestNewActionScaffold_Names(t *testing.T) {
+	s, err := newActionScaffold("pod-dns-failure", []string{"dnsDomain:string", "failureRate:int", "strict:bool"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if s.Camel != "PodDnsFailure" || s.LowerCamel != "podDnsFailure" || s.Snake != "pod_dns_failure" ||
+		s.Title != "Pod Dns Failure" || s.EventReason() != "ChaosPodDnsFailure" {
+		t.Errorf("unexpected names: %+v", s)
+	}
+	if len(s.Fields) != 3 || s.Fields[0].GoName != "DnsDomain" || s.Fields[1].Missing() != "<= 0" {
+		t.Errorf("unexpected fields: %+v", s.Fields)
+	}
+	if s.Fields[2].Required() {
+		t.Error("expected bool field to be optional")
+	}
+	if !s.HasRequired() {
+		t.Error("expected scaffold to have required fields")
+	}
+}
+
+func TestNewActionScaffold_Invalid(t *testing.T) {
+	tests := []struct {
+		name   string
+		action string
+		fields []string
+	}{
+		{"uppercase", "Pod-Dns", nil},
+		{"trailing dash", "pod-dns-", nil},
+		{"leading digit", "1pod", nil},
+		{"too long", strings.Repeat("a", 64), nil},
+		{"missing field type", "pod-dns", []string{"domain"}},
+		{"unsupported field type", "pod-dns", []string{"domain:float64"}},
+		{"capitalized field", "pod-dns", []string{"Domain:string"}},
+		{"duplicate field", "pod-dns", []string{"domain:string", "domain:int"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := newActionScaffold(tt.action, tt.fields); err == nil {
+				t.Error("expected an error")
+			}
+		})
+	}
+}
+
+// scaffoldTree copies the files the generator patches into a temporary source tree
+func scaffoldTree(t *testing.T) string {
+	t.Helper()
+	dir := t.TempDir()
+	for _, path := range []string{typesFile, webhookFile, controllerFile, preflightFile, capabilitiesFile} {
+		content, err := os.ReadFile(filepath.Join("..", "..", "..", path))
+		if err != nil {
+			t.Fatalf("failed to read %s: %v", path, err)
+		}
+		target := filepath.Join(dir, path)
+		if err := os.MkdirAll(filepath.Dir(target), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(target, content, 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := os.MkdirAll(filepath.Join(dir, "test", "e2e"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	return dir
+}
+
+func readTreeFile(t *testing.T, dir, path string) string {
+	t.Helper()
+	content, err := os.ReadFile(filepath.Join(dir, path))
+	if err != nil {
+		t.Fatalf("failed to read %s: %v", path, err)
+	}
+	return string(content)
+}
+
+func TestGenerateAction(t *testing.T) {
+	dir := scaffoldTree(t)
+	s, err := newActionScaffold("pod-dns-failure", []string{"dnsDomain:string"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var out bytes.Buffer
+	if err := generateAction(&out, dir, s); err != nil {
+		t.Fatalf("generateAction failed: %v", err)
+	}
+
+	for _, path := range s.generatedFiles() {
+		if !strings.Contains(out.String(), "created  "+path) {
+			t.Errorf("expected %s in summary:\n%s", path, out.String())
+		}
+	}
+	if !strings.Contains(readTreeFile(t, dir, "internal/controller/pod_dns_failure.go"),
+		"func (r *ChaosExperimentReconciler) handlePodDnsFailure(") {
+		t.Error("expected handler in generated controller file")
+	}
+	if !strings.Contains(readTreeFile(t, dir, "api/v1alpha1/pod_dns_failure_validation.go"), "spec.DnsDomain == \"\"") {
+		t.Error("expected dnsDomain check in generated validation")
+	}
+
+	types := readTreeFile(t, dir, typesFile)
+	if !strings.Contains(types, ";pod-dns-failure\n") {
+		t.Error("expected action in enum marker")
+	}
+	if !strings.Contains(types, "DnsDomain string `json:\"dnsDomain,omitempty\"`") {
+		t.Error("expected DnsDomain field in ChaosExperimentSpec")
+	}
+	if !strings.Contains(readTreeFile(t, dir, controllerFile), "\"pod-dns-failure\":        (*ChaosExperimentReconciler).handlePodDnsFailure,") {
+		t.Error("expected executor in controller")
+	}
+	if !strings.Contains(readTreeFile(t, dir, preflightFile), "\"pod-dns-failure\":        injectEphemeral,") {
+		t.Error("expected permissions in preflight")
+	}
+	if !strings.Contains(readTreeFile(t, dir, capabilitiesFile), "[]string{\"dnsDomain\"}, nil, false},") {
+		t.Error("expected description in capabilities")
+	}
+	if !strings.Contains(readTreeFile(t, dir, webhookFile), "case \"pod-dns-failure\":\n\t\treturn validatePodDnsFailureRequirements(spec)") {
+		t.Error("expected validation case in webhook")
+	}
+
+	// Running again must not clobber the scaffold
+	if err := generateAction(&out, dir, s); err == nil {
+		t.Error("expected an error when the action already exists")
+	}
+}
+
+func TestGenerateAction_ExistingAction(t *testing.T) {
+	dir := scaffoldTree(t)
+	s, err := newActionScaffold("pod-kill", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	before := readTreeFile(t, dir, controllerFile)
+
+	if err := generateAction(&bytes.Buffer{}, dir, s); err == nil {
+		t.Fatal("expected an error for an existing action")
+	}
+	if readTreeFile(t, dir, controllerFile) != before {
+		t.Error("expected the controller to be left untouched")
+	}
+	if _, err := os.Stat(filepath.Join(dir, "internal/controller/pod_kill_test.go")); err == nil {
+		t.Error("expected no files to be created")
+	}
+}
+
+func TestGenerateAction_DuplicateField(t *testing.T) {
+	dir := scaffoldTree(t)
+	s, err := newActionScaffold("pod-dns-failure", []string{"duration:string"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = generateAction(&bytes.Buffer{}, dir, s)
+	if err == nil || !strings.Contains(err.Error(), "duration already exists") {
+		t.Errorf("expected duplicate field error, got %v", err)
+	}
+}
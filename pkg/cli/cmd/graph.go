@@ -0,0 +1,134 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/types"
+
+	chaosv1alpha1 "github.com/neogan74/k8s-chaos/api/v1alpha1"
+)
+
+var graphCmd = &cobra.Command{
+	Use:   "graph EXPERIMENT_NAME",
+	Short: "Print the blast-radius graph of an experiment",
+	Long: `Print the resources reached by the last run of an experiment as a graph: the selected pods,
+their owning workloads, the Services selecting them and the Ingresses routing to those Services.
+The graph is the topology snapshot the controller records in status.blastRadius when it selects
+targets for a pod action.
+
+The default output is Graphviz DOT; use -o json for the raw snapshot.
+
+Examples:
+  # Render the graph as SVG
+  k8s-chaos graph nginx-chaos-demo -n chaos-testing | dot -Tsvg > blast-radius.svg
+
+  # Raw snapshot for other tools
+  k8s-chaos graph nginx-chaos-demo -n chaos-testing -o json`,
+	Args: cobra.ExactArgs(1),
+	RunE: runGraph,
+}
+
+var graphOutput string
+
+func init() {
+	graphCmd.Flags().StringVarP(&graphOutput, "output", "o", "dot", "output format: dot or json")
+	rootCmd.AddCommand(graphCmd)
+}
+
+func runGraph(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+
+	if namespace == "" {
+		return fmt.Errorf("namespace is required, use -n flag to specify")
+	}
+	if graphOutput != "dot" && graphOutput != "json" {
+		return fmt.Errorf("unsupported output format %q", graphOutput)
+	}
+
+	k8sClient, err := getKubeClient()
+	if err != nil {
+		return fmt.Errorf("failed to get Kubernetes client: %w", err)
+	}
+
+	exp := &chaosv1alpha1.ChaosExperiment{}
+	if err := k8sClient.Get(ctx, types.NamespacedName{Name: args[0], Namespace: namespace}, exp); err != nil {
+		return fmt.Errorf("failed to get experiment: %w", err)
+	}
+	if exp.Status.BlastRadius == nil || exp.Status.BlastRadius.Topology == nil {
+		return fmt.Errorf("experiment %s has no blast-radius topology yet; it is recorded when a pod action selects targets", args[0])
+	}
+
+	if graphOutput == "json" {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(exp.Status.BlastRadius.Topology)
+	}
+	return writeTopologyDOT(os.Stdout, exp.Name, exp.Spec.Namespace, exp.Status.BlastRadius.Topology)
+}
+
+// topologyShapes gives each kind of resource its own Graphviz shape; workloads use the default
+var topologyShapes = map[string]string{
+	"Pod":     "box",
+	"Service": "ellipse",
+	"Ingress": "hexagon",
+}
+
+// writeTopologyDOT renders a blast-radius topology as a Graphviz digraph flowing from the
+// disrupted pods to the Ingresses that expose them
+func writeTopologyDOT(out io.Writer, name, targetNamespace string, topology *chaosv1alpha1.BlastRadiusTopology) error {
+	var b strings.Builder
+	fmt.Fprintf(&b, "digraph %s {\n", dotQuote("blast-radius/"+name))
+	b.WriteString("  rankdir=LR;\n")
+	fmt.Fprintf(&b, "  label=%s;\n", dotQuote(fmt.Sprintf("Blast radius of %s in namespace %s", name, targetNamespace)))
+	b.WriteString("  node [fontname=\"Helvetica\"];\n")
+	for _, node := range topology.Nodes {
+		shape, ok := topologyShapes[node.Kind]
+		if !ok {
+			shape = "box3d"
+		}
+		attrs := fmt.Sprintf("label=%s, shape=%s", dotQuote(node.Kind+"\n"+node.Name), shape)
+		if node.Kind == "Pod" {
+			attrs += ", color=red, fontcolor=red"
+		}
+		fmt.Fprintf(&b, "  %s [%s];\n", dotQuote(node.ID), attrs)
+	}
+	for _, edge := range topology.Edges {
+		fmt.Fprintf(&b, "  %s -> %s;\n", dotQuote(edge.From), dotQuote(edge.To))
+	}
+	if topology.Truncated {
+		b.WriteString("  truncated [label=\"more pods not shown\", shape=plaintext];\n")
+	}
+	b.WriteString("}\n")
+
+	_, err := io.WriteString(out, b.String())
+	return err
+}
+
+// dotQuote returns s as a DOT quoted string
+func dotQuote(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	s = strings.ReplaceAll(s, `"`, `\"`)
+	return `"` + strings.ReplaceAll(s, "\n", `\n`) + `"`
+}
@@ -0,0 +1,69 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	chaosv1alpha1 "github.com/neogan74/k8s-chaos/api/v1alpha1"
+)
+
+func TestWriteTopologyDOT(t *testing.T) {
+	topology := &chaosv1alpha1.BlastRadiusTopology{
+		Nodes: []chaosv1alpha1.TopologyNode{
+			{ID: "Pod/web-1", Kind: "Pod", Name: "web-1"},
+			{ID: "Deployment/web", Kind: "Deployment", Name: "web"},
+			{ID: "Service/web", Kind: "Service", Name: "web"},
+			{ID: "Ingress/shop", Kind: "Ingress", Name: "shop"},
+		},
+		Edges: []chaosv1alpha1.TopologyEdge{
+			{From: "Pod/web-1", To: "Deployment/web"},
+			{From: "Deployment/web", To: "Service/web"},
+			{From: "Service/web", To: "Ingress/shop"},
+		},
+		Truncated: true,
+	}
+
+	var out bytes.Buffer
+	if err := writeTopologyDOT(&out, "kill-web", "shop", topology); err != nil {
+		t.Fatal(err)
+	}
+	dot := out.String()
+	for _, want := range []string{
+		`digraph "blast-radius/kill-web" {`,
+		`"Pod/web-1" [label="Pod\nweb-1", shape=box, color=red, fontcolor=red];`,
+		`"Deployment/web" [label="Deployment\nweb", shape=box3d];`,
+		`"Ingress/shop" [label="Ingress\nshop", shape=hexagon];`,
+		`"Deployment/web" -> "Service/web";`,
+		`more pods not shown`,
+	} {
+		if !strings.Contains(dot, want) {
+			t.Errorf("DOT output missing %q:\n%s", want, dot)
+		}
+	}
+	if !strings.HasSuffix(dot, "}\n") {
+		t.Errorf("DOT output not closed:\n%s", dot)
+	}
+}
+
+func TestDotQuote(t *testing.T) {
+	if got := dotQuote("a \"b\"\nc\\"); got != `"a \"b\"\nc\\"` {
+		t.Errorf("dotQuote = %s", got)
+	}
+}
@@ -0,0 +1,326 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	chaosv1alpha1 "github.com/neogan74/k8s-chaos/api/v1alpha1"
+	"github.com/neogan74/k8s-chaos/internal/historydiff"
+	"github.com/neogan74/k8s-chaos/internal/signing"
+)
+
+var historyCmd = &cobra.Command{
+	Use:   "history",
+	Short: "Work with chaos experiment history records",
+	Long: `Commands for inspecting ChaosExperimentHistory records created by the controller.
+
+Examples:
+  # Verify the signatures of all history records
+  k8s-chaos history verify -n chaos-system --secret chaos-system/history-signing-key
+
+  # Compare two runs
+  k8s-chaos history diff nginx-chaos-demo-20250101-120000-ab12 nginx-chaos-demo-20250102-120000-cd34 -n chaos-system`,
+}
+
+var historyVerifyCmd = &cobra.Command{
+	Use:   "verify [HISTORY_NAME...]",
+	Short: "Verify the signatures of history records",
+	Long: `Verify that ChaosExperimentHistory records have not been modified since the
+controller created them. Records are checked against the HMAC or ed25519 key
+stored in the given Secret; for ed25519 the public key alone is sufficient.
+
+Exits with an error if any record is unsigned or its signature does not match.
+
+Examples:
+  # Verify all history records in the history namespace
+  k8s-chaos history verify -n chaos-system --secret chaos-system/history-signing-key
+
+  # Verify specific records
+  k8s-chaos history verify nginx-chaos-demo-20250101-120000 -n chaos-system --secret chaos-system/history-signing-key
+
+  # Verify the records of every cluster in the kubeconfig
+  k8s-chaos history verify -n chaos-system --secret chaos-system/history-signing-key --all-contexts`,
+	RunE: runHistoryVerify,
+}
+
+var historyDiffCmd = &cobra.Command{
+	Use:   "diff HISTORY_A HISTORY_B",
+	Short: "Compare two history records",
+	Long: `Compare two ChaosExperimentHistory records side by side: execution outcome,
+timing, affected resources, and every experiment spec field that changed between them.
+Regressions of HISTORY_B relative to HISTORY_A are listed at the end.
+
+Examples:
+  # Compare two runs of the same experiment
+  k8s-chaos history diff nginx-chaos-demo-20250101-120000-ab12 nginx-chaos-demo-20250102-120000-cd34 -n chaos-system
+
+  # Flag recovery time regressions above 50%
+  k8s-chaos history diff run-a run-b -n chaos-system --threshold 50`,
+	Args: cobra.ExactArgs(2),
+	RunE: runHistoryDiff,
+}
+
+var (
+	signingSecret       string
+	regressionThreshold int
+)
+
+func init() {
+	historyVerifyCmd.Flags().StringVar(&signingSecret, "secret", "",
+		"secret (namespace/name) holding the signing or verification key")
+	_ = historyVerifyCmd.MarkFlagRequired("secret")
+	addAllContextsFlag(historyVerifyCmd)
+	historyDiffCmd.Flags().IntVar(&regressionThreshold, "threshold", 20,
+		"percentage increase in recovery time reported as a regression")
+	historyCmd.AddCommand(historyVerifyCmd)
+	historyCmd.AddCommand(historyDiffCmd)
+	rootCmd.AddCommand(historyCmd)
+}
+
+// verifyInput is the key and the records to verify from one cluster
+type verifyInput struct {
+	Key     *signing.Key
+	Records []chaosv1alpha1.ChaosExperimentHistory
+}
+
+func runHistoryVerify(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+
+	if namespace == "" {
+		return fmt.Errorf("namespace is required, use -n flag to specify")
+	}
+
+	secretNamespace, secretName, found := strings.Cut(signingSecret, "/")
+	if !found || secretNamespace == "" || secretName == "" {
+		return fmt.Errorf("--secret must be in namespace/name format, got %q", signingSecret)
+	}
+
+	clusters, err := getClusters()
+	if err != nil {
+		return err
+	}
+
+	results, err := successfulResults(queryClusters(ctx, clusters,
+		func(ctx context.Context, k8sClient client.Client) (verifyInput, error) {
+			input := verifyInput{}
+			secret := &corev1.Secret{}
+			if err := k8sClient.Get(ctx, client.ObjectKey{Namespace: secretNamespace, Name: secretName}, secret); err != nil {
+				return input, fmt.Errorf("failed to get secret %s: %w", signingSecret, err)
+			}
+			key, err := signing.KeyFromSecret(secret)
+			if err != nil {
+				return input, fmt.Errorf("failed to load signing key: %w", err)
+			}
+			input.Key = key
+
+			if len(args) == 0 {
+				historyList := &chaosv1alpha1.ChaosExperimentHistoryList{}
+				if err := k8sClient.List(ctx, historyList, client.InNamespace(namespace)); err != nil {
+					return input, fmt.Errorf("failed to list history records: %w", err)
+				}
+				input.Records = historyList.Items
+				return input, nil
+			}
+			for _, name := range args {
+				history := &chaosv1alpha1.ChaosExperimentHistory{}
+				if err := k8sClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, history); err != nil {
+					return input, fmt.Errorf("failed to get history record %s: %w", name, err)
+				}
+				input.Records = append(input.Records, *history)
+			}
+			return input, nil
+		}))
+	if err != nil {
+		return err
+	}
+
+	total := 0
+	for _, r := range results {
+		total += len(r.Value.Records)
+	}
+	if total == 0 {
+		fmt.Println("No history records found")
+		return nil
+	}
+
+	var failed int
+	if allContexts {
+		failed = printClusterVerifyResults(os.Stdout, results)
+	} else {
+		failed = printVerifyResults(os.Stdout, results[0].Value.Key, results[0].Value.Records)
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d of %d history records failed verification", failed, total)
+	}
+	return nil
+}
+
+// printVerifyResults writes one line per record and returns the number of records that did not verify
+func printVerifyResults(out io.Writer, key *signing.Key, records []chaosv1alpha1.ChaosExperimentHistory) int {
+	w := tabwriter.NewWriter(out, 0, 0, 3, ' ', 0)
+	_, _ = fmt.Fprintln(w, "NAME\tEXPERIMENT\tRESULT\tDETAIL")
+	failed := writeVerifyRows(w, "", key, records)
+	_ = w.Flush()
+
+	return failed
+}
+
+// printClusterVerifyResults is printVerifyResults for several clusters, with a CLUSTER column
+func printClusterVerifyResults(out io.Writer, results []clusterResult[verifyInput]) int {
+	w := tabwriter.NewWriter(out, 0, 0, 3, ' ', 0)
+	_, _ = fmt.Fprintln(w, "CLUSTER\tNAME\tEXPERIMENT\tRESULT\tDETAIL")
+	failed := 0
+	for _, r := range results {
+		failed += writeVerifyRows(w, r.Cluster+"\t", r.Value.Key, r.Value.Records)
+	}
+	_ = w.Flush()
+
+	return failed
+}
+
+// writeVerifyRows writes a verification line per record, each starting with prefix
+func writeVerifyRows(w io.Writer, prefix string, key *signing.Key, records []chaosv1alpha1.ChaosExperimentHistory) int {
+	failed := 0
+	for i := range records {
+		result, detail := verifyResult(key, &records[i])
+		if result != "valid" {
+			failed++
+		}
+		_, _ = fmt.Fprintf(w, "%s%s\t%s\t%s\t%s\n",
+			prefix,
+			records[i].Name,
+			records[i].Spec.ExperimentRef.Name,
+			result,
+			detail,
+		)
+	}
+	return failed
+}
+
+// verifyResult classifies a record as valid, unsigned or INVALID
+func verifyResult(key *signing.Key, history *chaosv1alpha1.ChaosExperimentHistory) (string, string) {
+	err := signing.Verify(key, history)
+	switch {
+	case err == nil:
+		return "valid", "-"
+	case errors.Is(err, signing.ErrNotSigned):
+		return "unsigned", err.Error()
+	default:
+		return "INVALID", err.Error()
+	}
+}
+
+func runHistoryDiff(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+
+	if namespace == "" {
+		return fmt.Errorf("namespace is required, use -n flag to specify")
+	}
+
+	k8sClient, err := getKubeClient()
+	if err != nil {
+		return fmt.Errorf("failed to get Kubernetes client: %w", err)
+	}
+
+	records := make([]*chaosv1alpha1.ChaosExperimentHistory, 0, len(args))
+	for _, name := range args {
+		history := &chaosv1alpha1.ChaosExperimentHistory{}
+		if err := k8sClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, history); err != nil {
+			return fmt.Errorf("failed to get history record %s: %w", name, err)
+		}
+		records = append(records, history)
+	}
+
+	return printHistoryDiff(os.Stdout, records[0], records[1], regressionThreshold)
+}
+
+// printHistoryDiff writes a side-by-side comparison of two history records
+func printHistoryDiff(out io.Writer, a, b *chaosv1alpha1.ChaosExperimentHistory, threshold int) error {
+	changes, err := historydiff.SpecChanges(a, b)
+	if err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(out, 0, 0, 3, ' ', 0)
+	_, _ = fmt.Fprintf(w, "FIELD\t%s\t%s\n", a.Name, b.Name)
+	rows := [][3]string{
+		{"Experiment", a.Spec.ExperimentRef.Namespace + "/" + a.Spec.ExperimentRef.Name,
+			b.Spec.ExperimentRef.Namespace + "/" + b.Spec.ExperimentRef.Name},
+		{"Status", a.Spec.Execution.Status, b.Spec.Execution.Status},
+		{"Start Time", a.Spec.Execution.StartTime.Format("2006-01-02 15:04:05"),
+			b.Spec.Execution.StartTime.Format("2006-01-02 15:04:05")},
+		{"Duration", valueOrDash(a.Spec.Execution.Duration), valueOrDash(b.Spec.Execution.Duration)},
+		{"Recovery Time", valueOrDash(a.Spec.Execution.RecoveryTime), valueOrDash(b.Spec.Execution.RecoveryTime)},
+		{"Affected Resources", fmt.Sprintf("%d", len(a.Spec.AffectedResources)),
+			fmt.Sprintf("%d", len(b.Spec.AffectedResources))},
+		{"Initiated By", valueOrDash(a.Spec.Audit.InitiatedBy), valueOrDash(b.Spec.Audit.InitiatedBy)},
+		{"Error", errorSummary(a.Spec.Error), errorSummary(b.Spec.Error)},
+	}
+	for _, row := range rows {
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\n", row[0], row[1], row[2])
+	}
+	_ = w.Flush()
+
+	_, _ = fmt.Fprintln(out)
+	if len(changes) == 0 {
+		_, _ = fmt.Fprintln(out, "Spec changes: none")
+	} else {
+		_, _ = fmt.Fprintln(out, "Spec changes:")
+		w = tabwriter.NewWriter(out, 0, 0, 3, ' ', 0)
+		for _, change := range changes {
+			_, _ = fmt.Fprintf(w, "  %s\t%s\t%s\n", change.Field, change.A, change.B)
+		}
+		_ = w.Flush()
+	}
+
+	_, _ = fmt.Fprintln(out)
+	regressions := historydiff.Regressions(a, b, threshold)
+	if len(regressions) == 0 {
+		_, _ = fmt.Fprintln(out, "Regressions: none")
+		return nil
+	}
+	_, _ = fmt.Fprintln(out, "Regressions:")
+	for _, regression := range regressions {
+		_, _ = fmt.Fprintf(out, "  - %s\n", regression)
+	}
+	return nil
+}
+
+func valueOrDash(value string) string {
+	if value == "" {
+		return "-"
+	}
+	return value
+}
+
+func errorSummary(details *chaosv1alpha1.ErrorDetails) string {
+	if details == nil {
+		return "-"
+	}
+	return details.Message
+}
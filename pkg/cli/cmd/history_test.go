@@ -0,0 +1,105 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	chaosv1alpha1 "github.com/neogan74/k8s-chaos/api/v1alpha1"
+	"github.com/neogan74/k8s-chaos/internal/signing"
+)
+
+func TestPrintVerifyResults(t *testing.T) {
+	key := &signing.Key{Algorithm: signing.AlgorithmHMAC, HMACSecret: []byte("s3cret")}
+
+	valid := chaosv1alpha1.ChaosExperimentHistory{}
+	valid.Name = "valid-record"
+	valid.Spec.ExperimentRef.Name = "exp"
+	if err := signing.Sign(key, &valid); err != nil {
+		t.Fatalf("unexpected error signing: %v", err)
+	}
+
+	tampered := *valid.DeepCopy()
+	tampered.Name = "tampered-record"
+	tampered.Spec.Execution.Status = "success"
+
+	unsigned := chaosv1alpha1.ChaosExperimentHistory{}
+	unsigned.Name = "unsigned-record"
+	unsigned.Spec.ExperimentRef.Name = "exp"
+
+	var out bytes.Buffer
+	failed := printVerifyResults(&out, key, []chaosv1alpha1.ChaosExperimentHistory{valid, tampered, unsigned})
+	if failed != 2 {
+		t.Fatalf("expected 2 failed records, got %d", failed)
+	}
+
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	if len(lines) != 4 {
+		t.Fatalf("expected header and 3 rows, got %d lines:\n%s", len(lines), out.String())
+	}
+	for i, want := range []string{"valid", "INVALID", "unsigned"} {
+		if fields := strings.Fields(lines[i+1]); fields[2] != want {
+			t.Errorf("row %d: expected result %s, got %s", i+1, want, fields[2])
+		}
+	}
+}
+
+func TestHistoryVerifyCmd_RequiresSecret(t *testing.T) {
+	flag := historyVerifyCmd.Flags().Lookup("secret")
+	if flag == nil {
+		t.Fatal("expected --secret flag on history verify")
+	}
+	if _, ok := flag.Annotations["cobra_annotation_bash_completion_one_required_flag"]; !ok {
+		t.Fatal("expected --secret to be required")
+	}
+}
+
+func TestPrintHistoryDiff(t *testing.T) {
+	a := &chaosv1alpha1.ChaosExperimentHistory{}
+	a.Name = "run-a"
+	a.Spec.ExperimentRef = chaosv1alpha1.ObjectReference{Name: "exp", Namespace: "default"}
+	a.Spec.ExperimentSpec = chaosv1alpha1.ChaosExperimentSpec{Action: "pod-kill", Count: 1}
+	a.Spec.Execution = chaosv1alpha1.ExecutionDetails{Status: "success", RecoveryTime: "10s"}
+
+	b := a.DeepCopy()
+	b.Name = "run-b"
+	b.Spec.ExperimentSpec.Count = 2
+	b.Spec.Execution.Status = "failure"
+	b.Spec.Execution.RecoveryTime = "20s"
+	b.Spec.Error = &chaosv1alpha1.ErrorDetails{Message: "timeout"}
+
+	var out bytes.Buffer
+	if err := printHistoryDiff(&out, a, b, 20); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	output := out.String()
+	for _, want := range []string{
+		"run-a", "run-b",
+		"Recovery Time",
+		"count",
+		"recoveryTime: 10s -> 20s (+100%)",
+		"status: success -> failure",
+		"timeout",
+	} {
+		if !strings.Contains(output, want) {
+			t.Errorf("expected diff output to contain %q, got:\n%s", want, output)
+		}
+	}
+}
@@ -0,0 +1,481 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	chaosv1alpha1 "github.com/neogan74/k8s-chaos/api/v1alpha1"
+	"github.com/neogan74/k8s-chaos/internal/capabilities"
+)
+
+var lintCmd = &cobra.Command{
+	Use:   "lint -f <file-or-dir>",
+	Short: "Check experiment manifests for common mistakes",
+	Long: `Check ChaosExperiment manifests before they are applied. Directories are searched
+recursively for .yaml, .yml and .json files; other kinds in the files are ignored.
+
+Without a cluster (--offline), lint reports unknown fields and actions, missing or malformed
+fields such as a duration the action needs, and production namespaces without allowProduction.
+Against the current cluster it also checks that the target namespace exists, that the selector
+matches something (suggesting close labels for typos), that the namespace labels mark it as
+production, and that count stays within maxPercentage of the eligible pods.
+
+Exits with an error when a finding has severity "error"; use -o json for CI.
+
+Examples:
+  # Lint a directory against the current cluster
+  k8s-chaos lint -f experiments/
+
+  # In CI, without cluster access
+  k8s-chaos lint -f experiments/ --offline -o json`,
+	RunE: runLint,
+}
+
+var (
+	lintFiles   []string
+	lintOffline bool
+	lintOutput  string
+)
+
+func init() {
+	lintCmd.Flags().StringSliceVarP(&lintFiles, "filename", "f", nil, "manifest file or directory to lint; repeatable")
+	lintCmd.Flags().BoolVar(&lintOffline, "offline", false, "skip the checks that need the cluster")
+	lintCmd.Flags().StringVarP(&lintOutput, "output", "o", "", "output format: json, or text when empty")
+	_ = lintCmd.MarkFlagRequired("filename")
+	rootCmd.AddCommand(lintCmd)
+}
+
+const (
+	severityError   = "error"
+	severityWarning = "warning"
+)
+
+// lintFinding is one problem in a manifest
+type lintFinding struct {
+	File string `json:"file"`
+	// Document is the 1-based index of the YAML document in the file
+	Document   int    `json:"document"`
+	Experiment string `json:"experiment,omitempty"`
+	Severity   string `json:"severity"`
+	Rule       string `json:"rule"`
+	Field      string `json:"field,omitempty"`
+	Message    string `json:"message"`
+}
+
+// lintReport is the -o json output
+type lintReport struct {
+	Experiments int           `json:"experiments"`
+	Errors      int           `json:"errors"`
+	Warnings    int           `json:"warnings"`
+	Findings    []lintFinding `json:"findings"`
+}
+
+// lintManifest is a ChaosExperiment read from a file
+type lintManifest struct {
+	File     string
+	Document int
+	Exp      *chaosv1alpha1.ChaosExperiment
+}
+
+func runLint(cmd *cobra.Command, args []string) error {
+	if lintOutput != "" && lintOutput != "json" {
+		return fmt.Errorf("unsupported output format %q", lintOutput)
+	}
+	manifests, findings, err := loadLintManifests(lintFiles)
+	if err != nil {
+		return err
+	}
+
+	l := &linter{}
+	if !lintOffline {
+		if l.client, err = getKubeClient(); err != nil {
+			return fmt.Errorf("failed to get Kubernetes client (use --offline to lint without a cluster): %w", err)
+		}
+	}
+	for _, m := range manifests {
+		findings = append(findings, l.lint(context.Background(), m)...)
+	}
+
+	report := newLintReport(len(manifests), findings)
+	if lintOutput == "json" {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(report); err != nil {
+			return err
+		}
+	} else {
+		printLintReport(os.Stdout, report)
+	}
+	if report.Errors > 0 {
+		return fmt.Errorf("lint found %d error(s)", report.Errors)
+	}
+	return nil
+}
+
+func newLintReport(experiments int, findings []lintFinding) *lintReport {
+	report := &lintReport{Experiments: experiments, Findings: findings}
+	if report.Findings == nil {
+		report.Findings = []lintFinding{}
+	}
+	for _, f := range findings {
+		if f.Severity == severityError {
+			report.Errors++
+		} else {
+			report.Warnings++
+		}
+	}
+	return report
+}
+
+func printLintReport(out io.Writer, report *lintReport) {
+	for _, f := range report.Findings {
+		location := fmt.Sprintf("%s#%d", f.File, f.Document)
+		if f.Experiment != "" {
+			location += " (" + f.Experiment + ")"
+		}
+		message := f.Message
+		if f.Field != "" {
+			message = f.Field + ": " + message
+		}
+		_, _ = fmt.Fprintf(out, "%s: %s [%s] %s\n", location, f.Severity, f.Rule, message)
+	}
+	_, _ = fmt.Fprintf(out, "%d experiment(s) checked: %d error(s), %d warning(s)\n",
+		report.Experiments, report.Errors, report.Warnings)
+}
+
+// loadLintManifests reads the ChaosExperiments of the files and directories in paths. Documents
+// that cannot be read as an experiment are returned as findings.
+func loadLintManifests(paths []string) ([]lintManifest, []lintFinding, error) {
+	var files []string
+	for _, path := range paths {
+		err := filepath.WalkDir(path, func(file string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if d.IsDir() {
+				return nil
+			}
+			// Files named explicitly are linted whatever their extension
+			switch strings.ToLower(filepath.Ext(file)) {
+			case ".yaml", ".yml", ".json":
+			default:
+				if file != path {
+					return nil
+				}
+			}
+			files = append(files, file)
+			return nil
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	var manifests []lintManifest
+	var findings []lintFinding
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return nil, nil, err
+		}
+		m, f := parseLintManifests(file, data)
+		manifests = append(manifests, m...)
+		findings = append(findings, f...)
+	}
+	return manifests, findings, nil
+}
+
+// parseLintManifests splits a file into YAML documents and decodes the ChaosExperiments among them
+func parseLintManifests(file string, data []byte) ([]lintManifest, []lintFinding) {
+	var manifests []lintManifest
+	var findings []lintFinding
+	reader := utilyaml.NewYAMLReader(bufio.NewReader(bytes.NewReader(data)))
+	for document := 1; ; document++ {
+		raw, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		finding := lintFinding{File: file, Document: document, Severity: severityError, Rule: "parse"}
+		if err != nil {
+			finding.Message = err.Error()
+			return manifests, append(findings, finding)
+		}
+		doc, err := utilyaml.ToJSON(raw)
+		if err != nil {
+			finding.Message = err.Error()
+			findings = append(findings, finding)
+			continue
+		}
+
+		var typeMeta metav1.TypeMeta
+		if string(doc) == "null" || json.Unmarshal(doc, &typeMeta) != nil {
+			continue
+		}
+		if typeMeta.Kind != "ChaosExperiment" || !strings.HasPrefix(typeMeta.APIVersion, chaosv1alpha1.GroupVersion.Group+"/") {
+			continue
+		}
+
+		exp := &chaosv1alpha1.ChaosExperiment{}
+		decoder := json.NewDecoder(bytes.NewReader(doc))
+		decoder.DisallowUnknownFields()
+		if err := decoder.Decode(exp); err != nil {
+			// Report the unknown field or wrong type, then lint what can be decoded
+			exp = &chaosv1alpha1.ChaosExperiment{}
+			if lenient := json.Unmarshal(doc, exp); lenient != nil {
+				finding.Message = lenient.Error()
+				findings = append(findings, finding)
+				continue
+			}
+			finding.Experiment = exp.Name
+			finding.Rule = "schema"
+			finding.Message = err.Error()
+			findings = append(findings, finding)
+		}
+		manifests = append(manifests, lintManifest{File: file, Document: document, Exp: exp})
+	}
+	return manifests, findings
+}
+
+// linter checks experiments; the cluster checks are skipped when client is nil
+type linter struct {
+	client client.Client
+}
+
+func (l *linter) lint(ctx context.Context, m lintManifest) []lintFinding {
+	var findings []lintFinding
+	add := func(severity, rule, field, format string, args ...any) {
+		findings = append(findings, lintFinding{
+			File: m.File, Document: m.Document, Experiment: m.Exp.Name,
+			Severity: severity, Rule: rule, Field: field, Message: fmt.Sprintf(format, args...),
+		})
+	}
+	spec := &m.Exp.Spec
+
+	if m.Exp.Name == "" && m.Exp.GenerateName == "" {
+		add(severityError, "spec", "metadata.name", "name is required")
+	}
+	described, err := capabilities.Describe([]string{spec.Action})
+	if err != nil {
+		add(severityError, "action", "spec.action", "unknown action %q; see k8s-chaos actions", spec.Action)
+	}
+	if spec.Namespace == "" {
+		add(severityError, "spec", "spec.namespace", "namespace is required")
+	}
+	for _, e := range chaosv1alpha1.ValidateSpecStructure(m.Exp.Name, spec) {
+		add(severityError, "spec", e.Field, "%s", e.Message)
+	}
+	if l.client == nil {
+		if spec.Namespace != "" && !spec.AllowProduction && chaosv1alpha1.IsProductionNamespace(spec.Namespace, nil) {
+			add(severityError, "production", "spec.allowProduction",
+				"namespace %q looks like production; set allowProduction: true to target it", spec.Namespace)
+		}
+		return findings
+	}
+	if spec.Namespace == "" || len(described) == 0 {
+		return findings
+	}
+
+	ns := &corev1.Namespace{}
+	if err := l.client.Get(ctx, client.ObjectKey{Name: spec.Namespace}, ns); err != nil {
+		if apierrors.IsNotFound(err) {
+			add(severityError, "namespace", "spec.namespace", "namespace %q does not exist", spec.Namespace)
+			return findings
+		}
+		add(severityWarning, "namespace", "spec.namespace", "cannot check namespace %q: %v", spec.Namespace, err)
+		ns = nil
+	}
+	if !spec.AllowProduction && chaosv1alpha1.IsProductionNamespace(spec.Namespace, ns) {
+		add(severityError, "production", "spec.allowProduction",
+			"namespace %q is marked as production; set allowProduction: true to target it", spec.Namespace)
+	}
+
+	matched, eligible, err := l.selectorTargets(ctx, described[0].Target, spec)
+	if err != nil {
+		add(severityWarning, "selector", "spec.selector", "cannot check the selector: %v", err)
+		return findings
+	}
+	if len(matched) == 0 {
+		resource := "pods in namespace " + spec.Namespace
+		if described[0].Target == capabilities.TargetNode {
+			resource = "nodes"
+		}
+		message := fmt.Sprintf("selector %s matches no %s", labels.SelectorFromSet(spec.Selector), resource)
+		if hint := selectorHint(spec.Selector, eligible); hint != "" {
+			message += "; " + hint
+		}
+		add(severityError, "selector", "spec.selector", "%s", message)
+		return findings
+	}
+	if described[0].Target == capabilities.TargetNode {
+		return findings
+	}
+
+	// For pods, eligible holds the matched pods without the exclusion label
+	if len(eligible) == 0 {
+		add(severityError, "selector", "spec.selector", "all %d matching pods are excluded via the %s label",
+			len(matched), chaosv1alpha1.ExclusionLabel)
+		return findings
+	}
+	if err := chaosv1alpha1.CheckMaxPercentage(spec.Count, spec.MaxPercentage, len(eligible)); err != nil {
+		add(severityError, "max-percentage", "spec.count", "%v", err)
+	} else if spec.Count > len(eligible) {
+		add(severityWarning, "max-percentage", "spec.count", "count %d exceeds the %d eligible pods; only %d will be affected",
+			spec.Count, len(eligible), len(eligible))
+	}
+	return findings
+}
+
+// selectorTargets returns the labels of the resources the selector matches and of the eligible
+// ones among them. When nothing matches, the second result holds the labels of every resource
+// in scope instead, to look for typos.
+func (l *linter) selectorTargets(ctx context.Context, target string, spec *chaosv1alpha1.ChaosExperimentSpec) ([]map[string]string, []map[string]string, error) {
+	var all []map[string]string
+	if target == capabilities.TargetNode {
+		nodes := &corev1.NodeList{}
+		if err := l.client.List(ctx, nodes); err != nil {
+			return nil, nil, err
+		}
+		for _, node := range nodes.Items {
+			all = append(all, node.Labels)
+		}
+	} else {
+		pods := &corev1.PodList{}
+		if err := l.client.List(ctx, pods, client.InNamespace(spec.Namespace)); err != nil {
+			return nil, nil, err
+		}
+		for _, pod := range pods.Items {
+			all = append(all, pod.Labels)
+		}
+	}
+
+	selector := labels.SelectorFromSet(spec.Selector)
+	var matched, eligible []map[string]string
+	for _, set := range all {
+		if !selector.Matches(labels.Set(set)) {
+			continue
+		}
+		matched = append(matched, set)
+		if set[chaosv1alpha1.ExclusionLabel] != "true" || target == capabilities.TargetNode {
+			eligible = append(eligible, set)
+		}
+	}
+	if len(matched) == 0 {
+		return nil, all, nil
+	}
+	return matched, eligible, nil
+}
+
+// maxLabelDistance is how many edits apart a label may be from a selector term to be suggested
+const maxLabelDistance = 2
+
+// selectorHint explains which term of a selector that matches nothing is likely wrong, suggesting
+// the closest existing label key or value
+func selectorHint(selector map[string]string, candidates []map[string]string) string {
+	values := map[string]map[string]bool{}
+	for _, set := range candidates {
+		for k, v := range set {
+			if values[k] == nil {
+				values[k] = map[string]bool{}
+			}
+			values[k][v] = true
+		}
+	}
+
+	keys := make([]string, 0, len(selector))
+	for k := range selector {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		v := selector[k]
+		known, ok := values[k]
+		if !ok {
+			if closest := closestLabel(k, sortedSet(values)); closest != "" {
+				return fmt.Sprintf("no label %q, did you mean %q?", k, closest)
+			}
+			return fmt.Sprintf("no label %q", k)
+		}
+		if !known[v] {
+			if closest := closestLabel(v, sortedSet(known)); closest != "" {
+				return fmt.Sprintf("no %s=%s, did you mean %s=%s?", k, v, k, closest)
+			}
+			return fmt.Sprintf("no %s=%s", k, v)
+		}
+	}
+	return ""
+}
+
+func sortedSet[V any](set map[string]V) []string {
+	keys := make([]string, 0, len(set))
+	for k := range set {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// closestLabel returns the candidate closest to s within maxLabelDistance, or ""
+func closestLabel(s string, candidates []string) string {
+	best, bestDistance := "", maxLabelDistance+1
+	for _, c := range candidates {
+		if d := editDistance(strings.ToLower(s), strings.ToLower(c)); d < bestDistance {
+			best, bestDistance = c, d
+		}
+	}
+	return best
+}
+
+// editDistance is the Levenshtein distance between a and b
+func editDistance(a, b string) int {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(b)]
+}
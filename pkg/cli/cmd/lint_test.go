@@ -0,0 +1,196 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+)
+
+const lintManifestsYAML = `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: unrelated
+---
+apiVersion: chaos.gushchin.dev/v1alpha1
+kind: ChaosExperiment
+metadata:
+  name: delay
+spec:
+  action: pod-delay
+  namespace: shop
+  selector:
+    app: web
+---
+apiVersion: chaos.gushchin.dev/v1alpha1
+kind: ChaosExperiment
+metadata:
+  name: kill
+spec:
+  action: pod-kill
+  namespace: shop
+  selector:
+    app: web
+  cuont: 2
+`
+
+func lintRules(findings []lintFinding) []string {
+	var rules []string
+	for _, f := range findings {
+		rules = append(rules, f.Experiment+":"+f.Rule)
+	}
+	return rules
+}
+
+func TestLoadLintManifests(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "experiments.yaml"), []byte(lintManifestsYAML), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "README.md"), []byte("# not a manifest"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	manifests, findings, err := loadLintManifests([]string{dir})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(manifests) != 2 {
+		t.Fatalf("expected the 2 experiments, got %d", len(manifests))
+	}
+	if manifests[0].Document != 2 || manifests[1].Exp.Spec.Action != "pod-kill" {
+		t.Errorf("unexpected manifests: %+v", manifests)
+	}
+	// The misspelled field is reported, and the experiment is still linted
+	if len(findings) != 1 || findings[0].Rule != "schema" || !strings.Contains(findings[0].Message, "cuont") {
+		t.Fatalf("expected a schema finding for the unknown field, got %+v", findings)
+	}
+}
+
+func TestLint_Offline(t *testing.T) {
+	manifests, _ := parseLintManifests("experiments.yaml", []byte(lintManifestsYAML+`---
+apiVersion: chaos.gushchin.dev/v1alpha1
+kind: ChaosExperiment
+metadata:
+  name: prod
+spec:
+  action: pod-explode
+  namespace: shop-prod
+  selector:
+    app: web
+`))
+
+	l := &linter{}
+	var findings []lintFinding
+	for _, m := range manifests {
+		findings = append(findings, l.lint(context.Background(), m)...)
+	}
+
+	got := strings.Join(lintRules(findings), ",")
+	if got != "delay:spec,prod:action,prod:production" {
+		t.Fatalf("unexpected findings: %s", got)
+	}
+	if !strings.Contains(findings[0].Message, "duration is required") {
+		t.Errorf("expected the missing duration, got %q", findings[0].Message)
+	}
+}
+
+func lintTestPod(name string, podLabels map[string]string) *corev1.Pod {
+	return &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "shop", Labels: podLabels}}
+}
+
+func TestLint_Cluster(t *testing.T) {
+	objs := []client.Object{
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "shop"}},
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "payments", Labels: map[string]string{"environment": "production"}}},
+		lintTestPod("web-1", map[string]string{"app": "web"}),
+		lintTestPod("web-2", map[string]string{"app": "web"}),
+		lintTestPod("web-3", map[string]string{"app": "web", "chaos.gushchin.dev/exclude": "true"}),
+	}
+	l := &linter{client: newDiagnoseClient(t, interceptor.Funcs{}, objs...)}
+
+	tests := []struct {
+		name     string
+		spec     string
+		rules    string
+		contains string
+	}{
+		{"ok", "namespace: shop\n  selector:\n    app: web", "", ""},
+		{"selector typo", "namespace: shop\n  selector:\n    app: wbe", "exp:selector", "did you mean app=web?"},
+		{"key typo", "namespace: shop\n  selector:\n    ap: web", "exp:selector", `did you mean "app"?`},
+		{"missing namespace", "namespace: shope\n  selector:\n    app: web", "exp:namespace", "does not exist"},
+		{"production label", "namespace: payments\n  selector:\n    app: web", "exp:production,exp:selector", "marked as production"},
+		{"max percentage", "namespace: shop\n  count: 2\n  maxPercentage: 50\n  selector:\n    app: web", "exp:max-percentage", "exceeding maxPercentage"},
+		{"count above eligible", "namespace: shop\n  count: 3\n  selector:\n    app: web", "exp:max-percentage", "only 2 will be affected"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			manifest := "apiVersion: chaos.gushchin.dev/v1alpha1\nkind: ChaosExperiment\nmetadata:\n  name: exp\nspec:\n  action: pod-kill\n  " + tt.spec + "\n"
+			manifests, findings := parseLintManifests("exp.yaml", []byte(manifest))
+			if len(manifests) != 1 || len(findings) != 0 {
+				t.Fatalf("failed to parse: %+v", findings)
+			}
+
+			findings = l.lint(context.Background(), manifests[0])
+			if got := strings.Join(lintRules(findings), ","); got != tt.rules {
+				t.Fatalf("expected findings %q, got %q: %+v", tt.rules, got, findings)
+			}
+			if tt.contains != "" && !strings.Contains(findings[0].Message, tt.contains) {
+				t.Errorf("expected %q in %q", tt.contains, findings[0].Message)
+			}
+		})
+	}
+}
+
+func TestPrintLintReport(t *testing.T) {
+	report := newLintReport(2, []lintFinding{
+		{File: "a.yaml", Document: 2, Experiment: "kill", Severity: severityError, Rule: "spec", Field: "spec.duration", Message: "invalid"},
+		{File: "a.yaml", Document: 3, Severity: severityWarning, Rule: "max-percentage", Message: "too many"},
+	})
+	if report.Errors != 1 || report.Warnings != 1 {
+		t.Fatalf("unexpected counts: %+v", report)
+	}
+
+	var out strings.Builder
+	printLintReport(&out, report)
+	expected := `a.yaml#2 (kill): error [spec] spec.duration: invalid
+a.yaml#3: warning [max-percentage] too many
+2 experiment(s) checked: 1 error(s), 1 warning(s)
+`
+	if out.String() != expected {
+		t.Errorf("unexpected output:\n%s", out.String())
+	}
+}
+
+func TestEditDistance(t *testing.T) {
+	for _, tt := range []struct {
+		a, b string
+		want int
+	}{{"app", "app", 0}, {"ap", "app", 1}, {"wbe", "web", 2}, {"", "abc", 3}, {"kitten", "sitting", 3}} {
+		if got := editDistance(tt.a, tt.b); got != tt.want {
+			t.Errorf("editDistance(%q, %q) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
@@ -19,6 +19,7 @@ package cmd
 import (
 	"context"
 	"fmt"
+	"io"
 	"os"
 	"strings"
 	"text/tabwriter"
@@ -43,7 +44,10 @@ Examples:
   k8s-chaos list -n chaos-testing
 
   # List with wide output showing more details
-  k8s-chaos list --wide`,
+  k8s-chaos list --wide
+
+  # List experiments of every cluster in the kubeconfig
+  k8s-chaos list --all-contexts`,
 	Aliases: []string{"ls"},
 	RunE:    runList,
 }
@@ -52,76 +56,106 @@ var wideOutput bool
 
 func init() {
 	listCmd.Flags().BoolVarP(&wideOutput, "wide", "w", false, "show more details in output")
+	addAllContextsFlag(listCmd)
 	rootCmd.AddCommand(listCmd)
 }
 
 func runList(cmd *cobra.Command, args []string) error {
 	ctx := context.Background()
 
-	k8sClient, err := getKubeClient()
+	clusters, err := getClusters()
 	if err != nil {
-		return fmt.Errorf("failed to get Kubernetes client: %w", err)
+		return err
 	}
 
-	expList := &chaosv1alpha1.ChaosExperimentList{}
 	listOpts := []client.ListOption{}
 	if namespace != "" {
 		listOpts = append(listOpts, client.InNamespace(namespace))
 	}
 
-	if err := k8sClient.List(ctx, expList, listOpts...); err != nil {
-		return fmt.Errorf("failed to list chaos experiments: %w", err)
+	results, err := successfulResults(queryClusters(ctx, clusters,
+		func(ctx context.Context, c client.Client) ([]chaosv1alpha1.ChaosExperiment, error) {
+			expList := &chaosv1alpha1.ChaosExperimentList{}
+			if err := c.List(ctx, expList, listOpts...); err != nil {
+				return nil, fmt.Errorf("failed to list chaos experiments: %w", err)
+			}
+			return expList.Items, nil
+		}))
+	if err != nil {
+		return err
 	}
 
-	if len(expList.Items) == 0 {
-		fmt.Println("No chaos experiments found")
-		return nil
+	printExperimentList(os.Stdout, results, wideOutput, allContexts)
+	return nil
+}
+
+// printExperimentList prints the experiments of all clusters as one table, with a leading
+// CLUSTER column when showCluster is set
+func printExperimentList(out io.Writer, results []clusterResult[[]chaosv1alpha1.ChaosExperiment], wide, showCluster bool) {
+	total := 0
+	for _, r := range results {
+		total += len(r.Value)
+	}
+	if total == 0 {
+		_, _ = fmt.Fprintln(out, "No chaos experiments found")
+		return
 	}
 
 	// Print table header and experiments
-	w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+	w := tabwriter.NewWriter(out, 0, 0, 3, ' ', 0)
 
-	if wideOutput {
-		_, _ = fmt.Fprintln(w, "NAMESPACE\tNAME\tACTION\tTARGET-NS\tSELECTOR\tCOUNT\tPHASE\tRETRIES\tDURATION\tAGE")
+	clusterHeader := ""
+	if showCluster {
+		clusterHeader = "CLUSTER\t"
+	}
+	if wide {
+		_, _ = fmt.Fprintln(w, clusterHeader+"NAMESPACE\tNAME\tACTION\tTARGET-NS\tSELECTOR\tCOUNT\tPHASE\tRETRIES\tDURATION\tAGE")
 	} else {
-		_, _ = fmt.Fprintln(w, "NAMESPACE\tNAME\tACTION\tTARGET-NS\tPHASE\tAGE")
+		_, _ = fmt.Fprintln(w, clusterHeader+"NAMESPACE\tNAME\tACTION\tTARGET-NS\tPHASE\tAGE")
 	}
 
-	for _, exp := range expList.Items {
-		age := formatAge(exp.CreationTimestamp.Time)
-		selector := formatSelector(exp.Spec.Selector)
-
-		if wideOutput {
-			duration := exp.Spec.ExperimentDuration
-			if duration == "" {
-				duration = "∞"
+	for _, r := range results {
+		clusterColumn := ""
+		if showCluster {
+			clusterColumn = r.Cluster + "\t"
+		}
+		for _, exp := range r.Value {
+			age := formatAge(exp.CreationTimestamp.Time)
+			selector := formatSelector(exp.Spec.Selector)
+
+			if wide {
+				duration := exp.Spec.ExperimentDuration
+				if duration == "" {
+					duration = "∞"
+				}
+				_, _ = fmt.Fprintf(w, "%s%s\t%s\t%s\t%s\t%s\t%d\t%s\t%d\t%s\t%s\n",
+					clusterColumn,
+					exp.Namespace,
+					exp.Name,
+					exp.Spec.Action,
+					exp.Spec.Namespace,
+					selector,
+					exp.Spec.Count,
+					exp.Status.Phase,
+					exp.Status.RetryCount,
+					duration,
+					age,
+				)
+			} else {
+				_, _ = fmt.Fprintf(w, "%s%s\t%s\t%s\t%s\t%s\t%s\n",
+					clusterColumn,
+					exp.Namespace,
+					exp.Name,
+					exp.Spec.Action,
+					exp.Spec.Namespace,
+					exp.Status.Phase,
+					age,
+				)
 			}
-			_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%d\t%s\t%d\t%s\t%s\n",
-				exp.Namespace,
-				exp.Name,
-				exp.Spec.Action,
-				exp.Spec.Namespace,
-				selector,
-				exp.Spec.Count,
-				exp.Status.Phase,
-				exp.Status.RetryCount,
-				duration,
-				age,
-			)
-		} else {
-			_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n",
-				exp.Namespace,
-				exp.Name,
-				exp.Spec.Action,
-				exp.Spec.Namespace,
-				exp.Status.Phase,
-				age,
-			)
 		}
 	}
 
 	_ = w.Flush()
-	return nil
 }
 
 // formatAge formats a time.Time to a human-readable age string
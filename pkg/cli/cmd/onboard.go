@@ -0,0 +1,325 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+
+	"github.com/spf13/cobra"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	chaosv1alpha1 "github.com/neogan74/k8s-chaos/api/v1alpha1"
+)
+
+// teamLabel marks the objects onboard creates with the team they were created for
+const teamLabel = "chaos.gushchin.dev/team"
+
+// criticalPriorityClasses mark pods Kubernetes itself considers critical
+var criticalPriorityClasses = map[string]bool{"system-cluster-critical": true, "system-node-critical": true}
+
+var onboardCmd = &cobra.Command{
+	Use:   "onboard NAMESPACE --team TEAM",
+	Short: "Set up a namespace for a team's chaos experiments",
+	Long: `Prepare a namespace for chaos experiments of a team:
+
+  - a ChaosPolicy, named after the namespace, that rate limits injections and requires an
+    approval for high severity experiments
+  - a Role and RoleBinding letting the team's group manage experiments and read their history
+  - the chaos.gushchin.dev/exclude label on the pod template of critical workloads: those
+    running with a system-*-critical priority class or matching an --exclude selector.
+    Their pods restart to pick up the label.
+  - a starter pod-kill experiment in dry-run mode against one of the other Deployments
+
+Objects that already exist are left unchanged, so onboard can be run again after adding
+workloads. Use --dry-run to have the API server validate everything without persisting it.
+
+Examples:
+  # Onboard the payments team
+  k8s-chaos onboard payments --team payments
+
+  # Bind an identity provider group and protect the message broker too
+  k8s-chaos onboard payments --team payments --group oidc:payments-oncall \
+    --exclude app.kubernetes.io/name=rabbitmq`,
+	Args: cobra.ExactArgs(1),
+	RunE: runOnboard,
+}
+
+// onboardOptions configure what onboard creates
+type onboardOptions struct {
+	Team          string
+	Group         string
+	Exclude       []string
+	MaxInjections int
+	DryRun        bool
+}
+
+var onboardOpts onboardOptions
+
+func init() {
+	onboardCmd.Flags().StringVar(&onboardOpts.Team, "team", "", "team the namespace is onboarded for (required)")
+	onboardCmd.Flags().StringVar(&onboardOpts.Group, "group", "", "group bound to the team's Role (default: the team name)")
+	onboardCmd.Flags().StringArrayVar(&onboardOpts.Exclude, "exclude",
+		[]string{"app.kubernetes.io/component in (database,datastore,queue)"},
+		"label selector of critical workloads to exclude from chaos; repeatable")
+	onboardCmd.Flags().IntVar(&onboardOpts.MaxInjections, "max-injections", 6, "injection rounds per hour the ChaosPolicy allows")
+	onboardCmd.Flags().BoolVar(&onboardOpts.DryRun, "dry-run", false, "validate the changes on the server without persisting them")
+	_ = onboardCmd.MarkFlagRequired("team")
+	rootCmd.AddCommand(onboardCmd)
+}
+
+func runOnboard(cmd *cobra.Command, args []string) error {
+	k8sClient, err := getKubeClient()
+	if err != nil {
+		return fmt.Errorf("failed to get Kubernetes client: %w", err)
+	}
+	return onboard(context.Background(), k8sClient, os.Stdout, args[0], onboardOpts)
+}
+
+// onboard sets up ns for opts.Team, reporting every step to out
+func onboard(ctx context.Context, c client.Client, out io.Writer, ns string, opts onboardOptions) error {
+	if opts.Team == "" {
+		return fmt.Errorf("--team is required")
+	}
+	if opts.Group == "" {
+		opts.Group = opts.Team
+	}
+	selectors := make([]labels.Selector, 0, len(opts.Exclude))
+	for _, s := range opts.Exclude {
+		selector, err := labels.Parse(s)
+		if err != nil {
+			return fmt.Errorf("invalid --exclude selector %q: %w", s, err)
+		}
+		selectors = append(selectors, selector)
+	}
+	if err := c.Get(ctx, client.ObjectKey{Name: ns}, &corev1.Namespace{}); err != nil {
+		return fmt.Errorf("failed to get namespace %s: %w", ns, err)
+	}
+
+	var createOpts []client.CreateOption
+	var patchOpts []client.PatchOption
+	suffix := ""
+	if opts.DryRun {
+		createOpts = append(createOpts, client.DryRunAll)
+		patchOpts = append(patchOpts, client.DryRunAll)
+		suffix = " (dry run)"
+	}
+	create := func(kind string, obj client.Object) error {
+		err := c.Create(ctx, obj, createOpts...)
+		switch {
+		case apierrors.IsAlreadyExists(err):
+			_, _ = fmt.Fprintf(out, "%s/%s already exists, left unchanged\n", kind, obj.GetName())
+		case err != nil:
+			return fmt.Errorf("failed to create %s %s: %w", kind, obj.GetName(), err)
+		default:
+			_, _ = fmt.Fprintf(out, "%s/%s created%s\n", kind, obj.GetName(), suffix)
+		}
+		return nil
+	}
+
+	for _, step := range []struct {
+		kind string
+		obj  client.Object
+	}{
+		{"chaospolicy", onboardPolicy(ns, opts)},
+		{"role", onboardRole(ns, opts)},
+		{"rolebinding", onboardRoleBinding(ns, opts)},
+	} {
+		if err := create(step.kind, step.obj); err != nil {
+			return err
+		}
+	}
+
+	workloads, err := listPodWorkloads(ctx, c, ns)
+	if err != nil {
+		return err
+	}
+	var starter *metav1.LabelSelector
+	for _, w := range workloads {
+		if !isCriticalWorkload(w.template, selectors) {
+			if starter == nil && w.kind == "deployment" && w.selector != nil && len(w.selector.MatchLabels) > 0 {
+				starter = w.selector
+			}
+			continue
+		}
+		if w.template.Labels[chaosv1alpha1.ExclusionLabel] == "true" {
+			_, _ = fmt.Fprintf(out, "%s/%s already excluded\n", w.kind, w.obj.GetName())
+			continue
+		}
+		patch := client.MergeFrom(w.obj.DeepCopyObject().(client.Object))
+		if w.template.Labels == nil {
+			w.template.Labels = map[string]string{}
+		}
+		w.template.Labels[chaosv1alpha1.ExclusionLabel] = "true"
+		if err := c.Patch(ctx, w.obj, patch, patchOpts...); err != nil {
+			return fmt.Errorf("failed to exclude %s %s: %w", w.kind, w.obj.GetName(), err)
+		}
+		_, _ = fmt.Fprintf(out, "%s/%s excluded from chaos, its pods restart%s\n", w.kind, w.obj.GetName(), suffix)
+	}
+
+	if starter == nil {
+		_, _ = fmt.Fprintf(out, "No Deployment left to target, skipped the starter experiment\n")
+		return nil
+	}
+	return create("chaosexperiment", onboardStarterExperiment(ns, opts, starter.MatchLabels))
+}
+
+// onboardLabels are the labels of the objects onboard creates
+func onboardLabels(opts onboardOptions) map[string]string {
+	return map[string]string{
+		"app.kubernetes.io/managed-by": "k8s-chaos-cli",
+		teamLabel:                      opts.Team,
+	}
+}
+
+// onboardPolicy rate limits injections into ns and holds high severity experiments for an approval
+func onboardPolicy(ns string, opts onboardOptions) *chaosv1alpha1.ChaosPolicy {
+	return &chaosv1alpha1.ChaosPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: ns, Labels: onboardLabels(opts)},
+		Spec: chaosv1alpha1.ChaosPolicySpec{
+			Namespaces: []string{ns},
+			RateLimit: &chaosv1alpha1.ChaosRateLimit{
+				MaxInjections: opts.MaxInjections,
+				Window:        "1h",
+				MinInterval:   "10m",
+			},
+			SeverityRules: []chaosv1alpha1.SeverityRule{
+				{Severity: chaosv1alpha1.SeverityHigh, RequireApproval: true},
+			},
+		},
+	}
+}
+
+// onboardRoleName names the Role and RoleBinding of a team
+func onboardRoleName(opts onboardOptions) string {
+	return "k8s-chaos-" + opts.Team
+}
+
+// onboardRole lets the team manage experiments in ns, read their history and preview their targets
+func onboardRole(ns string, opts onboardOptions) *rbacv1.Role {
+	return &rbacv1.Role{
+		ObjectMeta: metav1.ObjectMeta{Name: onboardRoleName(opts), Namespace: ns, Labels: onboardLabels(opts)},
+		Rules: []rbacv1.PolicyRule{
+			{
+				APIGroups: []string{chaosv1alpha1.GroupVersion.Group},
+				Resources: []string{"chaosexperiments"},
+				Verbs:     []string{"get", "list", "watch", "create", "update", "patch", "delete"},
+			},
+			{
+				APIGroups: []string{chaosv1alpha1.GroupVersion.Group},
+				Resources: []string{"chaosexperimenthistories"},
+				Verbs:     []string{"get", "list", "watch"},
+			},
+			{
+				APIGroups: []string{""},
+				Resources: []string{"pods", "events"},
+				Verbs:     []string{"get", "list", "watch"},
+			},
+		},
+	}
+}
+
+// onboardRoleBinding grants the team's Role to its group
+func onboardRoleBinding(ns string, opts onboardOptions) *rbacv1.RoleBinding {
+	return &rbacv1.RoleBinding{
+		ObjectMeta: metav1.ObjectMeta{Name: onboardRoleName(opts), Namespace: ns, Labels: onboardLabels(opts)},
+		Subjects:   []rbacv1.Subject{{Kind: rbacv1.GroupKind, APIGroup: rbacv1.GroupName, Name: opts.Group}},
+		RoleRef:    rbacv1.RoleRef{APIGroup: rbacv1.GroupName, Kind: "Role", Name: onboardRoleName(opts)},
+	}
+}
+
+// onboardStarterExperiment is a low severity pod-kill dry run the team can inspect and adapt
+func onboardStarterExperiment(ns string, opts onboardOptions, selector map[string]string) *chaosv1alpha1.ChaosExperiment {
+	return &chaosv1alpha1.ChaosExperiment{
+		ObjectMeta: metav1.ObjectMeta{Name: opts.Team + "-starter", Namespace: ns, Labels: onboardLabels(opts)},
+		Spec: chaosv1alpha1.ChaosExperimentSpec{
+			Action:    "pod-kill",
+			Namespace: ns,
+			Selector:  selector,
+			Count:     1,
+			Severity:  chaosv1alpha1.SeverityLow,
+			DryRun:    true,
+		},
+	}
+}
+
+// podWorkload is a workload whose pod template onboard may label
+type podWorkload struct {
+	kind     string
+	obj      client.Object
+	selector *metav1.LabelSelector
+	template *corev1.PodTemplateSpec
+}
+
+// listPodWorkloads returns the Deployments, StatefulSets and DaemonSets of ns, sorted by kind and name
+func listPodWorkloads(ctx context.Context, c client.Client, ns string) ([]podWorkload, error) {
+	var workloads []podWorkload
+	deployments := &appsv1.DeploymentList{}
+	if err := c.List(ctx, deployments, client.InNamespace(ns)); err != nil {
+		return nil, fmt.Errorf("failed to list deployments: %w", err)
+	}
+	for i := range deployments.Items {
+		d := &deployments.Items[i]
+		workloads = append(workloads, podWorkload{"deployment", d, d.Spec.Selector, &d.Spec.Template})
+	}
+	statefulSets := &appsv1.StatefulSetList{}
+	if err := c.List(ctx, statefulSets, client.InNamespace(ns)); err != nil {
+		return nil, fmt.Errorf("failed to list statefulsets: %w", err)
+	}
+	for i := range statefulSets.Items {
+		s := &statefulSets.Items[i]
+		workloads = append(workloads, podWorkload{"statefulset", s, s.Spec.Selector, &s.Spec.Template})
+	}
+	daemonSets := &appsv1.DaemonSetList{}
+	if err := c.List(ctx, daemonSets, client.InNamespace(ns)); err != nil {
+		return nil, fmt.Errorf("failed to list daemonsets: %w", err)
+	}
+	for i := range daemonSets.Items {
+		d := &daemonSets.Items[i]
+		workloads = append(workloads, podWorkload{"daemonset", d, d.Spec.Selector, &d.Spec.Template})
+	}
+	sort.SliceStable(workloads, func(i, j int) bool {
+		if workloads[i].kind != workloads[j].kind {
+			return workloads[i].kind < workloads[j].kind
+		}
+		return workloads[i].obj.GetName() < workloads[j].obj.GetName()
+	})
+	return workloads, nil
+}
+
+// isCriticalWorkload reports whether a pod template runs a critical component: one with a
+// system-*-critical priority class or labels matching any of selectors
+func isCriticalWorkload(template *corev1.PodTemplateSpec, selectors []labels.Selector) bool {
+	if criticalPriorityClasses[template.Spec.PriorityClassName] {
+		return true
+	}
+	for _, selector := range selectors {
+		if selector.Matches(labels.Set(template.Labels)) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,141 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	chaosv1alpha1 "github.com/neogan74/k8s-chaos/api/v1alpha1"
+)
+
+func onboardDeployment(name string, podLabels map[string]string, priorityClass string) *appsv1.Deployment {
+	return &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "payments"},
+		Spec: appsv1.DeploymentSpec{
+			Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": name}},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: podLabels},
+				Spec:       corev1.PodSpec{PriorityClassName: priorityClass},
+			},
+		},
+	}
+}
+
+func TestOnboard(t *testing.T) {
+	ctx := context.Background()
+	c := newDiagnoseClient(t, interceptor.Funcs{},
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "payments"}},
+		onboardDeployment("api", map[string]string{"app": "api"}, ""),
+		onboardDeployment("postgres", map[string]string{"app": "postgres", "app.kubernetes.io/component": "database"}, ""),
+		onboardDeployment("agent", map[string]string{"app": "agent"}, "system-node-critical"),
+	)
+	opts := onboardOptions{
+		Team:          "payments",
+		Exclude:       []string{"app.kubernetes.io/component in (database,queue)"},
+		MaxInjections: 6,
+	}
+
+	var out bytes.Buffer
+	if err := onboard(ctx, c, &out, "payments", opts); err != nil {
+		t.Fatal(err)
+	}
+	want := `chaospolicy/payments created
+role/k8s-chaos-payments created
+rolebinding/k8s-chaos-payments created
+deployment/agent excluded from chaos, its pods restart
+deployment/postgres excluded from chaos, its pods restart
+chaosexperiment/payments-starter created
+`
+	if out.String() != want {
+		t.Errorf("output:\n%s\nwant:\n%s", out.String(), want)
+	}
+
+	policy := &chaosv1alpha1.ChaosPolicy{}
+	if err := c.Get(ctx, client.ObjectKey{Name: "payments"}, policy); err != nil {
+		t.Fatal(err)
+	}
+	if !policy.AppliesTo("payments") || policy.AppliesTo("shop") || policy.Spec.RateLimit.MaxInjections != 6 {
+		t.Errorf("policy spec = %+v", policy.Spec)
+	}
+	binding := &rbacv1.RoleBinding{}
+	if err := c.Get(ctx, client.ObjectKey{Namespace: "payments", Name: "k8s-chaos-payments"}, binding); err != nil {
+		t.Fatal(err)
+	}
+	if binding.Subjects[0].Kind != rbacv1.GroupKind || binding.Subjects[0].Name != "payments" {
+		t.Errorf("binding subjects = %+v", binding.Subjects)
+	}
+
+	for name, excluded := range map[string]bool{"api": false, "postgres": true, "agent": true} {
+		d := &appsv1.Deployment{}
+		if err := c.Get(ctx, client.ObjectKey{Namespace: "payments", Name: name}, d); err != nil {
+			t.Fatal(err)
+		}
+		if got := d.Spec.Template.Labels[chaosv1alpha1.ExclusionLabel] == "true"; got != excluded {
+			t.Errorf("deployment %s excluded = %v, want %v", name, got, excluded)
+		}
+	}
+
+	exp := &chaosv1alpha1.ChaosExperiment{}
+	if err := c.Get(ctx, client.ObjectKey{Namespace: "payments", Name: "payments-starter"}, exp); err != nil {
+		t.Fatal(err)
+	}
+	if !exp.Spec.DryRun || exp.Spec.Selector["app"] != "api" || exp.Spec.Severity != chaosv1alpha1.SeverityLow {
+		t.Errorf("starter experiment spec = %+v", exp.Spec)
+	}
+
+	// Running it again changes nothing
+	out.Reset()
+	if err := onboard(ctx, c, &out, "payments", opts); err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(out.String(), "created") || !strings.Contains(out.String(), "deployment/postgres already excluded") {
+		t.Errorf("second run output:\n%s", out.String())
+	}
+}
+
+func TestOnboard_Errors(t *testing.T) {
+	ctx := context.Background()
+	c := newDiagnoseClient(t, interceptor.Funcs{})
+
+	tests := []struct {
+		name string
+		opts onboardOptions
+		want string
+	}{
+		{name: "no team", opts: onboardOptions{}, want: "--team is required"},
+		{name: "invalid selector", opts: onboardOptions{Team: "a", Exclude: []string{"app in ("}}, want: "invalid --exclude selector"},
+		{name: "missing namespace", opts: onboardOptions{Team: "a"}, want: "failed to get namespace payments"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := onboard(ctx, c, &bytes.Buffer{}, "payments", tt.opts)
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("error = %v, want %q", err, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,216 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	chaosv1alpha1 "github.com/neogan74/k8s-chaos/api/v1alpha1"
+)
+
+var rerunCmd = &cobra.Command{
+	Use:   "rerun HISTORY_NAME",
+	Short: "Create a new experiment that repeats a recorded run",
+	Long: `Reconstruct a ChaosExperiment from a ChaosExperimentHistory record and create it, so a past run
+can be reproduced faithfully, for example to confirm that a fix holds.
+
+The new experiment gets the spec captured in the record with stickyTargets enabled and the
+recorded pods listed in the chaos.gushchin.dev/replay-targets annotation: the controller targets
+those pods first and only picks replacements for the ones that no longer exist. A node action
+that affected a single node is pinned to it through the kubernetes.io/hostname selector.
+Schedules are dropped, the rerun happens once.
+
+The record is looked up in the namespace given with -n; the experiment is created in the
+namespace of the original experiment.
+
+Examples:
+  # Repeat a run
+  k8s-chaos rerun nginx-chaos-demo-20250101-120000-ab12 -n chaos-system
+
+  # Review the reconstructed experiment without creating it
+  k8s-chaos rerun nginx-chaos-demo-20250101-120000-ab12 -n chaos-system -o yaml`,
+	Args: cobra.ExactArgs(1),
+	RunE: runRerun,
+}
+
+var (
+	rerunName   string
+	rerunOutput string
+)
+
+func init() {
+	rerunCmd.Flags().StringVar(&rerunName, "name", "", "name of the new experiment (default: generated from the original name)")
+	rerunCmd.Flags().StringVarP(&rerunOutput, "output", "o", "", "print the experiment as yaml instead of creating it")
+	rootCmd.AddCommand(rerunCmd)
+}
+
+func runRerun(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+
+	if namespace == "" {
+		return fmt.Errorf("namespace is required, use -n flag to specify")
+	}
+	if rerunOutput != "" && rerunOutput != "yaml" {
+		return fmt.Errorf("unsupported output format %q", rerunOutput)
+	}
+
+	k8sClient, err := getKubeClient()
+	if err != nil {
+		return fmt.Errorf("failed to get Kubernetes client: %w", err)
+	}
+
+	history := &chaosv1alpha1.ChaosExperimentHistory{}
+	if err := k8sClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: args[0]}, history); err != nil {
+		return fmt.Errorf("failed to get history record %s: %w", args[0], err)
+	}
+
+	exp, warnings := replayExperiment(history, rerunName)
+	missing, err := missingReplayPods(ctx, k8sClient, exp)
+	if err != nil {
+		return err
+	}
+	if len(missing) > 0 {
+		warnings = append(warnings, fmt.Sprintf("%d recorded pod(s) no longer exist and will be replaced: %s",
+			len(missing), strings.Join(missing, ", ")))
+	}
+	for _, warning := range warnings {
+		fmt.Fprintf(os.Stderr, "Warning: %s\n", warning)
+	}
+
+	if rerunOutput == "yaml" {
+		return printReplayExperiment(os.Stdout, exp)
+	}
+
+	if err := k8sClient.Create(ctx, exp); err != nil {
+		return fmt.Errorf("failed to create experiment: %w", err)
+	}
+	fmt.Printf("Experiment '%s' created in namespace '%s' from history record '%s'\n",
+		exp.Name, exp.Namespace, history.Name)
+	return nil
+}
+
+// replayExperiment builds the experiment that repeats the run recorded in history, together with
+// warnings about the parts of the run that cannot be reproduced
+func replayExperiment(history *chaosv1alpha1.ChaosExperimentHistory, name string) (*chaosv1alpha1.ChaosExperiment, []string) {
+	var warnings []string
+
+	spec := *history.Spec.ExperimentSpec.DeepCopy()
+	if spec.Schedule != "" {
+		warnings = append(warnings, fmt.Sprintf("schedule %q dropped, the rerun happens once", spec.Schedule))
+	}
+	spec.Schedule = ""
+	spec.ScheduleJitter = ""
+	spec.Paused = false
+
+	exp := &chaosv1alpha1.ChaosExperiment{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: chaosv1alpha1.GroupVersion.String(),
+			Kind:       "ChaosExperiment",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: history.Spec.ExperimentRef.Namespace,
+			Annotations: map[string]string{
+				chaosv1alpha1.ReplayOfAnnotation: history.Namespace + "/" + history.Name,
+			},
+		},
+		Spec: spec,
+	}
+	if name != "" {
+		exp.Name = name
+	} else {
+		exp.GenerateName = history.Spec.ExperimentRef.Name + "-rerun-"
+	}
+
+	var pods, nodes []string
+	seen := make(map[string]bool)
+	for _, ref := range history.Spec.AffectedResources {
+		key := ref.Kind + "/" + ref.Namespace + "/" + ref.Name
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		switch ref.Kind {
+		case "Pod":
+			pods = append(pods, ref.Namespace+"/"+ref.Name)
+		case "Node":
+			nodes = append(nodes, ref.Name)
+		}
+	}
+	sort.Strings(pods)
+	sort.Strings(nodes)
+
+	switch {
+	case len(pods) > 0:
+		exp.Spec.StickyTargets = true
+		exp.Annotations[chaosv1alpha1.ReplayTargetsAnnotation] = strings.Join(pods, ",")
+	case len(nodes) == 1:
+		exp.Spec.Selector = map[string]string{corev1.LabelHostname: nodes[0]}
+	case len(nodes) > 1:
+		warnings = append(warnings, fmt.Sprintf("nodes cannot be pinned, the controller will select %d node(s) matching the selector again (originally %s)",
+			len(nodes), strings.Join(nodes, ", ")))
+	default:
+		warnings = append(warnings, "the record lists no affected resources, targets will be selected again")
+	}
+
+	return exp, warnings
+}
+
+// missingReplayPods returns the replay targets that no longer exist in the cluster
+func missingReplayPods(ctx context.Context, c client.Client, exp *chaosv1alpha1.ChaosExperiment) ([]string, error) {
+	var missing []string
+	for _, target := range replayTargetKeys(exp) {
+		podNamespace, podName, _ := strings.Cut(target, "/")
+		err := c.Get(ctx, client.ObjectKey{Namespace: podNamespace, Name: podName}, &corev1.Pod{})
+		if apierrors.IsNotFound(err) {
+			missing = append(missing, target)
+		} else if err != nil {
+			return nil, fmt.Errorf("failed to get pod %s: %w", target, err)
+		}
+	}
+	return missing, nil
+}
+
+// replayTargetKeys splits the replay-targets annotation
+func replayTargetKeys(exp *chaosv1alpha1.ChaosExperiment) []string {
+	value := exp.Annotations[chaosv1alpha1.ReplayTargetsAnnotation]
+	if value == "" {
+		return nil
+	}
+	return strings.Split(value, ",")
+}
+
+// printReplayExperiment writes exp as a manifest that can be applied later
+func printReplayExperiment(out io.Writer, exp *chaosv1alpha1.ChaosExperiment) error {
+	data, err := yaml.Marshal(exp)
+	if err != nil {
+		return fmt.Errorf("failed to encode experiment: %w", err)
+	}
+	_, err = out.Write(data)
+	return err
+}
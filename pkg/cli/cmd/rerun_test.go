@@ -0,0 +1,128 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	chaosv1alpha1 "github.com/neogan74/k8s-chaos/api/v1alpha1"
+)
+
+func newRerunHistory(action string, resources ...chaosv1alpha1.ResourceReference) *chaosv1alpha1.ChaosExperimentHistory {
+	return &chaosv1alpha1.ChaosExperimentHistory{
+		ObjectMeta: metav1.ObjectMeta{Name: "web-kill-20250101-120000-ab12", Namespace: "chaos-system"},
+		Spec: chaosv1alpha1.ChaosExperimentHistorySpec{
+			ExperimentRef: chaosv1alpha1.ObjectReference{Name: "web-kill", Namespace: "chaos-testing"},
+			ExperimentSpec: chaosv1alpha1.ChaosExperimentSpec{
+				Action:    action,
+				Namespace: "shop",
+				Selector:  map[string]string{"app": "web"},
+				Count:     2,
+				Schedule:  "0 2 * * *",
+			},
+			AffectedResources: resources,
+		},
+	}
+}
+
+func TestReplayExperiment_Pods(t *testing.T) {
+	history := newRerunHistory("pod-kill",
+		chaosv1alpha1.ResourceReference{Kind: "Pod", Name: "web-2", Namespace: "shop", Action: "deleted"},
+		chaosv1alpha1.ResourceReference{Kind: "Pod", Name: "web-1", Namespace: "shop", Action: "deleted"},
+		chaosv1alpha1.ResourceReference{Kind: "Pod", Name: "web-1", Namespace: "shop", Action: "deleted"},
+	)
+
+	exp, warnings := replayExperiment(history, "")
+
+	if exp.GenerateName != "web-kill-rerun-" || exp.Namespace != "chaos-testing" {
+		t.Errorf("unexpected name %q in namespace %q", exp.GenerateName, exp.Namespace)
+	}
+	if !exp.Spec.StickyTargets || exp.Spec.Schedule != "" || exp.Spec.Count != 2 {
+		t.Errorf("unexpected spec %+v", exp.Spec)
+	}
+	if got := exp.Annotations[chaosv1alpha1.ReplayTargetsAnnotation]; got != "shop/web-1,shop/web-2" {
+		t.Errorf("replay targets = %q", got)
+	}
+	if got := exp.Annotations[chaosv1alpha1.ReplayOfAnnotation]; got != "chaos-system/web-kill-20250101-120000-ab12" {
+		t.Errorf("replay of = %q", got)
+	}
+	if len(warnings) != 1 || !strings.Contains(warnings[0], "schedule") {
+		t.Errorf("expected a warning about the dropped schedule, got %v", warnings)
+	}
+	if history.Spec.ExperimentSpec.Schedule == "" {
+		t.Error("the history record must not be modified")
+	}
+}
+
+func TestReplayExperiment_Nodes(t *testing.T) {
+	exp, _ := replayExperiment(newRerunHistory("node-drain",
+		chaosv1alpha1.ResourceReference{Kind: "Node", Name: "worker-3", Action: "drained"},
+	), "drain-again")
+
+	if exp.Name != "drain-again" || exp.GenerateName != "" {
+		t.Errorf("unexpected name %q / %q", exp.Name, exp.GenerateName)
+	}
+	if len(exp.Spec.Selector) != 1 || exp.Spec.Selector[corev1.LabelHostname] != "worker-3" {
+		t.Errorf("a single node should be pinned by hostname, got %v", exp.Spec.Selector)
+	}
+
+	exp, warnings := replayExperiment(newRerunHistory("node-taint",
+		chaosv1alpha1.ResourceReference{Kind: "Node", Name: "worker-1"},
+		chaosv1alpha1.ResourceReference{Kind: "Node", Name: "worker-2"},
+	), "")
+	if exp.Spec.Selector["app"] != "web" {
+		t.Errorf("several nodes keep the original selector, got %v", exp.Spec.Selector)
+	}
+	if len(warnings) != 2 || !strings.Contains(warnings[1], "worker-1, worker-2") {
+		t.Errorf("expected a warning naming the nodes, got %v", warnings)
+	}
+}
+
+func TestMissingReplayPods(t *testing.T) {
+	c := newDiagnoseClient(t, interceptor.Funcs{},
+		&corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "web-1", Namespace: "shop"}},
+	)
+	exp, _ := replayExperiment(newRerunHistory("pod-kill",
+		chaosv1alpha1.ResourceReference{Kind: "Pod", Name: "web-1", Namespace: "shop"},
+		chaosv1alpha1.ResourceReference{Kind: "Pod", Name: "web-2", Namespace: "shop"},
+	), "")
+
+	missing, err := missingReplayPods(context.Background(), c, exp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(missing) != 1 || missing[0] != "shop/web-2" {
+		t.Errorf("missing = %v", missing)
+	}
+
+	var out bytes.Buffer
+	if err := printReplayExperiment(&out, exp); err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{"kind: ChaosExperiment", "generateName: web-kill-rerun-", "stickyTargets: true"} {
+		if !strings.Contains(out.String(), want) {
+			t.Errorf("manifest missing %q:\n%s", want, out.String())
+		}
+	}
+}
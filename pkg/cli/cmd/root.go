@@ -22,7 +22,9 @@ import (
 
 	"github.com/spf13/cobra"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -30,8 +32,9 @@ import (
 )
 
 var (
-	kubeconfig string
-	namespace  string
+	kubeconfig  string
+	kubeContext string
+	namespace   string
 )
 
 // rootCmd represents the base command when called without any subcommands
@@ -60,16 +63,44 @@ func Execute() {
 func init() {
 	rootCmd.PersistentFlags().StringVar(&kubeconfig, "kubeconfig", "",
 		"path to kubeconfig file (default: $HOME/.kube/config)")
+	rootCmd.PersistentFlags().StringVar(&kubeContext, "context", "",
+		"kubeconfig context to use (default: the current context)")
 	rootCmd.PersistentFlags().StringVarP(&namespace, "namespace", "n", "",
 		"namespace to operate in (default: all namespaces)")
 }
 
-// getKubeClient creates and returns a Kubernetes client
+// getKubeClient creates and returns a Kubernetes client for the --context context
 func getKubeClient() (client.Client, error) {
-	config, err := clientcmd.BuildConfigFromFlags("", getKubeconfigPath())
+	return newKubeClient(kubeContext)
+}
+
+// restConfig loads the kubeconfig for a context; "" is the current context
+func restConfig(contextName string) (*rest.Config, error) {
+	config, err := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(
+		&clientcmd.ClientConfigLoadingRules{ExplicitPath: getKubeconfigPath()},
+		&clientcmd.ConfigOverrides{CurrentContext: contextName},
+	).ClientConfig()
 	if err != nil {
 		return nil, fmt.Errorf("failed to build kubeconfig: %w", err)
 	}
+	return config, nil
+}
+
+// getClientset creates a typed clientset for the --context context, for logs and proxy requests
+func getClientset() (kubernetes.Interface, error) {
+	config, err := restConfig(kubeContext)
+	if err != nil {
+		return nil, err
+	}
+	return kubernetes.NewForConfig(config)
+}
+
+// newKubeClient creates a Kubernetes client for a kubeconfig context; "" is the current context
+func newKubeClient(contextName string) (client.Client, error) {
+	config, err := restConfig(contextName)
+	if err != nil {
+		return nil, err
+	}
 
 	// Create scheme and register ChaosExperiment types
 	scheme := runtime.NewScheme()
@@ -78,7 +78,7 @@ func TestRootCmd_Version(t *testing.T) {
 }
 
 func TestRootCmd_HasSubcommands(t *testing.T) {
-	expectedCommands := []string{"list", "describe", "delete", "stats", "top"}
+	expectedCommands := []string{"list", "describe", "delete", "stats", "top", "history", "generate", "diagnose", "doctor"}
 
 	commands := rootCmd.Commands()
 	commandNames := make(map[string]bool)
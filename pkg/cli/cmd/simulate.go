@@ -0,0 +1,179 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/neogan74/k8s-chaos/internal/controller"
+)
+
+var simulateCmd = &cobra.Command{
+	Use:   "simulate -f <file-or-dir>",
+	Short: "Show what the controller would do with an experiment, without changing the cluster",
+	Long: `Evaluate ChaosExperiment manifests against the current cluster the way the controller would,
+and print every decision: spec validation, chaos freezes, production protection, schedule, time
+and maintenance windows, dependencies, ChaosPolicy rate limits, target selection with each
+exclusion (opt-out labels, terminating pods, singletons and leaders, rollouts), maxPercentage,
+node-drain safety checks, blast radius and PodDisruptionBudgets.
+
+Unlike spec.dryRun nothing is created: the experiments need not be applied, only reads are made
+and no status is written. Rate limits are evaluated from the history records of the target
+namespace. Target selection that is random in the controller is shown for one possible draw.
+
+Exits with an error when an experiment would not inject; use -o json for CI.
+
+Examples:
+  # What would this experiment hit right now?
+  k8s-chaos simulate -f experiment.yaml
+
+  # Would the nightly run be allowed at 02:00 UTC tomorrow?
+  k8s-chaos simulate -f nightly.yaml --at 2026-01-15T02:00:00Z`,
+	RunE: runSimulate,
+}
+
+var (
+	simulateFiles  []string
+	simulateAt     string
+	simulateOutput string
+)
+
+func init() {
+	simulateCmd.Flags().StringSliceVarP(&simulateFiles, "filename", "f", nil, "manifest file or directory to simulate; repeatable")
+	simulateCmd.Flags().StringVar(&simulateAt, "at", "", "simulate at this time (RFC 3339) instead of now")
+	simulateCmd.Flags().StringVarP(&simulateOutput, "output", "o", "", "output format: json, or text when empty")
+	_ = simulateCmd.MarkFlagRequired("filename")
+	rootCmd.AddCommand(simulateCmd)
+}
+
+func runSimulate(cmd *cobra.Command, args []string) error {
+	if simulateOutput != "" && simulateOutput != "json" {
+		return fmt.Errorf("unsupported output format %q", simulateOutput)
+	}
+	at := time.Now()
+	if simulateAt != "" {
+		var err error
+		if at, err = time.Parse(time.RFC3339, simulateAt); err != nil {
+			return fmt.Errorf("invalid --at: %w", err)
+		}
+	}
+
+	manifests, findings, err := loadLintManifests(simulateFiles)
+	if err != nil {
+		return err
+	}
+	for _, f := range findings {
+		if f.Severity == severityError {
+			return fmt.Errorf("%s#%d: %s", f.File, f.Document, f.Message)
+		}
+	}
+	if len(manifests) == 0 {
+		return fmt.Errorf("no ChaosExperiment found in %s", strings.Join(simulateFiles, ", "))
+	}
+
+	k8sClient, err := getKubeClient()
+	if err != nil {
+		return fmt.Errorf("failed to get Kubernetes client: %w", err)
+	}
+	simulations, err := simulateManifests(context.Background(), k8sClient, manifests, at)
+	if err != nil {
+		return err
+	}
+
+	if simulateOutput == "json" {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(simulations); err != nil {
+			return err
+		}
+	} else {
+		printSimulations(os.Stdout, simulations)
+	}
+
+	blocked := 0
+	for _, sim := range simulations {
+		if !sim.WouldInject {
+			blocked++
+		}
+	}
+	if blocked > 0 {
+		return fmt.Errorf("%d of %d experiment(s) would not inject", blocked, len(simulations))
+	}
+	return nil
+}
+
+// simulateManifests runs the controller's simulation for every manifest with a client that is
+// only read from
+func simulateManifests(ctx context.Context, c client.Client, manifests []lintManifest, at time.Time) ([]*controller.Simulation, error) {
+	r := &controller.ChaosExperimentReconciler{Client: c, Scheme: c.Scheme()}
+	simulations := make([]*controller.Simulation, 0, len(manifests))
+	for _, m := range manifests {
+		exp := m.Exp.DeepCopy()
+		if exp.Namespace == "" {
+			exp.Namespace = "default"
+		}
+		sim, err := r.Simulate(ctx, exp, at)
+		if err != nil {
+			return nil, fmt.Errorf("simulating %s#%d: %w", m.File, m.Document, err)
+		}
+		simulations = append(simulations, sim)
+	}
+	return simulations, nil
+}
+
+func printSimulations(out io.Writer, simulations []*controller.Simulation) {
+	for i, sim := range simulations {
+		if i > 0 {
+			_, _ = fmt.Fprintln(out)
+		}
+		_, _ = fmt.Fprintf(out, "%s (%s) at %s\n", sim.Experiment, sim.Action, sim.At.Format(time.RFC3339))
+
+		w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+		for _, step := range sim.Steps {
+			_, _ = fmt.Fprintf(w, "  %s\t%s\t%s\n", strings.ToUpper(step.Outcome), step.Check, step.Message)
+			for _, detail := range step.Details {
+				_, _ = fmt.Fprintf(w, "  \t\t  - %s\n", detail)
+			}
+		}
+		_ = w.Flush()
+
+		if sim.WouldInject {
+			_, _ = fmt.Fprintf(out, "Result: would inject into %d target(s)\n", len(sim.Targets))
+			continue
+		}
+		var blockers []string
+		for _, step := range sim.Steps {
+			if step.Outcome == controller.SimulationBlock {
+				blockers = append(blockers, step.Check)
+			}
+		}
+		if len(blockers) == 0 {
+			blockers = append(blockers, "no targets")
+		}
+		_, _ = fmt.Fprintf(out, "Result: would not inject (%s)\n", strings.Join(blockers, ", "))
+	}
+}
@@ -0,0 +1,103 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	chaosv1alpha1 "github.com/neogan74/k8s-chaos/api/v1alpha1"
+)
+
+const simulateManifestsYAML = `apiVersion: chaos.gushchin.dev/v1alpha1
+kind: ChaosExperiment
+metadata:
+  name: kill
+spec:
+  action: pod-kill
+  namespace: shop
+  selector:
+    app: web
+---
+apiVersion: chaos.gushchin.dev/v1alpha1
+kind: ChaosExperiment
+metadata:
+  name: kill-missing
+spec:
+  action: pod-kill
+  namespace: shop
+  selector:
+    app: missing
+`
+
+func TestSimulateManifests(t *testing.T) {
+	c := newDiagnoseClient(t, interceptor.Funcs{},
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "shop"}},
+		&corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "web-1", Namespace: "shop", Labels: map[string]string{"app": "web"}}},
+		&corev1.Pod{ObjectMeta: metav1.ObjectMeta{
+			Name: "web-2", Namespace: "shop",
+			Labels: map[string]string{"app": "web", chaosv1alpha1.ExclusionLabel: "true"},
+		}},
+	)
+	manifests, findings := parseLintManifests("experiments.yaml", []byte(simulateManifestsYAML))
+	if len(findings) > 0 {
+		t.Fatalf("unexpected findings %+v", findings)
+	}
+
+	simulations, err := simulateManifests(context.Background(), c, manifests, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(simulations) != 2 {
+		t.Fatalf("got %d simulations", len(simulations))
+	}
+	if !simulations[0].WouldInject || len(simulations[0].Targets) != 1 || simulations[0].Targets[0] != "shop/web-1" {
+		t.Errorf("kill: unexpected simulation %+v", simulations[0])
+	}
+	if simulations[0].Experiment != "default/kill" {
+		t.Errorf("experiments without a namespace are simulated in default, got %s", simulations[0].Experiment)
+	}
+	if simulations[1].WouldInject {
+		t.Errorf("kill-missing should not inject: %+v", simulations[1])
+	}
+
+	var out bytes.Buffer
+	printSimulations(&out, simulations)
+	for _, want := range []string{
+		"default/kill (pod-kill) at ",
+		"  - web-2",
+		"Result: would inject into 1 target(s)",
+		"Result: would not inject (selector)",
+	} {
+		if !strings.Contains(out.String(), want) {
+			t.Errorf("output lacks %q:\n%s", want, out.String())
+		}
+	}
+
+	// Reads only
+	pods := &corev1.PodList{}
+	if err := c.List(context.Background(), pods); err != nil || len(pods.Items) != 2 {
+		t.Errorf("pods changed: %d (%v)", len(pods.Items), err)
+	}
+}
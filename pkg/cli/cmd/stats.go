@@ -19,8 +19,11 @@ package cmd
 import (
 	"context"
 	"fmt"
+	"io"
 	"os"
+	"sort"
 	"text/tabwriter"
+	"time"
 
 	"github.com/spf13/cobra"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -30,6 +33,11 @@ import (
 
 const phaseFailed = "Failed"
 
+const (
+	trendDay  = "day"
+	trendWeek = "week"
+)
+
 var statsCmd = &cobra.Command{
 	Use:   "stats",
 	Short: "Show chaos experiment statistics",
@@ -41,11 +49,36 @@ Examples:
   k8s-chaos stats
 
   # Show stats for a specific namespace
-  k8s-chaos stats -n chaos-testing`,
+  k8s-chaos stats -n chaos-testing
+
+  # Add daily success rate, MTTR and run counts from history records of the last week
+  k8s-chaos stats --trend day --since 168h
+
+  # Weekly trend of pod-kill runs
+  k8s-chaos stats --trend week --action pod-kill
+
+  # Combined stats of every cluster in the kubeconfig
+  k8s-chaos stats --all-contexts`,
 	RunE: runStats,
 }
 
+var (
+	statsTrend            string
+	statsSince            time.Duration
+	statsAction           string
+	statsHistoryNamespace string
+)
+
 func init() {
+	statsCmd.Flags().StringVar(&statsTrend, "trend", "",
+		"also show history trends grouped by \"day\" or \"week\"")
+	statsCmd.Flags().DurationVar(&statsSince, "since", 30*24*time.Hour,
+		"only include history records of runs started within this duration")
+	statsCmd.Flags().StringVar(&statsAction, "action", "",
+		"only include experiments and history records of this action")
+	statsCmd.Flags().StringVar(&statsHistoryNamespace, "history-namespace", "chaos-system",
+		"namespace where the controller stores history records")
+	addAllContextsFlag(statsCmd)
 	rootCmd.AddCommand(statsCmd)
 }
 
@@ -60,28 +93,190 @@ type stats struct {
 	TimeLimited int
 }
 
+// trendBucket aggregates the history records of one day or week
+type trendBucket struct {
+	Period    string
+	Start     time.Time
+	Runs      int
+	Succeeded int
+	Failed    int
+	// recoveries holds the recovery times of runs that measured one
+	recoveries []time.Duration
+}
+
+// MTTR is the mean recovery time of the bucket's runs, zero when none measured one
+func (b trendBucket) MTTR() time.Duration {
+	if len(b.recoveries) == 0 {
+		return 0
+	}
+	var total time.Duration
+	for _, d := range b.recoveries {
+		total += d
+	}
+	return (total / time.Duration(len(b.recoveries))).Round(time.Second)
+}
+
+// clusterData is what stats reads from one cluster
+type clusterData struct {
+	Experiments []chaosv1alpha1.ChaosExperiment
+	History     []chaosv1alpha1.ChaosExperimentHistory
+}
+
 func runStats(cmd *cobra.Command, args []string) error {
 	ctx := context.Background()
 
-	k8sClient, err := getKubeClient()
+	if statsTrend != "" && statsTrend != trendDay && statsTrend != trendWeek {
+		return fmt.Errorf("invalid --trend %q: must be %q or %q", statsTrend, trendDay, trendWeek)
+	}
+
+	clusters, err := getClusters()
+	if err != nil {
+		return err
+	}
+
+	results, err := successfulResults(queryClusters(ctx, clusters, readStatsData))
 	if err != nil {
-		return fmt.Errorf("failed to get Kubernetes client: %w", err)
+		return err
+	}
+
+	var experiments []chaosv1alpha1.ChaosExperiment
+	var records []chaosv1alpha1.ChaosExperimentHistory
+	for _, r := range results {
+		experiments = append(experiments, r.Value.Experiments...)
+		records = append(records, r.Value.History...)
+	}
+
+	stats := calculateStats(experiments)
+	printStats(stats, namespace)
+
+	if allContexts {
+		fmt.Println()
+		printClusterStats(os.Stdout, results)
+	}
+
+	if statsTrend == "" {
+		return nil
 	}
 
+	since := time.Now().Add(-statsSince)
+	fmt.Println()
+	printTrend(os.Stdout, calculateTrend(records, statsTrend, namespace, since), statsTrend, since)
+
+	return nil
+}
+
+// readStatsData lists the experiments and, with --trend, the history records of a cluster,
+// both restricted to --action
+func readStatsData(ctx context.Context, k8sClient client.Client) (clusterData, error) {
+	data := clusterData{}
+
 	expList := &chaosv1alpha1.ChaosExperimentList{}
 	listOpts := []client.ListOption{}
 	if namespace != "" {
 		listOpts = append(listOpts, client.InNamespace(namespace))
 	}
-
 	if err := k8sClient.List(ctx, expList, listOpts...); err != nil {
-		return fmt.Errorf("failed to list chaos experiments: %w", err)
+		return data, fmt.Errorf("failed to list chaos experiments: %w", err)
+	}
+	for _, exp := range expList.Items {
+		if statsAction == "" || exp.Spec.Action == statsAction {
+			data.Experiments = append(data.Experiments, exp)
+		}
 	}
 
-	stats := calculateStats(expList.Items)
-	printStats(stats, namespace)
+	if statsTrend == "" {
+		return data, nil
+	}
+	historyList := &chaosv1alpha1.ChaosExperimentHistoryList{}
+	historyOpts := []client.ListOption{client.InNamespace(statsHistoryNamespace)}
+	if statsAction != "" {
+		historyOpts = append(historyOpts, client.MatchingLabels{"chaos.gushchin.dev/action": statsAction})
+	}
+	if err := k8sClient.List(ctx, historyList, historyOpts...); err != nil {
+		return data, fmt.Errorf("failed to list history records: %w", err)
+	}
+	data.History = historyList.Items
+	return data, nil
+}
 
-	return nil
+// printClusterStats breaks the experiment phases down per cluster
+func printClusterStats(out io.Writer, results []clusterResult[clusterData]) {
+	_, _ = fmt.Fprintln(out, "By Cluster:")
+	w := tabwriter.NewWriter(out, 0, 0, 3, ' ', 0)
+	_, _ = fmt.Fprintln(w, "  CLUSTER\tTOTAL\tRUNNING\tCOMPLETED\tFAILED\tPENDING")
+	for _, r := range results {
+		s := calculateStats(r.Value.Experiments)
+		_, _ = fmt.Fprintf(w, "  %s\t%d\t%d\t%d\t%d\t%d\n", r.Cluster, s.Total, s.Running, s.Completed, s.Failed, s.Pending)
+	}
+	_ = w.Flush()
+}
+
+// calculateTrend groups the history records of runs started after since into day or week buckets,
+// oldest first. A non-empty namespace restricts the records to experiments in that namespace.
+func calculateTrend(records []chaosv1alpha1.ChaosExperimentHistory, trend, ns string, since time.Time) []trendBucket {
+	buckets := map[string]*trendBucket{}
+	for _, record := range records {
+		started := record.Spec.Execution.StartTime.Time
+		if started.Before(since) || (ns != "" && record.Spec.ExperimentRef.Namespace != ns) {
+			continue
+		}
+
+		period, start := trendPeriod(started, trend)
+		bucket, ok := buckets[period]
+		if !ok {
+			bucket = &trendBucket{Period: period, Start: start}
+			buckets[period] = bucket
+		}
+		bucket.Runs++
+		switch record.Spec.Execution.Status {
+		case "success":
+			bucket.Succeeded++
+		case "failure":
+			bucket.Failed++
+		}
+		if recovery, err := time.ParseDuration(record.Spec.Execution.RecoveryTime); err == nil {
+			bucket.recoveries = append(bucket.recoveries, recovery)
+		}
+	}
+
+	result := make([]trendBucket, 0, len(buckets))
+	for _, bucket := range buckets {
+		result = append(result, *bucket)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Start.Before(result[j].Start) })
+	return result
+}
+
+// trendPeriod returns the label and start of the UTC day or ISO week t falls into
+func trendPeriod(t time.Time, trend string) (string, time.Time) {
+	t = t.UTC()
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	if trend != trendWeek {
+		return day.Format("2006-01-02"), day
+	}
+	year, week := t.ISOWeek()
+	monday := day.AddDate(0, 0, -(int(day.Weekday())+6)%7)
+	return fmt.Sprintf("%d-W%02d", year, week), monday
+}
+
+func printTrend(out io.Writer, buckets []trendBucket, trend string, since time.Time) {
+	_, _ = fmt.Fprintf(out, "Trend (per %s, since %s):\n", trend, since.UTC().Format("2006-01-02 15:04"))
+	if len(buckets) == 0 {
+		_, _ = fmt.Fprintln(out, "  No history records found")
+		return
+	}
+
+	w := tabwriter.NewWriter(out, 0, 0, 3, ' ', 0)
+	_, _ = fmt.Fprintln(w, "  PERIOD\tRUNS\tSUCCEEDED\tFAILED\tSUCCESS RATE\tMTTR")
+	for _, b := range buckets {
+		mttr := "-"
+		if len(b.recoveries) > 0 {
+			mttr = b.MTTR().String()
+		}
+		_, _ = fmt.Fprintf(w, "  %s\t%d\t%d\t%d\t%.1f%%\t%s\n",
+			b.Period, b.Runs, b.Succeeded, b.Failed, float64(b.Succeeded)/float64(b.Runs)*100, mttr)
+	}
+	_ = w.Flush()
 }
 
 func calculateStats(experiments []chaosv1alpha1.ChaosExperiment) stats {
@@ -17,7 +17,12 @@ limitations under the License.
 package cmd
 
 import (
+	"bytes"
+	"strings"
 	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	chaosv1alpha1 "github.com/neogan74/k8s-chaos/api/v1alpha1"
 )
@@ -125,3 +130,89 @@ func TestCalculateStats_TimeLimited(t *testing.T) {
 		t.Fatalf("expected 3 time-limited, got %d", s.TimeLimited)
 	}
 }
+
+func historyRun(ns, status, recovery string, started time.Time) chaosv1alpha1.ChaosExperimentHistory {
+	return chaosv1alpha1.ChaosExperimentHistory{
+		Spec: chaosv1alpha1.ChaosExperimentHistorySpec{
+			ExperimentRef: chaosv1alpha1.ObjectReference{Name: "exp", Namespace: ns},
+			Execution: chaosv1alpha1.ExecutionDetails{
+				StartTime:    metav1.NewTime(started),
+				Status:       status,
+				RecoveryTime: recovery,
+			},
+		},
+	}
+}
+
+func TestCalculateTrend_Daily(t *testing.T) {
+	day1 := time.Date(2025, 6, 2, 9, 0, 0, 0, time.UTC)
+	day2 := day1.Add(24 * time.Hour)
+	records := []chaosv1alpha1.ChaosExperimentHistory{
+		historyRun("team-a", "success", "30s", day2),
+		historyRun("team-a", "success", "1m30s", day1),
+		historyRun("team-a", "failure", "", day1.Add(time.Hour)),
+		historyRun("team-b", "success", "", day1),
+		historyRun("team-a", "success", "", day1.Add(-72*time.Hour)),
+	}
+
+	buckets := calculateTrend(records, trendDay, "team-a", day1.Add(-time.Hour))
+
+	if len(buckets) != 2 {
+		t.Fatalf("expected 2 buckets, got %+v", buckets)
+	}
+	first := buckets[0]
+	if first.Period != "2025-06-02" || first.Runs != 2 || first.Succeeded != 1 || first.Failed != 1 {
+		t.Errorf("unexpected first bucket %+v", first)
+	}
+	if first.MTTR() != 90*time.Second {
+		t.Errorf("expected MTTR 1m30s, got %s", first.MTTR())
+	}
+	if buckets[1].Period != "2025-06-03" || buckets[1].Runs != 1 {
+		t.Errorf("unexpected second bucket %+v", buckets[1])
+	}
+}
+
+func TestCalculateTrend_Weekly(t *testing.T) {
+	// Sunday 2025-06-08 belongs to the ISO week starting Monday 2025-06-02
+	sunday := time.Date(2025, 6, 8, 23, 0, 0, 0, time.UTC)
+	monday := time.Date(2025, 6, 9, 1, 0, 0, 0, time.UTC)
+	records := []chaosv1alpha1.ChaosExperimentHistory{
+		historyRun("team-a", "success", "", sunday),
+		historyRun("team-a", "partial", "", monday),
+	}
+
+	buckets := calculateTrend(records, trendWeek, "", time.Time{})
+
+	if len(buckets) != 2 {
+		t.Fatalf("expected 2 buckets, got %+v", buckets)
+	}
+	if buckets[0].Period != "2025-W23" || !buckets[0].Start.Equal(time.Date(2025, 6, 2, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("unexpected first bucket %+v", buckets[0])
+	}
+	if buckets[1].Period != "2025-W24" || buckets[1].Succeeded != 0 || buckets[1].Failed != 0 {
+		t.Errorf("unexpected second bucket %+v", buckets[1])
+	}
+}
+
+func TestPrintTrend(t *testing.T) {
+	since := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	records := []chaosv1alpha1.ChaosExperimentHistory{
+		historyRun("team-a", "success", "45s", since.Add(time.Hour)),
+		historyRun("team-a", "failure", "", since.Add(2*time.Hour)),
+	}
+
+	var out bytes.Buffer
+	printTrend(&out, calculateTrend(records, trendDay, "", since), trendDay, since)
+
+	for _, want := range []string{"per day, since 2025-06-01 00:00", "2025-06-01", "50.0%", "45s"} {
+		if !strings.Contains(out.String(), want) {
+			t.Errorf("output missing %q:\n%s", want, out.String())
+		}
+	}
+
+	out.Reset()
+	printTrend(&out, nil, trendWeek, since)
+	if !strings.Contains(out.String(), "No history records found") {
+		t.Errorf("unexpected output for no records:\n%s", out.String())
+	}
+}
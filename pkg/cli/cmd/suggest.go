@@ -0,0 +1,361 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	chaosv1alpha1 "github.com/neogan74/k8s-chaos/api/v1alpha1"
+)
+
+// suggestionAnnotation carries the finding a suggested experiment was drafted for
+const suggestionAnnotation = "chaos.gushchin.dev/suggestion"
+
+var suggestCmd = &cobra.Command{
+	Use:   "suggest",
+	Short: "Suggest experiments for weaknesses found in the cluster's workloads",
+	Long: `Inspect Deployments and StatefulSets (replica counts, PodDisruptionBudgets, probes,
+topology spread and actual pod placement) and suggest a ChaosExperiment for every weakness
+found, for example a pod-kill for a single-replica Deployment without a PodDisruptionBudget.
+
+With -o yaml the suggestions are printed as ChaosExperiment drafts, ready to be reviewed and
+applied. Drafts are dry runs; remove spec.dryRun to inject. Workloads whose pods carry the
+chaos.gushchin.dev/exclude label are skipped, and so are kube-* namespaces unless -n names one.
+
+Examples:
+  # Findings across the cluster
+  k8s-chaos suggest
+
+  # Drafts for one namespace
+  k8s-chaos suggest -n shop -o yaml > suggested.yaml`,
+	RunE: runSuggest,
+}
+
+var suggestOutput string
+
+func init() {
+	suggestCmd.Flags().StringVarP(&suggestOutput, "output", "o", "", "output format: yaml for experiment drafts, or a table when empty")
+	rootCmd.AddCommand(suggestCmd)
+}
+
+// suggestion is a weakness found in a workload and the experiment drafted to exercise it
+type suggestion struct {
+	Workload   string
+	Namespace  string
+	Finding    string
+	Experiment *chaosv1alpha1.ChaosExperiment
+}
+
+// workload is the part of a Deployment or StatefulSet the analysis needs
+type workload struct {
+	Kind      string
+	Name      string
+	Namespace string
+	Replicas  int32
+	Selector  *metav1.LabelSelector
+	Template  corev1.PodTemplateSpec
+}
+
+func runSuggest(cmd *cobra.Command, args []string) error {
+	if suggestOutput != "" && suggestOutput != "yaml" {
+		return fmt.Errorf("unsupported output format %q", suggestOutput)
+	}
+
+	k8sClient, err := getKubeClient()
+	if err != nil {
+		return fmt.Errorf("failed to get Kubernetes client: %w", err)
+	}
+
+	suggestions, err := analyzeWorkloads(context.Background(), k8sClient, namespace)
+	if err != nil {
+		return err
+	}
+	if len(suggestions) == 0 {
+		fmt.Println("No weaknesses found")
+		return nil
+	}
+
+	if suggestOutput == "yaml" {
+		return printSuggestedExperiments(os.Stdout, suggestions)
+	}
+	printSuggestions(os.Stdout, suggestions)
+	return nil
+}
+
+// analyzeWorkloads inspects the workloads in ns ("" for all namespaces) and returns a suggestion per weakness
+func analyzeWorkloads(ctx context.Context, c client.Client, ns string) ([]suggestion, error) {
+	deployments := &appsv1.DeploymentList{}
+	if err := c.List(ctx, deployments, client.InNamespace(ns)); err != nil {
+		return nil, fmt.Errorf("failed to list deployments: %w", err)
+	}
+	statefulSets := &appsv1.StatefulSetList{}
+	if err := c.List(ctx, statefulSets, client.InNamespace(ns)); err != nil {
+		return nil, fmt.Errorf("failed to list statefulsets: %w", err)
+	}
+	budgets := &policyv1.PodDisruptionBudgetList{}
+	if err := c.List(ctx, budgets, client.InNamespace(ns)); err != nil {
+		return nil, fmt.Errorf("failed to list poddisruptionbudgets: %w", err)
+	}
+	pods := &corev1.PodList{}
+	if err := c.List(ctx, pods, client.InNamespace(ns)); err != nil {
+		return nil, fmt.Errorf("failed to list pods: %w", err)
+	}
+
+	workloads := make([]workload, 0, len(deployments.Items)+len(statefulSets.Items))
+	for _, d := range deployments.Items {
+		workloads = append(workloads, workload{
+			Kind: "Deployment", Name: d.Name, Namespace: d.Namespace,
+			Replicas: replicasOrDefault(d.Spec.Replicas), Selector: d.Spec.Selector, Template: d.Spec.Template,
+		})
+	}
+	for _, s := range statefulSets.Items {
+		workloads = append(workloads, workload{
+			Kind: "StatefulSet", Name: s.Name, Namespace: s.Namespace,
+			Replicas: replicasOrDefault(s.Spec.Replicas), Selector: s.Spec.Selector, Template: s.Spec.Template,
+		})
+	}
+
+	var suggestions []suggestion
+	for i := range workloads {
+		w := &workloads[i]
+		if ns == "" && strings.HasPrefix(w.Namespace, "kube-") {
+			continue
+		}
+		if w.Replicas == 0 || w.Template.Labels[chaosv1alpha1.ExclusionLabel] == "true" {
+			continue
+		}
+		if w.Selector == nil || len(w.Selector.MatchLabels) == 0 {
+			// Experiments select pods by matchLabels only
+			continue
+		}
+		suggestions = append(suggestions, analyzeWorkload(w, workloadBudgets(w, budgets.Items), workloadPods(w, pods.Items))...)
+	}
+
+	sort.SliceStable(suggestions, func(i, j int) bool {
+		if suggestions[i].Namespace != suggestions[j].Namespace {
+			return suggestions[i].Namespace < suggestions[j].Namespace
+		}
+		return suggestions[i].Workload < suggestions[j].Workload
+	})
+	return suggestions, nil
+}
+
+// analyzeWorkload applies every check to one workload
+func analyzeWorkload(w *workload, budgets []policyv1.PodDisruptionBudget, pods []corev1.Pod) []suggestion {
+	var suggestions []suggestion
+	add := func(finding, action string, selector map[string]string) {
+		// One draft per action: a further finding for the same action is merged into it
+		for i := range suggestions {
+			if suggestions[i].Experiment.Spec.Action == action {
+				suggestions[i].Finding += "; " + finding
+				suggestions[i].Experiment.Annotations[suggestionAnnotation] = suggestions[i].Finding
+				return
+			}
+		}
+		suggestions = append(suggestions, suggestion{
+			Workload:   w.Kind + "/" + w.Name,
+			Namespace:  w.Namespace,
+			Finding:    finding,
+			Experiment: draftExperiment(w, finding, action, selector),
+		})
+	}
+
+	if w.Replicas == 1 {
+		finding := "single replica"
+		if len(budgets) == 0 {
+			finding += " without a PodDisruptionBudget"
+		}
+		add(finding+": a pod-kill measures the outage and the recovery time", "pod-kill", w.Selector.MatchLabels)
+	}
+
+	for _, budget := range budgets {
+		if blocksDisruptions(&budget, w.Replicas) {
+			if node := busiestNode(pods); node != "" {
+				add(fmt.Sprintf("PodDisruptionBudget %s allows no voluntary disruptions: draining node %s will stall", budget.Name, node),
+					"node-drain", map[string]string{corev1.LabelHostname: node})
+			}
+		}
+	}
+
+	for _, container := range w.Template.Spec.Containers {
+		if container.ReadinessProbe == nil {
+			add(fmt.Sprintf("container %s has no readiness probe: restarted pods get traffic before they are ready", container.Name),
+				"pod-restart", w.Selector.MatchLabels)
+			break
+		}
+	}
+
+	if w.Replicas > 1 && len(w.Template.Spec.TopologySpreadConstraints) == 0 && !hasPodAntiAffinity(&w.Template.Spec) {
+		if node := busiestNode(pods); node != "" && podsOnNode(pods, node) > 1 {
+			add(fmt.Sprintf("no topology spread or pod anti-affinity: %d of %d replicas run on node %s",
+				podsOnNode(pods, node), len(pods), node),
+				"node-drain", map[string]string{corev1.LabelHostname: node})
+		}
+	}
+
+	return suggestions
+}
+
+// draftExperiment builds a dry-run experiment of the given action for a workload
+func draftExperiment(w *workload, finding, action string, selector map[string]string) *chaosv1alpha1.ChaosExperiment {
+	return &chaosv1alpha1.ChaosExperiment{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: chaosv1alpha1.GroupVersion.String(),
+			Kind:       "ChaosExperiment",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        fmt.Sprintf("suggested-%s-%s", w.Name, action),
+			Annotations: map[string]string{suggestionAnnotation: finding},
+		},
+		Spec: chaosv1alpha1.ChaosExperimentSpec{
+			Action:    action,
+			Namespace: w.Namespace,
+			Selector:  selector,
+			Count:     1,
+			DryRun:    true,
+		},
+	}
+}
+
+// workloadBudgets returns the PodDisruptionBudgets covering the workload's pods
+func workloadBudgets(w *workload, budgets []policyv1.PodDisruptionBudget) []policyv1.PodDisruptionBudget {
+	var matched []policyv1.PodDisruptionBudget
+	for _, budget := range budgets {
+		if budget.Namespace != w.Namespace || budget.Spec.Selector == nil {
+			continue
+		}
+		selector, err := metav1.LabelSelectorAsSelector(budget.Spec.Selector)
+		if err != nil || selector.Empty() {
+			continue
+		}
+		if selector.Matches(labels.Set(w.Template.Labels)) {
+			matched = append(matched, budget)
+		}
+	}
+	return matched
+}
+
+// workloadPods returns the scheduled pods matching the workload's selector
+func workloadPods(w *workload, pods []corev1.Pod) []corev1.Pod {
+	selector := labels.SelectorFromSet(w.Selector.MatchLabels)
+	var matched []corev1.Pod
+	for _, pod := range pods {
+		if pod.Namespace == w.Namespace && pod.Spec.NodeName != "" && pod.DeletionTimestamp == nil &&
+			selector.Matches(labels.Set(pod.Labels)) {
+			matched = append(matched, pod)
+		}
+	}
+	return matched
+}
+
+// blocksDisruptions reports whether a PodDisruptionBudget lets no pod of a workload with the
+// given replica count be evicted
+func blocksDisruptions(budget *policyv1.PodDisruptionBudget, replicas int32) bool {
+	if budget.Spec.MaxUnavailable != nil {
+		value, err := intstr.GetScaledValueFromIntOrPercent(budget.Spec.MaxUnavailable, int(replicas), true)
+		return err == nil && value == 0
+	}
+	if budget.Spec.MinAvailable != nil {
+		value, err := intstr.GetScaledValueFromIntOrPercent(budget.Spec.MinAvailable, int(replicas), true)
+		return err == nil && value >= int(replicas)
+	}
+	return false
+}
+
+// hasPodAntiAffinity reports whether a pod spec asks to be kept apart from other pods
+func hasPodAntiAffinity(spec *corev1.PodSpec) bool {
+	return spec.Affinity != nil && spec.Affinity.PodAntiAffinity != nil &&
+		(len(spec.Affinity.PodAntiAffinity.RequiredDuringSchedulingIgnoredDuringExecution) > 0 ||
+			len(spec.Affinity.PodAntiAffinity.PreferredDuringSchedulingIgnoredDuringExecution) > 0)
+}
+
+// busiestNode returns the node running most of the pods, the first by name on a tie
+func busiestNode(pods []corev1.Pod) string {
+	busiest := ""
+	for _, pod := range pods {
+		node := pod.Spec.NodeName
+		if busiest == "" || podsOnNode(pods, node) > podsOnNode(pods, busiest) ||
+			(podsOnNode(pods, node) == podsOnNode(pods, busiest) && node < busiest) {
+			busiest = node
+		}
+	}
+	return busiest
+}
+
+// podsOnNode counts the pods scheduled on node
+func podsOnNode(pods []corev1.Pod, node string) int {
+	count := 0
+	for _, pod := range pods {
+		if pod.Spec.NodeName == node {
+			count++
+		}
+	}
+	return count
+}
+
+// replicasOrDefault returns the replica count, which defaults to 1 when unset
+func replicasOrDefault(replicas *int32) int32 {
+	if replicas == nil {
+		return 1
+	}
+	return *replicas
+}
+
+// printSuggestions writes one line per suggestion
+func printSuggestions(out io.Writer, suggestions []suggestion) {
+	w := tabwriter.NewWriter(out, 0, 0, 3, ' ', 0)
+	_, _ = fmt.Fprintln(w, "NAMESPACE\tWORKLOAD\tSUGGESTED ACTION\tFINDING")
+	for _, s := range suggestions {
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", s.Namespace, s.Workload, s.Experiment.Spec.Action, s.Finding)
+	}
+	_ = w.Flush()
+}
+
+// printSuggestedExperiments writes the drafted experiments as a multi-document manifest
+func printSuggestedExperiments(out io.Writer, suggestions []suggestion) error {
+	for i, s := range suggestions {
+		data, err := yaml.Marshal(s.Experiment)
+		if err != nil {
+			return fmt.Errorf("failed to encode experiment: %w", err)
+		}
+		if i > 0 {
+			if _, err := io.WriteString(out, "---\n"); err != nil {
+				return err
+			}
+		}
+		if _, err := out.Write(data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
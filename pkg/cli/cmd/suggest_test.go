@@ -0,0 +1,170 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+)
+
+func suggestDeployment(name, namespace string, replicas int32, probed bool) *appsv1.Deployment {
+	container := corev1.Container{Name: "app", Image: "app"}
+	if probed {
+		container.ReadinessProbe = &corev1.Probe{}
+	}
+	selector := map[string]string{"app": name}
+	return &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: ptr.To(replicas),
+			Selector: &metav1.LabelSelector{MatchLabels: selector},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: selector},
+				Spec:       corev1.PodSpec{Containers: []corev1.Container{container}},
+			},
+		},
+	}
+}
+
+func suggestPod(name, namespace, app, node string) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace, Labels: map[string]string{"app": app}},
+		Spec:       corev1.PodSpec{NodeName: node},
+	}
+}
+
+func TestAnalyzeWorkloads(t *testing.T) {
+	spread := suggestDeployment("api", "shop", 3, true)
+	spread.Spec.Template.Spec.TopologySpreadConstraints = []corev1.TopologySpreadConstraint{{
+		MaxSkew: 1, TopologyKey: corev1.LabelHostname, WhenUnsatisfiable: corev1.ScheduleAnyway,
+	}}
+	excluded := suggestDeployment("debug", "shop", 1, true)
+	excluded.Spec.Template.Labels["chaos.gushchin.dev/exclude"] = "true"
+
+	objs := []client.Object{
+		suggestDeployment("single", "shop", 1, true),
+		suggestDeployment("web", "shop", 2, false),
+		spread,
+		excluded,
+		suggestDeployment("coredns", "kube-system", 1, true),
+		&policyv1.PodDisruptionBudget{
+			ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "shop"},
+			Spec: policyv1.PodDisruptionBudgetSpec{
+				MinAvailable: ptr.To(intstr.FromString("100%")),
+				Selector:     &metav1.LabelSelector{MatchLabels: map[string]string{"app": "web"}},
+			},
+		},
+		suggestPod("web-1", "shop", "web", "node-a"),
+		suggestPod("web-2", "shop", "web", "node-a"),
+		suggestPod("api-1", "shop", "api", "node-a"),
+		suggestPod("api-2", "shop", "api", "node-a"),
+		suggestPod("api-3", "shop", "api", "node-b"),
+	}
+	c := newDiagnoseClient(t, interceptor.Funcs{}, objs...)
+
+	suggestions, err := analyzeWorkloads(context.Background(), c, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := map[string]string{}
+	for _, s := range suggestions {
+		got[s.Workload+" "+s.Experiment.Spec.Action] = s.Finding
+		if !s.Experiment.Spec.DryRun || s.Experiment.Spec.Namespace != s.Namespace {
+			t.Errorf("%s: drafts must be dry runs in the workload's namespace: %+v", s.Workload, s.Experiment.Spec)
+		}
+	}
+	if len(got) != 3 {
+		t.Errorf("expected 3 suggestions, got %v", got)
+	}
+	if f := got["Deployment/single pod-kill"]; !strings.Contains(f, "single replica without a PodDisruptionBudget") {
+		t.Errorf("single: unexpected finding %q", f)
+	}
+	if f := got["Deployment/web pod-restart"]; !strings.Contains(f, "no readiness probe") {
+		t.Errorf("web: unexpected readiness finding %q", f)
+	}
+	f := got["Deployment/web node-drain"]
+	if !strings.Contains(f, "PodDisruptionBudget web allows no voluntary disruptions") || !strings.Contains(f, "2 of 2 replicas run on node node-a") {
+		t.Errorf("web: node findings should be merged into one draft, got %q", f)
+	}
+
+	for _, s := range suggestions {
+		if s.Workload == "Deployment/web" && s.Experiment.Spec.Action == "node-drain" &&
+			s.Experiment.Spec.Selector[corev1.LabelHostname] != "node-a" {
+			t.Errorf("node-drain should target node-a, got %v", s.Experiment.Spec.Selector)
+		}
+	}
+}
+
+func TestBlocksDisruptions(t *testing.T) {
+	budget := func(minAvailable, maxUnavailable *intstr.IntOrString) *policyv1.PodDisruptionBudget {
+		return &policyv1.PodDisruptionBudget{Spec: policyv1.PodDisruptionBudgetSpec{
+			MinAvailable: minAvailable, MaxUnavailable: maxUnavailable,
+		}}
+	}
+	tests := []struct {
+		name   string
+		budget *policyv1.PodDisruptionBudget
+		want   bool
+	}{
+		{"maxUnavailable 0", budget(nil, ptr.To(intstr.FromInt32(0))), true},
+		{"maxUnavailable 1", budget(nil, ptr.To(intstr.FromInt32(1))), false},
+		{"minAvailable equals replicas", budget(ptr.To(intstr.FromInt32(3)), nil), true},
+		{"minAvailable 50%", budget(ptr.To(intstr.FromString("50%")), nil), false},
+	}
+	for _, tt := range tests {
+		if got := blocksDisruptions(tt.budget, 3); got != tt.want {
+			t.Errorf("%s: got %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestPrintSuggestedExperiments(t *testing.T) {
+	c := newDiagnoseClient(t, interceptor.Funcs{}, suggestDeployment("single", "shop", 1, false))
+	suggestions, err := analyzeWorkloads(context.Background(), c, "shop")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var out bytes.Buffer
+	if err := printSuggestedExperiments(&out, suggestions); err != nil {
+		t.Fatal(err)
+	}
+	manifests, findings := parseLintManifests("suggested.yaml", out.Bytes())
+	for _, f := range findings {
+		if f.Severity == severityError {
+			t.Errorf("draft does not lint: %+v", f)
+		}
+	}
+	if len(manifests) != 2 {
+		t.Fatalf("expected 2 drafts, got %d:\n%s", len(manifests), out.String())
+	}
+	if manifests[0].Exp.Annotations[suggestionAnnotation] == "" {
+		t.Error("drafts should record their finding")
+	}
+}
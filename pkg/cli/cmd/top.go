@@ -19,8 +19,10 @@ package cmd
 import (
 	"context"
 	"fmt"
+	"io"
 	"os"
 	"sort"
+	"strings"
 	"text/tabwriter"
 	"time"
 
@@ -44,14 +46,34 @@ Examples:
   k8s-chaos top --limit 5
 
   # Show top experiments in a specific namespace
-  k8s-chaos top -n chaos-testing`,
+  k8s-chaos top -n chaos-testing
+
+  # Rank experiments by the most resources they affected in a single run
+  k8s-chaos top --sort-by affected
+
+  # Rank experiments by the number of failed runs
+  k8s-chaos top --sort-by failures`,
 	RunE: runTop,
 }
 
-var topLimit int
+const (
+	sortByAffected = "affected"
+	sortByDuration = "duration"
+	sortByFailures = "failures"
+)
+
+var (
+	topLimit            int
+	topSortBy           string
+	topHistoryNamespace string
+)
 
 func init() {
 	topCmd.Flags().IntVarP(&topLimit, "limit", "l", 10, "limit the number of experiments to show")
+	topCmd.Flags().StringVar(&topSortBy, "sort-by", "",
+		"rank experiments from their history records by \"affected\", \"duration\" or \"failures\"")
+	topCmd.Flags().StringVar(&topHistoryNamespace, "history-namespace", "chaos-system",
+		"namespace where the controller stores history records")
 	rootCmd.AddCommand(topCmd)
 }
 
@@ -65,9 +87,46 @@ type experimentMetrics struct {
 	TargetNS   string
 }
 
+// historyMetrics aggregates the history records of one experiment
+type historyMetrics struct {
+	Name      string
+	Namespace string
+	Action    string
+	Runs      int
+	Failures  int
+	// MaxAffected is the most resources a single run affected
+	MaxAffected int
+	// TotalDuration sums the durations of runs that recorded one
+	TotalDuration time.Duration
+	timedRuns     int
+}
+
+// AvgDuration is the mean duration of the experiment's runs
+func (m historyMetrics) AvgDuration() time.Duration {
+	if m.timedRuns == 0 {
+		return 0
+	}
+	return (m.TotalDuration / time.Duration(m.timedRuns)).Round(time.Second)
+}
+
+// injectingExperiment is an experiment that currently holds injected faults
+type injectingExperiment struct {
+	Name      string
+	Namespace string
+	Action    string
+	Targets   []string
+}
+
 func runTop(cmd *cobra.Command, args []string) error {
 	ctx := context.Background()
 
+	switch topSortBy {
+	case "", sortByAffected, sortByDuration, sortByFailures:
+	default:
+		return fmt.Errorf("invalid --sort-by %q: must be %q, %q or %q",
+			topSortBy, sortByAffected, sortByDuration, sortByFailures)
+	}
+
 	k8sClient, err := getKubeClient()
 	if err != nil {
 		return fmt.Errorf("failed to get Kubernetes client: %w", err)
@@ -88,6 +147,22 @@ func runTop(cmd *cobra.Command, args []string) error {
 		return nil
 	}
 
+	fmt.Println("=== Currently Injecting ===")
+	printInjecting(os.Stdout, injectingExperiments(expList.Items), topLimit)
+	fmt.Println()
+
+	if topSortBy != "" {
+		historyList := &chaosv1alpha1.ChaosExperimentHistoryList{}
+		if err := k8sClient.List(ctx, historyList, client.InNamespace(topHistoryNamespace)); err != nil {
+			return fmt.Errorf("failed to list history records: %w", err)
+		}
+		fmt.Printf("=== Top Experiments by %s (from history) ===\n", topSortTitle(topSortBy))
+		metrics := aggregateHistory(historyList.Items, namespace)
+		sortHistoryMetrics(metrics, topSortBy)
+		printTopFromHistory(os.Stdout, metrics, topLimit)
+		return nil
+	}
+
 	// Collect metrics
 	metrics := make([]experimentMetrics, 0, len(expList.Items))
 	for _, exp := range expList.Items {
@@ -212,3 +287,154 @@ func printFailed(metrics []experimentMetrics) {
 
 	_ = w.Flush()
 }
+
+// injectingExperiments returns experiments with injected pods or modified nodes, most targets first
+func injectingExperiments(experiments []chaosv1alpha1.ChaosExperiment) []injectingExperiment {
+	var result []injectingExperiment
+	for _, exp := range experiments {
+		var targets []string
+		seenPods := map[string]bool{}
+		for _, ref := range exp.Status.AffectedPods {
+			// Entries are namespace/pod:container; a pod with several injected containers counts once
+			pod, _, _ := strings.Cut(ref, ":")
+			if !seenPods[pod] {
+				seenPods[pod] = true
+				targets = append(targets, "pod/"+pod)
+			}
+		}
+		for _, node := range exp.Status.CordonedNodes {
+			targets = append(targets, "node/"+node)
+		}
+		for _, node := range exp.Status.TaintedNodes {
+			targets = append(targets, "node/"+node)
+		}
+		if len(targets) == 0 {
+			continue
+		}
+		result = append(result, injectingExperiment{
+			Name:      exp.Name,
+			Namespace: exp.Namespace,
+			Action:    exp.Spec.Action,
+			Targets:   targets,
+		})
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		return len(result[i].Targets) > len(result[j].Targets)
+	})
+	return result
+}
+
+func printInjecting(out io.Writer, injecting []injectingExperiment, limit int) {
+	if len(injecting) == 0 {
+		_, _ = fmt.Fprintln(out, "No experiments are injecting faults right now")
+		return
+	}
+
+	w := tabwriter.NewWriter(out, 0, 0, 3, ' ', 0)
+	_, _ = fmt.Fprintln(w, "NAMESPACE\tNAME\tACTION\tTARGETS\tRESOURCES")
+	for i := 0; i < limit && i < len(injecting); i++ {
+		e := injecting[i]
+		resources := e.Targets
+		if len(resources) > 3 {
+			resources = append(resources[:3:3], fmt.Sprintf("+%d more", len(e.Targets)-3))
+		}
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%d\t%s\n",
+			e.Namespace, e.Name, e.Action, len(e.Targets), strings.Join(resources, ", "))
+	}
+	_ = w.Flush()
+}
+
+// aggregateHistory folds history records into one entry per experiment. A non-empty namespace
+// restricts the result to experiments in that namespace.
+func aggregateHistory(records []chaosv1alpha1.ChaosExperimentHistory, ns string) []historyMetrics {
+	byExperiment := map[string]*historyMetrics{}
+	var order []string
+	for _, record := range records {
+		ref := record.Spec.ExperimentRef
+		if ns != "" && ref.Namespace != ns {
+			continue
+		}
+		key := ref.Namespace + "/" + ref.Name
+		m, ok := byExperiment[key]
+		if !ok {
+			m = &historyMetrics{Name: ref.Name, Namespace: ref.Namespace}
+			byExperiment[key] = m
+			order = append(order, key)
+		}
+		m.Action = record.Spec.ExperimentSpec.Action
+		m.Runs++
+		if record.Spec.Execution.Status == "failure" {
+			m.Failures++
+		}
+		m.MaxAffected = max(m.MaxAffected, len(record.Spec.AffectedResources))
+		if duration, ok := runDuration(record.Spec.Execution); ok {
+			m.TotalDuration += duration
+			m.timedRuns++
+		}
+	}
+
+	result := make([]historyMetrics, 0, len(order))
+	for _, key := range order {
+		result = append(result, *byExperiment[key])
+	}
+	return result
+}
+
+// runDuration reads the recorded duration of a run, falling back to its start and end times
+func runDuration(execution chaosv1alpha1.ExecutionDetails) (time.Duration, bool) {
+	if d, err := time.ParseDuration(execution.Duration); err == nil {
+		return d, true
+	}
+	if execution.EndTime != nil {
+		return execution.EndTime.Sub(execution.StartTime.Time), true
+	}
+	return 0, false
+}
+
+// sortHistoryMetrics orders metrics descending by the given --sort-by key
+func sortHistoryMetrics(metrics []historyMetrics, by string) {
+	key := func(m historyMetrics) int64 {
+		switch by {
+		case sortByDuration:
+			return int64(m.AvgDuration())
+		case sortByFailures:
+			return int64(m.Failures)
+		default:
+			return int64(m.MaxAffected)
+		}
+	}
+	sort.SliceStable(metrics, func(i, j int) bool {
+		return key(metrics[i]) > key(metrics[j])
+	})
+}
+
+func topSortTitle(by string) string {
+	switch by {
+	case sortByDuration:
+		return "Average Duration"
+	case sortByFailures:
+		return "Failed Runs"
+	default:
+		return "Affected Resources"
+	}
+}
+
+func printTopFromHistory(out io.Writer, metrics []historyMetrics, limit int) {
+	if len(metrics) == 0 {
+		_, _ = fmt.Fprintln(out, "No history records found")
+		return
+	}
+
+	w := tabwriter.NewWriter(out, 0, 0, 3, ' ', 0)
+	_, _ = fmt.Fprintln(w, "NAMESPACE\tNAME\tACTION\tRUNS\tMAX AFFECTED\tAVG DURATION\tFAILURES")
+	for i := 0; i < limit && i < len(metrics); i++ {
+		m := metrics[i]
+		duration := "-"
+		if m.timedRuns > 0 {
+			duration = m.AvgDuration().String()
+		}
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%d\t%d\t%s\t%d\n",
+			m.Namespace, m.Name, m.Action, m.Runs, m.MaxAffected, duration, m.Failures)
+	}
+	_ = w.Flush()
+}
@@ -17,9 +17,15 @@ limitations under the License.
 package cmd
 
 import (
+	"bytes"
 	"sort"
+	"strings"
 	"testing"
 	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	chaosv1alpha1 "github.com/neogan74/k8s-chaos/api/v1alpha1"
 )
 
 const (
@@ -180,3 +186,97 @@ func TestExperimentMetrics_LimitResults(t *testing.T) {
 		t.Fatalf("expected 3 results with limit, got %d", len(limited))
 	}
 }
+
+func TestInjectingExperiments(t *testing.T) {
+	experiments := []chaosv1alpha1.ChaosExperiment{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "idle", Namespace: "team-a"},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "stress", Namespace: "team-a"},
+			Spec:       chaosv1alpha1.ChaosExperimentSpec{Action: "pod-cpu-stress"},
+			Status: chaosv1alpha1.ChaosExperimentStatus{AffectedPods: []string{
+				"team-a/web-1:chaos-cpu-1", "team-a/web-1:chaos-cpu-2", "team-a/web-2:chaos-cpu-1",
+			}},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "drain", Namespace: "ops"},
+			Spec:       chaosv1alpha1.ChaosExperimentSpec{Action: "node-drain"},
+			Status:     chaosv1alpha1.ChaosExperimentStatus{CordonedNodes: []string{"n1", "n2", "n3"}},
+		},
+	}
+
+	injecting := injectingExperiments(experiments)
+
+	if len(injecting) != 2 {
+		t.Fatalf("expected 2 injecting experiments, got %+v", injecting)
+	}
+	if injecting[0].Name != "drain" || len(injecting[0].Targets) != 3 {
+		t.Errorf("expected drain with 3 nodes first, got %+v", injecting[0])
+	}
+	if strings.Join(injecting[1].Targets, ",") != "pod/team-a/web-1,pod/team-a/web-2" {
+		t.Errorf("expected pods to be counted once, got %v", injecting[1].Targets)
+	}
+
+	var out bytes.Buffer
+	printInjecting(&out, injecting, 10)
+	if !strings.Contains(out.String(), "node/n1, node/n2, node/n3") {
+		t.Errorf("unexpected output:\n%s", out.String())
+	}
+}
+
+func TestAggregateHistory_SortBy(t *testing.T) {
+	start := metav1.NewTime(time.Date(2025, 6, 1, 10, 0, 0, 0, time.UTC))
+	end := metav1.NewTime(start.Add(4 * time.Minute))
+	run := func(name, status, duration string, affected int) chaosv1alpha1.ChaosExperimentHistory {
+		record := chaosv1alpha1.ChaosExperimentHistory{
+			Spec: chaosv1alpha1.ChaosExperimentHistorySpec{
+				ExperimentRef:  chaosv1alpha1.ObjectReference{Name: name, Namespace: "team-a"},
+				ExperimentSpec: chaosv1alpha1.ChaosExperimentSpec{Action: "pod-kill"},
+				Execution:      chaosv1alpha1.ExecutionDetails{StartTime: start, Status: status, Duration: duration},
+			},
+		}
+		if duration == "" {
+			record.Spec.Execution.EndTime = &end
+		}
+		for i := 0; i < affected; i++ {
+			record.Spec.AffectedResources = append(record.Spec.AffectedResources,
+				chaosv1alpha1.ResourceReference{Kind: "Pod", Name: "p", Action: "deleted"})
+		}
+		return record
+	}
+	records := []chaosv1alpha1.ChaosExperimentHistory{
+		run("wide", "success", "10s", 8),
+		run("wide", "success", "20s", 2),
+		run("slow", "success", "", 1),
+		run("flaky", "failure", "30s", 1),
+		run("flaky", "failure", "30s", 1),
+	}
+
+	metrics := aggregateHistory(records, "")
+	if len(metrics) != 3 {
+		t.Fatalf("expected 3 experiments, got %+v", metrics)
+	}
+
+	for _, tc := range []struct {
+		by, first string
+	}{
+		{sortByAffected, "wide"},
+		{sortByDuration, "slow"},
+		{sortByFailures, "flaky"},
+	} {
+		sortHistoryMetrics(metrics, tc.by)
+		if metrics[0].Name != tc.first {
+			t.Errorf("--sort-by %s: expected %s first, got %s", tc.by, tc.first, metrics[0].Name)
+		}
+	}
+
+	sortHistoryMetrics(metrics, sortByAffected)
+	if metrics[0].MaxAffected != 8 || metrics[0].Runs != 2 || metrics[0].AvgDuration() != 15*time.Second {
+		t.Errorf("unexpected aggregate %+v", metrics[0])
+	}
+
+	if got := aggregateHistory(records, "other"); len(got) != 0 {
+		t.Errorf("expected namespace filter to drop all records, got %+v", got)
+	}
+}
@@ -0,0 +1,205 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/cache"
+
+	chaosv1alpha1 "github.com/neogan74/k8s-chaos/api/v1alpha1"
+)
+
+const experimentPath = "/apis/chaos.gushchin.dev/v1alpha1/namespaces/default/chaosexperiments"
+
+func writeJSON(t *testing.T, w http.ResponseWriter, status int, obj any) {
+	t.Helper()
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(obj); err != nil {
+		t.Error(err)
+	}
+}
+
+func experiment(phase, message string) *chaosv1alpha1.ChaosExperiment {
+	return &chaosv1alpha1.ChaosExperiment{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "chaos.gushchin.dev/v1alpha1", Kind: "ChaosExperiment"},
+		ObjectMeta: metav1.ObjectMeta{Name: "web-kill", Namespace: "default"},
+		Spec:       chaosv1alpha1.ChaosExperimentSpec{Action: "pod-kill", Namespace: "default"},
+		Status:     chaosv1alpha1.ChaosExperimentStatus{Phase: phase, Message: message},
+	}
+}
+
+func newTestClientset(t *testing.T, handler http.HandlerFunc) *Clientset {
+	t.Helper()
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+	cs, err := NewForConfig(&rest.Config{Host: srv.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return cs
+}
+
+func TestCreateAndGet(t *testing.T) {
+	cs := newTestClientset(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == experimentPath:
+			var exp chaosv1alpha1.ChaosExperiment
+			if err := json.NewDecoder(r.Body).Decode(&exp); err != nil || exp.Spec.Action != "pod-kill" {
+				t.Errorf("unexpected body %+v (%v)", exp, err)
+			}
+			writeJSON(t, w, http.StatusCreated, experiment(PhasePending, ""))
+		case r.Method == http.MethodGet && r.URL.Path == experimentPath+"/missing":
+			writeJSON(t, w, http.StatusNotFound, apierrors.NewNotFound(chaosv1alpha1.GroupVersion.WithResource("chaosexperiments").GroupResource(),
+				"missing").Status())
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	ctx := context.Background()
+	created, err := cs.ChaosExperiments("default").Create(ctx, experiment("", ""), metav1.CreateOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if created.Status.Phase != PhasePending {
+		t.Errorf("unexpected phase %q", created.Status.Phase)
+	}
+
+	if _, err := cs.ChaosExperiments("default").Get(ctx, "missing", metav1.GetOptions{}); !apierrors.IsNotFound(err) {
+		t.Errorf("expected NotFound, got %v", err)
+	}
+}
+
+func TestWaitForCompletion(t *testing.T) {
+	PollInterval = 10 * time.Millisecond
+	defer func() { PollInterval = 2 * time.Second }()
+
+	phases := []string{PhasePending, PhaseRunning, PhaseRunning, PhaseFailed}
+	var calls atomic.Int32
+	cs := newTestClientset(t, func(w http.ResponseWriter, r *http.Request) {
+		n := int(calls.Add(1)) - 1
+		writeJSON(t, w, http.StatusOK, experiment(phases[min(n, len(phases)-1)], "no eligible pods"))
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	exp, err := cs.WaitForCompletion(ctx, "default", "web-kill")
+	if !errors.Is(err, ErrExperimentFailed) {
+		t.Fatalf("expected ErrExperimentFailed, got %v", err)
+	}
+	if exp.Status.Phase != PhaseFailed || calls.Load() != 4 {
+		t.Errorf("unexpected result phase=%s calls=%d", exp.Status.Phase, calls.Load())
+	}
+
+	// A context deadline ends the wait
+	short, cancelShort := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancelShort()
+	if _, err := cs.WaitForPhase(short, "default", "web-kill", "Unknown"); err == nil {
+		t.Error("expected the wait to time out")
+	}
+}
+
+func TestReport(t *testing.T) {
+	start := time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC)
+	record := func(name, namespace, status string, offset time.Duration, affected int) chaosv1alpha1.ChaosExperimentHistory {
+		h := chaosv1alpha1.ChaosExperimentHistory{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "chaos-history"},
+			Spec: chaosv1alpha1.ChaosExperimentHistorySpec{
+				ExperimentRef: chaosv1alpha1.ObjectReference{Name: "web-kill", Namespace: namespace},
+				Execution:     chaosv1alpha1.ExecutionDetails{Status: status, StartTime: metav1.NewTime(start.Add(offset))},
+			},
+		}
+		for i := 0; i < affected; i++ {
+			h.Spec.AffectedResources = append(h.Spec.AffectedResources, chaosv1alpha1.ResourceReference{Kind: "Pod", Name: "p"})
+		}
+		return h
+	}
+
+	cs := newTestClientset(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case experimentPath + "/web-kill":
+			writeJSON(t, w, http.StatusOK, experiment(PhaseRunning, ""))
+		case "/apis/chaos.gushchin.dev/v1alpha1/namespaces/chaos-history/chaosexperimenthistories":
+			if got := r.URL.Query().Get("labelSelector"); got != ExperimentLabel+"=web-kill" {
+				t.Errorf("unexpected label selector %q", got)
+			}
+			writeJSON(t, w, http.StatusOK, &chaosv1alpha1.ChaosExperimentHistoryList{
+				TypeMeta: metav1.TypeMeta{APIVersion: "chaos.gushchin.dev/v1alpha1", Kind: "ChaosExperimentHistoryList"},
+				Items: []chaosv1alpha1.ChaosExperimentHistory{
+					record("first", "default", "success", 0, 2),
+					record("other-namespace", "staging", "failure", time.Hour, 1),
+					record("latest", "default", "failure", 2*time.Hour, 1),
+				},
+			})
+		default:
+			t.Errorf("unexpected request %s", r.URL.Path)
+		}
+	})
+	cs.HistoryNamespace = "chaos-history"
+
+	report, err := cs.Report(context.Background(), "default", "web-kill")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(report.Runs) != 2 || report.LastRun().Name != "latest" {
+		t.Errorf("unexpected runs %v", report.Runs)
+	}
+	if report.Results["success"] != 1 || report.Results["failure"] != 1 || report.AffectedResources != 3 {
+		t.Errorf("unexpected summary %v, %d affected", report.Results, report.AffectedResources)
+	}
+}
+
+func TestChaosExperimentLister(t *testing.T) {
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	for _, ns := range []string{"default", "staging"} {
+		exp := experiment(PhaseRunning, "")
+		exp.Namespace = ns
+		exp.Labels = map[string]string{"team": ns}
+		if err := indexer.Add(exp); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	lister := NewChaosExperimentLister(indexer)
+	all, err := lister.List(labels.Everything())
+	if err != nil || len(all) != 2 {
+		t.Fatalf("expected 2 experiments, got %d (%v)", len(all), err)
+	}
+	if exp, err := lister.ChaosExperiments("staging").Get("web-kill"); err != nil || exp.Namespace != "staging" {
+		t.Errorf("unexpected experiment %v (%v)", exp, err)
+	}
+	if _, err := lister.ChaosExperiments("prod").Get("web-kill"); !apierrors.IsNotFound(err) {
+		t.Errorf("expected NotFound, got %v", err)
+	}
+	selected, _ := lister.List(labels.SelectorFromSet(labels.Set{"team": "default"}))
+	if len(selected) != 1 {
+		t.Errorf("expected the selector to match one experiment, got %d", len(selected))
+	}
+}
@@ -0,0 +1,244 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package client is a typed Go client for the chaos.gushchin.dev/v1alpha1 API, for platform
+// tooling that manages experiments programmatically. It provides a clientset in the style of the
+// Kubernetes clientsets, informers and listers for caching, and helpers to wait for an
+// experiment to finish and to report on its runs.
+//
+//	cs, err := client.NewForConfig(config)
+//	exp, err := cs.ChaosExperiments("default").Create(ctx, exp, metav1.CreateOptions{})
+//	exp, err = cs.WaitForCompletion(ctx, "default", exp.Name)
+//	report, err := cs.Report(ctx, "default", exp.Name)
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/gentype"
+	"k8s.io/client-go/rest"
+
+	chaosv1alpha1 "github.com/neogan74/k8s-chaos/api/v1alpha1"
+)
+
+// Scheme knows the chaos.gushchin.dev/v1alpha1 types
+var Scheme = runtime.NewScheme()
+
+// ParameterCodec encodes list and watch options into query parameters
+var ParameterCodec = runtime.NewParameterCodec(Scheme)
+
+func init() {
+	if err := chaosv1alpha1.AddToScheme(Scheme); err != nil {
+		panic(err)
+	}
+}
+
+// ChaosCleanupTaskInterface manages ChaosCleanupTasks in one namespace
+type ChaosCleanupTaskInterface interface {
+	Create(ctx context.Context, obj *chaosv1alpha1.ChaosCleanupTask, opts metav1.CreateOptions) (*chaosv1alpha1.ChaosCleanupTask, error)
+	Update(ctx context.Context, obj *chaosv1alpha1.ChaosCleanupTask, opts metav1.UpdateOptions) (*chaosv1alpha1.ChaosCleanupTask, error)
+	UpdateStatus(ctx context.Context, obj *chaosv1alpha1.ChaosCleanupTask, opts metav1.UpdateOptions) (*chaosv1alpha1.ChaosCleanupTask, error)
+	Delete(ctx context.Context, name string, opts metav1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts metav1.DeleteOptions, listOpts metav1.ListOptions) error
+	Get(ctx context.Context, name string, opts metav1.GetOptions) (*chaosv1alpha1.ChaosCleanupTask, error)
+	List(ctx context.Context, opts metav1.ListOptions) (*chaosv1alpha1.ChaosCleanupTaskList, error)
+	Watch(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions,
+		subresources ...string) (*chaosv1alpha1.ChaosCleanupTask, error)
+}
+
+// ChaosExperimentInterface manages ChaosExperiments in one namespace
+type ChaosExperimentInterface interface {
+	Create(ctx context.Context, obj *chaosv1alpha1.ChaosExperiment, opts metav1.CreateOptions) (*chaosv1alpha1.ChaosExperiment, error)
+	Update(ctx context.Context, obj *chaosv1alpha1.ChaosExperiment, opts metav1.UpdateOptions) (*chaosv1alpha1.ChaosExperiment, error)
+	UpdateStatus(ctx context.Context, obj *chaosv1alpha1.ChaosExperiment, opts metav1.UpdateOptions) (*chaosv1alpha1.ChaosExperiment, error)
+	Delete(ctx context.Context, name string, opts metav1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts metav1.DeleteOptions, listOpts metav1.ListOptions) error
+	Get(ctx context.Context, name string, opts metav1.GetOptions) (*chaosv1alpha1.ChaosExperiment, error)
+	List(ctx context.Context, opts metav1.ListOptions) (*chaosv1alpha1.ChaosExperimentList, error)
+	Watch(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions,
+		subresources ...string) (*chaosv1alpha1.ChaosExperiment, error)
+}
+
+// ChaosExperimentHistoryInterface manages ChaosExperimentHistory records in one namespace
+type ChaosExperimentHistoryInterface interface {
+	Create(ctx context.Context, obj *chaosv1alpha1.ChaosExperimentHistory, opts metav1.CreateOptions) (*chaosv1alpha1.ChaosExperimentHistory, error)
+	Update(ctx context.Context, obj *chaosv1alpha1.ChaosExperimentHistory, opts metav1.UpdateOptions) (*chaosv1alpha1.ChaosExperimentHistory, error)
+	UpdateStatus(ctx context.Context, obj *chaosv1alpha1.ChaosExperimentHistory, opts metav1.UpdateOptions) (*chaosv1alpha1.ChaosExperimentHistory, error)
+	Delete(ctx context.Context, name string, opts metav1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts metav1.DeleteOptions, listOpts metav1.ListOptions) error
+	Get(ctx context.Context, name string, opts metav1.GetOptions) (*chaosv1alpha1.ChaosExperimentHistory, error)
+	List(ctx context.Context, opts metav1.ListOptions) (*chaosv1alpha1.ChaosExperimentHistoryList, error)
+	Watch(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions,
+		subresources ...string) (*chaosv1alpha1.ChaosExperimentHistory, error)
+}
+
+// ChaosFreezeInterface manages the cluster-scoped ChaosFreezes
+type ChaosFreezeInterface interface {
+	Create(ctx context.Context, obj *chaosv1alpha1.ChaosFreeze, opts metav1.CreateOptions) (*chaosv1alpha1.ChaosFreeze, error)
+	Update(ctx context.Context, obj *chaosv1alpha1.ChaosFreeze, opts metav1.UpdateOptions) (*chaosv1alpha1.ChaosFreeze, error)
+	UpdateStatus(ctx context.Context, obj *chaosv1alpha1.ChaosFreeze, opts metav1.UpdateOptions) (*chaosv1alpha1.ChaosFreeze, error)
+	Delete(ctx context.Context, name string, opts metav1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts metav1.DeleteOptions, listOpts metav1.ListOptions) error
+	Get(ctx context.Context, name string, opts metav1.GetOptions) (*chaosv1alpha1.ChaosFreeze, error)
+	List(ctx context.Context, opts metav1.ListOptions) (*chaosv1alpha1.ChaosFreezeList, error)
+	Watch(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions,
+		subresources ...string) (*chaosv1alpha1.ChaosFreeze, error)
+}
+
+// ChaosMonkeyInterface manages ChaosMonkeys in one namespace
+type ChaosMonkeyInterface interface {
+	Create(ctx context.Context, obj *chaosv1alpha1.ChaosMonkey, opts metav1.CreateOptions) (*chaosv1alpha1.ChaosMonkey, error)
+	Update(ctx context.Context, obj *chaosv1alpha1.ChaosMonkey, opts metav1.UpdateOptions) (*chaosv1alpha1.ChaosMonkey, error)
+	UpdateStatus(ctx context.Context, obj *chaosv1alpha1.ChaosMonkey, opts metav1.UpdateOptions) (*chaosv1alpha1.ChaosMonkey, error)
+	Delete(ctx context.Context, name string, opts metav1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts metav1.DeleteOptions, listOpts metav1.ListOptions) error
+	Get(ctx context.Context, name string, opts metav1.GetOptions) (*chaosv1alpha1.ChaosMonkey, error)
+	List(ctx context.Context, opts metav1.ListOptions) (*chaosv1alpha1.ChaosMonkeyList, error)
+	Watch(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions,
+		subresources ...string) (*chaosv1alpha1.ChaosMonkey, error)
+}
+
+// ChaosPolicyInterface manages the cluster-scoped ChaosPolicies
+type ChaosPolicyInterface interface {
+	Create(ctx context.Context, obj *chaosv1alpha1.ChaosPolicy, opts metav1.CreateOptions) (*chaosv1alpha1.ChaosPolicy, error)
+	Update(ctx context.Context, obj *chaosv1alpha1.ChaosPolicy, opts metav1.UpdateOptions) (*chaosv1alpha1.ChaosPolicy, error)
+	UpdateStatus(ctx context.Context, obj *chaosv1alpha1.ChaosPolicy, opts metav1.UpdateOptions) (*chaosv1alpha1.ChaosPolicy, error)
+	Delete(ctx context.Context, name string, opts metav1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts metav1.DeleteOptions, listOpts metav1.ListOptions) error
+	Get(ctx context.Context, name string, opts metav1.GetOptions) (*chaosv1alpha1.ChaosPolicy, error)
+	List(ctx context.Context, opts metav1.ListOptions) (*chaosv1alpha1.ChaosPolicyList, error)
+	Watch(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions,
+		subresources ...string) (*chaosv1alpha1.ChaosPolicy, error)
+}
+
+// Interface is the typed client of the chaos.gushchin.dev/v1alpha1 API
+type Interface interface {
+	ChaosCleanupTasks(namespace string) ChaosCleanupTaskInterface
+	ChaosExperiments(namespace string) ChaosExperimentInterface
+	ChaosExperimentHistories(namespace string) ChaosExperimentHistoryInterface
+	ChaosFreezes() ChaosFreezeInterface
+	ChaosMonkeys(namespace string) ChaosMonkeyInterface
+	ChaosPolicies() ChaosPolicyInterface
+	RESTClient() rest.Interface
+}
+
+// Clientset implements Interface on a REST client
+type Clientset struct {
+	restClient rest.Interface
+	// HistoryNamespace is where the controller stores history records, as set by its
+	// --history-namespace flag; Report reads records from there
+	HistoryNamespace string
+}
+
+var _ Interface = &Clientset{}
+
+// NewForConfig creates a clientset for the given config
+func NewForConfig(config *rest.Config) (*Clientset, error) {
+	httpClient, err := rest.HTTPClientFor(config)
+	if err != nil {
+		return nil, err
+	}
+	return NewForConfigAndClient(config, httpClient)
+}
+
+// NewForConfigAndClient creates a clientset for the given config, sharing httpClient
+func NewForConfigAndClient(config *rest.Config, httpClient *http.Client) (*Clientset, error) {
+	cfg := *config
+	cfg.GroupVersion = &chaosv1alpha1.GroupVersion
+	cfg.APIPath = "/apis"
+	cfg.ContentType = runtime.ContentTypeJSON
+	cfg.NegotiatedSerializer = serializer.NewCodecFactory(Scheme).WithoutConversion()
+	if cfg.UserAgent == "" {
+		cfg.UserAgent = rest.DefaultKubernetesUserAgent()
+	}
+
+	restClient, err := rest.RESTClientForConfigAndClient(&cfg, httpClient)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create chaos REST client: %w", err)
+	}
+	return New(restClient), nil
+}
+
+// New creates a clientset on top of restClient
+func New(restClient rest.Interface) *Clientset {
+	return &Clientset{restClient: restClient, HistoryNamespace: DefaultHistoryNamespace}
+}
+
+// ChaosCleanupTasks returns a client for the ChaosCleanupTasks in namespace
+func (c *Clientset) ChaosCleanupTasks(namespace string) ChaosCleanupTaskInterface {
+	return gentype.NewClientWithList[*chaosv1alpha1.ChaosCleanupTask, *chaosv1alpha1.ChaosCleanupTaskList](
+		"chaoscleanuptasks", c.restClient, ParameterCodec, namespace,
+		func() *chaosv1alpha1.ChaosCleanupTask { return &chaosv1alpha1.ChaosCleanupTask{} },
+		func() *chaosv1alpha1.ChaosCleanupTaskList { return &chaosv1alpha1.ChaosCleanupTaskList{} })
+}
+
+// ChaosExperiments returns a client for the ChaosExperiments in namespace
+func (c *Clientset) ChaosExperiments(namespace string) ChaosExperimentInterface {
+	return gentype.NewClientWithList[*chaosv1alpha1.ChaosExperiment, *chaosv1alpha1.ChaosExperimentList](
+		"chaosexperiments", c.restClient, ParameterCodec, namespace,
+		func() *chaosv1alpha1.ChaosExperiment { return &chaosv1alpha1.ChaosExperiment{} },
+		func() *chaosv1alpha1.ChaosExperimentList { return &chaosv1alpha1.ChaosExperimentList{} })
+}
+
+// ChaosExperimentHistories returns a client for the history records in namespace
+func (c *Clientset) ChaosExperimentHistories(namespace string) ChaosExperimentHistoryInterface {
+	return gentype.NewClientWithList[*chaosv1alpha1.ChaosExperimentHistory, *chaosv1alpha1.ChaosExperimentHistoryList](
+		"chaosexperimenthistories", c.restClient, ParameterCodec, namespace,
+		func() *chaosv1alpha1.ChaosExperimentHistory { return &chaosv1alpha1.ChaosExperimentHistory{} },
+		func() *chaosv1alpha1.ChaosExperimentHistoryList { return &chaosv1alpha1.ChaosExperimentHistoryList{} })
+}
+
+// ChaosFreezes returns a client for ChaosFreezes
+func (c *Clientset) ChaosFreezes() ChaosFreezeInterface {
+	return gentype.NewClientWithList[*chaosv1alpha1.ChaosFreeze, *chaosv1alpha1.ChaosFreezeList](
+		"chaosfreezes", c.restClient, ParameterCodec, "",
+		func() *chaosv1alpha1.ChaosFreeze { return &chaosv1alpha1.ChaosFreeze{} },
+		func() *chaosv1alpha1.ChaosFreezeList { return &chaosv1alpha1.ChaosFreezeList{} })
+}
+
+// ChaosMonkeys returns a client for the ChaosMonkeys in namespace
+func (c *Clientset) ChaosMonkeys(namespace string) ChaosMonkeyInterface {
+	return gentype.NewClientWithList[*chaosv1alpha1.ChaosMonkey, *chaosv1alpha1.ChaosMonkeyList](
+		"chaosmonkeys", c.restClient, ParameterCodec, namespace,
+		func() *chaosv1alpha1.ChaosMonkey { return &chaosv1alpha1.ChaosMonkey{} },
+		func() *chaosv1alpha1.ChaosMonkeyList { return &chaosv1alpha1.ChaosMonkeyList{} })
+}
+
+// ChaosPolicies returns a client for ChaosPolicies
+func (c *Clientset) ChaosPolicies() ChaosPolicyInterface {
+	return gentype.NewClientWithList[*chaosv1alpha1.ChaosPolicy, *chaosv1alpha1.ChaosPolicyList](
+		"chaospolicies", c.restClient, ParameterCodec, "",
+		func() *chaosv1alpha1.ChaosPolicy { return &chaosv1alpha1.ChaosPolicy{} },
+		func() *chaosv1alpha1.ChaosPolicyList { return &chaosv1alpha1.ChaosPolicyList{} })
+}
+
+// RESTClient returns the underlying REST client
+func (c *Clientset) RESTClient() rest.Interface {
+	return c.restClient
+}
@@ -0,0 +1,153 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	chaosv1alpha1 "github.com/neogan74/k8s-chaos/api/v1alpha1"
+)
+
+// Experiment phases reported in status.phase
+const (
+	PhasePending   = "Pending"
+	PhaseRunning   = "Running"
+	PhaseCompleted = "Completed"
+	PhaseFailed    = "Failed"
+)
+
+const (
+	// DefaultHistoryNamespace is the controller's default --history-namespace
+	DefaultHistoryNamespace = "chaos-system"
+	// ExperimentLabel is set on history records to the name of their experiment
+	ExperimentLabel = "chaos.gushchin.dev/experiment"
+)
+
+// PollInterval is how often the wait helpers fetch the experiment
+var PollInterval = 2 * time.Second
+
+// ErrExperimentFailed is returned by WaitForCompletion when the experiment ends in the Failed phase
+var ErrExperimentFailed = errors.New("experiment failed")
+
+// WaitFor polls the experiment until done returns true or ctx ends. A missing experiment is
+// an error, so a deleted experiment does not block until the deadline.
+func (c *Clientset) WaitFor(ctx context.Context, namespace, name string,
+	done func(*chaosv1alpha1.ChaosExperiment) bool) (*chaosv1alpha1.ChaosExperiment, error) {
+	var exp *chaosv1alpha1.ChaosExperiment
+	err := wait.PollUntilContextCancel(ctx, PollInterval, true, func(ctx context.Context) (bool, error) {
+		var err error
+		if exp, err = c.ChaosExperiments(namespace).Get(ctx, name, metav1.GetOptions{}); err != nil {
+			return false, err
+		}
+		return done(exp), nil
+	})
+	if err != nil {
+		return exp, fmt.Errorf("waiting for experiment %s/%s: %w", namespace, name, err)
+	}
+	return exp, nil
+}
+
+// WaitForPhase waits until the experiment is in one of phases
+func (c *Clientset) WaitForPhase(ctx context.Context, namespace, name string,
+	phases ...string) (*chaosv1alpha1.ChaosExperiment, error) {
+	return c.WaitFor(ctx, namespace, name, func(exp *chaosv1alpha1.ChaosExperiment) bool {
+		return slices.Contains(phases, exp.Status.Phase)
+	})
+}
+
+// WaitForCompletion waits until the experiment is Completed or Failed, returning
+// ErrExperimentFailed for the latter. Only experiments with spec.experimentDuration or a failure
+// ever finish; use WaitForRun for experiments that repeat until deleted.
+func (c *Clientset) WaitForCompletion(ctx context.Context, namespace, name string) (*chaosv1alpha1.ChaosExperiment, error) {
+	exp, err := c.WaitForPhase(ctx, namespace, name, PhaseCompleted, PhaseFailed)
+	if err != nil {
+		return exp, err
+	}
+	if exp.Status.Phase == PhaseFailed {
+		return exp, fmt.Errorf("%w: %s", ErrExperimentFailed, exp.Status.Message)
+	}
+	return exp, nil
+}
+
+// WaitForRun waits until the experiment has run after since, according to status.lastRunTime
+func (c *Clientset) WaitForRun(ctx context.Context, namespace, name string, since time.Time) (*chaosv1alpha1.ChaosExperiment, error) {
+	return c.WaitFor(ctx, namespace, name, func(exp *chaosv1alpha1.ChaosExperiment) bool {
+		return exp.Status.LastRunTime != nil && exp.Status.LastRunTime.After(since)
+	})
+}
+
+// Report summarizes an experiment and the runs recorded in its history
+type Report struct {
+	Experiment *chaosv1alpha1.ChaosExperiment
+	// Runs are the history records of the experiment, newest first
+	Runs []chaosv1alpha1.ChaosExperimentHistory
+	// Results counts runs by execution status (success, failure, partial, cancelled)
+	Results map[string]int
+	// AffectedResources is the number of resources affected over all runs
+	AffectedResources int
+}
+
+// LastRun returns the newest run, or nil when none was recorded
+func (r *Report) LastRun() *chaosv1alpha1.ChaosExperimentHistory {
+	if len(r.Runs) == 0 {
+		return nil
+	}
+	return &r.Runs[0]
+}
+
+// Report fetches an experiment and its history records from HistoryNamespace
+func (c *Clientset) Report(ctx context.Context, namespace, name string) (*Report, error) {
+	exp, err := c.ChaosExperiments(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	historyNamespace := c.HistoryNamespace
+	if historyNamespace == "" {
+		historyNamespace = DefaultHistoryNamespace
+	}
+	list, err := c.ChaosExperimentHistories(historyNamespace).List(ctx, metav1.ListOptions{
+		LabelSelector: labels.SelectorFromSet(labels.Set{ExperimentLabel: name}).String(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list history of %s/%s: %w", namespace, name, err)
+	}
+
+	report := &Report{Experiment: exp, Results: map[string]int{}}
+	for _, record := range list.Items {
+		// Experiments of the same name in other namespaces share the label
+		if record.Spec.ExperimentRef.Namespace != namespace {
+			continue
+		}
+		report.Runs = append(report.Runs, record)
+		report.Results[record.Spec.Execution.Status]++
+		report.AffectedResources += len(record.Spec.AffectedResources)
+	}
+	sort.SliceStable(report.Runs, func(i, j int) bool {
+		return report.Runs[j].Spec.Execution.StartTime.Before(&report.Runs[i].Spec.Execution.StartTime)
+	})
+	return report, nil
+}
@@ -0,0 +1,243 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/listers"
+	"k8s.io/client-go/tools/cache"
+
+	chaosv1alpha1 "github.com/neogan74/k8s-chaos/api/v1alpha1"
+)
+
+// newInformer builds a shared index informer from a list and a watch function
+func newInformer(obj runtime.Object, resync time.Duration, indexers cache.Indexers,
+	list cache.ListWithContextFunc, watchFn cache.WatchFuncWithContext) cache.SharedIndexInformer {
+	if indexers == nil {
+		indexers = cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc}
+	}
+	return cache.NewSharedIndexInformer(
+		&cache.ListWatch{ListWithContextFunc: list, WatchFuncWithContext: watchFn},
+		obj, resync, indexers)
+}
+
+// NewChaosCleanupTaskInformer returns an informer for the ChaosCleanupTasks in namespace ("" for all
+// namespaces)
+func NewChaosCleanupTaskInformer(c Interface, namespace string, resync time.Duration,
+	indexers cache.Indexers) cache.SharedIndexInformer {
+	return newInformer(&chaosv1alpha1.ChaosCleanupTask{}, resync, indexers,
+		func(ctx context.Context, opts metav1.ListOptions) (runtime.Object, error) {
+			return c.ChaosCleanupTasks(namespace).List(ctx, opts)
+		},
+		func(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error) {
+			return c.ChaosCleanupTasks(namespace).Watch(ctx, opts)
+		})
+}
+
+// NewChaosExperimentInformer returns an informer for the ChaosExperiments in namespace ("" for all
+// namespaces). Indexers default to a namespace index.
+func NewChaosExperimentInformer(c Interface, namespace string, resync time.Duration,
+	indexers cache.Indexers) cache.SharedIndexInformer {
+	return newInformer(&chaosv1alpha1.ChaosExperiment{}, resync, indexers,
+		func(ctx context.Context, opts metav1.ListOptions) (runtime.Object, error) {
+			return c.ChaosExperiments(namespace).List(ctx, opts)
+		},
+		func(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error) {
+			return c.ChaosExperiments(namespace).Watch(ctx, opts)
+		})
+}
+
+// NewChaosExperimentHistoryInformer returns an informer for the history records in namespace
+// ("" for all namespaces)
+func NewChaosExperimentHistoryInformer(c Interface, namespace string, resync time.Duration,
+	indexers cache.Indexers) cache.SharedIndexInformer {
+	return newInformer(&chaosv1alpha1.ChaosExperimentHistory{}, resync, indexers,
+		func(ctx context.Context, opts metav1.ListOptions) (runtime.Object, error) {
+			return c.ChaosExperimentHistories(namespace).List(ctx, opts)
+		},
+		func(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error) {
+			return c.ChaosExperimentHistories(namespace).Watch(ctx, opts)
+		})
+}
+
+// NewChaosFreezeInformer returns an informer for ChaosFreezes
+func NewChaosFreezeInformer(c Interface, resync time.Duration, indexers cache.Indexers) cache.SharedIndexInformer {
+	return newInformer(&chaosv1alpha1.ChaosFreeze{}, resync, indexers,
+		func(ctx context.Context, opts metav1.ListOptions) (runtime.Object, error) {
+			return c.ChaosFreezes().List(ctx, opts)
+		},
+		func(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error) {
+			return c.ChaosFreezes().Watch(ctx, opts)
+		})
+}
+
+// NewChaosMonkeyInformer returns an informer for the ChaosMonkeys in namespace ("" for all namespaces)
+func NewChaosMonkeyInformer(c Interface, namespace string, resync time.Duration,
+	indexers cache.Indexers) cache.SharedIndexInformer {
+	return newInformer(&chaosv1alpha1.ChaosMonkey{}, resync, indexers,
+		func(ctx context.Context, opts metav1.ListOptions) (runtime.Object, error) {
+			return c.ChaosMonkeys(namespace).List(ctx, opts)
+		},
+		func(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error) {
+			return c.ChaosMonkeys(namespace).Watch(ctx, opts)
+		})
+}
+
+// NewChaosPolicyInformer returns an informer for ChaosPolicies
+func NewChaosPolicyInformer(c Interface, resync time.Duration, indexers cache.Indexers) cache.SharedIndexInformer {
+	return newInformer(&chaosv1alpha1.ChaosPolicy{}, resync, indexers,
+		func(ctx context.Context, opts metav1.ListOptions) (runtime.Object, error) {
+			return c.ChaosPolicies().List(ctx, opts)
+		},
+		func(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error) {
+			return c.ChaosPolicies().Watch(ctx, opts)
+		})
+}
+
+// ChaosCleanupTaskLister lists ChaosCleanupTasks from an informer's indexer
+type ChaosCleanupTaskLister interface {
+	List(selector labels.Selector) ([]*chaosv1alpha1.ChaosCleanupTask, error)
+	ChaosCleanupTasks(namespace string) ChaosCleanupTaskNamespaceLister
+}
+
+// ChaosCleanupTaskNamespaceLister lists and gets the ChaosCleanupTasks of one namespace
+type ChaosCleanupTaskNamespaceLister interface {
+	List(selector labels.Selector) ([]*chaosv1alpha1.ChaosCleanupTask, error)
+	Get(name string) (*chaosv1alpha1.ChaosCleanupTask, error)
+}
+
+type chaosCleanupTaskLister struct {
+	listers.ResourceIndexer[*chaosv1alpha1.ChaosCleanupTask]
+}
+
+// NewChaosCleanupTaskLister returns a lister reading from indexer
+func NewChaosCleanupTaskLister(indexer cache.Indexer) ChaosCleanupTaskLister {
+	return chaosCleanupTaskLister{listers.New[*chaosv1alpha1.ChaosCleanupTask](indexer,
+		chaosv1alpha1.GroupVersion.WithResource("chaoscleanuptasks").GroupResource())}
+}
+
+func (l chaosCleanupTaskLister) ChaosCleanupTasks(namespace string) ChaosCleanupTaskNamespaceLister {
+	return listers.NewNamespaced(l.ResourceIndexer, namespace)
+}
+
+// ChaosExperimentLister lists ChaosExperiments from an informer's indexer
+type ChaosExperimentLister interface {
+	List(selector labels.Selector) ([]*chaosv1alpha1.ChaosExperiment, error)
+	ChaosExperiments(namespace string) ChaosExperimentNamespaceLister
+}
+
+// ChaosExperimentNamespaceLister lists and gets the ChaosExperiments of one namespace
+type ChaosExperimentNamespaceLister interface {
+	List(selector labels.Selector) ([]*chaosv1alpha1.ChaosExperiment, error)
+	Get(name string) (*chaosv1alpha1.ChaosExperiment, error)
+}
+
+type chaosExperimentLister struct {
+	listers.ResourceIndexer[*chaosv1alpha1.ChaosExperiment]
+}
+
+// NewChaosExperimentLister returns a lister reading from indexer, e.g. informer.GetIndexer()
+func NewChaosExperimentLister(indexer cache.Indexer) ChaosExperimentLister {
+	return chaosExperimentLister{listers.New[*chaosv1alpha1.ChaosExperiment](indexer,
+		chaosv1alpha1.GroupVersion.WithResource("chaosexperiments").GroupResource())}
+}
+
+func (l chaosExperimentLister) ChaosExperiments(namespace string) ChaosExperimentNamespaceLister {
+	return listers.NewNamespaced(l.ResourceIndexer, namespace)
+}
+
+// ChaosExperimentHistoryLister lists history records from an informer's indexer
+type ChaosExperimentHistoryLister interface {
+	List(selector labels.Selector) ([]*chaosv1alpha1.ChaosExperimentHistory, error)
+	ChaosExperimentHistories(namespace string) ChaosExperimentHistoryNamespaceLister
+}
+
+// ChaosExperimentHistoryNamespaceLister lists and gets the history records of one namespace
+type ChaosExperimentHistoryNamespaceLister interface {
+	List(selector labels.Selector) ([]*chaosv1alpha1.ChaosExperimentHistory, error)
+	Get(name string) (*chaosv1alpha1.ChaosExperimentHistory, error)
+}
+
+type chaosExperimentHistoryLister struct {
+	listers.ResourceIndexer[*chaosv1alpha1.ChaosExperimentHistory]
+}
+
+// NewChaosExperimentHistoryLister returns a lister reading from indexer
+func NewChaosExperimentHistoryLister(indexer cache.Indexer) ChaosExperimentHistoryLister {
+	return chaosExperimentHistoryLister{listers.New[*chaosv1alpha1.ChaosExperimentHistory](indexer,
+		chaosv1alpha1.GroupVersion.WithResource("chaosexperimenthistories").GroupResource())}
+}
+
+func (l chaosExperimentHistoryLister) ChaosExperimentHistories(namespace string) ChaosExperimentHistoryNamespaceLister {
+	return listers.NewNamespaced(l.ResourceIndexer, namespace)
+}
+
+// ChaosFreezeLister lists and gets ChaosFreezes from an informer's indexer
+type ChaosFreezeLister interface {
+	List(selector labels.Selector) ([]*chaosv1alpha1.ChaosFreeze, error)
+	Get(name string) (*chaosv1alpha1.ChaosFreeze, error)
+}
+
+// NewChaosFreezeLister returns a lister reading from indexer
+func NewChaosFreezeLister(indexer cache.Indexer) ChaosFreezeLister {
+	return listers.New[*chaosv1alpha1.ChaosFreeze](indexer,
+		chaosv1alpha1.GroupVersion.WithResource("chaosfreezes").GroupResource())
+}
+
+// ChaosMonkeyLister lists ChaosMonkeys from an informer's indexer
+type ChaosMonkeyLister interface {
+	List(selector labels.Selector) ([]*chaosv1alpha1.ChaosMonkey, error)
+	ChaosMonkeys(namespace string) ChaosMonkeyNamespaceLister
+}
+
+// ChaosMonkeyNamespaceLister lists and gets the ChaosMonkeys of one namespace
+type ChaosMonkeyNamespaceLister interface {
+	List(selector labels.Selector) ([]*chaosv1alpha1.ChaosMonkey, error)
+	Get(name string) (*chaosv1alpha1.ChaosMonkey, error)
+}
+
+type chaosMonkeyLister struct {
+	listers.ResourceIndexer[*chaosv1alpha1.ChaosMonkey]
+}
+
+// NewChaosMonkeyLister returns a lister reading from indexer
+func NewChaosMonkeyLister(indexer cache.Indexer) ChaosMonkeyLister {
+	return chaosMonkeyLister{listers.New[*chaosv1alpha1.ChaosMonkey](indexer,
+		chaosv1alpha1.GroupVersion.WithResource("chaosmonkeys").GroupResource())}
+}
+
+func (l chaosMonkeyLister) ChaosMonkeys(namespace string) ChaosMonkeyNamespaceLister {
+	return listers.NewNamespaced(l.ResourceIndexer, namespace)
+}
+
+// ChaosPolicyLister lists and gets ChaosPolicies from an informer's indexer
+type ChaosPolicyLister interface {
+	List(selector labels.Selector) ([]*chaosv1alpha1.ChaosPolicy, error)
+	Get(name string) (*chaosv1alpha1.ChaosPolicy, error)
+}
+
+// NewChaosPolicyLister returns a lister reading from indexer
+func NewChaosPolicyLister(indexer cache.Indexer) ChaosPolicyLister {
+	return listers.New[*chaosv1alpha1.ChaosPolicy](indexer,
+		chaosv1alpha1.GroupVersion.WithResource("chaospolicies").GroupResource())
+}